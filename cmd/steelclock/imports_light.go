@@ -20,20 +20,26 @@ import (
 	_ "github.com/pozitronik/steelclock-go/internal/widget/battery"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/beefwebwidget"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/bluetooth"
+	_ "github.com/pozitronik/steelclock-go/internal/widget/capture"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/claudecode"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/clipboard"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/clock"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/cpu"
+	_ "github.com/pozitronik/steelclock-go/internal/widget/cputemp"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/disk"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/doom"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/gameoflife"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/gpu"
+	_ "github.com/pozitronik/steelclock-go/internal/widget/httpjson"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/hyperspace"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/keyboard"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/keyboardlayout"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/matrix"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/memory"
+	_ "github.com/pozitronik/steelclock-go/internal/widget/moonphase"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/network"
+	_ "github.com/pozitronik/steelclock-go/internal/widget/process"
+	_ "github.com/pozitronik/steelclock-go/internal/widget/profilename"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/spotifywidget"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/starwarsintro"
 	// EXCLUDED: _ "github.com/pozitronik/steelclock-go/internal/widget/telegramcounter"
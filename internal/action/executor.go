@@ -0,0 +1,96 @@
+// Package action executes user-triggered actions such as tray menu entries.
+package action
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// Handlers provides application callbacks for actions that need app state.
+// A nil handler makes the corresponding action fail with an error.
+type Handlers struct {
+	SwitchProfile func(profile string) error
+	ToggleWidget  func(widgetID string) error
+	OpenEditor    func() error
+	ReloadConfig  func() error
+	SetBrightness func(level int) error
+}
+
+// Executor dispatches actions to handlers or runs them directly
+type Executor struct {
+	handlers Handlers
+
+	// startCommand launches an external command; replaceable in tests
+	startCommand func(name string, args ...string) error
+}
+
+// NewExecutor creates an executor with the given handlers
+func NewExecutor(handlers Handlers) *Executor {
+	return &Executor{
+		handlers:     handlers,
+		startCommand: startDetached,
+	}
+}
+
+// Execute runs a single action
+func (e *Executor) Execute(a config.ActionConfig) error {
+	if err := config.ValidateAction(a); err != nil {
+		return err
+	}
+
+	switch a.Action {
+	case config.ActionSwitchProfile:
+		if e.handlers.SwitchProfile == nil {
+			return errUnsupported(a.Action)
+		}
+		return e.handlers.SwitchProfile(a.Profile)
+	case config.ActionToggleWidget:
+		if e.handlers.ToggleWidget == nil {
+			return errUnsupported(a.Action)
+		}
+		return e.handlers.ToggleWidget(a.Widget)
+	case config.ActionOpenEditor:
+		if e.handlers.OpenEditor == nil {
+			return errUnsupported(a.Action)
+		}
+		return e.handlers.OpenEditor()
+	case config.ActionReloadConfig:
+		if e.handlers.ReloadConfig == nil {
+			return errUnsupported(a.Action)
+		}
+		return e.handlers.ReloadConfig()
+	case config.ActionSetBrightness:
+		if e.handlers.SetBrightness == nil {
+			return errUnsupported(a.Action)
+		}
+		return e.handlers.SetBrightness(*a.Brightness)
+	case config.ActionRunCommand:
+		return e.startCommand(a.Command, a.Args...)
+	}
+
+	return errUnsupported(a.Action)
+}
+
+func errUnsupported(action string) error {
+	return fmt.Errorf("action '%s' is not available", action)
+}
+
+// startDetached starts a command without waiting for it to finish.
+// The process is reaped in the background to avoid leaving zombies.
+func startDetached(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start '%s': %w", name, err)
+	}
+
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			log.Printf("Command '%s' exited: %v", name, err)
+		}
+	}()
+
+	return nil
+}
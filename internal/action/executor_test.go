@@ -0,0 +1,120 @@
+package action
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestExecute_DispatchesToHandlers(t *testing.T) {
+	var gotProfile, gotWidget string
+	var editorCalls, reloadCalls, gotBrightness int
+
+	e := NewExecutor(Handlers{
+		SwitchProfile: func(p string) error { gotProfile = p; return nil },
+		ToggleWidget:  func(id string) error { gotWidget = id; return nil },
+		OpenEditor:    func() error { editorCalls++; return nil },
+		ReloadConfig:  func() error { reloadCalls++; return nil },
+		SetBrightness: func(level int) error { gotBrightness = level; return nil },
+	})
+
+	brightness := 3
+
+	actions := []config.ActionConfig{
+		{Action: config.ActionSwitchProfile, Profile: "work"},
+		{Action: config.ActionToggleWidget, Widget: "clock_0"},
+		{Action: config.ActionOpenEditor},
+		{Action: config.ActionReloadConfig},
+		{Action: config.ActionSetBrightness, Brightness: &brightness},
+	}
+	for _, a := range actions {
+		if err := e.Execute(a); err != nil {
+			t.Fatalf("Execute(%s) error: %v", a.Action, err)
+		}
+	}
+
+	if gotProfile != "work" {
+		t.Errorf("profile = %q, want %q", gotProfile, "work")
+	}
+	if gotWidget != "clock_0" {
+		t.Errorf("widget = %q, want %q", gotWidget, "clock_0")
+	}
+	if gotBrightness != 3 {
+		t.Errorf("brightness = %d, want 3", gotBrightness)
+	}
+	if editorCalls != 1 || reloadCalls != 1 {
+		t.Errorf("editor calls = %d, reload calls = %d, want 1 and 1", editorCalls, reloadCalls)
+	}
+}
+
+func TestExecute_PropagatesHandlerError(t *testing.T) {
+	want := errors.New("boom")
+	e := NewExecutor(Handlers{
+		ReloadConfig: func() error { return want },
+	})
+
+	if err := e.Execute(config.ActionConfig{Action: config.ActionReloadConfig}); !errors.Is(err, want) {
+		t.Errorf("Execute() error = %v, want %v", err, want)
+	}
+}
+
+func TestExecute_MissingHandler(t *testing.T) {
+	e := NewExecutor(Handlers{})
+
+	err := e.Execute(config.ActionConfig{Action: config.ActionOpenEditor})
+	if err == nil || !strings.Contains(err.Error(), "not available") {
+		t.Errorf("Execute() error = %v, want 'not available'", err)
+	}
+}
+
+func TestExecute_InvalidAction(t *testing.T) {
+	e := NewExecutor(Handlers{})
+
+	if err := e.Execute(config.ActionConfig{Action: "unknown"}); err == nil {
+		t.Error("Execute() expected error for unknown action")
+	}
+	if err := e.Execute(config.ActionConfig{Action: config.ActionSwitchProfile}); err == nil {
+		t.Error("Execute() expected error for switch_profile without profile")
+	}
+}
+
+func TestExecute_RunCommand(t *testing.T) {
+	var gotName string
+	var gotArgs []string
+
+	e := NewExecutor(Handlers{})
+	e.startCommand = func(name string, args ...string) error {
+		gotName = name
+		gotArgs = args
+		return nil
+	}
+
+	err := e.Execute(config.ActionConfig{
+		Action:  config.ActionRunCommand,
+		Command: "notepad.exe",
+		Args:    []string{"notes.txt"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error: %v", err)
+	}
+	if gotName != "notepad.exe" || len(gotArgs) != 1 || gotArgs[0] != "notes.txt" {
+		t.Errorf("started %q %v, want notepad.exe [notes.txt]", gotName, gotArgs)
+	}
+}
+
+func TestStartDetached(t *testing.T) {
+	name, args := "true", []string(nil)
+	if runtime.GOOS == "windows" {
+		name, args = "cmd", []string{"/c", "exit", "0"}
+	}
+
+	if err := startDetached(name, args...); err != nil {
+		t.Errorf("startDetached() error: %v", err)
+	}
+	if err := startDetached("steelclock-nonexistent-command"); err == nil {
+		t.Error("startDetached() expected error for missing command")
+	}
+}
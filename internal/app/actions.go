@@ -0,0 +1,196 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/pozitronik/steelclock-go/internal/action"
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// newActionExecutor creates an action executor wired to application operations
+func (a *App) newActionExecutor() *action.Executor {
+	handlers := action.Handlers{
+		ToggleWidget:  a.ToggleWidget,
+		ReloadConfig:  a.ReloadConfig,
+		OpenEditor:    a.openEditor,
+		SetBrightness: a.SetBrightness,
+	}
+	if a.configMgr.HasProfiles() {
+		handlers.SwitchProfile = a.switchProfileByName
+	}
+	return action.NewExecutor(handlers)
+}
+
+// executeAction runs a user-triggered action, logging any failure
+func (a *App) executeAction(act config.ActionConfig) {
+	log.Printf("Executing action: %s", act.Action)
+	if err := a.actions.Execute(act); err != nil {
+		log.Printf("Action '%s' failed: %v", act.Action, err)
+	}
+}
+
+// updateTrayMenu refreshes custom tray menu entries from the given config
+func (a *App) updateTrayMenu(cfg *config.Config) {
+	if a.trayMgr == nil || cfg == nil {
+		return
+	}
+	a.trayMgr.SetCustomMenu(cfg.TrayMenu)
+}
+
+// switchProfileByName switches to a profile identified by name or config path
+func (a *App) switchProfileByName(profile string) error {
+	path, err := resolveProfilePath(a.configMgr.GetProfileManager().GetProfiles(), profile)
+	if err != nil {
+		return err
+	}
+	return a.switchProfileAndUpdateTray(path)
+}
+
+// resolveProfilePath finds a profile by path (exact or by file name) or display name (case-insensitive)
+func resolveProfilePath(profiles []*config.Profile, profile string) (string, error) {
+	for _, p := range profiles {
+		if p.Path == profile || filepath.Base(p.Path) == profile {
+			return p.Path, nil
+		}
+	}
+	for _, p := range profiles {
+		if strings.EqualFold(p.Name, profile) {
+			return p.Path, nil
+		}
+	}
+	return "", fmt.Errorf("profile '%s' not found", profile)
+}
+
+// applyRuntimeState applies persisted runtime overrides for the active config
+func (a *App) applyRuntimeState(cfg *config.Config) *config.Config {
+	return a.state.Apply(a.configMgr.GetConfigPath(), cfg)
+}
+
+// ToggleWidget flips the enabled state of a widget in the running configuration.
+// The widget is added to or removed from the running layout without restarting the device.
+// The new state is saved as a runtime override and restored on reload and restart;
+// the config file itself is not modified.
+func (a *App) ToggleWidget(widgetID string) error {
+	a.configMu.Lock()
+	defer a.configMu.Unlock()
+
+	cfg := a.lifecycle.GetLastGoodConfig()
+	if cfg == nil {
+		return fmt.Errorf("no configuration loaded")
+	}
+
+	newCfg, enabled, err := toggleWidgetInConfig(cfg, widgetID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Toggling widget %s (enabled: %v)", widgetID, enabled)
+
+	// The last good config already carries the runtime state, so newCfg is used as is.
+	// Toggle inside the running layout; restart only when there is none to update
+	toggled, err := a.lifecycle.ToggleWidget(newCfg, widgetID, enabled)
+	if err != nil {
+		return fmt.Errorf("failed to toggle widget '%s': %w", widgetID, err)
+	}
+	if !toggled {
+		if enabled {
+			a.lifecycle.QueueWidgetEnter(widgetID, findWidgetTransitions(newCfg, widgetID))
+		}
+		a.lifecycle.Stop()
+		if err := a.lifecycle.Start(newCfg); err != nil {
+			log.Printf("ERROR: Failed to restart after widget toggle: %v", err)
+			if restoreErr := a.lifecycle.Start(cfg); restoreErr != nil {
+				log.Printf("ERROR: Failed to restore previous configuration: %v", restoreErr)
+			}
+			return fmt.Errorf("failed to toggle widget '%s': %w", widgetID, err)
+		}
+	}
+
+	if err := a.state.SetWidgetEnabled(a.configMgr.GetConfigPath(), widgetID, enabled); err != nil {
+		log.Printf("Warning: Failed to save runtime state: %v", err)
+	}
+
+	a.updateWebClientProviderUnlocked()
+	return nil
+}
+
+// SetBrightness changes display brightness on running devices and saves it as a runtime override
+func (a *App) SetBrightness(level int) error {
+	a.configMu.Lock()
+	defer a.configMu.Unlock()
+
+	if level < config.MinBrightness || level > config.MaxBrightness {
+		return fmt.Errorf("brightness must be between %d and %d (got %d)",
+			config.MinBrightness, config.MaxBrightness, level)
+	}
+
+	if err := a.state.SetBrightness(a.configMgr.GetConfigPath(), level); err != nil {
+		log.Printf("Warning: Failed to save runtime state: %v", err)
+	}
+
+	applied, err := a.lifecycle.SetBrightness(level)
+	if err != nil {
+		return err
+	}
+	if applied == 0 {
+		log.Println("No running device supports brightness control")
+	} else {
+		log.Printf("Brightness set to %d (%d device(s))", level, applied)
+	}
+	return nil
+}
+
+// toggleWidgetInConfig returns a copy of cfg with the given widget's enabled state flipped.
+// Widget slices are copied so the original config is left untouched.
+func toggleWidgetInConfig(cfg *config.Config, widgetID string) (*config.Config, bool, error) {
+	newCfg := *cfg
+
+	if enabled, ok := toggleInWidgets(&newCfg.Widgets, widgetID); ok {
+		return &newCfg, enabled, nil
+	}
+
+	if len(newCfg.Devices) > 0 {
+		devices := make([]config.DeviceConfig, len(newCfg.Devices))
+		copy(devices, newCfg.Devices)
+		newCfg.Devices = devices
+		for i := range devices {
+			if enabled, ok := toggleInWidgets(&devices[i].Widgets, widgetID); ok {
+				return &newCfg, enabled, nil
+			}
+		}
+	}
+
+	return nil, false, fmt.Errorf("widget '%s' not found", widgetID)
+}
+
+// toggleInWidgets replaces *widgets with a copy where the matching widget is toggled
+func toggleInWidgets(widgets *[]config.WidgetConfig, widgetID string) (bool, bool) {
+	for i, w := range *widgets {
+		if w.ID != widgetID {
+			continue
+		}
+		copied := make([]config.WidgetConfig, len(*widgets))
+		copy(copied, *widgets)
+		enabled := !w.IsEnabled()
+		copied[i].Enabled = &enabled
+		*widgets = copied
+		return enabled, true
+	}
+	return false, false
+}
+
+// openEditor starts the web editor if needed and opens it in the browser
+func (a *App) openEditor() error {
+	if a.webEditor == nil {
+		return fmt.Errorf("web editor not available")
+	}
+	if !a.webEditor.IsRunning() {
+		if err := a.webEditor.Start(); err != nil {
+			return fmt.Errorf("failed to start web editor: %w", err)
+		}
+	}
+	return openBrowser(a.webEditor.GetURL())
+}
@@ -0,0 +1,125 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestResolveProfilePath(t *testing.T) {
+	profiles := []*config.Profile{
+		{Path: "/cfg/steelclock.json", Name: "Main"},
+		{Path: "/cfg/profiles/work.json", Name: "Work Setup"},
+	}
+
+	tests := []struct {
+		name    string
+		profile string
+		want    string
+		wantErr bool
+	}{
+		{"full path", "/cfg/profiles/work.json", "/cfg/profiles/work.json", false},
+		{"file name", "work.json", "/cfg/profiles/work.json", false},
+		{"display name", "Work Setup", "/cfg/profiles/work.json", false},
+		{"display name case-insensitive", "main", "/cfg/steelclock.json", false},
+		{"unknown", "gaming", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveProfilePath(profiles, tt.profile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveProfilePath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("resolveProfilePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToggleWidgetInConfig_TopLevel(t *testing.T) {
+	disabled := false
+	cfg := &config.Config{
+		Widgets: []config.WidgetConfig{
+			{ID: "clock_0", Type: "clock"},
+			{ID: "cpu_0", Type: "cpu", Enabled: &disabled},
+		},
+	}
+
+	newCfg, enabled, err := toggleWidgetInConfig(cfg, "clock_0")
+	if err != nil {
+		t.Fatalf("toggleWidgetInConfig() error: %v", err)
+	}
+	if enabled {
+		t.Error("clock_0 should become disabled")
+	}
+	if newCfg.Widgets[0].IsEnabled() {
+		t.Error("new config clock_0 should be disabled")
+	}
+	if !cfg.Widgets[0].IsEnabled() {
+		t.Error("original config must not be modified")
+	}
+
+	newCfg, enabled, err = toggleWidgetInConfig(cfg, "cpu_0")
+	if err != nil {
+		t.Fatalf("toggleWidgetInConfig() error: %v", err)
+	}
+	if !enabled || !newCfg.Widgets[1].IsEnabled() {
+		t.Error("cpu_0 should become enabled")
+	}
+}
+
+func TestToggleWidgetInConfig_Devices(t *testing.T) {
+	cfg := &config.Config{
+		Devices: []config.DeviceConfig{
+			{ID: "main", Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}}},
+			{ID: "second", Widgets: []config.WidgetConfig{{ID: "cpu_0", Type: "cpu"}}},
+		},
+	}
+
+	newCfg, enabled, err := toggleWidgetInConfig(cfg, "cpu_0")
+	if err != nil {
+		t.Fatalf("toggleWidgetInConfig() error: %v", err)
+	}
+	if enabled || newCfg.Devices[1].Widgets[0].IsEnabled() {
+		t.Error("cpu_0 should become disabled")
+	}
+	if !cfg.Devices[1].Widgets[0].IsEnabled() {
+		t.Error("original device widgets must not be modified")
+	}
+}
+
+func TestToggleWidgetInConfig_NotFound(t *testing.T) {
+	cfg := &config.Config{Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}}}
+
+	if _, _, err := toggleWidgetInConfig(cfg, "missing_0"); err == nil {
+		t.Error("expected error for unknown widget ID")
+	}
+}
+
+func TestToggleWidget_NoConfig(t *testing.T) {
+	a := NewApp("config.json")
+
+	if err := a.ToggleWidget("clock_0"); err == nil {
+		t.Error("expected error when no configuration is loaded")
+	}
+}
+
+func TestOpenEditor_NoWebEditor(t *testing.T) {
+	a := NewApp("config.json")
+
+	if err := a.openEditor(); err == nil {
+		t.Error("expected error when web editor is not configured")
+	}
+}
+
+func TestNewActionExecutor_LegacyModeHasNoProfileSwitch(t *testing.T) {
+	a := NewApp("config.json")
+	a.actions = a.newActionExecutor()
+
+	err := a.actions.Execute(config.ActionConfig{Action: config.ActionSwitchProfile, Profile: "work"})
+	if err == nil {
+		t.Error("expected error for switch_profile in single-config mode")
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pozitronik/steelclock-go/internal/action"
 	"github.com/pozitronik/steelclock-go/internal/config"
 	"github.com/pozitronik/steelclock-go/internal/tray"
 	"github.com/pozitronik/steelclock-go/internal/webeditor"
+	"github.com/pozitronik/steelclock-go/internal/widget/profilename"
 )
 
 // GameSense API constants
@@ -55,6 +57,11 @@ type App struct {
 	configMgr *ConfigManager
 	trayMgr   *tray.Manager
 	webEditor *webeditor.Server
+	configWeb *ConfigProviderAdapter // Config provider of the web editor
+	triggers  *profileTriggerPoller  // Automatic profile switching, nil without profiles
+	schedule  *profileSchedulePoller // Time-of-day profile switching, nil without profiles
+	actions   *action.Executor
+	state     *StateManager
 
 	// configMu serializes config reload and profile switch operations.
 	// This prevents race conditions when multiple sources (tray, web editor)
@@ -75,14 +82,20 @@ func NewApp(configPath string) *App {
 	return &App{
 		lifecycle: NewLifecycleManager(),
 		configMgr: NewConfigManager(configPath),
+		state:     NewStateManager(filepath.Join(filepath.Dir(configPath), RuntimeStateFile)),
 	}
 }
 
 // NewAppWithProfiles creates a new application instance with profile support
 func NewAppWithProfiles(profileMgr *config.ProfileManager) *App {
+	stateDir := "."
+	if profileMgr != nil {
+		stateDir = profileMgr.BaseDir()
+	}
 	return &App{
 		lifecycle: NewLifecycleManager(),
 		configMgr: NewConfigManagerWithProfiles(profileMgr),
+		state:     NewStateManager(filepath.Join(stateDir, RuntimeStateFile)),
 	}
 }
 
@@ -104,6 +117,13 @@ func (a *App) Run() {
 	// Create web editor server
 	a.createWebEditor()
 
+	// Wire custom tray menu actions
+	a.actions = a.newActionExecutor()
+	a.trayMgr.SetActionHandler(a.executeAction)
+
+	// Let profile_name widgets read the active profile
+	profilename.ActiveProfileName = a.configMgr.GetDisplayName
+
 	log.Println("========================================")
 
 	// Set callback to run when tray is ready
@@ -112,6 +132,14 @@ func (a *App) Run() {
 			a.handleStartupFailure(err)
 		}
 
+		// Switch profiles automatically by schedule and while trigger applications run
+		if a.configMgr.HasProfiles() {
+			a.schedule = a.newProfileSchedulePoller()
+			a.schedule.Start(ProfileSchedulePollInterval)
+			a.triggers = a.newProfileTriggerPoller()
+			a.triggers.Start(ProfileTriggerPollInterval)
+		}
+
 		// Auto-start web editor
 		if a.webEditor != nil {
 			if err := a.webEditor.Start(); err != nil {
@@ -124,6 +152,9 @@ func (a *App) Run() {
 		}
 	})
 
+	// Reload when the active config file is edited outside the application
+	a.configMgr.Watch(a.onConfigFileChanged)
+
 	log.Println("System tray initializing. Use tray icon to control the application.")
 
 	// Run system tray (blocks until Quit)
@@ -131,6 +162,14 @@ func (a *App) Run() {
 
 	log.Println("SteelClock shutting down...")
 
+	a.configMgr.StopWatching()
+	if a.schedule != nil {
+		a.schedule.Stop()
+	}
+	if a.triggers != nil {
+		a.triggers.Stop()
+	}
+
 	// Stop web editor if running
 	if a.webEditor != nil {
 		if err := a.webEditor.Stop(); err != nil {
@@ -162,10 +201,13 @@ func (a *App) createWebEditor() {
 
 	// Create providers
 	configProvider := NewConfigProviderAdapter(a.configMgr)
+	a.configWeb = configProvider
 	var profileProvider webeditor.ProfileProvider
 	var onProfileSwitch func(path string) error
 	if a.configMgr.HasProfiles() {
-		profileProvider = NewProfileProviderAdapter(a.configMgr.GetProfileManager())
+		profiles := NewProfileProviderAdapter(a.configMgr.GetProfileManager())
+		profiles.onWrite = a.configMgr.MarkWritten // Renaming rewrites the profile file
+		profileProvider = profiles
 		onProfileSwitch = a.switchProfileAndUpdateTray
 	}
 
@@ -174,6 +216,9 @@ func (a *App) createWebEditor() {
 
 	// Set webclient override callback
 	a.webEditor.SetPreviewOverrideCallback(a.SetWebClientOverride)
+	a.webEditor.SetFramePacingProvider(a.framePacingInfo)
+	a.webEditor.SetNowPlayingProvider(a.nowPlayingInfo)
+	a.webEditor.SetHIDDevicesProvider(hidDevicesInfo)
 
 	// Wire up with tray manager
 	a.trayMgr.SetWebEditor(a.webEditor)
@@ -189,10 +234,12 @@ func (a *App) Start() error {
 		return a.handleStartupError(err, nil)
 	}
 
-	if err := a.lifecycle.Start(cfg); err != nil {
+	if err := a.lifecycle.Start(a.applyRuntimeState(cfg)); err != nil {
 		return a.handleStartupError(err, cfg)
 	}
 
+	a.updateTrayMenu(cfg)
+
 	// Update webclient provider if webclient backend is active
 	a.updateWebClientProvider()
 
@@ -376,7 +423,7 @@ func (a *App) disableWebClientOverride() error {
 
 	// Start with original backend
 	log.Printf("Starting with original backend: %s", a.webclientOverrideOriginal)
-	if err := a.lifecycle.Start(&originalCfg); err != nil {
+	if err := a.lifecycle.Start(a.applyRuntimeState(&originalCfg)); err != nil {
 		log.Printf("ERROR: Failed to restore original backend: %v", err)
 		return fmt.Errorf("failed to disable webclient override: %w", err)
 	}
@@ -465,12 +512,14 @@ func (a *App) ReloadConfig() error {
 	time.Sleep(2 * time.Second)
 
 	log.Println("Starting with new config...")
-	if err := a.lifecycle.Start(newCfg); err != nil {
+	if err := a.lifecycle.Start(a.applyRuntimeState(newCfg)); err != nil {
 		log.Printf("ERROR: Failed to start with new config: %v", err)
 		time.Sleep(1 * time.Second)
 		return a.handleStartupError(err, newCfg)
 	}
 
+	a.updateTrayMenu(newCfg)
+
 	// Update webclient provider if webclient backend is active
 	a.updateWebClientProvider()
 
@@ -480,6 +529,18 @@ func (a *App) ReloadConfig() error {
 	return nil
 }
 
+// onConfigFileChanged reloads the configuration after the active config file was
+// changed outside the application and tells the web editor to refresh
+func (a *App) onConfigFileChanged(path string) {
+	log.Printf("Config file changed on disk: %s", path)
+	if err := a.ReloadConfig(); err != nil {
+		log.Printf("Failed to reload changed config: %v", err)
+	}
+	if a.configWeb != nil {
+		a.configWeb.notifyChanged(path)
+	}
+}
+
 // SwitchProfile switches to a different configuration profile.
 // This operation is serialized with other config operations via configMu.
 func (a *App) SwitchProfile(path string) error {
@@ -529,12 +590,14 @@ func (a *App) SwitchProfile(path string) error {
 
 	// Start with new config
 	log.Println("Starting with new profile...")
-	if err := a.lifecycle.Start(newCfg); err != nil {
+	if err := a.lifecycle.Start(a.applyRuntimeState(newCfg)); err != nil {
 		log.Printf("ERROR: Failed to start with new profile: %v", err)
 		time.Sleep(1 * time.Second)
 		return a.handleStartupError(err, newCfg)
 	}
 
+	a.updateTrayMenu(newCfg)
+
 	// Update webclient provider if webclient backend is active
 	a.updateWebClientProvider()
 
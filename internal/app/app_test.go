@@ -3,6 +3,13 @@ package app
 import (
 	"errors"
 	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/compositor"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/driver"
+	"github.com/pozitronik/steelclock-go/internal/webeditor"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+	"github.com/pozitronik/steelclock-go/internal/widget/winampwidget"
 )
 
 func TestNewApp(t *testing.T) {
@@ -288,3 +295,88 @@ func TestAppLifecycleManagerAccess(t *testing.T) {
 		t.Errorf("default dimensions = %dx%d, want 128x40", w, h)
 	}
 }
+
+func TestFramePacingInfos(t *testing.T) {
+	infos := framePacingInfos(map[string]compositor.PacerStats{
+		"secondary": {MaxFPS: 0, Pushed: 10},
+		"main":      {MaxFPS: 30, Pushed: 5, Coalesced: 3},
+	})
+
+	if len(infos) != 2 {
+		t.Fatalf("got %d entries, want 2", len(infos))
+	}
+	if infos[0].Device != "main" || infos[1].Device != "secondary" {
+		t.Errorf("entries not ordered by device: %+v", infos)
+	}
+	if infos[0].MaxFPS != 30 || infos[0].Pushed != 5 || infos[0].Coalesced != 3 {
+		t.Errorf("main entry = %+v, want max 30, 5 pushed, 3 coalesced", infos[0])
+	}
+}
+
+func TestHIDDeviceInfos(t *testing.T) {
+	infos := hidDeviceInfos([]driver.DeviceInfo{
+		{VID: 0x046D, PID: 0xC52B, Path: "/dev/hidraw0", ProductName: "USB Receiver"},
+		{VID: 0x1038, PID: 0x1612, Interface: "mi_01", UsagePage: 0xFFC0, Path: "/dev/hidraw3"},
+		{VID: 0x1038, PID: 0x1612, Interface: "mi_00", UsagePage: 0x0001, Path: "/dev/hidraw2"},
+	})
+
+	if len(infos) != 3 {
+		t.Fatalf("got %d entries, want 3", len(infos))
+	}
+	if infos[0].Interface != "mi_00" || infos[1].Interface != "mi_01" || infos[2].VID != "046D" {
+		t.Errorf("entries not ordered known first, then by interface: %+v", infos)
+	}
+	want := webeditor.HIDDeviceInfo{VID: "1038", PID: "1612", Interface: "mi_01", UsagePage: "FFC0", Path: "/dev/hidraw3", Known: "Apex 7"}
+	if infos[1] != want {
+		t.Errorf("apex entry = %+v, want %+v", infos[1], want)
+	}
+	if infos[2].Known != "" || infos[2].UsagePage != "" || infos[2].Product != "USB Receiver" {
+		t.Errorf("receiver entry = %+v, want unknown device without usage page", infos[2])
+	}
+}
+
+func TestNowPlayingFromWidgets(t *testing.T) {
+	if info := nowPlayingFromWidgets(nil); info != nil {
+		t.Errorf("no widgets: got %+v, want nil", info)
+	}
+
+	w, err := winampwidget.New(config.WidgetConfig{
+		Type:     "winamp",
+		ID:       "player",
+		Position: config.PositionConfig{W: 128, H: 40},
+	})
+	if err != nil {
+		t.Fatalf("winampwidget.New() error = %v", err)
+	}
+
+	info := nowPlayingFromWidgets([]widget.Widget{w})
+	if info == nil {
+		t.Fatal("media widget: got nil info")
+	}
+	if info.Widget != "player" || info.Status != "stopped" || info.Title != "" {
+		t.Errorf("idle media widget info = %+v, want widget player, status stopped", info)
+	}
+}
+
+func TestConfigProviderAdapter_NotifyChanged(t *testing.T) {
+	adapter := NewConfigProviderAdapter(NewConfigManager("config.json"))
+
+	// A pending notification absorbs further ones instead of blocking the watcher
+	adapter.notifyChanged("config.json")
+	adapter.notifyChanged("config.json")
+
+	select {
+	case path := <-adapter.ConfigChanged():
+		if path != "config.json" {
+			t.Errorf("notified path = %q, want config.json", path)
+		}
+	default:
+		t.Fatal("no change notification queued")
+	}
+
+	select {
+	case path := <-adapter.ConfigChanged():
+		t.Errorf("unexpected second notification %q", path)
+	default:
+	}
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"log"
+
 	"github.com/pozitronik/steelclock-go/internal/backend"
 	"github.com/pozitronik/steelclock-go/internal/config"
 	"github.com/pozitronik/steelclock-go/internal/display"
@@ -8,6 +10,7 @@ import (
 	// Import backend implementations for self-registration via init()
 	_ "github.com/pozitronik/steelclock-go/internal/backend/direct"
 	_ "github.com/pozitronik/steelclock-go/internal/backend/gamesense"
+	_ "github.com/pozitronik/steelclock-go/internal/backend/null"
 	_ "github.com/pozitronik/steelclock-go/internal/backend/webclient"
 )
 
@@ -21,6 +24,41 @@ func CreateBackendClient(cfg *config.Config) (display.Backend, string, error) {
 	return result.Backend, result.Name, nil
 }
 
+// CreateBackendClientWithRetry creates the backend client, retrying with exponential backoff
+// while no backend is available. This covers backends that are not ready right after boot
+// (GameSense engine still starting, USB device not yet enumerated).
+// The cancel channel aborts the wait between attempts.
+func CreateBackendClientWithRetry(cfg *config.Config, cancel <-chan struct{}) (display.Backend, string, error) {
+	attempts := backendAttempts(cfg)
+
+	var client display.Backend
+	var name string
+	err := RetryWithBackoff(attempts, cancel, func(attempt int) error {
+		result, err := backend.Create(cfg)
+		if err != nil {
+			if attempts > 1 {
+				log.Printf("Backend not ready (attempt %d/%d): %v", attempt, attempts, err)
+			}
+			return err
+		}
+		client, name = result.Backend, result.Name
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return client, name, nil
+}
+
+// backendAttempts returns the total number of backend creation attempts for the config
+func backendAttempts(cfg *config.Config) int {
+	retries := config.DefaultBackendRetries
+	if cfg.BackendRetries != nil {
+		retries = max(*cfg.BackendRetries, 0)
+	}
+	return retries + 1
+}
+
 // CreateBackendByName creates a specific backend by name.
 // Returns BackendUnavailableError if the backend cannot be created.
 func CreateBackendByName(name string, cfg *config.Config) (display.Backend, error) {
@@ -304,3 +304,49 @@ func TestCreateBackendByNameUnknownBackend(t *testing.T) {
 		t.Errorf("expected BackendUnavailableError, got %T: %v", err, err)
 	}
 }
+
+func TestBackendAttempts(t *testing.T) {
+	tests := []struct {
+		name    string
+		retries *int
+		want    int
+	}{
+		{"default", nil, config.DefaultBackendRetries + 1},
+		{"no retries", config.IntPtr(0), 1},
+		{"custom", config.IntPtr(9), 10},
+		{"negative treated as zero", config.IntPtr(-3), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{BackendRetries: tt.retries}
+			if got := backendAttempts(cfg); got != tt.want {
+				t.Errorf("backendAttempts() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateBackendClientWithRetryCancelled(t *testing.T) {
+	cfg := &config.Config{
+		GameName:        "TEST",
+		GameDisplayName: "Test",
+		Backend:         "invalid_backend",
+		BackendRetries:  config.IntPtr(5),
+		Display: config.DisplayConfig{
+			Width:  128,
+			Height: 40,
+		},
+	}
+
+	cancel := make(chan struct{})
+	close(cancel)
+
+	_, _, err := CreateBackendClientWithRetry(cfg, cancel)
+	if err == nil {
+		t.Fatal("expected error for unknown backend")
+	}
+	if err.Error() != "retry cancelled" {
+		t.Errorf("error = %q, want 'retry cancelled'", err.Error())
+	}
+}
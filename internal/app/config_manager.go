@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
 )
@@ -14,6 +15,7 @@ import (
 type ConfigManager struct {
 	configPath string
 	profileMgr *config.ProfileManager
+	watcher    *config.FileWatcher // Watches the active config file for external edits
 }
 
 // NewConfigManager creates a ConfigManager for direct config file mode.
@@ -66,6 +68,19 @@ func (m *ConfigManager) GetActiveProfileName() string {
 	return activeProfile.Name
 }
 
+// GetDisplayName returns a name identifying the active configuration:
+// the profile name in profile mode, or the config file name without extension.
+func (m *ConfigManager) GetDisplayName() string {
+	if m.profileMgr != nil {
+		return m.GetActiveProfileName()
+	}
+	if m.configPath == "" {
+		return ""
+	}
+	base := filepath.Base(m.configPath)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
 // Load loads the configuration from the current source.
 func (m *ConfigManager) Load() (*config.Config, error) {
 	if m.profileMgr != nil {
@@ -122,6 +137,31 @@ func (m *ConfigManager) SwitchProfile(path string) (*config.Config, error) {
 	return cfg, nil
 }
 
+// Watch starts watching the active configuration file for changes made outside the
+// application. onChange is called with the file path once a change has settled.
+// The watched file follows profile switches.
+func (m *ConfigManager) Watch(onChange func(path string)) {
+	m.StopWatching()
+	m.watcher = config.NewFileWatcher(m.GetConfigPath, config.WatchDebounce, onChange)
+	m.watcher.Start(config.WatchPollInterval)
+}
+
+// StopWatching stops watching the configuration file.
+func (m *ConfigManager) StopWatching() {
+	if m.watcher != nil {
+		m.watcher.Stop()
+		m.watcher = nil
+	}
+}
+
+// MarkWritten tells the watcher the application wrote the configuration file itself,
+// so the write is not reported as an external change.
+func (m *ConfigManager) MarkWritten() {
+	if m.watcher != nil {
+		m.watcher.Sync()
+	}
+}
+
 // LogStartupInfo logs configuration information at startup.
 func (m *ConfigManager) LogStartupInfo() {
 	if m.profileMgr != nil {
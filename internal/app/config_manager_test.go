@@ -133,6 +133,33 @@ func TestConfigManager_GetActiveProfileName(t *testing.T) {
 	}
 }
 
+func TestConfigManager_GetDisplayName(t *testing.T) {
+	// Direct mode - file name without extension
+	mgrDirect := NewConfigManager("profiles/night.json")
+	if got := mgrDirect.GetDisplayName(); got != "night" {
+		t.Errorf("GetDisplayName = %q, want %q", got, "night")
+	}
+
+	if got := NewConfigManager("").GetDisplayName(); got != "" {
+		t.Errorf("GetDisplayName with empty path = %q, want empty", got)
+	}
+
+	// Profile mode with active profile
+	tmpDir := t.TempDir()
+	mainPath := filepath.Join(tmpDir, config.MainConfigFile)
+	if err := os.WriteFile(mainPath, []byte(`{"config_name": "Desk", "widgets": [{"type": "clock"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	pm := config.NewProfileManager(tmpDir)
+	if err := pm.LoadProfiles(); err != nil {
+		t.Fatal(err)
+	}
+	mgrProfiles := NewConfigManagerWithProfiles(pm)
+	if got := mgrProfiles.GetDisplayName(); got != "Desk" {
+		t.Errorf("GetDisplayName = %q, want %q", got, "Desk")
+	}
+}
+
 func TestConfigManager_Load_DirectMode(t *testing.T) {
 	// Test with non-existent file - may or may not error depending on platform
 	mgr := NewConfigManager("non_existent_config_that_definitely_does_not_exist_12345.json")
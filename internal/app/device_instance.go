@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/backend/webclient"
 	"github.com/pozitronik/steelclock-go/internal/compositor"
 	"github.com/pozitronik/steelclock-go/internal/config"
 	"github.com/pozitronik/steelclock-go/internal/display"
+	"github.com/pozitronik/steelclock-go/internal/layout"
+	"github.com/pozitronik/steelclock-go/internal/widget"
 )
 
 // DeviceInstance manages the lifecycle of a single display device.
@@ -17,12 +20,16 @@ import (
 type DeviceInstance struct {
 	id             string
 	comp           *compositor.Compositor
+	cfg            *config.Config // Config of the running layout, rebuilt from on backend failover
+	layout         *layout.Manager
 	client         display.Backend
 	currentBackend string
 	displayWidth   int
 	displayHeight  int
 	widgetMgr      *WidgetManager
 	retryCancel    chan struct{}
+	pendingEnter   *widgetTransitionRequest // Enter transition to play when the next Start creates the widget
+	pendingRemove  map[string]*time.Timer   // Widgets toggled off, removed once their exit transition ends
 	mu             sync.Mutex
 }
 
@@ -37,13 +44,15 @@ func NewDeviceInstance(id string, retryCancel chan struct{}) *DeviceInstance {
 }
 
 // Start initializes and starts the device with the given per-device configuration.
-func (d *DeviceInstance) Start(cfg *config.Config, showSplash bool) error {
+// On the first start the splash is shown and backend creation is retried; later starts
+// (reloads, profile switches) fail fast so they never block on an absent backend.
+func (d *DeviceInstance) Start(cfg *config.Config, firstStart bool) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
 	log.Printf("[%s] Starting device (%dx%d)", d.id, cfg.Display.Width, cfg.Display.Height)
 
-	if err := d.ensureClient(cfg); err != nil {
+	if err := d.ensureClient(cfg, firstStart); err != nil {
 		return err
 	}
 
@@ -59,7 +68,7 @@ func (d *DeviceInstance) Start(cfg *config.Config, showSplash bool) error {
 		}
 	}
 
-	if showSplash {
+	if firstStart {
 		splash := NewSplashRenderer(d.client, d.displayWidth, d.displayHeight)
 		if err := splash.ShowStartupAnimation(); err != nil {
 			log.Printf("[%s] Warning: Startup animation failed: %v", d.id, err)
@@ -83,12 +92,19 @@ func (d *DeviceInstance) Start(cfg *config.Config, showSplash bool) error {
 	}
 
 	d.comp = setup.Compositor
+	d.layout = setup.Layout
+	d.cfg = cfg
+
+	// Start the enter transition before the first frame so the widget never flashes in
+	if req := d.pendingEnter; req != nil {
+		d.pendingEnter = nil
+		transitionType, seconds, easing := toggleTransition(req.transitions, true)
+		d.layout.StartEnterTransition(req.widgetID, transitionType, seconds, easing)
+	}
 
 	// Set up backend failover callback for auto-select mode
-	if cfg.Backend == "" {
-		d.comp.OnBackendFailure = func() {
-			d.handleBackendFailure(cfg)
-		}
+	if config.IsAutoBackend(cfg.Backend) {
+		d.comp.OnBackendFailure = d.handleBackendFailure
 	}
 
 	if err := d.comp.Start(); err != nil {
@@ -99,6 +115,19 @@ func (d *DeviceInstance) Start(cfg *config.Config, showSplash bool) error {
 	return nil
 }
 
+// SetBrightness applies display brightness if the backend supports it.
+// Returns false when the backend has no brightness control.
+func (d *DeviceInstance) SetBrightness(level int) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	bc, ok := d.client.(display.BrightnessControl)
+	if !ok {
+		return false, nil
+	}
+	return true, bc.SetBrightness(level)
+}
+
 // Stop stops the compositor but keeps the client for reuse
 func (d *DeviceInstance) Stop() {
 	d.mu.Lock()
@@ -109,10 +138,115 @@ func (d *DeviceInstance) Stop() {
 		d.comp = nil
 		log.Printf("[%s] Stopping compositor (keeping client)", d.id)
 	}
+	d.layout = nil
+	d.cancelPendingRemovals()
+}
+
+// cancelPendingRemovals drops widget removals waiting for an exit transition.
+// Must be called with mu held.
+func (d *DeviceInstance) cancelPendingRemovals() {
+	for _, timer := range d.pendingRemove {
+		timer.Stop()
+	}
+	d.pendingRemove = nil
 }
 
-// Shutdown performs a full shutdown of the device
-func (d *DeviceInstance) Shutdown(unregisterOnExit bool) {
+// ToggleWidget adds or removes a widget in the running layout without restarting the device.
+// cfg is the per-device config with the widget's new enabled state. A widget toggled on
+// plays its enter transition; a widget toggled off is animated out and removed in the
+// background. Returns false if the device has no running compositor.
+func (d *DeviceInstance) ToggleWidget(cfg *config.Config, widgetID string, enabled bool) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.comp == nil {
+		return false, nil
+	}
+
+	var wcfg *config.WidgetConfig
+	for i := range cfg.Widgets {
+		if cfg.Widgets[i].ID == widgetID {
+			wcfg = &cfg.Widgets[i]
+			break
+		}
+	}
+
+	if enabled {
+		if wcfg == nil {
+			return true, fmt.Errorf("widget '%s' not found", widgetID)
+		}
+		if err := d.addWidget(*wcfg); err != nil {
+			return true, err
+		}
+	} else {
+		var transitions *config.TransitionConfig
+		if wcfg != nil {
+			transitions = wcfg.Transitions
+		}
+		d.removeWidget(widgetID, transitions)
+	}
+
+	d.cfg = cfg
+	return true, nil
+}
+
+// addWidget creates a widget and adds it to the running layout with its enter transition.
+// Must be called with mu held.
+func (d *DeviceInstance) addWidget(wcfg config.WidgetConfig) error {
+	// Toggled back on before its exit transition ended: drop the old instance now
+	if timer, ok := d.pendingRemove[wcfg.ID]; ok {
+		timer.Stop()
+		delete(d.pendingRemove, wcfg.ID)
+		d.comp.RemoveWidget(wcfg.ID)
+	}
+
+	w, err := widget.CreateWidget(wcfg)
+	if err != nil {
+		return fmt.Errorf("failed to create widget '%s': %w", wcfg.ID, err)
+	}
+
+	d.comp.AddWidget(w)
+	transitionType, seconds, easing := toggleTransition(wcfg.Transitions, true)
+	d.layout.StartEnterTransition(wcfg.ID, transitionType, seconds, easing)
+	log.Printf("[%s] Widget %s added", d.id, wcfg.ID)
+	return nil
+}
+
+// removeWidget removes a widget from the running layout, after its exit transition if
+// one is configured. Must be called with mu held.
+func (d *DeviceInstance) removeWidget(widgetID string, transitions *config.TransitionConfig) {
+	transitionType, seconds, easing := toggleTransition(transitions, false)
+	if !d.layout.StartExitTransition(widgetID, transitionType, seconds, easing) {
+		if d.comp.RemoveWidget(widgetID) {
+			log.Printf("[%s] Widget %s removed", d.id, widgetID)
+		}
+		return
+	}
+
+	comp := d.comp
+	if d.pendingRemove == nil {
+		d.pendingRemove = make(map[string]*time.Timer)
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Duration(seconds*float64(time.Second)), func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		// Toggled back on, or the device was stopped in the meantime
+		if d.pendingRemove[widgetID] != timer {
+			return
+		}
+		delete(d.pendingRemove, widgetID)
+		if d.comp == comp && comp.RemoveWidget(widgetID) {
+			log.Printf("[%s] Widget %s removed", d.id, widgetID)
+		}
+	})
+	d.pendingRemove[widgetID] = timer
+}
+
+// Shutdown performs a full shutdown of the device.
+// exitDisplay selects the final display state (see config.ExitDisplay* constants).
+func (d *DeviceInstance) Shutdown(unregisterOnExit bool, exitDisplay string) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
@@ -120,27 +254,19 @@ func (d *DeviceInstance) Shutdown(unregisterOnExit bool) {
 		d.comp.Stop()
 		d.comp = nil
 	}
+	d.layout = nil
+	d.cancelPendingRemovals()
 
 	if d.client != nil {
-		// Return to device's native UI if supported
-		if uc, ok := d.client.(display.UIControl); ok {
+		// Return to device's native UI if supported, unless the final frame should stay
+		leaveFrame := exitDisplay == config.ExitDisplayKeep || exitDisplay == config.ExitDisplayLogo
+		if uc, ok := d.client.(display.UIControl); ok && !leaveFrame {
 			if err := uc.ReturnToUI(); err != nil {
 				log.Printf("[%s] Warning: Failed to return to UI: %v", d.id, err)
 			}
 		}
 
-		// Show exit message
-		w, h := d.displayWidth, d.displayHeight
-		if w == 0 {
-			w = config.DefaultDisplayWidth
-		}
-		if h == 0 {
-			h = config.DefaultDisplayHeight
-		}
-		splash := NewSplashRenderer(d.client, w, h)
-		if err := splash.ShowExitMessage(); err != nil {
-			log.Printf("[%s] Warning: Exit message failed: %v", d.id, err)
-		}
+		d.showExitDisplay(exitDisplay)
 
 		if unregisterOnExit {
 			log.Printf("[%s] Unregistering...", d.id)
@@ -154,6 +280,33 @@ func (d *DeviceInstance) Shutdown(unregisterOnExit bool) {
 	}
 }
 
+// showExitDisplay renders the final frame on shutdown. Caller must hold d.mu.
+func (d *DeviceInstance) showExitDisplay(exitDisplay string) {
+	w, h := d.displayWidth, d.displayHeight
+	if w == 0 {
+		w = config.DefaultDisplayWidth
+	}
+	if h == 0 {
+		h = config.DefaultDisplayHeight
+	}
+	splash := NewSplashRenderer(d.client, w, h)
+
+	var err error
+	switch exitDisplay {
+	case config.ExitDisplayKeep:
+		return
+	case config.ExitDisplayClear:
+		err = splash.Clear()
+	case config.ExitDisplayLogo:
+		err = splash.ShowLogo()
+	default:
+		err = splash.ShowExitMessage()
+	}
+	if err != nil {
+		log.Printf("[%s] Warning: Exit display failed: %v", d.id, err)
+	}
+}
+
 // ShowTransitionBanner displays a profile transition banner on this device
 func (d *DeviceInstance) ShowTransitionBanner(profileName string) {
 	d.mu.Lock()
@@ -193,6 +346,50 @@ func (d *DeviceInstance) GetWebClient() *webclient.Client {
 	return nil
 }
 
+// previewSource is implemented by backends that keep frames for the web editor
+// preview without being a webclient themselves (the null backend)
+type previewSource interface {
+	Preview() *webclient.Client
+}
+
+// GetPreviewClient returns the frame store feeding the web editor preview:
+// the webclient backend itself or the preview of a headless backend
+func (d *DeviceInstance) GetPreviewClient() *webclient.Client {
+	if wc := d.GetWebClient(); wc != nil {
+		return wc
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if ps, ok := d.client.(previewSource); ok {
+		return ps.Preview()
+	}
+	return nil
+}
+
+// PacerStats returns the frame pacing counters of the running compositor.
+// Returns false if the device has no compositor.
+func (d *DeviceInstance) PacerStats() (compositor.PacerStats, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.comp == nil {
+		return compositor.PacerStats{}, false
+	}
+	return d.comp.PacerStats(), true
+}
+
+// Widgets returns the widgets of the running layout, nil if the device is not started
+func (d *DeviceInstance) Widgets() []widget.Widget {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.layout == nil {
+		return nil
+	}
+	return d.layout.Widgets()
+}
+
 // GetCurrentBackend returns the name of this device's backend
 func (d *DeviceInstance) GetCurrentBackend() string {
 	d.mu.Lock()
@@ -200,8 +397,9 @@ func (d *DeviceInstance) GetCurrentBackend() string {
 	return d.currentBackend
 }
 
-// ensureClient ensures a valid backend client exists for this device
-func (d *DeviceInstance) ensureClient(cfg *config.Config) error {
+// ensureClient ensures a valid backend client exists for this device.
+// When retry is set, backend creation is retried per backend_retries.
+func (d *DeviceInstance) ensureClient(cfg *config.Config, retry bool) error {
 	needNewClient := d.client == nil
 
 	if d.client != nil {
@@ -225,7 +423,11 @@ func (d *DeviceInstance) ensureClient(cfg *config.Config) error {
 	// Create new client
 	var err error
 	var backendName string
-	d.client, backendName, err = CreateBackendClient(cfg)
+	if retry {
+		d.client, backendName, err = CreateBackendClientWithRetry(cfg, d.retryCancel)
+	} else {
+		d.client, backendName, err = CreateBackendClient(cfg)
+	}
 	if err != nil {
 		return err
 	}
@@ -256,10 +458,12 @@ func (d *DeviceInstance) bindEventWithRetry(maxAttempts int, deviceType string)
 }
 
 // handleBackendFailure attempts to switch to alternative backend
-func (d *DeviceInstance) handleBackendFailure(cfg *config.Config) {
+func (d *DeviceInstance) handleBackendFailure() {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
+	cfg := d.cfg
+
 	log.Println("========================================")
 	log.Printf("[%s] Backend failure detected (current: %s)", d.id, d.currentBackend)
 	log.Printf("[%s] Attempting to switch to alternative backend...", d.id)
@@ -268,6 +472,8 @@ func (d *DeviceInstance) handleBackendFailure(cfg *config.Config) {
 		d.comp.Stop()
 		d.comp = nil
 	}
+	d.layout = nil
+	d.cancelPendingRemovals()
 
 	newClient, newBackend, err := CreateBackendExcluding(cfg, d.currentBackend)
 	if err != nil {
@@ -293,9 +499,8 @@ func (d *DeviceInstance) handleBackendFailure(cfg *config.Config) {
 	}
 
 	d.comp = setup.Compositor
-	d.comp.OnBackendFailure = func() {
-		d.handleBackendFailure(cfg)
-	}
+	d.layout = setup.Layout
+	d.comp.OnBackendFailure = d.handleBackendFailure
 
 	if err := d.comp.Start(); err != nil {
 		log.Printf("[%s] ERROR: Failed to start compositor with new backend: %v", d.id, err)
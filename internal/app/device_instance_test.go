@@ -2,6 +2,9 @@ package app
 
 import (
 	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/backend/null"
+	"github.com/pozitronik/steelclock-go/internal/config"
 )
 
 func TestNewDeviceInstance(t *testing.T) {
@@ -50,6 +53,24 @@ func TestDeviceInstance_GetWebClient_Nil(t *testing.T) {
 	}
 }
 
+func TestDeviceInstance_GetPreviewClient(t *testing.T) {
+	d := NewDeviceInstance("test", make(chan struct{}))
+	if d.GetPreviewClient() != nil {
+		t.Error("GetPreviewClient() should be nil without a client")
+	}
+
+	// The null backend feeds the preview but is not a webclient (no browser auto-open)
+	headless := null.NewClient(128, 40, 30)
+	d.client = headless
+	d.currentBackend = "null"
+	if d.GetWebClient() != nil {
+		t.Error("GetWebClient() should be nil with the null backend")
+	}
+	if d.GetPreviewClient() != headless.Preview() {
+		t.Error("GetPreviewClient() should return the null backend preview")
+	}
+}
+
 func TestDeviceInstance_StopWithNilComponents(t *testing.T) {
 	d := NewDeviceInstance("test", make(chan struct{}))
 
@@ -61,8 +82,8 @@ func TestDeviceInstance_ShutdownWithNilComponents(t *testing.T) {
 	d := NewDeviceInstance("test", make(chan struct{}))
 
 	// Should not panic
-	d.Shutdown(false)
-	d.Shutdown(true)
+	d.Shutdown(false, "")
+	d.Shutdown(true, "")
 }
 
 func TestDeviceInstance_DoubleStop(t *testing.T) {
@@ -76,7 +97,7 @@ func TestDeviceInstance_StopThenShutdown(t *testing.T) {
 	d := NewDeviceInstance("test", make(chan struct{}))
 
 	d.Stop()
-	d.Shutdown(false)
+	d.Shutdown(false, "")
 }
 
 func TestDeviceInstance_ShowTransitionBanner_NilClient(t *testing.T) {
@@ -125,3 +146,94 @@ func TestDeviceInstance_ConcurrentAccessors(t *testing.T) {
 		<-done
 	}
 }
+
+// mockUIClient records ReturnToUI calls on top of the splash mock client
+type mockUIClient struct {
+	mockSplashClient
+	returnedToUI bool
+}
+
+func (m *mockUIClient) ReturnToUI() error {
+	m.returnedToUI = true
+	return nil
+}
+
+func TestDeviceInstance_ShutdownExitDisplay(t *testing.T) {
+	tests := []struct {
+		name         string
+		exitDisplay  string
+		wantFrames   int
+		wantReturnUI bool
+	}{
+		{"keep leaves last frame", config.ExitDisplayKeep, 0, false},
+		{"clear sends blank frame", config.ExitDisplayClear, 1, true},
+		{"logo sends logo frame", config.ExitDisplayLogo, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mockUIClient{}
+			d := NewDeviceInstance("test", make(chan struct{}))
+			d.client = client
+
+			d.Shutdown(false, tt.exitDisplay)
+
+			if client.framesSent != tt.wantFrames {
+				t.Errorf("framesSent = %d, want %d", client.framesSent, tt.wantFrames)
+			}
+			if client.returnedToUI != tt.wantReturnUI {
+				t.Errorf("returnedToUI = %v, want %v", client.returnedToUI, tt.wantReturnUI)
+			}
+			if d.client != nil {
+				t.Error("client should be released after shutdown")
+			}
+		})
+	}
+}
+
+func TestDeviceInstance_ToggleWidgetInPlace(t *testing.T) {
+	clockCfg := func(id string, enabled bool) config.WidgetConfig {
+		return config.WidgetConfig{
+			Type:     "clock",
+			ID:       id,
+			Enabled:  config.BoolPtr(enabled),
+			Position: config.PositionConfig{W: 64, H: 40},
+		}
+	}
+	cfg := &config.Config{
+		RefreshRateMs: 100,
+		Display:       config.DisplayConfig{Width: 128, Height: 40},
+		Widgets:       []config.WidgetConfig{clockCfg("clock_0", true), clockCfg("clock_1", true)},
+	}
+
+	d := NewDeviceInstance("test", make(chan struct{}))
+	d.client = &mockSplashClient{}
+	if err := d.Start(cfg, false); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer d.Stop()
+	comp, layoutMgr := d.comp, d.layout
+
+	off := *cfg
+	off.Widgets = []config.WidgetConfig{clockCfg("clock_0", true), clockCfg("clock_1", false)}
+	if running, err := d.ToggleWidget(&off, "clock_1", false); !running || err != nil {
+		t.Fatalf("ToggleWidget(off) = %v, %v; want true, nil", running, err)
+	}
+	if layoutMgr.RemoveWidget("clock_1") != nil {
+		t.Error("clock_1 should have been removed from the running layout")
+	}
+
+	on := *cfg
+	if running, err := d.ToggleWidget(&on, "clock_1", true); !running || err != nil {
+		t.Fatalf("ToggleWidget(on) = %v, %v; want true, nil", running, err)
+	}
+	if d.comp != comp || d.layout != layoutMgr {
+		t.Error("toggling should keep the running compositor and layout")
+	}
+	if d.cfg != &on {
+		t.Error("device config should follow the toggle for backend failover")
+	}
+	if layoutMgr.RemoveWidget("clock_1") == nil {
+		t.Error("clock_1 should have been added to the running layout")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/backend"
 	"github.com/pozitronik/steelclock-go/internal/backend/webclient"
@@ -11,6 +12,9 @@ import (
 	"github.com/pozitronik/steelclock-go/internal/compositor"
 	"github.com/pozitronik/steelclock-go/internal/config"
 	"github.com/pozitronik/steelclock-go/internal/display"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
+	"github.com/pozitronik/steelclock-go/internal/widget"
 )
 
 // ErrorDisplayRefreshRateMs is the refresh rate for error display (flash interval)
@@ -25,7 +29,9 @@ type LifecycleManager struct {
 	lastGoodConfig *config.Config
 	isFirstStart   bool
 	retryCancel    chan struct{}
-	widgetMgr      *WidgetManager // For error display only
+	cancelOnce     sync.Once
+	widgetMgr      *WidgetManager           // For error display only
+	pendingEnter   *widgetTransitionRequest // Enter transition for the next Start (widget toggled on)
 	mu             sync.Mutex
 }
 
@@ -43,6 +49,9 @@ func NewLifecycleManager() *LifecycleManager {
 // In multi-device mode (devices array), one DeviceInstance per device is created.
 // Returns the first error encountered; other devices may still start successfully.
 func (m *LifecycleManager) Start(cfg *config.Config) error {
+	// Wait outside the lock so Shutdown can cancel the delay
+	m.waitStartupDelay(cfg)
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -54,22 +63,26 @@ func (m *LifecycleManager) Start(cfg *config.Config) error {
 		log.Printf("Using custom bundled font URL: %s", *cfg.BundledFontURL)
 	}
 
+	// Select widget text language before widgets are created
+	if lang := i18n.SetLanguage(cfg.Language); lang != i18n.English {
+		log.Printf("Widget language: %s", lang)
+	}
+
 	// Stop any active error display
 	m.stopErrorDisplay()
 
 	// Get per-device configurations
 	deviceConfigs := cfg.GetDevices()
-	showSplash := m.isFirstStart
+	firstStart := m.isFirstStart
 	m.isFirstStart = false
 
 	var firstErr error
 	var startedDevices []*DeviceInstance
+	pendingEnter := m.pendingEnter
+	m.pendingEnter = nil
 
 	for _, devCfg := range deviceConfigs {
-		deviceID := devCfg.ID
-		if deviceID == "" {
-			deviceID = fmt.Sprintf("device_%d", len(startedDevices))
-		}
+		deviceID := deviceIDFor(devCfg, len(startedDevices))
 
 		// Build per-device config by merging global + device-specific settings
 		perDeviceCfg := cfg.ConfigForDevice(devCfg)
@@ -79,8 +92,9 @@ func (m *LifecycleManager) Start(cfg *config.Config) error {
 		if instance == nil {
 			instance = NewDeviceInstance(deviceID, m.retryCancel)
 		}
+		instance.pendingEnter = pendingEnter
 
-		if err := instance.Start(perDeviceCfg, showSplash); err != nil {
+		if err := instance.Start(perDeviceCfg, firstStart); err != nil {
 			log.Printf("[%s] ERROR: Failed to start device: %v", deviceID, err)
 			if firstErr == nil {
 				firstErr = err
@@ -104,6 +118,119 @@ func (m *LifecycleManager) Start(cfg *config.Config) error {
 	return nil
 }
 
+// waitStartupDelay pauses before the first start when startup_delay_ms is configured,
+// giving backends time to come up when the app is launched at login.
+// Later starts (config reloads, profile switches) are not delayed.
+func (m *LifecycleManager) waitStartupDelay(cfg *config.Config) {
+	m.mu.Lock()
+	firstStart := m.isFirstStart
+	m.mu.Unlock()
+
+	if !firstStart || cfg.StartupDelayMs <= 0 {
+		return
+	}
+
+	delay := time.Duration(cfg.StartupDelayMs) * time.Millisecond
+	log.Printf("Waiting %v before connecting to the display backend...", delay)
+
+	select {
+	case <-time.After(delay):
+	case <-m.retryCancel:
+		log.Println("Startup delay cancelled")
+	}
+}
+
+// SetBrightness applies display brightness to all running devices that support it.
+// Returns the number of devices the brightness was applied to.
+func (m *LifecycleManager) SetBrightness(level int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	applied := 0
+	var firstErr error
+	for _, d := range m.devices {
+		supported, err := d.SetBrightness(level)
+		if err != nil {
+			log.Printf("[%s] Warning: Failed to set brightness: %v", d.id, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if supported {
+			applied++
+		}
+	}
+	return applied, firstErr
+}
+
+// QueueWidgetEnter schedules the enter transition of a widget being toggled on.
+// It plays when the next Start creates the widget, before its first frame is shown.
+func (m *LifecycleManager) QueueWidgetEnter(widgetID string, transitions *config.TransitionConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if effect, _, _ := toggleTransition(transitions, true); effect == anim.TransitionNone {
+		m.pendingEnter = nil
+		return
+	}
+	m.pendingEnter = &widgetTransitionRequest{widgetID: widgetID, transitions: transitions}
+}
+
+// ToggleWidget applies a widget toggle to the running devices without restarting them.
+// cfg is the full config with the widget's new enabled state; it becomes the last good
+// config on success. Returns false when the running devices don't match cfg (or aren't
+// running), in which case nothing is changed and the caller should restart with cfg.
+func (m *LifecycleManager) ToggleWidget(cfg *config.Config, widgetID string, enabled bool) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deviceConfigs := cfg.GetDevices()
+	if len(m.devices) == 0 || len(deviceConfigs) != len(m.devices) {
+		return false, nil
+	}
+	for i, devCfg := range deviceConfigs {
+		if m.devices[i].id != deviceIDFor(devCfg, i) {
+			return false, nil
+		}
+	}
+
+	for i, devCfg := range deviceConfigs {
+		perDeviceCfg := cfg.ConfigForDevice(devCfg)
+		if !hasWidget(perDeviceCfg, widgetID) {
+			continue
+		}
+		running, err := m.devices[i].ToggleWidget(perDeviceCfg, widgetID, enabled)
+		if err != nil {
+			return true, err
+		}
+		if !running {
+			return false, nil
+		}
+	}
+
+	m.lastGoodConfig = cfg
+	return true, nil
+}
+
+// deviceIDFor returns the ID Start assigns to the device at the given index
+func deviceIDFor(devCfg config.DeviceConfig, index int) string {
+	if devCfg.ID != "" {
+		return devCfg.ID
+	}
+	return fmt.Sprintf("device_%d", index)
+}
+
+// hasWidget reports whether cfg contains a widget with the given ID
+func hasWidget(cfg *config.Config, widgetID string) bool {
+	for _, w := range cfg.Widgets {
+		if w.ID == widgetID {
+			return true
+		}
+	}
+	return false
+}
+
 // Stop stops all device compositors but keeps clients for reuse
 func (m *LifecycleManager) Stop() {
 	m.mu.Lock()
@@ -118,23 +245,22 @@ func (m *LifecycleManager) Stop() {
 
 // Shutdown performs a full shutdown including client cleanup for all devices
 func (m *LifecycleManager) Shutdown() {
+	// Cancel any ongoing retry before taking the lock: Start holds it while retrying
+	m.cancelOnce.Do(func() { close(m.retryCancel) })
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Cancel any ongoing retry
-	select {
-	case <-m.retryCancel:
-		// Already closed
-	default:
-		close(m.retryCancel)
-	}
-
 	m.stopErrorDisplay()
 
 	shouldUnregister := m.lastGoodConfig != nil && m.lastGoodConfig.UnregisterOnExit
+	exitDisplay := ""
+	if m.lastGoodConfig != nil {
+		exitDisplay = m.lastGoodConfig.OnExitDisplay
+	}
 
 	for _, dev := range m.devices {
-		dev.Shutdown(shouldUnregister)
+		dev.Shutdown(shouldUnregister, exitDisplay)
 	}
 	m.devices = nil
 
@@ -243,7 +369,8 @@ func (m *LifecycleManager) GetWebClient() *webclient.Client {
 	return nil
 }
 
-// GetWebClients returns all webclient backends keyed by device ID.
+// GetWebClients returns the preview frame stores of all devices on the
+// webclient or null backend, keyed by device ID.
 // Used for multi-device preview in the web editor.
 func (m *LifecycleManager) GetWebClients() map[string]*webclient.Client {
 	m.mu.Lock()
@@ -251,13 +378,39 @@ func (m *LifecycleManager) GetWebClients() map[string]*webclient.Client {
 
 	clients := make(map[string]*webclient.Client)
 	for _, dev := range m.devices {
-		if wc := dev.GetWebClient(); wc != nil {
+		if wc := dev.GetPreviewClient(); wc != nil {
 			clients[dev.id] = wc
 		}
 	}
 	return clients
 }
 
+// GetPacerStats returns frame pacing counters keyed by device ID
+func (m *LifecycleManager) GetPacerStats() map[string]compositor.PacerStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := make(map[string]compositor.PacerStats)
+	for _, dev := range m.devices {
+		if s, ok := dev.PacerStats(); ok {
+			stats[dev.id] = s
+		}
+	}
+	return stats
+}
+
+// GetWidgets returns the running widgets of all devices in device order
+func (m *LifecycleManager) GetWidgets() []widget.Widget {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var widgets []widget.Widget
+	for _, dev := range m.devices {
+		widgets = append(widgets, dev.Widgets()...)
+	}
+	return widgets
+}
+
 // GetCurrentBackend returns the name of the first device's backend
 func (m *LifecycleManager) GetCurrentBackend() string {
 	m.mu.Lock()
@@ -328,7 +481,7 @@ func (m *LifecycleManager) shutdownOldDevices(newDevices []*DeviceInstance) {
 
 	for _, dev := range m.devices {
 		if !newSet[dev] {
-			dev.Shutdown(false) // Don't unregister on config reload
+			dev.Shutdown(false, config.ExitDisplayGoodbye) // Don't unregister on config reload
 		}
 	}
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
 )
@@ -371,3 +372,143 @@ func TestShowTransitionBannerWithConfig(t *testing.T) {
 	// Should not panic, even without client
 	lm.ShowTransitionBanner("TestProfile")
 }
+
+// mockBrightnessClient adds brightness control to the splash mock client
+type mockBrightnessClient struct {
+	mockSplashClient
+	level int
+}
+
+func (m *mockBrightnessClient) SetBrightness(level int) error {
+	m.level = level
+	return nil
+}
+
+func TestLifecycleManagerSetBrightness(t *testing.T) {
+	m := NewLifecycleManager()
+
+	withBrightness := &mockBrightnessClient{}
+	d1 := NewDeviceInstance("main", m.retryCancel)
+	d1.client = withBrightness
+	d2 := NewDeviceInstance("second", m.retryCancel)
+	d2.client = &mockSplashClient{}
+	m.devices = []*DeviceInstance{d1, d2}
+
+	applied, err := m.SetBrightness(4)
+	if err != nil {
+		t.Fatalf("SetBrightness() error: %v", err)
+	}
+	if applied != 1 {
+		t.Errorf("applied = %d, want 1", applied)
+	}
+	if withBrightness.level != 4 {
+		t.Errorf("brightness = %d, want 4", withBrightness.level)
+	}
+}
+
+func TestLifecycleManagerSetBrightnessNoDevices(t *testing.T) {
+	m := NewLifecycleManager()
+
+	applied, err := m.SetBrightness(4)
+	if err != nil || applied != 0 {
+		t.Errorf("SetBrightness() = (%d, %v), want (0, nil)", applied, err)
+	}
+}
+
+func TestWaitStartupDelay(t *testing.T) {
+	t.Run("no delay configured", func(t *testing.T) {
+		lm := NewLifecycleManager()
+		start := time.Now()
+		lm.waitStartupDelay(&config.Config{})
+		if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+			t.Errorf("waited %v without a configured delay", elapsed)
+		}
+	})
+
+	t.Run("first start waits", func(t *testing.T) {
+		lm := NewLifecycleManager()
+		start := time.Now()
+		lm.waitStartupDelay(&config.Config{StartupDelayMs: 30})
+		if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+			t.Errorf("waited %v, want at least 30ms", elapsed)
+		}
+	})
+
+	t.Run("later starts do not wait", func(t *testing.T) {
+		lm := NewLifecycleManager()
+		lm.isFirstStart = false
+		start := time.Now()
+		lm.waitStartupDelay(&config.Config{StartupDelayMs: 10000})
+		if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+			t.Errorf("waited %v on a later start", elapsed)
+		}
+	})
+
+	t.Run("shutdown cancels the delay", func(t *testing.T) {
+		lm := NewLifecycleManager()
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			lm.Shutdown()
+		}()
+
+		start := time.Now()
+		lm.waitStartupDelay(&config.Config{StartupDelayMs: 10000})
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("delay not cancelled by shutdown, waited %v", elapsed)
+		}
+	})
+}
+
+func retryingConfig() *config.Config {
+	return &config.Config{
+		GameName:        "TEST",
+		GameDisplayName: "Test",
+		Backend:         "invalid_backend",
+		BackendRetries:  config.IntPtr(config.MaxBackendRetries),
+		Display: config.DisplayConfig{
+			Width:  128,
+			Height: 40,
+		},
+	}
+}
+
+func TestLifecycleManagerShutdownCancelsStartRetries(t *testing.T) {
+	lm := NewLifecycleManager()
+
+	started := make(chan error, 1)
+	go func() { started <- lm.Start(retryingConfig()) }()
+
+	// Let Start enter the backoff wait while holding the lock
+	time.Sleep(100 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		lm.Shutdown()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("Shutdown blocked while Start was retrying")
+	}
+
+	if err := <-started; err == nil {
+		t.Error("expected Start to fail with an unavailable backend")
+	}
+}
+
+func TestLifecycleManagerRetriesOnlyOnFirstStart(t *testing.T) {
+	lm := NewLifecycleManager()
+	lm.isFirstStart = false
+
+	start := time.Now()
+	if err := lm.Start(retryingConfig()); err == nil {
+		t.Fatal("expected Start to fail with an unavailable backend")
+	}
+
+	// A single attempt fails immediately; retrying would wait at least one backoff step
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("later Start took %v, want no backend retries", elapsed)
+	}
+}
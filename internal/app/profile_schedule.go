@@ -0,0 +1,185 @@
+package app
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/util"
+)
+
+// ProfileSchedulePollInterval is how often the profile schedule is evaluated
+const ProfileSchedulePollInterval = 30 * time.Second
+
+// scheduleWindow is one occurrence of a schedule rule's time window
+type scheduleWindow struct {
+	rule       int // Index of the rule in the schedule
+	start, end time.Time
+}
+
+// scheduleTimeOn resolves a schedule time on the calendar day of day.
+// Returns false for sunrise and sunset without a location or on days the sun does not rise or set.
+func scheduleTimeOn(spec string, day time.Time, loc *config.GeoLocationConfig) (time.Time, bool) {
+	if config.IsSunScheduleTime(spec) {
+		if loc == nil {
+			return time.Time{}, false
+		}
+		sunrise, sunset, ok := util.SunTimes(day, loc.Lat, loc.Lon)
+		if spec == config.ScheduleSunrise {
+			return sunrise, ok
+		}
+		return sunset, ok
+	}
+
+	minutes, err := config.ParseClockTime(spec)
+	if err != nil {
+		return time.Time{}, false
+	}
+	y, m, d := day.Date()
+	return time.Date(y, m, d, minutes/60, minutes%60, 0, 0, day.Location()), true
+}
+
+// ruleWindowAt returns the window of rule containing now. Windows start on the rule's
+// days; a window ending at or before its start ends on the next day, and a window
+// without an end lasts 24 hours.
+func ruleWindowAt(rule config.ScheduleRule, loc *config.GeoLocationConfig, now time.Time) (start, end time.Time, ok bool) {
+	days, err := config.ParseScheduleDays(rule.Days)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	y, m, d := now.Date()
+	// A window started yesterday may still be running
+	for offset := 0; offset >= -1; offset-- {
+		day := time.Date(y, m, d+offset, 12, 0, 0, 0, now.Location())
+		if !days[day.Weekday()] {
+			continue
+		}
+		start, ok := scheduleTimeOn(rule.Start, day, loc)
+		if !ok || start.After(now) {
+			continue
+		}
+
+		end := start.AddDate(0, 0, 1)
+		if rule.End != "" {
+			if end, ok = scheduleTimeOn(rule.End, day, loc); !ok {
+				continue
+			}
+			if !end.After(start) {
+				if end, ok = scheduleTimeOn(rule.End, day.AddDate(0, 0, 1), loc); !ok {
+					continue
+				}
+			}
+		}
+
+		if now.Before(end) {
+			return start, end, true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// activeScheduleWindow returns the window in effect at now. Among overlapping windows
+// the shortest wins, then the one started last, then the first rule in the list.
+func activeScheduleWindow(rules []config.ScheduleRule, loc *config.GeoLocationConfig, now time.Time) (scheduleWindow, bool) {
+	var best scheduleWindow
+	found := false
+	for i, rule := range rules {
+		start, end, ok := ruleWindowAt(rule, loc, now)
+		if !ok {
+			continue
+		}
+		w := scheduleWindow{rule: i, start: start, end: end}
+		if !found || isMoreSpecific(w, best) {
+			best, found = w, true
+		}
+	}
+	return best, found
+}
+
+// isMoreSpecific reports whether window a takes precedence over b
+func isMoreSpecific(a, b scheduleWindow) bool {
+	la, lb := a.end.Sub(a.start), b.end.Sub(b.start)
+	if la != lb {
+		return la < lb
+	}
+	return a.start.After(b.start)
+}
+
+// profileSchedulePoller switches to a rule's profile when the rule's window begins.
+// Between window starts the active profile is left alone, so a manual switch
+// stays until the next window begins.
+type profileSchedulePoller struct {
+	schedule func() ([]config.ScheduleRule, *config.GeoLocationConfig)
+	resolve  func(profile string) (string, error) // Profile reference to config path
+	active   func() string                        // Path of the active profile
+	switchTo func(path string) error
+	now      func() time.Time
+
+	current *scheduleWindow // Window in effect on the last poll, nil if none
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// newProfileSchedulePoller creates a poller for the application's profile manager
+func (a *App) newProfileSchedulePoller() *profileSchedulePoller {
+	pm := a.configMgr.GetProfileManager()
+	return &profileSchedulePoller{
+		schedule: pm.GetSchedule,
+		resolve: func(profile string) (string, error) {
+			return resolveProfilePath(pm.GetProfiles(), profile)
+		},
+		active:   a.configMgr.GetConfigPath,
+		switchTo: a.switchProfileAndUpdateTray,
+		now:      time.Now,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start evaluates the schedule now and then every interval until Stop is called
+func (p *profileSchedulePoller) Start(interval time.Duration) {
+	go func() {
+		p.poll()
+		pollEvery(interval, p.stopCh, p.poll)
+	}()
+}
+
+// Stop ends polling. It is safe to call more than once.
+func (p *profileSchedulePoller) Stop() {
+	p.stopOnce.Do(func() { close(p.stopCh) })
+}
+
+// poll switches profiles when a different schedule window comes into effect
+func (p *profileSchedulePoller) poll() {
+	rules, loc := p.schedule()
+	if len(rules) == 0 {
+		p.current = nil
+		return
+	}
+
+	w, ok := activeScheduleWindow(rules, loc, p.now())
+	if !ok {
+		p.current = nil
+		return
+	}
+	if p.current != nil && p.current.rule == w.rule && p.current.start.Equal(w.start) {
+		return
+	}
+	p.current = &w
+
+	path, err := p.resolve(rules[w.rule].Profile)
+	if err != nil {
+		log.Printf("Profile schedule: %v", err)
+		return
+	}
+	if path == p.active() {
+		return
+	}
+
+	log.Printf("Profile schedule: switching to %s", path)
+	if err := p.switchTo(path); err != nil {
+		log.Printf("Profile schedule: failed to switch profile: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// at returns 2024-06-19 (a Wednesday) plus days at hh:mm UTC
+func at(days, hh, mm int) time.Time {
+	return time.Date(2024, 6, 19+days, hh, mm, 0, 0, time.UTC)
+}
+
+func TestActiveScheduleWindow(t *testing.T) {
+	rules := []config.ScheduleRule{
+		{Profile: "day", Start: "07:00"},
+		{Profile: "night", Start: "19:00"},
+		{Profile: "meeting", Start: "10:00", End: "11:00", Days: []string{"weekdays"}},
+		{Profile: "late", Start: "23:00", End: "01:00", Days: []string{"wed"}},
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want string
+	}{
+		{"morning", at(0, 8, 0), "day"},
+		{"evening", at(0, 20, 0), "night"},
+		{"night after midnight", at(1, 3, 0), "night"},
+		{"shortest window wins", at(0, 10, 30), "meeting"},
+		{"weekday filter", at(3, 10, 30), "day"}, // Saturday
+		{"window across midnight", at(1, 0, 30), "late"},
+		{"day filter on window start", at(1, 23, 30), "night"}, // Thursday
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, ok := activeScheduleWindow(rules, nil, tt.now)
+			if !ok {
+				t.Fatal("no active window")
+			}
+			if got := rules[w.rule].Profile; got != tt.want {
+				t.Errorf("active profile = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActiveScheduleWindow_Sun(t *testing.T) {
+	rules := []config.ScheduleRule{
+		{Profile: "day", Start: "sunrise", End: "sunset"},
+		{Profile: "night", Start: "sunset", End: "sunrise"},
+	}
+	london := &config.GeoLocationConfig{Lat: 51.5074, Lon: -0.1278}
+
+	// Summer solstice in London: sunrise about 03:43 UTC, sunset about 20:21 UTC
+	for _, tt := range []struct {
+		now  time.Time
+		want string
+	}{
+		{time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC), "day"},
+		{time.Date(2024, 6, 21, 22, 0, 0, 0, time.UTC), "night"},
+		{time.Date(2024, 6, 21, 3, 0, 0, 0, time.UTC), "night"},
+	} {
+		w, ok := activeScheduleWindow(rules, london, tt.now)
+		if !ok || rules[w.rule].Profile != tt.want {
+			t.Errorf("at %s: window %+v (ok %v), want %s", tt.now.Format("15:04"), w, ok, tt.want)
+		}
+	}
+
+	if _, ok := activeScheduleWindow(rules, nil, time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC)); ok {
+		t.Error("sun times without a location should not match")
+	}
+}
+
+func TestProfileSchedulePoller(t *testing.T) {
+	rules := []config.ScheduleRule{
+		{Profile: "day", Start: "07:00"},
+		{Profile: "night", Start: "19:00"},
+	}
+	now := at(0, 8, 0)
+	active := "night.json"
+	var switches []string
+
+	p := &profileSchedulePoller{
+		schedule: func() ([]config.ScheduleRule, *config.GeoLocationConfig) { return rules, nil },
+		resolve:  func(profile string) (string, error) { return profile + ".json", nil },
+		active:   func() string { return active },
+		switchTo: func(path string) error {
+			switches = append(switches, path)
+			active = path
+			return nil
+		},
+		now:    func() time.Time { return now },
+		stopCh: make(chan struct{}),
+	}
+
+	// The window in effect at startup applies
+	p.poll()
+	if active != "day.json" {
+		t.Fatalf("active = %s, want day.json", active)
+	}
+
+	// A manual switch stays until the next window begins
+	active = "work.json"
+	now = at(0, 12, 0)
+	p.poll()
+	if active != "work.json" {
+		t.Fatalf("active = %s, want the manual work.json kept", active)
+	}
+
+	now = at(0, 19, 0)
+	p.poll()
+	if active != "night.json" || len(switches) != 2 {
+		t.Errorf("active = %s after %v, want a switch to night.json", active, switches)
+	}
+}
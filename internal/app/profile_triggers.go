@@ -0,0 +1,178 @@
+package app
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/shirou/gopsutil/v4/process"
+)
+
+// ProfileTriggerPollInterval is how often running applications are checked against profile triggers
+const ProfileTriggerPollInterval = 2 * time.Second
+
+// runningApps is a snapshot of running process names and visible window titles
+type runningApps struct {
+	processes    []string
+	windowTitles []string
+}
+
+// listRunningApps returns the names of running processes and the titles of visible windows.
+// Processes whose name cannot be read are skipped.
+func listRunningApps() runningApps {
+	var apps runningApps
+	if procs, err := process.Processes(); err == nil {
+		for _, p := range procs {
+			if name, err := p.Name(); err == nil && name != "" {
+				apps.processes = append(apps.processes, name)
+			}
+		}
+	}
+	apps.windowTitles = listWindowTitles()
+	return apps
+}
+
+// triggerMatches reports whether the trigger's conditions hold for the running applications
+func triggerMatches(t config.ProfileTrigger, apps runningApps) bool {
+	if t.Process == "" && t.WindowTitle == "" {
+		return false
+	}
+	if t.Process != "" && !hasProcess(apps.processes, t.Process) {
+		return false
+	}
+	if t.WindowTitle != "" && !hasWindowTitle(apps.windowTitles, t.WindowTitle) {
+		return false
+	}
+	return true
+}
+
+// hasProcess reports whether a process is named name, ignoring case and an ".exe" suffix
+func hasProcess(processes []string, name string) bool {
+	name = strings.TrimSuffix(strings.ToLower(name), ".exe")
+	for _, p := range processes {
+		if strings.TrimSuffix(strings.ToLower(p), ".exe") == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasWindowTitle reports whether a window title contains substr, ignoring case
+func hasWindowTitle(titles []string, substr string) bool {
+	substr = strings.ToLower(substr)
+	for _, t := range titles {
+		if strings.Contains(strings.ToLower(t), substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// profileTriggerPoller switches profiles when the first matching trigger changes.
+// While a trigger matches, its profile is active; once no trigger matches, the profile
+// that was active before is restored. A switch only happens when the matching trigger
+// changes, so a profile the user picks manually stays until the next change.
+type profileTriggerPoller struct {
+	triggers func() []config.ProfileTrigger
+	resolve  func(profile string) (string, error) // Profile reference to config path
+	active   func() string                        // Path of the active profile
+	switchTo func(path string) error
+	probe    func() runningApps
+
+	matched  string // Profile path of the trigger that matched on the last poll, "" if none
+	basePath string // Profile to restore once no trigger matches
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// newProfileTriggerPoller creates a poller for the application's profile manager
+func (a *App) newProfileTriggerPoller() *profileTriggerPoller {
+	pm := a.configMgr.GetProfileManager()
+	return &profileTriggerPoller{
+		triggers: pm.GetTriggers,
+		resolve: func(profile string) (string, error) {
+			return resolveProfilePath(pm.GetProfiles(), profile)
+		},
+		active:   a.configMgr.GetConfigPath,
+		switchTo: a.switchProfileAndUpdateTray,
+		probe:    listRunningApps,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start polls running applications every interval until Stop is called
+func (p *profileTriggerPoller) Start(interval time.Duration) {
+	go pollEvery(interval, p.stopCh, p.poll)
+}
+
+// Stop ends polling. It is safe to call more than once.
+func (p *profileTriggerPoller) Stop() {
+	p.stopOnce.Do(func() { close(p.stopCh) })
+}
+
+// pollEvery calls poll every interval until stop is closed
+func pollEvery(interval time.Duration, stop <-chan struct{}, poll func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			poll()
+		}
+	}
+}
+
+// poll evaluates the triggers in order and switches profiles when the first match changes
+func (p *profileTriggerPoller) poll() {
+	triggers := p.triggers()
+	if len(triggers) == 0 && p.matched == "" {
+		return
+	}
+
+	apps := p.probe()
+	matched := ""
+	for _, t := range triggers {
+		if !triggerMatches(t, apps) {
+			continue
+		}
+		path, err := p.resolve(t.Profile)
+		if err != nil {
+			log.Printf("Profile trigger: %v", err)
+			continue
+		}
+		matched = path
+		break
+	}
+
+	if matched == p.matched {
+		return
+	}
+
+	active := p.active()
+	target := matched
+	if matched == "" {
+		// Restore the previous profile, unless the user switched away from the triggered one
+		if active != p.matched {
+			p.matched = ""
+			return
+		}
+		target = p.basePath
+	} else if p.matched == "" {
+		p.basePath = active
+	}
+	p.matched = matched
+
+	if target == "" || target == active {
+		return
+	}
+
+	log.Printf("Profile trigger: switching to %s", target)
+	if err := p.switchTo(target); err != nil {
+		log.Printf("Profile trigger: failed to switch profile: %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestTriggerMatches(t *testing.T) {
+	apps := runningApps{
+		processes:    []string{"explorer.exe", "Game.exe"},
+		windowTitles: []string{"Inbox - Mail", "My Game - Level 3"},
+	}
+
+	tests := []struct {
+		name    string
+		trigger config.ProfileTrigger
+		want    bool
+	}{
+		{"process with exe", config.ProfileTrigger{Process: "game.exe"}, true},
+		{"process without exe", config.ProfileTrigger{Process: "GAME"}, true},
+		{"process partial name", config.ProfileTrigger{Process: "gam"}, false},
+		{"window title substring", config.ProfileTrigger{WindowTitle: "level 3"}, true},
+		{"window title missing", config.ProfileTrigger{WindowTitle: "Editor"}, false},
+		{"both match", config.ProfileTrigger{Process: "game", WindowTitle: "my game"}, true},
+		{"only one matches", config.ProfileTrigger{Process: "game", WindowTitle: "Editor"}, false},
+		{"no condition", config.ProfileTrigger{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := triggerMatches(tt.trigger, apps); got != tt.want {
+				t.Errorf("triggerMatches(%+v) = %v, want %v", tt.trigger, got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestTriggerPoller creates a poller over fake profiles whose switches only
+// update the active path
+func newTestTriggerPoller(triggers []config.ProfileTrigger, active *string, apps *runningApps) (*profileTriggerPoller, *[]string) {
+	var switches []string
+	p := &profileTriggerPoller{
+		triggers: func() []config.ProfileTrigger { return triggers },
+		resolve: func(profile string) (string, error) {
+			if profile == "missing" {
+				return "", fmt.Errorf("profile '%s' not found", profile)
+			}
+			return profile + ".json", nil
+		},
+		active: func() string { return *active },
+		switchTo: func(path string) error {
+			switches = append(switches, path)
+			*active = path
+			return nil
+		},
+		probe:  func() runningApps { return *apps },
+		stopCh: make(chan struct{}),
+	}
+	return p, &switches
+}
+
+func TestProfileTriggerPoller_SwitchAndRevert(t *testing.T) {
+	active := "main.json"
+	apps := runningApps{}
+	p, switches := newTestTriggerPoller([]config.ProfileTrigger{
+		{Profile: "missing", Process: "game"},
+		{Profile: "gaming", Process: "game"},
+		{Profile: "video", Process: "player"},
+	}, &active, &apps)
+
+	p.poll()
+	if len(*switches) != 0 {
+		t.Fatalf("switched without a running trigger app: %v", *switches)
+	}
+
+	// Both gaming and video match; the first resolvable trigger in the list wins
+	apps.processes = []string{"game.exe", "player.exe"}
+	p.poll()
+	p.poll()
+	if active != "gaming.json" || len(*switches) != 1 {
+		t.Fatalf("active = %s after %v, want a single switch to gaming.json", active, *switches)
+	}
+
+	// The next trigger takes over when the first one stops matching
+	apps.processes = []string{"player.exe"}
+	p.poll()
+	if active != "video.json" {
+		t.Fatalf("active = %s, want video.json", active)
+	}
+
+	// No trigger matches: the profile from before the first switch is restored
+	apps.processes = nil
+	p.poll()
+	if active != "main.json" {
+		t.Errorf("active = %s, want main.json restored", active)
+	}
+}
+
+func TestProfileTriggerPoller_KeepsManualSwitch(t *testing.T) {
+	active := "main.json"
+	apps := runningApps{processes: []string{"game.exe"}}
+	p, switches := newTestTriggerPoller([]config.ProfileTrigger{{Profile: "gaming", Process: "game"}}, &active, &apps)
+
+	p.poll()
+	if active != "gaming.json" {
+		t.Fatalf("active = %s, want gaming.json", active)
+	}
+
+	// The user picks another profile while the game runs; it is kept after the game exits
+	active = "work.json"
+	p.poll()
+	apps.processes = nil
+	p.poll()
+
+	if active != "work.json" || len(*switches) != 1 {
+		t.Errorf("active = %s after %v, want the manual work.json kept", active, *switches)
+	}
+}
@@ -123,6 +123,22 @@ func (s *SplashRenderer) ShowExitMessage() error {
 	return s.sendFrame(blank)
 }
 
+// ShowLogo displays the static SteelClock logo (final startup animation frame)
+func (s *SplashRenderer) ShowLogo() error {
+	if s.client == nil {
+		return nil
+	}
+	return s.sendFrame(s.renderStartupFrame(1.0))
+}
+
+// Clear sends a blank frame to the display
+func (s *SplashRenderer) Clear() error {
+	if s.client == nil {
+		return nil
+	}
+	return s.sendFrame(image.NewGray(image.Rect(0, 0, s.width, s.height)))
+}
+
 // ShowWebClientModeMessage displays "WEB CLIENT" on the hardware display
 // This is shown before switching to webclient backend so user knows display is paused
 func (s *SplashRenderer) ShowWebClientModeMessage() error {
@@ -73,6 +73,52 @@ func TestSplashRenderer_NilClient(t *testing.T) {
 	if err := splash.ShowExitMessage(); err != nil {
 		t.Errorf("ShowExitMessage with nil client returned error: %v", err)
 	}
+	if err := splash.ShowLogo(); err != nil {
+		t.Errorf("ShowLogo with nil client returned error: %v", err)
+	}
+	if err := splash.Clear(); err != nil {
+		t.Errorf("Clear with nil client returned error: %v", err)
+	}
+}
+
+func TestSplashRenderer_ShowLogo(t *testing.T) {
+	client := &mockSplashClient{}
+	splash := NewSplashRenderer(client, 128, 40)
+
+	if err := splash.ShowLogo(); err != nil {
+		t.Fatalf("ShowLogo returned error: %v", err)
+	}
+	if client.framesSent != 1 {
+		t.Errorf("framesSent = %d, want 1", client.framesSent)
+	}
+	if !hasLitPixel(client.lastFrameData) {
+		t.Error("logo frame should not be blank")
+	}
+}
+
+func TestSplashRenderer_Clear(t *testing.T) {
+	client := &mockSplashClient{}
+	splash := NewSplashRenderer(client, 128, 40)
+
+	if err := splash.Clear(); err != nil {
+		t.Fatalf("Clear returned error: %v", err)
+	}
+	if client.framesSent != 1 {
+		t.Errorf("framesSent = %d, want 1", client.framesSent)
+	}
+	if hasLitPixel(client.lastFrameData) {
+		t.Error("cleared frame should be blank")
+	}
+}
+
+// hasLitPixel reports whether packed frame data contains any set bit
+func hasLitPixel(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return true
+		}
+	}
+	return false
 }
 
 func TestSplashRenderer_RenderStartupFrame(t *testing.T) {
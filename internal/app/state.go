@@ -0,0 +1,175 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// RuntimeStateFile stores runtime overrides (widget toggles, brightness).
+// Kept separate from config files so shareable profiles stay clean.
+const RuntimeStateFile = ".steelclock.runtime"
+
+// runtimeState is the on-disk format of the runtime state file
+type runtimeState struct {
+	Profiles map[string]*profileState `json:"profiles,omitempty"` // Keyed by config file path
+}
+
+// profileState holds runtime overrides for a single config file
+type profileState struct {
+	Widgets    map[string]bool `json:"widgets,omitempty"`    // Widget ID -> enabled
+	Brightness *int            `json:"brightness,omitempty"` // Display brightness 0-10
+}
+
+// StateManager persists runtime overrides across restarts
+type StateManager struct {
+	path  string
+	state runtimeState
+	mu    sync.Mutex
+}
+
+// NewStateManager creates a state manager backed by the given file.
+// A missing or unreadable file starts with empty state.
+func NewStateManager(path string) *StateManager {
+	s := &StateManager{path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s
+	}
+	if err := json.Unmarshal(data, &s.state); err != nil {
+		log.Printf("Warning: Ignoring invalid runtime state file %s: %v", path, err)
+		s.state = runtimeState{}
+	}
+	return s
+}
+
+// SetWidgetEnabled records the enabled state of a widget for the given config and saves it
+func (s *StateManager) SetWidgetEnabled(configPath, widgetID string, enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps := s.profile(configPath)
+	if ps.Widgets == nil {
+		ps.Widgets = make(map[string]bool)
+	}
+	ps.Widgets[widgetID] = enabled
+	return s.save()
+}
+
+// SetBrightness records the display brightness for the given config and saves it
+func (s *StateManager) SetBrightness(configPath string, level int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.profile(configPath).Brightness = &level
+	return s.save()
+}
+
+// Apply returns a copy of cfg with runtime overrides for configPath applied.
+// The original config is not modified; cfg is returned as-is when there are no overrides.
+func (s *StateManager) Apply(configPath string, cfg *config.Config) *config.Config {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps := s.state.Profiles[stateKey(configPath)]
+	if ps == nil || cfg == nil {
+		return cfg
+	}
+
+	out := *cfg
+	if len(ps.Widgets) > 0 {
+		out.Widgets = applyWidgetOverrides(out.Widgets, ps.Widgets)
+		if len(out.Devices) > 0 {
+			devices := make([]config.DeviceConfig, len(out.Devices))
+			copy(devices, out.Devices)
+			for i := range devices {
+				devices[i].Widgets = applyWidgetOverrides(devices[i].Widgets, ps.Widgets)
+			}
+			out.Devices = devices
+		}
+	}
+
+	if ps.Brightness != nil {
+		out.DirectDriver = withBrightness(out.DirectDriver, *ps.Brightness)
+		if len(out.Devices) > 0 {
+			devices := make([]config.DeviceConfig, len(out.Devices))
+			copy(devices, out.Devices)
+			for i := range devices {
+				if devices[i].DirectDriver != nil {
+					devices[i].DirectDriver = withBrightness(devices[i].DirectDriver, *ps.Brightness)
+				}
+			}
+			out.Devices = devices
+		}
+	}
+
+	return &out
+}
+
+// profile returns (creating if needed) the state entry for a config. Caller must hold mu.
+func (s *StateManager) profile(configPath string) *profileState {
+	if s.state.Profiles == nil {
+		s.state.Profiles = make(map[string]*profileState)
+	}
+	key := stateKey(configPath)
+	ps := s.state.Profiles[key]
+	if ps == nil {
+		ps = &profileState{}
+		s.state.Profiles[key] = ps
+	}
+	return ps
+}
+
+// save writes the state file atomically. Caller must hold mu.
+func (s *StateManager) save() error {
+	data, err := json.MarshalIndent(s.state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode runtime state: %w", err)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write runtime state: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write runtime state: %w", err)
+	}
+	return nil
+}
+
+// stateKey normalizes a config path for use as a state key
+func stateKey(configPath string) string {
+	return filepath.ToSlash(filepath.Clean(configPath))
+}
+
+// applyWidgetOverrides returns a copy of widgets with enabled overrides applied
+func applyWidgetOverrides(widgets []config.WidgetConfig, overrides map[string]bool) []config.WidgetConfig {
+	if len(widgets) == 0 {
+		return widgets
+	}
+	out := make([]config.WidgetConfig, len(widgets))
+	copy(out, widgets)
+	for i := range out {
+		if enabled, ok := overrides[out[i].ID]; ok {
+			out[i].Enabled = &enabled
+		}
+	}
+	return out
+}
+
+// withBrightness returns a copy of the direct driver config with brightness set
+func withBrightness(dd *config.DirectDriverConfig, level int) *config.DirectDriverConfig {
+	out := config.DirectDriverConfig{}
+	if dd != nil {
+		out = *dd
+	}
+	out.Brightness = &level
+	return &out
+}
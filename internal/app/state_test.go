@@ -0,0 +1,114 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestNewStateManager_MissingFile(t *testing.T) {
+	s := NewStateManager(filepath.Join(t.TempDir(), RuntimeStateFile))
+
+	cfg := &config.Config{Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}}}
+	if got := s.Apply("config.json", cfg); got != cfg {
+		t.Error("Apply() without state should return the original config")
+	}
+}
+
+func TestNewStateManager_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), RuntimeStateFile)
+	if err := os.WriteFile(path, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewStateManager(path)
+	if len(s.state.Profiles) != 0 {
+		t.Errorf("expected empty state, got %+v", s.state)
+	}
+}
+
+func TestStateManager_PersistsAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), RuntimeStateFile)
+
+	s := NewStateManager(path)
+	if err := s.SetWidgetEnabled("profiles/work.json", "clock_0", false); err != nil {
+		t.Fatalf("SetWidgetEnabled() error: %v", err)
+	}
+	if err := s.SetBrightness("profiles/work.json", 2); err != nil {
+		t.Fatalf("SetBrightness() error: %v", err)
+	}
+
+	restored := NewStateManager(path)
+	cfg := &config.Config{Widgets: []config.WidgetConfig{
+		{ID: "clock_0", Type: "clock"},
+		{ID: "cpu_0", Type: "cpu"},
+	}}
+
+	got := restored.Apply("profiles/work.json", cfg)
+	if got.Widgets[0].IsEnabled() {
+		t.Error("clock_0 should be disabled by runtime state")
+	}
+	if !got.Widgets[1].IsEnabled() {
+		t.Error("cpu_0 should keep its config state")
+	}
+	if got.DirectDriver == nil || got.DirectDriver.Brightness == nil || *got.DirectDriver.Brightness != 2 {
+		t.Errorf("DirectDriver brightness = %+v, want 2", got.DirectDriver)
+	}
+	if !cfg.Widgets[0].IsEnabled() || cfg.DirectDriver != nil {
+		t.Error("original config must not be modified")
+	}
+}
+
+func TestStateManager_PerConfigIsolation(t *testing.T) {
+	s := NewStateManager(filepath.Join(t.TempDir(), RuntimeStateFile))
+	if err := s.SetWidgetEnabled("work.json", "clock_0", false); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}}}
+	if got := s.Apply("home.json", cfg); !got.Widgets[0].IsEnabled() {
+		t.Error("overrides for one config must not affect another")
+	}
+}
+
+func TestStateManager_ApplyDevices(t *testing.T) {
+	s := NewStateManager(filepath.Join(t.TempDir(), RuntimeStateFile))
+	if err := s.SetWidgetEnabled("config.json", "cpu_0", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetBrightness("config.json", 5); err != nil {
+		t.Fatal(err)
+	}
+
+	vid := &config.DirectDriverConfig{VID: "1038"}
+	cfg := &config.Config{Devices: []config.DeviceConfig{
+		{ID: "main", DirectDriver: vid, Widgets: []config.WidgetConfig{{ID: "cpu_0", Type: "cpu"}}},
+		{ID: "second", Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}}},
+	}}
+
+	got := s.Apply("config.json", cfg)
+	if got.Devices[0].Widgets[0].IsEnabled() {
+		t.Error("device cpu_0 should be disabled by runtime state")
+	}
+	if !got.Devices[1].Widgets[0].IsEnabled() {
+		t.Error("device clock_0 should keep its config state")
+	}
+	dd := got.Devices[0].DirectDriver
+	if dd == nil || dd.VID != "1038" || dd.Brightness == nil || *dd.Brightness != 5 {
+		t.Errorf("device DirectDriver = %+v, want VID 1038 with brightness 5", dd)
+	}
+	if vid.Brightness != nil {
+		t.Error("original device DirectDriver must not be modified")
+	}
+	if got.Devices[1].DirectDriver != nil {
+		t.Error("devices without direct_driver inherit the top-level override")
+	}
+}
+
+func TestStateKey(t *testing.T) {
+	if stateKey("profiles/../profiles/work.json") != stateKey("profiles/work.json") {
+		t.Error("equivalent paths should produce the same key")
+	}
+}
@@ -1,23 +1,36 @@
 package app
 
 import (
+	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/backend/webclient"
+	"github.com/pozitronik/steelclock-go/internal/compositor"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/driver"
 	"github.com/pozitronik/steelclock-go/internal/webeditor"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+	"github.com/pozitronik/steelclock-go/internal/winamp"
 )
 
 // ConfigProviderAdapter adapts ConfigManager to webeditor.ConfigProvider interface
+// and reports external config file changes as webeditor.ConfigChangeNotifier
 type ConfigProviderAdapter struct {
 	configMgr *ConfigManager
+	changed   chan string
 }
 
 // NewConfigProviderAdapter creates a new ConfigProviderAdapter
 func NewConfigProviderAdapter(configMgr *ConfigManager) *ConfigProviderAdapter {
-	return &ConfigProviderAdapter{configMgr: configMgr}
+	return &ConfigProviderAdapter{
+		configMgr: configMgr,
+		changed:   make(chan string, 1),
+	}
 }
 
 // GetConfigPath returns the path to the current configuration file
@@ -34,12 +47,29 @@ func (a *ConfigProviderAdapter) Load() ([]byte, error) {
 // Save writes the configuration JSON to the file
 func (a *ConfigProviderAdapter) Save(data []byte) error {
 	path := a.configMgr.GetConfigPath()
-	return os.WriteFile(path, data, 0644)
+	err := os.WriteFile(path, data, 0644)
+	a.configMgr.MarkWritten()
+	return err
+}
+
+// ConfigChanged returns the channel receiving the config path after external changes
+func (a *ConfigProviderAdapter) ConfigChanged() <-chan string {
+	return a.changed
+}
+
+// notifyChanged reports an external config change to the web editor.
+// A notification still waiting to be delivered already covers this one.
+func (a *ConfigProviderAdapter) notifyChanged(path string) {
+	select {
+	case a.changed <- path:
+	default:
+	}
 }
 
 // ProfileProviderAdapter adapts ProfileManager to webeditor.ProfileProvider interface
 type ProfileProviderAdapter struct {
 	profileMgr *config.ProfileManager
+	onWrite    func() // Called after a profile file was rewritten, may be nil
 }
 
 // NewProfileProviderAdapter creates a new ProfileProviderAdapter
@@ -111,7 +141,27 @@ func (a *ProfileProviderAdapter) RenameProfile(oldPath, newName string) (string,
 	if a.profileMgr == nil {
 		return "", nil
 	}
-	return a.profileMgr.RenameProfile(oldPath, newName)
+	newPath, err := a.profileMgr.RenameProfile(oldPath, newName)
+	if a.onWrite != nil {
+		a.onWrite()
+	}
+	return newPath, err
+}
+
+// ExportBundle writes the profile at path with its referenced fonts and WADs as a zip bundle
+func (a *ProfileProviderAdapter) ExportBundle(path string, w io.Writer) error {
+	if a.profileMgr == nil {
+		return fmt.Errorf("profile management not available")
+	}
+	return a.profileMgr.ExportBundle(path, w)
+}
+
+// ImportBundle adds the profile from a zip bundle and returns its path
+func (a *ProfileProviderAdapter) ImportBundle(data []byte) (string, error) {
+	if a.profileMgr == nil {
+		return "", fmt.Errorf("profile management not available")
+	}
+	return a.profileMgr.ImportBundle(data)
 }
 
 // WebClientProviderAdapter adapts webclient.Client to webeditor.PreviewProvider interface
@@ -139,6 +189,9 @@ func (a *WebClientProviderAdapter) GetPreviewConfig() webeditor.PreviewDisplayCo
 		Width:     cfg.Width,
 		Height:    cfg.Height,
 		TargetFPS: cfg.TargetFPS,
+		Scale:     cfg.Scale,
+		Grid:      cfg.Grid,
+		PixelGap:  cfg.PixelGap,
 	}
 }
 
@@ -146,3 +199,109 @@ func (a *WebClientProviderAdapter) GetPreviewConfig() webeditor.PreviewDisplayCo
 func (a *WebClientProviderAdapter) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	a.client.HandleWebSocket(w, r)
 }
+
+// framePacingInfo returns frame pacing counters of all running devices for the web editor
+func (a *App) framePacingInfo() []webeditor.FramePacingInfo {
+	return framePacingInfos(a.lifecycle.GetPacerStats())
+}
+
+// framePacingInfos converts pacer stats to web editor entries ordered by device ID
+func framePacingInfos(stats map[string]compositor.PacerStats) []webeditor.FramePacingInfo {
+	infos := make([]webeditor.FramePacingInfo, 0, len(stats))
+	for id, s := range stats {
+		infos = append(infos, webeditor.FramePacingInfo{
+			Device:    id,
+			MaxFPS:    s.MaxFPS,
+			Pushed:    s.Pushed,
+			Coalesced: s.Coalesced,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Device < infos[j].Device })
+	return infos
+}
+
+// hidDevicesInfo enumerates connected USB HID devices for the web editor.
+// It queries the system directly, independent of the active backend.
+func hidDevicesInfo() ([]webeditor.HIDDeviceInfo, error) {
+	devices, err := driver.EnumerateDevices()
+	if err != nil {
+		return nil, err
+	}
+	return hidDeviceInfos(devices), nil
+}
+
+// hidDeviceInfos converts driver device entries to web editor entries,
+// supported display devices first, then ordered by VID, PID and interface
+func hidDeviceInfos(devices []driver.DeviceInfo) []webeditor.HIDDeviceInfo {
+	infos := make([]webeditor.HIDDeviceInfo, 0, len(devices))
+	for _, d := range devices {
+		info := webeditor.HIDDeviceInfo{
+			VID:          fmt.Sprintf("%04X", d.VID),
+			PID:          fmt.Sprintf("%04X", d.PID),
+			Interface:    d.Interface,
+			Product:      d.ProductName,
+			Manufacturer: d.Manufacturer,
+			Path:         d.Path,
+		}
+		if d.UsagePage != 0 {
+			info.UsagePage = fmt.Sprintf("%04X", d.UsagePage)
+		}
+		for _, known := range driver.KnownDevices {
+			if known.VID == d.VID && known.PID == d.PID {
+				info.Known = known.Name
+				break
+			}
+		}
+		infos = append(infos, info)
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		a, b := infos[i], infos[j]
+		if (a.Known != "") != (b.Known != "") {
+			return a.Known != ""
+		}
+		if a.VID != b.VID {
+			return a.VID < b.VID
+		}
+		if a.PID != b.PID {
+			return a.PID < b.PID
+		}
+		return a.Interface < b.Interface
+	})
+	return infos
+}
+
+// nowPlayingSource is implemented by media widgets that expose the current track
+type nowPlayingSource interface {
+	NowPlaying() *winamp.TrackInfo
+}
+
+// nowPlayingInfo returns the track of the first running media widget for the web editor
+func (a *App) nowPlayingInfo() *webeditor.NowPlayingInfo {
+	return nowPlayingFromWidgets(a.lifecycle.GetWidgets())
+}
+
+// nowPlayingFromWidgets reports the track of the first media widget among widgets.
+// Returns nil if there is no media widget; a media widget with nothing loaded
+// reports the "stopped" status.
+func nowPlayingFromWidgets(widgets []widget.Widget) *webeditor.NowPlayingInfo {
+	for _, w := range widgets {
+		src, ok := w.(nowPlayingSource)
+		if !ok {
+			continue
+		}
+
+		info := &webeditor.NowPlayingInfo{
+			Widget: w.Name(),
+			Status: strings.ToLower(winamp.StatusStopped.String()),
+		}
+		if track := src.NowPlaying(); track != nil {
+			info.Title = track.Title
+			info.Artist = track.Artist
+			info.PositionMs = track.PositionMs
+			info.DurationS = track.DurationS
+			info.Status = strings.ToLower(track.Status.String())
+		}
+		return info
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
+)
+
+// defaultToggleTransitionSpeed is the transition duration in seconds when in_speed/out_speed is unset
+const defaultToggleTransitionSpeed = 0.5
+
+// widgetTransitionRequest is a pending enter transition for a widget being toggled on
+type widgetTransitionRequest struct {
+	widgetID    string
+	transitions *config.TransitionConfig
+}
+
+// toggleTransition returns the configured enter (in) or exit (out) effect, its duration
+// and easing. A nil config or unset effect yields "none".
+func toggleTransition(transitions *config.TransitionConfig, enter bool) (anim.TransitionType, float64, anim.Easing) {
+	if transitions == nil {
+		return anim.TransitionNone, 0, ""
+	}
+
+	effect, speed := transitions.Out, transitions.OutSpeed
+	if enter {
+		effect, speed = transitions.In, transitions.InSpeed
+	}
+	if effect == "" {
+		return anim.TransitionNone, 0, ""
+	}
+	if speed <= 0 {
+		speed = defaultToggleTransitionSpeed
+	}
+	return anim.TransitionType(effect), speed, anim.Easing(transitions.Easing)
+}
+
+// findWidgetTransitions returns the transitions config of the widget with the given ID, or nil
+func findWidgetTransitions(cfg *config.Config, widgetID string) *config.TransitionConfig {
+	for _, w := range cfg.Widgets {
+		if w.ID == widgetID {
+			return w.Transitions
+		}
+	}
+	for _, dev := range cfg.Devices {
+		for _, w := range dev.Widgets {
+			if w.ID == widgetID {
+				return w.Transitions
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
+)
+
+func TestToggleTransition(t *testing.T) {
+	tc := &config.TransitionConfig{In: "slide_down", InSpeed: 0.3, Out: "dissolve_fade", Easing: "ease_out"}
+
+	tests := []struct {
+		name        string
+		transitions *config.TransitionConfig
+		enter       bool
+		wantType    anim.TransitionType
+		wantSeconds float64
+		wantEasing  anim.Easing
+	}{
+		{"nil config", nil, true, anim.TransitionNone, 0, ""},
+		{"enter", tc, true, anim.TransitionSlideDown, 0.3, anim.EasingEaseOut},
+		{"exit uses default speed", tc, false, anim.TransitionDissolveFade, defaultToggleTransitionSpeed, anim.EasingEaseOut},
+		{"unset effect", &config.TransitionConfig{InSpeed: 1, Easing: "bounce"}, true, anim.TransitionNone, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotSeconds, gotEasing := toggleTransition(tt.transitions, tt.enter)
+			if gotType != tt.wantType || gotSeconds != tt.wantSeconds || gotEasing != tt.wantEasing {
+				t.Errorf("toggleTransition() = %q, %v, %q, want %q, %v, %q",
+					gotType, gotSeconds, gotEasing, tt.wantType, tt.wantSeconds, tt.wantEasing)
+			}
+		})
+	}
+}
+
+func TestFindWidgetTransitions(t *testing.T) {
+	tc := &config.TransitionConfig{In: "box_out"}
+	cfg := &config.Config{
+		Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}},
+		Devices: []config.DeviceConfig{
+			{ID: "main", Widgets: []config.WidgetConfig{{ID: "cpu_0", Type: "cpu", Transitions: tc}}},
+		},
+	}
+
+	if got := findWidgetTransitions(cfg, "cpu_0"); got != tc {
+		t.Errorf("findWidgetTransitions(cpu_0) = %v, want %v", got, tc)
+	}
+	if got := findWidgetTransitions(cfg, "clock_0"); got != nil {
+		t.Errorf("findWidgetTransitions(clock_0) = %v, want nil", got)
+	}
+	if got := findWidgetTransitions(cfg, "missing"); got != nil {
+		t.Errorf("findWidgetTransitions(missing) = %v, want nil", got)
+	}
+}
+
+func TestLifecycleQueueWidgetEnter(t *testing.T) {
+	lm := NewLifecycleManager()
+
+	lm.QueueWidgetEnter("clock_0", &config.TransitionConfig{In: "dissolve_fade"})
+	if lm.pendingEnter == nil || lm.pendingEnter.widgetID != "clock_0" {
+		t.Fatalf("pendingEnter = %+v, want clock_0", lm.pendingEnter)
+	}
+
+	// No enter effect configured: nothing to play
+	lm.QueueWidgetEnter("clock_0", &config.TransitionConfig{Out: "dissolve_fade"})
+	if lm.pendingEnter != nil {
+		t.Errorf("pendingEnter = %+v, want nil without an enter effect", lm.pendingEnter)
+	}
+}
+
+func TestLifecycleToggleWidget_NoDevices(t *testing.T) {
+	lm := NewLifecycleManager()
+	cfg := &config.Config{Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}}}
+
+	toggled, err := lm.ToggleWidget(cfg, "clock_0", false)
+	if err != nil || toggled {
+		t.Errorf("ToggleWidget() without devices = %v, %v; want false, nil", toggled, err)
+	}
+	if lm.GetLastGoodConfig() != nil {
+		t.Error("last good config should be unchanged when nothing was toggled")
+	}
+}
+
+func TestDeviceInstanceToggleWidget_NotStarted(t *testing.T) {
+	d := NewDeviceInstance("test", make(chan struct{}))
+	cfg := &config.Config{Widgets: []config.WidgetConfig{{ID: "clock_0", Type: "clock"}}}
+
+	if running, err := d.ToggleWidget(cfg, "clock_0", true); running || err != nil {
+		t.Errorf("ToggleWidget() on a stopped device = %v, %v; want false, nil", running, err)
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !windows
+
+package app
+
+// listWindowTitles returns nil: window titles are only available on Windows
+func listWindowTitles() []string {
+	return nil
+}
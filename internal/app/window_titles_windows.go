@@ -0,0 +1,52 @@
+//go:build windows
+
+package app
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	user32                   = syscall.NewLazyDLL("user32.dll")
+	procEnumWindows          = user32.NewProc("EnumWindows")
+	procIsWindowVisible      = user32.NewProc("IsWindowVisible")
+	procGetWindowTextW       = user32.NewProc("GetWindowTextW")
+	procGetWindowTextLengthW = user32.NewProc("GetWindowTextLengthW")
+)
+
+// enumWindowsCallback is created once, as syscall callbacks are a limited resource;
+// it collects titles into windowTitles, guarded by windowTitlesMu
+var (
+	windowTitlesMu      sync.Mutex
+	windowTitles        []string
+	enumWindowsCallback = syscall.NewCallback(func(hwnd uintptr, _ uintptr) uintptr {
+		visible, _, _ := procIsWindowVisible.Call(hwnd)
+		if visible == 0 {
+			return 1 // Continue enumeration
+		}
+
+		length, _, _ := procGetWindowTextLengthW.Call(hwnd)
+		if length == 0 {
+			return 1
+		}
+
+		buf := make([]uint16, length+1)
+		_, _, _ = procGetWindowTextW.Call(hwnd, uintptr(unsafe.Pointer(&buf[0])), length+1)
+		windowTitles = append(windowTitles, syscall.UTF16ToString(buf))
+		return 1
+	})
+)
+
+// listWindowTitles returns the titles of all visible top-level windows
+func listWindowTitles() []string {
+	windowTitlesMu.Lock()
+	defer windowTitlesMu.Unlock()
+
+	windowTitles = nil
+	_, _, _ = procEnumWindows.Call(enumWindowsCallback, 0)
+	titles := windowTitles
+	windowTitles = nil
+	return titles
+}
@@ -0,0 +1,105 @@
+// Package null provides a headless display backend. Frames are discarded
+// instead of being sent to a device, but the latest one is kept for the web
+// editor preview, so layouts can be rendered and designed without hardware.
+package null
+
+import (
+	"log"
+
+	"github.com/pozitronik/steelclock-go/internal/backend"
+	"github.com/pozitronik/steelclock-go/internal/backend/webclient"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/display"
+)
+
+// Priority for auto-selection: never auto-selected, the null backend must be configured explicitly
+const Priority = backend.ManualOnly
+
+func init() {
+	backend.Register("null", newBackend, Priority)
+}
+
+// Client implements display.Backend without a device
+type Client struct {
+	width   int
+	height  int
+	preview *webclient.Client // Keeps the latest frame for the web editor preview
+}
+
+// Ensure Client implements display.Backend
+var _ display.Backend = (*Client)(nil)
+
+// newBackend creates a null backend sized from the display configuration
+func newBackend(cfg *config.Config) (display.Backend, error) {
+	client := newClient(webclient.ConfigFromSettings(cfg.Display, cfg.WebClient))
+
+	log.Printf("Null backend created (width: %d, height: %d): frames are discarded, preview only",
+		cfg.Display.Width, cfg.Display.Height)
+
+	return client, nil
+}
+
+// NewClient creates a null backend client with a fixed display size.
+// previewFPS limits the frame rate streamed to preview clients (0 = unlimited).
+func NewClient(width, height, previewFPS int) *Client {
+	return newClient(webclient.Config{TargetFPS: previewFPS, Width: width, Height: height})
+}
+
+// newClient creates a null backend client feeding a preview with the given settings
+func newClient(preview webclient.Config) *Client {
+	return &Client{
+		width:   preview.Width,
+		height:  preview.Height,
+		preview: webclient.NewClient(preview),
+	}
+}
+
+// Size returns the display size configured for this backend
+func (c *Client) Size() (width, height int) {
+	return c.width, c.height
+}
+
+// Preview returns the frame store feeding the web editor preview
+func (c *Client) Preview() *webclient.Client {
+	return c.preview
+}
+
+// SendScreenData implements display.FrameSender
+func (c *Client) SendScreenData(eventName string, bitmapData []byte) error {
+	return c.preview.SendScreenData(eventName, bitmapData)
+}
+
+// SendScreenDataMultiRes implements display.FrameSender
+func (c *Client) SendScreenDataMultiRes(eventName string, resolutionData map[string][]byte) error {
+	return c.preview.SendScreenDataMultiRes(eventName, resolutionData)
+}
+
+// SendMultipleScreenData implements display.FrameSender
+func (c *Client) SendMultipleScreenData(eventName string, frames [][]byte) error {
+	return c.preview.SendMultipleScreenData(eventName, frames)
+}
+
+// SendHeartbeat implements display.HeartbeatSender; there is no device to lose
+func (c *Client) SendHeartbeat() error {
+	return nil
+}
+
+// SupportsMultipleEvents implements display.BatchCapability
+func (c *Client) SupportsMultipleEvents() bool {
+	return false
+}
+
+// RegisterGame implements display.GameRegistrar
+func (c *Client) RegisterGame(_ string, _ int) error {
+	return nil
+}
+
+// BindScreenEvent implements display.GameRegistrar
+func (c *Client) BindScreenEvent(_ string, _ string) error {
+	return nil
+}
+
+// RemoveGame implements display.GameRegistrar
+func (c *Client) RemoveGame() error {
+	return nil
+}
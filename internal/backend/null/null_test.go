@@ -0,0 +1,112 @@
+package null
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/backend"
+	"github.com/pozitronik/steelclock-go/internal/backend/webclient"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/display"
+)
+
+func TestNewBackend_SizeFromDisplayConfig(t *testing.T) {
+	cfg := &config.Config{Display: config.DisplayConfig{Width: 128, Height: 52}}
+
+	b, err := newBackend(cfg)
+	if err != nil {
+		t.Fatalf("newBackend() error = %v", err)
+	}
+	c := b.(*Client)
+
+	if w, h := c.Size(); w != 128 || h != 52 {
+		t.Errorf("Size() = %dx%d, want 128x52", w, h)
+	}
+	if pc := c.Preview().GetConfig(); pc.Width != 128 || pc.Height != 52 || pc.TargetFPS != webclient.DefaultTargetFPS {
+		t.Errorf("preview config = %+v, want 128x52 at %d FPS", pc, webclient.DefaultTargetFPS)
+	}
+}
+
+func TestNewBackend_PreviewFPSFromWebClientConfig(t *testing.T) {
+	cfg := &config.Config{
+		Display:   config.DisplayConfig{Width: 128, Height: 40},
+		WebClient: &config.WebClientConfig{TargetFPS: 10},
+	}
+
+	b, err := newBackend(cfg)
+	if err != nil {
+		t.Fatalf("newBackend() error = %v", err)
+	}
+	if got := b.(*Client).Preview().GetConfig().TargetFPS; got != 10 {
+		t.Errorf("preview TargetFPS = %d, want 10", got)
+	}
+}
+
+func TestClient_FramesReachPreview(t *testing.T) {
+	c := NewClient(128, 40, 0)
+	frame := bytes.Repeat([]byte{0xAA}, 640)
+
+	if err := c.SendScreenData("event", frame); err != nil {
+		t.Fatalf("SendScreenData() error = %v", err)
+	}
+
+	got, num, _ := c.Preview().GetCurrentFrame()
+	if !bytes.Equal(got, frame) || num != 1 {
+		t.Errorf("preview frame #%d = %d bytes, want frame #1 of %d bytes", num, len(got), len(frame))
+	}
+}
+
+func TestClient_NoOps(t *testing.T) {
+	c := NewClient(128, 40, 30)
+
+	if err := c.SendHeartbeat(); err != nil {
+		t.Errorf("SendHeartbeat() error = %v", err)
+	}
+	if err := c.RegisterGame("dev", 15000); err != nil {
+		t.Errorf("RegisterGame() error = %v", err)
+	}
+	if err := c.BindScreenEvent("event", "screened-128x40"); err != nil {
+		t.Errorf("BindScreenEvent() error = %v", err)
+	}
+	if err := c.RemoveGame(); err != nil {
+		t.Errorf("RemoveGame() error = %v", err)
+	}
+	if c.SupportsMultipleEvents() {
+		t.Error("SupportsMultipleEvents() should be false")
+	}
+	if _, ok := any(c).(display.FrameRateLimit); ok {
+		t.Error("null backend should not limit the render loop")
+	}
+}
+
+func TestRegistered_NotAutoSelected(t *testing.T) {
+	if !backend.IsRegistered("null") {
+		t.Fatal("null backend should be registered")
+	}
+
+	// With every other backend excluded, auto-selection must not fall back to null
+	exclude := []string{}
+	for _, name := range backend.RegisteredTypes() {
+		if name != "null" {
+			exclude = append(exclude, name)
+		}
+	}
+	if _, err := backend.CreateExcluding(&config.Config{}, exclude...); err == nil {
+		t.Error("auto-selection should not pick the null backend")
+	}
+}
+
+func TestNewBackend_PreviewOptions(t *testing.T) {
+	cfg := &config.Config{
+		Display:   config.DisplayConfig{Width: 128, Height: 40},
+		WebClient: &config.WebClientConfig{Scale: 6, Grid: true, PixelGap: 1},
+	}
+
+	b, err := newBackend(cfg)
+	if err != nil {
+		t.Fatalf("newBackend() error = %v", err)
+	}
+	if pc := b.(*Client).Preview().GetConfig(); pc.Scale != 6 || !pc.Grid || pc.PixelGap != 1 {
+		t.Errorf("preview config = %+v, want scale 6 with grid and gap 1", pc)
+	}
+}
@@ -16,6 +16,10 @@ import (
 // Factory creates a backend from configuration
 type Factory func(cfg *config.Config) (display.Backend, error)
 
+// ManualOnly is the priority of backends that are never auto-selected;
+// they are created only when named in the config
+const ManualOnly = -1
+
 // registration holds a factory and its priority for auto-selection
 type registration struct {
 	factory  Factory
@@ -34,8 +38,8 @@ func init() {
 }
 
 // Register registers a backend factory with the given name and priority.
-// Lower priority values are tried first during auto-selection.
-// This should be called from init() functions in backend implementation packages.
+// Lower priority values are tried first during auto-selection; ManualOnly
+// backends are skipped by it. This should be called from init() functions in backend implementation packages.
 func Register(name string, factory Factory, priority int) {
 	registryMu.Lock()
 	defer registryMu.Unlock()
@@ -77,10 +81,10 @@ type Result struct {
 }
 
 // Create creates a backend based on configuration.
-// If cfg.Backend is empty, tries all registered backends by priority.
+// If cfg.Backend is empty or "auto", tries all registered backends by priority.
 // Otherwise, creates the specified backend.
 func Create(cfg *config.Config) (Result, error) {
-	if cfg.Backend == "" {
+	if config.IsAutoBackend(cfg.Backend) {
 		return createAuto(cfg)
 	}
 	return CreateByName(cfg.Backend, cfg)
@@ -132,6 +136,9 @@ func createAutoWithExclude(cfg *config.Config, exclude map[string]bool) (Result,
 		if exclude != nil && exclude[name] {
 			continue // Skip excluded backends
 		}
+		if reg.priority == ManualOnly {
+			continue
+		}
 		entries = append(entries, entry{name: name, reg: reg})
 	}
 	registryMu.RUnlock()
@@ -142,14 +149,20 @@ func createAutoWithExclude(cfg *config.Config, exclude map[string]bool) (Result,
 	})
 
 	var lastErr error
+	var failed []string
 	for _, e := range entries {
 		log.Printf("Trying backend '%s'...", e.name)
 		backend, err := e.reg.factory(cfg)
 		if err == nil {
-			log.Printf("Backend '%s' connected successfully", e.name)
+			if len(failed) > 0 {
+				log.Printf("Backend '%s' connected successfully (unavailable: %s)", e.name, strings.Join(failed, ", "))
+			} else {
+				log.Printf("Backend '%s' connected successfully", e.name)
+			}
 			return Result{Backend: backend, Name: e.name}, nil
 		}
 		log.Printf("Backend '%s' failed: %v", e.name, err)
+		failed = append(failed, e.name)
 		lastErr = err
 	}
 
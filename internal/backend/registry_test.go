@@ -233,6 +233,49 @@ func TestCreate_AutoFallback(t *testing.T) {
 	}
 }
 
+func TestCreate_AutoKeyword(t *testing.T) {
+	restore := saveAndClearRegistry()
+	defer restore()
+
+	Register("failing", func(*config.Config) (display.Backend, error) {
+		return nil, errors.New("fails")
+	}, 1)
+	Register("working", func(*config.Config) (display.Backend, error) {
+		return &mockBackend{}, nil
+	}, 10)
+
+	// "auto" is not a backend name but selects like an empty value
+	cfg := &config.Config{Backend: config.BackendAuto}
+	result, err := Create(cfg)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if result.Name != "working" {
+		t.Errorf("auto should fall back to 'working', got %q", result.Name)
+	}
+}
+
+func TestCreate_ManualOnlySkipped(t *testing.T) {
+	restore := saveAndClearRegistry()
+	defer restore()
+
+	Register("manual", func(*config.Config) (display.Backend, error) {
+		return &mockBackend{}, nil
+	}, ManualOnly)
+
+	if _, err := Create(&config.Config{}); err == nil {
+		t.Error("auto-selection should skip ManualOnly backends")
+	}
+
+	result, err := Create(&config.Config{Backend: "manual"})
+	if err != nil {
+		t.Fatalf("Create() by name error = %v", err)
+	}
+	if result.Name != "manual" {
+		t.Errorf("result.Name = %q, want %q", result.Name, "manual")
+	}
+}
+
 func TestCreate_AllFail(t *testing.T) {
 	restore := saveAndClearRegistry()
 	defer restore()
@@ -17,13 +17,24 @@ import (
 	"github.com/pozitronik/steelclock-go/internal/display"
 )
 
-// Priority for auto-selection (highest = tried last, webclient should not be auto-selected)
+// Priority for auto-selection (highest = tried last: the preview-only fallback
+// when no hardware backend is available)
 const Priority = 1000
 
 func init() {
 	backend.Register("webclient", newBackend, Priority)
 }
 
+// Preview rendering defaults
+const (
+	// DefaultTargetFPS is the frame rate sent to clients when not configured
+	DefaultTargetFPS = 30
+	// DefaultScale is the browser preview upscaling factor when not configured
+	DefaultScale = 4
+	// MaxScale limits the browser preview upscaling factor
+	MaxScale = 16
+)
+
 // Config holds webclient backend configuration
 type Config struct {
 	// TargetFPS limits the frame rate sent to clients (0 = unlimited)
@@ -32,6 +43,38 @@ type Config struct {
 	Width int
 	// Height is the display height in pixels
 	Height int
+	// Scale is the initial preview upscaling factor (0 = browser default)
+	Scale int
+	// Grid draws a pixel grid over the upscaled preview
+	Grid bool
+	// PixelGap is the dark spacing between upscaled pixels, mimicking OLED dot pitch
+	PixelGap int
+}
+
+// ConfigFromSettings builds a client configuration for a display from the
+// webclient settings, applying defaults to unset values. Preview options only
+// change how frames are drawn in the browser, never the frames themselves.
+func ConfigFromSettings(display config.DisplayConfig, settings *config.WebClientConfig) Config {
+	cfg := Config{
+		TargetFPS: DefaultTargetFPS,
+		Width:     display.Width,
+		Height:    display.Height,
+		Scale:     DefaultScale,
+	}
+	if settings == nil {
+		return cfg
+	}
+
+	if settings.TargetFPS > 0 {
+		cfg.TargetFPS = settings.TargetFPS
+	}
+	if settings.Scale > 0 {
+		cfg.Scale = min(settings.Scale, MaxScale)
+	}
+	cfg.Grid = settings.Grid
+	// A gap must leave at least one lit row and column per pixel
+	cfg.PixelGap = max(0, min(settings.PixelGap, cfg.Scale-1))
+	return cfg
 }
 
 // Client implements display.Backend for web display
@@ -73,23 +116,12 @@ type FrameMessage struct {
 
 // newBackend creates a webclient backend from configuration
 func newBackend(cfg *config.Config) (display.Backend, error) {
-	webclientCfg := Config{
-		TargetFPS: 30, // Default 30 FPS
-		Width:     cfg.Display.Width,
-		Height:    cfg.Display.Height,
-	}
-
-	// Override from config if available
-	if cfg.WebClient != nil {
-		if cfg.WebClient.TargetFPS > 0 {
-			webclientCfg.TargetFPS = cfg.WebClient.TargetFPS
-		}
-	}
+	webclientCfg := ConfigFromSettings(cfg.Display, cfg.WebClient)
 
 	client := NewClient(webclientCfg)
 
-	log.Printf("WebClient backend created (width: %d, height: %d, target FPS: %d)",
-		webclientCfg.Width, webclientCfg.Height, webclientCfg.TargetFPS)
+	log.Printf("WebClient backend created (width: %d, height: %d, target FPS: %d, preview scale: %d)",
+		webclientCfg.Width, webclientCfg.Height, webclientCfg.TargetFPS, webclientCfg.Scale)
 
 	return client, nil
 }
@@ -144,6 +176,12 @@ func (c *Client) SupportsMultipleEvents() bool {
 	return false
 }
 
+// MaxPushFPS implements display.FrameRateLimit. Frames above the target rate
+// would be dropped by the broadcast rate limiter anyway.
+func (c *Client) MaxPushFPS() int {
+	return c.config.TargetFPS
+}
+
 // RegisterGame implements display.GameRegistrar
 func (c *Client) RegisterGame(_ string, _ int) error {
 	// No-op for webclient backend
@@ -302,6 +340,9 @@ func (c *Client) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 		"width":      c.config.Width,
 		"height":     c.config.Height,
 		"target_fps": c.config.TargetFPS,
+		"scale":      c.config.Scale,
+		"grid":       c.config.Grid,
+		"pixel_gap":  c.config.PixelGap,
 	}
 	if configBytes, err := json.Marshal(configMsg); err == nil {
 		select {
@@ -3,6 +3,9 @@ package webclient
 import (
 	"testing"
 	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/display"
 )
 
 func TestNewClient(t *testing.T) {
@@ -51,6 +54,49 @@ func TestGetConfig(t *testing.T) {
 	}
 }
 
+func TestConfigFromSettings(t *testing.T) {
+	display := config.DisplayConfig{Width: 128, Height: 40}
+
+	tests := []struct {
+		name     string
+		settings *config.WebClientConfig
+		want     Config
+	}{
+		{
+			name: "defaults",
+			want: Config{TargetFPS: DefaultTargetFPS, Width: 128, Height: 40, Scale: DefaultScale},
+		},
+		{
+			name:     "all options",
+			settings: &config.WebClientConfig{TargetFPS: 10, Scale: 8, Grid: true, PixelGap: 2},
+			want:     Config{TargetFPS: 10, Width: 128, Height: 40, Scale: 8, Grid: true, PixelGap: 2},
+		},
+		{
+			name:     "scale clamped",
+			settings: &config.WebClientConfig{Scale: 100},
+			want:     Config{TargetFPS: DefaultTargetFPS, Width: 128, Height: 40, Scale: MaxScale},
+		},
+		{
+			name:     "gap leaves a lit pixel",
+			settings: &config.WebClientConfig{Scale: 2, PixelGap: 5},
+			want:     Config{TargetFPS: DefaultTargetFPS, Width: 128, Height: 40, Scale: 2, PixelGap: 1},
+		},
+		{
+			name:     "negative gap ignored",
+			settings: &config.WebClientConfig{PixelGap: -1},
+			want:     Config{TargetFPS: DefaultTargetFPS, Width: 128, Height: 40, Scale: DefaultScale},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConfigFromSettings(display, tt.settings); got != tt.want {
+				t.Errorf("ConfigFromSettings() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSendScreenData_StoresFrame(t *testing.T) {
 	c := NewClient(Config{TargetFPS: 0, Width: 128, Height: 40})
 
@@ -190,6 +236,15 @@ func TestNoOpMethods(t *testing.T) {
 	}
 }
 
+func TestMaxPushFPS(t *testing.T) {
+	var _ display.FrameRateLimit = (*Client)(nil)
+
+	c := NewClient(Config{TargetFPS: 20, Width: 128, Height: 40})
+	if got := c.MaxPushFPS(); got != 20 {
+		t.Errorf("MaxPushFPS() = %d, want 20", got)
+	}
+}
+
 func TestRateLimiting(t *testing.T) {
 	// 10 FPS = 100ms between frames
 	c := NewClient(Config{TargetFPS: 10, Width: 128, Height: 40})
@@ -2,7 +2,11 @@
 // used by Foobar2000 and DeaDBeeF music players.
 package beefweb
 
-import "time"
+import (
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+)
 
 // PlaybackState represents the current player state.
 type PlaybackState int
@@ -28,6 +32,18 @@ func (s PlaybackState) String() string {
 	}
 }
 
+// Message returns the translatable message key for the state
+func (s PlaybackState) Message() i18n.Message {
+	switch s {
+	case StatePlaying:
+		return i18n.Playing
+	case StatePaused:
+		return i18n.Paused
+	default:
+		return i18n.Stopped
+	}
+}
+
 // TrackInfo contains metadata for the currently playing track.
 type TrackInfo struct {
 	Artist   string
@@ -6,6 +6,8 @@ import (
 	"net/http/httptest"
 	"testing"
 	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 )
 
 func TestPlaybackStateString(t *testing.T) {
@@ -28,6 +30,24 @@ func TestPlaybackStateString(t *testing.T) {
 	}
 }
 
+func TestPlaybackStateMessage(t *testing.T) {
+	tests := []struct {
+		state PlaybackState
+		want  i18n.Message
+	}{
+		{StateStopped, i18n.Stopped},
+		{StatePlaying, i18n.Playing},
+		{StatePaused, i18n.Paused},
+		{PlaybackState(99), i18n.Stopped},
+	}
+
+	for _, tt := range tests {
+		if got := tt.state.Message(); got != tt.want {
+			t.Errorf("PlaybackState(%d).Message() = %q, want %q", tt.state, got, tt.want)
+		}
+	}
+}
+
 func TestNew(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -11,7 +11,37 @@ import (
 
 // DrawHorizontalBar draws a horizontal progress bar
 func DrawHorizontalBar(img *image.Gray, x, y, w, h int, percentage float64, fillColor uint8, drawBorder bool) {
+	drawHorizontalBar(img, x, y, w, h, percentage, fillColor, solidFill(fillColor), drawBorder)
+}
+
+// DrawHorizontalBarGradient draws a horizontal progress bar whose fill color follows the
+// gradient stops from the left end of the bar to the right end, so only high values
+// reach the last stop. The border uses borderColor.
+func DrawHorizontalBarGradient(img *image.Gray, x, y, w, h int, percentage float64, stops []uint8, borderColor uint8, drawBorder bool) {
+	drawHorizontalBar(img, x, y, w, h, percentage, borderColor, gradientFill(stops), drawBorder)
+}
+
+// barFill returns the fill color of the pixel at offset along a bar of the given length
+type barFill func(offset, length int) color.Gray
+
+// solidFill fills the whole bar with one color
+func solidFill(fillColor uint8) barFill {
 	c := color.Gray{Y: fillColor}
+	return func(int, int) color.Gray { return c }
+}
+
+// gradientFill interpolates the gradient stops along the bar length
+func gradientFill(stops []uint8) barFill {
+	return func(offset, length int) color.Gray {
+		if length <= 1 {
+			return color.Gray{Y: GradientColor(stops, 0)}
+		}
+		return color.Gray{Y: GradientColor(stops, float64(offset)/float64(length-1))}
+	}
+}
+
+func drawHorizontalBar(img *image.Gray, x, y, w, h int, percentage float64, borderColor uint8, fill barFill, drawBorder bool) {
+	c := color.Gray{Y: borderColor}
 
 	// Draw border if requested
 	if drawBorder {
@@ -29,7 +59,7 @@ func DrawHorizontalBar(img *image.Gray, x, y, w, h int, percentage float64, fill
 		if fillW > 0 {
 			for py := y + 1; py < y+h-1; py++ {
 				for px := x + 1; px < x+1+fillW; px++ {
-					img.Set(px, py, c)
+					img.Set(px, py, fill(px-x-1, w-2))
 				}
 			}
 		}
@@ -39,7 +69,7 @@ func DrawHorizontalBar(img *image.Gray, x, y, w, h int, percentage float64, fill
 		if fillW > 0 {
 			for py := y; py < y+h; py++ {
 				for px := x; px < x+fillW; px++ {
-					img.Set(px, py, c)
+					img.Set(px, py, fill(px-x, w))
 				}
 			}
 		}
@@ -48,7 +78,17 @@ func DrawHorizontalBar(img *image.Gray, x, y, w, h int, percentage float64, fill
 
 // DrawVerticalBar draws a vertical progress bar (fills from bottom)
 func DrawVerticalBar(img *image.Gray, x, y, w, h int, percentage float64, fillColor uint8, drawBorder bool) {
-	c := color.Gray{Y: fillColor}
+	drawVerticalBar(img, x, y, w, h, percentage, fillColor, solidFill(fillColor), drawBorder)
+}
+
+// DrawVerticalBarGradient draws a vertical progress bar whose fill color follows the
+// gradient stops from the bottom of the bar to the top. The border uses borderColor.
+func DrawVerticalBarGradient(img *image.Gray, x, y, w, h int, percentage float64, stops []uint8, borderColor uint8, drawBorder bool) {
+	drawVerticalBar(img, x, y, w, h, percentage, borderColor, gradientFill(stops), drawBorder)
+}
+
+func drawVerticalBar(img *image.Gray, x, y, w, h int, percentage float64, borderColor uint8, fill barFill, drawBorder bool) {
+	c := color.Gray{Y: borderColor}
 
 	fillH := int(float64(h) * (percentage / 100.0))
 	fillY := y + h - fillH
@@ -72,7 +112,7 @@ func DrawVerticalBar(img *image.Gray, x, y, w, h int, percentage float64, fillCo
 			}
 			for py := startY; py < y+h-1; py++ {
 				for px := x + 1; px < x+w-1; px++ {
-					img.Set(px, py, c)
+					img.Set(px, py, fill(y+h-2-py, h-2))
 				}
 			}
 		}
@@ -81,7 +121,7 @@ func DrawVerticalBar(img *image.Gray, x, y, w, h int, percentage float64, fillCo
 		if fillH > 0 {
 			for py := fillY; py < y+h; py++ {
 				for px := x; px < x+w; px++ {
-					img.Set(px, py, c)
+					img.Set(px, py, fill(y+h-1-py, h))
 				}
 			}
 		}
@@ -261,23 +301,27 @@ func DrawDualGauge(img *image.Gray, pos config.PositionConfig, outerPercentage,
 }
 
 // DrawGauge draws a semicircular gauge with needle at a specific position
-//
-//nolint:gocyclo // Complex geometric calculations for gauge rendering
 func DrawGauge(img *image.Gray, x, y, width, height int, percentage float64, gaugeColor, needleColor uint8, showTicks bool, ticksColor uint8) {
-	centerX := x + width/2
-	centerY := y + height - 3 // Near bottom
+	gColor := color.Gray{Y: gaugeColor}
+	drawGauge(img, x, y, width, height, percentage, func(float64) color.Gray { return gColor }, needleColor, showTicks, ticksColor)
+}
 
-	// Radius of the gauge arc
-	radius := height - 6
-	if width/2 < radius {
-		radius = width/2 - 3
+// DrawGaugeGradient draws a semicircular gauge whose arc color follows the gradient
+// stops from the 0% end of the arc to the 100% end
+func DrawGaugeGradient(img *image.Gray, x, y, width, height int, percentage float64, arcStops []uint8, needleColor uint8, showTicks bool, ticksColor uint8) {
+	arcColor := func(angle float64) color.Gray {
+		return color.Gray{Y: GradientColor(arcStops, (180-angle)/180)}
 	}
+	drawGauge(img, x, y, width, height, percentage, arcColor, needleColor, showTicks, ticksColor)
+}
 
+//nolint:gocyclo // Complex geometric calculations for gauge rendering
+func drawGauge(img *image.Gray, x, y, width, height int, percentage float64, arcColor func(angle float64) color.Gray, needleColor uint8, showTicks bool, ticksColor uint8) {
+	centerX, centerY, radius := gaugeGeometry(x, y, width, height)
 	if radius <= 0 {
 		return
 	}
 
-	gColor := color.Gray{Y: gaugeColor}
 	nColor := color.Gray{Y: needleColor}
 	tColor := color.Gray{Y: ticksColor}
 
@@ -290,7 +334,7 @@ func DrawGauge(img *image.Gray, x, y, width, height int, percentage float64, gau
 		py := centerY - int(float64(radius)*math.Sin(rad))
 
 		if px >= bounds.Min.X && px < bounds.Max.X && py >= bounds.Min.Y && py < bounds.Max.Y {
-			img.Set(px, py, gColor)
+			img.Set(px, py, arcColor(angle))
 		}
 	}
 
@@ -0,0 +1,208 @@
+package bitmap
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// emojiFace wraps a TrueType face and draws emoji from glyphs.Emoji8x8.
+// TTF fonts suitable for the display mostly have no emoji, so without it most emoji
+// render as the font's missing glyph box.
+type emojiFace struct {
+	font.Face
+	scale int                   // Integer upscale of the 8x8 glyphs to match the line height
+	top   int                   // Glyph top relative to the baseline, in pixels
+	masks map[rune]*image.Alpha // Pre-rendered masks, shared by aliases of the same glyph
+}
+
+// WithEmojiGlyphs returns a face that draws emoji the font has no glyph for with the
+// monochrome emoji set, and delegates everything else to face. Known emoji get their own
+// glyph, other emoji a generic box (glyphs.EmojiFallback); variation selectors, zero-width
+// joiners and skin tone modifiers take no space, and flags read as their country code.
+// A nil face is returned as is: internal bitmap fonts draw emoji the same way on their own.
+func WithEmojiGlyphs(face font.Face) font.Face {
+	if face == nil {
+		return nil
+	}
+	if _, ok := face.(*emojiFace); ok {
+		return face
+	}
+
+	m := face.Metrics()
+	lineHeight := (m.Ascent + m.Descent).Ceil()
+	set := glyphs.Emoji8x8
+	scale := max(1, lineHeight/set.GlyphHeight)
+	height := set.GlyphHeight * scale
+
+	f := &emojiFace{
+		Face:  face,
+		scale: scale,
+		// Center the glyph on the line, so it lines up with both caps and descenders
+		top:   -m.Ascent.Ceil() + (lineHeight-height)/2,
+		masks: make(map[rune]*image.Alpha, len(set.Glyphs)),
+	}
+	rendered := make(map[*glyphs.Glyph]*image.Alpha, len(set.Glyphs))
+	for r, g := range set.Glyphs {
+		if rendered[g] == nil {
+			rendered[g] = f.mask(g)
+		}
+		f.masks[r] = rendered[g]
+	}
+	return f
+}
+
+// mask renders a glyph as an alpha mask at the face scale
+func (f *emojiFace) mask(g *glyphs.Glyph) *image.Alpha {
+	m := image.NewAlpha(image.Rect(0, 0, g.Width*f.scale, g.Height*f.scale))
+	for y, row := range g.Data {
+		for x, on := range row {
+			if !on {
+				continue
+			}
+			for dy := 0; dy < f.scale; dy++ {
+				for dx := 0; dx < f.scale; dx++ {
+					m.SetAlpha(x*f.scale+dx, y*f.scale+dy, opaqueAlpha)
+				}
+			}
+		}
+	}
+	return m
+}
+
+// emojiMask returns the mask drawn for r, or ok=false when the wrapped face draws r
+// itself: either r is not an emoji, or the font has its own glyph for it.
+// Zero-width emoji modifiers return a nil mask.
+func (f *emojiFace) emojiMask(r rune) (mask *image.Alpha, ok bool) {
+	if isEmojiModifier(r) {
+		return nil, true
+	}
+	m, found := f.masks[r]
+	if !found {
+		if !isEmoji(r) {
+			return nil, false
+		}
+		m = f.masks[glyphs.EmojiFallback]
+	}
+	if _, has := f.Face.GlyphAdvance(r); has {
+		return nil, false
+	}
+	return m, true
+}
+
+// advance is the horizontal advance of an emoji mask, with one pixel of spacing
+func (f *emojiFace) advance(mask *image.Alpha) fixed.Int26_6 {
+	if mask == nil {
+		return 0
+	}
+	return fixed.I(mask.Rect.Dx() + 1)
+}
+
+// Glyph implements font.Face
+func (f *emojiFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	r = regionalLetter(r)
+	mask, ok := f.emojiMask(r)
+	if !ok {
+		return f.Face.Glyph(dot, r)
+	}
+	if mask == nil {
+		return image.Rectangle{}, nil, image.Point{}, 0, true
+	}
+	x, y := dot.X.Round(), dot.Y.Round()+f.top
+	dr := image.Rect(x, y, x+mask.Rect.Dx(), y+mask.Rect.Dy())
+	return dr, mask, image.Point{}, f.advance(mask), true
+}
+
+// GlyphBounds implements font.Face
+func (f *emojiFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	r = regionalLetter(r)
+	mask, ok := f.emojiMask(r)
+	if !ok {
+		return f.Face.GlyphBounds(r)
+	}
+	if mask == nil {
+		return fixed.Rectangle26_6{}, 0, true
+	}
+	bounds := fixed.R(0, f.top, mask.Rect.Dx(), f.top+mask.Rect.Dy())
+	return bounds, f.advance(mask), true
+}
+
+// GlyphAdvance implements font.Face
+func (f *emojiFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	r = regionalLetter(r)
+	mask, ok := f.emojiMask(r)
+	if !ok {
+		return f.Face.GlyphAdvance(r)
+	}
+	return f.advance(mask), true
+}
+
+// Kern implements font.Face. Emoji are never kerned.
+func (f *emojiFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	r0, r1 = regionalLetter(r0), regionalLetter(r1)
+	if _, ok := f.emojiMask(r0); ok {
+		return 0
+	}
+	if _, ok := f.emojiMask(r1); ok {
+		return 0
+	}
+	return f.Face.Kern(r0, r1)
+}
+
+// emojiGlyph returns the emoji set glyph drawn for r, or ok=false when r is not an emoji.
+// Zero-width emoji modifiers return a nil glyph.
+func emojiGlyph(r rune) (g *glyphs.Glyph, ok bool) {
+	if isEmojiModifier(r) {
+		return nil, true
+	}
+	if g := glyphs.GetGlyph(glyphs.Emoji8x8, r); g != nil {
+		return g, true
+	}
+	if isEmoji(r) {
+		return glyphs.GetGlyph(glyphs.Emoji8x8, glyphs.EmojiFallback), true
+	}
+	return nil, false
+}
+
+// regionalLetter maps a regional indicator symbol to its letter and returns other runes
+// unchanged. A flag is a pair of regional indicators, so it reads as its country code
+// ("🇩🇪" as "DE"), as on systems without flag glyphs.
+func regionalLetter(r rune) rune {
+	if r >= 0x1F1E6 && r <= 0x1F1FF {
+		return 'A' + r - 0x1F1E6
+	}
+	return r
+}
+
+// opaqueAlpha is a fully covered mask pixel
+var opaqueAlpha = color.Alpha{A: 0xff}
+
+// isEmoji reports whether r is in one of the Unicode blocks holding emoji
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Pictographs, emoticons, transport, supplemental symbols
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous symbols and dingbats
+		return true
+	case r == 0x2B50 || r == 0x2B55 || r == 0x2B1B || r == 0x2B1C: // Star, circle, squares
+		return true
+	case r == 0x231A || r == 0x231B || (r >= 0x23E9 && r <= 0x23FA): // Watch, hourglass, media controls
+		return true
+	}
+	return false
+}
+
+// isEmojiModifier reports whether r only alters the emoji before it and takes no space:
+// variation selectors, zero-width joiner, combining keycap and skin tone modifiers
+func isEmojiModifier(r rune) bool {
+	switch {
+	case r == 0xFE0E || r == 0xFE0F || r == 0x200D || r == 0x20E3:
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return true
+	}
+	return false
+}
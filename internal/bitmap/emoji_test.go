@@ -0,0 +1,144 @@
+package bitmap
+
+import (
+	"image"
+	"testing"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestWithEmojiGlyphs_Nil(t *testing.T) {
+	if WithEmojiGlyphs(nil) != nil {
+		t.Error("WithEmojiGlyphs(nil) should stay nil for internal fonts")
+	}
+}
+
+func TestWithEmojiGlyphs_Idempotent(t *testing.T) {
+	face := WithEmojiGlyphs(basicfont.Face7x13)
+	if WithEmojiGlyphs(face) != face {
+		t.Error("Wrapping an emoji face again should return it unchanged")
+	}
+}
+
+func TestWithEmojiGlyphs_Measure(t *testing.T) {
+	face := WithEmojiGlyphs(basicfont.Face7x13)
+
+	// Plain text is measured by the wrapped face
+	plain, _ := MeasureText("abc", face)
+	if want, _ := MeasureText("abc", basicfont.Face7x13); plain != want {
+		t.Errorf("plain text width = %d, want %d", plain, want)
+	}
+
+	// 13px line height keeps the 8x8 glyphs at scale 1: 8 pixels plus 1 spacing
+	emoji, _ := MeasureText("👍", face)
+	if emoji != 9 {
+		t.Errorf("emoji width = %d, want 9", emoji)
+	}
+
+	// Unknown emoji take the fallback box; modifiers take no space
+	if w, _ := MeasureText("🦩", face); w != emoji {
+		t.Errorf("unknown emoji width = %d, want %d", w, emoji)
+	}
+	if w, _ := MeasureText("👍🏽️", face); w != emoji {
+		t.Errorf("emoji with skin tone and variation selector width = %d, want %d", w, emoji)
+	}
+	if w, _ := MeasureText("a👍b", face); w != plain/3*2+emoji {
+		t.Errorf("mixed text width = %d, want %d", w, plain/3*2+emoji)
+	}
+}
+
+func TestWithEmojiGlyphs_Scale(t *testing.T) {
+	// A face with a 26px line fits the 8x8 glyphs three times over
+	face := WithEmojiGlyphs(tallFace{basicfont.Face7x13})
+	if w, _ := MeasureText("❤", face); w != 25 {
+		t.Errorf("scaled emoji width = %d, want 25", w)
+	}
+}
+
+func TestWithEmojiGlyphs_Draw(t *testing.T) {
+	face := WithEmojiGlyphs(basicfont.Face7x13)
+
+	known := image.NewGray(image.Rect(0, 0, 20, 13))
+	DrawTextAt(known, "❌", face, 0, 0)
+	if countLitPixels(known) == 0 {
+		t.Fatal("known emoji drew nothing")
+	}
+
+	unknown := image.NewGray(image.Rect(0, 0, 20, 13))
+	DrawTextAt(unknown, "🦩", face, 0, 0)
+	if countLitPixels(unknown) == 0 {
+		t.Fatal("unknown emoji drew nothing")
+	}
+	if countLitPixels(unknown) == countLitPixels(known) {
+		t.Error("unknown emoji should draw the fallback box, not the known glyph")
+	}
+
+	// The glyph stays within the line
+	for y := 0; y < 13; y++ {
+		for x := 9; x < 20; x++ {
+			if known.GrayAt(x, y).Y != 0 {
+				t.Fatalf("pixel (%d,%d) lit outside the glyph", x, y)
+			}
+		}
+	}
+}
+
+// tallFace doubles the metrics of a face
+type tallFace struct {
+	font.Face
+}
+
+func (f tallFace) Metrics() font.Metrics {
+	m := f.Face.Metrics()
+	m.Height *= 2
+	m.Ascent *= 2
+	m.Descent *= 2
+	return m
+}
+
+func TestWithEmojiGlyphs_PrefersFontGlyph(t *testing.T) {
+	// The font has its own star, so the emoji stand-in must not replace it
+	face := WithEmojiGlyphs(starFace{basicfont.Face7x13})
+	if w, _ := MeasureText("★", face); w != 7 {
+		t.Errorf("font star width = %d, want the font's 7", w)
+	}
+	if w, _ := MeasureText("❤", face); w != 9 {
+		t.Errorf("emoji missing from the font width = %d, want 9", w)
+	}
+}
+
+func TestWithEmojiGlyphs_Flag(t *testing.T) {
+	face := WithEmojiGlyphs(basicfont.Face7x13)
+	flag, _ := MeasureText("🇩🇪", face)
+	if want, _ := MeasureText("DE", face); flag != want {
+		t.Errorf("flag width = %d, want the width of its country code %d", flag, want)
+	}
+}
+
+// starFace reports a glyph for '★', drawn with the face's 'A'
+type starFace struct {
+	font.Face
+}
+
+func (f starFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	if r == '★' {
+		r = 'A'
+	}
+	return f.Face.GlyphAdvance(r)
+}
+
+func (f starFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	if r == '★' {
+		r = 'A'
+	}
+	return f.Face.GlyphBounds(r)
+}
+
+func (f starFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	if r == '★' {
+		r = 'A'
+	}
+	return f.Face.Glyph(dot, r)
+}
@@ -95,6 +95,12 @@ func LoadFont(fontName string, size int) (font.Face, error) {
 	return face, nil
 }
 
+// FontExists reports whether fontName names an internal font or a TrueType font file
+// that can be found. LoadFont falls back to the default font for any other name.
+func FontExists(fontName string) bool {
+	return IsInternalFont(fontName) || resolveFontPath(fontName) != ""
+}
+
 // loadTTF loads a TrueType font file
 func loadTTF(path string) (*opentype.Font, error) {
 	// Check cache with read lock
@@ -91,6 +91,16 @@ func TestLoadFont_NonexistentFont(t *testing.T) {
 	}
 }
 
+// TestFontExists tests that only fonts that can be loaded without a fallback exist
+func TestFontExists(t *testing.T) {
+	if !FontExists(FontNamePixel5x7) {
+		t.Errorf("FontExists(%q) = false, want true", FontNamePixel5x7)
+	}
+	if FontExists("definitely_not_a_real_font_file_xyz123.ttf") {
+		t.Error("FontExists() = true for a missing font")
+	}
+}
+
 // TestResolveFontPath tests font path resolution
 func TestResolveFontPath(t *testing.T) {
 	tests := []struct {
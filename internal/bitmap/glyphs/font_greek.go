@@ -0,0 +1,1112 @@
+package glyphs
+
+// init adds Greek glyphs to Font5x7 and Font3x5
+func init() {
+	// Add Greek glyphs to Font5x7
+	for r, g := range greek5x7 {
+		Font5x7.Glyphs[r] = g
+	}
+
+	// Add Greek glyphs to Font3x5
+	for r, g := range greek3x5 {
+		Font3x5.Glyphs[r] = g
+	}
+}
+
+// greek5x7 contains Greek letters for 5x7 font
+// Includes the final sigma (ς)
+var greek5x7 = map[rune]*Glyph{
+	// Greek uppercase Α-Ω
+
+	'Α': { // Alpha
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, true, true, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'Β': { // Beta
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, true, true, false},
+		},
+	},
+	'Γ': { // Gamma
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+		},
+	},
+	'Δ': { // Delta
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, true, false, true, false},
+			{false, true, false, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, true, true, true},
+		},
+	},
+	'Ε': { // Epsilon
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{true, true, true, true, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{true, true, true, true, true},
+		},
+	},
+	'Ζ': { // Zeta
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{false, false, false, false, true},
+			{false, false, false, true, false},
+			{false, false, true, false, false},
+			{false, true, false, false, false},
+			{true, false, false, false, false},
+			{true, true, true, true, true},
+		},
+	},
+	'Η': { // Eta
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, true, true, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'Θ': { // Theta
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, true, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, true, true, false},
+		},
+	},
+	'Ι': { // Iota
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, true, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, true, true, true, false},
+		},
+	},
+	'Κ': { // Kappa
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, false, false, true},
+			{true, false, false, true, false},
+			{true, false, true, false, false},
+			{true, true, false, false, false},
+			{true, false, true, false, false},
+			{true, false, false, true, false},
+			{true, false, false, false, true},
+		},
+	},
+	'Λ': { // Lambda
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, true, false, true, false},
+			{false, true, false, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'Μ': { // Mu
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, false, false, true},
+			{true, true, false, true, true},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'Ν': { // Nu
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, false, false, true},
+			{true, false, true, false, true},
+			{true, false, false, true, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'Ξ': { // Xi
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{false, true, true, true, false},
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{true, true, true, true, true},
+		},
+	},
+	'Ο': { // Omicron
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, true, true, false},
+		},
+	},
+	'Π': { // Pi
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'Ρ': { // Rho
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, true, true, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+		},
+	},
+	'Σ': { // Sigma
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{true, false, false, false, false},
+			{false, true, false, false, false},
+			{false, false, true, false, false},
+			{false, true, false, false, false},
+			{true, false, false, false, false},
+			{true, true, true, true, true},
+		},
+	},
+	'Τ': { // Tau
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+		},
+	},
+	'Υ': { // Upsilon
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, false, true, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+		},
+	},
+	'Φ': { // Phi
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, true, false, false},
+			{false, true, true, true, false},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{false, true, true, true, false},
+			{false, false, true, false, false},
+		},
+	},
+	'Χ': { // Chi
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, false, true, false},
+			{false, false, true, false, false},
+			{false, true, false, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'Ψ': { // Psi
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{false, true, true, true, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+		},
+	},
+	'Ω': { // Omega
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, false, true, false},
+			{false, true, false, true, false},
+			{true, true, false, true, true},
+		},
+	},
+
+	// Greek lowercase α-ω
+
+	'α': { // alpha, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{false, true, true, false, true},
+			{true, false, false, true, false},
+			{true, false, false, true, false},
+			{true, false, false, true, false},
+			{false, true, true, false, true},
+		},
+	},
+	'β': { // beta, ascender and descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, false, false},
+			{true, false, false, true, false},
+			{true, true, true, false, false},
+			{true, false, false, true, false},
+			{true, false, false, true, false},
+			{true, true, true, false, false},
+			{true, false, false, false, false},
+		},
+	},
+	'γ': { // gamma, descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, false, true, false},
+			{false, true, false, true, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+		},
+	},
+	'δ': { // delta, ascender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, true, false},
+			{false, true, false, false, false},
+			{false, false, true, false, false},
+			{false, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, true, true, false},
+		},
+	},
+	'ε': { // epsilon, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{false, true, true, true, false},
+			{true, false, false, false, false},
+			{false, true, true, false, false},
+			{true, false, false, false, false},
+			{false, true, true, true, false},
+		},
+	},
+	'ζ': { // zeta, ascender and descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{false, false, false, true, false},
+			{false, false, true, false, false},
+			{false, true, false, false, false},
+			{true, false, false, false, false},
+			{false, true, true, true, false},
+			{false, false, false, false, true},
+		},
+	},
+	'η': { // eta, descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{true, false, true, true, false},
+			{true, true, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, false, false, false, true},
+		},
+	},
+	'θ': { // theta, ascender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, true, true, false, false},
+			{true, false, false, true, false},
+			{true, false, false, true, false},
+			{true, true, true, true, false},
+			{true, false, false, true, false},
+			{true, false, false, true, false},
+			{false, true, true, false, false},
+		},
+	},
+	'ι': { // iota, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{false, true, false, false, false},
+			{false, true, false, false, false},
+			{false, true, false, false, false},
+			{false, true, false, false, false},
+			{false, false, true, true, false},
+		},
+	},
+	'κ': { // kappa, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{true, false, false, true, false},
+			{true, false, true, false, false},
+			{true, true, false, false, false},
+			{true, false, true, false, false},
+			{true, false, false, true, false},
+		},
+	},
+	'λ': { // lambda, ascender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, false, false, false, false},
+			{false, true, false, false, false},
+			{false, true, false, false, false},
+			{false, false, true, false, false},
+			{false, true, false, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+		},
+	},
+	'μ': { // mu, descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, true, true},
+			{true, true, true, false, true},
+			{true, false, false, false, false},
+		},
+	},
+	'ν': { // nu, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, false, true, false},
+			{false, true, false, true, false},
+			{false, false, true, false, false},
+		},
+	},
+	'ξ': { // xi, ascender and descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{true, true, true, true, true},
+			{false, true, false, false, false},
+			{false, false, true, true, false},
+			{false, true, false, false, false},
+			{true, false, false, false, false},
+			{false, true, true, true, false},
+			{false, false, false, false, true},
+		},
+	},
+	'ο': { // omicron, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{false, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, true, true, false},
+		},
+	},
+	'π': { // pi, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{true, true, true, true, true},
+			{false, true, false, true, false},
+			{false, true, false, true, false},
+			{false, true, false, true, false},
+			{false, true, false, false, true},
+		},
+	},
+	'ρ': { // rho, descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, true, true, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, true, true, true, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+		},
+	},
+	'σ': { // sigma, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{false, true, true, true, true},
+			{true, false, false, true, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, true, true, false},
+		},
+	},
+	'ς': { // final sigma, descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, true, true, true, false},
+			{true, false, false, false, false},
+			{true, false, false, false, false},
+			{false, true, true, true, false},
+			{false, false, false, false, true},
+			{false, false, true, true, false},
+		},
+	},
+	'τ': { // tau, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{true, true, true, true, true},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+			{false, false, false, true, true},
+		},
+	},
+	'υ': { // upsilon, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{true, false, false, false, true},
+			{false, true, true, true, false},
+		},
+	},
+	'φ': { // phi, ascender and descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, true, false, false},
+			{false, true, true, true, false},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{false, true, true, true, false},
+			{false, false, true, false, false},
+		},
+	},
+	'χ': { // chi, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{true, false, false, false, true},
+			{false, true, false, true, false},
+			{false, false, true, false, false},
+			{false, true, false, true, false},
+			{true, false, false, false, true},
+		},
+	},
+	'ψ': { // psi, descender
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{false, true, true, true, false},
+			{false, false, true, false, false},
+			{false, false, true, false, false},
+		},
+	},
+	'ω': { // omega, x-height
+		Width: 5, Height: 7,
+		Data: [][]bool{
+			{false, false, false, false, false},
+			{false, false, false, false, false},
+			{false, true, false, true, false},
+			{true, false, false, false, true},
+			{true, false, true, false, true},
+			{true, false, true, false, true},
+			{false, true, false, true, false},
+		},
+	},
+}
+
+// greek3x5 contains Greek letters for 3x5 font
+// Includes the final sigma (ς)
+var greek3x5 = map[rune]*Glyph{
+	// Greek uppercase Α-Ω (compact 3x5)
+
+	'Α': { // Alpha
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{true, false, true},
+			{true, true, true},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'Β': { // Beta
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, false},
+			{true, false, true},
+			{true, true, false},
+			{true, false, true},
+			{true, true, false},
+		},
+	},
+	'Γ': { // Gamma
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{true, false, false},
+			{true, false, false},
+			{true, false, false},
+			{true, false, false},
+		},
+	},
+	'Δ': { // Delta
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+			{true, true, true},
+		},
+	},
+	'Ε': { // Epsilon
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{true, false, false},
+			{true, true, false},
+			{true, false, false},
+			{true, true, true},
+		},
+	},
+	'Ζ': { // Zeta
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{false, false, true},
+			{false, true, false},
+			{true, false, false},
+			{true, true, true},
+		},
+	},
+	'Η': { // Eta
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, true},
+			{true, false, true},
+			{true, true, true},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'Θ': { // Theta
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{true, false, true},
+			{true, true, true},
+			{true, false, true},
+			{false, true, false},
+		},
+	},
+	'Ι': { // Iota
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{false, true, false},
+			{false, true, false},
+			{false, true, false},
+			{true, true, true},
+		},
+	},
+	'Κ': { // Kappa
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, true},
+			{true, false, true},
+			{true, true, false},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'Λ': { // Lambda
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'Μ': { // Mu
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, true},
+			{true, true, true},
+			{true, true, true},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'Ν': { // Nu
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, true},
+			{true, true, true},
+			{true, true, true},
+			{true, true, true},
+			{true, false, true},
+		},
+	},
+	'Ξ': { // Xi
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{false, false, false},
+			{true, true, true},
+			{false, false, false},
+			{true, true, true},
+		},
+	},
+	'Ο': { // Omicron
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+		},
+	},
+	'Π': { // Pi
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{true, false, true},
+			{true, false, true},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'Ρ': { // Rho
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, false},
+			{true, false, true},
+			{true, true, false},
+			{true, false, false},
+			{true, false, false},
+		},
+	},
+	'Σ': { // Sigma
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{true, false, false},
+			{false, true, false},
+			{true, false, false},
+			{true, true, true},
+		},
+	},
+	'Τ': { // Tau
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{false, true, false},
+			{false, true, false},
+			{false, true, false},
+			{false, true, false},
+		},
+	},
+	'Υ': { // Upsilon
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+			{false, true, false},
+			{false, true, false},
+		},
+	},
+	'Φ': { // Phi
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{true, true, true},
+			{true, true, true},
+			{false, true, false},
+			{false, true, false},
+		},
+	},
+	'Χ': { // Chi
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'Ψ': { // Psi
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, true},
+			{true, false, true},
+			{true, true, true},
+			{false, true, false},
+			{false, true, false},
+		},
+	},
+	'Ω': { // Omega
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+			{true, false, true},
+		},
+	},
+
+	// Greek lowercase α-ω (compact 3x5)
+
+	'α': { // alpha, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, true},
+			{true, false, true},
+			{true, false, true},
+			{false, true, true},
+		},
+	},
+	'β': { // beta, ascender
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{true, false, true},
+			{true, true, false},
+			{true, false, true},
+			{true, true, false},
+		},
+	},
+	'γ': { // gamma, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+			{false, true, false},
+		},
+	},
+	'δ': { // delta, ascender
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, true},
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+		},
+	},
+	'ε': { // epsilon, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, true},
+			{true, true, false},
+			{true, false, false},
+			{false, true, true},
+		},
+	},
+	'ζ': { // zeta, ascender
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{false, true, false},
+			{true, false, false},
+			{false, true, true},
+			{false, false, true},
+		},
+	},
+	'η': { // eta, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, true, false},
+			{true, false, true},
+			{true, false, true},
+			{false, false, true},
+		},
+	},
+	'θ': { // theta, ascender
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, true, false},
+			{true, false, true},
+			{true, true, true},
+			{true, false, true},
+			{false, true, false},
+		},
+	},
+	'ι': { // iota, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, false},
+			{false, true, false},
+			{false, true, false},
+			{false, false, true},
+		},
+	},
+	'κ': { // kappa, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{true, true, false},
+			{true, true, false},
+			{true, false, true},
+		},
+	},
+	'λ': { // lambda, ascender
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, false, false},
+			{false, true, false},
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'μ': { // mu, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{true, false, true},
+			{true, true, true},
+			{true, false, false},
+		},
+	},
+	'ν': { // nu, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+			{false, true, false},
+		},
+	},
+	'ξ': { // xi, ascender
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{true, true, true},
+			{false, true, false},
+			{true, true, false},
+			{true, false, false},
+			{false, true, true},
+		},
+	},
+	'ο': { // omicron, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, false},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+		},
+	},
+	'π': { // pi, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, true, true},
+			{true, false, true},
+			{true, false, true},
+			{true, false, true},
+		},
+	},
+	'ρ': { // rho, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, false},
+			{true, false, true},
+			{true, true, false},
+			{true, false, false},
+		},
+	},
+	'σ': { // sigma, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, true},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+		},
+	},
+	'ς': { // final sigma, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, true},
+			{true, false, false},
+			{false, true, false},
+			{false, false, true},
+		},
+	},
+	'τ': { // tau, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, true, true},
+			{false, true, false},
+			{false, true, false},
+			{false, false, true},
+		},
+	},
+	'υ': { // upsilon, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{true, false, true},
+			{true, false, true},
+			{false, true, false},
+		},
+	},
+	'φ': { // phi, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{false, true, false},
+			{true, true, true},
+			{true, true, true},
+			{false, true, false},
+		},
+	},
+	'χ': { // chi, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{false, true, false},
+			{false, true, false},
+			{true, false, true},
+		},
+	},
+	'ψ': { // psi, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{true, true, true},
+			{false, true, false},
+			{false, true, false},
+		},
+	},
+	'ω': { // omega, x-height
+		Width: 3, Height: 5,
+		Data: [][]bool{
+			{false, false, false},
+			{true, false, true},
+			{true, false, true},
+			{true, true, true},
+			{false, true, false},
+		},
+	},
+}
@@ -554,6 +554,35 @@ func TestCommonIcons_Dimensions(t *testing.T) {
 	}
 }
 
+func TestEmojiIcons_Dimensions(t *testing.T) {
+	if len(Emoji8x8.Glyphs) < 50 {
+		t.Errorf("Emoji8x8 has %d glyphs, expected at least 50", len(Emoji8x8.Glyphs))
+	}
+	if GetGlyph(Emoji8x8, EmojiFallback) == nil {
+		t.Error("Emoji8x8 has no fallback glyph")
+	}
+
+	for r, g := range Emoji8x8.Glyphs {
+		if g == nil {
+			t.Errorf("Emoji %q has a nil glyph", r)
+			continue
+		}
+		if g.Width != 8 || g.Height != 8 || len(g.Data) != 8 {
+			t.Errorf("Emoji %q is %dx%d with %d rows, expected 8x8", r, g.Width, g.Height, len(g.Data))
+		}
+		for row, data := range g.Data {
+			if len(data) != g.Width {
+				t.Errorf("Emoji %q row %d has %d cols, expected %d", r, row, len(data), g.Width)
+			}
+		}
+	}
+
+	// Color variants reuse the base glyph
+	if Emoji8x8.Glyphs['💙'] != Emoji8x8.Glyphs['❤'] {
+		t.Error("Blue heart should alias the red heart glyph")
+	}
+}
+
 func TestDrawText_RealFont(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,922 @@
+package glyphs
+
+// EmojiFallback is the emoji set glyph drawn for emoji that have no glyph of their own
+const EmojiFallback = '▫'
+
+// Emoji8x8 contains monochrome stand-ins for the most common emoji at 8x8 resolution.
+// Fonts usually have no emoji, so they are drawn from this set instead.
+var Emoji8x8 = &GlyphSet{
+	Name:        "emoji_8x8",
+	GlyphWidth:  8,
+	GlyphHeight: 8,
+	Glyphs: map[rune]*Glyph{
+		// Grinning face
+		'😀': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, true, true, true, true, false, true},     // Row 4: # #### #
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Smiling face with open mouth
+		'😃': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, true, false, false, true, false, true},   // Row 3: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, true, true, true, false, true},     // Row 5: # #### #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Beaming face with smiling eyes
+		'😁': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, true, false, true, true, false, true, true},     // Row 3: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, true, true, true, false, true},     // Row 5: # #### #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Face with tears of joy
+		'😂': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, true, true, true, true, false, true},     // Row 4: # #### #
+				{true, true, false, true, true, false, true, true},     // Row 5: ## ## ##
+				{true, true, false, false, false, false, true, true},   // Row 6: ##    ##
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Rolling on the floor laughing
+		'🤣': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, true, true, false, false},  // Row 0:    ###
+				{false, false, true, false, false, false, true, false}, // Row 1:   #   #
+				{false, true, false, true, false, true, false, true},   // Row 2:  # # # #
+				{true, false, false, true, false, true, false, true},   // Row 3: #  # # #
+				{true, false, true, false, false, false, true, true},   // Row 4: # #   ##
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Grinning squinting face
+		'😆': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, true, true, false, false, true},   // Row 3: #  ##  #
+				{true, false, true, false, false, true, false, true},   // Row 4: # #  # #
+				{true, false, true, true, true, true, false, true},     // Row 5: # #### #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Grinning face with sweat
+		'😅': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, true},    // Row 0:   #### #
+				{false, true, false, false, false, false, true, true},  // Row 1:  #    ##
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, true, false, true, true, false, true, true},     // Row 3: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, true, true, true, false, true},     // Row 5: # #### #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Smiling face with smiling eyes
+		'😊': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, true, false, false, false, false, true, true},   // Row 4: ##    ##
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Slightly smiling face
+		'🙂': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Upside-down face
+		'🙃': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, true, true, false, true, false},   // Row 1:  # ## #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Winking face
+		'😉': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, false, false, true},  // Row 2: # #    #
+				{true, false, false, false, true, true, false, true},   // Row 3: #   ## #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Smiling face with heart-eyes
+		'😍': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, true, false, true, true, false, true, true},     // Row 3: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, true, true, true, false, true},     // Row 5: # #### #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Smiling face with hearts
+		'🥰': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{true, false, true, true, true, true, false, true},     // Row 0: # #### #
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, true, false, false, false, false, true, true},   // Row 4: ##    ##
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{true, false, true, true, true, true, false, true},     // Row 7: # #### #
+			},
+		},
+		// Face blowing a kiss
+		'😘': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, false, true, true},   // Row 2: # #   ##
+				{true, false, false, false, false, true, false, true},  // Row 3: #    # #
+				{true, false, false, false, true, false, false, true},  // Row 4: #   #  #
+				{true, false, false, true, false, false, true, true},   // Row 5: #  #  ##
+				{false, true, false, false, false, false, true, true},  // Row 6:  #    ##
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Face savoring food
+		'😋': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, true, true, true, true, false, true},     // Row 4: # #### #
+				{true, false, false, false, true, true, false, true},   // Row 5: #   ## #
+				{false, true, false, false, true, true, false, false},  // Row 6:  #  ##
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Smiling face with sunglasses
+		'😎': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, true, true, true, true, true, true},       // Row 2: ########
+				{true, false, true, true, false, true, true, true},     // Row 3: # ## ###
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Thinking face
+		'🤔': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, false, true, true, true, false, true},    // Row 4: #  ### #
+				{true, true, false, false, false, false, false, true},  // Row 5: ##     #
+				{true, true, true, false, false, false, true, false},   // Row 6: ###   #
+				{false, true, false, true, true, true, false, false},   // Row 7:  # ###
+			},
+		},
+		// Neutral face
+		'😐': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, true, false, false, true, false, true},   // Row 3: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, true, true, true, false, true},     // Row 5: # #### #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Expressionless face
+		'😑': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, false, false, false, false, false, true}, // Row 2: #      #
+				{true, true, false, true, true, false, true, true},     // Row 3: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, true, true, true, false, true},     // Row 5: # #### #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Smirking face
+		'😏': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, true, false, true, true, true},     // Row 2: # ## ###
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, false, false, false, true, false, true},  // Row 4: #    # #
+				{true, false, true, true, true, false, false, true},    // Row 5: # ###  #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Unamused face
+		'😒': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, true, false, true, true, true},     // Row 2: # ## ###
+				{true, false, true, false, false, true, false, true},   // Row 3: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, false, true, true, true, false, true},    // Row 5: #  ### #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Face with rolling eyes
+		'🙄': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, true, false, false, true, true, false},   // Row 1:  ##  ##
+				{true, true, false, true, true, false, false, true},    // Row 2: ## ##  #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, false, true, true, true, false, true},    // Row 5: #  ### #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Pensive face
+		'😔': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, false, false, false, true, true},   // Row 2: ##    ##
+				{true, false, true, true, false, true, false, true},    // Row 3: # ## # #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Crying face
+		'😢': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, true, false, false, false, false, true},  // Row 3: # #    #
+				{true, false, true, false, false, false, false, true},  // Row 4: # #    #
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, true, false, true, false, false},  // Row 6:  # # #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Loudly crying face
+		'😭': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, true, false, true, true, false, true, true},     // Row 3: ## ## ##
+				{true, true, false, false, false, false, true, true},   // Row 4: ##    ##
+				{true, true, false, true, true, false, true, true},     // Row 5: ## ## ##
+				{true, true, false, true, true, false, true, true},     // Row 6: ## ## ##
+				{false, true, true, true, true, true, true, false},     // Row 7:  ######
+			},
+		},
+		// Pleading face
+		'🥺': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, true, false, true, true, true},     // Row 2: # ## ###
+				{true, false, true, true, false, true, true, true},     // Row 3: # ## ###
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Face with open mouth
+		'😮': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, false, true, true, false, false, true},   // Row 4: #  ##  #
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Face screaming in fear
+		'😱': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{true, false, true, true, true, true, false, true},   // Row 0: # #### #
+				{true, true, false, false, false, false, true, true}, // Row 1: ##    ##
+				{true, false, true, false, false, true, false, true}, // Row 2: # #  # #
+				{true, false, true, false, false, true, false, true}, // Row 3: # #  # #
+				{true, false, false, true, true, false, false, true}, // Row 4: #  ##  #
+				{true, false, true, false, false, true, false, true}, // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false}, // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false}, // Row 7:   ####
+			},
+		},
+		// Flushed face
+		'😳': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, true, false, true, true, false, true, true},     // Row 3: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Grimacing face
+		'😬': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, true, true, true, true, true, true, true},       // Row 4: ########
+				{true, false, true, false, true, false, true, true},    // Row 5: # # # ##
+				{true, true, true, true, true, true, true, true},       // Row 6: ########
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Pouting face
+		'😡': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, true, false, false, true, true, false},   // Row 1:  ##  ##
+				{true, false, false, true, true, false, false, true},   // Row 2: #  ##  #
+				{true, false, true, false, false, true, false, true},   // Row 3: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Sleeping face
+		'😴': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, true},    // Row 0:   #### #
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, true, false, true, true, false, true, true},     // Row 2: ## ## ##
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, false, true, true, false, false, true},   // Row 5: #  ##  #
+				{false, true, false, false, false, false, true, false}, // Row 6:  #    #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Smiling face with halo
+		'😇': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, true, true, true, true, true, true, false},     // Row 0:  ######
+				{false, false, true, true, true, true, false, false},   // Row 1:   ####
+				{false, true, false, false, false, false, true, false}, // Row 2:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 3: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 4: #      #
+				{true, false, true, false, false, true, false, true},   // Row 5: # #  # #
+				{false, true, false, true, true, false, true, false},   // Row 6:  # ## #
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Thumbs up
+		'👍': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, false, false, false, false}, // Row 0:    #
+				{false, false, true, true, false, false, false, false},  // Row 1:   ##
+				{false, false, true, true, false, false, false, false},  // Row 2:   ##
+				{true, true, true, true, true, true, false, false},      // Row 3: ######
+				{true, true, true, true, true, true, true, false},       // Row 4: #######
+				{true, true, true, true, true, true, false, false},      // Row 5: ######
+				{true, true, true, true, true, true, true, false},       // Row 6: #######
+				{false, true, true, true, true, true, false, false},     // Row 7:  #####
+			},
+		},
+		// Thumbs down
+		'👎': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, true, true, true, true, true, false, false},     // Row 0:  #####
+				{true, true, true, true, true, true, true, false},       // Row 1: #######
+				{true, true, true, true, true, true, false, false},      // Row 2: ######
+				{true, true, true, true, true, true, true, false},       // Row 3: #######
+				{true, true, true, true, true, true, false, false},      // Row 4: ######
+				{false, false, true, true, false, false, false, false},  // Row 5:   ##
+				{false, false, true, true, false, false, false, false},  // Row 6:   ##
+				{false, false, false, true, false, false, false, false}, // Row 7:    #
+			},
+		},
+		// OK hand
+		'👌': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, false, true, false, true},  // Row 0:    # # #
+				{false, false, true, true, false, true, false, true},   // Row 1:   ## # #
+				{false, true, false, false, true, true, true, true},    // Row 2:  #  ####
+				{false, true, false, false, true, true, true, true},    // Row 3:  #  ####
+				{false, false, true, true, true, true, true, true},     // Row 4:   ######
+				{false, false, false, true, true, true, true, true},    // Row 5:    #####
+				{false, false, false, true, true, true, true, false},   // Row 6:    ####
+				{false, false, false, false, true, true, false, false}, // Row 7:     ##
+			},
+		},
+		// Clapping hands
+		'👏': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{true, false, false, false, true, false, false, false}, // Row 0: #   #
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{false, false, false, true, true, false, false, false}, // Row 2:    ##
+				{false, false, true, true, true, true, false, false},   // Row 3:   ####
+				{false, true, true, true, true, true, true, false},     // Row 4:  ######
+				{false, true, true, true, true, true, true, false},     // Row 5:  ######
+				{false, false, true, true, true, true, false, false},   // Row 6:   ####
+				{false, false, false, true, true, false, false, false}, // Row 7:    ##
+			},
+		},
+		// Folded hands
+		'🙏': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, true, false, false, false}, // Row 0:    ##
+				{false, false, true, true, true, true, false, false},   // Row 1:   ####
+				{false, false, true, true, true, true, false, false},   // Row 2:   ####
+				{false, true, true, true, true, true, true, false},     // Row 3:  ######
+				{false, true, true, true, true, true, true, false},     // Row 4:  ######
+				{false, true, true, true, true, true, true, false},     // Row 5:  ######
+				{true, true, true, true, true, true, true, false},      // Row 6: #######
+				{true, true, false, false, false, false, true, true},   // Row 7: ##    ##
+			},
+		},
+		// Flexed biceps
+		'💪': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, false, false, false}, // Row 0:   ###
+				{false, true, false, true, true, false, false, false}, // Row 1:  # ##
+				{false, true, false, true, true, false, false, false}, // Row 2:  # ##
+				{false, false, false, true, true, true, true, true},   // Row 3:    #####
+				{false, false, true, true, true, true, true, true},    // Row 4:   ######
+				{false, true, true, true, true, true, true, true},     // Row 5:  #######
+				{false, true, true, true, true, true, true, false},    // Row 6:  ######
+				{false, false, true, true, true, true, false, false},  // Row 7:   ####
+			},
+		},
+		// Waving hand
+		'👋': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, true, false, true, false, true, false, false}, // Row 0:  # # #
+				{false, true, false, true, false, true, false, false}, // Row 1:  # # #
+				{false, true, false, true, false, true, true, false},  // Row 2:  # # ##
+				{false, true, true, true, true, true, false, true},    // Row 3:  ##### #
+				{false, true, true, true, true, true, true, false},    // Row 4:  ######
+				{false, true, true, true, true, true, false, false},   // Row 5:  #####
+				{false, false, true, true, true, false, false, false}, // Row 6:   ###
+				{false, false, true, true, true, false, false, false}, // Row 7:   ###
+			},
+		},
+		// Backhand index pointing right
+		'👉': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false}, // Row 0:
+				{false, false, true, true, true, true, true, true},       // Row 1:   ######
+				{false, true, true, true, true, true, false, false},      // Row 2:  #####
+				{true, true, true, true, true, true, true, false},        // Row 3: #######
+				{true, true, true, true, true, true, false, false},       // Row 4: ######
+				{true, true, true, true, true, false, false, false},      // Row 5: #####
+				{false, true, true, true, false, false, false, false},    // Row 6:  ###
+				{false, false, false, false, false, false, false, false}, // Row 7:
+			},
+		},
+		// Eyes
+		'👀': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false}, // Row 0:
+				{false, true, true, false, false, true, true, false},     // Row 1:  ##  ##
+				{true, false, false, true, true, false, false, true},     // Row 2: #  ##  #
+				{true, false, true, true, false, true, false, true},      // Row 3: # ## # #
+				{true, false, true, true, false, true, false, true},      // Row 4: # ## # #
+				{true, false, false, true, true, false, false, true},     // Row 5: #  ##  #
+				{false, true, true, false, false, true, true, false},     // Row 6:  ##  ##
+				{false, false, false, false, false, false, false, false}, // Row 7:
+			},
+		},
+		// Red heart
+		'❤': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false}, // Row 0:
+				{false, true, true, false, false, true, true, false},     // Row 1:  ##  ##
+				{true, true, true, true, true, true, true, true},         // Row 2: ########
+				{true, true, true, true, true, true, true, true},         // Row 3: ########
+				{false, true, true, true, true, true, true, false},       // Row 4:  ######
+				{false, false, true, true, true, true, false, false},     // Row 5:   ####
+				{false, false, false, true, true, false, false, false},   // Row 6:    ##
+				{false, false, false, false, false, false, false, false}, // Row 7:
+			},
+		},
+		// Broken heart
+		'💔': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false}, // Row 0:
+				{false, true, true, false, false, true, true, false},     // Row 1:  ##  ##
+				{true, true, true, true, false, true, true, true},        // Row 2: #### ###
+				{true, true, true, false, true, true, true, true},        // Row 3: ### ####
+				{false, true, true, true, false, true, true, false},      // Row 4:  ### ##
+				{false, false, true, false, true, true, false, false},    // Row 5:   # ##
+				{false, false, false, true, true, false, false, false},   // Row 6:    ##
+				{false, false, false, false, false, false, false, false}, // Row 7:
+			},
+		},
+		// Two hearts
+		'💕': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, true, false, true, false, false, false, false},   // Row 0:  # #
+				{true, true, true, true, true, false, false, false},      // Row 1: #####
+				{false, true, true, true, false, true, false, true},      // Row 2:  ### # #
+				{false, false, true, false, true, true, true, true},      // Row 3:   # ####
+				{false, false, false, false, true, true, true, false},    // Row 4:     ###
+				{false, false, false, false, false, true, false, false},  // Row 5:      #
+				{false, false, false, false, false, false, false, false}, // Row 6:
+				{false, false, false, false, false, false, false, false}, // Row 7:
+			},
+		},
+		// Fire
+		'🔥': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, false, false, false, false}, // Row 0:    #
+				{false, false, false, true, true, false, false, false},  // Row 1:    ##
+				{false, false, true, true, true, false, true, false},    // Row 2:   ### #
+				{false, true, true, true, true, true, false, false},     // Row 3:  #####
+				{false, true, true, true, true, true, true, false},      // Row 4:  ######
+				{true, true, true, false, false, true, true, true},      // Row 5: ###  ###
+				{true, true, false, false, false, false, true, true},    // Row 6: ##    ##
+				{false, true, true, true, true, true, true, false},      // Row 7:  ######
+			},
+		},
+		// Sparkles
+		'✨': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, false, false, false, false}, // Row 0:    #
+				{false, false, false, true, false, false, false, false}, // Row 1:    #
+				{false, true, true, true, true, true, false, false},     // Row 2:  #####
+				{false, false, false, true, false, false, true, false},  // Row 3:    #  #
+				{false, false, false, true, false, true, true, true},    // Row 4:    # ###
+				{false, false, false, false, false, false, true, false}, // Row 5:       #
+				{false, true, false, false, false, false, false, false}, // Row 6:  #
+				{true, true, true, false, false, false, false, false},   // Row 7: ###
+			},
+		},
+		// Star
+		'⭐': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, true, false, false, false}, // Row 0:    ##
+				{false, false, false, true, true, false, false, false}, // Row 1:    ##
+				{true, true, true, true, true, true, true, true},       // Row 2: ########
+				{false, true, true, true, true, true, true, false},     // Row 3:  ######
+				{false, false, true, true, true, true, false, false},   // Row 4:   ####
+				{false, true, true, true, true, true, true, false},     // Row 5:  ######
+				{false, true, true, false, false, true, true, false},   // Row 6:  ##  ##
+				{false, true, false, false, false, false, true, false}, // Row 7:  #    #
+			},
+		},
+		// Party popper
+		'🎉': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, true, false, false, true, false, false, true},  // Row 0:  #  #  #
+				{false, false, false, true, false, false, true, false}, // Row 1:    #  #
+				{true, false, true, false, false, true, false, false},  // Row 2: # #  #
+				{false, true, false, true, false, false, false, false}, // Row 3:  # #
+				{false, true, true, true, false, true, false, true},    // Row 4:  ### # #
+				{true, true, true, true, true, false, false, false},    // Row 5: #####
+				{true, true, true, true, false, false, true, false},    // Row 6: ####  #
+				{true, true, true, false, false, false, false, false},  // Row 7: ###
+			},
+		},
+		// Birthday cake
+		'🎂': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, true, false, true, false, true, false, false},  // Row 0:  # # #
+				{false, true, false, true, false, true, false, false},  // Row 1:  # # #
+				{true, true, true, true, true, true, true, true},       // Row 2: ########
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, true, true, true, true, true, true, true},       // Row 4: ########
+				{true, false, false, false, false, false, false, true}, // Row 5: #      #
+				{true, false, false, false, false, false, false, true}, // Row 6: #      #
+				{true, true, true, true, true, true, true, true},       // Row 7: ########
+			},
+		},
+		// Hundred points
+		'💯': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{true, false, true, true, false, true, true, false},      // Row 0: # ## ##
+				{true, true, false, false, true, false, false, true},     // Row 1: ##  #  #
+				{true, false, true, false, true, true, false, true},      // Row 2: # # ## #
+				{true, false, true, false, true, true, false, true},      // Row 3: # # ## #
+				{true, false, true, false, true, true, false, true},      // Row 4: # # ## #
+				{true, false, false, true, false, false, true, false},    // Row 5: #  #  #
+				{false, false, false, false, false, false, false, false}, // Row 6:
+				{true, true, true, true, true, true, true, true},         // Row 7: ########
+			},
+		},
+		// Check mark button
+		'✅': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{true, true, true, true, true, true, true, true},       // Row 0: ########
+				{true, false, false, false, false, false, false, true}, // Row 1: #      #
+				{true, false, false, false, false, false, true, true},  // Row 2: #     ##
+				{true, false, false, false, false, true, false, true},  // Row 3: #    # #
+				{true, true, false, false, true, false, false, true},   // Row 4: ##  #  #
+				{true, false, true, true, false, false, false, true},   // Row 5: # ##   #
+				{true, false, false, true, false, false, false, true},  // Row 6: #  #   #
+				{true, true, true, true, true, true, true, true},       // Row 7: ########
+			},
+		},
+		// Cross mark
+		'❌': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{true, true, false, false, false, false, true, true}, // Row 0: ##    ##
+				{true, true, true, false, false, true, true, true},   // Row 1: ###  ###
+				{false, true, true, true, true, true, true, false},   // Row 2:  ######
+				{false, false, true, true, true, true, false, false}, // Row 3:   ####
+				{false, false, true, true, true, true, false, false}, // Row 4:   ####
+				{false, true, true, true, true, true, true, false},   // Row 5:  ######
+				{true, true, true, false, false, true, true, true},   // Row 6: ###  ###
+				{true, true, false, false, false, false, true, true}, // Row 7: ##    ##
+			},
+		},
+		// High voltage
+		'⚡': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, false, true, true, true, false},   // Row 0:     ###
+				{false, false, false, true, true, true, false, false},   // Row 1:    ###
+				{false, false, true, true, true, false, false, false},   // Row 2:   ###
+				{false, true, true, true, true, true, true, true},       // Row 3:  #######
+				{false, false, false, false, false, true, true, false},  // Row 4:      ##
+				{false, false, false, false, true, true, false, false},  // Row 5:     ##
+				{false, false, false, true, true, false, false, false},  // Row 6:    ##
+				{false, false, true, false, false, false, false, false}, // Row 7:   #
+			},
+		},
+		// Sun
+		'☀': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{true, false, false, true, false, false, false, true},   // Row 0: #  #   #
+				{false, true, false, true, false, true, false, false},   // Row 1:  # # #
+				{false, false, true, true, true, false, false, false},   // Row 2:   ###
+				{true, true, true, true, true, true, true, false},       // Row 3: #######
+				{false, false, true, true, true, false, false, false},   // Row 4:   ###
+				{false, true, false, true, false, true, false, false},   // Row 5:  # # #
+				{true, false, false, true, false, false, true, false},   // Row 6: #  #  #
+				{false, false, false, true, false, false, false, false}, // Row 7:    #
+			},
+		},
+		// Crescent moon
+		'🌙': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, false, false, false},  // Row 0:   ###
+				{false, true, true, false, false, false, false, false}, // Row 1:  ##
+				{true, true, false, false, false, false, false, false}, // Row 2: ##
+				{true, true, false, false, false, false, false, false}, // Row 3: ##
+				{true, true, false, false, false, false, false, false}, // Row 4: ##
+				{true, true, false, false, false, false, false, false}, // Row 5: ##
+				{false, true, true, false, false, false, false, false}, // Row 6:  ##
+				{false, false, true, true, true, false, false, false},  // Row 7:   ###
+			},
+		},
+		// Hot beverage
+		'☕': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, false, true, false, false, false},   // Row 0:   # #
+				{false, true, false, true, false, false, false, false},   // Row 1:  # #
+				{false, false, false, false, false, false, false, false}, // Row 2:
+				{true, true, true, true, true, true, false, false},       // Row 3: ######
+				{true, false, false, false, false, true, true, true},     // Row 4: #    ###
+				{true, false, false, false, false, true, false, true},    // Row 5: #    # #
+				{true, false, false, false, false, true, true, false},    // Row 6: #    ##
+				{false, true, true, true, true, false, false, false},     // Row 7:  ####
+			},
+		},
+		// Wrapped gift
+		'🎁': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, true, false, false, true, false, false, false}, // Row 0:  #  #
+				{false, false, true, true, false, false, false, false}, // Row 1:   ##
+				{true, true, true, true, true, true, true, true},       // Row 2: ########
+				{true, false, false, true, false, false, false, true},  // Row 3: #  #   #
+				{true, true, true, true, true, true, true, true},       // Row 4: ########
+				{true, false, false, true, false, false, false, true},  // Row 5: #  #   #
+				{true, false, false, true, false, false, false, true},  // Row 6: #  #   #
+				{true, true, true, true, true, true, true, true},       // Row 7: ########
+			},
+		},
+		// Musical note
+		'🎵': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, true, true, true, true, false},     // Row 0:    ####
+				{false, false, false, true, false, false, true, false},   // Row 1:    #  #
+				{false, false, false, true, false, false, true, false},   // Row 2:    #  #
+				{false, false, false, true, false, false, true, false},   // Row 3:    #  #
+				{false, true, true, true, false, true, true, false},      // Row 4:  ### ##
+				{true, true, true, true, false, true, true, false},       // Row 5: #### ##
+				{false, true, true, false, false, false, false, false},   // Row 6:  ##
+				{false, false, false, false, false, false, false, false}, // Row 7:
+			},
+		},
+		// Face with medical mask
+		'😷': {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, true, true, true, true, false, false},   // Row 0:   ####
+				{false, true, false, false, false, false, true, false}, // Row 1:  #    #
+				{true, false, true, false, false, true, false, true},   // Row 2: # #  # #
+				{true, false, false, false, false, false, false, true}, // Row 3: #      #
+				{true, true, true, true, true, true, true, true},       // Row 4: ########
+				{true, true, false, false, false, false, true, true},   // Row 5: ##    ##
+				{false, true, true, true, true, true, true, false},     // Row 6:  ######
+				{false, false, true, true, true, true, false, false},   // Row 7:   ####
+			},
+		},
+		// Generic emoji box
+		EmojiFallback: {
+			Width: 8, Height: 8,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false}, // Row 0:
+				{false, false, false, false, false, false, false, false}, // Row 1:
+				{false, true, true, true, true, true, true, false},       // Row 2:  ######
+				{false, true, false, false, false, false, true, false},   // Row 3:  #    #
+				{false, true, false, false, false, false, true, false},   // Row 4:  #    #
+				{false, true, false, false, false, false, true, false},   // Row 5:  #    #
+				{false, true, false, false, false, false, true, false},   // Row 6:  #    #
+				{false, true, true, true, true, true, true, false},       // Row 7:  ######
+			},
+		},
+	},
+}
+
+// emojiAliases draws emoji variants (other colors, similar faces and hands) with the glyph of a related emoji
+var emojiAliases = map[rune]rune{
+	'☑': '✅',
+	'☝': '👉',
+	'☺': '😊',
+	'♥': '❤',
+	'✌': '👋',
+	'✔': '✅',
+	'✖': '❌',
+	'❎': '❌',
+	'🌞': '☀',
+	'🌟': '⭐',
+	'🎊': '🎉',
+	'🎶': '🎵',
+	'👆': '👉',
+	'👇': '👉',
+	'👈': '👉',
+	'💓': '❤',
+	'💖': '❤',
+	'💗': '❤',
+	'💙': '❤',
+	'💚': '❤',
+	'💛': '❤',
+	'💜': '❤',
+	'💞': '💕',
+	'🖤': '❤',
+	'😄': '😁',
+	'😗': '😘',
+	'😙': '😘',
+	'😚': '😘',
+	'😛': '😋',
+	'😜': '😋',
+	'😝': '😋',
+	'😠': '😡',
+	'😤': '😡',
+	'😥': '😢',
+	'😨': '😱',
+	'😰': '😱',
+	'😿': '😢',
+	'🤍': '❤',
+	'🤎': '❤',
+	'🤝': '👏',
+	'🤩': '😍',
+	'🥳': '🎉',
+	'🧡': '❤',
+}
+
+// init adds the emoji aliases to Emoji8x8
+func init() {
+	for r, base := range emojiAliases {
+		Emoji8x8.Glyphs[r] = Emoji8x8.Glyphs[base]
+	}
+}
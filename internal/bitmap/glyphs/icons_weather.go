@@ -29,6 +29,50 @@ var WeatherIcons16x16 = &GlyphSet{
 				{false, false, false, false, false, false, false, true, true, false, false, false, false, false, false, false},
 			},
 		},
+		// Moon - clear night (crescent)
+		"moon": {
+			Width: 16, Height: 16,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, true, true, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, false, false, false, false, false, false, false, false, false, false},
+				{false, false, true, true, true, true, false, false, false, false, false, false, false, false, false, false},
+				{false, false, true, true, true, true, false, false, false, false, false, false, false, false, false, false},
+				{false, false, true, true, true, true, false, false, false, false, false, false, false, false, false, false},
+				{false, false, true, true, true, true, true, false, false, false, false, false, false, false, false, false},
+				{false, false, true, true, true, true, true, true, false, false, false, false, false, false, false, false},
+				{false, false, true, true, true, true, true, true, true, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false},
+				{false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false},
+				{false, false, false, false, false, true, true, true, true, true, true, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+			},
+		},
+		// Partly cloudy night - moon behind cloud
+		"partly_cloudy_night": {
+			Width: 16, Height: 16,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false, false, false, false, false, true, true, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, true, true, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, true, false, false, false, false},
+				{false, false, false, false, false, true, true, true, true, false, false, true, true, false, false, false},
+				{false, false, false, false, true, true, true, true, true, true, false, false, true, true, false, false},
+				{false, false, false, true, true, true, true, true, true, true, true, false, false, false, false, false},
+				{false, false, true, true, true, true, true, true, true, true, true, true, true, false, false, false},
+				{false, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false},
+				{false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false},
+				{true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true},
+				{true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true},
+				{false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+			},
+		},
 		// Cloud - overcast
 		"cloud": {
 			Width: 16, Height: 16,
@@ -729,6 +773,36 @@ var WeatherIcons24x24 = &GlyphSet{
 				{false, false, false, false, false, false, false, false, false, false, false, true, true, false, false, false, false, false, false, false, false, false, false, false},
 			},
 		},
+		// Moon - clear night (larger version)
+		"moon": {
+			Width: 24, Height: 24,
+			Data: [][]bool{
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false},
+				{false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false},
+				{false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false},
+				{false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+			},
+		},
 		// Cloud - overcast (larger version)
 		"cloud": {
 			Width: 24, Height: 24,
@@ -0,0 +1,25 @@
+package bitmap
+
+import "math"
+
+// GradientColor returns the grayscale value at position t (0-1) of a gradient,
+// interpolating linearly between evenly spaced stops. Positions outside 0-1 are
+// clamped; an empty gradient returns 0 and a single stop is a solid color.
+func GradientColor(stops []uint8, t float64) uint8 {
+	switch len(stops) {
+	case 0:
+		return 0
+	case 1:
+		return stops[0]
+	}
+
+	t = min(max(t, 0), 1)
+	pos := t * float64(len(stops)-1)
+	i := int(pos)
+	if i >= len(stops)-1 {
+		return stops[len(stops)-1]
+	}
+	frac := pos - float64(i)
+	from, to := float64(stops[i]), float64(stops[i+1])
+	return uint8(math.Round(from + (to-from)*frac))
+}
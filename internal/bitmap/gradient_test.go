@@ -0,0 +1,82 @@
+package bitmap
+
+import "testing"
+
+func TestGradientColor(t *testing.T) {
+	tests := []struct {
+		name  string
+		stops []uint8
+		t     float64
+		want  uint8
+	}{
+		{"empty", nil, 0.5, 0},
+		{"single stop", []uint8{120}, 0.9, 120},
+		{"start", []uint8{60, 255}, 0, 60},
+		{"end", []uint8{60, 255}, 1, 255},
+		{"midpoint", []uint8{0, 200}, 0.5, 100},
+		{"second segment", []uint8{0, 100, 200}, 0.75, 150},
+		{"descending", []uint8{255, 55}, 0.5, 155},
+		{"clamped below", []uint8{60, 255}, -1, 60},
+		{"clamped above", []uint8{60, 255}, 2, 255},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GradientColor(tt.stops, tt.t); got != tt.want {
+				t.Errorf("GradientColor(%v, %v) = %d, want %d", tt.stops, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDrawHorizontalBarGradient(t *testing.T) {
+	img := NewGrayscaleImage(11, 3, 0)
+	DrawHorizontalBarGradient(img, 0, 0, 11, 3, 100, []uint8{50, 250}, 255, false)
+
+	if got := img.GrayAt(0, 1).Y; got != 50 {
+		t.Errorf("left end = %d, want 50", got)
+	}
+	if got := img.GrayAt(5, 1).Y; got != 150 {
+		t.Errorf("middle = %d, want 150", got)
+	}
+	if got := img.GrayAt(10, 1).Y; got != 250 {
+		t.Errorf("right end = %d, want 250", got)
+	}
+
+	// A half-full bar keeps the colors of its position, never reaching the last stop
+	img = NewGrayscaleImage(11, 3, 0)
+	DrawHorizontalBarGradient(img, 0, 0, 11, 3, 50, []uint8{50, 250}, 255, true)
+	if got := img.GrayAt(0, 0).Y; got != 255 {
+		t.Errorf("border = %d, want 255", got)
+	}
+	if got := img.GrayAt(1, 1).Y; got != 50 {
+		t.Errorf("fill start = %d, want 50", got)
+	}
+	if got := img.GrayAt(9, 1).Y; got != 0 {
+		t.Errorf("unfilled end = %d, want 0", got)
+	}
+}
+
+func TestDrawVerticalBarGradient(t *testing.T) {
+	img := NewGrayscaleImage(3, 11, 0)
+	DrawVerticalBarGradient(img, 0, 0, 3, 11, 100, []uint8{50, 250}, 255, false)
+
+	if got := img.GrayAt(1, 10).Y; got != 50 {
+		t.Errorf("bottom = %d, want 50", got)
+	}
+	if got := img.GrayAt(1, 0).Y; got != 250 {
+		t.Errorf("top = %d, want 250", got)
+	}
+}
+
+func TestDrawGaugeGradient(t *testing.T) {
+	img := NewGrayscaleImage(50, 40, 0)
+	DrawGaugeGradient(img, 0, 0, 50, 40, 0, []uint8{40, 240}, 255, false, 150)
+
+	centerX, centerY, radius := gaugeGeometry(0, 0, 50, 40)
+	left := img.GrayAt(centerX-radius, centerY).Y
+	right := img.GrayAt(centerX+radius, centerY).Y
+	if left != 40 || right != 240 {
+		t.Errorf("arc ends = %d..%d, want 40..240", left, right)
+	}
+}
@@ -0,0 +1,282 @@
+package bitmap
+
+import (
+	"image"
+	"math"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// Grayscale render modes for converting full-color frames to the OLED palette.
+// Shared by widgets that display external imagery (DOOM, capture).
+const (
+	RenderModeNormal    = "normal"
+	RenderModeContrast  = "contrast"
+	RenderModePosterize = "posterize"
+	RenderModeThreshold = "threshold"
+	RenderModeDither    = "dither"
+	RenderModeGamma     = "gamma"
+)
+
+// RenderModeOptions holds grayscale render mode settings
+type RenderModeOptions struct {
+	Mode            string  // "normal", "contrast", "posterize", "threshold", "dither", "gamma"
+	PosterizeLevels int     // Number of gray levels for posterize mode
+	ThresholdValue  int     // Cutoff for threshold mode
+	Gamma           float64 // Gamma value for gamma mode
+	ContrastBoost   float64 // Contrast multiplier for gamma mode
+	DitherSize      int     // Bayer matrix size for dither mode
+}
+
+// NewRenderModeOptions creates render mode options from configuration.
+// Missing values get defaults, out-of-range values are clamped.
+// A nil config yields the "normal" mode with default parameters.
+func NewRenderModeOptions(cfg *config.DoomConfig) RenderModeOptions {
+	opts := RenderModeOptions{
+		Mode:            RenderModeNormal,
+		PosterizeLevels: 4,
+		ThresholdValue:  128,
+		Gamma:           1.5,
+		ContrastBoost:   1.2,
+		DitherSize:      4,
+	}
+
+	if cfg == nil {
+		return opts
+	}
+
+	if cfg.RenderMode != "" {
+		opts.Mode = cfg.RenderMode
+	}
+	if cfg.PosterizeLevels > 0 {
+		opts.PosterizeLevels = cfg.PosterizeLevels
+		if opts.PosterizeLevels < 2 {
+			opts.PosterizeLevels = 2
+		} else if opts.PosterizeLevels > 16 {
+			opts.PosterizeLevels = 16
+		}
+	}
+	if cfg.ThresholdValue > 0 {
+		opts.ThresholdValue = cfg.ThresholdValue
+		if opts.ThresholdValue > 255 {
+			opts.ThresholdValue = 255
+		}
+	}
+	if cfg.Gamma > 0 {
+		opts.Gamma = cfg.Gamma
+		if opts.Gamma < 0.1 {
+			opts.Gamma = 0.1
+		} else if opts.Gamma > 3.0 {
+			opts.Gamma = 3.0
+		}
+	}
+	if cfg.ContrastBoost > 0 {
+		opts.ContrastBoost = cfg.ContrastBoost
+		if opts.ContrastBoost < 1.0 {
+			opts.ContrastBoost = 1.0
+		} else if opts.ContrastBoost > 3.0 {
+			opts.ContrastBoost = 3.0
+		}
+	}
+	if cfg.DitherSize > 0 {
+		// Clamp to valid Bayer matrix sizes
+		if cfg.DitherSize <= 2 {
+			opts.DitherSize = 2
+		} else if cfg.DitherSize <= 4 {
+			opts.DitherSize = 4
+		} else {
+			opts.DitherSize = 8
+		}
+	}
+
+	return opts
+}
+
+// DownsampleToGray converts a color frame to a grayscale image of the given size,
+// sampling the nearest source pixel and using the standard luminance formula
+// (Y = 0.299*R + 0.587*G + 0.114*B)
+func DownsampleToGray(img image.Image, width, height int) *image.Gray {
+	grayImg := image.NewGray(image.Rect(0, 0, width, height))
+
+	bounds := img.Bounds()
+	scaleX := float64(bounds.Dx()) / float64(width)
+	scaleY := float64(bounds.Dy()) / float64(height)
+
+	for y := 0; y < height; y++ {
+		row := grayImg.Pix[y*grayImg.Stride:]
+		for x := 0; x < width; x++ {
+			srcX := bounds.Min.X + int(float64(x)*scaleX)
+			srcY := bounds.Min.Y + int(float64(y)*scaleY)
+			r, g, b, _ := img.At(srcX, srcY).RGBA()
+			row[x] = uint8((299*r + 587*g + 114*b) / 1000 / 256)
+		}
+	}
+
+	return grayImg
+}
+
+// ApplyRenderMode applies the configured render mode to a grayscale image in place.
+// Contrast and gamma modes use the min/max brightness of the whole image.
+func ApplyRenderMode(img *image.Gray, opts RenderModeOptions) {
+	if img == nil || opts.Mode == RenderModeNormal || opts.Mode == "" {
+		return
+	}
+
+	bounds := img.Bounds()
+
+	// First pass: find min/max for contrast modes
+	var minGray, maxGray uint8 = 255, 0
+	if opts.Mode == RenderModeContrast || opts.Mode == RenderModeGamma {
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			row := img.Pix[(y-bounds.Min.Y)*img.Stride : (y-bounds.Min.Y)*img.Stride+bounds.Dx()]
+			for _, gray := range row {
+				if gray < minGray {
+					minGray = gray
+				}
+				if gray > maxGray {
+					maxGray = gray
+				}
+			}
+		}
+	}
+
+	// Second pass: apply render mode
+	for y := 0; y < bounds.Dy(); y++ {
+		rowStart := y * img.Stride
+		for x := 0; x < bounds.Dx(); x++ {
+			gray := img.Pix[rowStart+x]
+			var finalGray uint8
+
+			switch opts.Mode {
+			case RenderModeContrast:
+				finalGray = applyContrast(gray, minGray, maxGray)
+			case RenderModePosterize:
+				finalGray = applyPosterize(gray, opts.PosterizeLevels)
+			case RenderModeThreshold:
+				finalGray = applyThreshold(gray, opts.ThresholdValue)
+			case RenderModeDither:
+				finalGray = applyDither(gray, x, y, opts.DitherSize)
+			case RenderModeGamma:
+				finalGray = applyGamma(gray, minGray, maxGray, opts.Gamma, opts.ContrastBoost)
+			default:
+				finalGray = gray
+			}
+
+			img.Pix[rowStart+x] = finalGray
+		}
+	}
+}
+
+// applyContrast applies auto-contrast stretching (histogram stretching)
+// Maps the actual min-max range to full 0-255 range
+func applyContrast(gray, minGray, maxGray uint8) uint8 {
+	if maxGray == minGray {
+		return gray
+	}
+	// Stretch to full range
+	stretched := float64(gray-minGray) * 255.0 / float64(maxGray-minGray)
+	if stretched > 255 {
+		return 255
+	}
+	return uint8(stretched)
+}
+
+// applyPosterize reduces the image to N discrete gray levels
+func applyPosterize(gray uint8, levels int) uint8 {
+	if levels < 2 {
+		levels = 2
+	}
+	// Quantize to N levels, then map back to 0-255
+	step := 256 / levels
+	level := int(gray) / step
+	if level >= levels {
+		level = levels - 1
+	}
+	// Map level back to 0-255 range
+	return uint8(level * 255 / (levels - 1))
+}
+
+// applyThreshold converts to pure black/white based on threshold value
+func applyThreshold(gray uint8, thresholdValue int) uint8 {
+	if int(gray) >= thresholdValue {
+		return 255
+	}
+	return 0
+}
+
+// Bayer matrices for ordered dithering, normalized to 0.0-1.0
+var (
+	bayer2 = [2][2]float64{
+		{0.0 / 4.0, 2.0 / 4.0},
+		{3.0 / 4.0, 1.0 / 4.0},
+	}
+	bayer4 = [4][4]float64{
+		{0.0 / 16.0, 8.0 / 16.0, 2.0 / 16.0, 10.0 / 16.0},
+		{12.0 / 16.0, 4.0 / 16.0, 14.0 / 16.0, 6.0 / 16.0},
+		{3.0 / 16.0, 11.0 / 16.0, 1.0 / 16.0, 9.0 / 16.0},
+		{15.0 / 16.0, 7.0 / 16.0, 13.0 / 16.0, 5.0 / 16.0},
+	}
+	bayer8 = [8][8]float64{
+		{0.0 / 64.0, 32.0 / 64.0, 8.0 / 64.0, 40.0 / 64.0, 2.0 / 64.0, 34.0 / 64.0, 10.0 / 64.0, 42.0 / 64.0},
+		{48.0 / 64.0, 16.0 / 64.0, 56.0 / 64.0, 24.0 / 64.0, 50.0 / 64.0, 18.0 / 64.0, 58.0 / 64.0, 26.0 / 64.0},
+		{12.0 / 64.0, 44.0 / 64.0, 4.0 / 64.0, 36.0 / 64.0, 14.0 / 64.0, 46.0 / 64.0, 6.0 / 64.0, 38.0 / 64.0},
+		{60.0 / 64.0, 28.0 / 64.0, 52.0 / 64.0, 20.0 / 64.0, 62.0 / 64.0, 30.0 / 64.0, 54.0 / 64.0, 22.0 / 64.0},
+		{3.0 / 64.0, 35.0 / 64.0, 11.0 / 64.0, 43.0 / 64.0, 1.0 / 64.0, 33.0 / 64.0, 9.0 / 64.0, 41.0 / 64.0},
+		{51.0 / 64.0, 19.0 / 64.0, 59.0 / 64.0, 27.0 / 64.0, 49.0 / 64.0, 17.0 / 64.0, 57.0 / 64.0, 25.0 / 64.0},
+		{15.0 / 64.0, 47.0 / 64.0, 7.0 / 64.0, 39.0 / 64.0, 13.0 / 64.0, 45.0 / 64.0, 5.0 / 64.0, 37.0 / 64.0},
+		{63.0 / 64.0, 31.0 / 64.0, 55.0 / 64.0, 23.0 / 64.0, 61.0 / 64.0, 29.0 / 64.0, 53.0 / 64.0, 21.0 / 64.0},
+	}
+)
+
+// applyDither applies ordered dithering using Bayer matrix
+func applyDither(gray uint8, x, y, ditherSize int) uint8 {
+	var threshold float64
+
+	switch ditherSize {
+	case 2:
+		threshold = bayer2[y%2][x%2]
+	case 8:
+		threshold = bayer8[y%8][x%8]
+	default: // 4x4 (default)
+		threshold = bayer4[y%4][x%4]
+	}
+
+	// Compare normalized gray value against threshold
+	normalizedGray := float64(gray) / 255.0
+	if normalizedGray > threshold {
+		return 255
+	}
+	return 0
+}
+
+// applyGamma applies gamma correction with optional contrast boost
+// First stretches contrast, then applies gamma curve
+func applyGamma(gray, minGray, maxGray uint8, gamma, contrastBoost float64) uint8 {
+	// First apply contrast stretching
+	var normalized float64
+	if maxGray == minGray {
+		normalized = float64(gray) / 255.0
+	} else {
+		normalized = float64(gray-minGray) / float64(maxGray-minGray)
+	}
+
+	// Apply contrast boost (expand around 0.5)
+	if contrastBoost > 1.0 {
+		normalized = (normalized-0.5)*contrastBoost + 0.5
+		if normalized < 0 {
+			normalized = 0
+		} else if normalized > 1 {
+			normalized = 1
+		}
+	}
+
+	// Apply gamma correction: output = input^(1/gamma)
+	// gamma > 1 brightens midtones, gamma < 1 darkens them
+	gammaCorrected := math.Pow(normalized, 1.0/gamma)
+
+	result := gammaCorrected * 255.0
+	if result > 255 {
+		return 255
+	}
+	return uint8(result)
+}
@@ -39,7 +39,45 @@ func GetInternalFontByName(name string) *glyphs.GlyphSet {
 
 // MeasureInternalText measures the width of text using the internal glyph-based font
 func MeasureInternalText(text string, glyphSet *glyphs.GlyphSet) int {
-	return glyphs.MeasureText(text, glyphSet)
+	if glyphSet == nil {
+		return 0
+	}
+	width := 0
+	for _, r := range text {
+		if glyph, _, _ := internalGlyph(glyphSet, r); glyph != nil {
+			width += glyph.Width + 1
+		}
+	}
+	// Remove trailing spacing
+	return max(0, width-1)
+}
+
+// internalGlyph returns the glyph drawn for r with an internal font and its vertical offset
+// from the text top. Runes the font lacks fall back to the emoji set, the same way
+// WithEmojiGlyphs handles TrueType faces: emoji centered on the line, flags as their
+// country code and emoji modifiers with no glyph. ok is false for runes neither covers.
+func internalGlyph(glyphSet *glyphs.GlyphSet, r rune) (glyph *glyphs.Glyph, dy int, ok bool) {
+	r = regionalLetter(r)
+	if glyph = glyphs.GetGlyph(glyphSet, r); glyph != nil {
+		return glyph, 0, true
+	}
+	glyph, ok = emojiGlyph(r)
+	if glyph == nil {
+		return nil, 0, ok
+	}
+	return glyph, (glyphSet.GlyphHeight - glyph.Height) / 2, true
+}
+
+// drawInternalText draws text with the internal font at (x, y), skipping runes the font cannot draw
+func drawInternalText(img *image.Gray, text string, x, y int, glyphSet *glyphs.GlyphSet, c color.Gray) {
+	for _, r := range text {
+		glyph, dy, _ := internalGlyph(glyphSet, r)
+		if glyph == nil {
+			continue
+		}
+		glyphs.DrawGlyph(img, glyph, x, y+dy, c)
+		x += glyph.Width + 1
+	}
 }
 
 // DrawAlignedInternalText draws text on an image with alignment and padding using internal fonts
@@ -57,7 +95,7 @@ func DrawAlignedInternalText(img *image.Gray, text string, glyphSet *glyphs.Glyp
 	contentH := height - padding*2
 
 	// Measure text
-	textWidth := glyphs.MeasureText(text, glyphSet)
+	textWidth := MeasureInternalText(text, glyphSet)
 	textHeight := glyphSet.GlyphHeight
 
 	// Calculate X position
@@ -83,11 +121,16 @@ func DrawAlignedInternalText(img *image.Gray, text string, glyphSet *glyphs.Glyp
 	}
 
 	// Draw text
-	glyphs.DrawText(img, text, x, y, glyphSet, color.Gray{Y: 255})
+	drawInternalText(img, text, x, y, glyphSet, color.Gray{Y: 255})
 }
 
 // DrawInternalTextInRect draws text within a specific rectangle with alignment using internal fonts
 func DrawInternalTextInRect(img *image.Gray, text string, glyphSet *glyphs.GlyphSet, rectX, rectY, rectW, rectH int, horizAlign config.HAlign, vertAlign config.VAlign, padding int) {
+	DrawInternalTextInRectWithColor(img, text, glyphSet, rectX, rectY, rectW, rectH, horizAlign, vertAlign, padding, 255)
+}
+
+// DrawInternalTextInRectWithColor draws internal font text within a rectangle like DrawInternalTextInRect, in the given gray level
+func DrawInternalTextInRectWithColor(img *image.Gray, text string, glyphSet *glyphs.GlyphSet, rectX, rectY, rectW, rectH int, horizAlign config.HAlign, vertAlign config.VAlign, padding int, textColor uint8) {
 	if glyphSet == nil {
 		glyphSet = glyphs.Font5x7
 	}
@@ -99,7 +142,7 @@ func DrawInternalTextInRect(img *image.Gray, text string, glyphSet *glyphs.Glyph
 	contentH := rectH - padding*2
 
 	// Measure text
-	textWidth := glyphs.MeasureText(text, glyphSet)
+	textWidth := MeasureInternalText(text, glyphSet)
 	textHeight := glyphSet.GlyphHeight
 
 	// Calculate X position
@@ -125,7 +168,7 @@ func DrawInternalTextInRect(img *image.Gray, text string, glyphSet *glyphs.Glyph
 	}
 
 	// Draw text
-	glyphs.DrawText(img, text, x, y, glyphSet, color.Gray{Y: 255})
+	drawInternalText(img, text, x, y, glyphSet, color.Gray{Y: textColor})
 }
 
 // DrawInternalTextClipped draws text using internal font with clipping bounds
@@ -143,12 +186,15 @@ func DrawInternalTextClipped(img *image.Gray, text string, glyphSet *glyphs.Glyp
 	// Draw each character with clipping
 	currentX := x
 	for _, r := range text {
-		glyph := glyphs.GetGlyph(glyphSet, r)
-		if glyph == nil {
+		glyph, glyphY, ok := internalGlyph(glyphSet, r)
+		if !ok {
 			// Skip unknown characters, advance by glyph width + spacing
 			currentX += glyphSet.GlyphWidth + 1
 			continue
 		}
+		if glyph == nil {
+			continue // Zero-width emoji modifier
+		}
 
 		charWidth := glyph.Width
 
@@ -160,7 +206,7 @@ func DrawInternalTextClipped(img *image.Gray, text string, glyphSet *glyphs.Glyp
 
 		// Draw the glyph with clipping
 		for dy := 0; dy < glyph.Height; dy++ {
-			py := y + dy
+			py := y + glyphY + dy
 			if py < clipY || py >= clipY+clipH {
 				continue
 			}
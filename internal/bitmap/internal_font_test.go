@@ -350,3 +350,47 @@ func TestInternalFontConstants(t *testing.T) {
 		t.Errorf("FontNamePixel5x7 = %q, want %q", FontNamePixel5x7, "pixel5x7")
 	}
 }
+
+func TestMeasureInternalText_Emoji(t *testing.T) {
+	emoji := glyphs.Emoji8x8.GlyphWidth
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{"known emoji", "👍", emoji},
+		{"unknown emoji takes the fallback box", "🦩", emoji},
+		{"modifiers take no space", "👍🏽️", emoji},
+		{"flag reads as its country code", "🇩🇪", MeasureInternalText("DE", glyphs.Font5x7)},
+		{"mixed text", "A👍", glyphs.Font5x7.GlyphWidth + 1 + emoji},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := MeasureInternalText(tc.text, glyphs.Font5x7); got != tc.want {
+				t.Errorf("MeasureInternalText(%q) = %d, want %d", tc.text, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDrawInternalText_EmojiMatchesClipped(t *testing.T) {
+	text := "A👍🇩🇪"
+	w := MeasureInternalText(text, glyphs.Font5x7)
+
+	inRect := image.NewGray(image.Rect(0, 0, w, 9))
+	DrawInternalTextInRect(inRect, text, glyphs.Font5x7, 0, 1, w, 7, config.AlignLeft, config.AlignTop, 0)
+	clipped := image.NewGray(image.Rect(0, 0, w, 9))
+	DrawInternalTextClipped(clipped, text, glyphs.Font5x7, 0, 1, 0, 0, w, 9, color.Gray{Y: 255})
+
+	if countLitPixels(inRect) == 0 {
+		t.Fatal("emoji text drew nothing")
+	}
+	for y := 0; y < 9; y++ {
+		for x := 0; x < w; x++ {
+			if inRect.GrayAt(x, y) != clipped.GrayAt(x, y) {
+				t.Fatalf("pixel (%d,%d) differs between rect and clipped drawing", x, y)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package bitmap
+
+import (
+	"image"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"golang.org/x/image/font"
+)
+
+// IsRotatedOrientation reports whether the orientation draws text along the vertical axis
+// by rotating a horizontally rendered buffer.
+func IsRotatedOrientation(orientation config.TextOrientation) bool {
+	return orientation == config.OrientationRotateCW || orientation == config.OrientationRotateCCW
+}
+
+// BlitRotated draws src onto dst with its top-left corner at (x, y), rotated by 90° as
+// requested by orientation. The destination area is src height wide and src width tall.
+// Pixels are merged by brightness, so text drawn over existing content keeps it visible.
+// Any orientation other than rotate_cw/rotate_ccw copies src unrotated.
+func BlitRotated(dst, src *image.Gray, x, y int, orientation config.TextOrientation) {
+	if dst == nil || src == nil {
+		return
+	}
+
+	sb := src.Bounds()
+	srcW, srcH := sb.Dx(), sb.Dy()
+	db := dst.Bounds()
+
+	for sy := 0; sy < srcH; sy++ {
+		for sx := 0; sx < srcW; sx++ {
+			v := src.Pix[src.PixOffset(sb.Min.X+sx, sb.Min.Y+sy)]
+			if v == 0 {
+				continue
+			}
+
+			var dx, dy int
+			switch orientation {
+			case config.OrientationRotateCW:
+				dx, dy = x+srcH-1-sy, y+sx
+			case config.OrientationRotateCCW:
+				dx, dy = x+sy, y+srcW-1-sx
+			default:
+				dx, dy = x+sx, y+sy
+			}
+
+			if !(image.Point{X: dx, Y: dy}.In(db)) {
+				continue
+			}
+			off := dst.PixOffset(dx, dy)
+			if v > dst.Pix[off] {
+				dst.Pix[off] = v
+			}
+		}
+	}
+}
+
+// SmartMeasureStackedText measures text drawn with one upright glyph per row.
+// Returns the widest glyph width and the summed glyph heights.
+func SmartMeasureStackedText(text string, fontFace font.Face, fontName string) (int, int) {
+	width, height := 0, 0
+	for _, r := range text {
+		w, h := SmartMeasureText(string(r), fontFace, fontName)
+		if w > width {
+			width = w
+		}
+		height += h
+	}
+	return width, height
+}
+
+// SmartDrawStackedTextAt draws text with one upright glyph per row, starting at top.
+// Each glyph is aligned horizontally within [x, x+width) and clipped to the clip area.
+func SmartDrawStackedTextAt(img *image.Gray, text string, fontFace font.Face, fontName string, x, top, width int, horizAlign config.HAlign, clipX, clipY, clipW, clipH int) {
+	rowY := top
+	for _, r := range text {
+		glyph := string(r)
+		_, h := SmartMeasureText(glyph, fontFace, fontName)
+
+		// Skip rows entirely outside the clip area
+		if rowY+h > clipY && rowY < clipY+clipH {
+			gx, gy := SmartCalculateTextPosition(glyph, fontFace, fontName, x, rowY, width, h, horizAlign, config.AlignTop)
+			SmartDrawTextAtPosition(img, glyph, fontFace, fontName, gx, gy, clipX, clipY, clipW, clipH)
+		}
+
+		rowY += h
+	}
+}
+
+// SmartDrawAlignedTextOriented draws text over the whole image like SmartDrawAlignedText,
+// laid out according to orientation.
+func SmartDrawAlignedTextOriented(img *image.Gray, text string, fontFace font.Face, fontName string, horizAlign config.HAlign, vertAlign config.VAlign, padding int, orientation config.TextOrientation) {
+	if !IsRotatedOrientation(orientation) && orientation != config.OrientationVerticalStacked {
+		SmartDrawAlignedText(img, text, fontFace, fontName, horizAlign, vertAlign, padding)
+		return
+	}
+	b := img.Bounds()
+	SmartDrawTextInRectOriented(img, text, fontFace, fontName, b.Min.X, b.Min.Y, b.Dx(), b.Dy(), horizAlign, vertAlign, padding, orientation)
+}
+
+// SmartDrawTextInRectOriented draws text within a rectangle like SmartDrawTextInRect,
+// laid out according to orientation. For rotated orientations the text is rendered into a
+// buffer with swapped dimensions and rotated into place, so alignment applies along the
+// reading direction. Unknown or empty orientations draw horizontally.
+func SmartDrawTextInRectOriented(img *image.Gray, text string, fontFace font.Face, fontName string, x, y, width, height int, horizAlign config.HAlign, vertAlign config.VAlign, padding int, orientation config.TextOrientation) {
+	switch {
+	case IsRotatedOrientation(orientation):
+		if width <= 0 || height <= 0 {
+			return
+		}
+		buf := image.NewGray(image.Rect(0, 0, height, width))
+		SmartDrawTextInRect(buf, text, fontFace, fontName, 0, 0, height, width, horizAlign, vertAlign, padding)
+		BlitRotated(img, buf, x, y, orientation)
+
+	case orientation == config.OrientationVerticalStacked:
+		contentX := x + padding
+		contentY := y + padding
+		contentW := width - padding*2
+		contentH := height - padding*2
+
+		_, textHeight := SmartMeasureStackedText(text, fontFace, fontName)
+
+		top := contentY + (contentH-textHeight)/2
+		switch vertAlign {
+		case config.AlignTop:
+			top = contentY
+		case config.AlignBottom:
+			top = contentY + contentH - textHeight
+		}
+
+		SmartDrawStackedTextAt(img, text, fontFace, fontName, contentX, top, contentW, horizAlign, contentX, contentY, contentW, contentH)
+
+	default:
+		SmartDrawTextInRect(img, text, fontFace, fontName, x, y, width, height, horizAlign, vertAlign, padding)
+	}
+}
@@ -0,0 +1,125 @@
+package bitmap
+
+import (
+	"image"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// litBounds returns the bounding box of all non-zero pixels
+func litBounds(img *image.Gray) image.Rectangle {
+	var r image.Rectangle
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if img.GrayAt(x, y).Y > 0 {
+				r = r.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return r
+}
+
+func TestBlitRotated(t *testing.T) {
+	// 3x2 source with a single lit pixel at the top-left corner
+	src := image.NewGray(image.Rect(0, 0, 3, 2))
+	src.Pix[src.PixOffset(0, 0)] = 255
+
+	tests := []struct {
+		name        string
+		orientation config.TextOrientation
+		wantX       int
+		wantY       int
+	}{
+		{"rotate_cw moves top-left to top-right", config.OrientationRotateCW, 1, 0},
+		{"rotate_ccw moves top-left to bottom-left", config.OrientationRotateCCW, 0, 2},
+		{"horizontal copies unrotated", config.OrientationHorizontal, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := image.NewGray(image.Rect(0, 0, 4, 4))
+			BlitRotated(dst, src, 0, 0, tt.orientation)
+
+			if got := dst.GrayAt(tt.wantX, tt.wantY).Y; got != 255 {
+				t.Errorf("pixel (%d,%d) = %d, want 255", tt.wantX, tt.wantY, got)
+			}
+			if lit := litBounds(dst); lit.Dx() != 1 || lit.Dy() != 1 {
+				t.Errorf("expected exactly one lit pixel, got bounds %v", lit)
+			}
+		})
+	}
+}
+
+func TestBlitRotated_KeepsBrighterDestination(t *testing.T) {
+	src := image.NewGray(image.Rect(0, 0, 1, 1))
+	src.Pix[0] = 100
+	dst := image.NewGray(image.Rect(0, 0, 1, 1))
+	dst.Pix[0] = 200
+
+	BlitRotated(dst, src, 0, 0, config.OrientationRotateCW)
+
+	if dst.Pix[0] != 200 {
+		t.Errorf("pixel = %d, want 200 (brighter destination kept)", dst.Pix[0])
+	}
+}
+
+func TestSmartMeasureStackedText(t *testing.T) {
+	w, h := SmartMeasureStackedText("ABC", nil, "pixel5x7")
+	if w != 5 {
+		t.Errorf("width = %d, want 5", w)
+	}
+	if h != 21 {
+		t.Errorf("height = %d, want 21", h)
+	}
+}
+
+func TestSmartDrawTextInRectOriented(t *testing.T) {
+	// Tall narrow strip, like a label next to a gauge
+	const width, height = 12, 40
+
+	t.Run("rotated text spans the tall axis", func(t *testing.T) {
+		for _, o := range []config.TextOrientation{config.OrientationRotateCW, config.OrientationRotateCCW} {
+			img := image.NewGray(image.Rect(0, 0, width, height))
+			SmartDrawTextInRectOriented(img, "CPU", nil, "pixel5x7", 0, 0, width, height, config.AlignCenter, config.AlignMiddle, 0, o)
+
+			lit := litBounds(img)
+			if lit.Empty() {
+				t.Fatalf("%s: nothing drawn", o)
+			}
+			if lit.Dy() <= lit.Dx() {
+				t.Errorf("%s: lit area %v should be taller than wide", o, lit)
+			}
+		}
+	})
+
+	t.Run("vertical_stacked draws one glyph per row", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, width, height))
+		SmartDrawTextInRectOriented(img, "AB", nil, "pixel5x7", 0, 0, width, height, config.AlignCenter, config.AlignTop, 0, config.OrientationVerticalStacked)
+
+		lit := litBounds(img)
+		if lit.Empty() {
+			t.Fatal("nothing drawn")
+		}
+		if lit.Min.Y != 0 || lit.Dy() != 14 {
+			t.Errorf("lit area %v, want two 7px rows starting at top", lit)
+		}
+		if lit.Dx() > 5 {
+			t.Errorf("lit width = %d, want <= 5 (upright glyphs)", lit.Dx())
+		}
+	})
+
+	t.Run("horizontal matches SmartDrawTextInRect", func(t *testing.T) {
+		a := image.NewGray(image.Rect(0, 0, 40, 12))
+		b := image.NewGray(image.Rect(0, 0, 40, 12))
+		SmartDrawTextInRectOriented(a, "CPU", nil, "pixel5x7", 0, 0, 40, 12, config.AlignCenter, config.AlignMiddle, 0, "")
+		SmartDrawTextInRect(b, "CPU", nil, "pixel5x7", 0, 0, 40, 12, config.AlignCenter, config.AlignMiddle, 0)
+
+		for i := range a.Pix {
+			if a.Pix[i] != b.Pix[i] {
+				t.Fatalf("pixel %d differs: %d vs %d", i, a.Pix[i], b.Pix[i])
+			}
+		}
+	})
+}
@@ -87,6 +87,11 @@ func DrawAlignedText(img *image.Gray, text string, face font.Face, horizAlign co
 
 // DrawTextInRect draws text within a specific rectangle with alignment and padding
 func DrawTextInRect(img *image.Gray, text string, face font.Face, x, y, width, height int, horizAlign config.HAlign, vertAlign config.VAlign, padding int) {
+	DrawTextInRectWithColor(img, text, face, x, y, width, height, horizAlign, vertAlign, padding, 255)
+}
+
+// DrawTextInRectWithColor draws text within a rectangle like DrawTextInRect, in the given gray level
+func DrawTextInRectWithColor(img *image.Gray, text string, face font.Face, x, y, width, height int, horizAlign config.HAlign, vertAlign config.VAlign, padding int, textColor uint8) {
 	// Protect font face access - font.Face is not thread-safe
 	fontMutex.Lock()
 	defer fontMutex.Unlock()
@@ -133,7 +138,7 @@ func DrawTextInRect(img *image.Gray, text string, face font.Face, x, y, width, h
 
 	drawer := &font.Drawer{
 		Dst:  img,
-		Src:  image.White,
+		Src:  image.NewUniform(color.Gray{Y: textColor}),
 		Face: face,
 		Dot:  point,
 	}
@@ -144,6 +149,11 @@ func DrawTextInRect(img *image.Gray, text string, face font.Face, x, y, width, h
 // DrawTextAtPosition draws text at a specific position with clipping to a content area
 // This is useful for scrolling text where the text may extend beyond visible bounds
 func DrawTextAtPosition(img *image.Gray, text string, face font.Face, x, y, clipX, clipY, clipW, clipH int) {
+	DrawTextAtPositionWithColor(img, text, face, x, y, clipX, clipY, clipW, clipH, 255)
+}
+
+// DrawTextAtPositionWithColor draws clipped text like DrawTextAtPosition, in the given gray level
+func DrawTextAtPositionWithColor(img *image.Gray, text string, face font.Face, x, y, clipX, clipY, clipW, clipH int, textColor uint8) {
 	// Protect font face access - font.Face is not thread-safe
 	fontMutex.Lock()
 	defer fontMutex.Unlock()
@@ -156,7 +166,7 @@ func DrawTextAtPosition(img *image.Gray, text string, face font.Face, x, y, clip
 
 	drawer := &font.Drawer{
 		Dst:  img,
-		Src:  image.White,
+		Src:  image.NewUniform(color.Gray{Y: textColor}),
 		Face: face,
 		Dot:  point,
 	}
@@ -269,15 +279,21 @@ func SmartDrawAlignedText(img *image.Gray, text string, fontFace font.Face, font
 // If fontFace is nil and fontName is an internal font name, uses internal font rendering.
 // Otherwise, uses TTF font rendering.
 func SmartDrawTextInRect(img *image.Gray, text string, fontFace font.Face, fontName string, x, y, width, height int, horizAlign config.HAlign, vertAlign config.VAlign, padding int) {
+	SmartDrawTextInRectWithColor(img, text, fontFace, fontName, x, y, width, height, horizAlign, vertAlign, padding, 255)
+}
+
+// SmartDrawTextInRectWithColor draws text within a rectangle like SmartDrawTextInRect, in the given gray level.
+// textColor is the grayscale value (0=black, 255=white).
+func SmartDrawTextInRectWithColor(img *image.Gray, text string, fontFace font.Face, fontName string, x, y, width, height int, horizAlign config.HAlign, vertAlign config.VAlign, padding int, textColor uint8) {
 	if fontFace == nil && IsInternalFont(fontName) {
 		glyphSet := GetInternalFontByName(fontName)
-		DrawInternalTextInRect(img, text, glyphSet, x, y, width, height, horizAlign, vertAlign, padding)
+		DrawInternalTextInRectWithColor(img, text, glyphSet, x, y, width, height, horizAlign, vertAlign, padding, textColor)
 		return
 	}
 
 	// Fall back to TTF rendering
 	if fontFace != nil {
-		DrawTextInRect(img, text, fontFace, x, y, width, height, horizAlign, vertAlign, padding)
+		DrawTextInRectWithColor(img, text, fontFace, x, y, width, height, horizAlign, vertAlign, padding, textColor)
 	}
 }
 
@@ -379,8 +395,8 @@ func SmartDrawTextAtPositionWithColor(img *image.Gray, text string, fontFace fon
 		return
 	}
 
-	// Fall back to TTF rendering (always white for now)
+	// Fall back to TTF rendering
 	if fontFace != nil {
-		DrawTextAtPosition(img, text, fontFace, x, y, clipX, clipY, clipW, clipH)
+		DrawTextAtPositionWithColor(img, text, fontFace, x, y, clipX, clipY, clipW, clipH, textColor)
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"golang.org/x/image/font"
 )
 
 // TestDrawAlignedText tests text drawing with various alignments
@@ -1118,6 +1119,35 @@ func TestSmartDrawTextInRect_TTFFont(t *testing.T) {
 	}
 }
 
+func TestSmartDrawTextInRectWithColor(t *testing.T) {
+	face, err := LoadFont("", 12)
+	if err != nil {
+		t.Skipf("Skipping test, cannot load font: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		face font.Face
+		font string
+	}{
+		{"internal", nil, FontNamePixel5x7},
+		{"ttf", face, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			img := NewGrayscaleImage(100, 50, 0)
+			SmartDrawTextInRectWithColor(img, "Test", tc.face, tc.font, 10, 10, 80, 30, "center", "center", 2, 100)
+
+			var brightest uint8
+			for _, v := range img.Pix {
+				brightest = max(brightest, v)
+			}
+			if brightest != 100 {
+				t.Errorf("brightest pixel = %d, want 100", brightest)
+			}
+		})
+	}
+}
+
 func TestSmartDrawTextInRect_NoFont(t *testing.T) {
 	img := NewGrayscaleImage(100, 50, 0)
 	// Should not panic with nil font and non-internal font name
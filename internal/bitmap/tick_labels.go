@@ -0,0 +1,142 @@
+package bitmap
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
+)
+
+// Tick label defaults
+const (
+	// DefaultGaugeLabelInterval is the default percentage step between gauge labels (0, 25, 50, 75, 100)
+	DefaultGaugeLabelInterval = 25
+	// DefaultAnalogLabelInterval is the default hour step between clock face labels (12, 3, 6, 9)
+	DefaultAnalogLabelInterval = 3
+)
+
+// tickLabelFont is the glyph set used for tick labels
+var tickLabelFont = glyphs.Font3x5
+
+// gaugeGeometry returns the center point and arc radius of a gauge drawn in the given rectangle
+func gaugeGeometry(x, y, width, height int) (centerX, centerY, radius int) {
+	centerX = x + width/2
+	centerY = y + height - 3 // Near bottom
+
+	radius = height - 6
+	if width/2 < radius {
+		radius = width/2 - 3
+	}
+	return centerX, centerY, radius
+}
+
+// tickLabelOffset returns how far a label centered on a ray at angle rad must be
+// moved inward so that its whole box stays inside the tick mark
+func tickLabelOffset(text string, rad float64) float64 {
+	halfW := float64(glyphs.MeasureText(text, tickLabelFont)) / 2
+	halfH := float64(tickLabelFont.GlyphHeight) / 2
+	return halfW*math.Abs(math.Cos(rad)) + halfH*math.Abs(math.Sin(rad))
+}
+
+// tickLabelsFit reports whether labels of the given width, placed on a circle of
+// labelRadius every stepDegrees, are far enough apart to stay legible
+func tickLabelsFit(labelRadius, stepDegrees float64, labelWidth int) bool {
+	if labelRadius < float64(tickLabelFont.GlyphHeight*2) {
+		return false
+	}
+	chord := 2 * labelRadius * math.Sin(stepDegrees*math.Pi/360.0)
+	return chord >= float64(labelWidth+2)
+}
+
+// drawTickLabel draws text centered at (cx, cy) using the small tick label font
+func drawTickLabel(img *image.Gray, text string, cx, cy int, c color.Gray) {
+	w := glyphs.MeasureText(text, tickLabelFont)
+	h := tickLabelFont.GlyphHeight
+	b := img.Bounds()
+	DrawInternalTextClipped(img, text, tickLabelFont, cx-w/2, cy-h/2, b.Min.X, b.Min.Y, b.Dx(), b.Dy(), c)
+}
+
+// DrawGaugeTickLabels draws percentage labels inside the arc of a gauge drawn by DrawGauge
+// with the same rectangle. Labels are placed every interval percent; nothing is drawn when
+// the gauge is too small for them to be legible.
+func DrawGaugeTickLabels(img *image.Gray, x, y, width, height, interval int, labelColor uint8) {
+	if interval <= 0 || interval > 100 {
+		return
+	}
+
+	centerX, centerY, radius := gaugeGeometry(x, y, width, height)
+	tickLen := 5 // Matches the longest gauge tick
+	labelRadius := float64(radius - tickLen - 1)
+
+	widest := glyphs.MeasureText("100", tickLabelFont)
+	if !tickLabelsFit(labelRadius-float64(widest)/2, float64(interval)*1.8, widest) {
+		return
+	}
+
+	c := color.Gray{Y: labelColor}
+	for value := 0; value <= 100; value += interval {
+		angle := 180.0 - float64(value)*1.8 // Same mapping as the gauge ticks
+		rad := angle * math.Pi / 180.0
+
+		text := strconv.Itoa(value)
+		dist := labelRadius - tickLabelOffset(text, rad)
+		lx := centerX + int(math.Round(dist*math.Cos(rad)))
+		ly := centerY - int(math.Round(dist*math.Sin(rad)))
+
+		drawTickLabel(img, text, lx, ly, c)
+	}
+}
+
+// romanHours are the Roman numerals of the clock hours, indexed by hour (0 = XII)
+var romanHours = [12]string{"XII", "I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX", "X", "XI"}
+
+// DrawClockTickLabels draws hour numbers inside a clock face of the given radius.
+// Labels are placed every interval hours starting at 12; nothing is drawn when the
+// face is too small for them to be legible.
+func DrawClockTickLabels(img *image.Gray, centerX, centerY, radius, tickLen, interval int, labelColor uint8) {
+	DrawClockHourNumerals(img, centerX, centerY, radius, tickLen, interval, false, labelColor)
+}
+
+// DrawClockHourNumerals draws hour numerals inside a clock face of the given radius,
+// every interval hours starting at 12, as Arabic or Roman numbers. It reports whether
+// the numerals were drawn: nothing is drawn when the face is too small for them to be legible.
+func DrawClockHourNumerals(img *image.Gray, centerX, centerY, radius, tickLen, interval int, roman bool, labelColor uint8) bool {
+	if interval <= 0 || interval > 12 {
+		return false
+	}
+
+	label := func(hour int) string {
+		if roman {
+			return romanHours[hour]
+		}
+		if hour == 0 {
+			return "12"
+		}
+		return strconv.Itoa(hour)
+	}
+
+	labelRadius := float64(radius - tickLen - 1)
+	widest := 0
+	for hour := 0; hour < 12; hour += interval {
+		widest = max(widest, glyphs.MeasureText(label(hour), tickLabelFont))
+	}
+	if !tickLabelsFit(labelRadius-float64(widest)/2, float64(interval)*30.0, widest) {
+		return false
+	}
+
+	c := color.Gray{Y: labelColor}
+	for hour := 0; hour < 12; hour += interval {
+		angle := float64(hour) * 30.0 // Same mapping as the hour ticks
+		rad := (angle - 90.0) * math.Pi / 180.0
+
+		text := label(hour)
+		dist := labelRadius - tickLabelOffset(text, rad)
+		lx := centerX + int(math.Round(dist*math.Cos(rad)))
+		ly := centerY + int(math.Round(dist*math.Sin(rad)))
+
+		drawTickLabel(img, text, lx, ly, c)
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package bitmap
+
+import (
+	"image"
+	"testing"
+)
+
+func countLitPixels(img *image.Gray) int {
+	count := 0
+	for _, p := range img.Pix {
+		if p > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func TestDrawGaugeTickLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		width     int
+		height    int
+		interval  int
+		wantDrawn bool
+	}{
+		{"large gauge", 128, 40, DefaultGaugeLabelInterval, true},
+		{"half steps", 128, 40, 50, true},
+		{"small gauge dropped", 30, 20, DefaultGaugeLabelInterval, false},
+		{"crowded labels dropped", 128, 40, 5, false},
+		{"zero interval", 128, 40, 0, false},
+		{"interval above range", 128, 40, 150, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := NewGrayscaleImage(tt.width, tt.height, 0)
+			DrawGaugeTickLabels(img, 0, 0, tt.width, tt.height, tt.interval, 255)
+
+			if drawn := countLitPixels(img) > 0; drawn != tt.wantDrawn {
+				t.Errorf("labels drawn = %v, want %v", drawn, tt.wantDrawn)
+			}
+		})
+	}
+}
+
+func TestDrawGaugeTickLabelsStayInsideArc(t *testing.T) {
+	img := NewGrayscaleImage(128, 40, 0)
+	DrawGaugeTickLabels(img, 0, 0, 128, 40, DefaultGaugeLabelInterval, 255)
+
+	centerX, centerY, radius := gaugeGeometry(0, 0, 128, 40)
+	for y := 0; y < 40; y++ {
+		for x := 0; x < 128; x++ {
+			if img.GrayAt(x, y).Y == 0 {
+				continue
+			}
+			dx, dy := x-centerX, y-centerY
+			if dx*dx+dy*dy > radius*radius {
+				t.Fatalf("label pixel (%d,%d) outside gauge arc of radius %d", x, y, radius)
+			}
+		}
+	}
+}
+
+func TestDrawClockTickLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		radius    int
+		interval  int
+		wantDrawn bool
+	}{
+		{"quarter hours", 30, DefaultAnalogLabelInterval, true},
+		{"all hours on large face", 60, 1, true},
+		{"all hours on small face dropped", 20, 1, false},
+		{"tiny face dropped", 8, DefaultAnalogLabelInterval, false},
+		{"zero interval", 30, 0, false},
+		{"interval above range", 30, 13, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size := tt.radius*2 + 4
+			img := NewGrayscaleImage(size, size, 0)
+			DrawClockTickLabels(img, size/2, size/2, tt.radius, 4, tt.interval, 255)
+
+			if drawn := countLitPixels(img) > 0; drawn != tt.wantDrawn {
+				t.Errorf("labels drawn = %v, want %v", drawn, tt.wantDrawn)
+			}
+		})
+	}
+}
+
+func TestDrawClockHourNumerals_Roman(t *testing.T) {
+	tests := []struct {
+		name      string
+		radius    int
+		interval  int
+		wantDrawn bool
+	}{
+		{"all hours on large face", 48, 1, true},
+		{"all hours on medium face dropped", 30, 1, false},
+		{"quarter hours on small face", 18, 3, true},
+		{"tiny face dropped", 10, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size := tt.radius*2 + 4
+			img := NewGrayscaleImage(size, size, 0)
+			drawn := DrawClockHourNumerals(img, size/2, size/2, tt.radius, 0, tt.interval, true, 255)
+
+			if drawn != tt.wantDrawn {
+				t.Errorf("DrawClockHourNumerals() = %v, want %v", drawn, tt.wantDrawn)
+			}
+			if lit := countLitPixels(img) > 0; lit != tt.wantDrawn {
+				t.Errorf("pixels drawn = %v, want %v", lit, tt.wantDrawn)
+			}
+		})
+	}
+}
+
+func TestDrawClockHourNumerals_RomanWiderThanArabic(t *testing.T) {
+	// "XII" is wider than "12", so Roman numerals need a larger face
+	const radius, interval = 36, 1
+	size := radius*2 + 4
+	arabic := NewGrayscaleImage(size, size, 0)
+	roman := NewGrayscaleImage(size, size, 0)
+
+	if !DrawClockHourNumerals(arabic, size/2, size/2, radius, 0, interval, false, 255) {
+		t.Fatal("Arabic numerals not drawn")
+	}
+	if DrawClockHourNumerals(roman, size/2, size/2, radius, 0, interval, true, 255) {
+		t.Error("Roman numerals drawn on a face too small for VIII")
+	}
+}
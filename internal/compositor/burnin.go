@@ -0,0 +1,192 @@
+package compositor
+
+import (
+	"image"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// BurnInProtector is the final compositing pass that protects OLED panels from burn-in.
+// It slowly walks the whole frame through a small grid of pixel offsets and can dim
+// pixels that have stayed lit and unchanged for a long time.
+type BurnInProtector struct {
+	enabled    bool
+	interval   time.Duration
+	offsets    []image.Point // Shift cycle; consecutive entries differ by one pixel
+	origin     int           // Index of the unshifted position in offsets
+	start      time.Time
+	background uint8
+
+	dimAfter  time.Duration
+	dimFactor float64
+	lastPix   []uint8 // Undimmed pixel values of the previous frame
+	since     []int64 // Unix nanos when each pixel last changed
+
+	shifted *image.Gray // Reused output buffer for shifted frames
+}
+
+// NewBurnInProtector creates a burn-in protector for the given display.
+// If protection is not configured or not enabled, Apply returns frames unchanged.
+func NewBurnInProtector(display config.DisplayConfig, now time.Time) *BurnInProtector {
+	cfg := display.BurnInProtection
+	if cfg == nil || !cfg.Enabled {
+		return &BurnInProtector{}
+	}
+
+	interval := cfg.ShiftInterval
+	if interval <= 0 {
+		interval = config.DefaultBurnInShiftInterval
+	}
+	magnitude := config.DefaultBurnInMagnitude
+	if cfg.Magnitude != nil {
+		magnitude = min(max(*cfg.Magnitude, 0), config.MaxBurnInMagnitude)
+	}
+	dimFactor := cfg.DimFactor
+	if dimFactor <= 0 || dimFactor > 1 {
+		dimFactor = config.DefaultBurnInDimFactor
+	}
+
+	offsets := shiftCycle(magnitude)
+	origin := 0
+	for i, p := range offsets {
+		if p == (image.Point{}) {
+			origin = i
+			break
+		}
+	}
+
+	return &BurnInProtector{
+		enabled:    true,
+		interval:   time.Duration(interval * float64(time.Second)),
+		offsets:    offsets,
+		origin:     origin,
+		start:      now,
+		background: uint8(display.Background),
+		dimAfter:   time.Duration(cfg.DimAfter * float64(time.Second)),
+		dimFactor:  dimFactor,
+	}
+}
+
+// IsEnabled returns whether burn-in protection is active
+func (b *BurnInProtector) IsEnabled() bool {
+	return b.enabled
+}
+
+// Apply dims long-static pixels and shifts the frame according to the time elapsed
+// since the protector was created. The returned image may share memory with canvas
+// or with an internal buffer reused on the next call.
+func (b *BurnInProtector) Apply(canvas image.Image, now time.Time) image.Image {
+	if !b.enabled {
+		return canvas
+	}
+	gray, ok := canvas.(*image.Gray)
+	if !ok {
+		return canvas
+	}
+
+	if b.dimAfter > 0 {
+		b.dimStatic(gray, now)
+	}
+
+	offset := b.OffsetAt(now)
+	if offset == (image.Point{}) {
+		return gray
+	}
+	return b.shift(gray, offset)
+}
+
+// OffsetAt returns the frame shift in effect at the given time
+func (b *BurnInProtector) OffsetAt(now time.Time) image.Point {
+	if !b.enabled || len(b.offsets) <= 1 || b.interval <= 0 {
+		return image.Point{}
+	}
+	elapsed := now.Sub(b.start)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	step := int(elapsed / b.interval)
+	return b.offsets[(b.origin+step)%len(b.offsets)]
+}
+
+// dimStatic scales down lit pixels that have not changed for at least dimAfter.
+// Change tracking uses the undimmed values, so a dimmed pixel stays dimmed until
+// the content underneath actually changes.
+func (b *BurnInProtector) dimStatic(img *image.Gray, now time.Time) {
+	bounds := img.Bounds()
+	n := bounds.Dx() * bounds.Dy()
+	nowNs := now.UnixNano()
+	if len(b.lastPix) != n {
+		b.lastPix = make([]uint8, n)
+		b.since = make([]int64, n)
+		for i := range b.since {
+			b.since[i] = nowNs
+		}
+	}
+	threshold := b.dimAfter.Nanoseconds()
+
+	i := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		rowStart := img.PixOffset(bounds.Min.X, y)
+		row := img.Pix[rowStart : rowStart+bounds.Dx()]
+		for x, v := range row {
+			if v != b.lastPix[i] {
+				b.lastPix[i] = v
+				b.since[i] = nowNs
+			} else if v > 0 && nowNs-b.since[i] >= threshold {
+				row[x] = uint8(float64(v) * b.dimFactor)
+			}
+			i++
+		}
+	}
+}
+
+// shift copies img moved by offset into the reusable output buffer,
+// filling uncovered edges with the display background
+func (b *BurnInProtector) shift(img *image.Gray, offset image.Point) *image.Gray {
+	bounds := img.Bounds()
+	if b.shifted == nil || b.shifted.Bounds() != bounds {
+		b.shifted = image.NewGray(bounds)
+	}
+	for i := range b.shifted.Pix {
+		b.shifted.Pix[i] = b.background
+	}
+
+	dst := bounds.Intersect(bounds.Add(offset))
+	if dst.Empty() {
+		return b.shifted
+	}
+	width := dst.Dx()
+	for y := dst.Min.Y; y < dst.Max.Y; y++ {
+		srcStart := img.PixOffset(dst.Min.X-offset.X, y-offset.Y)
+		dstStart := b.shifted.PixOffset(dst.Min.X, y)
+		copy(b.shifted.Pix[dstStart:dstStart+width], img.Pix[srcStart:srcStart+width])
+	}
+	return b.shifted
+}
+
+// shiftCycle returns every offset within magnitude pixels of the origin, ordered as a
+// row-by-row snake and then walked back, so each step moves the frame by a single pixel
+// and the cycle wraps around without a jump
+func shiftCycle(magnitude int) []image.Point {
+	if magnitude <= 0 {
+		return []image.Point{{}}
+	}
+
+	var path []image.Point
+	for row, y := 0, -magnitude; y <= magnitude; row, y = row+1, y+1 {
+		for i := 0; i <= 2*magnitude; i++ {
+			x := -magnitude + i
+			if row%2 == 1 {
+				x = magnitude - i
+			}
+			path = append(path, image.Point{X: x, Y: y})
+		}
+	}
+
+	cycle := path
+	for i := len(path) - 2; i > 0; i-- {
+		cycle = append(cycle, path[i])
+	}
+	return cycle
+}
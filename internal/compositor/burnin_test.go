@@ -0,0 +1,185 @@
+package compositor
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func burnInDisplay(b *config.BurnInProtectionConfig) config.DisplayConfig {
+	return config.DisplayConfig{Width: 8, Height: 4, BurnInProtection: b}
+}
+
+func TestNewBurnInProtector_Disabled(t *testing.T) {
+	now := time.Now()
+	for _, b := range []*config.BurnInProtectionConfig{nil, {Enabled: false, Magnitude: config.IntPtr(2)}} {
+		p := NewBurnInProtector(burnInDisplay(b), now)
+		if p.IsEnabled() {
+			t.Error("expected protector to be disabled")
+		}
+
+		img := image.NewGray(image.Rect(0, 0, 8, 4))
+		if got := p.Apply(img, now.Add(time.Hour)); got != image.Image(img) {
+			t.Error("disabled protector should return the canvas unchanged")
+		}
+	}
+}
+
+func TestShiftCycle(t *testing.T) {
+	if got := shiftCycle(0); len(got) != 1 || got[0] != (image.Point{}) {
+		t.Errorf("shiftCycle(0) = %v, want only the origin", got)
+	}
+
+	for _, m := range []int{1, 2, 3} {
+		cycle := shiftCycle(m)
+		side := 2*m + 1
+
+		seen := make(map[image.Point]bool)
+		for _, p := range cycle {
+			if p.X < -m || p.X > m || p.Y < -m || p.Y > m {
+				t.Errorf("magnitude %d: offset %v out of range", m, p)
+			}
+			seen[p] = true
+		}
+		if len(seen) != side*side {
+			t.Errorf("magnitude %d: visited %d offsets, want %d", m, len(seen), side*side)
+		}
+
+		// Every step, including the wrap-around, moves by exactly one pixel
+		for i := range cycle {
+			a, b := cycle[i], cycle[(i+1)%len(cycle)]
+			dx, dy := abs(a.X-b.X), abs(a.Y-b.Y)
+			if dx+dy != 1 {
+				t.Errorf("magnitude %d: step %v -> %v is not a single pixel", m, a, b)
+			}
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func TestBurnInProtector_OffsetAt(t *testing.T) {
+	start := time.Now()
+	p := NewBurnInProtector(burnInDisplay(&config.BurnInProtectionConfig{
+		Enabled:       true,
+		ShiftInterval: 10,
+	}), start)
+
+	if got := p.OffsetAt(start); got != (image.Point{}) {
+		t.Errorf("initial offset = %v, want origin", got)
+	}
+	if got := p.OffsetAt(start.Add(9 * time.Second)); got != (image.Point{}) {
+		t.Errorf("offset before first interval = %v, want origin", got)
+	}
+
+	first := p.OffsetAt(start.Add(10 * time.Second))
+	if abs(first.X)+abs(first.Y) != 1 {
+		t.Errorf("first shift = %v, want a one-pixel move", first)
+	}
+
+	// Default magnitude 1 covers a 3x3 grid walked forth and back (9 + 7 steps)
+	if got := p.OffsetAt(start.Add(160 * time.Second)); got != (image.Point{}) {
+		t.Errorf("offset after a full cycle = %v, want origin", got)
+	}
+
+	if got := p.OffsetAt(start.Add(-time.Minute)); got != (image.Point{}) {
+		t.Errorf("offset before start = %v, want origin", got)
+	}
+}
+
+func TestBurnInProtector_ZeroMagnitudeNeverShifts(t *testing.T) {
+	start := time.Now()
+	p := NewBurnInProtector(burnInDisplay(&config.BurnInProtectionConfig{
+		Enabled:       true,
+		ShiftInterval: 1,
+		Magnitude:     config.IntPtr(0),
+	}), start)
+
+	for s := 0; s < 5; s++ {
+		if got := p.OffsetAt(start.Add(time.Duration(s) * time.Second)); got != (image.Point{}) {
+			t.Errorf("offset at %ds = %v, want origin", s, got)
+		}
+	}
+}
+
+func TestBurnInProtector_Shift(t *testing.T) {
+	p := NewBurnInProtector(config.DisplayConfig{
+		Width:            4,
+		Height:           3,
+		Background:       7,
+		BurnInProtection: &config.BurnInProtectionConfig{Enabled: true},
+	}, time.Now())
+
+	img := image.NewGray(image.Rect(0, 0, 4, 3))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(100 + i)
+	}
+
+	out := p.shift(img, image.Point{X: 1, Y: -1})
+
+	// Content moved right and up: the left column and bottom row are uncovered
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 4; x++ {
+			want := uint8(7)
+			if x >= 1 && y <= 1 {
+				want = img.GrayAt(x-1, y+1).Y
+			}
+			if got := out.GrayAt(x, y).Y; got != want {
+				t.Errorf("pixel (%d,%d) = %d, want %d", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestBurnInProtector_DimStatic(t *testing.T) {
+	start := time.Now()
+	p := NewBurnInProtector(burnInDisplay(&config.BurnInProtectionConfig{
+		Enabled:   true,
+		Magnitude: config.IntPtr(0),
+		DimAfter:  60,
+		DimFactor: 0.5,
+	}), start)
+
+	frame := func(v uint8) *image.Gray {
+		img := image.NewGray(image.Rect(0, 0, 8, 4))
+		img.Pix[0] = 200 // Static pixel
+		img.Pix[1] = v   // Changing pixel
+		return img
+	}
+
+	out := p.Apply(frame(10), start).(*image.Gray)
+	if out.Pix[0] != 200 {
+		t.Errorf("fresh pixel = %d, want 200", out.Pix[0])
+	}
+
+	out = p.Apply(frame(20), start.Add(30*time.Second)).(*image.Gray)
+	if out.Pix[0] != 200 {
+		t.Errorf("pixel before dim_after = %d, want 200", out.Pix[0])
+	}
+
+	out = p.Apply(frame(30), start.Add(61*time.Second)).(*image.Gray)
+	if out.Pix[0] != 100 {
+		t.Errorf("static pixel after dim_after = %d, want 100", out.Pix[0])
+	}
+	if out.Pix[1] != 30 {
+		t.Errorf("changing pixel = %d, want 30", out.Pix[1])
+	}
+	if out.Pix[2] != 0 {
+		t.Errorf("unlit pixel = %d, want 0", out.Pix[2])
+	}
+
+	// Once the content changes, the pixel is shown at full brightness again
+	changed := frame(30)
+	changed.Pix[0] = 150
+	out = p.Apply(changed, start.Add(62*time.Second)).(*image.Gray)
+	if out.Pix[0] != 150 {
+		t.Errorf("changed pixel = %d, want 150", out.Pix[0])
+	}
+}
@@ -2,6 +2,7 @@ package compositor
 
 import (
 	"fmt"
+	"image"
 	"log"
 	"os"
 	"runtime/debug"
@@ -54,6 +55,16 @@ type Compositor struct {
 	// Frame deduplication - skip sending unchanged frames
 	deduplicator *FrameDeduplicator
 
+	// Frame pacing - cap the device push rate, coalescing faster renders
+	pacer *FramePacer
+
+	// Global brightness/contrast applied to the composited frame
+	tone *ToneAdjuster
+
+	// OLED burn-in protection - final passes over the composited frame
+	burnIn       *BurnInProtector
+	refreshCycle *RefreshCycle
+
 	// Backend failure handling
 	OnBackendFailure     func()     // Callback when backend fails (called once per failure)
 	heartbeatFailures    int        // Consecutive heartbeat failure count
@@ -92,6 +103,16 @@ func NewCompositor(client display.Client, layoutMgr *layout.Manager, widgets []w
 	}
 	batcher := NewFrameBatcher(batchingEnabled, cfg.EventBatchSize, client, DefaultEventName)
 
+	// Frame pacing: explicit max_push_fps wins over the limit reported by the backend
+	maxPushFPS := cfg.MaxPushFPS
+	pacingSource := "configured"
+	if maxPushFPS == 0 {
+		if limiter, ok := client.(display.FrameRateLimit); ok {
+			maxPushFPS = limiter.MaxPushFPS()
+			pacingSource = "detected"
+		}
+	}
+
 	comp := &Compositor{
 		client:        client,
 		layoutManager: layoutMgr,
@@ -103,6 +124,10 @@ func NewCompositor(client display.Client, layoutMgr *layout.Manager, widgets []w
 		resolutions:   resolutions,
 		bitmapBuffers: bitmapBuffers,
 		deduplicator:  deduplicator,
+		pacer:         NewFramePacer(maxPushFPS, refreshRate),
+		tone:          NewToneAdjuster(cfg.Brightness, cfg.Contrast),
+		burnIn:        NewBurnInProtector(cfg.Display, time.Now()),
+		refreshCycle:  NewRefreshCycle(cfg.Display, time.Now()),
 	}
 
 	log.Printf("Rendering for %d resolution(s):", len(resolutions))
@@ -114,10 +139,26 @@ func NewCompositor(client display.Client, layoutMgr *layout.Manager, widgets []w
 		log.Println("Frame deduplication enabled")
 	}
 
+	if comp.pacer.IsEnabled() {
+		log.Printf("Frame pacing enabled: max %d FPS (%s)", comp.pacer.MaxFPS(), pacingSource)
+	}
+
 	if comp.batcher.IsEnabled() {
 		log.Printf("Event batching enabled with batch size: %d", cfg.EventBatchSize)
 	}
 
+	if comp.tone.IsEnabled() {
+		log.Printf("Tone adjustment enabled: brightness %+d, contrast %g", cfg.Brightness, cfg.Contrast)
+	}
+
+	if comp.burnIn.IsEnabled() {
+		log.Println("Burn-in protection enabled")
+	}
+
+	if comp.refreshCycle.IsEnabled() {
+		log.Println("Pixel refresh cycle enabled")
+	}
+
 	return comp
 }
 
@@ -203,14 +244,48 @@ func (c *Compositor) renderLoop() {
 	}
 }
 
+// AddWidget adds a widget to the running display and starts its update loop
+func (c *Compositor) AddWidget(w widget.Widget) {
+	c.layoutManager.AddWidget(w)
+	c.scheduler.AddWidget(w)
+}
+
+// RemoveWidget removes the widget with the given ID from the running display and
+// stops its update loop. Returns false if no such widget is displayed.
+func (c *Compositor) RemoveWidget(widgetID string) bool {
+	w := c.layoutManager.RemoveWidget(widgetID)
+	if w == nil {
+		return false
+	}
+	c.scheduler.RemoveWidget(w)
+	return true
+}
+
+// PacerStats returns frame pacing counters
+func (c *Compositor) PacerStats() PacerStats {
+	return c.pacer.Stats()
+}
+
 // renderFrame renders and sends a single frame
 func (c *Compositor) renderFrame() error {
-	// Composite all widgets
-	canvas, err := c.layoutManager.Composite()
+	// Frame pacing: skip ticks faster than the device push rate; the next
+	// allowed tick composites the latest widget state
+	now := time.Now()
+	if !c.pacer.Ready(now) {
+		return nil
+	}
+
+	// Composite all widgets, unless one has taken over the display
+	canvas, err := c.composite()
 	if err != nil {
 		return fmt.Errorf("composite failed: %w", err)
 	}
 
+	// Tone adjustment, then burn-in protection runs last so it sees the finished frame
+	canvas = c.tone.Apply(canvas)
+	canvas = c.burnIn.Apply(canvas, now)
+	canvas = c.refreshCycle.Apply(canvas, now)
+
 	// Render at all resolutions using pre-allocated buffers
 	resolutionData := make(map[string][]byte)
 	for _, res := range c.resolutions {
@@ -242,6 +317,7 @@ func (c *Compositor) renderFrame() error {
 	}
 	if !shouldSendDirectly {
 		// Frame was buffered or batch was flushed
+		c.pacer.MarkPushed(now)
 		return nil
 	}
 
@@ -254,10 +330,28 @@ func (c *Compositor) renderFrame() error {
 	if shouldUpdateDedup {
 		c.deduplicator.Update(resolutionData)
 	}
+	c.pacer.MarkPushed(now)
 
 	return nil
 }
 
+// composite returns the exclusive overlay of the first widget requesting one,
+// or all widgets composited by the layout manager
+func (c *Compositor) composite() (image.Image, error) {
+	res := c.resolutions[0]
+	for _, w := range c.layoutManager.Widgets() {
+		overlay, err := widget.SafeRenderOverlay(w, res.Width, res.Height)
+		if err != nil {
+			log.Printf("Overlay of widget %s failed: %v", w.Name(), err)
+			continue
+		}
+		if overlay != nil {
+			return overlay, nil
+		}
+	}
+	return c.layoutManager.Composite()
+}
+
 // heartbeatLoop sends periodic heartbeats
 func (c *Compositor) heartbeatLoop() {
 	defer c.wg.Done()
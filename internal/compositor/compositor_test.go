@@ -241,6 +241,154 @@ func TestCompositor_RenderFrame(t *testing.T) {
 	}
 }
 
+// TestCompositor_RenderFrame_MaxPushFPS tests that renders faster than max_push_fps are coalesced
+func TestCompositor_RenderFrame_MaxPushFPS(t *testing.T) {
+	client := testutil.NewTestClient()
+
+	mockW := newMockWidget("widget1", 0, 0, 128, 40)
+	mockW.renderResult = image.NewGray(image.Rect(0, 0, 128, 40))
+
+	widgets := []widget.Widget{mockW}
+	layoutMgr := createLayoutManager(widgets)
+	dedup := false
+	cfg := &config.Config{
+		RefreshRateMs:     10,
+		MaxPushFPS:        1,
+		FrameDedupEnabled: &dedup,
+		Display: config.DisplayConfig{
+			Width:  128,
+			Height: 40,
+		},
+	}
+
+	comp := NewCompositor(client, layoutMgr, widgets, cfg)
+
+	for i := 0; i < 3; i++ {
+		if err := comp.renderFrame(); err != nil {
+			t.Fatalf("renderFrame() error = %v", err)
+		}
+	}
+
+	if client.FrameCount() != 1 {
+		t.Errorf("Frame count = %d, want 1", client.FrameCount())
+	}
+	// Coalesced ticks skip compositing entirely
+	if mockW.GetRenderCalls() != 1 {
+		t.Errorf("Widget render calls = %d, want 1", mockW.GetRenderCalls())
+	}
+
+	stats := comp.PacerStats()
+	if stats.Pushed != 1 || stats.Coalesced != 2 {
+		t.Errorf("PacerStats = %+v, want 1 pushed, 2 coalesced", stats)
+	}
+}
+
+// panickingWidget panics on every Render call
+type panickingWidget struct {
+	*mockWidget
+}
+
+func (p *panickingWidget) Render() (image.Image, error) {
+	panic("test render panic")
+}
+
+// TestCompositor_RenderLoop_WidgetPanic tests that a panicking widget doesn't stop the render loop
+func TestCompositor_RenderLoop_WidgetPanic(t *testing.T) {
+	client := testutil.NewTestClient()
+
+	bad := &panickingWidget{mockWidget: newMockWidget("bad", 0, 0, 64, 40)}
+	good := newMockWidget("good", 64, 0, 64, 40)
+
+	widgets := []widget.Widget{bad, good}
+	layoutMgr := createLayoutManager(widgets)
+	dedup := false
+	cfg := &config.Config{
+		RefreshRateMs:     20,
+		FrameDedupEnabled: &dedup,
+		Display: config.DisplayConfig{
+			Width:  128,
+			Height: 40,
+		},
+	}
+
+	comp := NewCompositor(client, layoutMgr, widgets, cfg)
+	if err := comp.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	time.Sleep(150 * time.Millisecond)
+	comp.Stop()
+
+	// The loop kept rendering frames after the first panic
+	if client.FrameCount() < 2 {
+		t.Errorf("Frame count = %d, want at least 2", client.FrameCount())
+	}
+	if good.GetRenderCalls() < 2 {
+		t.Errorf("Healthy widget render calls = %d, want at least 2", good.GetRenderCalls())
+	}
+}
+
+// overlayWidget requests an exclusive overlay while overlay is set
+type overlayWidget struct {
+	*mockWidget
+	overlay image.Image
+	panics  bool
+}
+
+func (o *overlayWidget) RenderOverlay(width, height int) (image.Image, error) {
+	if o.panics {
+		panic("test overlay panic")
+	}
+	return o.overlay, nil
+}
+
+// TestCompositor_RenderFrame_Overlay tests that a widget overlay replaces the composited layout
+func TestCompositor_RenderFrame_Overlay(t *testing.T) {
+	client := testutil.NewTestClient()
+
+	plain := newMockWidget("plain", 0, 0, 128, 40)
+	alert := &overlayWidget{mockWidget: newMockWidget("alert", 0, 0, 32, 20)}
+	white := image.NewGray(image.Rect(0, 0, 128, 40))
+	for i := range white.Pix {
+		white.Pix[i] = 255
+	}
+	alert.overlay = white
+
+	widgets := []widget.Widget{plain, alert}
+	layoutMgr := createLayoutManager(widgets)
+	cfg := &config.Config{
+		RefreshRateMs: 100,
+		Display: config.DisplayConfig{
+			Width:  128,
+			Height: 40,
+		},
+	}
+
+	comp := NewCompositor(client, layoutMgr, widgets, cfg)
+
+	if err := comp.renderFrame(); err != nil {
+		t.Fatalf("renderFrame() error = %v", err)
+	}
+	if !testutil.IsFullFrame(client.LastFrame().Data) {
+		t.Errorf("overlay frame has %d pixels set, want all", testutil.CountSetPixels(client.LastFrame().Data))
+	}
+	// The layout is not rendered while the overlay is shown
+	if plain.GetRenderCalls() != 0 {
+		t.Errorf("Widget render calls = %d, want 0", plain.GetRenderCalls())
+	}
+
+	// A failing overlay falls back to the layout
+	alert.panics = true
+	if err := comp.renderFrame(); err != nil {
+		t.Fatalf("renderFrame() error = %v", err)
+	}
+	if got := testutil.CountSetPixels(client.LastFrame().Data); got != 0 {
+		t.Errorf("layout frame has %d pixels set, want 0", got)
+	}
+	if plain.GetRenderCalls() != 1 {
+		t.Errorf("Widget render calls = %d, want 1", plain.GetRenderCalls())
+	}
+}
+
 // TestCompositor_RenderFrame_SendError tests error handling during send
 func TestCompositor_RenderFrame_SendError(t *testing.T) {
 	client := testutil.NewTestClient()
@@ -0,0 +1,97 @@
+package compositor
+
+import (
+	"sync"
+	"time"
+)
+
+// FramePacer limits how often frames are pushed to the device.
+// Render ticks arriving before the next push slot are coalesced: the frame is
+// skipped and the next allowed tick renders the latest widget state instead.
+type FramePacer struct {
+	mu        sync.Mutex
+	enabled   bool
+	interval  time.Duration // Minimum time between pushes
+	slack     time.Duration // Tolerance for render ticker jitter
+	lastPush  time.Time
+	pushed    uint64
+	coalesced uint64
+}
+
+// PacerStats holds frame pacing counters
+type PacerStats struct {
+	MaxFPS    int
+	Pushed    uint64 // Frames pushed to the device
+	Coalesced uint64 // Render ticks skipped because the push slot wasn't open yet
+}
+
+// NewFramePacer creates a pacer limiting pushes to maxFPS.
+// tick is the render loop interval, used to tolerate ticker jitter.
+// If maxFPS is 0 or less, Ready always returns true.
+func NewFramePacer(maxFPS int, tick time.Duration) *FramePacer {
+	if maxFPS <= 0 {
+		return &FramePacer{}
+	}
+
+	interval := time.Second / time.Duration(maxFPS)
+	// Without slack a tick landing a few microseconds early would be coalesced
+	// and halve the effective rate
+	slack := min(tick/2, interval/2)
+
+	return &FramePacer{
+		enabled:  true,
+		interval: interval,
+		slack:    slack,
+	}
+}
+
+// IsEnabled returns true if push pacing is active
+func (p *FramePacer) IsEnabled() bool {
+	return p.enabled
+}
+
+// MaxFPS returns the configured push rate limit (0 = unlimited)
+func (p *FramePacer) MaxFPS() int {
+	if !p.enabled {
+		return 0
+	}
+	return int(time.Second / p.interval)
+}
+
+// Ready reports whether a frame may be pushed at the given time.
+// A false result counts the frame as coalesced.
+func (p *FramePacer) Ready(now time.Time) bool {
+	if !p.enabled {
+		return true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.lastPush.IsZero() || now.Sub(p.lastPush) >= p.interval-p.slack {
+		return true
+	}
+	p.coalesced++
+	return false
+}
+
+// MarkPushed records that a frame was pushed to the device at the given time
+func (p *FramePacer) MarkPushed(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastPush = now
+	p.pushed++
+}
+
+// Stats returns the current pacing counters
+func (p *FramePacer) Stats() PacerStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return PacerStats{
+		MaxFPS:    p.MaxFPS(),
+		Pushed:    p.pushed,
+		Coalesced: p.coalesced,
+	}
+}
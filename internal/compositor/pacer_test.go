@@ -0,0 +1,94 @@
+package compositor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewFramePacer(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		p := NewFramePacer(0, 100*time.Millisecond)
+		if p.IsEnabled() {
+			t.Error("expected pacer to be disabled")
+		}
+		if p.MaxFPS() != 0 {
+			t.Errorf("MaxFPS() = %d, want 0", p.MaxFPS())
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		p := NewFramePacer(10, 20*time.Millisecond)
+		if !p.IsEnabled() {
+			t.Error("expected pacer to be enabled")
+		}
+		if p.MaxFPS() != 10 {
+			t.Errorf("MaxFPS() = %d, want 10", p.MaxFPS())
+		}
+	})
+}
+
+func TestFramePacer_Disabled_AlwaysReady(t *testing.T) {
+	p := NewFramePacer(0, 10*time.Millisecond)
+	now := time.Now()
+
+	for i := 0; i < 5; i++ {
+		if !p.Ready(now) {
+			t.Fatal("disabled pacer should always be ready")
+		}
+		p.MarkPushed(now)
+	}
+
+	if stats := p.Stats(); stats.Coalesced != 0 || stats.Pushed != 5 {
+		t.Errorf("stats = %+v, want 5 pushed, 0 coalesced", stats)
+	}
+}
+
+func TestFramePacer_CoalescesFastRenders(t *testing.T) {
+	// 10 FPS push limit with a 20ms render tick: one push per 5 ticks
+	p := NewFramePacer(10, 20*time.Millisecond)
+	start := time.Now()
+
+	for i := 0; i < 50; i++ {
+		now := start.Add(time.Duration(i) * 20 * time.Millisecond)
+		if p.Ready(now) {
+			p.MarkPushed(now)
+		}
+	}
+
+	stats := p.Stats()
+	if stats.Pushed != 10 {
+		t.Errorf("Pushed = %d, want 10", stats.Pushed)
+	}
+	if stats.Coalesced != 40 {
+		t.Errorf("Coalesced = %d, want 40", stats.Coalesced)
+	}
+}
+
+func TestFramePacer_ToleratesTickJitter(t *testing.T) {
+	// Push limit matches the render tick; a tick arriving slightly early must not be skipped
+	p := NewFramePacer(10, 100*time.Millisecond)
+	start := time.Now()
+
+	p.MarkPushed(start)
+	early := start.Add(99 * time.Millisecond)
+	if !p.Ready(early) {
+		t.Error("tick 1ms early should still be ready")
+	}
+}
+
+func TestFramePacer_NotReadyUntilIntervalElapsed(t *testing.T) {
+	p := NewFramePacer(10, 10*time.Millisecond)
+	start := time.Now()
+
+	if !p.Ready(start) {
+		t.Fatal("first frame should always be ready")
+	}
+	p.MarkPushed(start)
+
+	if p.Ready(start.Add(50 * time.Millisecond)) {
+		t.Error("frame 50ms after push should be coalesced at 10 FPS")
+	}
+	if !p.Ready(start.Add(100 * time.Millisecond)) {
+		t.Error("frame 100ms after push should be ready at 10 FPS")
+	}
+}
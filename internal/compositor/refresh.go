@@ -0,0 +1,105 @@
+package compositor
+
+import (
+	"image"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// RefreshCycle periodically overrides the composited frame with an inverted or solid
+// image for a fraction of a second. This is a common OLED maintenance routine that
+// evens out wear between pixels that are usually lit and pixels that are usually dark.
+type RefreshCycle struct {
+	enabled  bool
+	mode     string
+	interval time.Duration
+	duration time.Duration
+	start    time.Time
+
+	frame *image.Gray // Reused output buffer for override frames
+}
+
+// NewRefreshCycle creates a refresh cycle for the given display.
+// If the cycle is not configured or not enabled, Apply returns frames unchanged.
+func NewRefreshCycle(display config.DisplayConfig, now time.Time) *RefreshCycle {
+	cfg := display.RefreshCycle
+	if cfg == nil || !cfg.Enabled {
+		return &RefreshCycle{}
+	}
+
+	mode := cfg.Mode
+	if mode == "" {
+		mode = config.RefreshCycleInvert
+	}
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = config.DefaultRefreshCycleInterval
+	}
+	durationMs := cfg.DurationMs
+	if durationMs <= 0 {
+		durationMs = config.DefaultRefreshCycleDurationMs
+	}
+
+	return &RefreshCycle{
+		enabled:  true,
+		mode:     mode,
+		interval: time.Duration(interval * float64(time.Second)),
+		duration: time.Duration(durationMs) * time.Millisecond,
+		start:    now,
+	}
+}
+
+// IsEnabled returns whether the refresh cycle is active
+func (r *RefreshCycle) IsEnabled() bool {
+	return r.enabled
+}
+
+// Active reports whether a refresh cycle is running at the given time.
+// The first cycle starts one interval after the refresh cycle was created.
+func (r *RefreshCycle) Active(now time.Time) bool {
+	if !r.enabled || r.interval <= 0 {
+		return false
+	}
+	elapsed := now.Sub(r.start)
+	if elapsed < r.interval {
+		return false
+	}
+	return elapsed%r.interval < r.duration
+}
+
+// Apply returns the override frame while a refresh cycle is running and canvas otherwise.
+// The override frame is an internal buffer reused on the next call.
+func (r *RefreshCycle) Apply(canvas image.Image, now time.Time) image.Image {
+	if !r.Active(now) {
+		return canvas
+	}
+	gray, ok := canvas.(*image.Gray)
+	if !ok {
+		return canvas
+	}
+
+	bounds := gray.Bounds()
+	if r.frame == nil || r.frame.Bounds() != bounds {
+		r.frame = image.NewGray(bounds)
+	}
+
+	switch r.mode {
+	case config.RefreshCycleWhite:
+		for i := range r.frame.Pix {
+			r.frame.Pix[i] = 255
+		}
+	case config.RefreshCycleBlack:
+		clear(r.frame.Pix)
+	default:
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			start := gray.PixOffset(bounds.Min.X, y)
+			src := gray.Pix[start : start+bounds.Dx()]
+			dst := r.frame.Pix[r.frame.PixOffset(bounds.Min.X, y):]
+			for i, v := range src {
+				dst[i] = 255 - v
+			}
+		}
+	}
+	return r.frame
+}
@@ -0,0 +1,115 @@
+package compositor
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func refreshDisplay(r *config.RefreshCycleConfig) config.DisplayConfig {
+	return config.DisplayConfig{Width: 4, Height: 2, RefreshCycle: r}
+}
+
+func TestNewRefreshCycle_Disabled(t *testing.T) {
+	now := time.Now()
+	for _, r := range []*config.RefreshCycleConfig{nil, {Enabled: false, Interval: 1}} {
+		rc := NewRefreshCycle(refreshDisplay(r), now)
+		if rc.IsEnabled() {
+			t.Error("expected refresh cycle to be disabled")
+		}
+
+		img := image.NewGray(image.Rect(0, 0, 4, 2))
+		if got := rc.Apply(img, now.Add(2*time.Hour)); got != image.Image(img) {
+			t.Error("disabled refresh cycle should return the canvas unchanged")
+		}
+	}
+}
+
+func TestRefreshCycle_Active(t *testing.T) {
+	start := time.Now()
+	rc := NewRefreshCycle(refreshDisplay(&config.RefreshCycleConfig{
+		Enabled:    true,
+		Interval:   60,
+		DurationMs: 500,
+	}), start)
+
+	tests := []struct {
+		offset time.Duration
+		want   bool
+	}{
+		{0, false}, // No cycle right at startup
+		{30 * time.Second, false},
+		{60 * time.Second, true},
+		{60*time.Second + 499*time.Millisecond, true},
+		{60*time.Second + 500*time.Millisecond, false},
+		{90 * time.Second, false},
+		{120*time.Second + 100*time.Millisecond, true},
+	}
+	for _, tt := range tests {
+		if got := rc.Active(start.Add(tt.offset)); got != tt.want {
+			t.Errorf("Active(+%v) = %v, want %v", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestRefreshCycle_Defaults(t *testing.T) {
+	start := time.Now()
+	rc := NewRefreshCycle(refreshDisplay(&config.RefreshCycleConfig{Enabled: true}), start)
+
+	if rc.mode != config.RefreshCycleInvert {
+		t.Errorf("mode = %q, want %q", rc.mode, config.RefreshCycleInvert)
+	}
+	if rc.Active(start.Add(59 * time.Minute)) {
+		t.Error("default cycle should not run before an hour has passed")
+	}
+	if !rc.Active(start.Add(time.Hour)) {
+		t.Error("default cycle should run after an hour")
+	}
+	if rc.Active(start.Add(time.Hour + time.Second)) {
+		t.Error("default cycle should last less than a second")
+	}
+}
+
+func TestRefreshCycle_Apply(t *testing.T) {
+	start := time.Now()
+	during := start.Add(10 * time.Second)
+
+	canvas := image.NewGray(image.Rect(0, 0, 4, 2))
+	copy(canvas.Pix, []uint8{0, 255, 100, 10, 20, 30, 40, 50})
+
+	tests := []struct {
+		mode string
+		want func(v uint8) uint8
+	}{
+		{config.RefreshCycleInvert, func(v uint8) uint8 { return 255 - v }},
+		{config.RefreshCycleWhite, func(uint8) uint8 { return 255 }},
+		{config.RefreshCycleBlack, func(uint8) uint8 { return 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			rc := NewRefreshCycle(refreshDisplay(&config.RefreshCycleConfig{
+				Enabled:  true,
+				Mode:     tt.mode,
+				Interval: 10,
+			}), start)
+
+			out, ok := rc.Apply(canvas, during).(*image.Gray)
+			if !ok {
+				t.Fatal("expected *image.Gray result")
+			}
+			for i, v := range canvas.Pix {
+				if out.Pix[i] != tt.want(v) {
+					t.Errorf("pixel %d = %d, want %d", i, out.Pix[i], tt.want(v))
+				}
+			}
+
+			// Outside the cycle the canvas passes through untouched
+			if got := rc.Apply(canvas, during.Add(5*time.Second)); got != image.Image(canvas) {
+				t.Error("expected canvas outside the refresh cycle")
+			}
+		})
+	}
+}
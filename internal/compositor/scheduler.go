@@ -1,6 +1,7 @@
 package compositor
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -14,11 +15,18 @@ import (
 type WidgetScheduler struct {
 	widgets  []widget.Widget
 	stopChan chan struct{}
+	loops    map[widget.Widget]*updateLoop // Running update loops, for removing single widgets
 	wg       sync.WaitGroup
 	running  bool
 	mu       sync.Mutex
 }
 
+// updateLoop controls the update goroutine of a single widget
+type updateLoop struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
 // NewWidgetScheduler creates a new scheduler for the given widgets.
 func NewWidgetScheduler(widgets []widget.Widget) *WidgetScheduler {
 	return &WidgetScheduler{
@@ -37,11 +45,11 @@ func (s *WidgetScheduler) Start() {
 	}
 
 	s.stopChan = make(chan struct{})
+	s.loops = make(map[widget.Widget]*updateLoop)
 	s.running = true
 
 	for _, w := range s.widgets {
-		s.wg.Add(1)
-		go s.widgetUpdateLoop(w)
+		s.startLoop(w)
 	}
 
 	log.Printf("Widget scheduler started with %d widget(s)", len(s.widgets))
@@ -57,17 +65,69 @@ func (s *WidgetScheduler) Stop() {
 	}
 	s.running = false
 	close(s.stopChan)
+	widgets := s.widgets
 	s.mu.Unlock()
 
 	// Wait for all update loops to finish
 	s.wg.Wait()
 
 	// Stop any widgets that need cleanup (goroutines, subscriptions, etc.)
-	widget.StopWidgets(s.widgets)
+	widget.StopWidgets(widgets)
 
 	log.Println("Widget scheduler stopped")
 }
 
+// AddWidget adds a widget and starts its update loop if the scheduler is running.
+func (s *WidgetScheduler) AddWidget(w widget.Widget) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.widgets = append(s.widgets, w)
+	if s.running {
+		s.startLoop(w)
+	}
+}
+
+// RemoveWidget stops the update loop of a widget, waits for it to finish and
+// calls Stop() on the widget if it implements Stoppable.
+// Does nothing if the widget is not managed by this scheduler.
+func (s *WidgetScheduler) RemoveWidget(w widget.Widget) {
+	s.mu.Lock()
+	idx := -1
+	for i, sw := range s.widgets {
+		if sw == w {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		s.mu.Unlock()
+		return
+	}
+	s.widgets = append(s.widgets[:idx:idx], s.widgets[idx+1:]...)
+	loop := s.loops[w]
+	delete(s.loops, w)
+	// After Stop the widget has already been stopped along with the others
+	needsStop := s.running || s.stopChan == nil
+	s.mu.Unlock()
+
+	if loop != nil {
+		close(loop.stop)
+		<-loop.done
+	}
+	if needsStop {
+		widget.StopWidget(w)
+	}
+}
+
+// startLoop starts the update goroutine of a widget. Must be called with mu held.
+func (s *WidgetScheduler) startLoop(w widget.Widget) {
+	loop := &updateLoop{stop: make(chan struct{}), done: make(chan struct{})}
+	s.loops[w] = loop
+	s.wg.Add(1)
+	go s.widgetUpdateLoop(w, loop)
+}
+
 // IsRunning returns whether the scheduler is currently active.
 func (s *WidgetScheduler) IsRunning() bool {
 	s.mu.Lock()
@@ -81,26 +141,49 @@ func (s *WidgetScheduler) WidgetCount() int {
 }
 
 // widgetUpdateLoop runs the update loop for a single widget.
-func (s *WidgetScheduler) widgetUpdateLoop(w widget.Widget) {
+func (s *WidgetScheduler) widgetUpdateLoop(w widget.Widget, loop *updateLoop) {
 	defer s.wg.Done()
+	defer close(loop.done)
 	defer logPanic(fmt.Sprintf("widgetUpdateLoop for %s", w.Name()))
 
 	ticker := time.NewTicker(w.GetUpdateInterval())
 	defer ticker.Stop()
 
 	// Initial update
-	if err := w.Update(); err != nil {
-		log.Printf("Widget %s update error: %v", w.Name(), err)
-	}
+	panicking := false
+	s.update(w, &panicking)
 
 	for {
 		select {
 		case <-s.stopChan:
 			return
+		case <-loop.stop:
+			return
 		case <-ticker.C:
-			if err := w.Update(); err != nil {
-				log.Printf("Widget %s update error: %v", w.Name(), err)
-			}
+			s.update(w, &panicking)
+		}
+	}
+}
+
+// update runs a single widget update. A panic is logged with its stack trace
+// (once until the widget stops panicking) and doesn't end the update loop.
+func (s *WidgetScheduler) update(w widget.Widget, panicking *bool) {
+	err := widget.SafeUpdate(w)
+	if listener, ok := w.(widget.UpdateListener); ok && err == nil {
+		listener.MarkUpdated()
+	}
+
+	var panicErr *widget.PanicError
+	if errors.As(err, &panicErr) {
+		if !*panicking {
+			log.Printf("%v\n%s", panicErr, panicErr.Stack)
+			*panicking = true
 		}
+		return
+	}
+	*panicking = false
+
+	if err != nil {
+		log.Printf("Widget %s update error: %v", w.Name(), err)
 	}
 }
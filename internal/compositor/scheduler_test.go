@@ -1,6 +1,7 @@
 package compositor
 
 import (
+	"errors"
 	"image"
 	"sync/atomic"
 	"testing"
@@ -92,6 +93,74 @@ func TestWidgetScheduler_WidgetUpdates(t *testing.T) {
 	}
 }
 
+// mockPanickingSchedulerWidget panics on every update
+type mockPanickingSchedulerWidget struct {
+	*mockSchedulerWidget
+}
+
+func (w *mockPanickingSchedulerWidget) Update() error {
+	w.updateCount.Add(1)
+	panic("test update panic")
+}
+
+func TestWidgetScheduler_UpdatePanicKeepsLoopRunning(t *testing.T) {
+	w := &mockPanickingSchedulerWidget{mockSchedulerWidget: newMockSchedulerWidget("panicking", 20*time.Millisecond)}
+	widgets := []widget.Widget{w}
+
+	scheduler := NewWidgetScheduler(widgets)
+	scheduler.Start()
+
+	time.Sleep(100 * time.Millisecond)
+
+	scheduler.Stop()
+
+	// The loop must survive the panics and keep calling Update
+	if updateCount := w.GetUpdateCount(); updateCount < 3 {
+		t.Errorf("Widget should have been updated at least 3 times despite panics, got %d", updateCount)
+	}
+}
+
+// mockListeningSchedulerWidget records MarkUpdated calls and fails Update on demand
+type mockListeningSchedulerWidget struct {
+	*mockSchedulerWidget
+	updateErr error
+	panics    bool
+	marked    int
+}
+
+func (w *mockListeningSchedulerWidget) Update() error {
+	if w.panics {
+		panic("test update panic")
+	}
+	return w.updateErr
+}
+
+func (w *mockListeningSchedulerWidget) MarkUpdated() { w.marked++ }
+
+func TestWidgetScheduler_MarksOnlySuccessfulUpdates(t *testing.T) {
+	w := &mockListeningSchedulerWidget{mockSchedulerWidget: newMockSchedulerWidget("listening", time.Second)}
+	scheduler := NewWidgetScheduler([]widget.Widget{w})
+	panicking := false
+
+	scheduler.update(w, &panicking)
+	if w.marked != 1 {
+		t.Fatalf("marked = %d after a successful update, want 1", w.marked)
+	}
+
+	w.updateErr = errors.New("fetch failed")
+	scheduler.update(w, &panicking)
+	if w.marked != 1 {
+		t.Errorf("marked = %d after a failed update, want 1", w.marked)
+	}
+
+	w.updateErr = nil
+	w.panics = true
+	scheduler.update(w, &panicking)
+	if w.marked != 1 {
+		t.Errorf("marked = %d after a panicking update, want 1", w.marked)
+	}
+}
+
 func TestWidgetScheduler_MultipleWidgets(t *testing.T) {
 	w1 := newMockSchedulerWidget("fast", 20*time.Millisecond)
 	w2 := newMockSchedulerWidget("slow", 50*time.Millisecond)
@@ -174,3 +243,52 @@ func TestWidgetScheduler_EmptyWidgets(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 	scheduler.Stop()
 }
+
+// mockStoppableSchedulerWidget counts Stop calls
+type mockStoppableSchedulerWidget struct {
+	*mockSchedulerWidget
+	stops atomic.Int32
+}
+
+func (w *mockStoppableSchedulerWidget) Stop() { w.stops.Add(1) }
+
+func TestWidgetScheduler_AddRemoveWidget(t *testing.T) {
+	scheduler := NewWidgetScheduler(nil)
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	w := &mockStoppableSchedulerWidget{mockSchedulerWidget: newMockSchedulerWidget("added", 10*time.Millisecond)}
+	scheduler.AddWidget(w)
+	time.Sleep(50 * time.Millisecond)
+
+	if w.GetUpdateCount() == 0 {
+		t.Fatal("added widget should be updated while the scheduler runs")
+	}
+
+	scheduler.RemoveWidget(w)
+	if got := w.stops.Load(); got != 1 {
+		t.Errorf("Stop() calls after RemoveWidget = %d, want 1", got)
+	}
+	if scheduler.WidgetCount() != 0 {
+		t.Errorf("WidgetCount() = %d after RemoveWidget, want 0", scheduler.WidgetCount())
+	}
+
+	count := w.GetUpdateCount()
+	time.Sleep(50 * time.Millisecond)
+	if w.GetUpdateCount() != count {
+		t.Error("removed widget should no longer be updated")
+	}
+}
+
+func TestWidgetScheduler_RemoveAfterStop(t *testing.T) {
+	w := &mockStoppableSchedulerWidget{mockSchedulerWidget: newMockSchedulerWidget("w", time.Second)}
+	scheduler := NewWidgetScheduler([]widget.Widget{w})
+	scheduler.Start()
+	scheduler.Stop()
+
+	// Already stopped along with the scheduler: must not be stopped twice
+	scheduler.RemoveWidget(w)
+	if got := w.stops.Load(); got != 1 {
+		t.Errorf("Stop() calls = %d, want 1", got)
+	}
+}
@@ -0,0 +1,64 @@
+package compositor
+
+import (
+	"image"
+	"math"
+)
+
+// ToneAdjuster applies the global brightness and contrast settings to the
+// composited grayscale frame. Contrast scales levels around mid-gray, then the
+// brightness offset is added; results are clamped to 0..255.
+type ToneAdjuster struct {
+	enabled bool
+	lut     [256]uint8
+
+	adjusted *image.Gray // Reused output buffer, so source images are never modified
+}
+
+// NewToneAdjuster creates a tone adjuster. A contrast of 0 means unchanged (1.0);
+// with no brightness offset and unchanged contrast, Apply returns frames as is.
+func NewToneAdjuster(brightness int, contrast float64) *ToneAdjuster {
+	if contrast <= 0 {
+		contrast = 1
+	}
+	if brightness == 0 && contrast == 1 {
+		return &ToneAdjuster{}
+	}
+
+	t := &ToneAdjuster{enabled: true}
+	for v := range t.lut {
+		level := (float64(v)-128)*contrast + 128 + float64(brightness)
+		t.lut[v] = uint8(min(max(math.Round(level), 0), 255))
+	}
+	return t
+}
+
+// IsEnabled returns whether the adjuster changes frames
+func (t *ToneAdjuster) IsEnabled() bool {
+	return t.enabled
+}
+
+// Apply returns the frame with adjusted levels. The returned image may be an
+// internal buffer reused on the next call.
+func (t *ToneAdjuster) Apply(canvas image.Image) image.Image {
+	if !t.enabled {
+		return canvas
+	}
+	gray, ok := canvas.(*image.Gray)
+	if !ok {
+		return canvas
+	}
+
+	bounds := gray.Bounds()
+	if t.adjusted == nil || t.adjusted.Rect.Size() != bounds.Size() {
+		t.adjusted = image.NewGray(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	}
+	for y := 0; y < bounds.Dy(); y++ {
+		src := gray.Pix[gray.PixOffset(bounds.Min.X, bounds.Min.Y+y):][:bounds.Dx()]
+		dst := t.adjusted.Pix[y*t.adjusted.Stride:][:bounds.Dx()]
+		for x, v := range src {
+			dst[x] = t.lut[v]
+		}
+	}
+	return t.adjusted
+}
@@ -0,0 +1,82 @@
+package compositor
+
+import (
+	"image"
+	"testing"
+)
+
+func TestNewToneAdjuster_Disabled(t *testing.T) {
+	for _, contrast := range []float64{0, 1} {
+		tone := NewToneAdjuster(0, contrast)
+		if tone.IsEnabled() {
+			t.Errorf("contrast %v without brightness should be disabled", contrast)
+		}
+
+		canvas := image.NewGray(image.Rect(0, 0, 4, 2))
+		if got := tone.Apply(canvas); got != canvas {
+			t.Error("disabled adjuster should return the frame unchanged")
+		}
+	}
+}
+
+func TestToneAdjuster_Levels(t *testing.T) {
+	tests := []struct {
+		name       string
+		brightness int
+		contrast   float64
+		in         []uint8
+		want       []uint8
+	}{
+		{"brightness up", 50, 0, []uint8{0, 100, 230, 255}, []uint8{50, 150, 255, 255}},
+		{"brightness down", -50, 1, []uint8{0, 30, 100, 255}, []uint8{0, 0, 50, 205}},
+		{"contrast up", 0, 2, []uint8{0, 64, 128, 200}, []uint8{0, 0, 128, 255}},
+		{"contrast down", 0, 0.5, []uint8{0, 128, 200, 255}, []uint8{64, 128, 164, 192}},
+		{"both", 20, 2, []uint8{100, 128}, []uint8{92, 148}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tone := NewToneAdjuster(tt.brightness, tt.contrast)
+			canvas := image.NewGray(image.Rect(0, 0, len(tt.in), 1))
+			copy(canvas.Pix, tt.in)
+
+			got := tone.Apply(canvas).(*image.Gray)
+			for i, want := range tt.want {
+				if got.Pix[i] != want {
+					t.Errorf("level %d -> %d, want %d", tt.in[i], got.Pix[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestToneAdjuster_KeepsSource(t *testing.T) {
+	tone := NewToneAdjuster(10, 1)
+	canvas := image.NewGray(image.Rect(0, 0, 3, 3))
+
+	// Applying twice to the same frame must not compound the adjustment
+	tone.Apply(canvas)
+	got := tone.Apply(canvas).(*image.Gray)
+
+	if canvas.Pix[0] != 0 {
+		t.Errorf("source modified: %d", canvas.Pix[0])
+	}
+	if got.Pix[0] != 10 {
+		t.Errorf("adjusted level = %d, want 10", got.Pix[0])
+	}
+}
+
+func TestToneAdjuster_SubImage(t *testing.T) {
+	tone := NewToneAdjuster(0, 2)
+	canvas := image.NewGray(image.Rect(0, 0, 4, 4))
+	canvas.Pix[canvas.PixOffset(2, 2)] = 100
+	sub := canvas.SubImage(image.Rect(2, 2, 4, 4)).(*image.Gray)
+
+	got := tone.Apply(sub).(*image.Gray)
+	if b := got.Bounds(); b.Dx() != 2 || b.Dy() != 2 {
+		t.Fatalf("size = %dx%d, want 2x2", b.Dx(), b.Dy())
+	}
+	if got.Pix[0] != 72 {
+		t.Errorf("top-left level = %d, want 72", got.Pix[0])
+	}
+}
@@ -0,0 +1,287 @@
+package config
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Profile bundle layout: the config at the root, referenced local files in subdirectories
+const (
+	bundleConfigFile = "config.json"
+	bundleFontsDir   = "fonts"
+	bundleWadsDir    = "wads"
+
+	// maxBundleFileSize limits a single unpacked bundle file (WADs are the largest assets)
+	maxBundleFileSize = 64 << 20
+)
+
+// bundleAssetPattern matches "font" and "wad" string values in a config file.
+// Paths are rewritten textually so the rest of the file keeps its formatting.
+var bundleAssetPattern = regexp.MustCompile(`"(font|wad)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+
+// bundleAssetDir returns the bundle directory for files referenced by a config key
+func bundleAssetDir(key string) string {
+	if key == "wad" {
+		return bundleWadsDir
+	}
+	return bundleFontsDir
+}
+
+// rewriteAssetPaths replaces "font" and "wad" values using rewrite, which receives the
+// key and the decoded value and returns the new value and whether to replace it
+func rewriteAssetPaths(data []byte, rewrite func(key, value string) (string, bool)) []byte {
+	return bundleAssetPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		m := bundleAssetPattern.FindSubmatch(match)
+		var value string
+		if err := json.Unmarshal([]byte(`"`+string(m[3])+`"`), &value); err != nil {
+			return match
+		}
+		newValue, ok := rewrite(string(m[1]), value)
+		if !ok {
+			return match
+		}
+		encoded, err := json.Marshal(newValue)
+		if err != nil {
+			return match
+		}
+		return []byte(`"` + string(m[1]) + `"` + string(m[2]) + string(encoded))
+	})
+}
+
+// resolveLocalFile returns the absolute path of a local file referenced by a config value.
+// Relative paths are resolved against the base directory, matching how they are loaded.
+// Returns false for font names, built-in fonts and missing files.
+func (pm *ProfileManager) resolveLocalFile(value string) (string, bool) {
+	if value == "" {
+		return "", false
+	}
+	p := value
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(pm.baseDir, p)
+	}
+	info, err := os.Stat(p)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", false
+	}
+	return p, true
+}
+
+// uniqueName returns name, or name with a numeric suffix before the extension,
+// such that taken reports false for it
+func uniqueName(name string, taken func(string) bool) string {
+	if !taken(name) {
+		return name
+	}
+	ext := path.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	for i := 2; ; i++ {
+		candidate := stem + "_" + strconv.Itoa(i) + ext
+		if !taken(candidate) {
+			return candidate
+		}
+	}
+}
+
+// ExportBundle writes the profile at configPath as a zip bundle to w. Local font and
+// WAD files referenced by "font" and "wad" values are included under fonts/ and wads/,
+// and the bundled config refers to them there. Font names, built-in fonts and
+// download URLs (bundled_font_url, bundled_wad_url) are kept as they are.
+func (pm *ProfileManager) ExportBundle(configPath string, w io.Writer) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	// Bundle path of each included file, keyed by its absolute path
+	bundled := make(map[string]string)
+	used := make(map[string]bool)
+	var sources []string
+
+	data = rewriteAssetPaths(data, func(key, value string) (string, bool) {
+		src, ok := pm.resolveLocalFile(value)
+		if !ok {
+			return "", false
+		}
+		if name, ok := bundled[src]; ok {
+			return name, true
+		}
+		dir := bundleAssetDir(key)
+		name := dir + "/" + uniqueName(filepath.Base(src), func(n string) bool { return used[dir+"/"+n] })
+		used[name] = true
+		bundled[src] = name
+		sources = append(sources, src)
+		return name, true
+	})
+
+	zw := zip.NewWriter(w)
+	if err := writeZipFile(zw, bundleConfigFile, data); err != nil {
+		return err
+	}
+	for _, src := range sources {
+		content, err := os.ReadFile(src)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", src, err)
+		}
+		if err := writeZipFile(zw, bundled[src], content); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return nil
+}
+
+// writeZipFile adds a file with the given content to the zip archive
+func writeZipFile(zw *zip.Writer, name string, content []byte) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if _, err := f.Write(content); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return nil
+}
+
+// ImportBundle unpacks a zip bundle created by ExportBundle and adds its config as a
+// new profile. Fonts and WADs are placed in the fonts/ and wads/ directories next to
+// the main config; a file with the same name and content is reused, otherwise the
+// file gets a unique name. The config is rewritten to refer to the unpacked files.
+// Returns the path to the created profile file.
+func (pm *ProfileManager) ImportBundle(data []byte) (string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("invalid bundle: %w", err)
+	}
+
+	var cfgData []byte
+	assets := make(map[string][]byte) // Bundle path -> content
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		name := path.Clean(strings.ReplaceAll(f.Name, "\\", "/"))
+		dir, base := path.Split(name)
+		dir = strings.TrimSuffix(dir, "/")
+		if name != bundleConfigFile && dir != bundleFontsDir && dir != bundleWadsDir {
+			continue
+		}
+		content, err := readZipFile(f)
+		if err != nil {
+			return "", err
+		}
+		if name == bundleConfigFile {
+			cfgData = content
+		} else if base != "" && base != ".." {
+			assets[name] = content
+		}
+	}
+	if cfgData == nil {
+		return "", fmt.Errorf("invalid bundle: %s not found", bundleConfigFile)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(cfgData, &cfg); err != nil {
+		return "", fmt.Errorf("invalid bundle config: %w", err)
+	}
+
+	// Unpack assets referenced by the config, remembering where each one ended up
+	placed := make(map[string]string)
+	var unpackErr error
+	cfgData = rewriteAssetPaths(cfgData, func(key, value string) (string, bool) {
+		content, ok := assets[value]
+		if !ok || unpackErr != nil {
+			return "", false
+		}
+		if p, ok := placed[value]; ok {
+			return p, true
+		}
+		p, err := pm.unpackAsset(value, content)
+		if err != nil {
+			unpackErr = err
+			return "", false
+		}
+		placed[value] = p
+		return p, true
+	})
+	if unpackErr != nil {
+		return "", unpackErr
+	}
+
+	name := cfg.ConfigName
+	if name == "" {
+		name = "Imported"
+	}
+
+	profilesPath := filepath.Join(pm.baseDir, ProfilesDir)
+	if err := os.MkdirAll(profilesPath, 0755); err != nil {
+		return "", fmt.Errorf("failed to create profiles directory: %w", err)
+	}
+	filename := uniqueName(pm.sanitizeFilename(name)+".json", func(n string) bool {
+		_, err := os.Stat(filepath.Join(profilesPath, n))
+		return err == nil
+	})
+	profilePath := filepath.Join(profilesPath, filename)
+	if err := os.WriteFile(profilePath, cfgData, 0644); err != nil {
+		return "", fmt.Errorf("failed to write profile: %w", err)
+	}
+
+	pm.addProfile(profilePath, name)
+
+	return profilePath, nil
+}
+
+// unpackAsset writes a bundled file into the matching directory under the base
+// directory and returns its path relative to the base directory, with forward slashes
+func (pm *ProfileManager) unpackAsset(bundlePath string, content []byte) (string, error) {
+	dir, base := path.Split(bundlePath)
+	dir = strings.TrimSuffix(dir, "/")
+
+	destDir := filepath.Join(pm.baseDir, dir)
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s directory: %w", dir, err)
+	}
+
+	name := uniqueName(base, func(n string) bool {
+		existing, err := os.ReadFile(filepath.Join(destDir, n))
+		return err == nil && !bytes.Equal(existing, content)
+	})
+	dest := filepath.Join(destDir, name)
+	if _, err := os.Stat(dest); err != nil {
+		if err := os.WriteFile(dest, content, 0644); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return dir + "/" + name, nil
+}
+
+// readZipFile reads a bundle entry, rejecting files larger than maxBundleFileSize
+func readZipFile(f *zip.File) ([]byte, error) {
+	if f.UncompressedSize64 > maxBundleFileSize {
+		return nil, fmt.Errorf("bundle file %s is too large", f.Name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle file %s: %w", f.Name, err)
+	}
+	defer func() { _ = rc.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxBundleFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle file %s: %w", f.Name, err)
+	}
+	if len(content) > maxBundleFileSize {
+		return nil, fmt.Errorf("bundle file %s is too large", f.Name)
+	}
+	return content, nil
+}
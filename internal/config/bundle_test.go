@@ -0,0 +1,165 @@
+package config
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// bundleTestConfig references a relative font, an absolute WAD, a built-in font and a system font
+const bundleTestConfig = `{
+  "config_name": "Game Night",
+  "display": {"width": 128, "height": 40},
+  "defaults": {"text": {"font": "my font.ttf"}},
+  "widgets": [
+    {"type": "clock", "text": {"font": "pixel5x7"}},
+    {"type": "doom", "wad": %q, "bundled_wad_url": "https://example.com/doom1.wad"},
+    {"type": "clock", "text": {"font":   "my font.ttf"}},
+    {"type": "clock", "text": {"font": "Arial"}}
+  ]
+}`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
+
+func zipNames(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		content, err := readZipFile(f)
+		if err != nil {
+			t.Fatalf("readZipFile() error = %v", err)
+		}
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func TestProfileBundle_RoundTrip(t *testing.T) {
+	srcDir := t.TempDir()
+	wadPath := filepath.Join(t.TempDir(), "custom.wad")
+	writeFile(t, wadPath, "IWAD data")
+	writeFile(t, filepath.Join(srcDir, "my font.ttf"), "font data")
+	cfgPath := filepath.Join(srcDir, ProfilesDir, "game.json")
+	writeFile(t, cfgPath, strings.Replace(bundleTestConfig, "%q", `"`+filepath.ToSlash(wadPath)+`"`, 1))
+
+	src := NewProfileManager(srcDir)
+	var buf bytes.Buffer
+	if err := src.ExportBundle(cfgPath, &buf); err != nil {
+		t.Fatalf("ExportBundle() error = %v", err)
+	}
+
+	files := zipNames(t, buf.Bytes())
+	if len(files) != 3 {
+		t.Fatalf("bundle files = %v, want config, font and WAD", files)
+	}
+	if files["fonts/my font.ttf"] != "font data" || files["wads/custom.wad"] != "IWAD data" {
+		t.Errorf("bundle assets = %v", files)
+	}
+	bundled := files[bundleConfigFile]
+	for _, want := range []string{`"font": "fonts/my font.ttf"`, `"font":   "fonts/my font.ttf"`, `"wad": "wads/custom.wad"`,
+		`"font": "pixel5x7"`, `"font": "Arial"`, `"bundled_wad_url": "https://example.com/doom1.wad"`} {
+		if !strings.Contains(bundled, want) {
+			t.Errorf("bundled config missing %s:\n%s", want, bundled)
+		}
+	}
+
+	// The target already has a different file of the same name and the same WAD
+	dstDir := t.TempDir()
+	writeFile(t, filepath.Join(dstDir, "fonts", "my font.ttf"), "other font")
+	writeFile(t, filepath.Join(dstDir, "wads", "custom.wad"), "IWAD data")
+	writeFile(t, filepath.Join(dstDir, ProfilesDir, "game_night.json"), "{}")
+
+	dst := NewProfileManager(dstDir)
+	path, err := dst.ImportBundle(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ImportBundle() error = %v", err)
+	}
+	if want := filepath.Join(dstDir, ProfilesDir, "game_night_2.json"); path != want {
+		t.Errorf("ImportBundle() path = %s, want %s", path, want)
+	}
+
+	imported, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(imported), `"font": "fonts/my font_2.ttf"`) ||
+		!strings.Contains(string(imported), `"wad": "wads/custom.wad"`) {
+		t.Errorf("imported config paths not rewritten:\n%s", imported)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dstDir, "fonts", "my font_2.ttf")); string(data) != "font data" {
+		t.Errorf("unpacked font = %q, want %q", data, "font data")
+	}
+	if data, _ := os.ReadFile(filepath.Join(dstDir, "fonts", "my font.ttf")); string(data) != "other font" {
+		t.Errorf("existing font overwritten with %q", data)
+	}
+
+	profiles := dst.GetProfiles()
+	if len(profiles) != 1 || profiles[0].Path != path || profiles[0].Name != "Game Night" {
+		t.Errorf("profiles after import = %+v", profiles)
+	}
+}
+
+func TestProfileBundle_ImportRejectsTraversal(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		bundleConfigFile:       `{"config_name": "Evil", "widgets": [{"type": "doom", "wad": "wads/../../evil.wad"}]}`,
+		"wads/../../evil.wad":  "payload",
+		"../outside.ttf":       "payload",
+		"fonts/sub/nested.ttf": "payload",
+	} {
+		if err := writeZipFile(zw, name, []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "base")
+	pm := NewProfileManager(dir)
+	if _, err := pm.ImportBundle(buf.Bytes()); err != nil {
+		t.Fatalf("ImportBundle() error = %v", err)
+	}
+
+	for _, p := range []string{filepath.Join(root, "evil.wad"), filepath.Join(root, "outside.ttf"),
+		filepath.Join(dir, "fonts", "sub", "nested.ttf")} {
+		if _, err := os.Stat(p); err == nil {
+			t.Errorf("bundle wrote %s", p)
+		}
+	}
+}
+
+func TestProfileBundle_ImportInvalid(t *testing.T) {
+	pm := NewProfileManager(t.TempDir())
+
+	if _, err := pm.ImportBundle([]byte("not a zip")); err == nil {
+		t.Error("ImportBundle() of non-zip data should fail")
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if err := writeZipFile(zw, "fonts/a.ttf", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	_ = zw.Close()
+	if _, err := pm.ImportBundle(buf.Bytes()); err == nil || !strings.Contains(err.Error(), bundleConfigFile) {
+		t.Errorf("ImportBundle() without config error = %v, want missing %s", err, bundleConfigFile)
+	}
+}
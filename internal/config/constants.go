@@ -1,5 +1,9 @@
 package config
 
+// BackendAuto tries the registered backends by priority (GameSense, then the
+// direct driver, then the web client preview); same as omitting backend
+const BackendAuto = "auto"
+
 // Display modes for widgets
 const (
 	ModeText  = "text"
@@ -40,6 +44,17 @@ const (
 	AlignBottom VAlign = "bottom"
 )
 
+// TextOrientation defines how text is laid out within its area
+type TextOrientation string
+
+// Text orientations
+const (
+	OrientationHorizontal      TextOrientation = "horizontal"
+	OrientationRotateCW        TextOrientation = "rotate_cw"        // rotated 90° clockwise, reads top-to-bottom
+	OrientationRotateCCW       TextOrientation = "rotate_ccw"       // rotated 90° counter-clockwise, reads bottom-to-top
+	OrientationVerticalStacked TextOrientation = "vertical_stacked" // upright glyphs, each below the previous
+)
+
 // BlinkMode defines how blinking behaves
 type BlinkMode string
 
@@ -62,6 +77,22 @@ const (
 	ScrollBounce ScrollMode = "bounce"
 	// ScrollPauseEnds pauses at the end before resetting
 	ScrollPauseEnds ScrollMode = "pause_ends"
+	// ScrollTypewriter reveals the text one character at a time, then holds and restarts
+	ScrollTypewriter ScrollMode = "typewriter"
+)
+
+// Transition easings (progress curves)
+const (
+	// EasingLinear moves at a constant speed
+	EasingLinear = "linear"
+	// EasingEaseIn starts slow and ends fast
+	EasingEaseIn = "ease_in"
+	// EasingEaseOut starts fast and ends slow
+	EasingEaseOut = "ease_out"
+	// EasingEaseInOut is slow at both ends
+	EasingEaseInOut = "ease_in_out"
+	// EasingBounce reaches the end early and bounces back off it before settling
+	EasingBounce = "bounce"
 )
 
 // ScrollDirection defines the scroll direction
@@ -73,3 +104,170 @@ const (
 	ScrollUp    ScrollDirection = "up"
 	ScrollDown  ScrollDirection = "down"
 )
+
+// Analog clock hour markers
+const (
+	HourMarkersNone    = "none"    // No hour markers
+	HourMarkersTicks   = "ticks"   // Tick marks
+	HourMarkersNumbers = "numbers" // Arabic hour numbers
+	HourMarkersRoman   = "roman"   // Roman hour numerals
+)
+
+// Moon phase widget hemispheres
+const (
+	HemisphereNorthern = "northern" // Waxing moon lit on the right
+	HemisphereSouthern = "southern" // Waxing moon lit on the left
+)
+
+// OverlapMode defines how a widget affects the widgets beneath it
+type OverlapMode string
+
+const (
+	// OverlapOcclude draws the widget over lower widgets, hiding them
+	OverlapOcclude OverlapMode = "occlude"
+	// OverlapDim fades lower widgets under the widget's area instead of hiding them
+	OverlapDim OverlapMode = "dim"
+)
+
+// DefaultOverlapDimFactor is the brightness kept by widgets dimmed by an overlapping widget
+const DefaultOverlapDimFactor = 0.3
+
+// Burn-in protection defaults
+const (
+	// DefaultBurnInShiftInterval is the default number of seconds between frame shifts
+	DefaultBurnInShiftInterval = 60.0
+	// DefaultBurnInMagnitude is the default maximum frame shift in pixels
+	DefaultBurnInMagnitude = 1
+	// MaxBurnInMagnitude caps the frame shift so content is not pushed far off-screen
+	MaxBurnInMagnitude = 4
+	// DefaultBurnInDimFactor is the default brightness kept by dimmed static pixels
+	DefaultBurnInDimFactor = 0.5
+)
+
+// Refresh cycle modes
+const (
+	// RefreshCycleInvert shows the inverted frame during a refresh cycle
+	RefreshCycleInvert = "invert"
+	// RefreshCycleWhite lights every pixel during a refresh cycle
+	RefreshCycleWhite = "white"
+	// RefreshCycleBlack turns every pixel off during a refresh cycle
+	RefreshCycleBlack = "black"
+)
+
+// Refresh cycle defaults
+const (
+	// DefaultRefreshCycleInterval is the default number of seconds between refresh cycles
+	DefaultRefreshCycleInterval = 3600.0
+	// DefaultRefreshCycleDurationMs is the default length of a refresh cycle
+	DefaultRefreshCycleDurationMs = 500
+)
+
+// Disk widget I/O scopes
+const (
+	// DiskScopeVolume measures a single disk, or all disks when none is named
+	DiskScopeVolume = "volume"
+	// DiskScopeSystem measures total I/O across all disks
+	DiskScopeSystem = "system"
+	// DiskScopeProcess measures I/O issued by processes matching a name
+	DiskScopeProcess = "process"
+)
+
+// Disk widget metrics
+const (
+	// DiskMetricThroughput shows read/write throughput
+	DiskMetricThroughput = "throughput"
+	// DiskMetricCapacity shows the used share of the disk's file system
+	DiskMetricCapacity = "capacity"
+)
+
+// Telegram counter count formats
+const (
+	// CounterFormatDot shows a filled dot instead of the badge or text while anything is unread
+	CounterFormatDot = "dot"
+)
+
+// Network widget special interface values
+const (
+	// NetworkInterfaceAuto and NetworkInterfaceDefault follow the interface carrying the default route
+	NetworkInterfaceAuto    = "auto"
+	NetworkInterfaceDefault = "default"
+	// NetworkInterfaceAll sums all non-loopback interfaces
+	NetworkInterfaceAll = "all"
+)
+
+// Winamp widget player sources
+const (
+	// WinampSourceAuto uses MPRIS on Linux and Winamp elsewhere
+	WinampSourceAuto = "auto"
+	// WinampSourceWinamp reads Winamp through Windows IPC
+	WinampSourceWinamp = "winamp"
+	// WinampSourceMPRIS reads MPRIS players (Spotify, VLC, ...) through playerctl on Linux
+	WinampSourceMPRIS = "mpris"
+)
+
+// Disk widget text modes
+const (
+	// DiskTextModeRate shows throughput in the configured unit
+	DiskTextModeRate = "rate"
+	// DiskTextModePercent shows throughput as a percentage of max_speed_mbps
+	DiskTextModePercent = "percent"
+	// DiskTextModeBoth shows throughput followed by its percentage
+	DiskTextModeBoth = "both"
+)
+
+// Process widget metrics
+const (
+	// ProcessMetricCPU shows CPU usage as a share of total CPU capacity
+	ProcessMetricCPU = "cpu"
+	// ProcessMetricMemory shows resident memory as a share of physical memory
+	ProcessMetricMemory = "memory"
+)
+
+// Audio visualizer capture modes
+const (
+	// AudioCaptureModeLoopback analyzes what the default output device plays
+	AudioCaptureModeLoopback = "loopback"
+	// AudioCaptureModeMicrophone analyzes the default input device
+	AudioCaptureModeMicrophone = "microphone"
+)
+
+// Action types for user-triggered actions (tray menu)
+const (
+	ActionSwitchProfile = "switch_profile"
+	ActionToggleWidget  = "toggle_widget"
+	ActionRunCommand    = "run_command"
+	ActionOpenEditor    = "open_editor"
+	ActionReloadConfig  = "reload_config"
+	ActionSetBrightness = "set_brightness"
+)
+
+// Profile schedule times and day selectors
+const (
+	ScheduleSunrise  = "sunrise"
+	ScheduleSunset   = "sunset"
+	ScheduleAllDays  = "*"
+	ScheduleWeekdays = "weekdays"
+	ScheduleWeekend  = "weekend"
+)
+
+// Display brightness range (direct driver)
+const (
+	MinBrightness = 0
+	MaxBrightness = 10
+)
+
+// Exit display behaviors (on_exit_display)
+const (
+	ExitDisplayGoodbye = "goodbye" // Animated goodbye message, then blank (default)
+	ExitDisplayClear   = "clear"   // Blank the display immediately
+	ExitDisplayLogo    = "logo"    // Leave the SteelClock logo on screen
+	ExitDisplayKeep    = "keep"    // Leave the last rendered frame
+)
+
+// Widget text languages (language)
+const (
+	LanguageAuto      = "auto" // Follow the system UI language
+	LanguageEnglish   = "en"   // English (default)
+	LanguageRussian   = "ru"   // Russian
+	LanguageUkrainian = "uk"   // Ukrainian
+)
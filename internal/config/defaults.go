@@ -33,8 +33,26 @@ const (
 	// DefaultGraphHistory is the default number of history points for graphs
 	DefaultGraphHistory = 30
 
+	// DefaultAutoScaleWindow is the default network auto-scale peak window in seconds
+	DefaultAutoScaleWindow = 30.0
+
+	// DefaultMaxTempC is the default cpu_temp temperature shown as a full bar/gauge/graph
+	DefaultMaxTempC = 100.0
+
 	// DefaultEventBatchSize is the default batch size for event batching
 	DefaultEventBatchSize = 10
+
+	// DefaultBackendRetries is how many times backend client creation is retried before giving up
+	DefaultBackendRetries = 4
+
+	// DefaultHTTPJSONInterval is the default number of seconds between http_json widget requests
+	DefaultHTTPJSONInterval = 60.0
+
+	// DefaultHTTPJSONTimeout is the default http_json request timeout in seconds
+	DefaultHTTPJSONTimeout = 5.0
+
+	// DefaultHTTPJSONFormat is the default http_json display format
+	DefaultHTTPJSONFormat = "{value}"
 )
 
 // BoolPtr returns a pointer to a bool value
@@ -47,6 +65,11 @@ func IntPtr(i int) *int {
 	return &i
 }
 
+// Float64Ptr returns a pointer to a float64 value
+func Float64Ptr(f float64) *float64 {
+	return &f
+}
+
 // CreateDefault creates a configuration with sensible defaults
 func CreateDefault() *Config {
 	cfg := &Config{
@@ -97,11 +120,27 @@ func applyDefaults(cfg *Config) {
 
 	for i := range cfg.Widgets {
 		applyWidgetDefaults(&cfg.Widgets[i])
+		applyInheritedWidgetDefaults(&cfg.Widgets[i], cfg.Defaults)
 	}
 
 	// Apply defaults per device in multi-device mode
 	for i := range cfg.Devices {
 		applyDeviceDefaults(&cfg.Devices[i])
+		for j := range cfg.Devices[i].Widgets {
+			applyInheritedWidgetDefaults(&cfg.Devices[i].Widgets[j], cfg.Defaults)
+		}
+	}
+}
+
+// applyInheritedWidgetDefaults copies global defaults into widgets that do not set their own
+func applyInheritedWidgetDefaults(w *WidgetConfig, defaults *DefaultsConfig) {
+	if defaults == nil {
+		return
+	}
+
+	if w.Blink == nil && defaults.Blink != nil {
+		blink := *defaults.Blink
+		w.Blink = &blink
 	}
 }
 
@@ -169,6 +208,9 @@ func applyDisplayDefaults(cfg *Config) {
 
 // applyWidgetDefaults sets default values for a widget
 func applyWidgetDefaults(w *WidgetConfig) {
+	if w.Type == "http_json" {
+		syncHTTPJSONInterval(w)
+	}
 	applyCommonWidgetDefaults(w)
 	applyTypeSpecificDefaults(w)
 }
@@ -212,6 +254,8 @@ func applyTypeSpecificDefaults(w *WidgetConfig) {
 		applyClockDefaults(w)
 	case "cpu", "memory":
 		applyMetricWidgetDefaults(w)
+	case "cpu_temp":
+		applyCPUTempDefaults(w)
 	case "gpu":
 		applyGPUDefaults(w)
 	case "network":
@@ -229,6 +273,8 @@ func applyTypeSpecificDefaults(w *WidgetConfig) {
 		applyVolumeMeterDefaults(w)
 	case "bluetooth":
 		applyBluetoothDefaults(w)
+	case "http_json":
+		applyHTTPJSONDefaults(w)
 	case "hwmon":
 		applyHWMonDefaults(w)
 	}
@@ -322,6 +368,15 @@ func applyGPUDefaults(w *WidgetConfig) {
 	}
 }
 
+// applyCPUTempDefaults sets default values for CPU temperature widgets
+func applyCPUTempDefaults(w *WidgetConfig) {
+	applyMetricWidgetDefaults(w)
+
+	if w.MaxTempC == 0 {
+		w.MaxTempC = DefaultMaxTempC
+	}
+}
+
 // applyNetworkDefaults sets default values for network widgets
 func applyNetworkDefaults(w *WidgetConfig) {
 	if w.Mode == "" {
@@ -342,6 +397,10 @@ func applyNetworkDefaults(w *WidgetConfig) {
 		w.MaxSpeedMbps = -1
 	}
 
+	if w.AutoScale && w.AutoScaleWindow == 0 {
+		w.AutoScaleWindow = DefaultAutoScaleWindow
+	}
+
 	if w.Graph == nil {
 		w.Graph = &GraphConfig{}
 	}
@@ -519,6 +578,41 @@ func applyBluetoothDefaults(w *WidgetConfig) {
 	}
 }
 
+// syncHTTPJSONInterval makes http_json.interval and update_interval a single knob:
+// whichever one is set drives both. It runs before the common defaults so an explicit
+// update_interval can be told apart from the default one. Setting both to different
+// values is rejected by validation.
+func syncHTTPJSONInterval(w *WidgetConfig) {
+	if w.HTTPJSON == nil {
+		w.HTTPJSON = &HTTPJSONConfig{}
+	}
+	if w.HTTPJSON.Interval <= 0 {
+		w.HTTPJSON.Interval = w.UpdateInterval
+	}
+	if w.HTTPJSON.Interval <= 0 {
+		w.HTTPJSON.Interval = DefaultHTTPJSONInterval
+	}
+	if w.UpdateInterval == 0 {
+		w.UpdateInterval = w.HTTPJSON.Interval
+	}
+}
+
+// applyHTTPJSONDefaults sets default values for http_json widgets
+func applyHTTPJSONDefaults(w *WidgetConfig) {
+	if w.HTTPJSON == nil {
+		w.HTTPJSON = &HTTPJSONConfig{}
+	}
+	if w.HTTPJSON.Interval <= 0 {
+		w.HTTPJSON.Interval = DefaultHTTPJSONInterval
+	}
+	if w.HTTPJSON.Timeout <= 0 {
+		w.HTTPJSON.Timeout = DefaultHTTPJSONTimeout
+	}
+	if w.HTTPJSON.Format == "" {
+		w.HTTPJSON.Format = DefaultHTTPJSONFormat
+	}
+}
+
 // generateWidgetIDs assigns unique IDs to widgets based on type
 func generateWidgetIDs(widgets []WidgetConfig) {
 	typeCounts := make(map[string]int)
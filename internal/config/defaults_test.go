@@ -379,6 +379,24 @@ func TestApplyMetricWidgetDefaults(t *testing.T) {
 	}
 }
 
+func TestApplyCPUTempDefaults(t *testing.T) {
+	w := &WidgetConfig{Type: "cpu_temp"}
+	applyCPUTempDefaults(w)
+
+	if w.Mode != "text" {
+		t.Errorf("Mode = %q, want %q", w.Mode, "text")
+	}
+	if w.MaxTempC != DefaultMaxTempC {
+		t.Errorf("MaxTempC = %v, want %v", w.MaxTempC, DefaultMaxTempC)
+	}
+
+	w2 := &WidgetConfig{Type: "cpu_temp", MaxTempC: 90}
+	applyCPUTempDefaults(w2)
+	if w2.MaxTempC != 90 {
+		t.Errorf("Custom MaxTempC should be preserved, got %v", w2.MaxTempC)
+	}
+}
+
 func TestApplyNetworkDefaults(t *testing.T) {
 	w := &WidgetConfig{Type: "network"}
 	applyNetworkDefaults(w)
@@ -398,11 +416,52 @@ func TestApplyNetworkDefaults(t *testing.T) {
 	if w.MaxSpeedMbps != -1 {
 		t.Errorf("MaxSpeedMbps = %f, want -1", w.MaxSpeedMbps)
 	}
+	if w.AutoScaleWindow != 0 {
+		t.Errorf("AutoScaleWindow = %v, want 0 without auto_scale", w.AutoScaleWindow)
+	}
 	if w.Graph == nil || w.Graph.History != DefaultGraphHistory {
 		t.Errorf("Graph.History should be %d", DefaultGraphHistory)
 	}
 }
 
+func TestApplyNetworkDefaults_AutoScale(t *testing.T) {
+	w := &WidgetConfig{Type: "network", AutoScale: true}
+	applyNetworkDefaults(w)
+	if w.AutoScaleWindow != DefaultAutoScaleWindow {
+		t.Errorf("AutoScaleWindow = %v, want %v", w.AutoScaleWindow, DefaultAutoScaleWindow)
+	}
+
+	w2 := &WidgetConfig{Type: "network", AutoScale: true, AutoScaleWindow: 10}
+	applyNetworkDefaults(w2)
+	if w2.AutoScaleWindow != 10 {
+		t.Errorf("Custom AutoScaleWindow should be preserved, got %v", w2.AutoScaleWindow)
+	}
+}
+
+func TestApplyWidgetDefaults_HTTPJSONInterval(t *testing.T) {
+	tests := []struct {
+		name           string
+		updateInterval float64
+		interval       float64
+		want           float64
+	}{
+		{"neither set", 0, 0, DefaultHTTPJSONInterval},
+		{"http_json.interval only", 0, 30, 30},
+		{"update_interval only", 15, 0, 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &WidgetConfig{Type: "http_json", UpdateInterval: tt.updateInterval, HTTPJSON: &HTTPJSONConfig{Interval: tt.interval}}
+			applyWidgetDefaults(w)
+
+			if w.UpdateInterval != tt.want || w.HTTPJSON.Interval != tt.want {
+				t.Errorf("intervals = (%v, %v), want both %v", w.UpdateInterval, w.HTTPJSON.Interval, tt.want)
+			}
+		})
+	}
+}
+
 func TestApplyDiskDefaults(t *testing.T) {
 	w := &WidgetConfig{Type: "disk"}
 	applyDiskDefaults(w)
@@ -734,3 +793,34 @@ func TestApplyDefaults_IncludesDevices(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyDefaults_InheritsBlink(t *testing.T) {
+	cfg := &Config{
+		Defaults: &DefaultsConfig{Blink: &BlinkConfig{RateMs: 400, Duty: 0.7}},
+		Widgets: []WidgetConfig{
+			{Type: "battery"},
+			{Type: "bluetooth", Blink: &BlinkConfig{RateMs: 2000}},
+		},
+		Devices: []DeviceConfig{
+			{Widgets: []WidgetConfig{{Type: "telegram_counter"}}},
+		},
+	}
+
+	applyDefaults(cfg)
+
+	if b := cfg.Widgets[0].Blink; b == nil || b.RateMs != 400 || b.Duty != 0.7 {
+		t.Errorf("widget without blink should inherit defaults, got %+v", b)
+	}
+	if b := cfg.Widgets[1].Blink; b.RateMs != 2000 || b.Duty != 0 {
+		t.Errorf("widget blink should not be overridden, got %+v", b)
+	}
+	if b := cfg.Devices[0].Widgets[0].Blink; b == nil || b.RateMs != 400 {
+		t.Errorf("device widget should inherit defaults, got %+v", b)
+	}
+
+	// Inherited config must be a copy, not shared with the defaults
+	cfg.Widgets[0].Blink.RateMs = 100
+	if cfg.Defaults.Blink.RateMs != 400 {
+		t.Error("modifying widget blink changed global defaults")
+	}
+}
@@ -29,9 +29,12 @@ type Profile struct {
 
 // ProfileManager manages multiple configuration profiles
 type ProfileManager struct {
-	baseDir       string     // Directory containing steelclock.json
-	profiles      []*Profile // All discovered profiles
-	activeProfile *Profile   // Currently active profile
+	baseDir       string           // Directory containing steelclock.json
+	profiles      []*Profile       // All discovered profiles
+	activeProfile *Profile         // Currently active profile
+	triggers      []ProfileTrigger // Automatic switching rules from the main config
+	schedule      []ScheduleRule   // Time-of-day switching rules from the main config
+	scheduleLoc   *GeoLocationConfig
 }
 
 // appState stores persistent application state
@@ -50,6 +53,9 @@ func NewProfileManager(baseDir string) *ProfileManager {
 // LoadProfiles discovers and loads all available profiles
 func (pm *ProfileManager) LoadProfiles() error {
 	pm.profiles = nil
+	pm.triggers = nil
+	pm.schedule = nil
+	pm.scheduleLoc = nil
 
 	// Load main config (steelclock.json)
 	mainPath := filepath.Join(pm.baseDir, MainConfigFile)
@@ -76,12 +82,7 @@ func (pm *ProfileManager) LoadProfiles() error {
 	}
 
 	// Sort profiles: main first, then alphabetically by name
-	sort.Slice(pm.profiles, func(i, j int) bool {
-		if pm.profiles[i].IsMain != pm.profiles[j].IsMain {
-			return pm.profiles[i].IsMain // main config first
-		}
-		return pm.profiles[i].Name < pm.profiles[j].Name
-	})
+	pm.sortProfiles()
 
 	// Restore last active profile or default to first available
 	pm.restoreActiveProfile()
@@ -109,6 +110,9 @@ func (pm *ProfileManager) loadProfile(path string, isMain bool) *Profile {
 		} else {
 			profile.Name = pm.filenameToName(path)
 		}
+		if isMain {
+			pm.setSwitchRules(cfg)
+		}
 	}
 
 	return profile
@@ -124,11 +128,34 @@ func (pm *ProfileManager) filenameToName(path string) string {
 	return name
 }
 
+// BaseDir returns the directory containing the main config
+func (pm *ProfileManager) BaseDir() string {
+	return pm.baseDir
+}
+
 // GetProfiles returns all discovered profiles
 func (pm *ProfileManager) GetProfiles() []*Profile {
 	return pm.profiles
 }
 
+// GetTriggers returns the automatic profile switching rules of the main config, in priority order
+func (pm *ProfileManager) GetTriggers() []ProfileTrigger {
+	return pm.triggers
+}
+
+// GetSchedule returns the time-of-day switching rules of the main config and
+// the location used for sunrise and sunset times (nil if not configured)
+func (pm *ProfileManager) GetSchedule() ([]ScheduleRule, *GeoLocationConfig) {
+	return pm.schedule, pm.scheduleLoc
+}
+
+// setSwitchRules stores the automatic switching rules of the main config
+func (pm *ProfileManager) setSwitchRules(cfg *Config) {
+	pm.triggers = cfg.ProfileTriggers
+	pm.schedule = cfg.ProfileSchedule
+	pm.scheduleLoc = cfg.ScheduleLocation
+}
+
 // GetActiveProfile returns the currently active profile
 func (pm *ProfileManager) GetActiveProfile() *Profile {
 	return pm.activeProfile
@@ -230,6 +257,9 @@ func (pm *ProfileManager) RefreshProfile(path string) {
 				p.LoadError = err
 			} else {
 				p.LoadError = nil
+				if p.IsMain {
+					pm.setSwitchRules(cfg)
+				}
 				if cfg.ConfigName != "" {
 					p.Name = cfg.ConfigName
 					p.loadedName = cfg.ConfigName
@@ -296,24 +326,30 @@ func (pm *ProfileManager) CreateProfile(name string) (string, error) {
 		return "", fmt.Errorf("failed to write profile: %w", err)
 	}
 
-	// Add to profiles list
-	profile := &Profile{
-		Path:       profilePath,
+	pm.addProfile(profilePath, name)
+
+	return profilePath, nil
+}
+
+// addProfile adds a newly written profile file to the profiles list
+func (pm *ProfileManager) addProfile(path, name string) {
+	pm.profiles = append(pm.profiles, &Profile{
+		Path:       path,
 		Name:       name,
 		IsMain:     false,
 		loadedName: name,
-	}
-	pm.profiles = append(pm.profiles, profile)
+	})
+	pm.sortProfiles()
+}
 
-	// Re-sort profiles
+// sortProfiles orders profiles: main config first, then alphabetically by name
+func (pm *ProfileManager) sortProfiles() {
 	sort.Slice(pm.profiles, func(i, j int) bool {
 		if pm.profiles[i].IsMain != pm.profiles[j].IsMain {
-			return pm.profiles[i].IsMain
+			return pm.profiles[i].IsMain // main config first
 		}
 		return pm.profiles[i].Name < pm.profiles[j].Name
 	})
-
-	return profilePath, nil
 }
 
 // RenameProfile updates the config_name of a profile.
@@ -360,12 +396,7 @@ func (pm *ProfileManager) RenameProfile(path, newName string) (string, error) {
 	profile.loadedName = newName
 
 	// Re-sort profiles
-	sort.Slice(pm.profiles, func(i, j int) bool {
-		if pm.profiles[i].IsMain != pm.profiles[j].IsMain {
-			return pm.profiles[i].IsMain
-		}
-		return pm.profiles[i].Name < pm.profiles[j].Name
-	})
+	pm.sortProfiles()
 
 	return path, nil
 }
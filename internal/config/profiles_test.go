@@ -71,6 +71,9 @@ func TestNewProfileManager(t *testing.T) {
 	if pm.baseDir != "/tmp/test" {
 		t.Errorf("baseDir = %q, want %q", pm.baseDir, "/tmp/test")
 	}
+	if pm.BaseDir() != "/tmp/test" {
+		t.Errorf("BaseDir() = %q, want %q", pm.BaseDir(), "/tmp/test")
+	}
 }
 
 func TestProfileManager_LoadProfiles_NoConfigs(t *testing.T) {
@@ -164,6 +167,34 @@ func TestProfileManager_LoadProfiles_WithSubProfiles(t *testing.T) {
 	}
 }
 
+func TestProfileManager_GetTriggers(t *testing.T) {
+	tmpDir, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	main := `{
+	"game_name": "STEELCLOCK",
+	"game_display_name": "SteelClock",
+	"refresh_rate_ms": 100,
+	"display": {"width": 128, "height": 40},
+	"profile_triggers": [{"profile": "Gaming", "process": "game.exe"}],
+	"widgets": [{"type": "clock", "position": {"x": 0, "y": 0, "w": 128, "h": 40}}]
+}`
+	if err := os.WriteFile(filepath.Join(tmpDir, MainConfigFile), []byte(main), 0644); err != nil {
+		t.Fatalf("Failed to write main config: %v", err)
+	}
+	writeConfig(t, createProfilesDir(t, tmpDir), "gaming.json", "Gaming")
+
+	pm := NewProfileManager(tmpDir)
+	if err := pm.LoadProfiles(); err != nil {
+		t.Fatalf("LoadProfiles failed: %v", err)
+	}
+
+	triggers := pm.GetTriggers()
+	if len(triggers) != 1 || triggers[0].Profile != "Gaming" || triggers[0].Process != "game.exe" {
+		t.Errorf("GetTriggers() = %+v, want the main config trigger", triggers)
+	}
+}
+
 func TestProfileManager_SetActiveProfile(t *testing.T) {
 	tmpDir, cleanup := setupTestDir(t)
 	defer cleanup()
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// scheduleDayNames maps day selectors to weekdays
+var scheduleDayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseScheduleDays returns the weekdays selected by days, indexed by time.Weekday.
+// An empty list or "*" selects every day.
+func ParseScheduleDays(days []string) ([7]bool, error) {
+	var set [7]bool
+	if len(days) == 0 {
+		days = []string{ScheduleAllDays}
+	}
+	for _, d := range days {
+		switch d = strings.ToLower(strings.TrimSpace(d)); d {
+		case ScheduleAllDays:
+			set = [7]bool{true, true, true, true, true, true, true}
+		case ScheduleWeekdays:
+			for wd := time.Monday; wd <= time.Friday; wd++ {
+				set[wd] = true
+			}
+		case ScheduleWeekend:
+			set[time.Saturday], set[time.Sunday] = true, true
+		default:
+			wd, ok := scheduleDayNames[d]
+			if !ok {
+				return set, fmt.Errorf("invalid day '%s' (valid: mon..sun, %s, %s, %s)", d, ScheduleWeekdays, ScheduleWeekend, ScheduleAllDays)
+			}
+			set[wd] = true
+		}
+	}
+	return set, nil
+}
+
+// ParseClockTime parses an "HH:MM" time of day into minutes since midnight
+func ParseClockTime(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time '%s' (expected HH:MM, %s or %s)", s, ScheduleSunrise, ScheduleSunset)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// IsSunScheduleTime reports whether a schedule time depends on the sun
+func IsSunScheduleTime(s string) bool {
+	return s == ScheduleSunrise || s == ScheduleSunset
+}
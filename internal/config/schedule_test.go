@@ -0,0 +1,35 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleDays(t *testing.T) {
+	tests := []struct {
+		days []string
+		want []time.Weekday
+	}{
+		{nil, []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}},
+		{[]string{"weekdays"}, []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}},
+		{[]string{"weekend", "Mon"}, []time.Weekday{time.Sunday, time.Monday, time.Saturday}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseScheduleDays(tt.days)
+		if err != nil {
+			t.Fatalf("ParseScheduleDays(%v) error = %v", tt.days, err)
+		}
+		var want [7]bool
+		for _, wd := range tt.want {
+			want[wd] = true
+		}
+		if got != want {
+			t.Errorf("ParseScheduleDays(%v) = %v, want %v", tt.days, got, want)
+		}
+	}
+
+	if _, err := ParseScheduleDays([]string{"monday"}); err == nil {
+		t.Error("ParseScheduleDays(monday) expected an error")
+	}
+}
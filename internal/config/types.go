@@ -129,26 +129,37 @@ func (v *IntOrString) MarshalJSON() ([]byte, error) {
 
 // Config represents the complete SteelClock configuration (v2 schema)
 type Config struct {
-	SchemaVersion        int                 `json:"schema_version,omitempty"`
-	ConfigName           string              `json:"config_name,omitempty"` // Display name for profile selection menu
-	GameName             string              `json:"game_name"`
-	GameDisplayName      string              `json:"game_display_name"`
-	RefreshRateMs        int                 `json:"refresh_rate_ms"`
-	UnregisterOnExit     bool                `json:"unregister_on_exit,omitempty"`
-	DeinitializeTimerMs  int                 `json:"deinitialize_timer_ms,omitempty"`
-	EventBatchingEnabled bool                `json:"event_batching_enabled,omitempty"`
-	EventBatchSize       int                 `json:"event_batch_size,omitempty"`
-	FrameDedupEnabled    *bool               `json:"frame_dedup_enabled,omitempty"` // Skip sending unchanged frames (default: true)
-	SupportedResolutions []ResolutionConfig  `json:"supported_resolutions,omitempty"`
-	BundledFontURL       *string             `json:"bundled_font_url,omitempty"`
-	Backend              string              `json:"backend,omitempty"`
-	DirectDriver         *DirectDriverConfig `json:"direct_driver,omitempty"`
-	WebClient            *WebClientConfig    `json:"webclient,omitempty"`
-	Devices              []DeviceConfig      `json:"devices,omitempty"`
-	Display              DisplayConfig       `json:"display"`
-	Defaults             *DefaultsConfig     `json:"defaults,omitempty"`
-	Layout               *LayoutConfig       `json:"layout,omitempty"`
-	Widgets              []WidgetConfig      `json:"widgets"`
+	SchemaVersion        int                  `json:"schema_version,omitempty"`
+	ConfigName           string               `json:"config_name,omitempty"` // Display name for profile selection menu
+	GameName             string               `json:"game_name"`
+	GameDisplayName      string               `json:"game_display_name"`
+	RefreshRateMs        int                  `json:"refresh_rate_ms"`
+	UnregisterOnExit     bool                 `json:"unregister_on_exit,omitempty"`
+	OnExitDisplay        string               `json:"on_exit_display,omitempty"` // "goodbye" (default), "clear", "logo", "keep"
+	Language             string               `json:"language,omitempty"`        // Widget text language: "en" (default), "ru", "uk", "auto"
+	DeinitializeTimerMs  int                  `json:"deinitialize_timer_ms,omitempty"`
+	StartupDelayMs       int                  `json:"startup_delay_ms,omitempty"` // Wait before first connecting to the backend (e.g. autostart at login)
+	BackendRetries       *int                 `json:"backend_retries,omitempty"`  // Extra attempts to create the backend client (default: 4)
+	EventBatchingEnabled bool                 `json:"event_batching_enabled,omitempty"`
+	EventBatchSize       int                  `json:"event_batch_size,omitempty"`
+	FrameDedupEnabled    *bool                `json:"frame_dedup_enabled,omitempty"` // Skip sending unchanged frames (default: true)
+	MaxPushFPS           int                  `json:"max_push_fps,omitempty"`        // Max frames pushed to the device per second (0 = backend limit or unlimited)
+	Brightness           int                  `json:"brightness,omitempty"`          // Image brightness offset added to every pixel (-255..255)
+	Contrast             float64              `json:"contrast,omitempty"`            // Image contrast factor around mid-gray (0 = unchanged, 1.0)
+	SupportedResolutions []ResolutionConfig   `json:"supported_resolutions,omitempty"`
+	BundledFontURL       *string              `json:"bundled_font_url,omitempty"`
+	Backend              string               `json:"backend,omitempty"`
+	DirectDriver         *DirectDriverConfig  `json:"direct_driver,omitempty"`
+	WebClient            *WebClientConfig     `json:"webclient,omitempty"`
+	Devices              []DeviceConfig       `json:"devices,omitempty"`
+	Display              DisplayConfig        `json:"display"`
+	Defaults             *DefaultsConfig      `json:"defaults,omitempty"`
+	Layout               *LayoutConfig        `json:"layout,omitempty"`
+	TrayMenu             []TrayMenuItemConfig `json:"tray_menu,omitempty"`         // Custom tray menu entries
+	ProfileTriggers      []ProfileTrigger     `json:"profile_triggers,omitempty"`  // Automatic profile switching (main config only)
+	ProfileSchedule      []ScheduleRule       `json:"profile_schedule,omitempty"`  // Time-of-day profile switching (main config only)
+	ScheduleLocation     *GeoLocationConfig   `json:"schedule_location,omitempty"` // Coordinates for sunrise/sunset schedule times
+	Widgets              []WidgetConfig       `json:"widgets"`
 }
 
 // GetDevices returns the list of device configurations.
@@ -184,6 +195,9 @@ func (cfg *Config) ConfigForDevice(dev DeviceConfig) *Config {
 	if dev.WebClient != nil {
 		merged.WebClient = dev.WebClient
 	}
+	if dev.MaxPushFPS != 0 {
+		merged.MaxPushFPS = dev.MaxPushFPS
+	}
 	merged.Devices = nil // Don't carry devices in per-device config
 	return &merged
 }
@@ -200,6 +214,10 @@ type DirectDriverConfig struct {
 type WebClientConfig struct {
 	// TargetFPS limits the frame rate sent to web clients (default: 30)
 	TargetFPS int `json:"target_fps,omitempty"`
+	// Preview rendering in the browser; frames sent to the device are unaffected
+	Scale    int  `json:"scale,omitempty"`     // Initial upscaling factor (default: 4, max: 16)
+	Grid     bool `json:"grid,omitempty"`      // Draw a pixel grid over the preview
+	PixelGap int  `json:"pixel_gap,omitempty"` // Dark spacing between pixels, in screen pixels
 }
 
 // DeviceConfig represents per-device settings for multi-device configurations.
@@ -210,6 +228,7 @@ type DeviceConfig struct {
 	Backend      string              `json:"backend,omitempty"`
 	DirectDriver *DirectDriverConfig `json:"direct_driver,omitempty"`
 	WebClient    *WebClientConfig    `json:"webclient,omitempty"`
+	MaxPushFPS   int                 `json:"max_push_fps,omitempty"` // Overrides the global max_push_fps for this device
 	Widgets      []WidgetConfig      `json:"widgets"`
 }
 
@@ -224,6 +243,35 @@ type DisplayConfig struct {
 	Width      int `json:"width"`
 	Height     int `json:"height"`
 	Background int `json:"background"`
+
+	BurnInProtection *BurnInProtectionConfig `json:"burn_in_protection,omitempty"` // OLED burn-in mitigation; nil = disabled
+	RefreshCycle     *RefreshCycleConfig     `json:"refresh_cycle,omitempty"`      // Periodic pixel refresh; nil = disabled
+}
+
+// BurnInProtectionConfig controls the final compositing pass that protects OLED panels
+// from burn-in by slowly shifting the whole frame and dimming long-static pixels
+type BurnInProtectionConfig struct {
+	Enabled bool `json:"enabled"`
+	// ShiftInterval: seconds between one-pixel frame shifts (default: 60)
+	ShiftInterval float64 `json:"shift_interval,omitempty"`
+	// Magnitude: maximum shift from the original position in pixels, 0-4 (default: 1; 0 disables shifting)
+	Magnitude *int `json:"magnitude,omitempty"`
+	// DimAfter: seconds a lit pixel must stay unchanged before it is dimmed (default: 0 = never)
+	DimAfter float64 `json:"dim_after,omitempty"`
+	// DimFactor: brightness kept by dimmed static pixels, 0.0-1.0 (default: 0.5)
+	DimFactor float64 `json:"dim_factor,omitempty"`
+}
+
+// RefreshCycleConfig controls a periodic OLED maintenance cycle that briefly overrides
+// normal rendering with an inverted or solid frame to even out pixel wear
+type RefreshCycleConfig struct {
+	Enabled bool `json:"enabled"`
+	// Mode: "invert" (default), "white" or "black"
+	Mode string `json:"mode,omitempty"`
+	// Interval: seconds between refresh cycles (default: 3600)
+	Interval float64 `json:"interval,omitempty"`
+	// DurationMs: how long each cycle overrides the display in milliseconds (default: 500)
+	DurationMs int `json:"duration_ms,omitempty"`
 }
 
 // DefaultsConfig represents global defaults inherited by widgets
@@ -231,6 +279,55 @@ type DefaultsConfig struct {
 	Colors         map[string]int `json:"colors,omitempty"`
 	Text           *TextConfig    `json:"text,omitempty"`
 	UpdateInterval float64        `json:"update_interval,omitempty"`
+	Blink          *BlinkConfig   `json:"blink,omitempty"` // Blink timing inherited by widgets without their own
+}
+
+// BlinkConfig controls blink timing for widgets that offer blinking
+type BlinkConfig struct {
+	// RateMs: full on+off blink cycle in milliseconds (default: 1000)
+	RateMs int `json:"rate_ms,omitempty"`
+	// Duty: fraction of the cycle content stays visible, 0.05-0.95 (default: 0.5)
+	Duty float64 `json:"duty,omitempty"`
+}
+
+// ActionConfig describes a user-triggered action (tray menu entries)
+type ActionConfig struct {
+	Action  string   `json:"action"`            // "switch_profile", "toggle_widget", "run_command", "open_editor", "reload_config", "set_brightness"
+	Profile string   `json:"profile,omitempty"` // switch_profile: profile name or config file path
+	Widget  string   `json:"widget,omitempty"`  // toggle_widget: widget ID (e.g. "clock_0")
+	Command string   `json:"command,omitempty"` // run_command: executable to start
+	Args    []string `json:"args,omitempty"`    // run_command: command arguments
+
+	Brightness *int `json:"brightness,omitempty"` // set_brightness: display brightness 0-10
+}
+
+// TrayMenuItemConfig represents a custom tray menu entry
+type TrayMenuItemConfig struct {
+	Label string `json:"label"`
+	ActionConfig
+}
+
+// ProfileTrigger activates a profile while a matching application is running.
+// When both process and window_title are set, both must match.
+type ProfileTrigger struct {
+	Profile     string `json:"profile"`                // Profile display name, file name or path
+	Process     string `json:"process,omitempty"`      // Process name, case-insensitive; ".exe" may be omitted
+	WindowTitle string `json:"window_title,omitempty"` // Substring of a visible window title, case-insensitive (Windows only)
+}
+
+// ScheduleRule activates a profile when its time window begins.
+// Times are "HH:MM", "sunrise" or "sunset" in local time.
+type ScheduleRule struct {
+	Profile string   `json:"profile"`        // Profile display name, file name or path
+	Start   string   `json:"start"`          // Window start
+	End     string   `json:"end,omitempty"`  // Window end; the window lasts 24 hours when empty
+	Days    []string `json:"days,omitempty"` // "mon".."sun", "weekdays", "weekend" or "*"; every day when empty
+}
+
+// GeoLocationConfig represents geographic coordinates in degrees
+type GeoLocationConfig struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
 }
 
 // LayoutConfig represents virtual canvas layout settings
@@ -260,18 +357,25 @@ type WidgetConfig struct {
 	Segment      *SegmentClockConfig `json:"segment,omitempty"` // Clock segment mode
 	Spectrum     *SpectrumConfig     `json:"spectrum,omitempty"`
 	Oscilloscope *OscilloscopeConfig `json:"oscilloscope,omitempty"`
+	Correlation  *CorrelationConfig  `json:"correlation,omitempty"`
 
 	// Common widget configurations
 	Text           *TextConfig     `json:"text,omitempty"`
 	Colors         *ColorsConfig   `json:"colors,omitempty"`
 	AutoHide       *AutoHideConfig `json:"auto_hide,omitempty"`
+	Blink          *BlinkConfig    `json:"blink,omitempty"` // Blink timing (battery, bluetooth, keyboard, telegram, telegram_counter)
 	UpdateInterval float64         `json:"update_interval,omitempty"`
-	PollInterval   float64         `json:"poll_interval,omitempty"` // Internal polling rate for volume/volume_meter (seconds)
+	RedrawOnUpdate *bool           `json:"redraw_on_update,omitempty"` // Redraw once per update_interval (default true); false redraws every frame
+	PollInterval   float64         `json:"poll_interval,omitempty"`    // Internal polling rate for volume/volume_meter (seconds)
+	Demo           bool            `json:"demo,omitempty"`             // Feed synthetic data instead of live sources (layout design)
+
+	// Transitions: enter/exit effects when the widget is toggled at runtime (toggle_widget action)
+	Transitions *TransitionConfig `json:"transitions,omitempty"`
 
 	// Widget-specific configurations
 	PerCore    *PerCoreConfig    `json:"per_core,omitempty"`   // CPU widget
 	GPU        *GPUConfig        `json:"gpu,omitempty"`        // GPU widget
-	Stereo     *StereoConfig     `json:"stereo,omitempty"`     // Volume meter
+	Stereo     *StereoConfig     `json:"stereo,omitempty"`     // Volume meter, audio visualizer
 	Metering   *MeteringConfig   `json:"metering,omitempty"`   // Volume meter
 	Peak       *PeakConfig       `json:"peak,omitempty"`       // Volume meter
 	Clipping   *ClippingConfig   `json:"clipping,omitempty"`   // Volume meter
@@ -279,15 +383,29 @@ type WidgetConfig struct {
 	Layout     *KeyboardLayout   `json:"layout,omitempty"`     // Keyboard
 
 	// Simple widget-specific properties
-	Interface      *string `json:"interface,omitempty"`       // Network
-	MaxSpeedMbps   float64 `json:"max_speed_mbps,omitempty"`  // Network, Disk
-	Disk           *string `json:"disk,omitempty"`            // Disk
-	Unit           string  `json:"unit,omitempty"`            // Disk: "auto", "B/s", "KB/s", "MB/s", "GB/s", "KiB/s", "MiB/s", "GiB/s"
-	Format         string  `json:"format,omitempty"`          // Keyboard layout
-	Channel        string  `json:"channel,omitempty"`         // Audio visualizer
-	ErrorThreshold int     `json:"error_threshold,omitempty"` // Audio visualizer: consecutive errors before failure (default: 30)
-	Wad            string  `json:"wad,omitempty"`             // DOOM
-	BundledWadURL  *string `json:"bundled_wad_url,omitempty"` // DOOM - custom WAD download URL
+	Interface       *string `json:"interface,omitempty"`         // Network
+	MaxSpeedMbps    float64 `json:"max_speed_mbps,omitempty"`    // Network, Disk
+	AutoScale       bool    `json:"auto_scale,omitempty"`        // Network: scale to the observed peak instead of max_speed_mbps
+	AutoScaleWindow float64 `json:"auto_scale_window,omitempty"` // Network: seconds a peak is held before decaying (default: 30)
+	MaxTempC        float64 `json:"max_temp_c,omitempty"`        // CPU temperature: °C shown as a full bar/gauge/graph (default: 100)
+	ShowSwap        bool    `json:"show_swap,omitempty"`         // Memory: show swap/pagefile usage instead of physical memory
+	Aggregate       string  `json:"aggregate,omitempty"`         // CPU, Network, Disk: "instant" (default), "avg", "max", "min"
+	AggregateWindow int     `json:"aggregate_window,omitempty"`  // CPU, Network, Disk: samples to aggregate over (default: 10)
+	Disk            *string `json:"disk,omitempty"`              // Disk
+	Scope           string  `json:"scope,omitempty"`             // Disk: "volume" (default), "system", "process"
+	Process         string  `json:"process,omitempty"`           // Disk: process name matcher for "process" scope
+	Unit            string  `json:"unit,omitempty"`              // Disk: "auto", "B/s", "KB/s", "MB/s", "GB/s", "KiB/s", "MiB/s", "GiB/s"
+	TextMode        string  `json:"text_mode,omitempty"`         // Disk: "rate" (default), "percent", "both"
+	Metric          string  `json:"metric,omitempty"`            // Disk: "throughput" (default), "capacity"
+	Format          string  `json:"format,omitempty"`            // Keyboard layout
+	Channel         string  `json:"channel,omitempty"`           // Audio visualizer
+	CaptureMode     string  `json:"capture_mode,omitempty"`      // Audio visualizer: "loopback" (default), "microphone"
+	ErrorThreshold  int     `json:"error_threshold,omitempty"`   // Audio visualizer: consecutive errors before failure (default: 30)
+	Wad             string  `json:"wad,omitempty"`               // DOOM
+	BundledWadURL   *string `json:"bundled_wad_url,omitempty"`   // DOOM - custom WAD download URL
+
+	// Clock widget
+	Clock *ClockConfig `json:"clock,omitempty"` // Time source settings (countdown/elapsed)
 
 	// Winamp widget
 	Winamp   *WinampConfig         `json:"winamp,omitempty"`    // Winamp settings (placeholder)
@@ -310,6 +428,9 @@ type WidgetConfig struct {
 	// Game of Life widget
 	GameOfLife *GameOfLifeConfig `json:"game_of_life,omitempty"` // Game of Life settings
 
+	// Moon phase widget
+	MoonPhase *MoonPhaseConfig `json:"moon_phase,omitempty"` // Moon phase settings
+
 	// Hyperspace widget
 	Hyperspace *HyperspaceConfig `json:"hyperspace,omitempty"` // Hyperspace effect settings
 
@@ -322,6 +443,9 @@ type WidgetConfig struct {
 	// Screen mirror widget
 	ScreenMirror *ScreenMirrorConfig `json:"screen_mirror,omitempty"` // Screen mirror widget settings
 
+	// Capture widget
+	Capture *CaptureConfig `json:"capture,omitempty"` // Screen/region/camera capture settings
+
 	// Hacker code widget
 	HackerCode *HackerCodeConfig `json:"hacker_code,omitempty"` // Hacker code widget settings
 
@@ -335,7 +459,8 @@ type WidgetConfig struct {
 	Appearance *TelegramAppearanceConfig `json:"appearance,omitempty"` // Notification appearance settings
 
 	// Telegram counter widget specific
-	Badge *TelegramBadgeConfig `json:"badge,omitempty"` // Badge mode settings (for telegram_counter)
+	Badge   *TelegramBadgeConfig   `json:"badge,omitempty"`   // Badge mode settings (for telegram_counter)
+	Counter *TelegramCounterConfig `json:"counter,omitempty"` // Count display settings (for telegram_counter)
 
 	// Claude Code status widget
 	ClaudeCode *ClaudeCodeConfig `json:"claude_code,omitempty"` // Claude Code status widget settings
@@ -346,6 +471,12 @@ type WidgetConfig struct {
 	// Bluetooth widget
 	Bluetooth *BluetoothConfig `json:"bluetooth,omitempty"` // Bluetooth device status settings
 
+	// HTTP JSON widget
+	HTTPJSON *HTTPJSONConfig `json:"http_json,omitempty"` // Generic JSON endpoint settings
+
+	// Process widget
+	ProcessMonitor *ProcessConfig `json:"process_monitor,omitempty"` // Watched process and metric
+
 	// Beefweb widget (Foobar2000/DeaDBeeF)
 	Beefweb         *BeefwebConfig         `json:"beefweb,omitempty"`           // Beefweb settings
 	BeefwebAutoShow *BeefwebAutoShowConfig `json:"beefweb_auto_show,omitempty"` // Beefweb auto-show events
@@ -378,6 +509,18 @@ type StyleConfig struct {
 	Background int `json:"background"`
 	Border     int `json:"border"` // -1=disabled, 0-255=border color
 	Padding    int `json:"padding,omitempty"`
+
+	// OnOverlap: how this widget affects widgets beneath it while visible (default: occlude)
+	OnOverlap *OverlapConfig `json:"on_overlap,omitempty"`
+}
+
+// OverlapConfig controls how a widget treats lower widgets it overlaps
+type OverlapConfig struct {
+	// Mode: "occlude" (draw over, default) or "dim" (fade widgets beneath instead of hiding them)
+	Mode OverlapMode `json:"mode,omitempty"`
+	// Factor: brightness kept by dimmed widgets beneath, 0.0-1.0 (default: 0.3).
+	// Pointer so that 0 (fade lower widgets out completely) can be told apart from unset
+	Factor *float64 `json:"factor,omitempty"`
 }
 
 // TextConfig represents text rendering properties
@@ -390,6 +533,9 @@ type TextConfig struct {
 	ShowUnit *bool        `json:"show_unit,omitempty"` // Show unit suffix in text mode (disk widget)
 	Use12h   bool         `json:"use_12h,omitempty"`   // Use 12-hour format instead of 24-hour (clock widget)
 	ShowAmPm bool         `json:"show_ampm,omitempty"` // Show AM/PM text when use_12h is true (clock widget)
+
+	// Orientation: "horizontal" (default), "rotate_cw", "rotate_ccw", "vertical_stacked"
+	Orientation TextOrientation `json:"orientation,omitempty"`
 }
 
 // AlignConfig represents text alignment
@@ -447,6 +593,9 @@ type ModeColorsConfig struct {
 	Fill *int `json:"fill,omitempty"`
 	Line *int `json:"line,omitempty"` // Optional separate line color for graph
 
+	// Bar fill / gauge arc: grayscale stops interpolated from the low end to the high end (empty = solid color)
+	Gradient []int `json:"gradient,omitempty"`
+
 	// Gauge specific
 	Arc    *int `json:"arc,omitempty"`
 	Needle *int `json:"needle,omitempty"`
@@ -484,6 +633,10 @@ type BarConfig struct {
 	Direction string            `json:"direction,omitempty"` // "horizontal", "vertical"
 	Border    bool              `json:"border,omitempty"`
 	Colors    *ModeColorsConfig `json:"colors,omitempty"`
+
+	// Pulse the fill while the value (0-100) is at or above this threshold: 0 = always, negative or unset = never
+	BlinkThreshold *float64 `json:"blink_threshold,omitempty"`
+	BlinkRate      float64  `json:"blink_rate,omitempty"` // Pulse frequency in Hz (default: 1)
 }
 
 // GraphConfig represents graph mode settings
@@ -496,6 +649,9 @@ type GraphConfig struct {
 type GaugeConfig struct {
 	ShowTicks *bool             `json:"show_ticks,omitempty"`
 	Colors    *ModeColorsConfig `json:"colors,omitempty"`
+
+	TickLabels    bool `json:"tick_labels,omitempty"`    // Show numeric labels at major ticks
+	LabelInterval int  `json:"label_interval,omitempty"` // Percent between labels (default: 25)
 }
 
 // AnalogConfig represents analog clock mode settings
@@ -503,11 +659,19 @@ type AnalogConfig struct {
 	ShowSeconds bool              `json:"show_seconds,omitempty"`
 	ShowTicks   bool              `json:"show_ticks,omitempty"`
 	Colors      *ModeColorsConfig `json:"colors,omitempty"`
+
+	TickLabels    bool `json:"tick_labels,omitempty"`    // Show hour numbers on the face
+	LabelInterval int  `json:"label_interval,omitempty"` // Hours between labels (default: 3, or 1 for numeral hour markers)
+
+	// HourMarkers: "none", "ticks", "numbers" or "roman"; overrides show_ticks and tick_labels when set
+	HourMarkers string `json:"hour_markers,omitempty"`
+	// SmoothSeconds: sweep the second hand continuously instead of ticking once per second
+	SmoothSeconds bool `json:"smooth_seconds,omitempty"`
 }
 
 // BinaryClockConfig represents binary clock mode settings
 type BinaryClockConfig struct {
-	// Format: time format like "%H:%M" or "%H:%M:%S" (default: "%H:%M:%S")
+	// Format: time format like "%H:%M", "%H:%M:%S" or "%I:%M %p" (default: "%H:%M:%S")
 	Format string `json:"format,omitempty"`
 	// Style: "bcd" (each digit in binary) or "true" (whole number in binary)
 	Style string `json:"style,omitempty"`
@@ -533,9 +697,20 @@ type BinaryClockConfig struct {
 	ShowAmPm bool `json:"show_ampm,omitempty"`
 }
 
+// ClockConfig represents the clock widget time source
+type ClockConfig struct {
+	// Source: "clock" (current time, default), "countdown" (time left until target), "elapsed" (time since target)
+	Source string `json:"source,omitempty"`
+	// Target: date and time as "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02" or RFC 3339.
+	// A time of day alone ("15:04" or "15:04:05") repeats daily.
+	Target string `json:"target,omitempty"`
+	// Timezone: IANA zone name like "America/New_York" for the time of day and targets (default: local time)
+	Timezone string `json:"timezone,omitempty"`
+}
+
 // SegmentClockConfig represents seven-segment clock mode settings
 type SegmentClockConfig struct {
-	// Format: time format like "%H:%M" or "%H:%M:%S" (default: "%H:%M:%S")
+	// Format: time format like "%H:%M", "%H:%M:%S" or "%I:%M %p" (default: "%H:%M:%S")
 	Format string `json:"format,omitempty"`
 	// DigitHeight: height of digits in pixels (default: auto-fit)
 	DigitHeight int `json:"digit_height,omitempty"`
@@ -547,8 +722,11 @@ type SegmentClockConfig struct {
 	DigitSpacing int `json:"digit_spacing,omitempty"`
 	// ColonStyle: "dots", "bar", or "none" (default: "dots")
 	ColonStyle string `json:"colon_style,omitempty"`
-	// ColonBlink: blink colon every second (default: true)
+	// ColonBlink: legacy switch, true = colon_blink_ms 1000, false = 0 (default: true)
 	ColonBlink *bool `json:"colon_blink,omitempty"`
+	// ColonBlinkMs: time between colon toggles in ms, 0 = steady (default: 1000).
+	// Takes precedence over ColonBlink.
+	ColonBlinkMs *int `json:"colon_blink_ms,omitempty"`
 	// OnColor: color for "on" segments (default: 255)
 	OnColor *int `json:"on_color,omitempty"`
 	// OffColor: color for "off" segments, 0 = invisible (default: 30)
@@ -559,7 +737,7 @@ type SegmentClockConfig struct {
 	Use12h bool `json:"use_12h,omitempty"`
 	// ShowAmPm: show AM/PM indicator when use_12h is true (default: false)
 	ShowAmPm bool `json:"show_ampm,omitempty"`
-	// AmPmStyle: AM/PM indicator style - "dot" or "text" (default: "dot")
+	// AmPmStyle: AM/PM indicator style - "dot", "text" or "letter" (default: "dot", or "letter" when format contains %p)
 	AmPmStyle string `json:"ampm_style,omitempty"`
 }
 
@@ -574,13 +752,27 @@ type FlipEffectConfig struct {
 // SpectrumConfig represents spectrum analyzer settings
 type SpectrumConfig struct {
 	Bars                  int                   `json:"bars,omitempty"`
-	Scale                 string                `json:"scale,omitempty"` // "logarithmic", "linear"
-	Style                 string                `json:"style,omitempty"` // "bars", "line"
+	Scale                 string                `json:"scale,omitempty"`         // "logarithmic", "linear"
+	Style                 string                `json:"style,omitempty"`         // "bars", "line"
+	Layout                string                `json:"layout,omitempty"`        // "normal", "mirror_center", "mirror_edges"
+	Window                string                `json:"window,omitempty"`        // FFT window: "none", "hann" (default), "hamming", "blackman"
+	SegmentCount          int                   `json:"segment_count,omitempty"` // LED segments per bar, 0 = solid bars
+	SegmentGap            *int                  `json:"segment_gap,omitempty"`   // Pixels between LED segments (default: 1, 0 = touching)
 	Smoothing             float64               `json:"smoothing,omitempty"`
 	FrequencyCompensation bool                  `json:"frequency_compensation,omitempty"`
 	DynamicScaling        *DynamicScalingConfig `json:"dynamic_scaling,omitempty"`
 	Peak                  *PeakConfig           `json:"peak,omitempty"`
 	Colors                *ModeColorsConfig     `json:"colors,omitempty"`
+
+	AmplitudeScale string  `json:"amplitude_scale,omitempty"` // "linear" (default), "db"
+	DBFloor        float64 `json:"db_floor,omitempty"`        // Lowest level shown with "db" scale (default: -60)
+
+	Attack  *float64 `json:"attack,omitempty"`  // Smoothing while bars rise (0 = instant, default: smoothing)
+	Release *float64 `json:"release,omitempty"` // Smoothing while bars fall (0 = instant, default: smoothing)
+
+	Gain               float64 `json:"gain,omitempty"`                // Multiplier applied to captured samples (default: 1.0)
+	VolumeCompensation *bool   `json:"volume_compensation,omitempty"` // Undo system volume before analysis (default: true, Windows)
+	SilentOnNoDevice   bool    `json:"silent_on_no_device,omitempty"` // Show silence and keep retrying instead of the error state
 }
 
 // DynamicScalingConfig represents dynamic scaling settings
@@ -594,6 +786,19 @@ type OscilloscopeConfig struct {
 	Style   string            `json:"style,omitempty"` // "line", "filled"
 	Samples int               `json:"samples,omitempty"`
 	Colors  *ModeColorsConfig `json:"colors,omitempty"`
+
+	Trigger      string  `json:"trigger,omitempty"`       // "none" (default), "rising", "falling"
+	TriggerLevel float64 `json:"trigger_level,omitempty"` // Sample level the trigger fires at (-1.0 to 1.0, default: 0)
+
+	Gain               float64 `json:"gain,omitempty"`                // Multiplier applied to captured samples (default: 1.0)
+	VolumeCompensation *bool   `json:"volume_compensation,omitempty"` // Undo system volume before drawing (default: true, Windows)
+	SilentOnNoDevice   bool    `json:"silent_on_no_device,omitempty"` // Show silence and keep retrying instead of the error state
+}
+
+// CorrelationConfig represents stereo correlation meter settings
+type CorrelationConfig struct {
+	Smoothing float64           `json:"smoothing,omitempty"` // Indicator smoothing 0.0-1.0 (default: 0.5)
+	Colors    *ModeColorsConfig `json:"colors,omitempty"`    // fill: in phase, clipping: out of phase, ticks: scale
 }
 
 // PerCoreConfig represents per-core CPU settings
@@ -609,6 +814,13 @@ type GPUConfig struct {
 	Metric  string `json:"metric,omitempty"`  // Metric to display: utilization, utilization_3d, memory_dedicated, etc.
 }
 
+// ProcessConfig represents process widget settings
+type ProcessConfig struct {
+	Name   string `json:"name,omitempty"`   // Process name matcher (case-insensitive substring, e.g. "chrome")
+	PID    int32  `json:"pid,omitempty"`    // Exact process ID; takes precedence over name
+	Metric string `json:"metric,omitempty"` // "cpu" (default) or "memory"
+}
+
 // HWMonConfig represents Hardware Monitor widget settings (LHM/OHM).
 type HWMonConfig struct {
 	// LHM/OHM web server URL (default: "http://localhost:8085")
@@ -636,12 +848,15 @@ type MeteringConfig struct {
 	DBScale          bool    `json:"db_scale,omitempty"`
 	DecayRate        float64 `json:"decay_rate,omitempty"`
 	SilenceThreshold float64 `json:"silence_threshold,omitempty"`
+	Ballistics       string  `json:"ballistics,omitempty"` // "vu", "ppm", "digital_peak"; empty keeps the decay_rate behavior
 }
 
 // PeakConfig represents peak hold settings
 type PeakConfig struct {
-	Enabled  bool    `json:"enabled,omitempty"`
-	HoldTime float64 `json:"hold_time,omitempty"`
+	Enabled        bool    `json:"enabled,omitempty"`
+	HoldTime       float64 `json:"hold_time,omitempty"`
+	PeakDecayStyle string  `json:"decay_style,omitempty"` // Spectrum only: "exponential" (default), "instant", "linear", "gravity"
+	PeakGravity    float64 `json:"gravity,omitempty"`     // Spectrum only: fall acceleration for "gravity", bar heights/s² (default: 2.0)
 }
 
 // ClippingConfig represents clipping detection settings
@@ -655,6 +870,9 @@ type IndicatorsConfig struct {
 	Caps   *IndicatorConfig `json:"caps,omitempty"`
 	Num    *IndicatorConfig `json:"num,omitempty"`
 	Scroll *IndicatorConfig `json:"scroll,omitempty"`
+
+	// FlashOnChange: seconds an indicator flashes after its state changes, using blink timing (default: 0 = disabled)
+	FlashOnChange float64 `json:"flash_on_change,omitempty"`
 }
 
 // IndicatorConfig represents a single keyboard indicator
@@ -676,6 +894,18 @@ type KeyboardLayout struct {
 type WinampConfig struct {
 	// Placeholder configuration when Winamp is not playing
 	Placeholder *WinampPlaceholderConfig `json:"placeholder,omitempty"`
+	// Lines: separate text lines stacked top to bottom, replacing text.format
+	Lines []WinampLineConfig `json:"lines,omitempty"`
+	// Source: player to read - "auto" (default, MPRIS on Linux, Winamp elsewhere), "winamp", "mpris"
+	Source string `json:"source,omitempty"`
+}
+
+// WinampLineConfig represents one line of the Winamp widget
+type WinampLineConfig struct {
+	// Format: text with the same placeholders as text.format
+	Format string `json:"format"`
+	// Scroll: scrolling for this line (default: widget-level scroll)
+	Scroll *ScrollConfig `json:"scroll,omitempty"`
 }
 
 // WinampAutoShowConfig represents events that trigger the widget to show
@@ -783,11 +1013,13 @@ type ScrollConfig struct {
 	Enabled bool `json:"enabled,omitempty"`
 	// Direction: "left", "right", "up", "down"
 	Direction ScrollDirection `json:"direction,omitempty"`
-	// Speed in pixels per second
+	// Speed in pixels per second (characters per second in typewriter mode)
 	Speed float64 `json:"speed,omitempty"`
-	// Mode: "continuous" (loop), "bounce" (reverse at edges), "pause_ends" (pause at start/end)
+	// Mode: "continuous" (loop), "bounce" (reverse at edges), "pause_ends" (pause at start/end),
+	// "typewriter" (characters appear one at a time)
 	Mode ScrollMode `json:"mode,omitempty"`
-	// PauseMs - pause duration in milliseconds at ends (for bounce/pause_ends modes)
+	// PauseMs - pause duration in milliseconds at ends (for bounce/pause_ends modes),
+	// or how long the full text is held before typing restarts (typewriter mode)
 	PauseMs int `json:"pause_ms,omitempty"`
 	// Gap - pixels between end and start of text in continuous mode
 	Gap int `json:"gap,omitempty"`
@@ -837,6 +1069,9 @@ type DoomConfig struct {
 	ContrastBoost float64 `json:"contrast_boost,omitempty"`
 	// DitherSize: Bayer matrix size for dither mode (2, 4, or 8, default: 4)
 	DitherSize int `json:"dither_size,omitempty"`
+	// Demo: path to a recorded demo (.lmp, Doom v1.9 format) played in a loop
+	// instead of the WAD's own demos (default: none)
+	Demo string `json:"demo,omitempty"`
 }
 
 // BatteryConfig represents Battery widget settings
@@ -886,6 +1121,11 @@ type PowerStatusConfig struct {
 	ShowPlugged string `json:"show_plugged,omitempty"`
 	// NotifyDuration: seconds to show indicator in "notify" modes (default: 60)
 	NotifyDuration int `json:"notify_duration,omitempty"`
+	// AlertMode: how to alert when the battery drops to the critical threshold while unplugged
+	// Values: "off", "widget" (flash the widget), "fullscreen" (take over the whole display) (default: "off")
+	AlertMode string `json:"alert_mode,omitempty"`
+	// AlertDuration: seconds to show the critical battery alert (default: 5)
+	AlertDuration int `json:"alert_duration,omitempty"`
 }
 
 // WeatherConfig represents Weather widget settings
@@ -896,10 +1136,18 @@ type WeatherConfig struct {
 	ApiKey string `json:"api_key,omitempty"`
 	// Location configuration
 	Location *WeatherLocationConfig `json:"location,omitempty"`
+	// Locations: several places to cycle through with the cycle settings; replaces Location when set
+	Locations []WeatherLocationConfig `json:"locations,omitempty"`
 	// Units: "metric" (Celsius, m/s) or "imperial" (Fahrenheit, mph) (default: "metric")
 	Units string `json:"units,omitempty"`
 	// IconSize: size of weather icons in pixels (default: 16)
 	IconSize int `json:"icon_size,omitempty"`
+	// Timezone: IANA zone name like "Europe/Berlin" for the {sunrise} and {sunset} tokens (default: local time)
+	Timezone string `json:"timezone,omitempty"`
+	// CacheMaxAgeMin: minutes a cached reading is shown while fetches fail, then the error is shown (default: 0 = no limit)
+	CacheMaxAgeMin int `json:"cache_max_age_min,omitempty"`
+	// AqiColor: draw the {aqi} token brighter the worse the air quality band (default: false)
+	AqiColor bool `json:"aqi_color,omitempty"`
 	// Format: display format string(s) with tokens like {icon}, {temp}, {aqi}, etc.
 	// Can be a single string or an array of strings (for cycling between formats).
 	// Supports newlines (\n) for multi-line layouts.
@@ -923,6 +1171,8 @@ type WeatherConfig struct {
 
 // WeatherLocationConfig represents weather location settings
 type WeatherLocationConfig struct {
+	// Name: shown by the {city} token (default: City)
+	Name string `json:"name,omitempty"`
 	// City: city name (e.g., "London" or "New York,US")
 	City string `json:"city,omitempty"`
 	// Lat: latitude for coordinate-based location
@@ -949,10 +1199,14 @@ type WeatherCycleConfig struct {
 	// Values: "none", "push_left", "push_right", "push_up", "push_down",
 	//         "slide_left", "slide_right", "slide_up", "slide_down",
 	//         "dissolve_fade", "dissolve_pixel", "dissolve_dither",
-	//         "box_in", "box_out", "clock_wipe", "random"
+	//         "box_in", "box_out", "clock_wipe", "wipe_diagonal_tl", "wipe_diagonal_br",
+	//         "iris_in", "iris_out", "random"
 	Transition string `json:"transition,omitempty"`
 	// Speed: transition duration in seconds (default: 0.5)
 	Speed float64 `json:"speed,omitempty"`
+	// Easing: transition progress curve: "linear" (default), "ease_in", "ease_out",
+	// "ease_in_out", "bounce"
+	Easing string `json:"easing,omitempty"`
 }
 
 // GameOfLifeConfig represents Conway's Game of Life widget settings
@@ -965,7 +1219,8 @@ type GameOfLifeConfig struct {
 	// WrapEdges: wrap edges to form a torus topology (default: true)
 	WrapEdges *bool `json:"wrap_edges,omitempty"`
 	// InitialPattern: starting pattern (default: "random")
-	// Values: "random", "clear", "glider", "r_pentomino", "acorn", "diehard", "lwss", "pulsar", "glider_gun"
+	// Values: "random", "clear", "glider", "r_pentomino", "acorn", "diehard", "lwss", "pulsar", "glider_gun",
+	// or a path to a pattern file in the Life RLE format (*.rle)
 	InitialPattern string `json:"initial_pattern,omitempty"`
 	// RandomDensity: probability of cell being alive in random pattern (0.0-1.0, default: 0.3)
 	RandomDensity float64 `json:"random_density,omitempty"`
@@ -977,6 +1232,8 @@ type GameOfLifeConfig struct {
 	TrailDecay int `json:"trail_decay,omitempty"`
 	// CellColor: brightness of alive cells (1-255, default: 255)
 	CellColor int `json:"cell_color,omitempty"`
+	// BirthColor: brightness of cells born in the last generation (1-255, default: 0 = same as cell_color)
+	BirthColor int `json:"birth_color,omitempty"`
 	// RestartTimeout: seconds to wait before restarting when simulation ends (default: 3.0)
 	// 0 = restart immediately, -1 = never restart (stay in final state)
 	RestartTimeout *float64 `json:"restart_timeout,omitempty"`
@@ -985,6 +1242,16 @@ type GameOfLifeConfig struct {
 	RestartMode string `json:"restart_mode,omitempty"`
 }
 
+// MoonPhaseConfig represents moon phase widget settings
+type MoonPhaseConfig struct {
+	// ShowLabel: draw the phase name ("Waxing Gibbous") next to the moon (default: false)
+	ShowLabel bool `json:"show_label,omitempty"`
+	// Radius: moon radius in pixels (default: fit the widget height)
+	Radius int `json:"radius,omitempty"`
+	// Hemisphere: "northern" or "southern"; the southern view mirrors the crescent (default: "northern")
+	Hemisphere string `json:"hemisphere,omitempty"`
+}
+
 // HyperspaceConfig represents Star Wars hyperspace effect widget settings
 type HyperspaceConfig struct {
 	// StarCount: number of stars (default: 100)
@@ -1146,6 +1413,36 @@ type ScreenMirrorWindowConfig struct {
 	Active bool `json:"active,omitempty"`
 }
 
+// CaptureConfig contains settings for the capture widget.
+// Captured frames are converted to grayscale using the same render modes as the DOOM widget.
+type CaptureConfig struct {
+	// Source: what to capture - "screen", "region", "camera" (default: "screen")
+	Source string `json:"source,omitempty"`
+	// Display: which display to capture for "screen" source (same values as screen_mirror display)
+	Display *IntOrString `json:"display,omitempty"`
+	// Region: rectangular area to capture, required for "region" source
+	Region *ScreenMirrorRegionConfig `json:"region,omitempty"`
+	// Camera: camera device for "camera" source
+	// Windows: DirectShow device name, Linux: device path (default: first available camera)
+	Camera string `json:"camera,omitempty"`
+	// FPS: capture framerate, 1-10 (default: 2)
+	FPS int `json:"fps,omitempty"`
+	// ScaleMode: how to scale captured content - "fit", "stretch", "crop" (default: "fit")
+	ScaleMode string `json:"scale_mode,omitempty"`
+	// RenderMode: grayscale conversion mode, same as DOOM widget (default: "normal")
+	RenderMode string `json:"render_mode,omitempty"`
+	// PosterizeLevels: number of gray levels for posterize mode (2-16, default: 4)
+	PosterizeLevels int `json:"posterize_levels,omitempty"`
+	// ThresholdValue: cutoff value for threshold mode (0-255, default: 128)
+	ThresholdValue int `json:"threshold_value,omitempty"`
+	// Gamma: gamma value for gamma mode (0.1-3.0, default: 1.5)
+	Gamma float64 `json:"gamma,omitempty"`
+	// ContrastBoost: contrast multiplier for gamma mode (1.0-3.0, default: 1.2)
+	ContrastBoost float64 `json:"contrast_boost,omitempty"`
+	// DitherSize: Bayer matrix size for dither mode (2, 4, or 8, default: 4)
+	DitherSize int `json:"dither_size,omitempty"`
+}
+
 // HackerCodeConfig contains settings for the hacker code widget.
 // Font is configured via standard text.font setting ("3x5", "5x7", "pixel3x5", "pixel5x7").
 type HackerCodeConfig struct {
@@ -1175,7 +1472,8 @@ type SeparatorConfig struct {
 // Transition types: "none", "push_left", "push_right", "push_up", "push_down",
 // "slide_left", "slide_right", "slide_up", "slide_down",
 // "dissolve_fade", "dissolve_pixel", "dissolve_dither",
-// "box_in", "box_out", "clock_wipe", "random"
+// "box_in", "box_out", "clock_wipe", "wipe_diagonal_tl", "wipe_diagonal_br",
+// "iris_in", "iris_out", "random"
 type TransitionConfig struct {
 	// In: transition effect when showing (default: "none")
 	In string `json:"in,omitempty"`
@@ -1185,6 +1483,9 @@ type TransitionConfig struct {
 	Out string `json:"out,omitempty"`
 	// OutSpeed: transition duration in seconds (default: 0.5)
 	OutSpeed float64 `json:"out_speed,omitempty"`
+	// Easing: progress curve of both effects: "linear" (default), "ease_in", "ease_out",
+	// "ease_in_out", "bounce"
+	Easing string `json:"easing,omitempty"`
 }
 
 // TelegramAuthConfig contains Telegram API authentication credentials
@@ -1230,6 +1531,14 @@ type TelegramBadgeConfig struct {
 	Colors *TelegramBadgeColorsConfig `json:"colors,omitempty"`
 }
 
+// TelegramCounterConfig contains count display settings for the telegram_counter widget
+type TelegramCounterConfig struct {
+	// Max: largest count shown as is; higher counts render as "{max}+", e.g. "99+" (0 = no limit, default: 0)
+	Max int `json:"max,omitempty"`
+	// Format: "dot" replaces the badge or text with a filled dot while anything is unread (default: "")
+	Format string `json:"format,omitempty"`
+}
+
 // TelegramBadgeColorsConfig contains color settings for badge icon
 type TelegramBadgeColorsConfig struct {
 	// Foreground: color for icon shape (0-255, or -1 for transparent)
@@ -1250,6 +1559,14 @@ type TelegramAppearanceConfig struct {
 	Timeout int `json:"timeout,omitempty"`
 	// Transitions: transition effects for showing/hiding notifications
 	Transitions *TransitionConfig `json:"transitions,omitempty"`
+	// Highlight: names or keywords that make the message blink when it contains them as
+	// whole words, case-insensitive (default: none)
+	Highlight []string `json:"highlight,omitempty"`
+	// HighlightInvert: also draw highlighted messages inverted, light background with dark text (default: false)
+	HighlightInvert bool `json:"highlight_invert,omitempty"`
+	// MinDisplayMs: show each message at least this long before the next one replaces it.
+	// Messages arriving sooner wait in a short queue (0 = replace immediately, default: 0)
+	MinDisplayMs int `json:"min_display_ms,omitempty"`
 }
 
 // TelegramElementConfig contains settings for a notification element (header or message)
@@ -1264,6 +1581,9 @@ type TelegramElementConfig struct {
 	Scroll *ScrollConfig `json:"scroll,omitempty"`
 	// WordBreak: how to break lines - "normal" (break on spaces) or "break-all" (break anywhere)
 	WordBreak string `json:"word_break,omitempty"`
+	// MultiLine: wrap the header onto several lines and scroll it vertically instead of
+	// horizontally (header only; the message always wraps, default: false)
+	MultiLine bool `json:"multi_line,omitempty"`
 }
 
 // ClaudeCodeConfig contains settings for the Claude Code notification widget.
@@ -1327,3 +1647,20 @@ type BluetoothConfig struct {
 	// LowBatteryThreshold: battery percentage at or below which the indicator blinks (0 = disabled, default: 0)
 	LowBatteryThreshold int `json:"low_battery_threshold,omitempty"`
 }
+
+// HTTPJSONConfig holds settings for the generic JSON/HTTP data widget
+type HTTPJSONConfig struct {
+	// URL: endpoint returning JSON (required). Environment variables ($VAR or ${VAR}) are expanded
+	URL string `json:"url"`
+	// Headers: extra request headers, e.g. {"Authorization": "Bearer ${HA_TOKEN}"}.
+	// Environment variables in values are expanded
+	Headers map[string]string `json:"headers,omitempty"`
+	// Interval: seconds between requests (default: 60)
+	Interval float64 `json:"interval,omitempty"`
+	// Timeout: request timeout in seconds (default: 5)
+	Timeout float64 `json:"timeout,omitempty"`
+	// Tokens: maps token names to JSONPath expressions, e.g. {"temp": "$.sensors[0].value"}
+	Tokens map[string]string `json:"tokens"`
+	// Format: display format with tokens, {name} or {name:N} for N decimal places (default: "{value}")
+	Format string `json:"format,omitempty"`
+}
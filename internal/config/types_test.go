@@ -410,6 +410,17 @@ func TestConfigForDevice_NilDriversInheritGlobal(t *testing.T) {
 	}
 }
 
+func TestConfigForDevice_MaxPushFPS(t *testing.T) {
+	global := &Config{MaxPushFPS: 30}
+
+	if merged := global.ConfigForDevice(DeviceConfig{}); merged.MaxPushFPS != 30 {
+		t.Errorf("MaxPushFPS = %d, want 30 (inherited)", merged.MaxPushFPS)
+	}
+	if merged := global.ConfigForDevice(DeviceConfig{MaxPushFPS: 10}); merged.MaxPushFPS != 10 {
+		t.Errorf("MaxPushFPS = %d, want 10 (device override)", merged.MaxPushFPS)
+	}
+}
+
 func TestConfigForDevice_DoesNotMutateOriginal(t *testing.T) {
 	global := &Config{
 		Backend: "gamesense",
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 )
 
 // Validation constants
@@ -10,6 +11,11 @@ const (
 	MaxDeinitializeTimerMs = 60000
 	MinEventBatchSize      = 1
 	MaxEventBatchSize      = 100
+	MaxStartupDelayMs      = 300000
+	MaxBackendRetries      = 30
+	MaxPushFPS             = 240
+	MaxImageBrightness     = 255
+	MaxContrast            = 10
 )
 
 // BackendTypeChecker is a callback function that checks if a backend type is registered.
@@ -20,11 +26,17 @@ var BackendTypeChecker func(name string) bool
 // This is set by the backend package to avoid import cycles.
 var BackendTypesLister func() string
 
+// IsAutoBackend reports whether a backend setting selects the backend
+// automatically: either empty or "auto"
+func IsAutoBackend(name string) bool {
+	return name == "" || name == BackendAuto
+}
+
 // IsValidBackend checks if the given backend name is valid.
-// Empty string means auto-selection (try backends by priority).
+// Empty string and "auto" mean auto-selection (try backends by priority).
 // Other values are checked against the backend registry.
 func IsValidBackend(name string) bool {
-	if name == "" {
+	if IsAutoBackend(name) {
 		return true
 	}
 	if BackendTypeChecker != nil {
@@ -119,12 +131,22 @@ func validateDevices(cfg *Config) error {
 		if dev.Display.Height <= 0 {
 			return fmt.Errorf("devices[%d]: display height must be positive (got %d)", i, dev.Display.Height)
 		}
+		if err := validateBurnInProtection(dev.Display.BurnInProtection); err != nil {
+			return fmt.Errorf("devices[%d]: %w", i, err)
+		}
+		if err := validateRefreshCycle(dev.Display.RefreshCycle); err != nil {
+			return fmt.Errorf("devices[%d]: %w", i, err)
+		}
 
 		// Validate backend
 		if !IsValidBackend(dev.Backend) {
 			return fmt.Errorf("devices[%d]: invalid backend '%s' (valid: %s)", i, dev.Backend, GetValidBackendsList())
 		}
 
+		if err := validateMaxPushFPS(dev.MaxPushFPS); err != nil {
+			return fmt.Errorf("devices[%d]: %w", i, err)
+		}
+
 		// Validate widgets
 		if len(dev.Widgets) == 0 {
 			return fmt.Errorf("devices[%d]: at least one widget must be configured", i)
@@ -161,6 +183,139 @@ func validateGlobalConfig(cfg *Config) error {
 		}
 	}
 
+	if cfg.StartupDelayMs < 0 || cfg.StartupDelayMs > MaxStartupDelayMs {
+		return fmt.Errorf("startup_delay_ms must be between 0 and %d (got %d)", MaxStartupDelayMs, cfg.StartupDelayMs)
+	}
+
+	if cfg.BackendRetries != nil && (*cfg.BackendRetries < 0 || *cfg.BackendRetries > MaxBackendRetries) {
+		return fmt.Errorf("backend_retries must be between 0 and %d (got %d)", MaxBackendRetries, *cfg.BackendRetries)
+	}
+
+	if err := validateMaxPushFPS(cfg.MaxPushFPS); err != nil {
+		return err
+	}
+
+	if cfg.Brightness < -MaxImageBrightness || cfg.Brightness > MaxImageBrightness {
+		return fmt.Errorf("brightness must be between %d and %d (got %d)", -MaxImageBrightness, MaxImageBrightness, cfg.Brightness)
+	}
+
+	if cfg.Contrast < 0 || cfg.Contrast > MaxContrast {
+		return fmt.Errorf("contrast must be between 0 and %d (got %g)", MaxContrast, cfg.Contrast)
+	}
+
+	switch cfg.OnExitDisplay {
+	case "", ExitDisplayGoodbye, ExitDisplayClear, ExitDisplayLogo, ExitDisplayKeep:
+	default:
+		return fmt.Errorf("invalid on_exit_display '%s' (valid: %s, %s, %s, %s)", cfg.OnExitDisplay,
+			ExitDisplayGoodbye, ExitDisplayClear, ExitDisplayLogo, ExitDisplayKeep)
+	}
+
+	switch cfg.Language {
+	case "", LanguageAuto, LanguageEnglish, LanguageRussian, LanguageUkrainian:
+	default:
+		return fmt.Errorf("invalid language '%s' (valid: %s, %s, %s, %s)", cfg.Language,
+			LanguageAuto, LanguageEnglish, LanguageRussian, LanguageUkrainian)
+	}
+
+	for i, item := range cfg.TrayMenu {
+		if item.Label == "" {
+			return fmt.Errorf("tray_menu[%d]: label is required", i)
+		}
+		if err := ValidateAction(item.ActionConfig); err != nil {
+			return fmt.Errorf("tray_menu[%d]: %w", i, err)
+		}
+	}
+
+	for i, trigger := range cfg.ProfileTriggers {
+		if trigger.Profile == "" {
+			return fmt.Errorf("profile_triggers[%d]: profile is required", i)
+		}
+		if trigger.Process == "" && trigger.WindowTitle == "" {
+			return fmt.Errorf("profile_triggers[%d]: process or window_title is required", i)
+		}
+	}
+
+	return validateSchedule(cfg)
+}
+
+// validateScheduleTime checks a schedule rule time
+func validateScheduleTime(s string) error {
+	if IsSunScheduleTime(s) {
+		return nil
+	}
+	_, err := ParseClockTime(s)
+	return err
+}
+
+// validateSchedule validates the profile schedule rules
+func validateSchedule(cfg *Config) error {
+	for i, rule := range cfg.ProfileSchedule {
+		if rule.Profile == "" {
+			return fmt.Errorf("profile_schedule[%d]: profile is required", i)
+		}
+		if rule.Start == "" {
+			return fmt.Errorf("profile_schedule[%d]: start is required", i)
+		}
+		if err := validateScheduleTime(rule.Start); err != nil {
+			return fmt.Errorf("profile_schedule[%d]: start: %w", i, err)
+		}
+		if rule.End != "" {
+			if err := validateScheduleTime(rule.End); err != nil {
+				return fmt.Errorf("profile_schedule[%d]: end: %w", i, err)
+			}
+		}
+		if _, err := ParseScheduleDays(rule.Days); err != nil {
+			return fmt.Errorf("profile_schedule[%d]: %w", i, err)
+		}
+		if (IsSunScheduleTime(rule.Start) || IsSunScheduleTime(rule.End)) && cfg.ScheduleLocation == nil {
+			return fmt.Errorf("profile_schedule[%d]: %s and %s require schedule_location", i, ScheduleSunrise, ScheduleSunset)
+		}
+	}
+
+	if loc := cfg.ScheduleLocation; loc != nil {
+		if loc.Lat < -90 || loc.Lat > 90 || loc.Lon < -180 || loc.Lon > 180 {
+			return fmt.Errorf("schedule_location: lat must be between -90 and 90, lon between -180 and 180")
+		}
+	}
+	return nil
+}
+
+// validateMaxPushFPS validates the frame push rate limit (0 = not set)
+func validateMaxPushFPS(fps int) error {
+	if fps < 0 || fps > MaxPushFPS {
+		return fmt.Errorf("max_push_fps must be between 0 and %d (got %d)", MaxPushFPS, fps)
+	}
+	return nil
+}
+
+// ValidateAction checks that an action has a known type and its required fields
+func ValidateAction(a ActionConfig) error {
+	switch a.Action {
+	case ActionSwitchProfile:
+		if a.Profile == "" {
+			return fmt.Errorf("action '%s' requires 'profile'", a.Action)
+		}
+	case ActionToggleWidget:
+		if a.Widget == "" {
+			return fmt.Errorf("action '%s' requires 'widget'", a.Action)
+		}
+	case ActionRunCommand:
+		if a.Command == "" {
+			return fmt.Errorf("action '%s' requires 'command'", a.Action)
+		}
+	case ActionSetBrightness:
+		if a.Brightness == nil {
+			return fmt.Errorf("action '%s' requires 'brightness'", a.Action)
+		}
+		if *a.Brightness < MinBrightness || *a.Brightness > MaxBrightness {
+			return fmt.Errorf("action '%s': brightness must be between %d and %d (got %d)",
+				a.Action, MinBrightness, MaxBrightness, *a.Brightness)
+		}
+	case ActionOpenEditor, ActionReloadConfig:
+	default:
+		return fmt.Errorf("invalid action '%s' (valid: %s, %s, %s, %s, %s, %s)", a.Action,
+			ActionSwitchProfile, ActionToggleWidget, ActionRunCommand, ActionOpenEditor, ActionReloadConfig, ActionSetBrightness)
+	}
 	return nil
 }
 
@@ -187,6 +342,50 @@ func validateDisplayConfig(cfg *Config) error {
 		}
 	}
 
+	if err := validateBurnInProtection(cfg.Display.BurnInProtection); err != nil {
+		return err
+	}
+
+	return validateRefreshCycle(cfg.Display.RefreshCycle)
+}
+
+// validateBurnInProtection validates burn-in protection settings
+func validateBurnInProtection(b *BurnInProtectionConfig) error {
+	if b == nil {
+		return nil
+	}
+	if b.ShiftInterval < 0 {
+		return fmt.Errorf("burn_in_protection.shift_interval must not be negative (got %g)", b.ShiftInterval)
+	}
+	if b.Magnitude != nil && (*b.Magnitude < 0 || *b.Magnitude > MaxBurnInMagnitude) {
+		return fmt.Errorf("burn_in_protection.magnitude must be between 0 and %d (got %d)", MaxBurnInMagnitude, *b.Magnitude)
+	}
+	if b.DimAfter < 0 {
+		return fmt.Errorf("burn_in_protection.dim_after must not be negative (got %g)", b.DimAfter)
+	}
+	if b.DimFactor < 0 || b.DimFactor > 1 {
+		return fmt.Errorf("burn_in_protection.dim_factor must be between 0 and 1 (got %g)", b.DimFactor)
+	}
+	return nil
+}
+
+// validateRefreshCycle validates refresh cycle settings
+func validateRefreshCycle(r *RefreshCycleConfig) error {
+	if r == nil {
+		return nil
+	}
+	switch r.Mode {
+	case "", RefreshCycleInvert, RefreshCycleWhite, RefreshCycleBlack:
+	default:
+		return fmt.Errorf("invalid refresh_cycle.mode '%s' (valid: %s, %s, %s)", r.Mode,
+			RefreshCycleInvert, RefreshCycleWhite, RefreshCycleBlack)
+	}
+	if r.Interval < 0 {
+		return fmt.Errorf("refresh_cycle.interval must not be negative (got %g)", r.Interval)
+	}
+	if r.DurationMs < 0 {
+		return fmt.Errorf("refresh_cycle.duration_ms must not be negative (got %d)", r.DurationMs)
+	}
 	return nil
 }
 
@@ -230,8 +429,286 @@ func validateWidgetType(index int, w *WidgetConfig) error {
 }
 
 // validateWidgetProperties validates type-specific widget properties
-func validateWidgetProperties(_ int, _ *WidgetConfig) error {
+func validateWidgetProperties(index int, w *WidgetConfig) error {
+	if err := validateTextOrientation(index, w); err != nil {
+		return err
+	}
+	if err := validateBarBlink(index, w); err != nil {
+		return err
+	}
+	if err := validateGradients(index, w); err != nil {
+		return err
+	}
+	if err := validateEasings(index, w); err != nil {
+		return err
+	}
+
 	// Network and disk widgets support auto-detection when interface/disk is omitted
-	// (sums all interfaces/disks), so no validation required
+	// (sums all interfaces/disks), so no validation required for those
+	switch w.Type {
+	case "winamp":
+		if w.Winamp != nil {
+			switch w.Winamp.Source {
+			case "", WinampSourceAuto, WinampSourceWinamp, WinampSourceMPRIS:
+			default:
+				return fmt.Errorf("widget[%d]: invalid winamp.source '%s' (valid: %s, %s, %s)",
+					index, w.Winamp.Source, WinampSourceAuto, WinampSourceWinamp, WinampSourceMPRIS)
+			}
+			for i, line := range w.Winamp.Lines {
+				if strings.TrimSpace(line.Format) == "" {
+					return fmt.Errorf("widget[%d]: winamp.lines[%d].format is required", index, i)
+				}
+			}
+		}
+	case "network":
+		if w.AutoScaleWindow < 0 {
+			return fmt.Errorf("widget[%d]: auto_scale_window must be positive, got %g", index, w.AutoScaleWindow)
+		}
+	case "disk":
+		if err := validateDiskScope(index, w); err != nil {
+			return err
+		}
+		if err := validateDiskMetric(index, w); err != nil {
+			return err
+		}
+		return validateDiskTextMode(index, w)
+	case "http_json":
+		return validateHTTPJSON(index, w)
+	case "audio_visualizer":
+		return validateCaptureMode(index, w)
+	case "process":
+		return validateProcessMonitor(index, w)
+	case "clock":
+		if w.Analog != nil {
+			switch w.Analog.HourMarkers {
+			case "", HourMarkersNone, HourMarkersTicks, HourMarkersNumbers, HourMarkersRoman:
+			default:
+				return fmt.Errorf("widget[%d]: invalid analog.hour_markers '%s' (valid: %s, %s, %s, %s)",
+					index, w.Analog.HourMarkers, HourMarkersNone, HourMarkersTicks, HourMarkersNumbers, HourMarkersRoman)
+			}
+		}
+	case "moon_phase":
+		if w.MoonPhase != nil {
+			if w.MoonPhase.Radius < 0 {
+				return fmt.Errorf("widget[%d]: moon_phase.radius must be positive, got %d", index, w.MoonPhase.Radius)
+			}
+			switch w.MoonPhase.Hemisphere {
+			case "", HemisphereNorthern, HemisphereSouthern:
+			default:
+				return fmt.Errorf("widget[%d]: invalid moon_phase.hemisphere '%s' (valid: %s, %s)",
+					index, w.MoonPhase.Hemisphere, HemisphereNorthern, HemisphereSouthern)
+			}
+		}
+	case "telegram_counter":
+		if w.Counter != nil {
+			if w.Counter.Max < 0 {
+				return fmt.Errorf("widget[%d]: counter.max must be positive, got %d", index, w.Counter.Max)
+			}
+			switch w.Counter.Format {
+			case "", CounterFormatDot:
+			default:
+				return fmt.Errorf("widget[%d]: invalid counter.format '%s' (valid: %s)",
+					index, w.Counter.Format, CounterFormatDot)
+			}
+		}
+	case "cpu_temp":
+		if w.MaxTempC < 0 {
+			return fmt.Errorf("widget[%d]: max_temp_c must be positive, got %g", index, w.MaxTempC)
+		}
+	}
 	return nil
 }
+
+// orientationWidgetTypes lists widget types whose text rendering honors text.orientation
+var orientationWidgetTypes = []string{
+	"clipboard", "clock", "cpu", "cpu_temp", "disk", "gpu", "http_json", "hwmon",
+	"memory", "network", "process", "profile_name", "volume",
+}
+
+// validateTextOrientation validates text.orientation and rejects it on widgets that ignore it
+func validateTextOrientation(index int, w *WidgetConfig) error {
+	if w.Text == nil {
+		return nil
+	}
+	switch w.Text.Orientation {
+	case "", OrientationHorizontal:
+		return nil
+	case OrientationRotateCW, OrientationRotateCCW, OrientationVerticalStacked:
+	default:
+		return fmt.Errorf("widget[%d]: invalid text.orientation '%s' (valid: %s, %s, %s, %s)",
+			index, w.Text.Orientation, OrientationHorizontal, OrientationRotateCW, OrientationRotateCCW, OrientationVerticalStacked)
+	}
+	for _, t := range orientationWidgetTypes {
+		if w.Type == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("widget[%d]: text.orientation is not supported by '%s' widgets (supported: %s)",
+		index, w.Type, strings.Join(orientationWidgetTypes, ", "))
+}
+
+// metricRendererWidgetTypes lists widget types drawn by the shared metric renderer,
+// which honors bar.blink_threshold and bar/gauge color gradients
+var metricRendererWidgetTypes = []string{"cpu", "cpu_temp", "gpu", "hwmon", "memory", "process"}
+
+// validateBarBlink validates bar.blink_rate and rejects bar.blink_threshold on widgets that ignore it
+func validateBarBlink(index int, w *WidgetConfig) error {
+	if w.Bar == nil {
+		return nil
+	}
+	if w.Bar.BlinkRate < 0 {
+		return fmt.Errorf("widget[%d]: bar.blink_rate must be positive, got %g", index, w.Bar.BlinkRate)
+	}
+	if w.Bar.BlinkThreshold == nil || *w.Bar.BlinkThreshold < 0 {
+		return nil
+	}
+	for _, t := range metricRendererWidgetTypes {
+		if w.Type == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("widget[%d]: bar.blink_threshold is not supported by '%s' widgets (supported: %s)",
+		index, w.Type, strings.Join(metricRendererWidgetTypes, ", "))
+}
+
+// validateGradients validates bar.colors.gradient and gauge.colors.gradient stops
+// and rejects them on widgets that ignore them
+func validateGradients(index int, w *WidgetConfig) error {
+	var bar, gauge []int
+	if w.Bar != nil && w.Bar.Colors != nil {
+		bar = w.Bar.Colors.Gradient
+	}
+	if w.Gauge != nil && w.Gauge.Colors != nil {
+		gauge = w.Gauge.Colors.Gradient
+	}
+	if len(bar) == 0 && len(gauge) == 0 {
+		return nil
+	}
+	if err := validateGradientStops(index, "bar", bar); err != nil {
+		return err
+	}
+	if err := validateGradientStops(index, "gauge", gauge); err != nil {
+		return err
+	}
+	for _, t := range metricRendererWidgetTypes {
+		if w.Type == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("widget[%d]: color gradients are not supported by '%s' widgets (supported: %s)",
+		index, w.Type, strings.Join(metricRendererWidgetTypes, ", "))
+}
+
+// validateGradientStops checks that every gradient stop is a valid grayscale value
+func validateGradientStops(index int, mode string, stops []int) error {
+	for i, v := range stops {
+		if v < 0 || v > 255 {
+			return fmt.Errorf("widget[%d]: %s.colors.gradient[%d] must be 0-255, got %d", index, mode, i, v)
+		}
+	}
+	return nil
+}
+
+// validateDiskTextMode validates the disk widget text mode
+func validateDiskTextMode(index int, w *WidgetConfig) error {
+	switch w.TextMode {
+	case "", DiskTextModeRate, DiskTextModePercent, DiskTextModeBoth:
+		return nil
+	default:
+		return fmt.Errorf("widget[%d]: invalid text_mode '%s' (valid: %s, %s, %s)",
+			index, w.TextMode, DiskTextModeRate, DiskTextModePercent, DiskTextModeBoth)
+	}
+}
+
+// validateProcessMonitor validates the process widget matcher and metric
+func validateProcessMonitor(index int, w *WidgetConfig) error {
+	p := w.ProcessMonitor
+	if p == nil || (strings.TrimSpace(p.Name) == "" && p.PID <= 0) {
+		return fmt.Errorf("widget[%d]: process_monitor.name or process_monitor.pid is required", index)
+	}
+	switch p.Metric {
+	case "", ProcessMetricCPU, ProcessMetricMemory:
+		return nil
+	default:
+		return fmt.Errorf("widget[%d]: invalid process_monitor.metric '%s' (valid: %s, %s)",
+			index, p.Metric, ProcessMetricCPU, ProcessMetricMemory)
+	}
+}
+
+// validateHTTPJSON validates the http_json widget endpoint and token mapping
+func validateHTTPJSON(index int, w *WidgetConfig) error {
+	if w.HTTPJSON == nil || strings.TrimSpace(w.HTTPJSON.URL) == "" {
+		return fmt.Errorf("widget[%d]: http_json.url is required", index)
+	}
+	if w.HTTPJSON.Interval > 0 && w.UpdateInterval > 0 && w.HTTPJSON.Interval != w.UpdateInterval {
+		return fmt.Errorf("widget[%d]: set either update_interval or http_json.interval, not both", index)
+	}
+	for name, path := range w.HTTPJSON.Tokens {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("widget[%d]: http_json.tokens.%s must not be empty", index, name)
+		}
+	}
+	return nil
+}
+
+// validateCaptureMode validates the audio visualizer capture source
+// validateEasings validates the transition easings of a widget
+func validateEasings(index int, w *WidgetConfig) error {
+	type easingField struct{ name, easing string }
+	var easings []easingField
+	if w.Transitions != nil {
+		easings = append(easings, easingField{"transitions.easing", w.Transitions.Easing})
+	}
+	if w.Appearance != nil && w.Appearance.Transitions != nil {
+		easings = append(easings, easingField{"appearance.transitions.easing", w.Appearance.Transitions.Easing})
+	}
+	if w.Weather != nil && w.Weather.Cycle != nil {
+		easings = append(easings, easingField{"weather.cycle.easing", w.Weather.Cycle.Easing})
+	}
+	for _, f := range easings {
+		switch f.easing {
+		case "", EasingLinear, EasingEaseIn, EasingEaseOut, EasingEaseInOut, EasingBounce:
+		default:
+			return fmt.Errorf("widget[%d]: invalid %s '%s' (valid: %s, %s, %s, %s, %s)",
+				index, f.name, f.easing, EasingLinear, EasingEaseIn, EasingEaseOut, EasingEaseInOut, EasingBounce)
+		}
+	}
+	return nil
+}
+
+func validateCaptureMode(index int, w *WidgetConfig) error {
+	switch w.CaptureMode {
+	case "", AudioCaptureModeLoopback, AudioCaptureModeMicrophone:
+		return nil
+	default:
+		return fmt.Errorf("widget[%d]: invalid capture_mode '%s' (valid: %s, %s)",
+			index, w.CaptureMode, AudioCaptureModeLoopback, AudioCaptureModeMicrophone)
+	}
+}
+
+// validateDiskMetric validates the disk widget metric
+func validateDiskMetric(index int, w *WidgetConfig) error {
+	switch w.Metric {
+	case "", DiskMetricThroughput, DiskMetricCapacity:
+		return nil
+	default:
+		return fmt.Errorf("widget[%d]: invalid metric '%s' (valid: %s, %s)",
+			index, w.Metric, DiskMetricThroughput, DiskMetricCapacity)
+	}
+}
+
+// validateDiskScope validates the disk widget I/O scope and process matcher
+func validateDiskScope(index int, w *WidgetConfig) error {
+	switch w.Scope {
+	case "", DiskScopeVolume, DiskScopeSystem:
+		return nil
+	case DiskScopeProcess:
+		if strings.TrimSpace(w.Process) == "" {
+			return fmt.Errorf("widget[%d]: process is required when scope is '%s'", index, DiskScopeProcess)
+		}
+		return nil
+	default:
+		return fmt.Errorf("widget[%d]: invalid scope '%s' (valid: %s, %s, %s)",
+			index, w.Scope, DiskScopeVolume, DiskScopeSystem, DiskScopeProcess)
+	}
+}
@@ -25,6 +25,7 @@ var testWidgetTypes = map[string]bool{
 	"weather":          true,
 	"battery":          true,
 	"game_of_life":     true,
+	"moon_phase":       true,
 	"hyperspace":       true,
 	"starwars_intro":   true,
 	"telegram":         true,
@@ -92,6 +93,9 @@ func TestValidBackends(t *testing.T) {
 	if !IsValidBackend("") {
 		t.Error("IsValidBackend(\"\") should be true (auto-selection)")
 	}
+	if !IsValidBackend(BackendAuto) {
+		t.Error("IsValidBackend(\"auto\") should be true (auto-selection)")
+	}
 
 	// Registered backends should be valid
 	registeredNames := []string{"gamesense", "direct"}
@@ -193,6 +197,102 @@ func TestValidateGlobalConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "startup delay valid",
+			cfg: Config{
+				Backend:        "gamesense",
+				StartupDelayMs: 15000,
+			},
+			wantErr: false,
+		},
+		{
+			name: "startup delay negative",
+			cfg: Config{
+				Backend:        "gamesense",
+				StartupDelayMs: -1,
+			},
+			wantErr: true,
+			errMsg:  "startup_delay_ms",
+		},
+		{
+			name: "startup delay too high",
+			cfg: Config{
+				Backend:        "gamesense",
+				StartupDelayMs: MaxStartupDelayMs + 1,
+			},
+			wantErr: true,
+			errMsg:  "startup_delay_ms",
+		},
+		{
+			name: "max push fps valid",
+			cfg: Config{
+				Backend:    "gamesense",
+				MaxPushFPS: 30,
+			},
+			wantErr: false,
+		},
+		{
+			name: "max push fps negative",
+			cfg: Config{
+				Backend:    "gamesense",
+				MaxPushFPS: -1,
+			},
+			wantErr: true,
+			errMsg:  "max_push_fps",
+		},
+		{
+			name: "max push fps too high",
+			cfg: Config{
+				Backend:    "gamesense",
+				MaxPushFPS: MaxPushFPS + 1,
+			},
+			wantErr: true,
+			errMsg:  "max_push_fps",
+		},
+		{
+			name: "brightness and contrast valid",
+			cfg: Config{
+				Backend:    "gamesense",
+				Brightness: -40,
+				Contrast:   1.5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "brightness out of range",
+			cfg: Config{
+				Backend:    "gamesense",
+				Brightness: MaxImageBrightness + 1,
+			},
+			wantErr: true,
+			errMsg:  "brightness",
+		},
+		{
+			name: "contrast negative",
+			cfg: Config{
+				Backend:  "gamesense",
+				Contrast: -0.5,
+			},
+			wantErr: true,
+			errMsg:  "contrast",
+		},
+		{
+			name: "backend retries zero",
+			cfg: Config{
+				Backend:        "gamesense",
+				BackendRetries: IntPtr(0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "backend retries too high",
+			cfg: Config{
+				Backend:        "gamesense",
+				BackendRetries: IntPtr(MaxBackendRetries + 1),
+			},
+			wantErr: true,
+			errMsg:  "backend_retries",
+		},
 		{
 			name: "event batch size too low",
 			cfg: Config{
@@ -219,6 +319,198 @@ func TestValidateGlobalConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "on_exit_display valid",
+			cfg: Config{
+				Backend:       "gamesense",
+				OnExitDisplay: ExitDisplayKeep,
+			},
+			wantErr: false,
+		},
+		{
+			name: "on_exit_display invalid",
+			cfg: Config{
+				Backend:       "gamesense",
+				OnExitDisplay: "fade",
+			},
+			wantErr: true,
+			errMsg:  "on_exit_display",
+		},
+		{
+			name: "language valid",
+			cfg: Config{
+				Backend:  "gamesense",
+				Language: LanguageRussian,
+			},
+			wantErr: false,
+		},
+		{
+			name: "language auto",
+			cfg: Config{
+				Backend:  "gamesense",
+				Language: LanguageAuto,
+			},
+			wantErr: false,
+		},
+		{
+			name: "language invalid",
+			cfg: Config{
+				Backend:  "gamesense",
+				Language: "klingon",
+			},
+			wantErr: true,
+			errMsg:  "language",
+		},
+		{
+			name: "tray menu valid",
+			cfg: Config{
+				Backend: "gamesense",
+				TrayMenu: []TrayMenuItemConfig{
+					{Label: "Work", ActionConfig: ActionConfig{Action: ActionSwitchProfile, Profile: "work"}},
+					{Label: "Clock", ActionConfig: ActionConfig{Action: ActionToggleWidget, Widget: "clock_0"}},
+					{Label: "Notes", ActionConfig: ActionConfig{Action: ActionRunCommand, Command: "notepad.exe"}},
+					{Label: "Editor", ActionConfig: ActionConfig{Action: ActionOpenEditor}},
+					{Label: "Reload", ActionConfig: ActionConfig{Action: ActionReloadConfig}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "tray menu missing label",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{ActionConfig: ActionConfig{Action: ActionOpenEditor}}},
+			},
+			wantErr: true,
+			errMsg:  "label is required",
+		},
+		{
+			name: "tray menu invalid action",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{Label: "X", ActionConfig: ActionConfig{Action: "explode"}}},
+			},
+			wantErr: true,
+			errMsg:  "invalid action",
+		},
+		{
+			name: "tray menu switch profile without profile",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{Label: "X", ActionConfig: ActionConfig{Action: ActionSwitchProfile}}},
+			},
+			wantErr: true,
+			errMsg:  "requires 'profile'",
+		},
+		{
+			name: "tray menu toggle widget without widget",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{Label: "X", ActionConfig: ActionConfig{Action: ActionToggleWidget}}},
+			},
+			wantErr: true,
+			errMsg:  "requires 'widget'",
+		},
+		{
+			name: "tray menu set brightness",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{Label: "Dim", ActionConfig: ActionConfig{Action: ActionSetBrightness, Brightness: IntPtr(2)}}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "tray menu set brightness out of range",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{Label: "Dim", ActionConfig: ActionConfig{Action: ActionSetBrightness, Brightness: IntPtr(11)}}},
+			},
+			wantErr: true,
+			errMsg:  "brightness must be between",
+		},
+		{
+			name: "tray menu set brightness without brightness",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{Label: "Dim", ActionConfig: ActionConfig{Action: ActionSetBrightness}}},
+			},
+			wantErr: true,
+			errMsg:  "requires 'brightness'",
+		},
+		{
+			name: "tray menu run command without command",
+			cfg: Config{
+				Backend:  "gamesense",
+				TrayMenu: []TrayMenuItemConfig{{Label: "X", ActionConfig: ActionConfig{Action: ActionRunCommand}}},
+			},
+			wantErr: true,
+			errMsg:  "requires 'command'",
+		},
+		{
+			name: "valid profile trigger",
+			cfg: Config{
+				Backend:         "gamesense",
+				ProfileTriggers: []ProfileTrigger{{Profile: "Gaming", Process: "game.exe"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "profile trigger without profile",
+			cfg: Config{
+				Backend:         "gamesense",
+				ProfileTriggers: []ProfileTrigger{{WindowTitle: "Game"}},
+			},
+			wantErr: true,
+			errMsg:  "profile_triggers[0]: profile is required",
+		},
+		{
+			name: "profile trigger without condition",
+			cfg: Config{
+				Backend:         "gamesense",
+				ProfileTriggers: []ProfileTrigger{{Profile: "Gaming"}},
+			},
+			wantErr: true,
+			errMsg:  "process or window_title is required",
+		},
+		{
+			name: "valid profile schedule",
+			cfg: Config{
+				Backend: "gamesense",
+				ProfileSchedule: []ScheduleRule{
+					{Profile: "Day", Start: "sunrise", End: "sunset", Days: []string{"weekdays"}},
+					{Profile: "Night", Start: "20:30"},
+				},
+				ScheduleLocation: &GeoLocationConfig{Lat: 55.75, Lon: 37.62},
+			},
+			wantErr: false,
+		},
+		{
+			name: "profile schedule invalid time",
+			cfg: Config{
+				Backend:         "gamesense",
+				ProfileSchedule: []ScheduleRule{{Profile: "Night", Start: "25:00"}},
+			},
+			wantErr: true,
+			errMsg:  "profile_schedule[0]: start: invalid time '25:00'",
+		},
+		{
+			name: "profile schedule invalid day",
+			cfg: Config{
+				Backend:         "gamesense",
+				ProfileSchedule: []ScheduleRule{{Profile: "Night", Start: "20:00", Days: []string{"someday"}}},
+			},
+			wantErr: true,
+			errMsg:  "invalid day 'someday'",
+		},
+		{
+			name: "profile schedule sunset without location",
+			cfg: Config{
+				Backend:         "gamesense",
+				ProfileSchedule: []ScheduleRule{{Profile: "Night", Start: "sunset"}},
+			},
+			wantErr: true,
+			errMsg:  "require schedule_location",
+		},
 	}
 
 	for _, tt := range tests {
@@ -407,6 +699,83 @@ func TestValidateDisplayConfigSupportedResolutions(t *testing.T) {
 	}
 }
 
+func TestValidateBurnInProtection(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *BurnInProtectionConfig
+		errMsg string
+	}{
+		{name: "nil", cfg: nil},
+		{name: "defaults", cfg: &BurnInProtectionConfig{Enabled: true}},
+		{name: "full", cfg: &BurnInProtectionConfig{Enabled: true, ShiftInterval: 30, Magnitude: IntPtr(2), DimAfter: 600, DimFactor: 0.4}},
+		{name: "zero magnitude", cfg: &BurnInProtectionConfig{Enabled: true, Magnitude: IntPtr(0)}},
+		{name: "negative interval", cfg: &BurnInProtectionConfig{ShiftInterval: -1}, errMsg: "shift_interval"},
+		{name: "magnitude too large", cfg: &BurnInProtectionConfig{Magnitude: IntPtr(MaxBurnInMagnitude + 1)}, errMsg: "magnitude"},
+		{name: "negative dim_after", cfg: &BurnInProtectionConfig{DimAfter: -5}, errMsg: "dim_after"},
+		{name: "dim_factor above one", cfg: &BurnInProtectionConfig{DimFactor: 1.5}, errMsg: "dim_factor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{
+				Display:       DisplayConfig{Width: 128, Height: 40, BurnInProtection: tt.cfg},
+				RefreshRateMs: 100,
+			}
+			err := validateDisplayConfig(&cfg)
+			if tt.errMsg == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error %q should contain %q", err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidateRefreshCycle(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *RefreshCycleConfig
+		errMsg string
+	}{
+		{name: "nil", cfg: nil},
+		{name: "defaults", cfg: &RefreshCycleConfig{Enabled: true}},
+		{name: "white", cfg: &RefreshCycleConfig{Enabled: true, Mode: RefreshCycleWhite, Interval: 1800, DurationMs: 250}},
+		{name: "black", cfg: &RefreshCycleConfig{Enabled: true, Mode: RefreshCycleBlack}},
+		{name: "invalid mode", cfg: &RefreshCycleConfig{Mode: "strobe"}, errMsg: "refresh_cycle.mode"},
+		{name: "negative interval", cfg: &RefreshCycleConfig{Interval: -1}, errMsg: "refresh_cycle.interval"},
+		{name: "negative duration", cfg: &RefreshCycleConfig{DurationMs: -100}, errMsg: "refresh_cycle.duration_ms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{
+				Display:       DisplayConfig{Width: 128, Height: 40, RefreshCycle: tt.cfg},
+				RefreshRateMs: 100,
+			}
+			err := validateDisplayConfig(&cfg)
+			if tt.errMsg == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error %q should contain %q", err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
+
 func TestValidateWidgetType(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -515,6 +884,259 @@ func TestValidateWidgetProperties(t *testing.T) {
 			widget:  WidgetConfig{Type: "disk", ID: "disk_0", Disk: &disk},
 			wantErr: false,
 		},
+		{
+			name:    "disk - system scope",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", Scope: DiskScopeSystem},
+			wantErr: false,
+		},
+		{
+			name:    "disk - process scope with matcher",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", Scope: DiskScopeProcess, Process: "chrome"},
+			wantErr: false,
+		},
+		{
+			name:    "disk - process scope without matcher",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", Scope: DiskScopeProcess},
+			wantErr: true,
+			errMsg:  "process is required",
+		},
+		{
+			name:    "disk - invalid scope",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", Scope: "partition"},
+			wantErr: true,
+			errMsg:  "invalid scope",
+		},
+		{
+			name:    "clock - roman hour markers",
+			widget:  WidgetConfig{Type: "clock", ID: "clock_0", Analog: &AnalogConfig{HourMarkers: HourMarkersRoman}},
+			wantErr: false,
+		},
+		{
+			name:    "clock - invalid hour markers",
+			widget:  WidgetConfig{Type: "clock", ID: "clock_0", Analog: &AnalogConfig{HourMarkers: "dots"}},
+			wantErr: true,
+			errMsg:  "invalid analog.hour_markers",
+		},
+		{
+			name:    "moon_phase - southern hemisphere",
+			widget:  WidgetConfig{Type: "moon_phase", ID: "moon_phase_0", MoonPhase: &MoonPhaseConfig{Hemisphere: HemisphereSouthern}},
+			wantErr: false,
+		},
+		{
+			name:    "moon_phase - invalid hemisphere",
+			widget:  WidgetConfig{Type: "moon_phase", ID: "moon_phase_0", MoonPhase: &MoonPhaseConfig{Hemisphere: "eastern"}},
+			wantErr: true,
+			errMsg:  "invalid moon_phase.hemisphere",
+		},
+		{
+			name:    "moon_phase - negative radius",
+			widget:  WidgetConfig{Type: "moon_phase", ID: "moon_phase_0", MoonPhase: &MoonPhaseConfig{Radius: -1}},
+			wantErr: true,
+			errMsg:  "moon_phase.radius must be positive",
+		},
+		{
+			name:    "winamp - lines",
+			widget:  WidgetConfig{Type: "winamp", ID: "winamp_0", Winamp: &WinampConfig{Lines: []WinampLineConfig{{Format: "{title}"}, {Format: "{position}"}}}},
+			wantErr: false,
+		},
+		{
+			name:    "winamp - invalid source",
+			widget:  WidgetConfig{Type: "winamp", ID: "winamp_0", Winamp: &WinampConfig{Source: "rhythmbox"}},
+			wantErr: true,
+			errMsg:  "invalid winamp.source",
+		},
+		{
+			name:    "winamp - line without format",
+			widget:  WidgetConfig{Type: "winamp", ID: "winamp_0", Winamp: &WinampConfig{Lines: []WinampLineConfig{{Format: "{title}"}, {}}}},
+			wantErr: true,
+			errMsg:  "winamp.lines[1].format is required",
+		},
+		{
+			name:    "telegram_counter - dot format",
+			widget:  WidgetConfig{Type: "telegram_counter", ID: "telegram_counter_0", Counter: &TelegramCounterConfig{Max: 99, Format: CounterFormatDot}},
+			wantErr: false,
+		},
+		{
+			name:    "telegram_counter - invalid format",
+			widget:  WidgetConfig{Type: "telegram_counter", ID: "telegram_counter_0", Counter: &TelegramCounterConfig{Format: "number"}},
+			wantErr: true,
+			errMsg:  "invalid counter.format",
+		},
+		{
+			name:    "telegram_counter - negative max",
+			widget:  WidgetConfig{Type: "telegram_counter", ID: "telegram_counter_0", Counter: &TelegramCounterConfig{Max: -1}},
+			wantErr: true,
+			errMsg:  "counter.max must be positive",
+		},
+		{
+			name:    "transitions - ease in out",
+			widget:  WidgetConfig{Type: "clock", ID: "clock_0", Transitions: &TransitionConfig{In: "slide_down", Easing: EasingEaseInOut}},
+			wantErr: false,
+		},
+		{
+			name:    "transitions - invalid easing",
+			widget:  WidgetConfig{Type: "clock", ID: "clock_0", Transitions: &TransitionConfig{In: "slide_down", Easing: "elastic"}},
+			wantErr: true,
+			errMsg:  "invalid transitions.easing",
+		},
+		{
+			name:    "telegram - invalid easing",
+			widget:  WidgetConfig{Type: "telegram", ID: "telegram_0", Appearance: &TelegramAppearanceConfig{Transitions: &TransitionConfig{Easing: "Bounce"}}},
+			wantErr: true,
+			errMsg:  "invalid appearance.transitions.easing",
+		},
+		{
+			name:    "weather - invalid cycle easing",
+			widget:  WidgetConfig{Type: "weather", ID: "weather_0", Weather: &WeatherConfig{Cycle: &WeatherCycleConfig{Easing: "ease"}}},
+			wantErr: true,
+			errMsg:  "invalid weather.cycle.easing",
+		},
+		{
+			name:    "network - negative auto-scale window",
+			widget:  WidgetConfig{Type: "network", ID: "network_0", AutoScale: true, AutoScaleWindow: -5},
+			wantErr: true,
+			errMsg:  "auto_scale_window must be positive",
+		},
+		{
+			name:    "disk - percent text mode",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", TextMode: DiskTextModePercent, MaxSpeedMbps: 500},
+			wantErr: false,
+		},
+		{
+			name:    "disk - invalid text mode",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", TextMode: "ratio"},
+			wantErr: true,
+			errMsg:  "invalid text_mode",
+		},
+		{
+			name:    "disk - capacity metric",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", Metric: DiskMetricCapacity, Mode: "gauge"},
+			wantErr: false,
+		},
+		{
+			name:    "disk - invalid metric",
+			widget:  WidgetConfig{Type: "disk", ID: "disk_0", Metric: "iops"},
+			wantErr: true,
+			errMsg:  "invalid metric",
+		},
+		{
+			name:    "http_json - missing url",
+			widget:  WidgetConfig{Type: "http_json", ID: "http_json_0", HTTPJSON: &HTTPJSONConfig{}},
+			wantErr: true,
+			errMsg:  "http_json.url is required",
+		},
+		{
+			name:    "http_json - conflicting intervals",
+			widget:  WidgetConfig{Type: "http_json", ID: "http_json_0", UpdateInterval: 5, HTTPJSON: &HTTPJSONConfig{URL: "http://localhost", Interval: 60}},
+			wantErr: true,
+			errMsg:  "not both",
+		},
+		{
+			name:    "http_json - empty token path",
+			widget:  WidgetConfig{Type: "http_json", ID: "http_json_0", HTTPJSON: &HTTPJSONConfig{URL: "http://localhost", Tokens: map[string]string{"temp": ""}}},
+			wantErr: true,
+			errMsg:  "http_json.tokens.temp",
+		},
+		{
+			name:    "http_json - valid",
+			widget:  WidgetConfig{Type: "http_json", ID: "http_json_0", HTTPJSON: &HTTPJSONConfig{URL: "http://localhost", Tokens: map[string]string{"temp": "$.state"}}},
+			wantErr: false,
+		},
+		{
+			name:    "audio_visualizer - microphone capture",
+			widget:  WidgetConfig{Type: "audio_visualizer", ID: "audio_visualizer_0", CaptureMode: AudioCaptureModeMicrophone},
+			wantErr: false,
+		},
+		{
+			name:    "text orientation - supported widget",
+			widget:  WidgetConfig{Type: "cpu", ID: "cpu_0", Text: &TextConfig{Orientation: OrientationVerticalStacked}},
+			wantErr: false,
+		},
+		{
+			name:    "text orientation - invalid value",
+			widget:  WidgetConfig{Type: "clock", ID: "clock_0", Text: &TextConfig{Orientation: "diagonal"}},
+			wantErr: true,
+			errMsg:  "invalid text.orientation",
+		},
+		{
+			name:    "text orientation - unsupported widget",
+			widget:  WidgetConfig{Type: "weather", ID: "weather_0", Text: &TextConfig{Orientation: OrientationRotateCW}},
+			wantErr: true,
+			errMsg:  "text.orientation is not supported",
+		},
+		{
+			name:    "audio_visualizer - invalid capture mode",
+			widget:  WidgetConfig{Type: "audio_visualizer", ID: "audio_visualizer_0", CaptureMode: "line_in"},
+			wantErr: true,
+			errMsg:  "invalid capture_mode",
+		},
+		{
+			name:    "process - missing matcher",
+			widget:  WidgetConfig{Type: "process", ID: "process_0", ProcessMonitor: &ProcessConfig{Metric: ProcessMetricCPU}},
+			wantErr: true,
+			errMsg:  "process_monitor.name or process_monitor.pid is required",
+		},
+		{
+			name:    "process - invalid metric",
+			widget:  WidgetConfig{Type: "process", ID: "process_0", ProcessMonitor: &ProcessConfig{Name: "game", Metric: "disk"}},
+			wantErr: true,
+			errMsg:  "invalid process_monitor.metric",
+		},
+		{
+			name:    "process - pid only",
+			widget:  WidgetConfig{Type: "process", ID: "process_0", ProcessMonitor: &ProcessConfig{PID: 1234, Metric: ProcessMetricMemory}},
+			wantErr: false,
+		},
+		{
+			name:    "bar blink - supported widget",
+			widget:  WidgetConfig{Type: "cpu", ID: "cpu_0", Bar: &BarConfig{BlinkThreshold: Float64Ptr(90), BlinkRate: 2}},
+			wantErr: false,
+		},
+		{
+			name:    "bar blink - negative rate",
+			widget:  WidgetConfig{Type: "memory", ID: "memory_0", Bar: &BarConfig{BlinkThreshold: Float64Ptr(90), BlinkRate: -1}},
+			wantErr: true,
+			errMsg:  "bar.blink_rate must be positive",
+		},
+		{
+			name:    "bar blink - unsupported widget",
+			widget:  WidgetConfig{Type: "volume", ID: "volume_0", Bar: &BarConfig{BlinkThreshold: Float64Ptr(0)}},
+			wantErr: true,
+			errMsg:  "bar.blink_threshold is not supported",
+		},
+		{
+			name:    "bar blink - disabled on unsupported widget",
+			widget:  WidgetConfig{Type: "volume", ID: "volume_0", Bar: &BarConfig{BlinkThreshold: Float64Ptr(-1)}},
+			wantErr: false,
+		},
+		{
+			name:    "gradient - bar on supported widget",
+			widget:  WidgetConfig{Type: "cpu", ID: "cpu_0", Bar: &BarConfig{Colors: &ModeColorsConfig{Gradient: []int{64, 128, 255}}}},
+			wantErr: false,
+		},
+		{
+			name:    "gradient - gauge stop out of range",
+			widget:  WidgetConfig{Type: "cpu", ID: "cpu_0", Gauge: &GaugeConfig{Colors: &ModeColorsConfig{Gradient: []int{64, 300}}}},
+			wantErr: true,
+			errMsg:  "gauge.colors.gradient[1] must be 0-255",
+		},
+		{
+			name:    "gradient - unsupported widget",
+			widget:  WidgetConfig{Type: "volume", ID: "volume_0", Bar: &BarConfig{Colors: &ModeColorsConfig{Gradient: []int{0, 255}}}},
+			wantErr: true,
+			errMsg:  "color gradients are not supported",
+		},
+		{
+			name:    "cpu_temp - custom scale",
+			widget:  WidgetConfig{Type: "cpu_temp", ID: "cpu_temp_0", MaxTempC: 90},
+			wantErr: false,
+		},
+		{
+			name:    "cpu_temp - negative scale",
+			widget:  WidgetConfig{Type: "cpu_temp", ID: "cpu_temp_0", MaxTempC: -10},
+			wantErr: true,
+			errMsg:  "max_temp_c must be positive",
+		},
 	}
 
 	for _, tt := range tests {
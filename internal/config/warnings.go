@@ -0,0 +1,165 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Warning kinds
+const (
+	WarningOffCanvas = "off_canvas" // Widget extends past the display bounds
+	WarningOverlap   = "overlap"    // Widget is drawn over another widget
+)
+
+// Warning describes a non-fatal configuration problem of a widget.
+// Unlike validation errors, warnings do not prevent the config from loading.
+type Warning struct {
+	Kind    string `json:"kind"`
+	Device  string `json:"device,omitempty"` // Device ID, set for multi-device configs
+	Widget  int    `json:"widget"`           // Widget index within its widget list
+	Type    string `json:"type"`             // Widget type
+	Message string `json:"message"`
+
+	Overlap *OverlapInfo `json:"overlap,omitempty"` // Set for overlap warnings
+}
+
+// OverlapInfo describes two overlapping widgets. The warning's widget is drawn on top.
+type OverlapInfo struct {
+	Z         int            `json:"z"`          // Z-order of the widget on top
+	Below     int            `json:"below"`      // Index of the widget beneath
+	BelowType string         `json:"below_type"` // Type of the widget beneath
+	BelowZ    int            `json:"below_z"`    // Z-order of the widget beneath
+	Area      PositionConfig `json:"area"`       // Overlapping area within the display (z unused)
+}
+
+// Warnings returns non-fatal problems of the widget layout: enabled widgets placed
+// partly or fully outside the display, and pairs of enabled widgets that overlap
+// on the display, reported for the widget drawn on top. It expects a config that
+// passed Validate.
+func Warnings(cfg *Config) []Warning {
+	var warnings []Warning
+	if len(cfg.Devices) == 0 {
+		warnings = appendOffCanvasWarnings(warnings, "", "", cfg.Display, cfg.Widgets)
+		return appendOverlapWarnings(warnings, "", "", cfg.Display, cfg.Widgets)
+	}
+	for i, dev := range cfg.Devices {
+		id := dev.ID
+		if id == "" {
+			id = fmt.Sprintf("%d", i)
+		}
+		prefix := fmt.Sprintf("devices[%d]: ", i)
+		warnings = appendOffCanvasWarnings(warnings, id, prefix, dev.Display, dev.Widgets)
+		warnings = appendOverlapWarnings(warnings, id, prefix, dev.Display, dev.Widgets)
+	}
+	return warnings
+}
+
+// appendOffCanvasWarnings adds a warning for each enabled widget extending past the display
+func appendOffCanvasWarnings(warnings []Warning, device, prefix string, display DisplayConfig, widgets []WidgetConfig) []Warning {
+	if display.Width <= 0 || display.Height <= 0 {
+		return warnings
+	}
+	for i := range widgets {
+		w := &widgets[i]
+		if !w.IsEnabled() {
+			continue
+		}
+		msg := offCanvasMessage(w.Position, display)
+		if msg == "" {
+			continue
+		}
+		warnings = append(warnings, Warning{
+			Kind:    WarningOffCanvas,
+			Device:  device,
+			Widget:  i,
+			Type:    w.Type,
+			Message: fmt.Sprintf("%swidget[%d] (%s): %s", prefix, i, w.Type, msg),
+		})
+	}
+	return warnings
+}
+
+// offCanvasMessage describes how a widget rectangle exceeds the display, or returns "" if it fits
+func offCanvasMessage(p PositionConfig, display DisplayConfig) string {
+	size := fmt.Sprintf("%dx%d", display.Width, display.Height)
+	if p.X >= display.Width || p.Y >= display.Height || p.X+p.W <= 0 || p.Y+p.H <= 0 {
+		return fmt.Sprintf("fully outside the %s display", size)
+	}
+
+	var edges []string
+	if p.X < 0 {
+		edges = append(edges, fmt.Sprintf("x = %d < 0", p.X))
+	}
+	if p.Y < 0 {
+		edges = append(edges, fmt.Sprintf("y = %d < 0", p.Y))
+	}
+	if p.X+p.W > display.Width {
+		edges = append(edges, fmt.Sprintf("x+w = %d > width %d", p.X+p.W, display.Width))
+	}
+	if p.Y+p.H > display.Height {
+		edges = append(edges, fmt.Sprintf("y+h = %d > height %d", p.Y+p.H, display.Height))
+	}
+	if len(edges) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("partly outside the %s display (%s)", size, strings.Join(edges, ", "))
+}
+
+// appendOverlapWarnings adds a warning for each pair of enabled widgets overlapping on the display.
+// Widgets are composited by ascending z; with equal z, the later widget in the list is on top.
+func appendOverlapWarnings(warnings []Warning, device, prefix string, display DisplayConfig, widgets []WidgetConfig) []Warning {
+	if display.Width <= 0 || display.Height <= 0 {
+		return warnings
+	}
+	for i := range widgets {
+		for j := i + 1; j < len(widgets); j++ {
+			below, top := &widgets[i], &widgets[j]
+			belowIdx, topIdx := i, j
+			if below.Position.Z > top.Position.Z {
+				below, top = top, below
+				belowIdx, topIdx = j, i
+			}
+			if !below.IsEnabled() || !top.IsEnabled() {
+				continue
+			}
+			area, ok := overlapArea(below.Position, top.Position, display)
+			if !ok {
+				continue
+			}
+
+			verb := "covers"
+			if top.Style != nil && top.Style.OnOverlap != nil && top.Style.OnOverlap.Mode == OverlapDim {
+				verb = "dims"
+			}
+			warnings = append(warnings, Warning{
+				Kind:   WarningOverlap,
+				Device: device,
+				Widget: topIdx,
+				Type:   top.Type,
+				Message: fmt.Sprintf("%swidget[%d] (%s, z=%d) %s widget[%d] (%s, z=%d) in %dx%d at (%d,%d)",
+					prefix, topIdx, top.Type, top.Position.Z, verb, belowIdx, below.Type, below.Position.Z,
+					area.W, area.H, area.X, area.Y),
+				Overlap: &OverlapInfo{
+					Z:         top.Position.Z,
+					Below:     belowIdx,
+					BelowType: below.Type,
+					BelowZ:    below.Position.Z,
+					Area:      area,
+				},
+			})
+		}
+	}
+	return warnings
+}
+
+// overlapArea returns the intersection of two widget rectangles within the display
+func overlapArea(a, b PositionConfig, display DisplayConfig) (PositionConfig, bool) {
+	x0 := max(a.X, b.X, 0)
+	y0 := max(a.Y, b.Y, 0)
+	x1 := min(a.X+a.W, b.X+b.W, display.Width)
+	y1 := min(a.Y+a.H, b.Y+b.H, display.Height)
+	if x1 <= x0 || y1 <= y0 {
+		return PositionConfig{}, false
+	}
+	return PositionConfig{X: x0, Y: y0, W: x1 - x0, H: y1 - y0}, true
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+// warningsOfKind returns the warnings of the given kind
+func warningsOfKind(warnings []Warning, kind string) []Warning {
+	var result []Warning
+	for _, w := range warnings {
+		if w.Kind == kind {
+			result = append(result, w)
+		}
+	}
+	return result
+}
+
+func TestWarnings_OffCanvas(t *testing.T) {
+	disabled := false
+	display := DisplayConfig{Width: 128, Height: 40}
+
+	tests := []struct {
+		name    string
+		pos     PositionConfig
+		enabled *bool
+		wantMsg string // Empty = no warning
+	}{
+		{"inside", PositionConfig{X: 0, Y: 0, W: 128, H: 40}, nil, ""},
+		{"right edge", PositionConfig{X: 100, Y: 0, W: 40, H: 20}, nil, "partly outside the 128x40 display (x+w = 140 > width 128)"},
+		{"bottom edge", PositionConfig{X: 0, Y: 30, W: 20, H: 20}, nil, "y+h = 50 > height 40"},
+		{"negative origin", PositionConfig{X: -5, Y: -2, W: 20, H: 20}, nil, "x = -5 < 0, y = -2 < 0"},
+		{"fully right", PositionConfig{X: 128, Y: 0, W: 20, H: 20}, nil, "fully outside the 128x40 display"},
+		{"fully above", PositionConfig{X: 0, Y: -20, W: 20, H: 20}, nil, "fully outside"},
+		{"disabled", PositionConfig{X: 200, Y: 0, W: 20, H: 20}, &disabled, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Display: display,
+				Widgets: []WidgetConfig{
+					{Type: "clock", Position: PositionConfig{W: 10, H: 10}},
+					{Type: "matrix", Position: tt.pos, Enabled: tt.enabled},
+				},
+			}
+
+			warnings := warningsOfKind(Warnings(cfg), WarningOffCanvas)
+			if tt.wantMsg == "" {
+				if len(warnings) != 0 {
+					t.Errorf("Warnings() = %+v, want none", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 {
+				t.Fatalf("Warnings() = %+v, want one warning", warnings)
+			}
+			w := warnings[0]
+			if w.Kind != WarningOffCanvas || w.Widget != 1 || w.Type != "matrix" || w.Device != "" {
+				t.Errorf("warning = %+v, want off_canvas for widget 1 (matrix)", w)
+			}
+			if !strings.HasPrefix(w.Message, "widget[1] (matrix): ") || !strings.Contains(w.Message, tt.wantMsg) {
+				t.Errorf("message = %q, want it to contain %q", w.Message, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestWarnings_Devices(t *testing.T) {
+	cfg := &Config{
+		Devices: []DeviceConfig{
+			{ID: "keyboard", Display: DisplayConfig{Width: 128, Height: 40}, Widgets: []WidgetConfig{
+				{Type: "clock", Position: PositionConfig{W: 128, H: 40}},
+			}},
+			{ID: "gamedac", Display: DisplayConfig{Width: 128, Height: 52}, Widgets: []WidgetConfig{
+				{Type: "clock", Position: PositionConfig{W: 128, H: 52}},
+				{Type: "cpu", Position: PositionConfig{Y: 40, W: 128, H: 20}},
+			}},
+		},
+	}
+
+	warnings := warningsOfKind(Warnings(cfg), WarningOffCanvas)
+	if len(warnings) != 1 {
+		t.Fatalf("Warnings() = %+v, want one warning", warnings)
+	}
+	w := warnings[0]
+	if w.Device != "gamedac" || w.Widget != 1 || w.Type != "cpu" {
+		t.Errorf("warning = %+v, want gamedac widget 1 (cpu)", w)
+	}
+	if !strings.HasPrefix(w.Message, "devices[1]: widget[1] (cpu): ") {
+		t.Errorf("message = %q, want devices[1] prefix", w.Message)
+	}
+}
+
+func TestWarnings_Overlap(t *testing.T) {
+	disabled := false
+	cfg := &Config{
+		Display: DisplayConfig{Width: 128, Height: 40},
+		Widgets: []WidgetConfig{
+			{Type: "clock", Position: PositionConfig{X: 0, Y: 0, W: 64, H: 20, Z: 2}},
+			{Type: "matrix", Position: PositionConfig{X: 0, Y: 0, W: 128, H: 40}},
+			{Type: "cpu", Position: PositionConfig{X: 100, Y: 30, W: 40, H: 20, Z: 2},
+				Style: &StyleConfig{OnOverlap: &OverlapConfig{Mode: OverlapDim}}},
+			{Type: "memory", Position: PositionConfig{X: 64, Y: 0, W: 64, H: 20, Z: 2}}, // Touches clock edge only
+			{Type: "gpu", Position: PositionConfig{X: 0, Y: 0, W: 128, H: 40}, Enabled: &disabled},
+		},
+	}
+
+	warnings := warningsOfKind(Warnings(cfg), WarningOverlap)
+	if len(warnings) != 3 {
+		t.Fatalf("Warnings() = %+v, want 3 overlaps", warnings)
+	}
+
+	want := []struct {
+		top, below int
+		area       PositionConfig
+		msg        string
+	}{
+		{0, 1, PositionConfig{X: 0, Y: 0, W: 64, H: 20}, "widget[0] (clock, z=2) covers widget[1] (matrix, z=0) in 64x20 at (0,0)"},
+		{2, 1, PositionConfig{X: 100, Y: 30, W: 28, H: 10}, "widget[2] (cpu, z=2) dims widget[1] (matrix, z=0) in 28x10 at (100,30)"},
+		{3, 1, PositionConfig{X: 64, Y: 0, W: 64, H: 20}, "widget[3] (memory, z=2) covers widget[1] (matrix, z=0)"},
+	}
+	for i, w := range want {
+		got := warnings[i]
+		if got.Widget != w.top || got.Overlap == nil || got.Overlap.Below != w.below || got.Overlap.Area != w.area {
+			t.Errorf("warning %d = %+v (overlap %+v), want widget %d over %d in %+v", i, got, got.Overlap, w.top, w.below, w.area)
+			continue
+		}
+		if !strings.HasPrefix(got.Message, w.msg) {
+			t.Errorf("warning %d message = %q, want prefix %q", i, got.Message, w.msg)
+		}
+	}
+}
+
+func TestWarnings_OverlapSameZ(t *testing.T) {
+	cfg := &Config{
+		Display: DisplayConfig{Width: 128, Height: 40},
+		Widgets: []WidgetConfig{
+			{Type: "clock", Position: PositionConfig{X: 0, Y: 0, W: 64, H: 40}},
+			{Type: "cpu", Position: PositionConfig{X: 32, Y: 0, W: 64, H: 40}},
+		},
+	}
+
+	warnings := warningsOfKind(Warnings(cfg), WarningOverlap)
+	if len(warnings) != 1 {
+		t.Fatalf("Warnings() = %+v, want one overlap", warnings)
+	}
+	// With equal z the later widget is drawn on top
+	if w := warnings[0]; w.Widget != 1 || w.Overlap.Below != 0 || w.Overlap.Z != 0 || w.Overlap.BelowZ != 0 {
+		t.Errorf("warning = %+v (overlap %+v), want widget 1 over widget 0", w, w.Overlap)
+	}
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// WatchPollInterval is how often the watched file is checked for changes
+	WatchPollInterval = 250 * time.Millisecond
+	// WatchDebounce is how long the file must stay unchanged before a change is reported,
+	// so an editor writing a file in several steps triggers a single reload
+	WatchDebounce = 500 * time.Millisecond
+)
+
+// fileStamp identifies a version of a file on disk
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+	exists  bool
+}
+
+func statFile(path string) fileStamp {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size(), exists: true}
+}
+
+// FileWatcher reports external changes of a configuration file.
+// It polls the modification time and size of the file returned by path, so it
+// follows the active profile when it is switched. A switch to another file only
+// resets the baseline and is not reported as a change.
+type FileWatcher struct {
+	path     func() string
+	onChange func(path string)
+	debounce time.Duration
+
+	mu         sync.Mutex
+	lastPath   string
+	last       fileStamp
+	pending    bool      // A change was seen and waits for the debounce to pass
+	lastChange time.Time // When the file was last seen changing
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewFileWatcher creates a watcher calling onChange with the file path once the file
+// returned by path has changed and then stayed unchanged for the debounce period
+func NewFileWatcher(path func() string, debounce time.Duration, onChange func(path string)) *FileWatcher {
+	w := &FileWatcher{
+		path:     path,
+		onChange: onChange,
+		debounce: debounce,
+		stopCh:   make(chan struct{}),
+	}
+	w.Sync()
+	return w
+}
+
+// Start begins polling the file every interval until Stop is called
+func (w *FileWatcher) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case now := <-ticker.C:
+				w.check(now)
+			}
+		}
+	}()
+}
+
+// Stop ends polling. It is safe to call more than once.
+func (w *FileWatcher) Stop() {
+	w.stopOnce.Do(func() { close(w.stopCh) })
+}
+
+// Sync takes the current file state as the baseline and drops any pending change.
+// Call it after the application writes the file itself.
+func (w *FileWatcher) Sync() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lastPath = w.path()
+	w.last = statFile(w.lastPath)
+	w.pending = false
+}
+
+// check compares the file with the last seen state and reports a change once the
+// file has been stable for the debounce period
+func (w *FileWatcher) check(now time.Time) {
+	w.mu.Lock()
+
+	path := w.path()
+	current := statFile(path)
+
+	switch {
+	case path != w.lastPath:
+		w.lastPath = path
+		w.last = current
+		w.pending = false
+	case current != w.last:
+		w.last = current
+		w.pending = true
+		w.lastChange = now
+	}
+
+	// A deleted file is reported once it is written again
+	fire := w.pending && current.exists && now.Sub(w.lastChange) >= w.debounce
+	if fire {
+		w.pending = false
+	}
+	w.mu.Unlock()
+
+	if fire && path != "" {
+		w.onChange(path)
+	}
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeWithModTime writes content and sets a distinct modification time, so changes
+// are detected regardless of the file system timestamp resolution
+func writeWithModTime(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Chtimes() error = %v", err)
+	}
+}
+
+func TestFileWatcher_Debounce(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	base := time.Unix(1000, 0)
+	writeWithModTime(t, path, "{}", base)
+
+	var changes []string
+	w := NewFileWatcher(func() string { return path }, 500*time.Millisecond, func(p string) {
+		changes = append(changes, p)
+	})
+
+	now := time.Unix(2000, 0)
+	w.check(now)
+	if len(changes) != 0 {
+		t.Fatalf("unchanged file reported %d changes", len(changes))
+	}
+
+	// Two quick writes, as an editor save may do
+	writeWithModTime(t, path, `{"a":1}`, base.Add(time.Second))
+	w.check(now.Add(100 * time.Millisecond))
+	writeWithModTime(t, path, `{"a":12}`, base.Add(2*time.Second))
+	w.check(now.Add(300 * time.Millisecond))
+	w.check(now.Add(700 * time.Millisecond))
+	if len(changes) != 0 {
+		t.Fatalf("change reported within the debounce period")
+	}
+
+	w.check(now.Add(800 * time.Millisecond))
+	w.check(now.Add(2 * time.Second))
+	if len(changes) != 1 || changes[0] != path {
+		t.Fatalf("changes = %v, want a single change of %s", changes, path)
+	}
+}
+
+func TestFileWatcher_SyncIgnoresOwnWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	writeWithModTime(t, path, "{}", time.Unix(1000, 0))
+
+	changes := 0
+	w := NewFileWatcher(func() string { return path }, 0, func(string) { changes++ })
+
+	writeWithModTime(t, path, `{"a":1}`, time.Unix(1001, 0))
+	w.Sync()
+	w.check(time.Unix(2000, 0))
+
+	if changes != 0 {
+		t.Errorf("synced write reported %d changes, want 0", changes)
+	}
+}
+
+func TestFileWatcher_PathSwitch(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.json")
+	second := filepath.Join(dir, "second.json")
+	writeWithModTime(t, first, "{}", time.Unix(1000, 0))
+	writeWithModTime(t, second, `{"b":2}`, time.Unix(1500, 0))
+
+	active := first
+	changes := 0
+	w := NewFileWatcher(func() string { return active }, 0, func(string) { changes++ })
+
+	// Switching the active file is not a change of the file
+	active = second
+	w.check(time.Unix(2000, 0))
+	if changes != 0 {
+		t.Fatalf("profile switch reported %d changes, want 0", changes)
+	}
+
+	writeWithModTime(t, second, `{"b":3}`, time.Unix(1600, 0))
+	w.check(time.Unix(2001, 0))
+	if changes != 1 {
+		t.Errorf("edit of the new file reported %d changes, want 1", changes)
+	}
+}
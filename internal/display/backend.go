@@ -53,3 +53,11 @@ type BrightnessControl interface {
 type UIControl interface {
 	ReturnToUI() error
 }
+
+// FrameRateLimit is an optional interface for backends that can't usefully accept
+// frames faster than a fixed rate. The compositor paces pushes to this limit
+// unless max_push_fps is configured explicitly. Only the webclient backend reports
+// a limit; GameSense and direct HID have no fixed rate, so only max_push_fps paces them.
+type FrameRateLimit interface {
+	MaxPushFPS() int // 0 = no limit
+}
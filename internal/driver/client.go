@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/display"
 )
@@ -14,6 +16,12 @@ var (
 	ErrResolutionNotFound = errors.New("resolution not found in data")
 )
 
+// Reconnection pacing while the device is gone
+const (
+	reconnectInterval    = 2 * time.Second  // Minimum time between reconnection attempts
+	reconnectLogInterval = 30 * time.Second // Minimum time between "still waiting" log lines
+)
+
 // Client wraps HIDDriver and implements display.Backend interface
 // This allows the direct driver to be used interchangeably with the GameSense client
 type Client struct {
@@ -21,6 +29,12 @@ type Client struct {
 	width            int
 	height           int
 	disconnectLogged bool // prevents log spam on disconnect
+
+	reconnectMu       sync.Mutex // Guards reconnection state (frames and heartbeats run on different goroutines)
+	lastReconnect     time.Time  // Time of the last reconnection attempt
+	lastReconnectLog  time.Time  // Time of the last logged failed attempt
+	lastReconnectErr  error      // Result of the last failed attempt
+	reconnectAttempts int        // Failed attempts since the device was lost
 }
 
 // Ensure Client implements display.Backend
@@ -73,7 +87,9 @@ func (c *Client) SendScreenData(_ string, bitmapData []byte) error {
 			log.Printf("Direct driver: device disconnected, skipping frames until reconnected")
 			c.disconnectLogged = true
 		}
-		return ErrDeviceNotConnected
+		if err := c.tryReconnect(time.Now()); err != nil {
+			return ErrDeviceNotConnected
+		}
 	}
 
 	if err := c.driver.SendFrame(bitmapData); err != nil {
@@ -88,17 +104,48 @@ func (c *Client) SendScreenData(_ string, bitmapData []byte) error {
 	return nil
 }
 
-// SendHeartbeat checks connection and attempts to reconnect if needed
+// SendHeartbeat checks connection and attempts to reconnect if needed.
+// An unplugged device is not reported as a failure: it is expected to come
+// back (e.g. after re-docking) and frames resume once it does. A device that
+// is present but cannot be opened is reported so the backend can fail over.
 func (c *Client) SendHeartbeat() error {
-	if !c.driver.IsConnected() {
-		log.Printf("Direct driver: attempting reconnect...")
-		if err := c.driver.Reconnect(); err != nil {
-			log.Printf("Direct driver: reconnect failed: %v", err)
-			return err
+	if err := c.tryReconnect(time.Now()); err != nil && !errors.Is(err, ErrDeviceNotFound) {
+		return err
+	}
+	return nil
+}
+
+// tryReconnect reopens a lost device using the configured VID/PID/Interface.
+// Attempts are made at most once per reconnectInterval; in between, the result
+// of the last attempt is returned. Failed attempts are logged on the first try
+// and then at most once per reconnectLogInterval.
+func (c *Client) tryReconnect(now time.Time) error {
+	c.reconnectMu.Lock()
+	defer c.reconnectMu.Unlock()
+
+	if c.driver.IsConnected() {
+		return nil
+	}
+	if c.lastReconnectErr != nil && now.Sub(c.lastReconnect) < reconnectInterval {
+		return c.lastReconnectErr
+	}
+
+	c.lastReconnect = now
+	if err := c.driver.Reconnect(); err != nil {
+		c.lastReconnectErr = err
+		c.reconnectAttempts++
+		if c.reconnectAttempts == 1 || now.Sub(c.lastReconnectLog) >= reconnectLogInterval {
+			log.Printf("Direct driver: waiting for device (%d reconnect attempts): %v", c.reconnectAttempts, err)
+			c.lastReconnectLog = now
 		}
-		log.Printf("Direct driver: reconnected successfully")
-		c.disconnectLogged = false // reset flag so next disconnect gets logged
+		return err
 	}
+
+	info := c.driver.DeviceInfo()
+	log.Printf("Direct driver: reconnected to device after %d failed attempts: path=%s", c.reconnectAttempts, info.Path)
+	c.lastReconnectErr = nil
+	c.reconnectAttempts = 0
+	c.disconnectLogged = false // reset flag so next disconnect gets logged
 	return nil
 }
 
@@ -3,10 +3,15 @@
 package driver
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 )
 
+// ErrDeviceNotFound is returned by Open when no matching device is present,
+// as opposed to a device that is present but cannot be opened
+var ErrDeviceNotFound = errors.New("device not found")
+
 // DeviceInfo contains information about a connected device
 type DeviceInfo struct {
 	VID          uint16 // Vendor ID
@@ -15,6 +20,7 @@ type DeviceInfo struct {
 	ProductName  string // Product name (if available)
 	Manufacturer string // Manufacturer name (if available)
 	Interface    string // Interface identifier (e.g., "mi_01")
+	UsagePage    uint16 // HID usage page of the top-level collection (if available)
 }
 
 // Driver interface for USB HID communication with OLED displays
@@ -103,7 +109,7 @@ func (d *HIDDriver) Open() error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("device not found: %w", err)
+		return fmt.Errorf("%w: %w", ErrDeviceNotFound, err)
 	}
 
 	// Open device
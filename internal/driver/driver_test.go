@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -129,6 +130,20 @@ func TestHIDDriver_Reconnect_NoDevice(t *testing.T) {
 	}
 }
 
+func TestHIDDriver_Open_NotFound(t *testing.T) {
+	driver := NewDriver(Config{VID: 0x1038, PID: 0xFFFF})
+
+	// A missing device is distinguishable from one that cannot be opened
+	err := driver.Open()
+	if err == nil {
+		_ = driver.Close()
+		t.Skip("Open() succeeded unexpectedly with non-existent PID")
+	}
+	if !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("Open() error = %v, want ErrDeviceNotFound", err)
+	}
+}
+
 func TestHIDDriver_Reconnect_AutoDetect(t *testing.T) {
 	// Test auto-detect reconnection behavior
 	driver := NewDriver(Config{})
@@ -0,0 +1,41 @@
+package driver
+
+// descriptorUsagePage returns the usage page of the first top-level collection
+// in a HID report descriptor, or 0 if the descriptor declares none
+func descriptorUsagePage(desc []byte) uint16 {
+	for i := 0; i < len(desc); {
+		prefix := desc[i]
+
+		// Long items: 0xFE, data size, long tag, data
+		if prefix == 0xFE {
+			if i+1 >= len(desc) {
+				return 0
+			}
+			i += 3 + int(desc[i+1])
+			continue
+		}
+
+		// Short items: tag and type in the upper 6 bits, size code in the lower 2
+		size := int(prefix & 0x03)
+		if size == 3 {
+			size = 4
+		}
+		if i+1+size > len(desc) {
+			return 0
+		}
+
+		// Usage Page is global item tag 0
+		if prefix&0xFC == 0x04 {
+			var page uint16
+			if size >= 1 {
+				page = uint16(desc[i+1])
+			}
+			if size >= 2 {
+				page |= uint16(desc[i+2]) << 8
+			}
+			return page
+		}
+		i += 1 + size
+	}
+	return 0
+}
@@ -0,0 +1,27 @@
+package driver
+
+import "testing"
+
+func TestDescriptorUsagePage(t *testing.T) {
+	tests := []struct {
+		name string
+		desc []byte
+		want uint16
+	}{
+		{"vendor defined two-byte page", []byte{0x06, 0xC0, 0xFF, 0x09, 0x01, 0xA1, 0x01}, 0xFFC0},
+		{"generic desktop one-byte page", []byte{0x05, 0x01, 0x09, 0x06, 0xA1, 0x01}, 0x0001},
+		{"page after other items", []byte{0x09, 0x01, 0x15, 0x00, 0x05, 0x0C}, 0x000C},
+		{"long item skipped", []byte{0xFE, 0x02, 0x10, 0xAA, 0xBB, 0x05, 0x0C}, 0x000C},
+		{"no usage page", []byte{0x09, 0x01, 0xA1, 0x01, 0xC0}, 0},
+		{"truncated item", []byte{0x06, 0xC0}, 0},
+		{"empty", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := descriptorUsagePage(tt.desc); got != tt.want {
+				t.Errorf("descriptorUsagePage() = 0x%04X, want 0x%04X", got, tt.want)
+			}
+		})
+	}
+}
@@ -49,6 +49,7 @@ type hidrawDevice struct {
 	pid        uint16
 	hidName    string // Device name from HID_NAME
 	interface_ string // Interface identifier from path
+	usagePage  uint16 // Usage page from the report descriptor
 }
 
 // parseUevent parses the uevent file to extract VID and PID
@@ -132,6 +133,11 @@ func enumerateHidrawDevices() ([]hidrawDevice, error) {
 
 		iface := getInterfaceFromPath(sysDevPath)
 
+		var usagePage uint16
+		if desc, err := os.ReadFile(filepath.Join(sysDevPath, "device", "report_descriptor")); err == nil {
+			usagePage = descriptorUsagePage(desc)
+		}
+
 		devices = append(devices, hidrawDevice{
 			name:       entry.Name(),
 			path:       filepath.Join(devPath, entry.Name()),
@@ -139,6 +145,7 @@ func enumerateHidrawDevices() ([]hidrawDevice, error) {
 			pid:        pid,
 			hidName:    hidName,
 			interface_: iface,
+			usagePage:  usagePage,
 		})
 	}
 
@@ -267,6 +274,7 @@ func EnumerateDevices() ([]DeviceInfo, error) {
 			Path:        dev.path,
 			ProductName: dev.hidName,
 			Interface:   dev.interface_,
+			UsagePage:   dev.usagePage,
 		})
 	}
 
@@ -4,6 +4,8 @@ package driver
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"syscall"
 	"unsafe"
@@ -25,7 +27,12 @@ var (
 	procSetupDiGetDeviceInterfaceDetailW = modSetupApi.NewProc("SetupDiGetDeviceInterfaceDetailW")
 	procSetupDiDestroyDeviceInfoList     = modSetupApi.NewProc("SetupDiDestroyDeviceInfoList")
 
-	procHidDSetFeature = modHid.NewProc("HidD_SetFeature")
+	procHidDSetFeature            = modHid.NewProc("HidD_SetFeature")
+	procHidDGetProductString      = modHid.NewProc("HidD_GetProductString")
+	procHidDGetManufacturerString = modHid.NewProc("HidD_GetManufacturerString")
+	procHidDGetPreparsedData      = modHid.NewProc("HidD_GetPreparsedData")
+	procHidDFreePreparsedData     = modHid.NewProc("HidD_FreePreparsedData")
+	procHidPGetCaps               = modHid.NewProc("HidP_GetCaps")
 )
 
 // Windows constants
@@ -36,6 +43,8 @@ const (
 	fileShareRead  = 0x00000001
 	fileShareWrite = 0x00000002
 	openExisting   = 3
+
+	hidpStatusSuccess = 0x00110000
 )
 
 // GUID structure for Windows API
@@ -63,8 +72,21 @@ type spDeviceInterfaceDetailData struct {
 	DevicePath [512]uint16
 }
 
-// findDevicePath finds a HID device by VID, PID, and interface
-func findDevicePath(vid, pid uint16, targetInterface string) (string, error) {
+// hidpCaps mirrors the leading fields of the HIDP_CAPS structure
+type hidpCaps struct {
+	Usage     uint16
+	UsagePage uint16
+	_         [60]byte // Report lengths and collection counts (unused)
+}
+
+// Device path patterns, e.g. \\?\hid#vid_1038&pid_1612&mi_01#...
+var (
+	devicePathIDRegex        = regexp.MustCompile(`vid_([0-9a-f]{4})&pid_([0-9a-f]{4})`)
+	devicePathInterfaceRegex = regexp.MustCompile(`mi_([0-9a-f]{2})`)
+)
+
+// enumerateDevicePaths lists the paths of all present HID device interfaces
+func enumerateDevicePaths() ([]string, error) {
 	hDevInfo, _, _ := procSetupDiGetClassDevsW.Call(
 		uintptr(unsafe.Pointer(&hidGUID)),
 		0,
@@ -72,7 +94,7 @@ func findDevicePath(vid, pid uint16, targetInterface string) (string, error) {
 		digcfPresent|digcfDeviceInterface,
 	)
 	if hDevInfo == 0 || hDevInfo == ^uintptr(0) {
-		return "", fmt.Errorf("SetupDiGetClassDevsW failed")
+		return nil, fmt.Errorf("SetupDiGetClassDevsW failed")
 	}
 	defer func() { _, _, _ = procSetupDiDestroyDeviceInfoList.Call(hDevInfo) }()
 
@@ -84,9 +106,7 @@ func findDevicePath(vid, pid uint16, targetInterface string) (string, error) {
 		ifaceData.cbSize = 28
 	}
 
-	targetSubstr := fmt.Sprintf("vid_%04x&pid_%04x", vid, pid)
-	targetInterface = strings.ToLower(targetInterface)
-
+	var paths []string
 	for i := 0; ; i++ {
 		r, _, _ := procSetupDiEnumDeviceInterfaces.Call(
 			hDevInfo,
@@ -116,7 +136,23 @@ func findDevicePath(vid, pid uint16, targetInterface string) (string, error) {
 			0,
 		)
 
-		path := syscall.UTF16ToString(detailData.DevicePath[:])
+		paths = append(paths, syscall.UTF16ToString(detailData.DevicePath[:]))
+	}
+
+	return paths, nil
+}
+
+// findDevicePath finds a HID device by VID, PID, and interface
+func findDevicePath(vid, pid uint16, targetInterface string) (string, error) {
+	paths, err := enumerateDevicePaths()
+	if err != nil {
+		return "", err
+	}
+
+	targetSubstr := fmt.Sprintf("vid_%04x&pid_%04x", vid, pid)
+	targetInterface = strings.ToLower(targetInterface)
+
+	for _, path := range paths {
 		lPath := strings.ToLower(path)
 
 		// Check if path matches VID/PID and interface
@@ -193,3 +229,75 @@ func sendFeatureReport(handle DeviceHandle, data []byte) error {
 
 	return nil
 }
+
+// EnumerateDevices returns a list of all connected HID devices
+func EnumerateDevices() ([]DeviceInfo, error) {
+	paths, err := enumerateDevicePaths()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []DeviceInfo
+	for _, path := range paths {
+		lPath := strings.ToLower(path)
+		ids := devicePathIDRegex.FindStringSubmatch(lPath)
+		if ids == nil {
+			continue // Not a USB device (e.g. Bluetooth or virtual)
+		}
+		vid, _ := strconv.ParseUint(ids[1], 16, 16)
+		pid, _ := strconv.ParseUint(ids[2], 16, 16)
+
+		info := DeviceInfo{
+			VID:  uint16(vid),
+			PID:  uint16(pid),
+			Path: path,
+		}
+		if m := devicePathInterfaceRegex.FindStringSubmatch(lPath); m != nil {
+			info.Interface = "mi_" + m[1]
+		}
+
+		// Strings and capabilities are readable without access rights;
+		// devices that cannot be opened are listed with IDs only
+		if handle, err := openDevice(path); err == nil {
+			info.ProductName = hidString(procHidDGetProductString, handle)
+			info.Manufacturer = hidString(procHidDGetManufacturerString, handle)
+			info.UsagePage = hidUsagePage(handle)
+			_ = closeDevice(handle)
+		}
+
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+// hidString reads a device string using one of the HidD_Get*String functions
+func hidString(proc *syscall.LazyProc, handle DeviceHandle) string {
+	var buf [127]uint16 // USB string descriptors hold at most 126 characters
+	r, _, _ := proc.Call(
+		uintptr(handle),
+		uintptr(unsafe.Pointer(&buf[0])),
+		unsafe.Sizeof(buf),
+	)
+	if r == 0 {
+		return ""
+	}
+	return syscall.UTF16ToString(buf[:])
+}
+
+// hidUsagePage returns the usage page of the device's top-level collection, or 0
+func hidUsagePage(handle DeviceHandle) uint16 {
+	var preparsed uintptr
+	r, _, _ := procHidDGetPreparsedData.Call(uintptr(handle), uintptr(unsafe.Pointer(&preparsed)))
+	if r == 0 {
+		return 0
+	}
+	defer func() { _, _, _ = procHidDFreePreparsedData.Call(preparsed) }()
+
+	var caps hidpCaps
+	status, _, _ := procHidPGetCaps.Call(preparsed, uintptr(unsafe.Pointer(&caps)))
+	if status != hidpStatusSuccess {
+		return 0
+	}
+	return caps.UsagePage
+}
@@ -0,0 +1,137 @@
+package i18n
+
+import (
+	"strings"
+	"time"
+)
+
+// dateNames holds localized month and weekday names
+type dateNames struct {
+	months         [12]string // Standalone month names ("January 2006")
+	monthsGenitive [12]string // Month names used with a day number ("2 January")
+	monthsShort    [12]string
+	weekdays       [7]string // Indexed by time.Weekday (Sunday first)
+	weekdaysShort  [7]string
+}
+
+// dates holds date name tables by language code; English uses Go's built-in names
+var dates = map[string]*dateNames{
+	Russian: {
+		months: [12]string{"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь",
+			"Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
+		monthsGenitive: [12]string{"января", "февраля", "марта", "апреля", "мая", "июня",
+			"июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		monthsShort: [12]string{"янв", "фев", "мар", "апр", "май", "июн",
+			"июл", "авг", "сен", "окт", "ноя", "дек"},
+		weekdays:      [7]string{"Воскресенье", "Понедельник", "Вторник", "Среда", "Четверг", "Пятница", "Суббота"},
+		weekdaysShort: [7]string{"Вс", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"},
+	},
+	Ukrainian: {
+		months: [12]string{"Січень", "Лютий", "Березень", "Квітень", "Травень", "Червень",
+			"Липень", "Серпень", "Вересень", "Жовтень", "Листопад", "Грудень"},
+		monthsGenitive: [12]string{"січня", "лютого", "березня", "квітня", "травня", "червня",
+			"липня", "серпня", "вересня", "жовтня", "листопада", "грудня"},
+		monthsShort: [12]string{"січ", "лют", "бер", "кві", "тра", "чер",
+			"лип", "сер", "вер", "жов", "лис", "гру"},
+		weekdays:      [7]string{"Неділя", "Понеділок", "Вівторок", "Середа", "Четвер", "П'ятниця", "Субота"},
+		weekdaysShort: [7]string{"Нд", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"},
+	},
+}
+
+// activeDates returns the date name table for the active language, or nil for English
+func activeDates() *dateNames {
+	return dates[Language()]
+}
+
+// MonthName returns the full month name in the active language
+func MonthName(m time.Month) string {
+	if d := activeDates(); d != nil {
+		return d.months[m-1]
+	}
+	return m.String()
+}
+
+// MonthShort returns the abbreviated month name in the active language
+func MonthShort(m time.Month) string {
+	if d := activeDates(); d != nil {
+		return d.monthsShort[m-1]
+	}
+	return m.String()[:3]
+}
+
+// WeekdayName returns the full weekday name in the active language
+func WeekdayName(w time.Weekday) string {
+	if d := activeDates(); d != nil {
+		return d.weekdays[w]
+	}
+	return w.String()
+}
+
+// WeekdayShort returns the abbreviated weekday name in the active language
+func WeekdayShort(w time.Weekday) string {
+	if d := activeDates(); d != nil {
+		return d.weekdaysShort[w]
+	}
+	return w.String()[:3]
+}
+
+// Go layout name tokens, longest first so "January" is not matched as "Jan"
+var nameTokens = []string{"January", "Monday", "Jan", "Mon"}
+
+// FormatTime formats t like time.Format, with month and weekday names in the active
+// language. Full month names use the genitive form when the layout also shows the
+// day of the month ("2 January" becomes "2 января").
+func FormatTime(t time.Time, layout string) string {
+	d := activeDates()
+	if d == nil {
+		return t.Format(layout)
+	}
+
+	genitive := hasDayOfMonth(layout)
+
+	var sb strings.Builder
+	for len(layout) > 0 {
+		pos, token := nextNameToken(layout)
+		if pos < 0 {
+			sb.WriteString(t.Format(layout))
+			break
+		}
+		if pos > 0 {
+			sb.WriteString(t.Format(layout[:pos]))
+		}
+
+		switch token {
+		case "January":
+			if genitive {
+				sb.WriteString(d.monthsGenitive[t.Month()-1])
+			} else {
+				sb.WriteString(d.months[t.Month()-1])
+			}
+		case "Jan":
+			sb.WriteString(d.monthsShort[t.Month()-1])
+		case "Monday":
+			sb.WriteString(d.weekdays[t.Weekday()])
+		case "Mon":
+			sb.WriteString(d.weekdaysShort[t.Weekday()])
+		}
+		layout = layout[pos+len(token):]
+	}
+	return sb.String()
+}
+
+// nextNameToken finds the first month or weekday name token in a layout
+func nextNameToken(layout string) (int, string) {
+	bestPos, bestToken := -1, ""
+	for _, token := range nameTokens {
+		if pos := strings.Index(layout, token); pos >= 0 && (bestPos < 0 || pos < bestPos) {
+			bestPos, bestToken = pos, token
+		}
+	}
+	return bestPos, bestToken
+}
+
+// hasDayOfMonth reports whether a layout contains a day-of-month token ("2", "02" or "_2").
+// The year token "2006" is removed first since it also contains a "2".
+func hasDayOfMonth(layout string) bool {
+	return strings.Contains(strings.ReplaceAll(layout, "2006", ""), "2")
+}
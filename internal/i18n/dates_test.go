@@ -0,0 +1,77 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTime(t *testing.T) {
+	defer SetLanguage(English)
+
+	// Friday, 2 May 2025
+	ts := time.Date(2025, time.May, 2, 14, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		lang   string
+		layout string
+		want   string
+	}{
+		{"English passthrough", English, "Mon, Jan 2 15:04", "Fri, May 2 14:05"},
+		{"Russian weekday and genitive month", Russian, "Monday, 2 January", "Пятница, 2 мая"},
+		{"Russian standalone month", Russian, "January 2006", "Май 2025"},
+		{"Russian short names", Russian, "Mon Jan 02", "Пт май 02"},
+		{"Ukrainian genitive month", Ukrainian, "2 January 2006", "2 травня 2025"},
+		{"Ukrainian weekday", Ukrainian, "Monday 15:04", "П'ятниця 14:05"},
+		{"no names", Russian, "15:04:05", "14:05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetLanguage(tt.lang)
+			if got := FormatTime(ts, tt.layout); got != tt.want {
+				t.Errorf("FormatTime(%q) = %q, want %q", tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	defer SetLanguage(English)
+
+	SetLanguage(English)
+	if got := WeekdayShort(time.Monday); got != "Mon" {
+		t.Errorf("WeekdayShort(Monday) in English = %q", got)
+	}
+	if got := MonthName(time.March); got != "March" {
+		t.Errorf("MonthName(March) in English = %q", got)
+	}
+
+	SetLanguage(Russian)
+	if got := WeekdayShort(time.Sunday); got != "Вс" {
+		t.Errorf("WeekdayShort(Sunday) in Russian = %q", got)
+	}
+	if got := WeekdayName(time.Wednesday); got != "Среда" {
+		t.Errorf("WeekdayName(Wednesday) in Russian = %q", got)
+	}
+	if got := MonthShort(time.December); got != "дек" {
+		t.Errorf("MonthShort(December) in Russian = %q", got)
+	}
+}
+
+func TestHasDayOfMonth(t *testing.T) {
+	tests := map[string]bool{
+		"Jan 2":        true,
+		"02.01.2006":   true,
+		"_2 January":   true,
+		"January 2006": false,
+		"15:04:05":     false,
+		"Monday":       false,
+	}
+
+	for layout, want := range tests {
+		if got := hasDayOfMonth(layout); got != want {
+			t.Errorf("hasDayOfMonth(%q) = %v, want %v", layout, got, want)
+		}
+	}
+}
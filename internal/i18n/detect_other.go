@@ -0,0 +1,15 @@
+//go:build !windows
+
+package i18n
+
+import "os"
+
+// systemLanguage returns the locale from the standard environment variables (e.g. "ru_RU.UTF-8")
+func systemLanguage() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(name); v != "" && v != "C" && v != "POSIX" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,22 @@
+//go:build !windows
+
+package i18n
+
+import "testing"
+
+func TestSetLanguage_Auto(t *testing.T) {
+	defer SetLanguage(English)
+
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "ru_RU.UTF-8")
+	if got := SetLanguage(Auto); got != Russian {
+		t.Errorf("SetLanguage(auto) with LANG=ru_RU = %q, want %q", got, Russian)
+	}
+
+	t.Setenv("LC_ALL", "C")
+	t.Setenv("LANG", "")
+	if got := SetLanguage(Auto); got != English {
+		t.Errorf("SetLanguage(auto) with C locale = %q, want %q", got, English)
+	}
+}
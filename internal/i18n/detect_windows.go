@@ -0,0 +1,14 @@
+//go:build windows
+
+package i18n
+
+import "golang.org/x/sys/windows"
+
+// systemLanguage returns the user's preferred UI language (e.g. "ru-RU")
+func systemLanguage() string {
+	langs, err := windows.GetUserPreferredUILanguages(windows.MUI_LANGUAGE_NAME)
+	if err != nil || len(langs) == 0 {
+		return ""
+	}
+	return langs[0]
+}
@@ -0,0 +1,89 @@
+// Package i18n provides translations for user-facing widget text.
+//
+// Widgets call T with a message key instead of using string literals. The active
+// language is set once from the top-level "language" config setting; missing
+// translations fall back to English.
+package i18n
+
+import (
+	"strings"
+	"sync"
+)
+
+// Supported languages
+const (
+	English   = "en"
+	Russian   = "ru"
+	Ukrainian = "uk"
+)
+
+// Auto selects the operating system UI language
+const Auto = "auto"
+
+var (
+	mu       sync.RWMutex
+	language = English
+)
+
+// SetLanguage selects the active language and returns the resolved language code.
+// "auto" uses the system language; empty or unsupported values fall back to English.
+func SetLanguage(lang string) string {
+	resolved := resolve(lang)
+
+	mu.Lock()
+	language = resolved
+	mu.Unlock()
+
+	return resolved
+}
+
+// Language returns the active language code
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return language
+}
+
+// IsSupported reports whether a translation table exists for the language code
+func IsSupported(lang string) bool {
+	_, ok := messages[lang]
+	return ok
+}
+
+// T returns the message text in the active language
+func T(key Message) string {
+	return Translate(Language(), key)
+}
+
+// Translate returns the message text in the given language, falling back to English
+// and finally to the key itself
+func Translate(lang string, key Message) string {
+	if text, ok := messages[lang][key]; ok {
+		return text
+	}
+	if text, ok := messages[English][key]; ok {
+		return text
+	}
+	return string(key)
+}
+
+// resolve maps a configured language to a supported language code
+func resolve(lang string) string {
+	if lang == Auto {
+		lang = systemLanguage()
+	}
+	code := normalize(lang)
+	if IsSupported(code) {
+		return code
+	}
+	return English
+}
+
+// normalize reduces a locale such as "ru_RU.UTF-8" or "ru-RU" to its lowercase language code
+func normalize(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "_-.@"); i >= 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
@@ -0,0 +1,81 @@
+package i18n
+
+import "testing"
+
+func TestSetLanguage(t *testing.T) {
+	defer SetLanguage(English)
+
+	tests := []struct {
+		name string
+		lang string
+		want string
+	}{
+		{"empty defaults to English", "", English},
+		{"English", "en", English},
+		{"Russian", "ru", Russian},
+		{"Ukrainian", "uk", Ukrainian},
+		{"upper case", "RU", Russian},
+		{"unsupported falls back", "de", English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetLanguage(tt.lang); got != tt.want {
+				t.Errorf("SetLanguage(%q) = %q, want %q", tt.lang, got, tt.want)
+			}
+			if got := Language(); got != tt.want {
+				t.Errorf("Language() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"ru_RU.UTF-8": "ru",
+		"ru-RU":       "ru",
+		"en_US":       "en",
+		"de@euro":     "de",
+		" EN ":        "en",
+		"":            "",
+	}
+
+	for in, want := range tests {
+		if got := normalize(in); got != want {
+			t.Errorf("normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	defer SetLanguage(English)
+
+	SetLanguage(English)
+	if got := T(Disconnected); got != "Disconnected" {
+		t.Errorf("T(Disconnected) in English = %q", got)
+	}
+
+	SetLanguage(Russian)
+	if got := T(Disconnected); got != "Нет связи" {
+		t.Errorf("T(Disconnected) in Russian = %q", got)
+	}
+}
+
+func TestTranslate_Fallbacks(t *testing.T) {
+	if got := Translate("de", NoData); got != "No data" {
+		t.Errorf("unsupported language should fall back to English, got %q", got)
+	}
+	if got := Translate(Russian, Message("missing_key")); got != "missing_key" {
+		t.Errorf("unknown key should return the key, got %q", got)
+	}
+}
+
+func TestTranslationsComplete(t *testing.T) {
+	for lang, table := range messages {
+		for key := range messages[English] {
+			if table[key] == "" {
+				t.Errorf("language %q is missing translation for %q", lang, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package i18n
+
+// Message identifies a translatable user-facing string
+type Message string
+
+// Message keys
+const (
+	Connecting   Message = "connecting"
+	Disconnected Message = "disconnected"
+	NotConnected Message = "not_connected"
+	NoAudio      Message = "no_audio"
+	NoAccess     Message = "no_access"
+	NoSensors    Message = "no_sensors"
+	NoData       Message = "no_data"
+	NotRunning   Message = "not_running"
+	Now          Message = "now"
+	Loading      Message = "loading"
+	NoConnection Message = "no_connection"
+	BadURL       Message = "bad_url"
+	BadJSON      Message = "bad_json"
+	Error        Message = "error"
+
+	Playing Message = "playing"
+	Paused  Message = "paused"
+	Stopped Message = "stopped"
+
+	Charging Message = "charging"
+	Economy  Message = "economy"
+	ACPower  Message = "ac_power"
+)
+
+// messages holds translation tables by language code
+var messages = map[string]map[Message]string{
+	English: {
+		Connecting:   "Connecting...",
+		Disconnected: "Disconnected",
+		NotConnected: "Not connected",
+		NoAudio:      "NO AUDIO",
+		NoAccess:     "NO ACCESS",
+		NoSensors:    "No sensors",
+		NoData:       "No data",
+		NotRunning:   "Not running",
+		Now:          "Now",
+		Loading:      "...",
+		NoConnection: "No connection",
+		BadURL:       "Bad URL",
+		BadJSON:      "Bad JSON",
+		Error:        "Error",
+		Playing:      "Playing",
+		Paused:       "Paused",
+		Stopped:      "Stopped",
+		Charging:     "Charging",
+		Economy:      "Economy",
+		ACPower:      "AC Power",
+	},
+	Russian: {
+		Connecting:   "Подключение...",
+		Disconnected: "Нет связи",
+		NotConnected: "Не подключено",
+		NoAudio:      "НЕТ ЗВУКА",
+		NoAccess:     "НЕТ ДОСТУПА",
+		NoSensors:    "Нет датчиков",
+		NoData:       "Нет данных",
+		NotRunning:   "Не запущен",
+		Now:          "Сейчас",
+		Loading:      "...",
+		NoConnection: "Нет связи",
+		BadURL:       "Неверный URL",
+		BadJSON:      "Неверный JSON",
+		Error:        "Ошибка",
+		Playing:      "Играет",
+		Paused:       "Пауза",
+		Stopped:      "Остановлено",
+		Charging:     "Зарядка",
+		Economy:      "Экономия",
+		ACPower:      "От сети",
+	},
+	Ukrainian: {
+		Connecting:   "Підключення...",
+		Disconnected: "Немає зв'язку",
+		NotConnected: "Не підключено",
+		NoAudio:      "НЕМАЄ ЗВУКУ",
+		NoAccess:     "НЕМАЄ ДОСТУПУ",
+		NoSensors:    "Немає датчиків",
+		NoData:       "Немає даних",
+		NotRunning:   "Не запущено",
+		Now:          "Зараз",
+		Loading:      "...",
+		NoConnection: "Немає зв'язку",
+		BadURL:       "Невірний URL",
+		BadJSON:      "Невірний JSON",
+		Error:        "Помилка",
+		Playing:      "Грає",
+		Paused:       "Пауза",
+		Stopped:      "Зупинено",
+		Charging:     "Заряджання",
+		Economy:      "Економія",
+		ACPower:      "Від мережі",
+	},
+}
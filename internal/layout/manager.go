@@ -1,10 +1,13 @@
 package layout
 
 import (
+	"errors"
 	"fmt"
 	"image"
 	"image/draw"
+	"log"
 	"sort"
+	"sync"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
@@ -18,15 +21,22 @@ type Manager struct {
 	bgColor       uint8
 	widgets       []widget.Widget
 	sortedWidgets []widget.Widget // Pre-sorted by z-order (cached to avoid sorting every frame)
+	lastImages    []image.Image   // Last rendered image per sorted widget, reused while unchanged
+	panicked      []bool          // Sorted widget is currently panicking in Render (logged once until it recovers)
+	widgetsMu     sync.Mutex      // Guards the widget lists against AddWidget/RemoveWidget during Composite
+
+	transitionMu sync.Mutex
+	transitions  map[int]*widgetTransition // Active enter/exit transitions by sorted widget index
 }
 
 // NewManager creates a new layout manager
 func NewManager(display config.DisplayConfig, widgets []widget.Widget) *Manager {
 	// Pre-sort widgets by z-order once during initialization
-	// Z-order only changes on config reload, which creates a new Manager
+	// Z-order only changes on config reload (new Manager) or AddWidget/RemoveWidget
+	// Widgets with the same z are drawn in configuration order
 	sortedWidgets := make([]widget.Widget, len(widgets))
 	copy(sortedWidgets, widgets)
-	sort.Slice(sortedWidgets, func(i, j int) bool {
+	sort.SliceStable(sortedWidgets, func(i, j int) bool {
 		return sortedWidgets[i].GetPosition().Z < sortedWidgets[j].GetPosition().Z
 	})
 
@@ -36,25 +46,32 @@ func NewManager(display config.DisplayConfig, widgets []widget.Widget) *Manager
 		bgColor:       uint8(display.Background),
 		widgets:       widgets,
 		sortedWidgets: sortedWidgets,
+		lastImages:    make([]image.Image, len(sortedWidgets)),
+		panicked:      make([]bool, len(sortedWidgets)),
+		transitions:   make(map[int]*widgetTransition),
 	}
 }
 
 // Composite renders all widgets onto a single canvas
 func (m *Manager) Composite() (image.Image, error) {
+	m.widgetsMu.Lock()
+	defer m.widgetsMu.Unlock()
+
 	// Create canvas
 	canvas := bitmap.NewGrayscaleImage(m.width, m.height, m.bgColor)
 
 	// Use pre-sorted widgets (sorted once in NewManager)
 	// Render and composite each widget
-	for _, w := range m.sortedWidgets {
+	for i, w := range m.sortedWidgets {
 		// Render widget
 		// NOTE: We do NOT call Update() here because widgets have dedicated
 		// update loops running in background goroutines (see compositor.widgetUpdateLoop).
 		// Calling Update() here would create a race condition.
-		widgetImg, err := w.Render()
+		widgetImg, err := m.renderWidget(i, w)
 		if err != nil {
 			return nil, fmt.Errorf("failed to render widget %s: %w", w.Name(), err)
 		}
+		widgetImg = m.applyTransition(i, widgetImg)
 
 		// Skip if widget returned nil (hidden, e.g., auto-hide)
 		if widgetImg == nil {
@@ -68,6 +85,13 @@ func (m *Manager) Composite() (image.Image, error) {
 		// Check if widget has transparent background (background = -1)
 		transparentBg := style.Background == -1
 
+		// Dim overlap: fade whatever lies beneath, then let it show through the widget's background
+		if style.OnOverlap != nil && style.OnOverlap.Mode == config.OverlapDim {
+			dimRegion(canvas, image.Rect(pos.X, pos.Y, pos.X+pos.W, pos.Y+pos.H), overlapDimFactor(style.OnOverlap), m.bgColor)
+			compositeWithTransparency(canvas, widgetImg, pos, style.Background)
+			continue
+		}
+
 		// Composite widget onto canvas
 		if transparentBg {
 			// Transparent background: only copy non-background pixels
@@ -82,6 +106,163 @@ func (m *Manager) Composite() (image.Image, error) {
 	return canvas, nil
 }
 
+// AddWidget adds a widget to the running layout, keeping the z-order.
+// Among widgets with the same z it is drawn last.
+func (m *Manager) AddWidget(w widget.Widget) {
+	m.widgetsMu.Lock()
+	defer m.widgetsMu.Unlock()
+
+	at := len(m.sortedWidgets)
+	for i, sw := range m.sortedWidgets {
+		if sw.GetPosition().Z > w.GetPosition().Z {
+			at = i
+			break
+		}
+	}
+
+	sorted := make([]widget.Widget, 0, len(m.sortedWidgets)+1)
+	sorted = append(sorted, m.sortedWidgets[:at]...)
+	sorted = append(sorted, w)
+	sorted = append(sorted, m.sortedWidgets[at:]...)
+
+	m.widgets = append(m.widgets, w)
+	m.setSortedWidgets(sorted)
+}
+
+// Widgets returns the widgets of the running layout in configuration order
+func (m *Manager) Widgets() []widget.Widget {
+	m.widgetsMu.Lock()
+	defer m.widgetsMu.Unlock()
+
+	widgets := make([]widget.Widget, len(m.widgets))
+	copy(widgets, m.widgets)
+	return widgets
+}
+
+// RemoveWidget removes the widget with the given ID from the running layout.
+// Returns the removed widget, or nil if no such widget is displayed.
+func (m *Manager) RemoveWidget(widgetID string) widget.Widget {
+	m.widgetsMu.Lock()
+	defer m.widgetsMu.Unlock()
+
+	_, removed := m.findWidget(widgetID)
+	if removed == nil {
+		return nil
+	}
+
+	var widgets, sorted []widget.Widget
+	for _, w := range m.widgets {
+		if w != removed {
+			widgets = append(widgets, w)
+		}
+	}
+	for _, w := range m.sortedWidgets {
+		if w != removed {
+			sorted = append(sorted, w)
+		}
+	}
+
+	m.widgets = widgets
+	m.setSortedWidgets(sorted)
+	return removed
+}
+
+// setSortedWidgets replaces the sorted widget list, carrying the cached image,
+// panic state and transition of each remaining widget over to its new index.
+// Must be called with widgetsMu held.
+func (m *Manager) setSortedWidgets(sorted []widget.Widget) {
+	m.transitionMu.Lock()
+	defer m.transitionMu.Unlock()
+
+	lastImages := make([]image.Image, len(sorted))
+	panicked := make([]bool, len(sorted))
+	transitions := make(map[int]*widgetTransition)
+	for newIdx, w := range sorted {
+		for oldIdx, old := range m.sortedWidgets {
+			if old != w {
+				continue
+			}
+			lastImages[newIdx] = m.lastImages[oldIdx]
+			panicked[newIdx] = m.panicked[oldIdx]
+			if t, ok := m.transitions[oldIdx]; ok {
+				transitions[newIdx] = t
+			}
+			break
+		}
+	}
+
+	m.sortedWidgets = sorted
+	m.lastImages = lastImages
+	m.panicked = panicked
+	m.transitions = transitions
+}
+
+// renderWidget renders the widget at index i of sortedWidgets, reusing its previous
+// image when the widget reports that nothing changed. NeedsRender is asked on every
+// frame because it may clear a pending update flag.
+func (m *Manager) renderWidget(i int, w widget.Widget) (image.Image, error) {
+	if reporter, ok := w.(widget.ChangeReporter); ok && !reporter.NeedsRender() && m.lastImages[i] != nil {
+		return m.lastImages[i], nil
+	}
+
+	img, err := widget.SafeRender(w)
+	var panicErr *widget.PanicError
+	if errors.As(err, &panicErr) {
+		return m.panicPlaceholder(i, w, panicErr), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.panicked[i] {
+		log.Printf("Widget %s rendered successfully again", w.Name())
+		m.panicked[i] = false
+	}
+	m.lastImages[i] = img
+	return img, nil
+}
+
+// panicPlaceholder logs a widget render panic (once until the widget recovers)
+// and returns an error image in its place, so the rest of the display keeps working
+func (m *Manager) panicPlaceholder(i int, w widget.Widget, panicErr *widget.PanicError) image.Image {
+	if !m.panicked[i] {
+		log.Printf("%v\n%s", panicErr, panicErr.Stack)
+		m.panicked[i] = true
+	}
+	// Not cached: the widget must be asked to render again on the next frame
+	m.lastImages[i] = nil
+
+	pos := w.GetPosition()
+	placeholder, _ := widget.NewErrorWidget(pos.W, pos.H, "WIDGET ERROR").Render()
+	return placeholder
+}
+
+// overlapDimFactor returns the configured dim factor clamped to 0.0-1.0,
+// falling back to the default when unset
+func overlapDimFactor(cfg *config.OverlapConfig) float64 {
+	if cfg.Factor == nil {
+		return config.DefaultOverlapDimFactor
+	}
+	return max(0, min(*cfg.Factor, 1))
+}
+
+// dimRegion fades canvas pixels within rect towards the display background by factor,
+// so only widget content is dimmed and the background keeps its color
+func dimRegion(canvas *image.Gray, rect image.Rectangle, factor float64, bgColor uint8) {
+	rect = rect.Intersect(canvas.Bounds())
+	if rect.Empty() {
+		return
+	}
+
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		rowStart := canvas.PixOffset(rect.Min.X, y)
+		row := canvas.Pix[rowStart : rowStart+rect.Dx()]
+		bg := float64(bgColor)
+		for i, v := range row {
+			row[i] = uint8(bg + (float64(v)-bg)*factor + 0.5)
+		}
+	}
+}
+
 // compositeWithTransparency composites a widget image onto canvas, skipping background pixels.
 // Optimized version using direct slice access instead of GrayAt/SetGray calls.
 func compositeWithTransparency(canvas *image.Gray, widgetImg image.Image, pos config.PositionConfig, bgColor int) {
@@ -505,6 +505,54 @@ func (m *mockWidgetWithError) Render() (image.Image, error) {
 	return nil, m.renderError
 }
 
+type mockWidgetWithPanic struct {
+	*mockWidgetSimple
+}
+
+func (m *mockWidgetWithPanic) Render() (image.Image, error) {
+	panic("test render panic")
+}
+
+func TestComposite_WidgetRenderPanic(t *testing.T) {
+	displayCfg := config.DisplayConfig{
+		Width:      128,
+		Height:     40,
+		Background: 0,
+	}
+
+	panicking := &mockWidgetWithPanic{mockWidgetSimple: newMockWidgetSimple("panicking", 0, 0, 64, 40, 0)}
+	healthy := newMockWidgetSimple("healthy", 64, 0, 64, 40, 0)
+
+	mgr := NewManager(displayCfg, []widget.Widget{panicking, healthy})
+
+	// Repeated frames keep working while the widget keeps panicking
+	for i := 0; i < 2; i++ {
+		img, err := mgr.Composite()
+		if err != nil {
+			t.Fatalf("Composite() error = %v, want panic isolated to the widget", err)
+		}
+
+		grayImg := img.(*image.Gray)
+		if grayImg.GrayAt(96, 20).Y != 128 {
+			t.Errorf("healthy widget pixel = %d, want 128", grayImg.GrayAt(96, 20).Y)
+		}
+
+		// Placeholder error image drawn in the panicking widget's area
+		hasContent := false
+		for y := 0; y < 40 && !hasContent; y++ {
+			for x := 0; x < 64; x++ {
+				if grayImg.GrayAt(x, y).Y != 0 {
+					hasContent = true
+					break
+				}
+			}
+		}
+		if !hasContent {
+			t.Error("panicking widget area should show the error placeholder")
+		}
+	}
+}
+
 func TestComposite_ZOrderRespected(t *testing.T) {
 	displayCfg := config.DisplayConfig{
 		Width:      128,
@@ -578,6 +626,29 @@ func TestComposite_ZOrderRespected(t *testing.T) {
 	}
 }
 
+func TestNewManager_SameZConfigOrder(t *testing.T) {
+	displayCfg := config.DisplayConfig{Width: 128, Height: 40}
+
+	// Enough widgets for the sort to leave the insertion sort path
+	widgets := make([]widget.Widget, 13)
+	for i := range widgets {
+		widgets[i] = newMockWidgetSimple(fmt.Sprintf("w%d", i), 0, 0, 128, 40, i%2)
+	}
+	mgr := NewManager(displayCfg, widgets)
+
+	// Widgets with the same z keep their configuration order
+	last := map[int]int{0: -1, 1: -1}
+	for _, w := range mgr.sortedWidgets {
+		var idx int
+		_, _ = fmt.Sscanf(w.Name(), "w%d", &idx)
+		z := w.GetPosition().Z
+		if idx < last[z] {
+			t.Fatalf("widget %s drawn after w%d with the same z", w.Name(), last[z])
+		}
+		last[z] = idx
+	}
+}
+
 func TestComposite_TransparentBackground(t *testing.T) {
 	displayCfg := config.DisplayConfig{
 		Width:      128,
@@ -681,6 +752,134 @@ func TestComposite_TransparentLayering(t *testing.T) {
 	}
 }
 
+func TestComposite_OverlapDim(t *testing.T) {
+	displayCfg := config.DisplayConfig{
+		Width:      128,
+		Height:     40,
+		Background: 0,
+	}
+
+	// Bottom widget: solid 200 across the display
+	bottom := newMockWidgetSimple("bottom", 0, 0, 128, 40, 0)
+	bottomImg := image.NewGray(image.Rect(0, 0, 128, 40))
+	for i := range bottomImg.Pix {
+		bottomImg.Pix[i] = 200
+	}
+	bottom.img = bottomImg
+
+	// Top widget: covers the left half, background 0 with a single lit column
+	top := newMockWidgetSimple("top", 0, 0, 64, 40, 1)
+	top.style.OnOverlap = &config.OverlapConfig{Mode: config.OverlapDim, Factor: config.Float64Ptr(0.5)}
+	topImg := image.NewGray(image.Rect(0, 0, 64, 40))
+	for y := 0; y < 40; y++ {
+		topImg.SetGray(10, y, color.Gray{Y: 255})
+	}
+	top.img = topImg
+
+	mgr := NewManager(displayCfg, []widget.Widget{bottom, top})
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	grayImg := img.(*image.Gray)
+
+	// Under the top widget's background: bottom widget dimmed, not hidden
+	if got := grayImg.GrayAt(20, 20).Y; got != 100 {
+		t.Errorf("Pixel under top widget should be dimmed to 100, got %d", got)
+	}
+	// Top widget content drawn at full brightness
+	if got := grayImg.GrayAt(10, 20).Y; got != 255 {
+		t.Errorf("Top widget pixel should be 255, got %d", got)
+	}
+	// Outside the top widget: bottom widget untouched
+	if got := grayImg.GrayAt(100, 20).Y; got != 200 {
+		t.Errorf("Pixel outside top widget should stay 200, got %d", got)
+	}
+}
+
+func TestComposite_OverlapDimHiddenWidget(t *testing.T) {
+	displayCfg := config.DisplayConfig{
+		Width:      128,
+		Height:     40,
+		Background: 0,
+	}
+
+	bottom := newMockWidgetSimple("bottom", 0, 0, 128, 40, 0)
+
+	// Hidden top widget must not dim anything
+	topMock := newMockWidgetSimple("top", 0, 0, 128, 40, 1)
+	topMock.style.OnOverlap = &config.OverlapConfig{Mode: config.OverlapDim}
+	top := &mockWidgetWithNilRender{mockWidgetSimple: topMock}
+
+	mgr := NewManager(displayCfg, []widget.Widget{bottom, top})
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+
+	if got := img.(*image.Gray).GrayAt(64, 20).Y; got != 128 {
+		t.Errorf("Pixel should keep bottom widget value 128, got %d", got)
+	}
+}
+
+func TestComposite_OverlapDimKeepsBackground(t *testing.T) {
+	displayCfg := config.DisplayConfig{
+		Width:      128,
+		Height:     40,
+		Background: 50,
+	}
+
+	// Bottom widget only covers the right half; the left half shows the display background
+	bottom := newMockWidgetSimple("bottom", 64, 0, 64, 40, 0)
+	bottomImg := image.NewGray(image.Rect(0, 0, 64, 40))
+	for i := range bottomImg.Pix {
+		bottomImg.Pix[i] = 250
+	}
+	bottom.img = bottomImg
+
+	// Top widget spans the whole display with a transparent-looking background of 0
+	top := newMockWidgetSimple("top", 0, 0, 128, 40, 1)
+	top.style.OnOverlap = &config.OverlapConfig{Mode: config.OverlapDim, Factor: config.Float64Ptr(0.5)}
+	top.img = image.NewGray(image.Rect(0, 0, 128, 40))
+
+	mgr := NewManager(displayCfg, []widget.Widget{bottom, top})
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	grayImg := img.(*image.Gray)
+
+	if got := grayImg.GrayAt(10, 20).Y; got != 50 {
+		t.Errorf("Display background should stay 50, got %d", got)
+	}
+	if got := grayImg.GrayAt(100, 20).Y; got != 150 {
+		t.Errorf("Widget content should fade halfway towards background (150), got %d", got)
+	}
+}
+
+func TestOverlapDimFactor(t *testing.T) {
+	tests := []struct {
+		name   string
+		factor *float64
+		want   float64
+	}{
+		{"unset uses default", nil, config.DefaultOverlapDimFactor},
+		{"zero fades out completely", config.Float64Ptr(0), 0},
+		{"in range", config.Float64Ptr(0.6), 0.6},
+		{"above range clamped", config.Float64Ptr(2), 1},
+		{"below range clamped", config.Float64Ptr(-1), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := overlapDimFactor(&config.OverlapConfig{Mode: config.OverlapDim, Factor: tt.factor})
+			if got != tt.want {
+				t.Errorf("overlapDimFactor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestComposite_PartiallyOffscreen(t *testing.T) {
 	displayCfg := config.DisplayConfig{
 		Width:      128,
@@ -743,3 +942,162 @@ func TestCompositeWithTransparency_NonGrayImage(t *testing.T) {
 		t.Fatal("Composite() returned nil image")
 	}
 }
+
+// mockWidgetWithChanges reports changes through widget.ChangeReporter and counts renders
+type mockWidgetWithChanges struct {
+	*mockWidgetSimple
+	changed bool
+	renders int
+}
+
+func (m *mockWidgetWithChanges) Render() (image.Image, error) {
+	m.renders++
+	return m.mockWidgetSimple.Render()
+}
+
+func (m *mockWidgetWithChanges) NeedsRender() bool {
+	return m.changed
+}
+
+func TestComposite_SkipsUnchangedWidgets(t *testing.T) {
+	displayCfg := config.DisplayConfig{Width: 128, Height: 40}
+
+	w := &mockWidgetWithChanges{mockWidgetSimple: newMockWidgetSimple("clean", 0, 0, 128, 40, 0)}
+	mgr := NewManager(displayCfg, []widget.Widget{w})
+
+	// The first frame always renders, even when the widget reports no change
+	for i := 0; i < 3; i++ {
+		img, err := mgr.Composite()
+		if err != nil {
+			t.Fatalf("Composite() error = %v", err)
+		}
+		if got := img.(*image.Gray).GrayAt(10, 10).Y; got != 128 {
+			t.Errorf("frame %d: pixel = %d, want cached widget content 128", i, got)
+		}
+	}
+	if w.renders != 1 {
+		t.Errorf("renders = %d, want 1 while the widget is clean", w.renders)
+	}
+
+	w.changed = true
+	if _, err := mgr.Composite(); err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	if w.renders != 2 {
+		t.Errorf("renders = %d, want 2 after the widget changed", w.renders)
+	}
+}
+
+// mockRedrawOnUpdateWidget embeds BaseWidget for its update tracking and counts renders
+type mockRedrawOnUpdateWidget struct {
+	*widget.BaseWidget
+	renders int
+}
+
+func (m *mockRedrawOnUpdateWidget) Update() error { return nil }
+
+func (m *mockRedrawOnUpdateWidget) Render() (image.Image, error) {
+	m.renders++
+	img := m.CreateCanvas()
+	img.SetGray(10, 10, color.Gray{Y: 200})
+	return img, nil
+}
+
+func TestComposite_RedrawOnUpdate(t *testing.T) {
+	displayCfg := config.DisplayConfig{Width: 128, Height: 40}
+
+	w := &mockRedrawOnUpdateWidget{BaseWidget: widget.NewBaseWidget(config.WidgetConfig{
+		ID:             "weather",
+		Position:       config.PositionConfig{W: 128, H: 40},
+		UpdateInterval: 600,
+	})}
+	mgr := NewManager(displayCfg, []widget.Widget{w})
+
+	// Simulate 20 minutes of 100ms frames; the scheduler marks the widget
+	// updated every 600 seconds (at 0 and 600s)
+	const frameInterval = 100 * time.Millisecond
+	updateEvery := int(w.GetUpdateInterval() / frameInterval)
+	for frame := 0; frame < 2*updateEvery; frame++ {
+		if frame%updateEvery == 0 {
+			w.MarkUpdated()
+		}
+		img, err := mgr.Composite()
+		if err != nil {
+			t.Fatalf("Composite() error = %v", err)
+		}
+		if got := img.(*image.Gray).GrayAt(10, 10).Y; got != 200 {
+			t.Fatalf("frame %d: pixel = %d, want cached widget content 200", frame, got)
+		}
+	}
+
+	// Once per ten minutes: one render per update
+	if w.renders != 2 {
+		t.Errorf("renders = %d over 20 minutes, want 2", w.renders)
+	}
+}
+
+func TestManager_AddWidgetKeepsZOrder(t *testing.T) {
+	displayCfg := config.DisplayConfig{Width: 128, Height: 40}
+
+	bottom := newMockWidgetSimple("bottom", 0, 0, 128, 40, 0)
+	bottom.img = solidGray(128, 40, 50)
+	top := newMockWidgetSimple("top", 64, 0, 64, 40, 2)
+	top.img = solidGray(64, 40, 150)
+	mgr := NewManager(displayCfg, []widget.Widget{bottom, top})
+
+	// Toggled on at runtime between the two: covers bottom, stays under top
+	middle := newMockWidgetSimple("middle", 32, 0, 64, 40, 1)
+	middle.img = solidGray(64, 40, 100)
+	mgr.AddWidget(middle)
+
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	gray := img.(*image.Gray)
+	for _, tc := range []struct{ x, want int }{{16, 50}, {48, 100}, {80, 150}} {
+		if got := int(gray.GrayAt(tc.x, 20).Y); got != tc.want {
+			t.Errorf("pixel at x=%d = %d, want %d", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestManager_RemoveWidget(t *testing.T) {
+	displayCfg := config.DisplayConfig{Width: 128, Height: 40}
+
+	left := newMockWidgetSimple("left", 0, 0, 64, 40, 0)
+	left.img = solidGray(64, 40, 50)
+	right := &mockWidgetWithChanges{mockWidgetSimple: newMockWidgetSimple("right", 64, 0, 64, 40, 1)}
+	mgr := NewManager(displayCfg, []widget.Widget{right, left})
+
+	if _, err := mgr.Composite(); err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+
+	if mgr.RemoveWidget("missing") != nil {
+		t.Error("RemoveWidget(missing) should return nil")
+	}
+	if removed := mgr.RemoveWidget("left"); removed != widget.Widget(left) {
+		t.Fatalf("RemoveWidget(left) = %v, want the left widget", removed)
+	}
+
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	if got := img.(*image.Gray).GrayAt(16, 20).Y; got != 0 {
+		t.Errorf("removed widget area = %d, want background 0", got)
+	}
+	// The remaining widget keeps its cached frame across the reindex
+	if right.renders != 1 {
+		t.Errorf("right renders = %d, want 1 (cached image reused)", right.renders)
+	}
+}
+
+func solidGray(w, h int, v uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for i := range img.Pix {
+		img.Pix[i] = v
+	}
+	return img
+}
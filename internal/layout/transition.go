@@ -0,0 +1,140 @@
+package layout
+
+import (
+	"image"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+)
+
+// widgetTransition animates a single widget between its content and an empty area
+type widgetTransition struct {
+	manager        *anim.TransitionManager
+	blank          *image.Gray // Empty widget area (background color)
+	exit           bool        // true: content -> blank, widget stays hidden afterwards
+	transitionType anim.TransitionType
+	seconds        float64
+	started        bool // Exit transitions start on the next composite, from the widget's current frame
+}
+
+// StartEnterTransition animates the widget with the given ID in from an empty area.
+// Returns false if no such widget is displayed or the transition type is "none".
+func (m *Manager) StartEnterTransition(widgetID string, transitionType anim.TransitionType, seconds float64, easing anim.Easing) bool {
+	m.widgetsMu.Lock()
+	defer m.widgetsMu.Unlock()
+	m.transitionMu.Lock()
+	defer m.transitionMu.Unlock()
+
+	i, w := m.findWidget(widgetID)
+	if w == nil || !isAnimated(transitionType, seconds) {
+		return false
+	}
+
+	t := newWidgetTransition(w, false, transitionType, seconds, easing)
+	t.manager.Start(transitionType, seconds, t.blank)
+	t.started = true
+	m.transitions[i] = t
+	return true
+}
+
+// StartExitTransition animates the widget with the given ID out to an empty area.
+// The transition begins on the next composite from the widget's current frame; the widget
+// stays hidden once it completes, until it is removed or the layout is rebuilt.
+// Returns false if no such widget is displayed or the transition type is "none".
+func (m *Manager) StartExitTransition(widgetID string, transitionType anim.TransitionType, seconds float64, easing anim.Easing) bool {
+	m.widgetsMu.Lock()
+	defer m.widgetsMu.Unlock()
+	m.transitionMu.Lock()
+	defer m.transitionMu.Unlock()
+
+	i, w := m.findWidget(widgetID)
+	if w == nil || !isAnimated(transitionType, seconds) {
+		return false
+	}
+
+	m.transitions[i] = newWidgetTransition(w, true, transitionType, seconds, easing)
+	return true
+}
+
+// applyTransition returns the widget image with any active enter/exit transition applied.
+// A nil image means the widget is hidden.
+func (m *Manager) applyTransition(i int, img image.Image) image.Image {
+	m.transitionMu.Lock()
+	defer m.transitionMu.Unlock()
+
+	t, ok := m.transitions[i]
+	if !ok {
+		return img
+	}
+
+	if !t.started {
+		t.started = true
+		gray, ok := img.(*image.Gray)
+		if !ok || gray.Bounds().Size() != t.blank.Bounds().Size() {
+			// Hidden or non-grayscale content: nothing to animate out, just stay hidden
+			return nil
+		}
+		// Copy the frame: widgets may reuse their image buffer between renders
+		oldFrame := image.NewGray(t.blank.Bounds())
+		anim.CopyGrayImage(oldFrame, gray)
+		t.manager.Start(t.transitionType, t.seconds, oldFrame)
+	}
+
+	if !t.manager.IsActiveLive() {
+		if t.exit {
+			return nil
+		}
+		delete(m.transitions, i)
+		return img
+	}
+
+	newFrame := t.blank
+	if !t.exit {
+		gray, ok := img.(*image.Gray)
+		if !ok || gray.Bounds().Size() != t.blank.Bounds().Size() {
+			// Hidden or non-grayscale content: nothing to animate towards yet
+			return img
+		}
+		newFrame = gray
+	}
+
+	dst := image.NewGray(t.blank.Bounds())
+	t.manager.ApplyLive(dst, newFrame)
+	return dst
+}
+
+// findWidget returns the sorted index and widget with the given ID.
+// Must be called with widgetsMu held.
+func (m *Manager) findWidget(widgetID string) (int, widget.Widget) {
+	for i, w := range m.sortedWidgets {
+		if w.Name() == widgetID {
+			return i, w
+		}
+	}
+	return -1, nil
+}
+
+// newWidgetTransition prepares a transition sized to the widget, with an empty frame
+// in the widget's background color (or the transparent color for transparent widgets)
+func newWidgetTransition(w widget.Widget, exit bool, transitionType anim.TransitionType, seconds float64, easing anim.Easing) *widgetTransition {
+	pos := w.GetPosition()
+	bg := uint8(0)
+	if style := w.GetStyle(); style.Background > 0 && style.Background <= 255 {
+		bg = uint8(style.Background)
+	}
+	manager := anim.NewTransitionManager(pos.W, pos.H)
+	manager.SetEasing(easing)
+	return &widgetTransition{
+		manager:        manager,
+		blank:          bitmap.NewGrayscaleImage(pos.W, pos.H, bg),
+		exit:           exit,
+		transitionType: transitionType,
+		seconds:        seconds,
+	}
+}
+
+// isAnimated reports whether a transition would be visible
+func isAnimated(transitionType anim.TransitionType, seconds float64) bool {
+	return transitionType != "" && transitionType != anim.TransitionNone && seconds > 0
+}
@@ -0,0 +1,125 @@
+package layout
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+)
+
+func newTransitionTestManager(w *mockWidgetSimple) *Manager {
+	displayCfg := config.DisplayConfig{Width: 128, Height: 40, Background: 0}
+	return NewManager(displayCfg, []widget.Widget{w})
+}
+
+func TestStartEnterTransition(t *testing.T) {
+	mgr := newTransitionTestManager(newMockWidgetSimple("clock_0", 0, 0, 20, 10, 0))
+
+	if mgr.StartEnterTransition("missing", anim.TransitionDissolveFade, 0.5, "") {
+		t.Error("StartEnterTransition() for unknown widget should return false")
+	}
+	if mgr.StartEnterTransition("clock_0", anim.TransitionNone, 0.5, "") {
+		t.Error("StartEnterTransition() with type none should return false")
+	}
+	if mgr.StartEnterTransition("clock_0", anim.TransitionDissolveFade, 0, "") {
+		t.Error("StartEnterTransition() with zero duration should return false")
+	}
+
+	if !mgr.StartEnterTransition("clock_0", anim.TransitionSlideDown, 10, "") {
+		t.Fatal("StartEnterTransition() should start the transition")
+	}
+
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	// At the very start of a slide-in the widget area is still mostly empty
+	if got := img.(*image.Gray).GrayAt(10, 9).Y; got != 0 {
+		t.Errorf("pixel at start of enter transition = %d, want 0 (empty)", got)
+	}
+}
+
+func TestEnterTransition_CompletesToContent(t *testing.T) {
+	mgr := newTransitionTestManager(newMockWidgetSimple("clock_0", 0, 0, 20, 10, 0))
+
+	mgr.StartEnterTransition("clock_0", anim.TransitionDissolveFade, 0.01, "")
+	time.Sleep(20 * time.Millisecond)
+
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	if got := img.(*image.Gray).GrayAt(10, 5).Y; got != 128 {
+		t.Errorf("pixel after enter transition = %d, want 128 (content)", got)
+	}
+	if len(mgr.transitions) != 0 {
+		t.Errorf("finished enter transition should be removed, %d left", len(mgr.transitions))
+	}
+}
+
+func TestStartExitTransition(t *testing.T) {
+	mgr := newTransitionTestManager(newMockWidgetSimple("clock_0", 0, 0, 20, 10, 0))
+
+	if mgr.StartExitTransition("missing", anim.TransitionDissolveFade, 0.5, "") {
+		t.Error("StartExitTransition() for unknown widget should return false")
+	}
+	if !mgr.StartExitTransition("clock_0", anim.TransitionSlideUp, 0.05, "") {
+		t.Fatal("StartExitTransition() should start the transition")
+	}
+
+	// The first composite captures the current frame, which is still fully visible
+	img, err := mgr.Composite()
+	if err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	if got := img.(*image.Gray).GrayAt(10, 0).Y; got != 128 {
+		t.Errorf("pixel at start of exit transition = %d, want 128 (content)", got)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	// Widget stays hidden after the exit transition finishes
+	for range 2 {
+		img, err := mgr.Composite()
+		if err != nil {
+			t.Fatalf("Composite() error = %v", err)
+		}
+		if got := img.(*image.Gray).GrayAt(10, 5).Y; got != 0 {
+			t.Errorf("pixel after exit transition = %d, want 0 (hidden)", got)
+		}
+	}
+}
+
+func TestExitTransition_HiddenWidgetStaysHidden(t *testing.T) {
+	hidden := &mockWidgetWithNilRender{mockWidgetSimple: newMockWidgetSimple("clock_0", 0, 0, 20, 10, 0)}
+	displayCfg := config.DisplayConfig{Width: 128, Height: 40, Background: 0}
+	mgr := NewManager(displayCfg, []widget.Widget{hidden})
+
+	mgr.StartExitTransition("clock_0", anim.TransitionDissolveFade, 10, "")
+	if _, err := mgr.Composite(); err != nil {
+		t.Fatalf("Composite() error = %v", err)
+	}
+	if got := mgr.applyTransition(0, image.NewGray(image.Rect(0, 0, 20, 10))); got != nil {
+		t.Error("exit transition of a hidden widget should keep it hidden")
+	}
+}
+
+func TestEnterTransition_BlankUsesWidgetBackground(t *testing.T) {
+	w := newMockWidgetSimple("clock_0", 0, 0, 20, 10, 0)
+	w.style.Background = 50
+	mgr := newTransitionTestManager(w)
+
+	mgr.StartEnterTransition("clock_0", anim.TransitionSlideDown, 10, "")
+	if got := mgr.transitions[0].blank.GrayAt(0, 0).Y; got != 50 {
+		t.Errorf("blank frame color = %d, want 50", got)
+	}
+
+	w.style.Background = -1
+	mgr.StartEnterTransition("clock_0", anim.TransitionSlideDown, 10, "")
+	if got := mgr.transitions[0].blank.GrayAt(0, 0).Y; got != 0 {
+		t.Errorf("blank frame color for transparent widget = %d, want 0", got)
+	}
+}
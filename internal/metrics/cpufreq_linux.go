@@ -0,0 +1,89 @@
+//go:build linux
+
+package metrics
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// CPUFrequency implements CPUFrequencyProvider by reading cpufreq sysfs entries,
+// falling back to the "cpu MHz" lines of /proc/cpuinfo
+type CPUFrequency struct {
+	sysfsRoot   string // Directory holding cpuN subdirectories
+	cpuinfoPath string
+}
+
+// NewCPUFrequency creates a CPU frequency provider
+func NewCPUFrequency() *CPUFrequency {
+	return &CPUFrequency{
+		sysfsRoot:   "/sys/devices/system/cpu",
+		cpuinfoPath: "/proc/cpuinfo",
+	}
+}
+
+// CurrentMHz returns the current clock speed averaged over all cores, in MHz
+func (c *CPUFrequency) CurrentMHz() (float64, error) {
+	if mhz, ok := c.sysfsMHz(); ok {
+		return mhz, nil
+	}
+	if mhz, ok := c.cpuinfoMHz(); ok {
+		return mhz, nil
+	}
+	return 0, ErrCPUFrequencyUnavailable
+}
+
+// sysfsMHz averages scaling_cur_freq (reported in kHz) over all cores
+func (c *CPUFrequency) sysfsMHz() (float64, bool) {
+	paths, _ := filepath.Glob(filepath.Join(c.sysfsRoot, "cpu[0-9]*", "cpufreq", "scaling_cur_freq"))
+	sum := 0.0
+	count := 0
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		khz, err := strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+		if err != nil || khz <= 0 {
+			continue
+		}
+		sum += khz / 1000
+		count++
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+// cpuinfoMHz averages the "cpu MHz" lines of /proc/cpuinfo
+func (c *CPUFrequency) cpuinfoMHz() (float64, bool) {
+	f, err := os.Open(c.cpuinfoPath)
+	if err != nil {
+		return 0, false
+	}
+	defer func() { _ = f.Close() }()
+
+	sum := 0.0
+	count := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok || strings.TrimSpace(key) != "cpu MHz" {
+			continue
+		}
+		mhz, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || mhz <= 0 {
+			continue
+		}
+		sum += mhz
+		count++
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
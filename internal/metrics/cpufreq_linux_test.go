@@ -0,0 +1,59 @@
+//go:build linux
+
+package metrics
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCPUFrequency_Sysfs(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "cpu0", "cpufreq", "scaling_cur_freq"), "3000000\n")
+	writeFile(t, filepath.Join(dir, "cpu1", "cpufreq", "scaling_cur_freq"), "4000000\n")
+	writeFile(t, filepath.Join(dir, "cpufreq", "boost"), "1\n")
+
+	c := &CPUFrequency{sysfsRoot: dir, cpuinfoPath: filepath.Join(dir, "missing")}
+	mhz, err := c.CurrentMHz()
+	if err != nil {
+		t.Fatalf("CurrentMHz() error: %v", err)
+	}
+	if mhz != 3500 {
+		t.Errorf("CurrentMHz() = %v, want 3500", mhz)
+	}
+}
+
+func TestCPUFrequency_CpuinfoFallback(t *testing.T) {
+	dir := t.TempDir()
+	cpuinfo := filepath.Join(dir, "cpuinfo")
+	writeFile(t, cpuinfo, "processor\t: 0\ncpu MHz\t\t: 2000.000\n\nprocessor\t: 1\ncpu MHz\t\t: 3000.000\n")
+
+	c := &CPUFrequency{sysfsRoot: dir, cpuinfoPath: cpuinfo}
+	mhz, err := c.CurrentMHz()
+	if err != nil {
+		t.Fatalf("CurrentMHz() error: %v", err)
+	}
+	if mhz != 2500 {
+		t.Errorf("CurrentMHz() = %v, want 2500", mhz)
+	}
+}
+
+func TestCPUFrequency_Unavailable(t *testing.T) {
+	dir := t.TempDir()
+	c := &CPUFrequency{sysfsRoot: dir, cpuinfoPath: filepath.Join(dir, "missing")}
+	if _, err := c.CurrentMHz(); !errors.Is(err, ErrCPUFrequencyUnavailable) {
+		t.Errorf("CurrentMHz() error = %v, want ErrCPUFrequencyUnavailable", err)
+	}
+}
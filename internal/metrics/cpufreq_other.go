@@ -0,0 +1,17 @@
+//go:build !windows && !linux
+
+package metrics
+
+// CPUFrequency is a CPUFrequencyProvider for platforms without a supported
+// clock speed source; it always reports ErrCPUFrequencyUnavailable
+type CPUFrequency struct{}
+
+// NewCPUFrequency creates a CPU frequency provider
+func NewCPUFrequency() *CPUFrequency {
+	return &CPUFrequency{}
+}
+
+// CurrentMHz always returns ErrCPUFrequencyUnavailable
+func (c *CPUFrequency) CurrentMHz() (float64, error) {
+	return 0, ErrCPUFrequencyUnavailable
+}
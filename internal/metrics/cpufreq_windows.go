@@ -0,0 +1,77 @@
+//go:build windows
+
+package metrics
+
+import "sync"
+
+// Performance counters for the current clock speed. "Processor Frequency" is the
+// nominal clock; "% Processor Performance" scales it (above 100 when boosting).
+const (
+	processorFrequencyPath   = `\Processor Information(_Total)\Processor Frequency`
+	processorPerformancePath = `\Processor Information(_Total)\% Processor Performance`
+)
+
+// CPUFrequency implements CPUFrequencyProvider using PDH performance counters
+type CPUFrequency struct {
+	mu                 sync.Mutex
+	initialized        bool
+	initErr            error
+	queryHandle        uintptr
+	frequencyCounter   uintptr
+	performanceCounter uintptr
+}
+
+// NewCPUFrequency creates a CPU frequency provider. The PDH query is opened on first use.
+func NewCPUFrequency() *CPUFrequency {
+	return &CPUFrequency{}
+}
+
+// CurrentMHz returns the current clock speed averaged over all cores, in MHz.
+// "% Processor Performance" is a rate counter, so the first call after
+// initialization only primes it and reports ErrCPUFrequencyUnavailable.
+func (c *CPUFrequency) CurrentMHz() (float64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.initialized {
+		c.initialized = true
+		c.initErr = c.init()
+		if c.initErr == nil {
+			pdhCollectQueryData.Call(c.queryHandle)
+		}
+	}
+	if c.initErr != nil {
+		return 0, ErrCPUFrequencyUnavailable
+	}
+
+	if ret, _, _ := pdhCollectQueryData.Call(c.queryHandle); ret != 0 {
+		return 0, ErrCPUFrequencyUnavailable
+	}
+
+	nominal, ok := formattedCounterValue(c.frequencyCounter)
+	if !ok || nominal <= 0 {
+		return 0, ErrCPUFrequencyUnavailable
+	}
+	performance, ok := formattedCounterValue(c.performanceCounter)
+	if !ok || performance <= 0 {
+		return 0, ErrCPUFrequencyUnavailable
+	}
+	return nominal * performance / 100, nil
+}
+
+// init opens the PDH query and adds both counters
+func (c *CPUFrequency) init() error {
+	queryHandle, err := openPDHQuery()
+	if err != nil {
+		return err
+	}
+	c.queryHandle = queryHandle
+
+	if c.frequencyCounter, err = addPDHCounter(queryHandle, processorFrequencyPath); err != nil {
+		return err
+	}
+	if c.performanceCounter, err = addPDHCounter(queryHandle, processorPerformancePath); err != nil {
+		return err
+	}
+	return nil
+}
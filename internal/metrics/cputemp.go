@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/shirou/gopsutil/v4/sensors"
+)
+
+// cpuSensorProfile describes how a platform names CPU temperature sensors.
+// All matching is done on lowercase sensor keys.
+type cpuSensorProfile struct {
+	// isCPU reports whether a reading comes from a CPU sensor
+	isCPU func(key string) bool
+	// packageMarkers identify whole-package readings among the CPU sensors
+	packageMarkers []string
+	// fallback selects readings to average when no CPU sensor is present (optional)
+	fallback func(key string) bool
+}
+
+// cpuSensorProfiles maps GOOS to the CPU sensor naming of its gopsutil source.
+// Platforms not listed use the Linux hwmon profile.
+var cpuSensorProfiles = map[string]cpuSensorProfile{
+	// hwmon: Intel coretemp, AMD k10temp/zenpower and SoC thermal zones (Raspberry Pi etc.)
+	"linux": {
+		isCPU:          hasAnyPrefix("coretemp", "k10temp", "zenpower", "cpu_thermal", "cpu-thermal", "soc_thermal"),
+		packageMarkers: []string{"package", "tctl", "tdie"},
+	},
+	// WMI thermal zones keyed by InstanceName (ACPI\ThermalZone\TZ00_0). Zones named
+	// after the CPU are used when present, otherwise all zones are averaged: on most
+	// machines the ACPI zones track the CPU.
+	"windows": {
+		isCPU: func(key string) bool {
+			return strings.Contains(key, "thermalzone") && strings.Contains(key, "cpu")
+		},
+		fallback: func(key string) bool {
+			return strings.Contains(key, "thermalzone")
+		},
+	},
+	// Intel Macs report SMC keys (TC0D CPU diode, TC0P proximity, TC0H heatsink);
+	// Apple Silicon reports IOKit product names ("PMU tdie1", "pACC MTR Temp Sensor0")
+	"darwin": {
+		isCPU: func(key string) bool {
+			return strings.HasPrefix(key, "tc") || strings.Contains(key, "tdie") ||
+				strings.Contains(key, "pacc") || strings.Contains(key, "eacc")
+		},
+		packageMarkers: []string{"tc0d"},
+	},
+}
+
+// hasAnyPrefix returns a matcher for keys starting with any of the prefixes
+func hasAnyPrefix(prefixes ...string) func(string) bool {
+	return func(key string) bool {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// cpuSensorProfileFor returns the CPU sensor profile of a platform
+func cpuSensorProfileFor(goos string) cpuSensorProfile {
+	if p, ok := cpuSensorProfiles[goos]; ok {
+		return p
+	}
+	return cpuSensorProfiles["linux"]
+}
+
+// pickCPUTemperature selects the CPU temperature from sensor readings of the
+// current platform
+func pickCPUTemperature(temps []sensors.TemperatureStat) (float64, bool) {
+	return pickCPUTemperatureFor(cpuSensorProfileFor(runtime.GOOS), temps)
+}
+
+// pickCPUTemperatureFor selects the CPU temperature from sensor readings.
+// A package reading (Intel "package id", AMD Tctl/Tdie, Mac CPU diode) is
+// preferred; otherwise all CPU readings are averaged, or the profile's fallback
+// readings when there are none. Zero and negative readings are treated as
+// absent sensors.
+func pickCPUTemperatureFor(profile cpuSensorProfile, temps []sensors.TemperatureStat) (float64, bool) {
+	var cpu, fallback []float64
+	for _, t := range temps {
+		if t.Temperature <= 0 {
+			continue
+		}
+		key := strings.ToLower(t.SensorKey)
+		switch {
+		case profile.isCPU(key):
+			for _, marker := range profile.packageMarkers {
+				if strings.Contains(key, marker) {
+					return t.Temperature, true
+				}
+			}
+			cpu = append(cpu, t.Temperature)
+		case profile.fallback != nil && profile.fallback(key):
+			fallback = append(fallback, t.Temperature)
+		}
+	}
+
+	if len(cpu) == 0 {
+		cpu = fallback
+	}
+	if len(cpu) == 0 {
+		return 0, false
+	}
+	sum := 0.0
+	for _, v := range cpu {
+		sum += v
+	}
+	return sum / float64(len(cpu)), true
+}
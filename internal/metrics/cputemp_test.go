@@ -0,0 +1,149 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/sensors"
+)
+
+func TestPickCPUTemperature_Linux(t *testing.T) {
+	tests := []struct {
+		name   string
+		temps  []sensors.TemperatureStat
+		want   float64
+		wantOK bool
+	}{
+		{
+			name: "intel package preferred over cores",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: "coretemp_core_0", Temperature: 50},
+				{SensorKey: "coretemp_package_id_0", Temperature: 62},
+				{SensorKey: "coretemp_core_1", Temperature: 54},
+			},
+			want: 62, wantOK: true,
+		},
+		{
+			name: "amd tctl",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: "nvme_composite", Temperature: 38},
+				{SensorKey: "k10temp_tctl", Temperature: 71},
+			},
+			want: 71, wantOK: true,
+		},
+		{
+			name: "cores averaged without package reading",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: "coretemp_core_0", Temperature: 50},
+				{SensorKey: "coretemp_core_1", Temperature: 60},
+			},
+			want: 55, wantOK: true,
+		},
+		{
+			name:  "soc thermal zone",
+			temps: []sensors.TemperatureStat{{SensorKey: "cpu_thermal", Temperature: 47.5}},
+			want:  47.5, wantOK: true,
+		},
+		{
+			name: "non-cpu sensors only",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: "nvme_composite", Temperature: 38},
+				{SensorKey: "amdgpu_edge", Temperature: 45},
+			},
+		},
+		{
+			name:  "zero readings ignored",
+			temps: []sensors.TemperatureStat{{SensorKey: "coretemp_package_id_0", Temperature: 0}},
+		},
+		{name: "no sensors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := pickCPUTemperatureFor(cpuSensorProfileFor("linux"), tt.temps)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("pickCPUTemperatureFor() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPickCPUTemperature_Windows(t *testing.T) {
+	tests := []struct {
+		name   string
+		temps  []sensors.TemperatureStat
+		want   float64
+		wantOK bool
+	}{
+		{
+			name: "cpu thermal zone preferred",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: `ACPI\ThermalZone\TZ00_0`, Temperature: 30},
+				{SensorKey: `ACPI\ThermalZone\CPUZ_0`, Temperature: 58},
+			},
+			want: 58, wantOK: true,
+		},
+		{
+			name: "generic thermal zones averaged",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: `ACPI\ThermalZone\TZ00_0`, Temperature: 40},
+				{SensorKey: `ACPI\ThermalZone\TZ01_0`, Temperature: 50},
+			},
+			want: 45, wantOK: true,
+		},
+		{
+			name:  "hwmon names do not match",
+			temps: []sensors.TemperatureStat{{SensorKey: "coretemp_package_id_0", Temperature: 60}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := pickCPUTemperatureFor(cpuSensorProfileFor("windows"), tt.temps)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("pickCPUTemperatureFor() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPickCPUTemperature_Darwin(t *testing.T) {
+	tests := []struct {
+		name   string
+		temps  []sensors.TemperatureStat
+		want   float64
+		wantOK bool
+	}{
+		{
+			name: "intel smc diode preferred",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: "TA0P", Temperature: 28},
+				{SensorKey: "TC0P", Temperature: 50},
+				{SensorKey: "TC0D", Temperature: 64},
+				{SensorKey: "TG0D", Temperature: 55},
+			},
+			want: 64, wantOK: true,
+		},
+		{
+			name: "apple silicon die sensors averaged",
+			temps: []sensors.TemperatureStat{
+				{SensorKey: "PMU tdie1", Temperature: 44},
+				{SensorKey: "PMU tdie2", Temperature: 48},
+				{SensorKey: "gas gauge battery", Temperature: 31},
+			},
+			want: 46, wantOK: true,
+		},
+		{
+			name:  "no cpu keys",
+			temps: []sensors.TemperatureStat{{SensorKey: "TA0P", Temperature: 28}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := pickCPUTemperatureFor(cpuSensorProfileFor("darwin"), tt.temps)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("pickCPUTemperatureFor() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
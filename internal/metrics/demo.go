@@ -0,0 +1,282 @@
+package metrics
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Demo providers generate plausible synthetic values for designing layouts offline.
+// Values are smooth functions of the time elapsed since the provider was created,
+// so every call is cheap and cumulative I/O counters never go backwards.
+
+// DemoCPUCores is the number of logical cores reported by DemoCPU
+const DemoCPUCores = 8
+
+// demoName is the interface/device name reported when none is configured
+const demoName = "demo"
+
+// wave returns a sine wave oscillating between lo and hi with the given period in seconds
+func wave(t, period, phase, lo, hi float64) float64 {
+	s := (math.Sin(2*math.Pi*t/period+phase) + 1) / 2
+	return lo + (hi-lo)*s
+}
+
+// waveIntegral returns the integral of wave(t, period, phase, lo, hi) from 0 to t
+func waveIntegral(t, period, phase, lo, hi float64) float64 {
+	mid := (lo + hi) / 2
+	amp := (hi - lo) / 2
+	w := 2 * math.Pi / period
+	return mid*t - amp/w*(math.Cos(w*t+phase)-math.Cos(phase))
+}
+
+// DemoCPU is a synthetic CPUProvider with per-core sine-wave load.
+// It also implements CPUFrequencyProvider, CPUTemperatureProvider and LoadAvgProvider
+// from the same load.
+type DemoCPU struct {
+	start time.Time
+}
+
+// NewDemoCPU creates a demo CPU provider
+func NewDemoCPU() *DemoCPU {
+	return &DemoCPU{start: time.Now()}
+}
+
+// Counts returns DemoCPUCores regardless of logical
+func (d *DemoCPU) Counts(bool) (int, error) {
+	return DemoCPUCores, nil
+}
+
+// Percent returns synthetic usage without sampling; the interval is ignored
+func (d *DemoCPU) Percent(_ time.Duration, perCore bool) ([]float64, error) {
+	return demoCPUPercent(time.Since(d.start).Seconds(), perCore), nil
+}
+
+// demoCPUPercent returns CPU load at t seconds: cores drift independently
+// with a slower shared swell on top
+func demoCPUPercent(t float64, perCore bool) []float64 {
+	cores := make([]float64, DemoCPUCores)
+	sum := 0.0
+	for i := range cores {
+		v := wave(t, 7+float64(i), float64(i)*0.9, 5, 70) + wave(t, 40, 0, 0, 25)
+		cores[i] = min(v, 100)
+		sum += cores[i]
+	}
+	if perCore {
+		return cores
+	}
+	return []float64{sum / DemoCPUCores}
+}
+
+// CurrentMHz returns a clock speed that follows the synthetic load, boosting under load
+func (d *DemoCPU) CurrentMHz() (float64, error) {
+	usage := demoCPUPercent(time.Since(d.start).Seconds(), false)[0]
+	return 2200 + usage/100*2900, nil
+}
+
+// CurrentCelsius returns a temperature that follows the synthetic load, idling around 40°C
+func (d *DemoCPU) CurrentCelsius() (float64, error) {
+	usage := demoCPUPercent(time.Since(d.start).Seconds(), false)[0]
+	return 40 + usage/100*45, nil
+}
+
+// LoadAvg returns load averages derived from the synthetic usage; longer windows lag behind
+func (d *DemoCPU) LoadAvg() (LoadAvgStat, error) {
+	t := time.Since(d.start).Seconds()
+	load := func(lag float64) float64 {
+		return demoCPUPercent(max(t-lag, 0), false)[0] / 100 * DemoCPUCores
+	}
+	return LoadAvgStat{Load1: load(0), Load5: load(20), Load15: load(40)}, nil
+}
+
+// DemoMemory is a synthetic MemoryProvider with slowly drifting usage
+type DemoMemory struct {
+	start time.Time
+}
+
+// NewDemoMemory creates a demo memory provider
+func NewDemoMemory() *DemoMemory {
+	return &DemoMemory{start: time.Now()}
+}
+
+// Demo memory sizes reported by DemoMemory
+const (
+	demoMemoryTotal = 16 << 30
+	demoSwapTotal   = 4 << 30
+)
+
+// VirtualMemory returns synthetic usage between 45% and 75% of 16 GiB
+func (d *DemoMemory) VirtualMemory() (MemoryStat, error) {
+	return demoMemoryStat(demoMemoryTotal, demoMemoryPercent(time.Since(d.start).Seconds())), nil
+}
+
+// SwapMemory returns synthetic usage between 5% and 20% of 4 GiB
+func (d *DemoMemory) SwapMemory() (MemoryStat, error) {
+	return demoMemoryStat(demoSwapTotal, wave(time.Since(d.start).Seconds(), 120, 0, 5, 20)), nil
+}
+
+// demoMemoryStat builds a MemoryStat with the given share of total in use
+func demoMemoryStat(total uint64, percent float64) MemoryStat {
+	return MemoryStat{Total: total, Used: uint64(float64(total) * percent / 100), UsedPercent: percent}
+}
+
+func demoMemoryPercent(t float64) float64 {
+	return wave(t, 90, 0, 45, 70) + wave(t, 13, 1, 0, 5)
+}
+
+// DemoNetwork is a synthetic NetworkProvider with looping download/upload throughput
+type DemoNetwork struct {
+	name  string
+	start time.Time
+}
+
+// NewDemoNetwork creates a demo network provider reporting a single interface.
+// Pass the configured interface name so widgets filtering by name still find it.
+func NewDemoNetwork(name string) *DemoNetwork {
+	if name == "" {
+		name = demoName
+	}
+	return &DemoNetwork{name: name, start: time.Now()}
+}
+
+// IOCounters returns cumulative counters for the demo interface
+func (d *DemoNetwork) IOCounters() ([]NetworkStat, error) {
+	recv, sent := demoNetworkBytes(time.Since(d.start).Seconds())
+	return []NetworkStat{{Name: d.name, BytesRecv: recv, BytesSent: sent}}, nil
+}
+
+// demoNetworkBytes returns bytes transferred after t seconds: download loops
+// between 0.1 and 4 MB/s every 30s, upload between 20 and 600 KB/s every 45s
+func demoNetworkBytes(t float64) (recv, sent uint64) {
+	recv = uint64(waveIntegral(t, 30, 0, 100e3, 4e6))
+	sent = uint64(waveIntegral(t, 45, 2, 20e3, 600e3))
+	return recv, sent
+}
+
+// DemoDefaultRoute is a DefaultRouteProvider resolving to the demo network interface
+type DemoDefaultRoute struct{}
+
+// DefaultRouteInterface returns the name reported by NewDemoNetwork("")
+func (DemoDefaultRoute) DefaultRouteInterface() (string, error) {
+	return demoName, nil
+}
+
+// DemoDisk is a synthetic DiskProvider with alternating read and write bursts
+type DemoDisk struct {
+	name  string
+	start time.Time
+}
+
+// NewDemoDisk creates a demo disk provider reporting a single device.
+// Pass the configured disk name so widgets filtering by name still find it.
+func NewDemoDisk(name string) *DemoDisk {
+	if name == "" {
+		name = demoName
+	}
+	return &DemoDisk{name: name, start: time.Now()}
+}
+
+// IOCounters returns cumulative counters for the demo device
+func (d *DemoDisk) IOCounters() (map[string]DiskStat, error) {
+	read, write := demoDiskBytes(time.Since(d.start).Seconds())
+	return map[string]DiskStat{
+		d.name: {Name: d.name, ReadBytes: read, WriteBytes: write},
+	}, nil
+}
+
+// demoDiskBytes returns bytes read and written after t seconds: reads and
+// writes peak half a cycle apart, up to 50 and 20 MB/s
+func demoDiskBytes(t float64) (read, write uint64) {
+	read = uint64(waveIntegral(t, 20, 0, 0, 50e6))
+	write = uint64(waveIntegral(t, 20, math.Pi, 0, 20e6))
+	return read, write
+}
+
+// demoDiskTotal is the capacity DemoDiskUsage reports for any path
+const demoDiskTotal = 512 << 30
+
+// DemoDiskUsage is a synthetic DiskUsageProvider with a slowly filling and emptying disk
+type DemoDiskUsage struct {
+	start time.Time
+}
+
+// NewDemoDiskUsage creates a demo disk usage provider
+func NewDemoDiskUsage() *DemoDiskUsage {
+	return &DemoDiskUsage{start: time.Now()}
+}
+
+// Usage returns the demo capacity, the same for every path
+func (d *DemoDiskUsage) Usage(string) (DiskUsageStat, error) {
+	percent := demoDiskUsedPercent(time.Since(d.start).Seconds())
+	used := uint64(demoDiskTotal * percent / 100)
+	return DiskUsageStat{Total: demoDiskTotal, Free: demoDiskTotal - used, Used: used, UsedPercent: percent}, nil
+}
+
+// demoDiskUsedPercent loops between 55% and 85% used every two minutes
+func demoDiskUsedPercent(t float64) float64 {
+	return wave(t, 120, 0, 55, 85)
+}
+
+// demoProcessMemory is the memory total DemoProcessUsage reports percentages against
+const demoProcessMemory = 16 << 30
+
+// DemoProcessUsage is a synthetic ProcessUsageProvider: any matcher finds a single
+// process with fluctuating CPU load and slowly growing and shrinking memory
+type DemoProcessUsage struct {
+	start time.Time
+}
+
+// NewDemoProcessUsage creates a demo process usage provider
+func NewDemoProcessUsage() *DemoProcessUsage {
+	return &DemoProcessUsage{start: time.Now()}
+}
+
+// ProcessUsage returns synthetic usage regardless of name and pid
+func (d *DemoProcessUsage) ProcessUsage(string, int32) (ProcessUsageStat, error) {
+	t := time.Since(d.start).Seconds()
+	memory := uint64(wave(t, 90, 0, 1.5, 2.5) * (1 << 30))
+	return ProcessUsageStat{
+		Count:         1,
+		CPUPercent:    wave(t, 11, 0, 10, 45) + wave(t, 3, 1, 0, 10),
+		MemoryBytes:   memory,
+		MemoryPercent: float64(memory) / demoProcessMemory * 100,
+	}, nil
+}
+
+// DemoHWMon is a synthetic HWMonProvider with a typical desktop sensor set
+type DemoHWMon struct {
+	start time.Time
+}
+
+// NewDemoHWMon creates a demo hardware sensor provider
+func NewDemoHWMon() *DemoHWMon {
+	return &DemoHWMon{start: time.Now()}
+}
+
+// Sensors returns synthetic temperature, load, fan, power and clock readings
+func (d *DemoHWMon) Sensors() ([]HWMonStat, error) {
+	return demoSensors(time.Since(d.start).Seconds()), nil
+}
+
+func demoSensors(t float64) []HWMonStat {
+	stats := []HWMonStat{
+		{SensorID: "/demo/cpu/temperature/0", Name: "CPU Package", Type: "Temperature", Value: wave(t, 25, 0, 42, 78), Unit: "°C"},
+	}
+	for i := 0; i < 4; i++ {
+		stats = append(stats, HWMonStat{
+			SensorID: "/demo/cpu/temperature/" + strconv.Itoa(i+1),
+			Name:     "CPU Core #" + strconv.Itoa(i+1),
+			Type:     "Temperature",
+			Value:    wave(t, 11+float64(i)*2, float64(i), 40, 82),
+			Unit:     "°C",
+		})
+	}
+	return append(stats,
+		HWMonStat{SensorID: "/demo/cpu/load/0", Name: "CPU Total", Type: "Load", Value: demoCPUPercent(t, false)[0], Unit: "%"},
+		HWMonStat{SensorID: "/demo/gpu/temperature/0", Name: "GPU Core", Type: "Temperature", Value: wave(t, 35, 1, 38, 71), Unit: "°C"},
+		HWMonStat{SensorID: "/demo/gpu/load/0", Name: "GPU Core", Type: "Load", Value: wave(t, 17, 2, 3, 96), Unit: "%"},
+		HWMonStat{SensorID: "/demo/fan/0", Name: "CPU Fan", Type: "Fan", Value: wave(t, 25, 0.5, 700, 1800), Unit: "RPM"},
+		HWMonStat{SensorID: "/demo/cpu/power/0", Name: "CPU Package", Type: "Power", Value: wave(t, 25, 0, 18, 125), Unit: "W"},
+		HWMonStat{SensorID: "/demo/cpu/clock/0", Name: "CPU Core #1", Type: "Clock", Value: wave(t, 9, 0, 2200, 5100), Unit: "MHz"},
+	)
+}
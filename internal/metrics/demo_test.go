@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWaveIntegral(t *testing.T) {
+	// Numerically integrate wave and compare with the closed form
+	const period, phase, lo, hi = 10.0, 1.3, 2.0, 8.0
+	sum := 0.0
+	step := 0.001
+	for x := 0.0; x < 25; x += step {
+		sum += wave(x+step/2, period, phase, lo, hi) * step
+	}
+	if got := waveIntegral(25, period, phase, lo, hi); math.Abs(got-sum) > 0.01 {
+		t.Errorf("waveIntegral = %f, numeric = %f", got, sum)
+	}
+	if got := waveIntegral(0, period, phase, lo, hi); got != 0 {
+		t.Errorf("waveIntegral(0) = %f, want 0", got)
+	}
+}
+
+func TestDemoCPUPercent(t *testing.T) {
+	for _, ts := range []float64{0, 3.5, 17, 120, 3601} {
+		cores := demoCPUPercent(ts, true)
+		if len(cores) != DemoCPUCores {
+			t.Fatalf("per-core values = %d, want %d", len(cores), DemoCPUCores)
+		}
+		sum := 0.0
+		for _, v := range cores {
+			if v < 0 || v > 100 {
+				t.Errorf("t=%v: core load %f out of range", ts, v)
+			}
+			sum += v
+		}
+		total := demoCPUPercent(ts, false)
+		if len(total) != 1 || math.Abs(total[0]-sum/DemoCPUCores) > 1e-9 {
+			t.Errorf("t=%v: aggregate %v, want average %f", ts, total, sum/DemoCPUCores)
+		}
+	}
+
+	d := NewDemoCPU()
+	if n, _ := d.Counts(true); n != DemoCPUCores {
+		t.Errorf("Counts = %d, want %d", n, DemoCPUCores)
+	}
+}
+
+func TestDemoCPUFrequencyAndLoad(t *testing.T) {
+	d := NewDemoCPU()
+	mhz, err := d.CurrentMHz()
+	if err != nil || mhz < 2200 || mhz > 5100 {
+		t.Errorf("CurrentMHz() = %v, %v; want 2200-5100", mhz, err)
+	}
+	celsius, err := d.CurrentCelsius()
+	if err != nil || celsius < 40 || celsius > 85 {
+		t.Errorf("CurrentCelsius() = %v, %v; want 40-85", celsius, err)
+	}
+	avg, err := d.LoadAvg()
+	if err != nil {
+		t.Fatalf("LoadAvg() error: %v", err)
+	}
+	for _, v := range []float64{avg.Load1, avg.Load5, avg.Load15} {
+		if v < 0 || v > DemoCPUCores {
+			t.Errorf("load average %v out of range 0-%d", v, DemoCPUCores)
+		}
+	}
+}
+
+func TestDemoMemoryPercent(t *testing.T) {
+	for ts := 0.0; ts < 300; ts += 7 {
+		if v := demoMemoryPercent(ts); v < 0 || v > 100 {
+			t.Errorf("t=%v: memory %f out of range", ts, v)
+		}
+	}
+}
+
+func TestDemoMemoryStat(t *testing.T) {
+	d := NewDemoMemory()
+	for _, get := range []func() (MemoryStat, error){d.VirtualMemory, d.SwapMemory} {
+		stat, err := get()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if stat.Total == 0 || stat.Used > stat.Total || stat.UsedPercent <= 0 || stat.UsedPercent > 100 {
+			t.Errorf("implausible demo memory: %+v", stat)
+		}
+	}
+}
+
+func TestDemoIOCountersMonotonic(t *testing.T) {
+	var lastRecv, lastSent, lastRead, lastWrite uint64
+	for ts := 0.0; ts < 200; ts += 0.5 {
+		recv, sent := demoNetworkBytes(ts)
+		read, write := demoDiskBytes(ts)
+		if recv < lastRecv || sent < lastSent || read < lastRead || write < lastWrite {
+			t.Fatalf("t=%v: counters went backwards", ts)
+		}
+		lastRecv, lastSent, lastRead, lastWrite = recv, sent, read, write
+	}
+	if lastRecv == 0 || lastSent == 0 || lastRead == 0 || lastWrite == 0 {
+		t.Error("expected non-zero traffic after 200 seconds")
+	}
+}
+
+func TestDemoNetworkName(t *testing.T) {
+	stats, err := NewDemoNetwork("").IOCounters()
+	if err != nil || len(stats) != 1 || stats[0].Name != "demo" {
+		t.Errorf("unnamed demo network = %v, %v", stats, err)
+	}
+
+	stats, _ = NewDemoNetwork("eth0").IOCounters()
+	if stats[0].Name != "eth0" {
+		t.Errorf("interface name = %q, want eth0", stats[0].Name)
+	}
+}
+
+func TestDemoDefaultRoute(t *testing.T) {
+	name, err := DemoDefaultRoute{}.DefaultRouteInterface()
+	stats, _ := NewDemoNetwork("").IOCounters()
+	if err != nil || name != stats[0].Name {
+		t.Errorf("demo default route = %q, %v, want %q", name, err, stats[0].Name)
+	}
+}
+
+func TestDemoDiskName(t *testing.T) {
+	stats, err := NewDemoDisk("C:").IOCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := stats["C:"]; !ok {
+		t.Errorf("expected device C:, got %v", stats)
+	}
+}
+
+func TestDemoDiskUsage(t *testing.T) {
+	for ts := 0.0; ts < 240; ts += 7 {
+		if p := demoDiskUsedPercent(ts); p < 55 || p > 85 {
+			t.Errorf("demoDiskUsedPercent(%v) = %v, want 55-85", ts, p)
+		}
+	}
+
+	stat, err := NewDemoDiskUsage().Usage("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.Used+stat.Free != stat.Total {
+		t.Errorf("used %d + free %d != total %d", stat.Used, stat.Free, stat.Total)
+	}
+}
+
+func TestDemoSensors(t *testing.T) {
+	stats := demoSensors(42)
+	types := make(map[string]int)
+	ids := make(map[string]bool)
+	for _, s := range stats {
+		types[s.Type]++
+		if ids[s.SensorID] {
+			t.Errorf("duplicate sensor ID %q", s.SensorID)
+		}
+		ids[s.SensorID] = true
+		if s.Unit == "" || s.Name == "" {
+			t.Errorf("sensor %q missing name or unit", s.SensorID)
+		}
+	}
+	for _, typ := range []string{"Temperature", "Load", "Fan", "Power", "Clock"} {
+		if types[typ] == 0 {
+			t.Errorf("no %s sensors", typ)
+		}
+	}
+}
+
+func TestDemoInterfaceImplementation(t *testing.T) {
+	var _ CPUProvider = NewDemoCPU()
+	var _ CPUFrequencyProvider = NewDemoCPU()
+	var _ CPUTemperatureProvider = NewDemoCPU()
+	var _ LoadAvgProvider = NewDemoCPU()
+	var _ MemoryProvider = NewDemoMemory()
+	var _ NetworkProvider = NewDemoNetwork("")
+	var _ DefaultRouteProvider = DemoDefaultRoute{}
+	var _ DiskProvider = NewDemoDisk("")
+	var _ DiskUsageProvider = NewDemoDiskUsage()
+	var _ HWMonProvider = NewDemoHWMon()
+	var _ ProcessUsageProvider = NewDemoProcessUsage()
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/shirou/gopsutil/v4/cpu"
 	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/load"
 	"github.com/shirou/gopsutil/v4/mem"
 	"github.com/shirou/gopsutil/v4/net"
+	"github.com/shirou/gopsutil/v4/sensors"
 )
 
 // GopsutilCPU implements CPUProvider using gopsutil
@@ -27,6 +29,43 @@ func (g *GopsutilCPU) Percent(interval time.Duration, perCore bool) ([]float64,
 	return cpu.Percent(interval, perCore)
 }
 
+// GopsutilCPUTemperature implements CPUTemperatureProvider using gopsutil sensors
+type GopsutilCPUTemperature struct{}
+
+// NewGopsutilCPUTemperature creates a new gopsutil-based CPU temperature provider
+func NewGopsutilCPUTemperature() *GopsutilCPUTemperature {
+	return &GopsutilCPUTemperature{}
+}
+
+// CurrentCelsius returns the CPU package temperature picked from all sensors.
+// gopsutil reports unreadable sensors as warnings alongside the readable ones,
+// so any usable reading wins over the error.
+func (g *GopsutilCPUTemperature) CurrentCelsius() (float64, error) {
+	temps, _ := sensors.SensorsTemperatures()
+	if celsius, ok := pickCPUTemperature(temps); ok {
+		return celsius, nil
+	}
+	return 0, ErrCPUTemperatureUnavailable
+}
+
+// GopsutilLoad implements LoadAvgProvider using gopsutil.
+// On Windows gopsutil approximates load averages from the processor queue length.
+type GopsutilLoad struct{}
+
+// NewGopsutilLoad creates a new gopsutil-based load average provider
+func NewGopsutilLoad() *GopsutilLoad {
+	return &GopsutilLoad{}
+}
+
+// LoadAvg returns the 1, 5 and 15 minute load averages
+func (g *GopsutilLoad) LoadAvg() (LoadAvgStat, error) {
+	avg, err := load.Avg()
+	if err != nil {
+		return LoadAvgStat{}, err
+	}
+	return LoadAvgStat{Load1: avg.Load1, Load5: avg.Load5, Load15: avg.Load15}, nil
+}
+
 // GopsutilMemory implements MemoryProvider using gopsutil
 type GopsutilMemory struct{}
 
@@ -35,13 +74,25 @@ func NewGopsutilMemory() *GopsutilMemory {
 	return &GopsutilMemory{}
 }
 
-// UsedPercent returns the percentage of memory in use
-func (g *GopsutilMemory) UsedPercent() (float64, error) {
+// VirtualMemory returns physical memory usage
+func (g *GopsutilMemory) VirtualMemory() (MemoryStat, error) {
 	vmem, err := mem.VirtualMemory()
 	if err != nil {
-		return 0, err
+		return MemoryStat{}, err
 	}
-	return vmem.UsedPercent, nil
+	return MemoryStat{Total: vmem.Total, Used: vmem.Used, UsedPercent: vmem.UsedPercent}, nil
+}
+
+// SwapMemory returns swap usage; a system without swap reports zero total and usage
+func (g *GopsutilMemory) SwapMemory() (MemoryStat, error) {
+	swap, err := mem.SwapMemory()
+	if err != nil {
+		return MemoryStat{}, err
+	}
+	if swap.Total == 0 {
+		return MemoryStat{}, nil
+	}
+	return MemoryStat{Total: swap.Total, Used: swap.Used, UsedPercent: swap.UsedPercent}, nil
 }
 
 // GopsutilNetwork implements NetworkProvider using gopsutil
@@ -96,10 +147,37 @@ func (g *GopsutilDisk) IOCounters() (map[string]DiskStat, error) {
 	return result, nil
 }
 
+// GopsutilDiskUsage implements DiskUsageProvider using gopsutil
+type GopsutilDiskUsage struct{}
+
+// NewGopsutilDiskUsage creates a new gopsutil-based disk usage provider
+func NewGopsutilDiskUsage() *GopsutilDiskUsage {
+	return &GopsutilDiskUsage{}
+}
+
+// Usage returns the capacity of the file system holding path
+func (g *GopsutilDiskUsage) Usage(path string) (DiskUsageStat, error) {
+	stat, err := disk.Usage(path)
+	if err != nil {
+		return DiskUsageStat{}, err
+	}
+	return DiskUsageStat{
+		Total:       stat.Total,
+		Free:        stat.Free,
+		Used:        stat.Used,
+		UsedPercent: stat.UsedPercent,
+	}, nil
+}
+
 // Default provider instances for convenience.
 var (
-	DefaultCPU     CPUProvider     = NewGopsutilCPU()
-	DefaultMemory  MemoryProvider  = NewGopsutilMemory()
-	DefaultNetwork NetworkProvider = NewGopsutilNetwork()
-	DefaultDisk    DiskProvider    = NewGopsutilDisk()
+	DefaultCPU            CPUProvider            = NewGopsutilCPU()
+	DefaultCPUFrequency   CPUFrequencyProvider   = NewCPUFrequency()
+	DefaultCPUTemperature CPUTemperatureProvider = NewGopsutilCPUTemperature()
+	DefaultLoadAvg        LoadAvgProvider        = NewGopsutilLoad()
+	DefaultMemory         MemoryProvider         = NewGopsutilMemory()
+	DefaultNetwork        NetworkProvider        = NewGopsutilNetwork()
+	DefaultRoute          DefaultRouteProvider   = NewSystemRoute()
+	DefaultDisk           DiskProvider           = NewGopsutilDisk()
+	DefaultDiskUsage      DiskUsageProvider      = NewGopsutilDiskUsage()
 )
@@ -27,17 +27,65 @@ func (m *MockCPU) Percent(interval time.Duration, perCore bool) ([]float64, erro
 	return []float64{50.0}, nil
 }
 
+// MockCPUFrequency is a mock implementation of CPUFrequencyProvider for testing
+type MockCPUFrequency struct {
+	CurrentMHzFunc func() (float64, error)
+}
+
+// CurrentMHz calls the mock function if set, otherwise returns default
+func (m *MockCPUFrequency) CurrentMHz() (float64, error) {
+	if m.CurrentMHzFunc != nil {
+		return m.CurrentMHzFunc()
+	}
+	return 3800.0, nil // Default: 3.8 GHz
+}
+
+// MockCPUTemperature is a mock implementation of CPUTemperatureProvider for testing
+type MockCPUTemperature struct {
+	CurrentCelsiusFunc func() (float64, error)
+}
+
+// CurrentCelsius calls the mock function if set, otherwise returns default
+func (m *MockCPUTemperature) CurrentCelsius() (float64, error) {
+	if m.CurrentCelsiusFunc != nil {
+		return m.CurrentCelsiusFunc()
+	}
+	return 55.0, nil // Default: 55°C
+}
+
+// MockLoadAvg is a mock implementation of LoadAvgProvider for testing
+type MockLoadAvg struct {
+	LoadAvgFunc func() (LoadAvgStat, error)
+}
+
+// LoadAvg calls the mock function if set, otherwise returns defaults
+func (m *MockLoadAvg) LoadAvg() (LoadAvgStat, error) {
+	if m.LoadAvgFunc != nil {
+		return m.LoadAvgFunc()
+	}
+	return LoadAvgStat{Load1: 1.5, Load5: 1.0, Load15: 0.5}, nil
+}
+
 // MockMemory is a mock implementation of MemoryProvider for testing
 type MockMemory struct {
-	UsedPercentFunc func() (float64, error)
+	VirtualMemoryFunc func() (MemoryStat, error)
+	SwapMemoryFunc    func() (MemoryStat, error)
 }
 
-// UsedPercent calls the mock function if set, otherwise returns default
-func (m *MockMemory) UsedPercent() (float64, error) {
-	if m.UsedPercentFunc != nil {
-		return m.UsedPercentFunc()
+// VirtualMemory calls the mock function if set, otherwise returns default
+func (m *MockMemory) VirtualMemory() (MemoryStat, error) {
+	if m.VirtualMemoryFunc != nil {
+		return m.VirtualMemoryFunc()
 	}
-	return 65.0, nil // Default: 65% used
+	return MemoryStat{Total: 16 << 30, Used: 10 << 30, UsedPercent: 62.5}, nil // Default: 10 of 16 GiB used
+}
+
+// SwapMemory calls the mock function if set, otherwise returns default
+func (m *MockMemory) SwapMemory() (MemoryStat, error) {
+	if m.SwapMemoryFunc != nil {
+		return m.SwapMemoryFunc()
+	}
+	return MemoryStat{Total: 4 << 30, Used: 1 << 30, UsedPercent: 25}, nil // Default: 1 of 4 GiB used
 }
 
 // MockNetwork is a mock implementation of NetworkProvider for testing
@@ -56,6 +104,19 @@ func (m *MockNetwork) IOCounters() ([]NetworkStat, error) {
 	}, nil
 }
 
+// MockDefaultRoute is a mock implementation of DefaultRouteProvider for testing
+type MockDefaultRoute struct {
+	DefaultRouteInterfaceFunc func() (string, error)
+}
+
+// DefaultRouteInterface calls the mock function if set, otherwise returns default
+func (m *MockDefaultRoute) DefaultRouteInterface() (string, error) {
+	if m.DefaultRouteInterfaceFunc != nil {
+		return m.DefaultRouteInterfaceFunc()
+	}
+	return "eth0", nil
+}
+
 // MockDisk is a mock implementation of DiskProvider for testing
 type MockDisk struct {
 	IOCountersFunc func() (map[string]DiskStat, error)
@@ -72,6 +133,50 @@ func (m *MockDisk) IOCounters() (map[string]DiskStat, error) {
 	}, nil
 }
 
+// MockDiskUsage is a mock implementation of DiskUsageProvider for testing
+type MockDiskUsage struct {
+	UsageFunc func(path string) (DiskUsageStat, error)
+}
+
+// Usage calls the mock function if set, otherwise returns defaults
+func (m *MockDiskUsage) Usage(path string) (DiskUsageStat, error) {
+	if m.UsageFunc != nil {
+		return m.UsageFunc(path)
+	}
+	return DiskUsageStat{
+		Total:       500 << 30,
+		Free:        200 << 30,
+		Used:        300 << 30,
+		UsedPercent: 60.0,
+	}, nil
+}
+
+// MockProcessIO is a mock implementation of ProcessIOProvider for testing
+type MockProcessIO struct {
+	ProcessIOFunc func(match string) (DiskStat, error)
+}
+
+// ProcessIO calls the mock function if set, otherwise returns defaults
+func (m *MockProcessIO) ProcessIO(match string) (DiskStat, error) {
+	if m.ProcessIOFunc != nil {
+		return m.ProcessIOFunc(match)
+	}
+	return DiskStat{Name: match, ReadBytes: 300000, WriteBytes: 100000}, nil
+}
+
+// MockProcessUsage is a mock implementation of ProcessUsageProvider for testing
+type MockProcessUsage struct {
+	ProcessUsageFunc func(name string, pid int32) (ProcessUsageStat, error)
+}
+
+// ProcessUsage calls the mock function if set, otherwise returns defaults
+func (m *MockProcessUsage) ProcessUsage(name string, pid int32) (ProcessUsageStat, error) {
+	if m.ProcessUsageFunc != nil {
+		return m.ProcessUsageFunc(name, pid)
+	}
+	return ProcessUsageStat{Count: 1, CPUPercent: 25.0, MemoryBytes: 1 << 30, MemoryPercent: 12.5}, nil
+}
+
 // MockHWMon is a mock implementation of HWMonProvider for testing
 type MockHWMon struct {
 	SensorsFunc func() ([]HWMonStat, error)
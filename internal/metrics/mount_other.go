@@ -0,0 +1,60 @@
+//go:build !windows
+
+package metrics
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shirou/gopsutil/v4/disk"
+)
+
+// IsMountPath reports whether a disk name is a mount point (an absolute path)
+// rather than a device name such as "sda" or "nvme0n1"
+func IsMountPath(name string) bool {
+	return strings.HasPrefix(name, "/")
+}
+
+// DiskDeviceForMount returns the DiskProvider device name of the partition holding
+// the path mount, e.g. "sda2" for "/home"
+func DiskDeviceForMount(mount string) (string, error) {
+	partitions, err := disk.Partitions(false)
+	if err != nil {
+		return "", err
+	}
+	return mountDevice(mount, partitions, filepath.EvalSymlinks)
+}
+
+// mountDevice finds the partition with the longest mount point containing path.
+// Device paths are resolved through symlinks, so "/dev/mapper/vg-root" becomes "dm-0"
+// as the kernel names it in I/O statistics.
+func mountDevice(path string, partitions []disk.PartitionStat, evalSymlinks func(string) (string, error)) (string, error) {
+	path = filepath.Clean(path)
+	best := -1
+	for i, p := range partitions {
+		if !pathWithin(path, p.Mountpoint) || !strings.HasPrefix(p.Device, "/dev/") {
+			continue
+		}
+		if best < 0 || len(p.Mountpoint) > len(partitions[best].Mountpoint) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return "", fmt.Errorf("no disk partition is mounted at %s", path)
+	}
+
+	device := partitions[best].Device
+	if resolved, err := evalSymlinks(device); err == nil {
+		device = resolved
+	}
+	return filepath.Base(device), nil
+}
+
+// pathWithin reports whether path is mount or lies below it
+func pathWithin(path, mount string) bool {
+	if mount == "/" || path == mount {
+		return true
+	}
+	return strings.HasPrefix(path, mount+"/")
+}
@@ -0,0 +1,62 @@
+//go:build !windows
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/disk"
+)
+
+func TestIsMountPath(t *testing.T) {
+	for name, want := range map[string]bool{
+		"/":         true,
+		"/home":     true,
+		"sda":       false,
+		"nvme0n1p2": false,
+		"":          false,
+	} {
+		if got := IsMountPath(name); got != want {
+			t.Errorf("IsMountPath(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestMountDevice(t *testing.T) {
+	partitions := []disk.PartitionStat{
+		{Device: "/dev/mapper/vg-root", Mountpoint: "/"},
+		{Device: "/dev/sda1", Mountpoint: "/boot"},
+		{Device: "/dev/nvme0n1p3", Mountpoint: "/home"},
+		{Device: "tmpfs", Mountpoint: "/home/user/tmp"},
+	}
+	evalSymlinks := func(path string) (string, error) {
+		if path == "/dev/mapper/vg-root" {
+			return "/dev/dm-0", nil
+		}
+		return "", errors.New("not a symlink")
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/", "dm-0"},
+		{"/boot", "sda1"},
+		{"/boot/", "sda1"},
+		{"/bootstrap", "dm-0"},
+		{"/home", "nvme0n1p3"},
+		{"/home/user/tmp", "nvme0n1p3"},
+		{"/var/log", "dm-0"},
+	}
+	for _, tt := range tests {
+		got, err := mountDevice(tt.path, partitions, evalSymlinks)
+		if err != nil || got != tt.want {
+			t.Errorf("mountDevice(%q) = %q, %v, want %q", tt.path, got, err, tt.want)
+		}
+	}
+
+	if got, err := mountDevice("/home", partitions[3:], evalSymlinks); err == nil {
+		t.Errorf("mountDevice() without a disk partition = %q, want error", got)
+	}
+}
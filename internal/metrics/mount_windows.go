@@ -0,0 +1,26 @@
+//go:build windows
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsMountPath reports whether a disk name is a drive letter mount such as "c:" or "D:\"
+func IsMountPath(name string) bool {
+	if len(name) < 2 || name[1] != ':' {
+		return false
+	}
+	letter := name[0] | 0x20
+	return letter >= 'a' && letter <= 'z' && (len(name) == 2 || name[2:] == `\` || name[2:] == "/")
+}
+
+// DiskDeviceForMount returns the DiskProvider device name of a drive letter mount.
+// Disk statistics are reported per drive letter, so "d:\" becomes "D:".
+func DiskDeviceForMount(mount string) (string, error) {
+	if !IsMountPath(mount) {
+		return "", fmt.Errorf("%s is not a drive letter", mount)
+	}
+	return strings.ToUpper(mount[:1]) + ":", nil
+}
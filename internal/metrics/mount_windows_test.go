@@ -0,0 +1,25 @@
+//go:build windows
+
+package metrics
+
+import "testing"
+
+func TestDiskDeviceForMount(t *testing.T) {
+	for mount, want := range map[string]string{
+		"C:":  "C:",
+		"c:":  "C:",
+		`d:\`: "D:",
+		"E:/": "E:",
+	} {
+		got, err := DiskDeviceForMount(mount)
+		if err != nil || got != want {
+			t.Errorf("DiskDeviceForMount(%q) = %q, %v, want %q", mount, got, err, want)
+		}
+	}
+
+	for _, name := range []string{"PhysicalDrive0", "C:\\Users", "1:", ""} {
+		if IsMountPath(name) {
+			t.Errorf("IsMountPath(%q) = true, want false", name)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+//go:build windows
+
+package metrics
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// PDH (Performance Data Helper) bindings shared by the Windows providers
+
+var (
+	pdhDLL                       = syscall.NewLazyDLL("pdh.dll")
+	pdhOpenQuery                 = pdhDLL.NewProc("PdhOpenQueryW")
+	pdhAddEnglishCounter         = pdhDLL.NewProc("PdhAddEnglishCounterW")
+	pdhCollectQueryData          = pdhDLL.NewProc("PdhCollectQueryData")
+	pdhGetFormattedCounterValue  = pdhDLL.NewProc("PdhGetFormattedCounterValue")
+	pdhGetFormattedCounterArrayW = pdhDLL.NewProc("PdhGetFormattedCounterArrayW")
+)
+
+const (
+	pdhFmtDouble      = 0x00000200
+	pdhFmtNoCap100    = 0x00008000 // Don't clamp percentages of multi-core counters to 100
+	pdhMoreData       = 0x800007D2
+	pdhCstatValidData = 0x00000000
+)
+
+// pdhFmtCounterValueDouble matches PDH_FMT_COUNTERVALUE with a double value
+type pdhFmtCounterValueDouble struct {
+	CStatus     uint32
+	doubleValue float64
+}
+
+// pdhFmtCounterValueItemDouble matches PDH_FMT_COUNTERVALUE_ITEM with a double value
+type pdhFmtCounterValueItemDouble struct {
+	szName   *uint16
+	FmtValue pdhFmtCounterValueDouble
+}
+
+// openPDHQuery opens a PDH query
+func openPDHQuery() (uintptr, error) {
+	var queryHandle uintptr
+	ret, _, _ := pdhOpenQuery.Call(0, 0, uintptr(unsafe.Pointer(&queryHandle)))
+	if ret != 0 {
+		return 0, fmt.Errorf("PdhOpenQuery failed: 0x%x", ret)
+	}
+	return queryHandle, nil
+}
+
+// addPDHCounter adds a counter to the query by its English path
+func addPDHCounter(queryHandle uintptr, path string) (uintptr, error) {
+	pathPtr, _ := syscall.UTF16PtrFromString(path)
+	var counterHandle uintptr
+	ret, _, _ := pdhAddEnglishCounter.Call(
+		queryHandle,
+		uintptr(unsafe.Pointer(pathPtr)),
+		0,
+		uintptr(unsafe.Pointer(&counterHandle)),
+	)
+	if ret != 0 {
+		return 0, fmt.Errorf("PdhAddEnglishCounter(%s) failed: 0x%x", path, ret)
+	}
+	return counterHandle, nil
+}
+
+// formattedCounterValue reads the last collected value of a counter as a double
+func formattedCounterValue(counter uintptr) (float64, bool) {
+	var value pdhFmtCounterValueDouble
+	ret, _, _ := pdhGetFormattedCounterValue.Call(
+		counter,
+		pdhFmtDouble,
+		0,
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if ret != 0 || value.CStatus != pdhCstatValidData {
+		return 0, false
+	}
+	return value.doubleValue, true
+}
+
+// formattedCounterArray reads the last collected values of a wildcard counter,
+// keyed by instance name. Instances without valid data are skipped.
+func formattedCounterArray(counter uintptr, format uint32) map[string]float64 {
+	var bufferSize, itemCount uint32
+	ret, _, _ := pdhGetFormattedCounterArrayW.Call(
+		counter,
+		uintptr(format),
+		uintptr(unsafe.Pointer(&bufferSize)),
+		uintptr(unsafe.Pointer(&itemCount)),
+		0,
+	)
+	if (ret != pdhMoreData && ret != 0) || bufferSize == 0 || itemCount == 0 {
+		return nil
+	}
+
+	buffer := make([]byte, bufferSize)
+	ret, _, _ = pdhGetFormattedCounterArrayW.Call(
+		counter,
+		uintptr(format),
+		uintptr(unsafe.Pointer(&bufferSize)),
+		uintptr(unsafe.Pointer(&itemCount)),
+		uintptr(unsafe.Pointer(&buffer[0])),
+	)
+	if ret != 0 {
+		return nil
+	}
+
+	values := make(map[string]float64, itemCount)
+	itemSize := unsafe.Sizeof(pdhFmtCounterValueItemDouble{})
+	bufEnd := uintptr(unsafe.Pointer(&buffer[0])) + uintptr(len(buffer))
+	for i := uint32(0); i < itemCount; i++ {
+		item := (*pdhFmtCounterValueItemDouble)(unsafe.Pointer(&buffer[uintptr(i)*itemSize]))
+		if item.szName == nil || item.FmtValue.CStatus != pdhCstatValidData {
+			continue
+		}
+		// szName points within buffer; bound the slice to not exceed the allocation
+		nameAddr := uintptr(unsafe.Pointer(item.szName))
+		maxChars := min(int((bufEnd-nameAddr)/2), 256) // uint16 = 2 bytes
+		if maxChars <= 0 {
+			continue
+		}
+		values[syscall.UTF16ToString(unsafe.Slice(item.szName, maxChars))] = item.FmtValue.doubleValue
+	}
+	return values
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/shirou/gopsutil/v4/mem"
+	"github.com/shirou/gopsutil/v4/process"
+)
+
+// ErrProcessIOUnavailable is returned when processes cannot be enumerated on this system
+var ErrProcessIOUnavailable = errors.New("process I/O counters are not available")
+
+// ErrProcessUsageUnavailable is returned when process CPU and memory usage cannot be read
+var ErrProcessUsageUnavailable = errors.New("process usage counters are not available")
+
+// ErrProcessNotFound is returned by ProcessUsageProvider when no running process matches
+var ErrProcessNotFound = errors.New("process not found")
+
+// GopsutilProcessIO implements ProcessIOProvider using gopsutil.
+// Raw per-process counters start from zero for every new process and vanish
+// when a process exits, so the provider accumulates per-PID deltas instead of
+// summing raw values. Bytes a process transferred before it was first seen are
+// not counted. Each widget should use its own instance.
+type GopsutilProcessIO struct {
+	mu    sync.Mutex
+	last  map[int32]DiskStat
+	total DiskStat
+}
+
+// NewGopsutilProcessIO creates a new gopsutil-based process I/O provider
+func NewGopsutilProcessIO() *GopsutilProcessIO {
+	return &GopsutilProcessIO{last: make(map[int32]DiskStat)}
+}
+
+// ProcessIO returns cumulative I/O of processes whose name contains match (case-insensitive).
+// Processes whose counters cannot be read (e.g. access denied) are skipped.
+func (g *GopsutilProcessIO) ProcessIO(match string) (DiskStat, error) {
+	procs, err := process.Processes()
+	if err != nil {
+		return DiskStat{}, fmt.Errorf("%w: %v", ErrProcessIOUnavailable, err)
+	}
+
+	samples := make(map[int32]DiskStat)
+	for _, p := range procs {
+		name, err := p.Name()
+		if err != nil || !matchProcessName(name, match) {
+			continue
+		}
+		io, err := p.IOCounters()
+		if err != nil || io == nil {
+			continue
+		}
+		samples[p.Pid] = DiskStat{Name: name, ReadBytes: io.ReadBytes, WriteBytes: io.WriteBytes}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.accumulate(match, samples), nil
+}
+
+// accumulate adds the growth of every process seen in the previous sample to the
+// running total and stores samples as the new baseline
+func (g *GopsutilProcessIO) accumulate(match string, samples map[int32]DiskStat) DiskStat {
+	for pid, s := range samples {
+		prev, ok := g.last[pid]
+		// A PID reused by a new process shows up as counters going backwards
+		if !ok || s.ReadBytes < prev.ReadBytes || s.WriteBytes < prev.WriteBytes {
+			continue
+		}
+		g.total.ReadBytes += s.ReadBytes - prev.ReadBytes
+		g.total.WriteBytes += s.WriteBytes - prev.WriteBytes
+	}
+	g.last = samples
+	g.total.Name = match
+	return g.total
+}
+
+// matchProcessName reports whether name contains match, ignoring case.
+// An empty match never matches so a missing matcher does not select every process.
+func matchProcessName(name, match string) bool {
+	match = strings.TrimSpace(match)
+	if match == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(name), strings.ToLower(match))
+}
+
+// newProcessUsageStat builds a ProcessUsageStat from readings summed over matched processes.
+// cpuPercent is in per-core units (100 = one core fully busy) and is scaled to total capacity.
+func newProcessUsageStat(count int, cpuPercent float64, memoryBytes uint64) ProcessUsageStat {
+	stat := ProcessUsageStat{
+		Count:       count,
+		CPUPercent:  min(max(cpuPercent/float64(runtime.NumCPU()), 0), 100),
+		MemoryBytes: memoryBytes,
+	}
+	if vm, err := mem.VirtualMemory(); err == nil && vm.Total > 0 {
+		stat.MemoryPercent = min(float64(memoryBytes)/float64(vm.Total)*100, 100)
+	}
+	return stat
+}
+
+// trimInstanceIndex strips the "#N" suffix Windows performance counters append
+// to instances of processes sharing a name (e.g. "chrome#3" -> "chrome")
+func trimInstanceIndex(instance string) string {
+	if i := strings.LastIndexByte(instance, '#'); i > 0 {
+		return instance[:i]
+	}
+	return instance
+}
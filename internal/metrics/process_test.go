@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatchProcessName(t *testing.T) {
+	tests := []struct {
+		name  string
+		match string
+		want  bool
+	}{
+		{"chrome.exe", "chrome", true},
+		{"Chrome.exe", "CHROME.EXE", true},
+		{"firefox", "fox", true},
+		{"firefox", "chrome", false},
+		{"firefox", "", false},
+		{"firefox", "  ", false},
+	}
+	for _, tt := range tests {
+		if got := matchProcessName(tt.name, tt.match); got != tt.want {
+			t.Errorf("matchProcessName(%q, %q) = %v, want %v", tt.name, tt.match, got, tt.want)
+		}
+	}
+}
+
+func TestGopsutilProcessIO_Accumulate(t *testing.T) {
+	g := NewGopsutilProcessIO()
+
+	// First sample only establishes baselines
+	got := g.accumulate("app", map[int32]DiskStat{
+		1: {ReadBytes: 1000, WriteBytes: 500},
+	})
+	if got.ReadBytes != 0 || got.WriteBytes != 0 {
+		t.Fatalf("first sample = %+v, want zero counters", got)
+	}
+
+	// Existing process grows, a new process appears with prior history
+	got = g.accumulate("app", map[int32]DiskStat{
+		1: {ReadBytes: 1600, WriteBytes: 700},
+		2: {ReadBytes: 9000, WriteBytes: 9000},
+	})
+	if got.ReadBytes != 600 || got.WriteBytes != 200 {
+		t.Errorf("second sample = %+v, want read 600 write 200", got)
+	}
+
+	// Process 1 exits: the total must not drop
+	got = g.accumulate("app", map[int32]DiskStat{
+		2: {ReadBytes: 9100, WriteBytes: 9050},
+	})
+	if got.ReadBytes != 700 || got.WriteBytes != 250 {
+		t.Errorf("after exit = %+v, want read 700 write 250", got)
+	}
+
+	// PID 2 reused by a new process with smaller counters is treated as a fresh baseline
+	got = g.accumulate("app", map[int32]DiskStat{
+		2: {ReadBytes: 10, WriteBytes: 10},
+	})
+	if got.ReadBytes != 700 || got.WriteBytes != 250 {
+		t.Errorf("after PID reuse = %+v, want read 700 write 250", got)
+	}
+	if got.Name != "app" {
+		t.Errorf("Name = %q, want app", got.Name)
+	}
+}
+
+func TestMockProcessIO(t *testing.T) {
+	mock := &MockProcessIO{}
+	stat, err := mock.ProcessIO("app")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stat.Name != "app" || stat.ReadBytes == 0 {
+		t.Errorf("default stat = %+v", stat)
+	}
+}
+
+func TestTrimInstanceIndex(t *testing.T) {
+	tests := map[string]string{
+		"chrome":    "chrome",
+		"chrome#12": "chrome",
+		"a#b#3":     "a#b",
+		"#1":        "#1",
+	}
+	for instance, want := range tests {
+		if got := trimInstanceIndex(instance); got != want {
+			t.Errorf("trimInstanceIndex(%q) = %q, want %q", instance, got, want)
+		}
+	}
+}
+
+func TestNewProcessUsageStat(t *testing.T) {
+	stat := newProcessUsageStat(2, 1e9, 1024)
+	if stat.Count != 2 || stat.MemoryBytes != 1024 {
+		t.Errorf("Count = %d, MemoryBytes = %d, want 2, 1024", stat.Count, stat.MemoryBytes)
+	}
+	if stat.CPUPercent != 100 {
+		t.Errorf("CPUPercent = %v, want clamped to 100", stat.CPUPercent)
+	}
+	if stat.MemoryPercent < 0 || stat.MemoryPercent > 100 {
+		t.Errorf("MemoryPercent = %v, want 0-100", stat.MemoryPercent)
+	}
+}
+
+func TestProcessUsage_NotFound(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	_, err := NewProcessUsage().ProcessUsage("no-such-process-steelclock", 0)
+	if !errors.Is(err, ErrProcessNotFound) && !errors.Is(err, ErrProcessUsageUnavailable) {
+		t.Errorf("ProcessUsage() error = %v, want ErrProcessNotFound", err)
+	}
+}
@@ -0,0 +1,70 @@
+//go:build !windows
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/shirou/gopsutil/v4/process"
+)
+
+// ProcessUsage implements ProcessUsageProvider using gopsutil.
+// Process handles are kept between calls because gopsutil measures CPU usage
+// since the previous Percent call on the same handle.
+type ProcessUsage struct {
+	mu    sync.Mutex
+	procs map[int32]*process.Process
+}
+
+// NewProcessUsage creates a process usage provider.
+// Each widget should use its own instance.
+func NewProcessUsage() *ProcessUsage {
+	return &ProcessUsage{procs: make(map[int32]*process.Process)}
+}
+
+// ProcessUsage returns the combined usage of processes matching name or pid.
+// CPU usage of a newly seen process reads 0 until the next call.
+func (p *ProcessUsage) ProcessUsage(name string, pid int32) (ProcessUsageStat, error) {
+	pids, err := process.Pids()
+	if err != nil {
+		return ProcessUsageStat{}, ErrProcessUsageUnavailable
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	seen := make(map[int32]*process.Process)
+	cpuSum := 0.0
+	var memory uint64
+	for _, id := range pids {
+		if pid > 0 && id != pid {
+			continue
+		}
+		proc, ok := p.procs[id]
+		if !ok {
+			if proc, err = process.NewProcess(id); err != nil {
+				continue
+			}
+		}
+		if pid <= 0 {
+			procName, err := proc.Name()
+			if err != nil || !matchProcessName(procName, name) {
+				continue
+			}
+		}
+		seen[id] = proc
+
+		if percent, err := proc.Percent(0); err == nil {
+			cpuSum += percent
+		}
+		if info, err := proc.MemoryInfo(); err == nil && info != nil {
+			memory += info.RSS
+		}
+	}
+	p.procs = seen
+
+	if len(seen) == 0 {
+		return ProcessUsageStat{}, ErrProcessNotFound
+	}
+	return newProcessUsageStat(len(seen), cpuSum, memory), nil
+}
@@ -1,6 +1,9 @@
 package metrics
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 // Metrics providers abstract system metrics collection for widgets.
 //
@@ -38,10 +41,41 @@ type CPUProvider interface {
 	Percent(interval time.Duration, perCore bool) ([]float64, error)
 }
 
+// ErrCPUFrequencyUnavailable is returned by CPUFrequencyProvider when the current
+// clock speed can't be read on this platform
+var ErrCPUFrequencyUnavailable = errors.New("cpu frequency unavailable")
+
+// CPUFrequencyProvider abstracts CPU clock speed collection
+type CPUFrequencyProvider interface {
+	// CurrentMHz returns the current clock speed averaged over all cores, in MHz.
+	// Returns ErrCPUFrequencyUnavailable when the platform doesn't expose it.
+	CurrentMHz() (float64, error)
+}
+
+// ErrCPUTemperatureUnavailable is returned by CPUTemperatureProvider when no CPU
+// temperature sensor can be read on this platform
+var ErrCPUTemperatureUnavailable = errors.New("cpu temperature unavailable")
+
+// CPUTemperatureProvider abstracts CPU temperature collection
+type CPUTemperatureProvider interface {
+	// CurrentCelsius returns the CPU package temperature in degrees Celsius.
+	// Returns ErrCPUTemperatureUnavailable when the platform doesn't expose it.
+	CurrentCelsius() (float64, error)
+}
+
+// LoadAvgProvider abstracts system load average collection
+type LoadAvgProvider interface {
+	// LoadAvg returns the 1, 5 and 15 minute load averages.
+	LoadAvg() (LoadAvgStat, error)
+}
+
 // MemoryProvider abstracts memory metrics collection
 type MemoryProvider interface {
-	// UsedPercent returns the percentage of memory currently in use.
-	UsedPercent() (float64, error)
+	// VirtualMemory returns physical memory usage.
+	VirtualMemory() (MemoryStat, error)
+
+	// SwapMemory returns swap usage (the pagefile on Windows).
+	SwapMemory() (MemoryStat, error)
 }
 
 // NetworkProvider abstracts network I/O metrics collection
@@ -50,12 +84,45 @@ type NetworkProvider interface {
 	IOCounters() ([]NetworkStat, error)
 }
 
+// ErrDefaultRouteUnavailable is returned by DefaultRouteProvider when no interface
+// carries the default route, e.g. while disconnected
+var ErrDefaultRouteUnavailable = errors.New("default route unavailable")
+
+// DefaultRouteProvider resolves the network interface carrying the default route
+type DefaultRouteProvider interface {
+	// DefaultRouteInterface returns the name of the interface outgoing traffic
+	// is sent through, as reported by NetworkProvider.
+	DefaultRouteInterface() (string, error)
+}
+
 // DiskProvider abstracts disk I/O metrics collection
 type DiskProvider interface {
 	// IOCounters returns disk I/O statistics for all devices.
 	IOCounters() (map[string]DiskStat, error)
 }
 
+// DiskUsageProvider abstracts file system capacity collection
+type DiskUsageProvider interface {
+	// Usage returns the capacity of the file system holding path
+	// (a mount point such as "/home" or a drive such as "C:").
+	Usage(path string) (DiskUsageStat, error)
+}
+
+// ProcessIOProvider abstracts per-process I/O metrics collection
+type ProcessIOProvider interface {
+	// ProcessIO returns cumulative I/O of all processes whose name matches.
+	// Counters never decrease, even when matching processes start or exit.
+	ProcessIO(match string) (DiskStat, error)
+}
+
+// ProcessUsageProvider abstracts per-process CPU and memory collection
+type ProcessUsageProvider interface {
+	// ProcessUsage returns the combined usage of all processes whose name contains
+	// name (case-insensitive), or of the process with the given pid when pid is non-zero.
+	// Returns ErrProcessNotFound when no process matches.
+	ProcessUsage(name string, pid int32) (ProcessUsageStat, error)
+}
+
 // HWMonStat represents a single hardware sensor reading from LHM/OHM.
 type HWMonStat struct {
 	SensorID string  // Unique sensor path (e.g., "/amdcpu/0/temperature/2")
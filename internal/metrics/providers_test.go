@@ -122,45 +122,56 @@ func TestMockCPU_Percent(t *testing.T) {
 	})
 }
 
-func TestMockMemory_UsedPercent(t *testing.T) {
+func TestMockMemory_VirtualMemory(t *testing.T) {
 	t.Run("default value", func(t *testing.T) {
 		mock := &MockMemory{}
-		percent, err := mock.UsedPercent()
+		stat, err := mock.VirtualMemory()
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
-		if percent != 65.0 {
-			t.Errorf("expected 65.0, got %f", percent)
+		if stat.UsedPercent != 62.5 || stat.Total != 16<<30 {
+			t.Errorf("expected 62.5%% of 16 GiB, got %+v", stat)
 		}
 	})
 
 	t.Run("custom function", func(t *testing.T) {
 		mock := &MockMemory{
-			UsedPercentFunc: func() (float64, error) {
-				return 80.5, nil
+			VirtualMemoryFunc: func() (MemoryStat, error) {
+				return MemoryStat{UsedPercent: 80.5}, nil
 			},
 		}
-		percent, _ := mock.UsedPercent()
-		if percent != 80.5 {
-			t.Errorf("expected 80.5, got %f", percent)
+		stat, _ := mock.VirtualMemory()
+		if stat.UsedPercent != 80.5 {
+			t.Errorf("expected 80.5, got %f", stat.UsedPercent)
 		}
 	})
 
 	t.Run("returns error", func(t *testing.T) {
 		expectedErr := errors.New("memory error")
 		mock := &MockMemory{
-			UsedPercentFunc: func() (float64, error) {
-				return 0, expectedErr
+			VirtualMemoryFunc: func() (MemoryStat, error) {
+				return MemoryStat{}, expectedErr
 			},
 		}
 
-		_, err := mock.UsedPercent()
+		_, err := mock.VirtualMemory()
 		if !errors.Is(err, expectedErr) {
 			t.Errorf("expected error %v, got %v", expectedErr, err)
 		}
 	})
 }
 
+func TestMockMemory_SwapMemory(t *testing.T) {
+	mock := &MockMemory{}
+	stat, err := mock.SwapMemory()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if stat.UsedPercent != 25 || stat.Total != 4<<30 {
+		t.Errorf("expected 25%% of 4 GiB, got %+v", stat)
+	}
+}
+
 func TestMockNetwork_IOCounters(t *testing.T) {
 	t.Run("default values", func(t *testing.T) {
 		mock := &MockNetwork{}
@@ -258,14 +269,35 @@ func TestInterfaceImplementation(t *testing.T) {
 	var _ CPUProvider = &MockCPU{}
 	var _ CPUProvider = &GopsutilCPU{}
 
+	var _ CPUFrequencyProvider = &MockCPUFrequency{}
+	var _ CPUFrequencyProvider = NewCPUFrequency()
+
+	var _ CPUTemperatureProvider = &MockCPUTemperature{}
+	var _ CPUTemperatureProvider = &GopsutilCPUTemperature{}
+
+	var _ LoadAvgProvider = &MockLoadAvg{}
+	var _ LoadAvgProvider = &GopsutilLoad{}
+
 	var _ MemoryProvider = &MockMemory{}
 	var _ MemoryProvider = &GopsutilMemory{}
 
 	var _ NetworkProvider = &MockNetwork{}
 	var _ NetworkProvider = &GopsutilNetwork{}
 
+	var _ DefaultRouteProvider = &MockDefaultRoute{}
+	var _ DefaultRouteProvider = NewSystemRoute()
+
 	var _ DiskProvider = &MockDisk{}
 	var _ DiskProvider = &GopsutilDisk{}
+
+	var _ DiskUsageProvider = &MockDiskUsage{}
+	var _ DiskUsageProvider = &GopsutilDiskUsage{}
+
+	var _ ProcessIOProvider = &MockProcessIO{}
+	var _ ProcessIOProvider = &GopsutilProcessIO{}
+
+	var _ ProcessUsageProvider = &MockProcessUsage{}
+	var _ ProcessUsageProvider = NewProcessUsage()
 }
 
 // Integration tests for gopsutil implementations
@@ -315,18 +347,35 @@ func TestGopsutilCPU_Percent(t *testing.T) {
 	}
 }
 
-func TestGopsutilMemory_UsedPercent(t *testing.T) {
+func TestGopsutilMemory_VirtualMemory(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode")
 	}
 
 	mem := NewGopsutilMemory()
-	percent, err := mem.UsedPercent()
+	stat, err := mem.VirtualMemory()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stat.UsedPercent < 0 || stat.UsedPercent > 100 {
+		t.Errorf("expected percentage between 0-100, got %f", stat.UsedPercent)
+	}
+	if stat.Total == 0 || stat.Used > stat.Total {
+		t.Errorf("implausible memory sizes: %+v", stat)
+	}
+}
+
+func TestGopsutilMemory_SwapMemory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	stat, err := NewGopsutilMemory().SwapMemory()
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if percent < 0 || percent > 100 {
-		t.Errorf("expected percentage between 0-100, got %f", percent)
+	if stat.UsedPercent < 0 || stat.UsedPercent > 100 {
+		t.Errorf("expected percentage between 0-100, got %f", stat.UsedPercent)
 	}
 }
 
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"net"
+)
+
+// routeProbeAddrs are documentation-range addresses (RFC 5737, RFC 3849) used to ask
+// the OS which local address it would send outgoing traffic from. Connecting a UDP
+// socket only selects the route; no packets are sent.
+var routeProbeAddrs = []string{"198.51.100.1:9", "[2001:db8::1]:9"}
+
+// SystemRoute implements DefaultRouteProvider using the OS routing table
+type SystemRoute struct{}
+
+// NewSystemRoute creates a default route provider
+func NewSystemRoute() *SystemRoute {
+	return &SystemRoute{}
+}
+
+// DefaultRouteInterface returns the interface owning the local address the OS picks
+// for outgoing traffic. IPv4 is tried first, then IPv6.
+func (s *SystemRoute) DefaultRouteInterface() (string, error) {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return "", err
+	}
+	addrs := make(map[string][]net.Addr, len(interfaces))
+	for _, iface := range interfaces {
+		if list, err := iface.Addrs(); err == nil {
+			addrs[iface.Name] = list
+		}
+	}
+
+	for _, probe := range routeProbeAddrs {
+		conn, err := net.Dial("udp", probe)
+		if err != nil {
+			continue
+		}
+		local, ok := conn.LocalAddr().(*net.UDPAddr)
+		_ = conn.Close()
+		if !ok {
+			continue
+		}
+		if name, ok := interfaceWithIP(addrs, local.IP); ok {
+			return name, nil
+		}
+	}
+	return "", ErrDefaultRouteUnavailable
+}
+
+// interfaceWithIP returns the name of the interface that has the address ip
+func interfaceWithIP(addrs map[string][]net.Addr, ip net.IP) (string, bool) {
+	if ip == nil || ip.IsUnspecified() || ip.IsLoopback() {
+		return "", false
+	}
+	for name, list := range addrs {
+		for _, addr := range list {
+			var ifaceIP net.IP
+			switch a := addr.(type) {
+			case *net.IPNet:
+				ifaceIP = a.IP
+			case *net.IPAddr:
+				ifaceIP = a.IP
+			}
+			if ifaceIP.Equal(ip) {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
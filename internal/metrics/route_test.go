@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestInterfaceWithIP(t *testing.T) {
+	addrs := map[string][]net.Addr{
+		"lo": {&net.IPNet{IP: net.ParseIP("127.0.0.1"), Mask: net.CIDRMask(8, 32)}},
+		"eth0": {
+			&net.IPNet{IP: net.ParseIP("192.168.1.10"), Mask: net.CIDRMask(24, 32)},
+			&net.IPNet{IP: net.ParseIP("fe80::1"), Mask: net.CIDRMask(64, 128)},
+		},
+		"wlan0": {&net.IPAddr{IP: net.ParseIP("10.0.0.5")}},
+		"tun0":  {&net.IPNet{IP: net.ParseIP("2001:db8::5"), Mask: net.CIDRMask(64, 128)}},
+	}
+
+	tests := []struct {
+		ip     string
+		want   string
+		wantOK bool
+	}{
+		{"192.168.1.10", "eth0", true},
+		{"10.0.0.5", "wlan0", true},
+		{"2001:db8::5", "tun0", true},
+		{"172.16.0.1", "", false},
+		{"127.0.0.1", "", false},
+		{"0.0.0.0", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := interfaceWithIP(addrs, net.ParseIP(tt.ip))
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("interfaceWithIP(%s) = %q, %v, want %q, %v", tt.ip, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if _, ok := interfaceWithIP(addrs, nil); ok {
+		t.Error("interfaceWithIP(nil) found an interface")
+	}
+}
+
+func TestSystemRoute(t *testing.T) {
+	// The result depends on the host: either a configured interface or no route
+	name, err := NewSystemRoute().DefaultRouteInterface()
+	if err != nil {
+		if !errors.Is(err, ErrDefaultRouteUnavailable) {
+			t.Logf("DefaultRouteInterface() error = %v", err)
+		}
+		return
+	}
+	if _, err := net.InterfaceByName(name); err != nil {
+		t.Errorf("DefaultRouteInterface() = %q, not an interface: %v", name, err)
+	}
+}
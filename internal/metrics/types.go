@@ -13,3 +13,33 @@ type DiskStat struct {
 	ReadBytes  uint64 // Total bytes read
 	WriteBytes uint64 // Total bytes written
 }
+
+// DiskUsageStat represents the capacity of the file system holding a path
+type DiskUsageStat struct {
+	Total       uint64  // Total bytes
+	Free        uint64  // Bytes available
+	Used        uint64  // Bytes in use
+	UsedPercent float64 // Share in use (0-100)
+}
+
+// MemoryStat represents usage of physical memory or swap
+type MemoryStat struct {
+	Total       uint64  // Total bytes
+	Used        uint64  // Bytes in use
+	UsedPercent float64 // Share in use (0-100)
+}
+
+// LoadAvgStat represents system load averages
+type LoadAvgStat struct {
+	Load1  float64 // 1-minute load average
+	Load5  float64 // 5-minute load average
+	Load15 float64 // 15-minute load average
+}
+
+// ProcessUsageStat represents combined resource usage of matched processes
+type ProcessUsageStat struct {
+	Count         int     // Number of matched processes
+	CPUPercent    float64 // Share of total CPU capacity (0-100)
+	MemoryBytes   uint64  // Resident memory (working set)
+	MemoryPercent float64 // Share of physical memory (0-100)
+}
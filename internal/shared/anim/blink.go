@@ -16,12 +16,25 @@ const (
 	BlinkProgressive = config.BlinkProgressive
 )
 
+// Default blink timing, used when no BlinkConfig is given
+const (
+	DefaultBlinkRateMs = 1000 // Full on+off cycle
+	DefaultBlinkDuty   = 0.5  // Fraction of the cycle content is visible
+)
+
+// Duty cycle limits, so neither phase disappears entirely
+const (
+	minBlinkDuty = 0.05
+	maxBlinkDuty = 0.95
+)
+
 // BlinkAnimator handles toggling blink state at intervals
 type BlinkAnimator struct {
 	state        bool
 	lastToggle   time.Time
 	mode         BlinkMode
 	baseInterval time.Duration // interval for "always" mode
+	duty         float64       // fraction of a cycle spent visible
 }
 
 // NewBlinkAnimator creates a new blink animator with the specified mode and base interval
@@ -31,30 +44,72 @@ func NewBlinkAnimator(mode BlinkMode, baseInterval time.Duration) *BlinkAnimator
 		lastToggle:   time.Now(),
 		mode:         mode,
 		baseInterval: baseInterval,
+		duty:         DefaultBlinkDuty,
 	}
 }
 
-// Update advances the blink state based on elapsed time
-// For progressive mode, pass the intensity value (e.g., unread count)
-// Returns true if state changed
-func (b *BlinkAnimator) Update(intensity int) bool {
-	interval := b.GetInterval(intensity)
-	if interval <= 0 {
-		return false
+// NewBlinkAnimatorFromConfig creates a blink animator with timing from a BlinkConfig.
+// A nil config uses the default rate and duty cycle.
+func NewBlinkAnimatorFromConfig(mode BlinkMode, cfg *config.BlinkConfig) *BlinkAnimator {
+	rate, duty := BlinkTiming(cfg)
+	b := NewBlinkAnimator(mode, rate/2)
+	b.duty = duty
+	return b
+}
+
+// BlinkTiming returns the blink cycle length and duty cycle from config,
+// applying defaults and clamping
+func BlinkTiming(cfg *config.BlinkConfig) (rate time.Duration, duty float64) {
+	rate = DefaultBlinkRateMs * time.Millisecond
+	duty = DefaultBlinkDuty
+
+	if cfg == nil {
+		return rate, duty
+	}
+	if cfg.RateMs > 0 {
+		rate = time.Duration(cfg.RateMs) * time.Millisecond
 	}
+	if cfg.Duty > 0 {
+		duty = min(max(cfg.Duty, minBlinkDuty), maxBlinkDuty)
+	}
+	return rate, duty
+}
 
-	if time.Since(b.lastToggle) >= interval {
-		b.state = !b.state
-		b.lastToggle = time.Now()
+// BlinkVisible reports whether blinking content is visible at the given time.
+// Stateless alternative to BlinkAnimator: the phase is derived from the wall clock,
+// so all widgets using the same config blink in sync.
+func BlinkVisible(now time.Time, cfg *config.BlinkConfig) bool {
+	return blinkPhaseVisible(time.Duration(now.UnixMilli())*time.Millisecond, cfg)
+}
+
+// FlashVisible reports whether content flashing since start is in its visible phase.
+// The flash follows the blink timing and settles on visible once duration has elapsed.
+func FlashVisible(start, now time.Time, duration time.Duration, cfg *config.BlinkConfig) bool {
+	elapsed := now.Sub(start)
+	if start.IsZero() || duration <= 0 || elapsed < 0 || elapsed >= duration {
 		return true
 	}
-	return false
+	return blinkPhaseVisible(elapsed, cfg)
+}
+
+// blinkPhaseVisible reports whether the given offset into the blink cycle falls in the visible phase
+func blinkPhaseVisible(offset time.Duration, cfg *config.BlinkConfig) bool {
+	rate, duty := BlinkTiming(cfg)
+	phase := offset.Milliseconds() % rate.Milliseconds()
+	return float64(phase) < float64(rate.Milliseconds())*duty
+}
+
+// Update advances the blink state based on elapsed time
+// For progressive mode, pass the intensity value (e.g., unread count)
+// Returns true if state changed
+func (b *BlinkAnimator) Update(intensity int) bool {
+	return b.UpdateWithTime(time.Now(), intensity)
 }
 
 // UpdateWithTime advances the blink state using the provided time
 // Useful when caller already has the current time
 func (b *BlinkAnimator) UpdateWithTime(now time.Time, intensity int) bool {
-	interval := b.GetInterval(intensity)
+	interval := b.phaseInterval(b.GetInterval(intensity))
 	if interval <= 0 {
 		return false
 	}
@@ -67,6 +122,19 @@ func (b *BlinkAnimator) UpdateWithTime(now time.Time, intensity int) bool {
 	return false
 }
 
+// phaseInterval converts a toggle interval (half cycle at 50% duty)
+// into the duration of the current visible or hidden phase
+func (b *BlinkAnimator) phaseInterval(interval time.Duration) time.Duration {
+	if interval <= 0 || b.duty <= 0 || b.duty == DefaultBlinkDuty {
+		return interval
+	}
+	cycle := float64(2 * interval)
+	if b.state {
+		return time.Duration(cycle * b.duty)
+	}
+	return time.Duration(cycle * (1 - b.duty))
+}
+
 // State returns the current blink state (true = visible, false = hidden)
 func (b *BlinkAnimator) State() bool {
 	return b.state
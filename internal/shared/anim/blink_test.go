@@ -3,6 +3,8 @@ package anim
 import (
 	"testing"
 	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
 )
 
 func TestNewBlinkAnimator(t *testing.T) {
@@ -197,3 +199,112 @@ func TestBlinkAnimator_SetMode(t *testing.T) {
 		t.Errorf("Mode() = %s after SetMode, want %s", b.Mode(), BlinkAlways)
 	}
 }
+
+func TestBlinkTiming(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *config.BlinkConfig
+		wantRate time.Duration
+		wantDuty float64
+	}{
+		{"nil uses defaults", nil, time.Second, 0.5},
+		{"empty uses defaults", &config.BlinkConfig{}, time.Second, 0.5},
+		{"custom", &config.BlinkConfig{RateMs: 300, Duty: 0.25}, 300 * time.Millisecond, 0.25},
+		{"duty clamped high", &config.BlinkConfig{Duty: 1.5}, time.Second, maxBlinkDuty},
+		{"duty clamped low", &config.BlinkConfig{Duty: 0.01}, time.Second, minBlinkDuty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, duty := BlinkTiming(tt.cfg)
+			if rate != tt.wantRate {
+				t.Errorf("rate = %v, want %v", rate, tt.wantRate)
+			}
+			if duty != tt.wantDuty {
+				t.Errorf("duty = %v, want %v", duty, tt.wantDuty)
+			}
+		})
+	}
+}
+
+func TestBlinkVisible(t *testing.T) {
+	cfg := &config.BlinkConfig{RateMs: 1000, Duty: 0.25}
+	base := time.UnixMilli(10_000) // Cycle boundary
+
+	tests := []struct {
+		offset time.Duration
+		want   bool
+	}{
+		{0, true},
+		{200 * time.Millisecond, true},
+		{250 * time.Millisecond, false},
+		{900 * time.Millisecond, false},
+		{1000 * time.Millisecond, true},
+	}
+
+	for _, tt := range tests {
+		if got := BlinkVisible(base.Add(tt.offset), cfg); got != tt.want {
+			t.Errorf("BlinkVisible(+%v) = %v, want %v", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestBlinkAnimator_FromConfigDuty(t *testing.T) {
+	b := NewBlinkAnimatorFromConfig(BlinkAlways, &config.BlinkConfig{RateMs: 1000, Duty: 0.8})
+	start := b.lastToggle
+
+	if b.GetInterval(0) != 500*time.Millisecond {
+		t.Errorf("GetInterval(0) = %v, want 500ms (half cycle)", b.GetInterval(0))
+	}
+
+	// Visible phase lasts 800ms
+	b.UpdateWithTime(start.Add(700*time.Millisecond), 0)
+	if !b.State() {
+		t.Error("State() = false at 700ms, want true (visible phase is 800ms)")
+	}
+	b.UpdateWithTime(start.Add(800*time.Millisecond), 0)
+	if b.State() {
+		t.Error("State() = true at 800ms, want false")
+	}
+
+	// Hidden phase lasts 200ms
+	b.UpdateWithTime(start.Add(900*time.Millisecond), 0)
+	if b.State() {
+		t.Error("State() = true at 900ms, want false (hidden phase is 200ms)")
+	}
+	b.UpdateWithTime(start.Add(1000*time.Millisecond), 0)
+	if !b.State() {
+		t.Error("State() = false at 1000ms, want true")
+	}
+}
+
+func TestFlashVisible(t *testing.T) {
+	cfg := &config.BlinkConfig{RateMs: 200, Duty: 0.5}
+	start := time.Unix(1000, 0)
+	duration := time.Second
+
+	tests := []struct {
+		name  string
+		start time.Time
+		now   time.Time
+		want  bool
+	}{
+		{"flash start is visible", start, start, true},
+		{"hidden phase", start, start.Add(150 * time.Millisecond), false},
+		{"next visible phase", start, start.Add(250 * time.Millisecond), true},
+		{"settled after duration", start, start.Add(duration + 150*time.Millisecond), true},
+		{"never changed", time.Time{}, start, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlashVisible(tt.start, tt.now, duration, cfg); got != tt.want {
+				t.Errorf("FlashVisible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !FlashVisible(start, start.Add(150*time.Millisecond), 0, cfg) {
+		t.Error("FlashVisible() with zero duration should always be visible")
+	}
+}
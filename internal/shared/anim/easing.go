@@ -0,0 +1,55 @@
+package anim
+
+import "github.com/pozitronik/steelclock-go/internal/config"
+
+// Easing shapes transition progress over time
+type Easing string
+
+const (
+	EasingLinear    Easing = config.EasingLinear    // Constant speed (default)
+	EasingEaseIn    Easing = config.EasingEaseIn    // Starts slow, ends fast
+	EasingEaseOut   Easing = config.EasingEaseOut   // Starts fast, ends slow
+	EasingEaseInOut Easing = config.EasingEaseInOut // Slow at both ends
+	EasingBounce    Easing = config.EasingBounce    // Reaches the end early and bounces back off it, never past it
+)
+
+// Ease maps linear progress t (0.0 to 1.0) through the easing curve.
+// Unknown or empty easings are linear. The result starts at 0 and ends at 1.
+func Ease(easing Easing, t float64) float64 {
+	t = min(max(t, 0), 1)
+	switch easing {
+	case EasingEaseIn:
+		return t * t * t
+	case EasingEaseOut:
+		u := 1 - t
+		return 1 - u*u*u
+	case EasingEaseInOut:
+		if t < 0.5 {
+			return 4 * t * t * t
+		}
+		u := -2*t + 2
+		return 1 - u*u*u/2
+	case EasingBounce:
+		return bounceOut(t)
+	}
+	return t
+}
+
+// bounceOut is the classic bounce curve: the value hits 1 and rebounds in
+// three decaying arcs before coming to rest
+func bounceOut(t float64) float64 {
+	const n, d = 7.5625, 2.75
+	switch {
+	case t < 1/d:
+		return n * t * t
+	case t < 2/d:
+		t -= 1.5 / d
+		return n*t*t + 0.75
+	case t < 2.5/d:
+		t -= 2.25 / d
+		return n*t*t + 0.9375
+	default:
+		t -= 2.625 / d
+		return n*t*t + 0.984375
+	}
+}
@@ -0,0 +1,93 @@
+package anim
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEase_Endpoints(t *testing.T) {
+	for _, e := range []Easing{"", EasingLinear, EasingEaseIn, EasingEaseOut, EasingEaseInOut, EasingBounce, "unknown"} {
+		if got := Ease(e, 0); math.Abs(got) > 1e-9 {
+			t.Errorf("Ease(%q, 0) = %v, want 0", e, got)
+		}
+		if got := Ease(e, 1); math.Abs(got-1) > 1e-9 {
+			t.Errorf("Ease(%q, 1) = %v, want 1", e, got)
+		}
+		// Out-of-range progress is clamped
+		if got := Ease(e, 1.5); math.Abs(got-1) > 1e-9 {
+			t.Errorf("Ease(%q, 1.5) = %v, want 1", e, got)
+		}
+		if got := Ease(e, -0.5); math.Abs(got) > 1e-9 {
+			t.Errorf("Ease(%q, -0.5) = %v, want 0", e, got)
+		}
+	}
+}
+
+func TestEase_Shapes(t *testing.T) {
+	if got := Ease(EasingLinear, 0.3); got != 0.3 {
+		t.Errorf("linear(0.3) = %v, want 0.3", got)
+	}
+	if got := Ease(EasingEaseIn, 0.5); got >= 0.5 {
+		t.Errorf("ease_in(0.5) = %v, want below 0.5", got)
+	}
+	if got := Ease(EasingEaseOut, 0.5); got <= 0.5 {
+		t.Errorf("ease_out(0.5) = %v, want above 0.5", got)
+	}
+	if got := Ease(EasingEaseInOut, 0.5); math.Abs(got-0.5) > 1e-9 {
+		t.Errorf("ease_in_out(0.5) = %v, want 0.5", got)
+	}
+	if a, b := Ease(EasingEaseInOut, 0.25), Ease(EasingEaseInOut, 0.75); math.Abs(a+b-1) > 1e-9 {
+		t.Errorf("ease_in_out is not symmetric: %v + %v != 1", a, b)
+	}
+}
+
+func TestEase_Monotonic(t *testing.T) {
+	for _, e := range []Easing{EasingLinear, EasingEaseIn, EasingEaseOut, EasingEaseInOut} {
+		prev := 0.0
+		for i := 1; i <= 100; i++ {
+			v := Ease(e, float64(i)/100)
+			if v < prev {
+				t.Errorf("Ease(%q) decreases at %d%%: %v < %v", e, i, v, prev)
+				break
+			}
+			prev = v
+		}
+	}
+}
+
+func TestEase_Bounce(t *testing.T) {
+	// The curve touches 1 before the end and dips back down
+	touched, dipped := false, false
+	for i := 0; i <= 1000; i++ {
+		v := Ease(EasingBounce, float64(i)/1000)
+		if v < 0 || v > 1+1e-9 {
+			t.Fatalf("bounce(%v) = %v, out of 0-1", float64(i)/1000, v)
+		}
+		if v > 0.999 && i < 500 {
+			touched = true
+		}
+		if touched && v < 0.9 {
+			dipped = true
+		}
+	}
+	if !touched || !dipped {
+		t.Errorf("bounce should reach the end early and rebound (touched %v, dipped %v)", touched, dipped)
+	}
+}
+
+func TestTransitionManager_Easing(t *testing.T) {
+	oldFrame := createTestFrame(4, 1, 0)
+	newFrame := createTestFrame(4, 1, 200)
+
+	tm := NewTransitionManager(4, 1)
+	tm.SetEasing(EasingEaseIn)
+	tm.Start(TransitionDissolveFade, 1000, oldFrame)
+	tm.progress = 0.5
+
+	dst := createTestFrame(4, 1, 0)
+	tm.Apply(dst, newFrame)
+	// Linear would blend halfway (100); ease_in at 0.5 is 0.125 of the way
+	if got := dst.Pix[0]; got != 25 {
+		t.Errorf("eased fade pixel = %d, want 25", got)
+	}
+}
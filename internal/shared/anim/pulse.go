@@ -0,0 +1,25 @@
+package anim
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultPulseRateHz is the pulse frequency used when none is configured
+const DefaultPulseRateHz = 1.0
+
+// MinPulseLevel is the brightness factor at the dimmest point of a pulse
+const MinPulseLevel = 0.25
+
+// PulseLevel returns a brightness factor between MinPulseLevel and 1 that oscillates
+// smoothly rateHz times per second. Like BlinkVisible, the phase is derived from the
+// wall clock, so everything pulsing at the same rate stays in sync.
+// A non-positive rate uses DefaultPulseRateHz.
+func PulseLevel(now time.Time, rateHz float64) float64 {
+	if rateHz <= 0 {
+		rateHz = DefaultPulseRateHz
+	}
+	seconds := float64(now.UnixNano()) / float64(time.Second)
+	wave := (math.Cos(2*math.Pi*rateHz*seconds) + 1) / 2
+	return MinPulseLevel + (1-MinPulseLevel)*wave
+}
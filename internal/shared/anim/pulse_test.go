@@ -0,0 +1,38 @@
+package anim
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPulseLevel(t *testing.T) {
+	epoch := time.Unix(0, 0)
+
+	tests := []struct {
+		name   string
+		offset time.Duration
+		rateHz float64
+		want   float64
+	}{
+		{"cycle start is full", 0, 2, 1},
+		{"half cycle is dim", 250 * time.Millisecond, 2, MinPulseLevel},
+		{"full cycle is full again", 500 * time.Millisecond, 2, 1},
+		{"default rate half cycle", 500 * time.Millisecond, 0, MinPulseLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PulseLevel(epoch.Add(tt.offset), tt.rateHz)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("PulseLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for ms := 0; ms < 2000; ms += 37 {
+		if v := PulseLevel(epoch.Add(time.Duration(ms)*time.Millisecond), 3); v < MinPulseLevel || v > 1 {
+			t.Fatalf("PulseLevel() = %v out of range at %dms", v, ms)
+		}
+	}
+}
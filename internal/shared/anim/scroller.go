@@ -1,6 +1,7 @@
 package anim
 
 import (
+	"math"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
@@ -14,6 +15,7 @@ const (
 	ScrollContinuous = config.ScrollContinuous
 	ScrollBounce     = config.ScrollBounce
 	ScrollPauseEnds  = config.ScrollPauseEnds
+	ScrollTypewriter = config.ScrollTypewriter
 )
 
 // ScrollDirection type alias for convenience
@@ -29,11 +31,11 @@ const (
 
 // ScrollerConfig holds scroll behavior configuration
 type ScrollerConfig struct {
-	Speed     float64         // pixels per second
+	Speed     float64         // pixels per second (characters per second for typewriter)
 	Mode      ScrollMode      // scrolling mode
 	Direction ScrollDirection // scroll direction
 	Gap       int             // gap between text copies for continuous mode
-	PauseMs   int             // pause duration at ends (for bounce/pause_ends)
+	PauseMs   int             // pause duration at ends (for bounce/pause_ends), full text hold (for typewriter)
 }
 
 // TextScroller manages scrolling animation state
@@ -65,6 +67,13 @@ func (s *TextScroller) Update(contentSize, containerSize int) float64 {
 
 // UpdateWithTime advances the scroll position using the provided time
 func (s *TextScroller) UpdateWithTime(now time.Time, contentSize, containerSize int) float64 {
+	// Typewriter counts typed characters whether the text fits or not; see VisibleText
+	if s.config.Mode == ScrollTypewriter {
+		s.offset += s.config.Speed * now.Sub(s.lastUpdate).Seconds()
+		s.lastUpdate = now
+		return s.offset
+	}
+
 	// Don't scroll if content fits in container
 	if contentSize <= containerSize {
 		s.offset = 0
@@ -149,6 +158,31 @@ func (s *TextScroller) updatePauseEnds(movement float64, contentSize, containerS
 	}
 }
 
+// VisibleText returns the part of text to draw. In typewriter mode that is the characters
+// typed so far; other modes move the whole text instead.
+func (s *TextScroller) VisibleText(text string) string {
+	if s.config.Mode != ScrollTypewriter {
+		return text
+	}
+	hold := s.config.Speed * float64(s.config.PauseMs) / 1000
+	return TypewriterText(text, s.offset, hold)
+}
+
+// TypewriterText returns the beginning of text after typed characters of a typewriter loop.
+// Once the text is complete it stays for hold characters' worth of time, then typing restarts.
+func TypewriterText(text string, typed, hold float64) string {
+	runes := []rune(text)
+	if len(runes) == 0 || typed <= 0 {
+		return ""
+	}
+
+	n := int(math.Mod(typed, float64(len(runes))+max(hold, 0)))
+	if n >= len(runes) {
+		return text
+	}
+	return string(runes[:n])
+}
+
 // Reset resets the scroll position to the beginning
 func (s *TextScroller) Reset() {
 	s.offset = 0
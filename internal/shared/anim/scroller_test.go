@@ -303,3 +303,67 @@ func TestTextScroller_VerticalScroll(t *testing.T) {
 		t.Errorf("offset after 500ms down = %f, want ~-50", offset)
 	}
 }
+
+func TestTextScroller_Typewriter(t *testing.T) {
+	cfg := ScrollerConfig{Speed: 10, Mode: ScrollTypewriter, PauseMs: 500}
+	s := NewTextScroller(cfg)
+	baseTime := s.lastUpdate
+
+	if got := s.VisibleText("Hello"); got != "" {
+		t.Errorf("VisibleText before typing = %q, want empty", got)
+	}
+
+	// Typing happens even when the text fits
+	tests := []struct {
+		at   time.Duration
+		want string
+	}{
+		{250 * time.Millisecond, "He"},    // 2.5 characters typed
+		{500 * time.Millisecond, "Hello"}, // full text
+		{900 * time.Millisecond, "Hello"}, // held for 500ms (5 characters' worth)
+		{1050 * time.Millisecond, ""},     // restarted
+		{1250 * time.Millisecond, "He"},
+	}
+	for _, tt := range tests {
+		s.UpdateWithTime(baseTime.Add(tt.at), 5, 100)
+		if got := s.VisibleText("Hello"); got != tt.want {
+			t.Errorf("VisibleText at %v = %q, want %q", tt.at, got, tt.want)
+		}
+	}
+
+	s.Reset()
+	if got := s.VisibleText("Hello"); got != "" {
+		t.Errorf("VisibleText after Reset = %q, want empty", got)
+	}
+}
+
+func TestTextScroller_VisibleTextOtherModes(t *testing.T) {
+	s := NewTextScroller(ScrollerConfig{Speed: 100, Mode: ScrollContinuous, Direction: ScrollLeft})
+	s.UpdateWithTime(s.lastUpdate.Add(time.Second), 500, 100)
+	if got := s.VisibleText("Hello"); got != "Hello" {
+		t.Errorf("VisibleText in continuous mode = %q, want full text", got)
+	}
+}
+
+func TestTypewriterText(t *testing.T) {
+	tests := []struct {
+		text        string
+		typed, hold float64
+		want        string
+	}{
+		{"Привет", 3, 0, "При"}, // counts characters, not bytes
+		{"abc", 3, 2, "abc"},
+		{"abc", 4.9, 2, "abc"},
+		{"abc", 5, 2, ""},
+		{"abc", 6, 2, "a"},
+		{"abc", 4, 0, "a"}, // no hold: restarts right away
+		{"abc", 1, -5, "a"},
+		{"", 3, 0, ""},
+		{"abc", 0, 0, ""},
+	}
+	for _, tt := range tests {
+		if got := TypewriterText(tt.text, tt.typed, tt.hold); got != tt.want {
+			t.Errorf("TypewriterText(%q, %v, %v) = %q, want %q", tt.text, tt.typed, tt.hold, got, tt.want)
+		}
+	}
+}
@@ -27,6 +27,10 @@ const (
 	TransitionBoxIn          TransitionType = "box_in"
 	TransitionBoxOut         TransitionType = "box_out"
 	TransitionClockWipe      TransitionType = "clock_wipe"
+	TransitionWipeDiagonalTL TransitionType = "wipe_diagonal_tl"
+	TransitionWipeDiagonalBR TransitionType = "wipe_diagonal_br"
+	TransitionIrisIn         TransitionType = "iris_in"
+	TransitionIrisOut        TransitionType = "iris_out"
 	TransitionRandom         TransitionType = "random"
 )
 
@@ -36,6 +40,7 @@ var AllTransitions = []TransitionType{
 	TransitionSlideLeft, TransitionSlideRight, TransitionSlideUp, TransitionSlideDown,
 	TransitionDissolveFade, TransitionDissolvePixel, TransitionDissolveDither,
 	TransitionBoxIn, TransitionBoxOut, TransitionClockWipe,
+	TransitionWipeDiagonalTL, TransitionWipeDiagonalBR, TransitionIrisIn, TransitionIrisOut,
 }
 
 // TransitionManager handles frame transitions between old and new content
@@ -46,7 +51,8 @@ type TransitionManager struct {
 	duration       float64 // seconds
 	transitionType TransitionType
 	oldFrame       *image.Gray
-	pixelOrder     []int // pre-shuffled for dissolve_pixel
+	pixelOrder     []int  // pre-shuffled for dissolve_pixel
+	easing         Easing // Shapes progress when compositing (default: linear)
 	width          int
 	height         int
 }
@@ -80,6 +86,12 @@ func (t *TransitionManager) Start(transitionType TransitionType, duration float6
 	}
 }
 
+// SetEasing sets the easing curve applied to progress when compositing frames.
+// It applies to transitions started before and after the call.
+func (t *TransitionManager) SetEasing(easing Easing) {
+	t.easing = easing
+}
+
 // Update advances the transition based on elapsed time
 // Returns true if the transition is still active
 func (t *TransitionManager) Update() bool {
@@ -159,7 +171,7 @@ func (t *TransitionManager) Apply(dst, newFrame *image.Gray) {
 		CopyGrayImage(dst, newFrame)
 		return
 	}
-	ApplyTransition(dst, t.oldFrame, newFrame, t.progress, t.transitionType, t.pixelOrder)
+	ApplyTransition(dst, t.oldFrame, newFrame, Ease(t.easing, t.progress), t.transitionType, t.pixelOrder)
 }
 
 // ApplyLive composites old and new frames using live progress calculated from elapsed time.
@@ -170,7 +182,7 @@ func (t *TransitionManager) ApplyLive(dst, newFrame *image.Gray) {
 		CopyGrayImage(dst, newFrame)
 		return
 	}
-	progress := t.LiveProgress()
+	progress := Ease(t.easing, t.LiveProgress())
 	ApplyTransition(dst, t.oldFrame, newFrame, progress, t.transitionType, t.pixelOrder)
 }
 
@@ -256,6 +268,16 @@ func ApplyTransition(dst, oldFrame, newFrame *image.Gray, progress float64, tran
 	case TransitionClockWipe:
 		applyClockWipe(dst, oldFrame, newFrame, progress)
 
+	case TransitionWipeDiagonalTL:
+		applyDiagonalWipe(dst, oldFrame, newFrame, progress, false)
+	case TransitionWipeDiagonalBR:
+		applyDiagonalWipe(dst, oldFrame, newFrame, progress, true)
+
+	case TransitionIrisIn:
+		applyIrisTransition(dst, oldFrame, newFrame, progress, true)
+	case TransitionIrisOut:
+		applyIrisTransition(dst, oldFrame, newFrame, progress, false)
+
 	default:
 		// Unknown transition, just copy new frame
 		CopyGrayImage(dst, newFrame)
@@ -485,3 +507,69 @@ func applyClockWipe(dst, oldFrame, newFrame *image.Gray, progress float64) {
 		}
 	}
 }
+
+// applyDiagonalWipe reveals new frame behind a diagonal line sweeping from the
+// top-left corner to the bottom-right one, or the other way round when fromBottomRight is set
+func applyDiagonalWipe(dst, oldFrame, newFrame *image.Gray, progress float64, fromBottomRight bool) {
+	bounds := dst.Bounds()
+	width := bounds.Dx()
+	height := bounds.Dy()
+
+	// The line passes through pixel centers where x/width + y/height equals the threshold (0 to 2)
+	threshold := progress * 2
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			dstX := x + bounds.Min.X
+			dstY := y + bounds.Min.Y
+
+			pos := (float64(x)+0.5)/float64(width) + (float64(y)+0.5)/float64(height)
+			if fromBottomRight {
+				pos = 2 - pos
+			}
+
+			if pos < threshold {
+				dst.SetGray(dstX, dstY, newFrame.GrayAt(dstX, dstY))
+			} else {
+				dst.SetGray(dstX, dstY, oldFrame.GrayAt(dstX, dstY))
+			}
+		}
+	}
+}
+
+// applyIrisTransition reveals new frame through a circle centered on the frame
+func applyIrisTransition(dst, oldFrame, newFrame *image.Gray, progress float64, irisIn bool) {
+	bounds := dst.Bounds()
+	width := bounds.Dx()
+	height := bounds.Dy()
+	centerX := float64(width) / 2
+	centerY := float64(height) / 2
+
+	// The circle reaching the corners covers the whole frame
+	maxRadius := math.Hypot(centerX, centerY)
+
+	var radius float64
+	if irisIn {
+		// Circle closes toward the center: outside circle = new, inside circle = old
+		radius = maxRadius * (1 - progress)
+	} else {
+		// Circle opens from the center: inside circle = new, outside circle = old
+		radius = maxRadius * progress
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			dstX := x + bounds.Min.X
+			dstY := y + bounds.Min.Y
+
+			dist := math.Hypot(float64(x)+0.5-centerX, float64(y)+0.5-centerY)
+			inCircle := dist < radius
+
+			if inCircle != irisIn {
+				dst.SetGray(dstX, dstY, newFrame.GrayAt(dstX, dstY))
+			} else {
+				dst.SetGray(dstX, dstY, oldFrame.GrayAt(dstX, dstY))
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package anim
 
 import (
+	"hash/fnv"
 	"image"
 	"image/color"
 	"testing"
@@ -267,6 +268,7 @@ func TestApplyTransition_AllTypes(t *testing.T) {
 		TransitionSlideLeft, TransitionSlideRight, TransitionSlideUp, TransitionSlideDown,
 		TransitionDissolveFade, TransitionDissolvePixel, TransitionDissolveDither,
 		TransitionBoxIn, TransitionBoxOut, TransitionClockWipe,
+		TransitionWipeDiagonalTL, TransitionWipeDiagonalBR, TransitionIrisIn, TransitionIrisOut,
 	}
 
 	for _, tt := range allTypes {
@@ -278,6 +280,82 @@ func TestApplyTransition_AllTypes(t *testing.T) {
 	}
 }
 
+func TestApplyTransition_WipeAndIris(t *testing.T) {
+	// Odd and non-square sizes make sure the edges and corners are covered
+	for _, size := range []image.Point{{20, 20}, {128, 40}, {7, 3}} {
+		oldFrame := createTestFrame(size.X, size.Y, 50)
+		newFrame := createTestFrame(size.X, size.Y, 200)
+		dst := createTestFrame(size.X, size.Y, 0)
+
+		for _, tt := range []TransitionType{
+			TransitionWipeDiagonalTL, TransitionWipeDiagonalBR, TransitionIrisIn, TransitionIrisOut,
+		} {
+			ApplyTransition(dst, oldFrame, newFrame, 0.0, tt, nil)
+			if n := countPixels(dst, 200); n != 0 {
+				t.Errorf("%s %dx%d at progress 0.0: %d new pixels, want 0", tt, size.X, size.Y, n)
+			}
+
+			ApplyTransition(dst, oldFrame, newFrame, 1.0, tt, nil)
+			if n := countPixels(dst, 50); n != 0 {
+				t.Errorf("%s %dx%d at progress 1.0: %d old pixels left, want 0", tt, size.X, size.Y, n)
+			}
+		}
+	}
+}
+
+func TestApplyTransition_WipeDiagonalDirection(t *testing.T) {
+	oldFrame := createTestFrame(20, 10, 50)
+	newFrame := createTestFrame(20, 10, 200)
+	dst := createTestFrame(20, 10, 0)
+
+	ApplyTransition(dst, oldFrame, newFrame, 0.3, TransitionWipeDiagonalTL, nil)
+	if dst.GrayAt(0, 0).Y != 200 || dst.GrayAt(19, 9).Y != 50 {
+		t.Errorf("wipe_diagonal_tl: top-left = %d, bottom-right = %d, want 200 and 50",
+			dst.GrayAt(0, 0).Y, dst.GrayAt(19, 9).Y)
+	}
+
+	ApplyTransition(dst, oldFrame, newFrame, 0.3, TransitionWipeDiagonalBR, nil)
+	if dst.GrayAt(0, 0).Y != 50 || dst.GrayAt(19, 9).Y != 200 {
+		t.Errorf("wipe_diagonal_br: top-left = %d, bottom-right = %d, want 50 and 200",
+			dst.GrayAt(0, 0).Y, dst.GrayAt(19, 9).Y)
+	}
+}
+
+func TestApplyTransition_IrisDirection(t *testing.T) {
+	oldFrame := createTestFrame(20, 20, 50)
+	newFrame := createTestFrame(20, 20, 200)
+	dst := createTestFrame(20, 20, 0)
+
+	// Iris out opens from the center
+	ApplyTransition(dst, oldFrame, newFrame, 0.3, TransitionIrisOut, nil)
+	if dst.GrayAt(10, 10).Y != 200 || dst.GrayAt(0, 0).Y != 50 {
+		t.Errorf("iris_out: center = %d, corner = %d, want 200 and 50", dst.GrayAt(10, 10).Y, dst.GrayAt(0, 0).Y)
+	}
+
+	// Iris in closes toward the center
+	ApplyTransition(dst, oldFrame, newFrame, 0.3, TransitionIrisIn, nil)
+	if dst.GrayAt(10, 10).Y != 50 || dst.GrayAt(0, 0).Y != 200 {
+		t.Errorf("iris_in: center = %d, corner = %d, want 50 and 200", dst.GrayAt(10, 10).Y, dst.GrayAt(0, 0).Y)
+	}
+}
+
+func TestSelectTransition_RandomIncludesWipeAndIris(t *testing.T) {
+	for _, want := range []TransitionType{
+		TransitionWipeDiagonalTL, TransitionWipeDiagonalBR, TransitionIrisIn, TransitionIrisOut,
+	} {
+		found := false
+		for _, tt := range AllTransitions {
+			if tt == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("AllTransitions does not include %s", want)
+		}
+	}
+}
+
 func TestTransitionManager_Apply(t *testing.T) {
 	tm := NewTransitionManager(10, 10)
 	oldFrame := createTestFrame(10, 10, 0)
@@ -425,3 +503,97 @@ func createTestFrame(w, h int, value uint8) *image.Gray {
 	}
 	return img
 }
+
+// countPixels returns the number of pixels with the given value
+func countPixels(img *image.Gray, value uint8) int {
+	n := 0
+	for _, p := range img.Pix {
+		if p == value {
+			n++
+		}
+	}
+	return n
+}
+
+// TestApplyTransition_Golden pins the frames of the compositor shared by the telegram
+// and weather widgets and layout transitions, so any change to a transition shows up here
+func TestApplyTransition_Golden(t *testing.T) {
+	// Hashes of the composited frame at 25%, 50% and 75% progress
+	tests := []struct {
+		transition TransitionType
+		want       [3]uint64
+	}{
+		{TransitionNone, [3]uint64{0xe0c68270b8826d25, 0x9a9a1ede9cb047a5, 0x9a9a1ede9cb047a5}},
+		{TransitionPushLeft, [3]uint64{0x1a06ca64a6a056c5, 0x132ad6acb6f9fe65, 0x1dcf2b6f0f23cb45}},
+		{TransitionPushRight, [3]uint64{0xfe9f984d549c6e45, 0x5003d95a4419f965, 0x79505384756509c5}},
+		{TransitionPushUp, [3]uint64{0xfe4fad843710c2c5, 0x4a869778de7ad2e5, 0xbc3ce5adfac7e285}},
+		{TransitionPushDown, [3]uint64{0xa8655d3ef6fe4e45, 0x9bbdded04ad4dce5, 0x8faffea73d8de085}},
+		{TransitionSlideLeft, [3]uint64{0x47fcd21677933cc5, 0x907374bc54cd2065, 0xe1dfe4bba0907645}},
+		{TransitionSlideRight, [3]uint64{0xb5195a3cf35c2fc5, 0xab95e991da7a9d65, 0x0613211c0c194f45}},
+		{TransitionSlideUp, [3]uint64{0xfe4fad843710c2c5, 0x4a869778de7ad2e5, 0xbc3ce5adfac7e285}},
+		{TransitionSlideDown, [3]uint64{0xa8655d3ef6fe4e45, 0x9bbdded04ad4dce5, 0x8faffea73d8de085}},
+		{TransitionDissolveFade, [3]uint64{0xfa0f5d7ee4125fa5, 0xaf8099a476281125, 0x9e875578b103ce25}},
+		{TransitionDissolvePixel, [3]uint64{0xdf4329d89d278cb9, 0x695f178c6c20738d, 0xbee2b90e6e4b6ee9}},
+		{TransitionDissolveDither, [3]uint64{0x78ae2f892021d2a5, 0x6372d573e4b1dd25, 0x3b2f8dd259c273e5}},
+		{TransitionBoxIn, [3]uint64{0xf3500a0bdec3cd2d, 0x27cb645d91c11ac5, 0xa2c75f28d100bf2d}},
+		{TransitionBoxOut, [3]uint64{0x2f01feb36beb89dd, 0xddc773eff9c37285, 0xdb6d91efcc63605d}},
+		{TransitionClockWipe, [3]uint64{0xc7d8344c30b0d285, 0x6620a7750f358b85, 0x508463f51a3040cd}},
+		{TransitionWipeDiagonalTL, [3]uint64{0xf722dff5ca6e65a1, 0xc31bc2a53d7e5d1d, 0x958325a3360f32c1}},
+		{TransitionWipeDiagonalBR, [3]uint64{0x9c86b0e82be5f421, 0x4699db3e76fd209d, 0xa4bb6f1501c26861}},
+		{TransitionIrisIn, [3]uint64{0xc26f6a054a11a18d, 0x06b6aba15e3695e1, 0x6fa267f583f84635}},
+		{TransitionIrisOut, [3]uint64{0xcb9d3c75869a5a75, 0x55cf19ac44b09441, 0xacab6342cc3c582d}},
+	}
+
+	oldFrame, newFrame := goldenFrames()
+	for _, tt := range tests {
+		t.Run(string(tt.transition), func(t *testing.T) {
+			for i, progress := range goldenProgress {
+				dst := createTestFrame(goldenWidth, goldenHeight, 0)
+				ApplyTransition(dst, oldFrame, newFrame, progress, tt.transition, goldenPixelOrder())
+				if got := frameHash(dst); got != tt.want[i] {
+					t.Errorf("progress %.2f: frame hash = %#016x, want %#016x", progress, got, tt.want[i])
+				}
+			}
+		})
+	}
+
+	// Every selectable transition is pinned
+	if len(tests) != len(AllTransitions)+1 {
+		t.Errorf("golden table has %d transitions, want %d", len(tests), len(AllTransitions)+1)
+	}
+}
+
+// Golden frames pin the compositor output, so refactoring keeps it byte-identical
+const goldenWidth, goldenHeight = 16, 8
+
+var goldenProgress = [3]float64{0.25, 0.5, 0.75}
+
+// goldenFrames returns two distinct gradient frames
+func goldenFrames() (oldFrame, newFrame *image.Gray) {
+	oldFrame = createTestFrame(goldenWidth, goldenHeight, 0)
+	newFrame = createTestFrame(goldenWidth, goldenHeight, 0)
+	for y := 0; y < goldenHeight; y++ {
+		for x := 0; x < goldenWidth; x++ {
+			oldFrame.SetGray(x, y, color.Gray{Y: uint8(x * 16)})
+			newFrame.SetGray(x, y, color.Gray{Y: uint8(255 - y*32)})
+		}
+	}
+	return oldFrame, newFrame
+}
+
+// goldenPixelOrder is a fixed dissolve_pixel order: every seventh pixel, wrapping around
+func goldenPixelOrder() []int {
+	total := goldenWidth * goldenHeight
+	order := make([]int, total)
+	for i := range order {
+		order[i] = i * 7 % total
+	}
+	return order
+}
+
+// frameHash returns the FNV-1a hash of the frame pixels
+func frameHash(img *image.Gray) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(img.Pix)
+	return h.Sum64()
+}
@@ -39,6 +39,8 @@ type DualIOWidget struct {
 	MaxSpeedBps   float64 // Max speed in bytes per second (-1 for auto-scale)
 	Unit          string  // "auto", "Mbps", "MB/s", etc.
 	ShowUnit      bool    // Show unit suffix in text mode
+	ShowRate      bool    // Show throughput in text mode
+	ShowPercent   bool    // Show throughput as a percentage of the bar scale in text mode
 	SupportsGauge bool    // Whether this widget supports gauge mode
 	TextConfig    DualIOTextConfig
 	Converter     *util.ByteRateConverter
@@ -53,6 +55,13 @@ type DualIOWidget struct {
 	PrimaryHistory   *util.RingBuffer[float64]
 	SecondaryHistory *util.RingBuffer[float64]
 
+	// Aggregation of displayed values (nil = instantaneous)
+	PrimaryAggregator   *util.Aggregator
+	SecondaryAggregator *util.Aggregator
+
+	// Observed peak used as the scale maximum (nil = MaxSpeedBps)
+	ScaleTracker *util.PeakTracker
+
 	Mu sync.RWMutex
 }
 
@@ -66,20 +75,32 @@ type DualIOConfig struct {
 	ShowUnit      bool
 	SupportsGauge bool
 	TextConfig    DualIOTextConfig
-	Converter     *util.ByteRateConverter
-	Renderer      *render.DualMetricRenderer
-	HistoryLen    int
+
+	// Text mode content: throughput, percentage of max speed, or both ("rate" by default)
+	TextMode   string
+	Converter  *util.ByteRateConverter
+	Renderer   *render.DualMetricRenderer
+	HistoryLen int
+
+	// Aggregation of displayed values over a sample window ("instant", "avg", "max", "min")
+	AggregateMode   string
+	AggregateWindow int
+
+	// Scale bars, gauges and graphs to the peak observed over this window instead of MaxSpeedBps (0 = disabled)
+	AutoScaleWindow time.Duration
 }
 
 // NewDualIOWidget creates a new DualIOWidget with the given configuration
 func NewDualIOWidget(cfg DualIOConfig) *DualIOWidget {
-	return &DualIOWidget{
+	w := &DualIOWidget{
 		Base:             cfg.Base,
 		DisplayMode:      cfg.DisplayMode,
 		Padding:          cfg.Padding,
 		MaxSpeedBps:      cfg.MaxSpeedBps,
 		Unit:             cfg.Unit,
 		ShowUnit:         cfg.ShowUnit,
+		ShowRate:         cfg.TextMode != config.DiskTextModePercent,
+		ShowPercent:      cfg.TextMode == config.DiskTextModePercent || cfg.TextMode == config.DiskTextModeBoth,
 		SupportsGauge:    cfg.SupportsGauge,
 		TextConfig:       cfg.TextConfig,
 		Converter:        cfg.Converter,
@@ -87,7 +108,14 @@ func NewDualIOWidget(cfg DualIOConfig) *DualIOWidget {
 		Strategy:         render.GetDualMetricStrategy(cfg.DisplayMode),
 		PrimaryHistory:   util.NewRingBuffer[float64](cfg.HistoryLen),
 		SecondaryHistory: util.NewRingBuffer[float64](cfg.HistoryLen),
+
+		PrimaryAggregator:   util.NewAggregator(cfg.AggregateMode, cfg.AggregateWindow),
+		SecondaryAggregator: util.NewAggregator(cfg.AggregateMode, cfg.AggregateWindow),
+	}
+	if cfg.AutoScaleWindow > 0 {
+		w.ScaleTracker = util.NewPeakTracker(cfg.AutoScaleWindow)
 	}
+	return w
 }
 
 // Name delegates to the embedded Base widget
@@ -127,11 +155,15 @@ func (w *DualIOWidget) AddToHistory(primary, secondary float64) {
 	w.Mu.Unlock()
 }
 
-// SetValuesAndHistory updates values and optionally adds to history (thread-safe)
+// SetValuesAndHistory updates values and optionally adds to history (thread-safe).
+// Displayed values are aggregated over the configured window; history keeps raw samples.
 func (w *DualIOWidget) SetValuesAndHistory(primary, secondary float64, addHistory bool) {
 	w.Mu.Lock()
-	w.PrimaryValue = primary
-	w.SecondaryValue = secondary
+	w.PrimaryValue = w.PrimaryAggregator.Add(primary)
+	w.SecondaryValue = w.SecondaryAggregator.Add(secondary)
+	if w.ScaleTracker != nil {
+		w.ScaleTracker.Add(max(primary, secondary), time.Now())
+	}
 	if addHistory {
 		w.PrimaryHistory.Push(primary)
 		w.SecondaryHistory.Push(secondary)
@@ -185,8 +217,23 @@ func (w *DualIOWidget) Render() (image.Image, error) {
 	return img, nil
 }
 
-// formatText formats the text output with unit conversion
+// formatText formats the text output according to the text mode
 func (w *DualIOWidget) formatText() string {
+	if !w.ShowPercent {
+		return w.formatRateText()
+	}
+
+	primaryPct, secondaryPct := w.calculatePercentages()
+	percentText := fmt.Sprintf("%s%.0f%% %s%.0f%%",
+		w.TextConfig.PrimaryPrefix, primaryPct, w.TextConfig.SecondaryPrefix, secondaryPct)
+	if !w.ShowRate {
+		return percentText
+	}
+	return w.formatRateText() + " " + percentText
+}
+
+// formatRateText formats the throughput with unit conversion
+func (w *DualIOWidget) formatRateText() string {
 	if w.Unit == "auto" || util.IsPseudoUnit(w.Unit) {
 		// Auto-scale each value independently using the converter's family
 		primaryVal, primaryUnit := w.Converter.AutoScale(w.PrimaryValue)
@@ -213,7 +260,9 @@ func (w *DualIOWidget) formatText() string {
 // calculatePercentages calculates primary/secondary percentages based on max speed
 func (w *DualIOWidget) calculatePercentages() (primaryPct, secondaryPct float64) {
 	maxSpeed := w.MaxSpeedBps
-	if maxSpeed < 0 {
+	if w.ScaleTracker != nil {
+		maxSpeed = max(w.ScaleTracker.Peak(), 1)
+	} else if maxSpeed < 0 {
 		// Auto-scale
 		maxSpeed = max(w.PrimaryValue, w.SecondaryValue)
 		if maxSpeed < 1 {
@@ -238,7 +287,9 @@ func (w *DualIOWidget) normalizeHistory() (primaryPct, secondaryPct []float64) {
 
 	// Determine max speed for normalization
 	maxSpeed := w.MaxSpeedBps
-	if maxSpeed < 0 {
+	if w.ScaleTracker != nil {
+		maxSpeed = max(w.ScaleTracker.Peak(), 1)
+	} else if maxSpeed < 0 {
 		// Find max in history
 		maxSpeed = 1.0
 		for _, v := range primaryData {
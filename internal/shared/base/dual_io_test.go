@@ -214,6 +214,32 @@ func TestSetValuesAndHistory(t *testing.T) {
 	}
 }
 
+// TestSetValuesAndHistory_Aggregate tests that displayed values are aggregated while history stays raw
+func TestSetValuesAndHistory_Aggregate(t *testing.T) {
+	widget := createTestWidget(render.DisplayModeGraph)
+	widget.PrimaryAggregator = util.NewAggregator(util.AggregateMax, 3)
+	widget.SecondaryAggregator = util.NewAggregator(util.AggregateAvg, 3)
+
+	widget.SetValuesAndHistory(500.0, 100.0, true)
+	widget.SetValuesAndHistory(100.0, 200.0, true)
+
+	widget.Mu.RLock()
+	primary := widget.PrimaryValue
+	secondary := widget.SecondaryValue
+	lastRaw := widget.PrimaryHistory.Get(widget.PrimaryHistory.Len() - 1)
+	widget.Mu.RUnlock()
+
+	if primary != 500.0 {
+		t.Errorf("PrimaryValue = %f, want 500.0 (max)", primary)
+	}
+	if secondary != 150.0 {
+		t.Errorf("SecondaryValue = %f, want 150.0 (avg)", secondary)
+	}
+	if lastRaw != 100.0 {
+		t.Errorf("PrimaryHistory newest = %f, want raw 100.0", lastRaw)
+	}
+}
+
 // TestIsGraphMode tests display mode detection
 func TestIsGraphMode(t *testing.T) {
 	tests := []struct {
@@ -257,6 +283,61 @@ func TestRender_TextMode(t *testing.T) {
 	}
 }
 
+// TestFormatText_TextModes tests rate, percent and combined text output
+func TestFormatText_TextModes(t *testing.T) {
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{"", "R1.00 W2.50"},
+		{config.DiskTextModeRate, "R1.00 W2.50"},
+		{config.DiskTextModePercent, "R10% W25%"},
+		{config.DiskTextModeBoth, "R1.00 W2.50 R10% W25%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			widget := NewDualIOWidget(DualIOConfig{
+				Base:        newMockWidgetBase(128, 40),
+				DisplayMode: render.DisplayModeText,
+				MaxSpeedBps: 10000000, // 10 MB/s
+				Unit:        "MB/s",
+				TextMode:    tt.mode,
+				TextConfig:  DualIOTextConfig{PrimaryPrefix: "R", SecondaryPrefix: "W"},
+				Converter:   util.NewByteRateConverter("MB/s"),
+				HistoryLen:  30,
+			})
+			widget.SetValues(1000000, 2500000)
+
+			if got := widget.formatText(); got != tt.want {
+				t.Errorf("formatText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCalculatePercentages_AutoScaleWindow tests scaling to the observed peak
+func TestCalculatePercentages_AutoScaleWindow(t *testing.T) {
+	widget := NewDualIOWidget(DualIOConfig{
+		Base:            newMockWidgetBase(128, 40),
+		DisplayMode:     render.DisplayModeBar,
+		MaxSpeedBps:     10000000,
+		Unit:            "MB/s",
+		Converter:       util.NewByteRateConverter("MB/s"),
+		HistoryLen:      30,
+		AutoScaleWindow: 30 * time.Second,
+	})
+
+	widget.SetValuesAndHistory(4000000, 1000000, false)
+	widget.SetValuesAndHistory(1000000, 500000, false)
+
+	// The earlier 4 MB/s peak is held, replacing the fixed 10 MB/s maximum
+	primary, secondary := widget.calculatePercentages()
+	if primary != 25 || secondary != 12.5 {
+		t.Errorf("percentages = %v/%v, want 25/12.5", primary, secondary)
+	}
+}
+
 // TestRender_BarMode tests rendering in bar mode
 func TestRender_BarMode(t *testing.T) {
 	widget := createTestWidget(render.DisplayModeBar)
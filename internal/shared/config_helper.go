@@ -5,23 +5,29 @@ import (
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	"github.com/pozitronik/steelclock-go/internal/shared/util"
 	"golang.org/x/image/font"
 )
 
 // TextSettings holds extracted text configuration with defaults
 type TextSettings struct {
-	FontSize   int
-	FontName   string
-	HorizAlign config.HAlign
-	VertAlign  config.VAlign
+	FontSize    int
+	FontName    string
+	HorizAlign  config.HAlign
+	VertAlign   config.VAlign
+	Orientation config.TextOrientation
 }
 
 // BarSettings holds extracted bar configuration with defaults
 type BarSettings struct {
-	Direction string
-	Border    bool
-	FillColor int
+	Direction      string
+	Border         bool
+	FillColor      int
+	BlinkThreshold float64 // Negative = never pulse
+	BlinkRate      float64 // Pulse frequency in Hz
+	Gradient       []uint8 // Fill color stops along the bar (nil = solid FillColor)
 }
 
 // GaugeSettings holds extracted gauge configuration with defaults
@@ -30,6 +36,11 @@ type GaugeSettings struct {
 	NeedleColor int
 	ShowTicks   bool
 	TicksColor  int
+
+	TickLabels    bool
+	LabelInterval int
+
+	Gradient []uint8 // Arc color stops from 0% to 100% (nil = solid ArcColor)
 }
 
 // GraphSettings holds extracted graph configuration with defaults
@@ -62,10 +73,11 @@ func (h *ConfigHelper) GetDisplayMode(defaultMode string) string {
 // GetTextSettings extracts text configuration with defaults
 func (h *ConfigHelper) GetTextSettings() TextSettings {
 	settings := TextSettings{
-		FontSize:   10,
-		FontName:   "",
-		HorizAlign: config.AlignCenter,
-		VertAlign:  config.AlignMiddle,
+		FontSize:    10,
+		FontName:    "",
+		HorizAlign:  config.AlignCenter,
+		VertAlign:   config.AlignMiddle,
+		Orientation: config.OrientationHorizontal,
 	}
 
 	if h.cfg.Text != nil {
@@ -73,6 +85,9 @@ func (h *ConfigHelper) GetTextSettings() TextSettings {
 			settings.FontSize = h.cfg.Text.Size
 		}
 		settings.FontName = h.cfg.Text.Font
+		if h.cfg.Text.Orientation != "" {
+			settings.Orientation = h.cfg.Text.Orientation
+		}
 		if h.cfg.Text.Align != nil {
 			if h.cfg.Text.Align.H != "" {
 				settings.HorizAlign = h.cfg.Text.Align.H
@@ -100,6 +115,9 @@ func (h *ConfigHelper) GetBarSettings() BarSettings {
 		Direction: config.DirectionHorizontal,
 		Border:    false,
 		FillColor: 255,
+
+		BlinkThreshold: -1,
+		BlinkRate:      anim.DefaultPulseRateHz,
 	}
 
 	if h.cfg.Bar != nil {
@@ -107,8 +125,17 @@ func (h *ConfigHelper) GetBarSettings() BarSettings {
 			settings.Direction = h.cfg.Bar.Direction
 		}
 		settings.Border = h.cfg.Bar.Border
-		if h.cfg.Bar.Colors != nil && h.cfg.Bar.Colors.Fill != nil {
-			settings.FillColor = *h.cfg.Bar.Colors.Fill
+		if h.cfg.Bar.Colors != nil {
+			if h.cfg.Bar.Colors.Fill != nil {
+				settings.FillColor = *h.cfg.Bar.Colors.Fill
+			}
+			settings.Gradient = gradientStops(h.cfg.Bar.Colors.Gradient)
+		}
+		if h.cfg.Bar.BlinkThreshold != nil {
+			settings.BlinkThreshold = *h.cfg.Bar.BlinkThreshold
+		}
+		if h.cfg.Bar.BlinkRate > 0 {
+			settings.BlinkRate = h.cfg.Bar.BlinkRate
 		}
 	}
 
@@ -122,12 +149,18 @@ func (h *ConfigHelper) GetGaugeSettings() GaugeSettings {
 		NeedleColor: 255,
 		ShowTicks:   true,
 		TicksColor:  150,
+
+		LabelInterval: bitmap.DefaultGaugeLabelInterval,
 	}
 
 	if h.cfg.Gauge != nil {
 		if h.cfg.Gauge.ShowTicks != nil {
 			settings.ShowTicks = *h.cfg.Gauge.ShowTicks
 		}
+		settings.TickLabels = h.cfg.Gauge.TickLabels
+		if h.cfg.Gauge.LabelInterval > 0 {
+			settings.LabelInterval = h.cfg.Gauge.LabelInterval
+		}
 		if h.cfg.Gauge.Colors != nil {
 			if h.cfg.Gauge.Colors.Arc != nil {
 				settings.ArcColor = *h.cfg.Gauge.Colors.Arc
@@ -138,12 +171,26 @@ func (h *ConfigHelper) GetGaugeSettings() GaugeSettings {
 			if h.cfg.Gauge.Colors.Ticks != nil {
 				settings.TicksColor = *h.cfg.Gauge.Colors.Ticks
 			}
+			settings.Gradient = gradientStops(h.cfg.Gauge.Colors.Gradient)
 		}
 	}
 
 	return settings
 }
 
+// gradientStops converts configured gradient stops to grayscale values, clamping to 0-255.
+// Returns nil for an empty gradient.
+func gradientStops(stops []int) []uint8 {
+	if len(stops) == 0 {
+		return nil
+	}
+	result := make([]uint8, len(stops))
+	for i, v := range stops {
+		result[i] = uint8(min(max(v, 0), 255))
+	}
+	return result
+}
+
 // GetGraphSettings extracts graph configuration with defaults
 func (h *ConfigHelper) GetGraphSettings() GraphSettings {
 	settings := GraphSettings{
@@ -177,6 +224,34 @@ func (h *ConfigHelper) GetPerCoreSettings() (enabled bool, border bool, margin i
 	return false, false, 0
 }
 
+// GetAggregateSettings extracts the metric aggregation mode and window with defaults
+// (CPU, Network, Disk). Unknown modes fall back to "instant".
+func (h *ConfigHelper) GetAggregateSettings() (mode string, window int) {
+	mode = util.AggregateInstant
+	if util.IsValidAggregateMode(h.cfg.Aggregate) {
+		mode = h.cfg.Aggregate
+	}
+
+	window = util.DefaultAggregateWindow
+	if h.cfg.AggregateWindow > 0 {
+		window = h.cfg.AggregateWindow
+	}
+
+	return mode, window
+}
+
+// DefaultStereoDivider is the default divider color between separated stereo channels (mid-gray)
+const DefaultStereoDivider = 64
+
+// GetStereoDivider extracts the divider color drawn between separated stereo channels
+// (volume meter, audio visualizer). Returns -1 when the divider is disabled.
+func (h *ConfigHelper) GetStereoDivider() int {
+	if h.cfg.Stereo != nil && h.cfg.Stereo.Divider != nil {
+		return *h.cfg.Stereo.Divider
+	}
+	return DefaultStereoDivider
+}
+
 // MetricRendererResult holds all outputs from BuildMetricRenderer needed by widget constructors
 type MetricRendererResult struct {
 	Renderer    *render.MetricRenderer
@@ -218,6 +293,11 @@ func (h *ConfigHelper) BuildMetricRenderer() (*MetricRendererResult, error) {
 			Direction: barSettings.Direction,
 			Border:    barSettings.Border,
 			Color:     barColor,
+
+			Gradient:       barSettings.Gradient,
+			Blink:          barSettings.BlinkThreshold >= 0,
+			BlinkThreshold: barSettings.BlinkThreshold,
+			BlinkRate:      barSettings.BlinkRate,
 		},
 		render.GraphConfig{
 			FillColor:  graphSettings.FillColor,
@@ -229,13 +309,19 @@ func (h *ConfigHelper) BuildMetricRenderer() (*MetricRendererResult, error) {
 			NeedleColor: uint8(gaugeSettings.NeedleColor),
 			ShowTicks:   gaugeSettings.ShowTicks,
 			TicksColor:  uint8(gaugeSettings.TicksColor),
+
+			TickLabels:    gaugeSettings.TickLabels,
+			LabelInterval: gaugeSettings.LabelInterval,
+
+			ArcGradient: gaugeSettings.Gradient,
 		},
 		render.TextConfig{
-			FontFace:   fontFace,
-			FontName:   textSettings.FontName,
-			HorizAlign: textSettings.HorizAlign,
-			VertAlign:  textSettings.VertAlign,
-			Padding:    padding,
+			FontFace:    fontFace,
+			FontName:    textSettings.FontName,
+			HorizAlign:  textSettings.HorizAlign,
+			VertAlign:   textSettings.VertAlign,
+			Padding:     padding,
+			Orientation: textSettings.Orientation,
 		},
 	)
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/util"
 )
 
 func TestConfigHelper_GetDisplayMode(t *testing.T) {
@@ -135,6 +136,31 @@ func TestConfigHelper_GetBarSettings(t *testing.T) {
 		if settings.FillColor != 255 {
 			t.Errorf("FillColor = %d, want 255", settings.FillColor)
 		}
+		if settings.BlinkThreshold >= 0 {
+			t.Errorf("BlinkThreshold = %v, want negative (never)", settings.BlinkThreshold)
+		}
+	})
+
+	t.Run("blink", func(t *testing.T) {
+		cfg := config.WidgetConfig{
+			Bar: &config.BarConfig{BlinkThreshold: config.Float64Ptr(90), BlinkRate: 2.5},
+		}
+		settings := NewConfigHelper(cfg).GetBarSettings()
+
+		if settings.BlinkThreshold != 90 || settings.BlinkRate != 2.5 {
+			t.Errorf("blink = %v at %v Hz, want 90 at 2.5 Hz", settings.BlinkThreshold, settings.BlinkRate)
+		}
+	})
+
+	t.Run("gradient", func(t *testing.T) {
+		cfg := config.WidgetConfig{
+			Bar: &config.BarConfig{Colors: &config.ModeColorsConfig{Gradient: []int{-5, 128, 300}}},
+		}
+		settings := NewConfigHelper(cfg).GetBarSettings()
+
+		if len(settings.Gradient) != 3 || settings.Gradient[0] != 0 || settings.Gradient[1] != 128 || settings.Gradient[2] != 255 {
+			t.Errorf("Gradient = %v, want [0 128 255]", settings.Gradient)
+		}
 	})
 
 	t.Run("custom values", func(t *testing.T) {
@@ -181,6 +207,20 @@ func TestConfigHelper_GetGaugeSettings(t *testing.T) {
 		if settings.TicksColor != 150 {
 			t.Errorf("TicksColor = %d, want 150", settings.TicksColor)
 		}
+		if settings.Gradient != nil {
+			t.Errorf("Gradient = %v, want nil", settings.Gradient)
+		}
+	})
+
+	t.Run("gradient", func(t *testing.T) {
+		cfg := config.WidgetConfig{
+			Gauge: &config.GaugeConfig{Colors: &config.ModeColorsConfig{Gradient: []int{60, 255}}},
+		}
+		settings := NewConfigHelper(cfg).GetGaugeSettings()
+
+		if len(settings.Gradient) != 2 || settings.Gradient[0] != 60 || settings.Gradient[1] != 255 {
+			t.Errorf("Gradient = %v, want [60 255]", settings.Gradient)
+		}
 	})
 
 	t.Run("custom values", func(t *testing.T) {
@@ -323,6 +363,56 @@ func TestConfigHelper_GetPerCoreSettings(t *testing.T) {
 	})
 }
 
+func TestConfigHelper_GetAggregateSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		aggregate  string
+		window     int
+		wantMode   string
+		wantWindow int
+	}{
+		{"defaults", "", 0, util.AggregateInstant, util.DefaultAggregateWindow},
+		{"custom values", "max", 5, util.AggregateMax, 5},
+		{"invalid mode falls back", "median", 0, util.AggregateInstant, util.DefaultAggregateWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewConfigHelper(config.WidgetConfig{Aggregate: tt.aggregate, AggregateWindow: tt.window})
+			mode, window := h.GetAggregateSettings()
+
+			if mode != tt.wantMode {
+				t.Errorf("mode = %q, want %q", mode, tt.wantMode)
+			}
+			if window != tt.wantWindow {
+				t.Errorf("window = %d, want %d", window, tt.wantWindow)
+			}
+		})
+	}
+}
+
+func TestConfigHelper_GetStereoDivider(t *testing.T) {
+	tests := []struct {
+		name   string
+		stereo *config.StereoConfig
+		want   int
+	}{
+		{"defaults (nil)", nil, DefaultStereoDivider},
+		{"divider not set", &config.StereoConfig{Enabled: true}, DefaultStereoDivider},
+		{"custom color", &config.StereoConfig{Divider: config.IntPtr(200)}, 200},
+		{"disabled", &config.StereoConfig{Divider: config.IntPtr(-1)}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewConfigHelper(config.WidgetConfig{Stereo: tt.stereo})
+			if got := h.GetStereoDivider(); got != tt.want {
+				t.Errorf("GetStereoDivider() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConfigHelper_GetFillColorForMode(t *testing.T) {
 	tests := []struct {
 		name string
@@ -2,9 +2,12 @@ package render
 
 import (
 	"image"
+	"math"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"golang.org/x/image/font"
 )
 
@@ -23,6 +26,11 @@ type BarConfig struct {
 	Direction string // "horizontal" or "vertical"
 	Border    bool
 	Color     uint8
+	Gradient  []uint8 // Fill color stops along the bar; nil = solid Color
+
+	Blink          bool    // Pulse the fill while the value is at or above BlinkThreshold
+	BlinkThreshold float64 // Value (0-100) that starts the pulse
+	BlinkRate      float64 // Pulse frequency in Hz
 }
 
 // GraphConfig holds configuration for graph rendering
@@ -38,15 +46,21 @@ type GaugeConfig struct {
 	NeedleColor uint8
 	ShowTicks   bool
 	TicksColor  uint8
+
+	TickLabels    bool
+	LabelInterval int
+
+	ArcGradient []uint8 // Arc color stops from 0% to 100%; nil = solid ArcColor
 }
 
 // TextConfig holds configuration for text rendering
 type TextConfig struct {
-	FontFace   font.Face
-	FontName   string
-	HorizAlign config.HAlign
-	VertAlign  config.VAlign
-	Padding    int
+	FontFace    font.Face
+	FontName    string
+	HorizAlign  config.HAlign
+	VertAlign   config.VAlign
+	Padding     int
+	Orientation config.TextOrientation
 }
 
 // MetricRenderer handles rendering of single-value metrics (0-100 percentage)
@@ -69,13 +83,56 @@ func NewMetricRenderer(bar BarConfig, graph GraphConfig, gauge GaugeConfig, text
 
 // RenderBar renders a single-value bar (horizontal or vertical)
 func (r *MetricRenderer) RenderBar(img *image.Gray, x, y, w, h int, value float64) {
-	if r.Bar.Direction == config.DirectionVertical {
-		bitmap.DrawVerticalBar(img, x, y, w, h, value, r.Bar.Color, r.Bar.Border)
+	r.drawBar(img, x, y, w, h, value, r.Bar.Border, time.Now())
+}
+
+// drawBar draws a bar in the configured direction, with the gradient fill when set
+// and dimmed by the pulse at the given time
+func (r *MetricRenderer) drawBar(img *image.Gray, x, y, w, h int, value float64, border bool, now time.Time) {
+	level := r.barPulseLevel(value, now)
+	color := dim(r.Bar.Color, level)
+	vertical := r.Bar.Direction == config.DirectionVertical
+
+	if len(r.Bar.Gradient) == 0 {
+		if vertical {
+			bitmap.DrawVerticalBar(img, x, y, w, h, value, color, border)
+		} else {
+			bitmap.DrawHorizontalBar(img, x, y, w, h, value, color, border)
+		}
+		return
+	}
+
+	stops := r.Bar.Gradient
+	if level < 1 {
+		stops = make([]uint8, len(r.Bar.Gradient))
+		for i, s := range r.Bar.Gradient {
+			stops[i] = dim(s, level)
+		}
+	}
+	if vertical {
+		bitmap.DrawVerticalBarGradient(img, x, y, w, h, value, stops, color, border)
 	} else {
-		bitmap.DrawHorizontalBar(img, x, y, w, h, value, r.Bar.Color, r.Bar.Border)
+		bitmap.DrawHorizontalBarGradient(img, x, y, w, h, value, stops, color, border)
 	}
 }
 
+// barPulseLevel returns the brightness factor of the bar fill at the given time:
+// the pulse level while the value is at or above the blink threshold, 1 otherwise
+func (r *MetricRenderer) barPulseLevel(value float64, now time.Time) float64 {
+	if !r.Bar.Blink || value < r.Bar.BlinkThreshold {
+		return 1
+	}
+	return anim.PulseLevel(now, r.Bar.BlinkRate)
+}
+
+// dim scales a grayscale value by a brightness factor
+func dim(c uint8, level float64) uint8 {
+	if level >= 1 {
+		return c
+	}
+	return uint8(math.Round(float64(c) * level))
+}
+
 // RenderGraph renders a graph from history data
 func (r *MetricRenderer) RenderGraph(img *image.Gray, x, y, w, h int, history []float64) {
 	bitmap.DrawGraph(img, x, y, w, h, history, r.Graph.HistoryLen, r.Graph.FillColor, r.Graph.LineColor)
@@ -83,12 +140,24 @@ func (r *MetricRenderer) RenderGraph(img *image.Gray, x, y, w, h int, history []
 
 // RenderGauge renders a gauge for a single value
 func (r *MetricRenderer) RenderGauge(img *image.Gray, x, y, w, h int, value float64) {
+	r.drawGauge(img, x, y, w, h, value)
+	if r.Gauge.TickLabels {
+		bitmap.DrawGaugeTickLabels(img, x, y, w, h, r.Gauge.LabelInterval, r.Gauge.TicksColor)
+	}
+}
+
+// drawGauge draws the gauge dial, with the gradient arc when set
+func (r *MetricRenderer) drawGauge(img *image.Gray, x, y, w, h int, value float64) {
+	if len(r.Gauge.ArcGradient) > 0 {
+		bitmap.DrawGaugeGradient(img, x, y, w, h, value, r.Gauge.ArcGradient, r.Gauge.NeedleColor, r.Gauge.ShowTicks, r.Gauge.TicksColor)
+		return
+	}
 	bitmap.DrawGauge(img, x, y, w, h, value, r.Gauge.ArcColor, r.Gauge.NeedleColor, r.Gauge.ShowTicks, r.Gauge.TicksColor)
 }
 
 // RenderText renders aligned text
 func (r *MetricRenderer) RenderText(img *image.Gray, text string) {
-	bitmap.SmartDrawAlignedText(img, text, r.Text.FontFace, r.Text.FontName, r.Text.HorizAlign, r.Text.VertAlign, r.Text.Padding)
+	bitmap.SmartDrawAlignedTextOriented(img, text, r.Text.FontFace, r.Text.FontName, r.Text.HorizAlign, r.Text.VertAlign, r.Text.Padding, r.Text.Orientation)
 }
 
 // Render dispatches to the appropriate render method based on display mode
@@ -172,5 +241,5 @@ func (r *DualMetricRenderer) RenderGauge(img *image.Gray, pos config.PositionCon
 
 // RenderText renders aligned text
 func (r *DualMetricRenderer) RenderText(img *image.Gray, text string) {
-	bitmap.SmartDrawAlignedText(img, text, r.Text.FontFace, r.Text.FontName, r.Text.HorizAlign, r.Text.VertAlign, r.Text.Padding)
+	bitmap.SmartDrawAlignedTextOriented(img, text, r.Text.FontFace, r.Text.FontName, r.Text.HorizAlign, r.Text.VertAlign, r.Text.Padding, r.Text.Orientation)
 }
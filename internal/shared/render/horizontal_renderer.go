@@ -18,6 +18,7 @@ type HorizontalTextRendererConfig struct {
 	ScrollMode    anim.ScrollMode
 	ScrollGap     int
 	ScrollEnabled bool
+	Orientation   config.TextOrientation
 }
 
 // HorizontalTextRenderer handles rendering of single-line text with optional horizontal scrolling
@@ -29,6 +30,7 @@ type HorizontalTextRenderer struct {
 	scrollMode    anim.ScrollMode
 	scrollGap     int
 	scrollEnabled bool
+	orientation   config.TextOrientation
 }
 
 // NewHorizontalTextRenderer creates a new HorizontalTextRenderer with the specified configuration
@@ -41,6 +43,7 @@ func NewHorizontalTextRenderer(cfg HorizontalTextRendererConfig) *HorizontalText
 		scrollMode:    cfg.ScrollMode,
 		scrollGap:     cfg.ScrollGap,
 		scrollEnabled: cfg.ScrollEnabled,
+		orientation:   cfg.Orientation,
 	}
 }
 
@@ -50,88 +53,174 @@ func (r *HorizontalTextRenderer) MeasureTextWidth(text string) int {
 	return width
 }
 
-// Render draws single-line text with optional horizontal scrolling
+// ScrollExtent returns the text length and the visible length along the reading axis,
+// which is the vertical axis for rotated and stacked orientations.
+// Callers pass these to the scroller instead of raw widths.
+func (r *HorizontalTextRenderer) ScrollExtent(text string, bounds image.Rectangle) (textLen, viewLen int) {
+	if r.orientation == config.OrientationVerticalStacked {
+		_, h := bitmap.SmartMeasureStackedText(text, r.fontFace, r.fontName)
+		return h, bounds.Dy()
+	}
+	if bitmap.IsRotatedOrientation(r.orientation) {
+		return r.MeasureTextWidth(text), bounds.Dy()
+	}
+	return r.MeasureTextWidth(text), bounds.Dx()
+}
+
+// Render draws single-line text with optional scrolling along the reading axis
 // scrollOffset is the current scroll position in pixels
 func (r *HorizontalTextRenderer) Render(img *image.Gray, text string, scrollOffset float64, bounds image.Rectangle) {
+	switch {
+	case bitmap.IsRotatedOrientation(r.orientation):
+		// Render horizontally into a buffer with swapped dimensions, then rotate into place
+		buf := image.NewGray(image.Rect(0, 0, bounds.Dy(), bounds.Dx()))
+		r.renderHorizontal(buf, text, scrollOffset, buf.Bounds())
+		bitmap.BlitRotated(img, buf, bounds.Min.X, bounds.Min.Y, r.orientation)
+	case r.orientation == config.OrientationVerticalStacked:
+		r.renderStacked(img, text, scrollOffset, bounds)
+	default:
+		r.renderHorizontal(img, text, scrollOffset, bounds)
+	}
+}
+
+// RenderStyled draws a line of styled text like Render. Stacked orientation draws the
+// plain text, since its glyphs are laid out one per row.
+func (r *HorizontalTextRenderer) RenderStyled(img *image.Gray, text StyledText, scrollOffset float64, bounds image.Rectangle) {
+	switch {
+	case bitmap.IsRotatedOrientation(r.orientation):
+		buf := image.NewGray(image.Rect(0, 0, bounds.Dy(), bounds.Dx()))
+		r.renderStyledHorizontal(buf, text, scrollOffset, buf.Bounds())
+		bitmap.BlitRotated(img, buf, bounds.Min.X, bounds.Min.Y, r.orientation)
+	case r.orientation == config.OrientationVerticalStacked:
+		r.renderStacked(img, text.String(), scrollOffset, bounds)
+	default:
+		r.renderStyledHorizontal(img, text, scrollOffset, bounds)
+	}
+}
+
+// renderHorizontal draws single-line text with optional horizontal scrolling
+func (r *HorizontalTextRenderer) renderHorizontal(img *image.Gray, text string, scrollOffset float64, bounds image.Rectangle) {
 	x, y := bounds.Min.X, bounds.Min.Y
 	width, height := bounds.Dx(), bounds.Dy()
 
-	textWidth := r.MeasureTextWidth(text)
-
 	// Calculate base position
 	textX, textY := bitmap.SmartCalculateTextPosition(text, r.fontFace, r.fontName, x, y, width, height, r.horizAlign, r.vertAlign)
 
+	r.scrollHorizontal(r.MeasureTextWidth(text), textX, scrollOffset, bounds, func(left int) {
+		bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, left, textY, x, y, width, height)
+	})
+}
+
+// renderStyledHorizontal draws a line of styled text with optional horizontal scrolling
+func (r *HorizontalTextRenderer) renderStyledHorizontal(img *image.Gray, text StyledText, scrollOffset float64, bounds image.Rectangle) {
+	textWidth, _ := text.Measure()
+	textX := AlignedX(textWidth, bounds.Min.X, bounds.Dx(), r.horizAlign)
+
+	r.scrollHorizontal(textWidth, textX, scrollOffset, bounds, func(left int) {
+		DrawStyledTextAt(img, text, left, bounds.Min.Y, bounds.Dy(), r.vertAlign, bounds)
+	})
+}
+
+// scrollHorizontal calls draw with the left edge of each visible copy of text,
+// which is textX when the text fits or scrolling is disabled
+func (r *HorizontalTextRenderer) scrollHorizontal(textWidth, textX int, scrollOffset float64, bounds image.Rectangle, draw func(left int)) {
+	x, width := bounds.Min.X, bounds.Dx()
+
 	// If text fits or scrolling disabled, draw normally
 	if textWidth <= width || !r.scrollEnabled {
-		bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, textX, textY, x, y, width, height)
+		draw(textX)
 		return
 	}
 
 	// Handle scrolling - text is wider than container
-	totalWidth := textWidth + r.scrollGap
-
 	switch r.scrollMode {
 	case anim.ScrollContinuous:
-		r.renderContinuousScroll(img, text, textY, scrollOffset, totalWidth, x, y, width, height)
+		totalWidth := textWidth + r.scrollGap
+		scrollX := x - int(scrollOffset)%totalWidth
+
+		// Draw first copy, then a second one for seamless loop
+		draw(scrollX)
+		if scrollX+totalWidth < x+width {
+			draw(scrollX + totalWidth)
+		}
 
 	case anim.ScrollBounce:
-		r.renderBounceScroll(img, text, textX, textY, scrollOffset, textWidth, x, y, width, height)
+		maxOffset := float64(textWidth - width)
+		offset := scrollOffset
+		cycle := int(offset / maxOffset)
+		progress := offset - float64(cycle)*maxOffset
+		if cycle%2 == 1 {
+			progress = maxOffset - progress
+		}
+		draw(x - int(progress))
 
 	case anim.ScrollPauseEnds:
-		r.renderPauseEndsScroll(img, text, textX, textY, scrollOffset, textWidth, x, y, width, height)
+		maxOffset := textWidth - width
+		pausePixels := 100
+		offset := int(scrollOffset) % (maxOffset + pausePixels)
+		if offset > maxOffset {
+			offset = maxOffset
+		}
+		draw(x - offset)
 
 	default:
 		// No scrolling, draw at calculated position
-		bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, textX, textY, x, y, width, height)
+		draw(textX)
 	}
 }
 
-// renderContinuousScroll renders with continuous looping scroll
-func (r *HorizontalTextRenderer) renderContinuousScroll(img *image.Gray, text string, textY int, scrollOffset float64, totalWidth, x, y, width, height int) {
-	offset := int(scrollOffset) % totalWidth
-	scrollX := x - offset
+// renderStacked draws text with one upright glyph per row, scrolling vertically when it does not fit
+func (r *HorizontalTextRenderer) renderStacked(img *image.Gray, text string, scrollOffset float64, bounds image.Rectangle) {
+	x, y := bounds.Min.X, bounds.Min.Y
+	width, height := bounds.Dx(), bounds.Dy()
 
-	// Draw first copy
-	bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, scrollX, textY, x, y, width, height)
+	_, textHeight := bitmap.SmartMeasureStackedText(text, r.fontFace, r.fontName)
 
-	// Draw second copy for seamless loop
-	scrollX2 := scrollX + totalWidth
-	if scrollX2 < x+width {
-		bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, scrollX2, textY, x, y, width, height)
+	draw := func(top int) {
+		bitmap.SmartDrawStackedTextAt(img, text, r.fontFace, r.fontName, x, top, width, r.horizAlign, x, y, width, height)
 	}
-}
 
-// renderBounceScroll renders with bounce-back scroll
-func (r *HorizontalTextRenderer) renderBounceScroll(img *image.Gray, text string, textX, textY int, scrollOffset float64, textWidth, x, y, width, height int) {
-	maxOffset := float64(textWidth - width)
-	if maxOffset <= 0 {
-		bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, textX, textY, x, y, width, height)
+	// If text fits or scrolling disabled, align vertically
+	if textHeight <= height || !r.scrollEnabled {
+		switch r.vertAlign {
+		case config.AlignTop:
+			draw(y)
+		case config.AlignBottom:
+			draw(y + height - textHeight)
+		default:
+			draw(y + (height-textHeight)/2)
+		}
 		return
 	}
 
-	offset := scrollOffset
-	cycle := int(offset / maxOffset)
-	progress := offset - float64(cycle)*maxOffset
-	if cycle%2 == 1 {
-		progress = maxOffset - progress
-	}
-	scrollX := x - int(progress)
-	bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, scrollX, textY, x, y, width, height)
-}
+	maxOffset := textHeight - height
 
-// renderPauseEndsScroll renders with pause at ends scroll
-func (r *HorizontalTextRenderer) renderPauseEndsScroll(img *image.Gray, text string, textX, textY int, scrollOffset float64, textWidth, x, y, width, height int) {
-	maxOffset := float64(textWidth - width)
-	if maxOffset <= 0 {
-		bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, textX, textY, x, y, width, height)
-		return
-	}
+	switch r.scrollMode {
+	case anim.ScrollContinuous:
+		totalHeight := textHeight + r.scrollGap
+		top := y - int(scrollOffset)%totalHeight
+		draw(top)
+		if top+totalHeight < y+height {
+			draw(top + totalHeight)
+		}
+
+	case anim.ScrollBounce:
+		cycle := int(scrollOffset / float64(maxOffset))
+		progress := scrollOffset - float64(cycle*maxOffset)
+		if cycle%2 == 1 {
+			progress = float64(maxOffset) - progress
+		}
+		draw(y - int(progress))
+
+	case anim.ScrollPauseEnds:
+		pausePixels := 100
+		offset := int(scrollOffset) % (maxOffset + pausePixels)
+		if offset > maxOffset {
+			offset = maxOffset
+		}
+		draw(y - offset)
 
-	pausePixels := 100
-	offset := int(scrollOffset) % (int(maxOffset) + pausePixels)
-	if offset > int(maxOffset) {
-		offset = int(maxOffset)
+	default:
+		draw(y)
 	}
-	scrollX := x - offset
-	bitmap.SmartDrawTextAtPosition(img, text, r.fontFace, r.fontName, scrollX, textY, x, y, width, height)
 }
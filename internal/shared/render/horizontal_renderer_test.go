@@ -266,3 +266,75 @@ func TestHorizontalTextRenderer_PauseEndsEdgeCases(t *testing.T) {
 		r.Render(img, "Short", 100.0, bounds)
 	})
 }
+
+func TestHorizontalTextRenderer_Orientation(t *testing.T) {
+	bounds := image.Rect(0, 0, 12, 40)
+
+	newRenderer := func(o config.TextOrientation, scroll bool) *HorizontalTextRenderer {
+		return NewHorizontalTextRenderer(HorizontalTextRendererConfig{
+			FontName:      "pixel5x7",
+			HorizAlign:    config.AlignCenter,
+			VertAlign:     config.AlignTop,
+			ScrollMode:    anim.ScrollContinuous,
+			ScrollGap:     5,
+			ScrollEnabled: scroll,
+			Orientation:   o,
+		})
+	}
+
+	t.Run("scroll extent follows the reading axis", func(t *testing.T) {
+		textLen, viewLen := newRenderer(config.OrientationHorizontal, true).ScrollExtent("ABCDEF", bounds)
+		if viewLen != 12 {
+			t.Errorf("horizontal viewLen = %d, want 12", viewLen)
+		}
+		horizLen := textLen
+
+		textLen, viewLen = newRenderer(config.OrientationRotateCW, true).ScrollExtent("ABCDEF", bounds)
+		if viewLen != 40 || textLen != horizLen {
+			t.Errorf("rotate_cw extent = (%d, %d), want (%d, 40)", textLen, viewLen, horizLen)
+		}
+
+		textLen, viewLen = newRenderer(config.OrientationVerticalStacked, true).ScrollExtent("ABCDEF", bounds)
+		if viewLen != 40 || textLen != 42 {
+			t.Errorf("vertical_stacked extent = (%d, %d), want (42, 40)", textLen, viewLen)
+		}
+	})
+
+	t.Run("rotated text is drawn within bounds", func(t *testing.T) {
+		for _, o := range []config.TextOrientation{config.OrientationRotateCW, config.OrientationRotateCCW, config.OrientationVerticalStacked} {
+			img := image.NewGray(image.Rect(0, 0, 20, 50))
+			newRenderer(o, true).Render(img, "ABCDEFGH", 7, bounds)
+
+			lit := false
+			for y := 0; y < 50; y++ {
+				for x := 0; x < 20; x++ {
+					if img.GrayAt(x, y).Y == 0 {
+						continue
+					}
+					lit = true
+					if !(image.Point{X: x, Y: y}.In(bounds)) {
+						t.Fatalf("%s: pixel (%d,%d) drawn outside bounds", o, x, y)
+					}
+				}
+			}
+			if !lit {
+				t.Errorf("%s: nothing drawn", o)
+			}
+		}
+	})
+
+	t.Run("scrolling moves stacked text vertically", func(t *testing.T) {
+		r := newRenderer(config.OrientationVerticalStacked, true)
+		a := image.NewGray(bounds)
+		b := image.NewGray(bounds)
+		r.Render(a, "ABCDEFGH", 0, bounds)
+		r.Render(b, "ABCDEFGH", 7, bounds)
+
+		// Shifting by one glyph row should make row 0 of b match row 7 of a
+		for x := 0; x < bounds.Dx(); x++ {
+			if a.GrayAt(x, 7).Y != b.GrayAt(x, 0).Y {
+				t.Fatalf("column %d: scrolled row mismatch", x)
+			}
+		}
+	})
+}
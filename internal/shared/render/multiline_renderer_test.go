@@ -2,6 +2,7 @@ package render
 
 import (
 	"image"
+	"strings"
 	"testing"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
@@ -136,6 +137,24 @@ func TestMultiLineRenderer_MeasureTotalHeight(t *testing.T) {
 			t.Errorf("Expected %d for 3 lines, got %d", expected, height)
 		}
 	})
+
+	// CJK text has no spaces, so both modes break between characters
+	t.Run("CJK text", func(t *testing.T) {
+		text := strings.Repeat("你好世界", 5)
+		charWidth, _ := bitmap.SmartMeasureText("你", r.fontFace, r.fontName)
+		if charWidth == 0 {
+			t.Skip("font does not measure CJK characters")
+		}
+		perLine := 100 / charWidth
+		expected := (20 + perLine - 1) / perLine * r.MeasureLineHeight()
+
+		for _, wordBreak := range []string{"normal", "break-all"} {
+			cjk := NewMultiLineRenderer(MultiLineRendererConfig{FontFace: r.fontFace, WordBreak: wordBreak}, 100)
+			if height := cjk.MeasureTotalHeight(text, 100); height != expected {
+				t.Errorf("%s: expected %d, got %d", wordBreak, expected, height)
+			}
+		}
+	})
 }
 
 func TestMultiLineRenderer_WrapText(t *testing.T) {
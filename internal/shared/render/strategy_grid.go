@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image"
 	"math"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
@@ -21,6 +22,7 @@ type GridMetricData struct {
 	BorderColor uint8
 	FontFace    font.Face
 	FontName    string
+	Orientation config.TextOrientation
 }
 
 // GridMetricDisplayStrategy defines the interface for rendering grid-based metrics.
@@ -119,8 +121,8 @@ func (s *GridTextDisplayStrategy) Render(img *image.Gray, data GridMetricData, _
 
 		// Format and draw text centered in cell
 		text := fmt.Sprintf("%.0f", value)
-		bitmap.SmartDrawTextInRect(img, text, data.FontFace, data.FontName,
-			cellX, cellY, cellWidth, cellHeight, "center", "center", 0)
+		bitmap.SmartDrawTextInRectOriented(img, text, data.FontFace, data.FontName,
+			cellX, cellY, cellWidth, cellHeight, config.AlignCenter, config.AlignMiddle, 0, data.Orientation)
 	}
 
 	_ = rows // suppress unused warning
@@ -136,20 +138,20 @@ func (s *GridBarDisplayStrategy) Render(img *image.Gray, data GridMetricData, re
 	}
 
 	r := data.ContentArea
-	barColor := renderer.Bar.Color
 	border := renderer.Bar.Border || data.CoreBorder
+	now := time.Now() // Cores above the blink threshold pulse in sync
 
 	if renderer.Bar.Direction == config.DirectionVertical {
 		coreWidth := (r.Dx() - (numCells-1)*data.CoreMargin) / numCells
 		for i, value := range data.Values {
 			coreX := r.Min.X + i*(coreWidth+data.CoreMargin)
-			bitmap.DrawVerticalBar(img, coreX, r.Min.Y, coreWidth, r.Dy(), value, barColor, border)
+			renderer.drawBar(img, coreX, r.Min.Y, coreWidth, r.Dy(), value, border, now)
 		}
 	} else {
 		coreHeight := (r.Dy() - (numCells-1)*data.CoreMargin) / numCells
 		for i, value := range data.Values {
 			coreY := r.Min.Y + i*(coreHeight+data.CoreMargin)
-			bitmap.DrawHorizontalBar(img, r.Min.X, coreY, r.Dx(), coreHeight, value, barColor, border)
+			renderer.drawBar(img, r.Min.X, coreY, r.Dx(), coreHeight, value, border, now)
 		}
 	}
 }
@@ -210,9 +212,11 @@ func (s *GridGaugeDisplayStrategy) Render(img *image.Gray, data GridMetricData,
 			bitmap.DrawRectangle(img, cellX, cellY, cellWidth, cellHeight, data.BorderColor)
 		}
 
-		bitmap.DrawGauge(img, cellX, cellY, cellWidth, cellHeight, value,
-			renderer.Gauge.ArcColor, renderer.Gauge.NeedleColor,
-			renderer.Gauge.ShowTicks, renderer.Gauge.TicksColor)
+		renderer.drawGauge(img, cellX, cellY, cellWidth, cellHeight, value)
+		if renderer.Gauge.TickLabels {
+			bitmap.DrawGaugeTickLabels(img, cellX, cellY, cellWidth, cellHeight,
+				renderer.Gauge.LabelInterval, renderer.Gauge.TicksColor)
+		}
 	}
 
 	_ = rows // suppress unused warning
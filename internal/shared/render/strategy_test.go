@@ -3,6 +3,7 @@ package render
 import (
 	"image"
 	"testing"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
@@ -229,3 +230,73 @@ func TestStrategyStatelessness(t *testing.T) {
 
 	// Both renders should succeed without interference
 }
+
+func TestMetricRenderer_BarBlink(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	trough := epoch.Add(500 * time.Millisecond) // Half a cycle at 1 Hz
+
+	tests := []struct {
+		name  string
+		bar   BarConfig
+		value float64
+		at    time.Time
+		want  uint8
+	}{
+		{"blink disabled", BarConfig{Color: 200}, 95, trough, 200},
+		{"below threshold", BarConfig{Color: 200, Blink: true, BlinkThreshold: 90, BlinkRate: 1}, 50, trough, 200},
+		{"above threshold dims", BarConfig{Color: 200, Blink: true, BlinkThreshold: 90, BlinkRate: 1}, 95, trough, 50},
+		{"above threshold at full phase", BarConfig{Color: 200, Blink: true, BlinkThreshold: 90, BlinkRate: 1}, 95, epoch, 200},
+		{"zero threshold always pulses", BarConfig{Color: 200, Blink: true, BlinkRate: 1}, 0, trough, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewMetricRenderer(tt.bar, GraphConfig{}, GaugeConfig{}, TextConfig{})
+			if got := dim(tt.bar.Color, r.barPulseLevel(tt.value, tt.at)); got != tt.want {
+				t.Errorf("bar color at %v = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetricRenderer_Gradient(t *testing.T) {
+	t.Run("bar fill follows stops", func(t *testing.T) {
+		r := NewMetricRenderer(BarConfig{Direction: config.DirectionHorizontal, Color: 255, Gradient: []uint8{50, 250}}, GraphConfig{}, GaugeConfig{}, TextConfig{})
+		img := bitmap.NewGrayscaleImage(101, 4, 0)
+
+		r.RenderBar(img, 0, 0, 101, 4, 100)
+
+		if left, right := img.GrayAt(0, 2).Y, img.GrayAt(100, 2).Y; left != 50 || right != 250 {
+			t.Errorf("bar ends = %d..%d, want 50..250", left, right)
+		}
+	})
+
+	t.Run("pulse dims stops", func(t *testing.T) {
+		r := NewMetricRenderer(BarConfig{Direction: config.DirectionHorizontal, Color: 255, Gradient: []uint8{200, 200}, Blink: true, BlinkRate: 1}, GraphConfig{}, GaugeConfig{}, TextConfig{})
+		img := bitmap.NewGrayscaleImage(10, 4, 0)
+
+		r.drawBar(img, 0, 0, 10, 4, 100, false, time.Unix(0, 0).Add(500*time.Millisecond))
+
+		if got := img.GrayAt(5, 2).Y; got != 50 {
+			t.Errorf("pulsed gradient pixel = %d, want 50", got)
+		}
+	})
+
+	t.Run("gauge arc follows stops", func(t *testing.T) {
+		r := NewMetricRenderer(BarConfig{}, GraphConfig{}, GaugeConfig{ArcColor: 255, NeedleColor: 255, ArcGradient: []uint8{40, 40}}, TextConfig{})
+		img := bitmap.NewGrayscaleImage(64, 40, 0)
+
+		r.RenderGauge(img, 0, 0, 64, 40, 0)
+
+		found := false
+		for _, p := range img.Pix {
+			if p == 40 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Error("gradient gauge should draw the arc with gradient stops")
+		}
+	})
+}
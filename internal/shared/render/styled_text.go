@@ -0,0 +1,156 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"strings"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"golang.org/x/image/font"
+)
+
+// StyledRun is a piece of a line drawn in one gray level and font
+type StyledRun struct {
+	Text     string
+	Color    uint8     // Gray level
+	FontFace font.Face // TrueType face, nil for an internal font
+	FontName string
+}
+
+// sameStyle reports whether two runs draw alike, so they can be merged
+func (r StyledRun) sameStyle(o StyledRun) bool {
+	return r.Color == o.Color && r.FontFace == o.FontFace && r.FontName == o.FontName
+}
+
+// StyledText is a line of text made of runs with differing gray levels and fonts,
+// built from a format whose tokens carry styles such as {title|dim}
+type StyledText []StyledRun
+
+// String returns the plain text of the line
+func (s StyledText) String() string {
+	var b strings.Builder
+	for _, run := range s {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
+
+// Prefix returns the first n runes of the line, keeping their styles
+func (s StyledText) Prefix(n int) StyledText {
+	var prefix StyledText
+	for _, run := range s {
+		if n <= 0 {
+			break
+		}
+		runes := []rune(run.Text)
+		if len(runes) > n {
+			run.Text = string(runes[:n])
+		}
+		n -= len(runes)
+		prefix = append(prefix, run)
+	}
+	return prefix
+}
+
+// Measure returns the width of the line and the height of its tallest run
+func (s StyledText) Measure() (width, height int) {
+	for _, run := range s {
+		w, h := bitmap.SmartMeasureText(run.Text, run.FontFace, run.FontName)
+		width += w
+		height = max(height, h)
+	}
+	return width, height
+}
+
+// StyleTokens builds styled text from parsed format tokens. value returns the text of a
+// token, or ok=false for tokens the widget does not know, which keep their source text.
+// Tokens without a style, and literals, are drawn in base; fonts holds the faces of the
+// fonts named by token styles (see LoadStyleFonts). Adjacent runs of one style are merged,
+// so a format without styles gives a single run drawn exactly like plain text.
+func StyleTokens(tokens []Token, value func(t Token) (text string, ok bool), base StyledRun, fonts map[string]font.Face) StyledText {
+	var text StyledText
+	for _, t := range tokens {
+		run := base
+		switch {
+		case t.Type == TokenLiteral && t.Name == "":
+			run.Text = t.Literal
+		default:
+			v, ok := value(t)
+			if !ok {
+				run.Text = t.Raw
+				break
+			}
+			run.Text = v
+			run.Color = t.Style.TextColor(base.Color)
+			if face, loaded := fonts[t.Style.Font]; loaded {
+				run.FontFace, run.FontName = face, t.Style.Font
+			}
+		}
+		if run.Text == "" {
+			continue
+		}
+		if n := len(text); n > 0 && text[n-1].sameStyle(run) {
+			text[n-1].Text += run.Text
+			continue
+		}
+		text = append(text, run)
+	}
+	return text
+}
+
+// LoadStyleFonts loads the fonts named by token styles, e.g. {temp|font=pixel5x7}, at the
+// given size. Unlike bitmap.LoadFont, which falls back to the default font, an unknown
+// font name is an error, so a typo does not go unnoticed.
+func LoadStyleFonts(tokens []Token, size int) (map[string]font.Face, error) {
+	fonts := make(map[string]font.Face)
+	for _, t := range tokens {
+		name := t.Style.Font
+		if _, loaded := fonts[name]; name == "" || loaded {
+			continue
+		}
+		if !bitmap.FontExists(name) {
+			return nil, fmt.Errorf("%s: font %q not found", t.Raw, name)
+		}
+		face, err := bitmap.LoadFont(name, size)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.Raw, err)
+		}
+		fonts[name] = face
+	}
+	return fonts, nil
+}
+
+// AlignedX returns the left edge of text of the given width aligned within x..x+width
+func AlignedX(textWidth, x, width int, horizAlign config.HAlign) int {
+	switch horizAlign {
+	case config.AlignLeft:
+		return x
+	case config.AlignRight:
+		return x + width - textWidth
+	default: // center
+		return x + (width-textWidth)/2
+	}
+}
+
+// DrawStyledTextInRect draws the line aligned within a rectangle
+func DrawStyledTextInRect(img *image.Gray, text StyledText, rect image.Rectangle, horizAlign config.HAlign, vertAlign config.VAlign) {
+	width, _ := text.Measure()
+	x := AlignedX(width, rect.Min.X, rect.Dx(), horizAlign)
+	for _, run := range text {
+		w, _ := bitmap.SmartMeasureText(run.Text, run.FontFace, run.FontName)
+		bitmap.SmartDrawTextInRectWithColor(img, run.Text, run.FontFace, run.FontName, x, rect.Min.Y, w, rect.Dy(), config.AlignLeft, vertAlign, 0, run.Color)
+		x += w
+	}
+}
+
+// DrawStyledTextAt draws the line from its left edge x, with each run aligned vertically
+// within the row from y to y+height, clipped to clip. Scrolling text is drawn this way.
+func DrawStyledTextAt(img *image.Gray, text StyledText, x, y, height int, vertAlign config.VAlign, clip image.Rectangle) {
+	for _, run := range text {
+		w, _ := bitmap.SmartMeasureText(run.Text, run.FontFace, run.FontName)
+		rx, ry := bitmap.SmartCalculateTextPosition(run.Text, run.FontFace, run.FontName, x, y, w, height, config.AlignLeft, vertAlign)
+		bitmap.SmartDrawTextAtPositionWithColor(img, run.Text, run.FontFace, run.FontName, rx, ry, clip.Min.X, clip.Min.Y, clip.Dx(), clip.Dy(), run.Color)
+		x += w
+	}
+}
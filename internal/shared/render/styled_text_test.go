@@ -0,0 +1,135 @@
+package render
+
+import (
+	"image"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"golang.org/x/image/font"
+)
+
+func TestStyleTokens(t *testing.T) {
+	values := map[string]string{"name": "Ann", "value": "42", "temp": ""}
+	value := func(tok Token) (string, bool) {
+		v, ok := values[tok.Name]
+		return v, ok
+	}
+	fonts := map[string]font.Face{bitmap.FontNamePixel5x7: nil} // Internal fonts have no face
+	base := StyledRun{Color: 200, FontName: "base"}
+
+	tests := []struct {
+		name   string
+		format string
+		want   StyledText
+	}{
+		{
+			name:   "plain format is one run",
+			format: "{name}: {value}",
+			want:   StyledText{{Text: "Ann: 42", Color: 200, FontName: "base"}},
+		},
+		{
+			name:   "styled token gets its own run",
+			format: "{name|dim}: {value}",
+			want: StyledText{
+				{Text: "Ann", Color: StyleDim, FontName: "base"},
+				{Text: ": 42", Color: 200, FontName: "base"},
+			},
+		},
+		{
+			name:   "adjacent runs of one style merge",
+			format: "{name|90}{value|90}",
+			want:   StyledText{{Text: "Ann42", Color: 90, FontName: "base"}},
+		},
+		{
+			name:   "font style",
+			format: "{value|font=pixel5x7}",
+			want:   StyledText{{Text: "42", Color: 200, FontName: bitmap.FontNamePixel5x7}},
+		},
+		{
+			name:   "unknown token keeps its source text",
+			format: "{name} {missing|dim}",
+			want:   StyledText{{Text: "Ann {missing|dim}", Color: 200, FontName: "base"}},
+		},
+		{
+			name:   "empty values are skipped",
+			format: "{temp|dim}{name}",
+			want:   StyledText{{Text: "Ann", Color: 200, FontName: "base"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StyleTokens(ParseFormatTokens(tt.format, testClassifier), value, base, fonts)
+			if len(got) != len(tt.want) {
+				t.Fatalf("StyleTokens() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Text != tt.want[i].Text || got[i].Color != tt.want[i].Color || got[i].FontName != tt.want[i].FontName {
+					t.Errorf("run %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStyledText_Prefix(t *testing.T) {
+	text := StyledText{{Text: "ab", Color: 1}, {Text: "cde", Color: 2}}
+
+	tests := []struct {
+		n    int
+		want string
+		runs int
+	}{
+		{0, "", 0},
+		{1, "a", 1},
+		{2, "ab", 1},
+		{4, "abcd", 2},
+		{10, "abcde", 2},
+	}
+	for _, tt := range tests {
+		got := text.Prefix(tt.n)
+		if got.String() != tt.want || len(got) != tt.runs {
+			t.Errorf("Prefix(%d) = %+v, want %q in %d runs", tt.n, got, tt.want, tt.runs)
+		}
+	}
+}
+
+func TestLoadStyleFonts(t *testing.T) {
+	fonts, err := LoadStyleFonts(ParseFormatTokens("{name|font=pixel5x7} {value|font=pixel5x7} {temp}", testClassifier), 10)
+	if err != nil {
+		t.Fatalf("LoadStyleFonts() error = %v", err)
+	}
+	if _, ok := fonts[bitmap.FontNamePixel5x7]; !ok || len(fonts) != 1 {
+		t.Errorf("LoadStyleFonts() = %v, want only %s", fonts, bitmap.FontNamePixel5x7)
+	}
+
+	if _, err := LoadStyleFonts(ParseFormatTokens("{name|font=no-such-font}", testClassifier), 10); err == nil {
+		t.Error("LoadStyleFonts() should fail for an unknown font")
+	}
+}
+
+func TestDrawStyledTextInRect(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 64, 10))
+	text := StyledText{
+		{Text: "AA", Color: 90, FontName: bitmap.FontNamePixel5x7},
+		{Text: "BB", Color: 255, FontName: bitmap.FontNamePixel5x7},
+	}
+	DrawStyledTextInRect(img, text, img.Bounds(), config.AlignLeft, config.AlignTop)
+
+	width, _ := text.Measure()
+	split, _ := text[:1].Measure()
+	var left, right uint8
+	for y := 0; y < 10; y++ {
+		for x := 0; x < width; x++ {
+			if x < split {
+				left = max(left, img.GrayAt(x, y).Y)
+			} else {
+				right = max(right, img.GrayAt(x, y).Y)
+			}
+		}
+	}
+	if left != 90 || right != 255 {
+		t.Errorf("runs drawn at %d and %d, want 90 and 255", left, right)
+	}
+}
@@ -0,0 +1,74 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+)
+
+// bytesPerGB is the size of a gigabyte as reported by the OS dialogs (GiB)
+const bytesPerGB = 1 << 30
+
+// TokenValue returns the text of a format token, or an empty string for tokens
+// the widget does not know or values that aren't available
+type TokenValue func(t Token) string
+
+// ParseTextFormat parses a single-value text mode format, e.g. "{used_gb}/{total_gb} GB",
+// into tokens, or returns nil when the format has none. Text mode draws in a single
+// style, so token styles are rejected.
+func ParseTextFormat(format string) ([]Token, error) {
+	tokens := ParseFormatTokens(format, func(string) TokenType {
+		return TokenText
+	})
+	if err := RejectTokenStyles(tokens); err != nil {
+		return nil, err
+	}
+	for _, t := range tokens {
+		if t.Type == TokenText {
+			return tokens, nil
+		}
+	}
+	return nil, nil
+}
+
+// UsesToken reports whether any of the tokens has the given name
+func UsesToken(tokens []Token, name string) bool {
+	for _, t := range tokens {
+		if t.Type == TokenText && t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatText renders the tokens, taking each token's text from value
+func FormatText(tokens []Token, value TokenValue) string {
+	var sb strings.Builder
+	for _, t := range tokens {
+		if t.Type == TokenLiteral {
+			sb.WriteString(t.Literal)
+			continue
+		}
+		sb.WriteString(value(t))
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// FormatPercent formats a percentage, with no decimals unless param says otherwise
+func FormatPercent(percent float64, param string) string {
+	return strconv.FormatFloat(percent, 'f', TokenDecimals(param, 0), 64) + "%"
+}
+
+// FormatGB formats a byte count in gigabytes, one decimal unless param says otherwise
+func FormatGB(bytes uint64, param string) string {
+	return strconv.FormatFloat(float64(bytes)/bytesPerGB, 'f', TokenDecimals(param, 1), 64)
+}
+
+// TokenDecimals parses a token's decimal places parameter, e.g. 2 in {used_gb:2},
+// falling back to def
+func TokenDecimals(param string, def int) int {
+	n, err := strconv.Atoi(param)
+	if err != nil || n < 0 {
+		return def
+	}
+	return n
+}
@@ -0,0 +1,70 @@
+package render
+
+import "testing"
+
+func TestParseTextFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		wantTokens bool
+		wantErr    bool
+	}{
+		{"empty", "", false, false},
+		{"literal only", "RAM", false, false},
+		{"token", "{percent} used", true, false},
+		{"styled token", "{percent|dim}", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := ParseTextFormat(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTextFormat(%q) error = %v, wantErr %v", tt.format, err, tt.wantErr)
+			}
+			if (tokens != nil) != tt.wantTokens {
+				t.Errorf("ParseTextFormat(%q) = %v, want tokens %v", tt.format, tokens, tt.wantTokens)
+			}
+		})
+	}
+}
+
+func TestUsesToken(t *testing.T) {
+	tokens, _ := ParseTextFormat("{usage} {freq}")
+	if !UsesToken(tokens, "freq") || UsesToken(tokens, "load_avg") {
+		t.Errorf("UsesToken mismatch for %v", tokens)
+	}
+}
+
+func TestFormatText(t *testing.T) {
+	value := func(t Token) string {
+		switch t.Name {
+		case "percent":
+			return FormatPercent(65.04, t.Param)
+		case "used_gb":
+			return FormatGB(10<<30+400<<20, t.Param)
+		}
+		return ""
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"{percent}", "65%"},
+		{"{percent:1}", "65.0%"},
+		{"{used_gb} GB", "10.4 GB"},
+		{"{used_gb:0}G {used_gb:2}G", "10G 10.39G"},
+		{"{used_gb:-1}", "10.4"},
+		{" {percent}{bogus} ", "65%"},
+	}
+
+	for _, tt := range tests {
+		tokens, err := ParseTextFormat(tt.format)
+		if err != nil {
+			t.Fatalf("ParseTextFormat(%q) error = %v", tt.format, err)
+		}
+		if got := FormatText(tokens, value); got != tt.want {
+			t.Errorf("FormatText(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
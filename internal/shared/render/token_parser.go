@@ -1,6 +1,11 @@
 package render
 
-import "regexp"
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
 
 // TokenType represents the type of format token
 type TokenType int
@@ -15,18 +20,59 @@ const (
 // Token represents a parsed token from the format string
 type Token struct {
 	Type    TokenType
-	Name    string // Token name without braces (e.g., "icon", "battery")
-	Param   string // Optional parameter (e.g., "20" in {battery:20})
-	Literal string // For literal tokens, the text content
+	Name    string     // Token name without braces (e.g., "icon", "battery")
+	Param   string     // Optional parameter (e.g., "20" in {battery:20})
+	Literal string     // For literal tokens, the text content
+	Style   TokenStyle // Optional drawing style (e.g. "dim" in {temp|dim})
+	Raw     string     // Token text as written in the format, e.g. "{temp:f|dim}"
+}
+
+// TokenStyle holds per-token drawing attributes, written after a pipe:
+// {temp|dim}, {temp:f|bright} or {aqi|200,font=pixel5x7}. The zero value draws
+// the token like the rest of the format.
+type TokenStyle struct {
+	Color    uint8  // Gray level, used when HasColor is set
+	HasColor bool   // Color overrides the widget's text color
+	Font     string // Font name overriding the widget's font, empty for none
+}
+
+// Named gray levels accepted as token styles
+const (
+	StyleBright = 255
+	StyleDim    = 128
+)
+
+// TextColor returns the token's gray level, or def when the style sets none
+func (s TokenStyle) TextColor(def uint8) uint8 {
+	if s.HasColor {
+		return s.Color
+	}
+	return def
+}
+
+// IsZero reports whether the style sets no attribute
+func (s TokenStyle) IsZero() bool {
+	return s == TokenStyle{}
+}
+
+// RejectTokenStyles returns an error for the first token with a style, for widgets
+// that draw their format in one style
+func RejectTokenStyles(tokens []Token) error {
+	for _, t := range tokens {
+		if !t.Style.IsZero() {
+			return fmt.Errorf("%s: token styles are not supported by this widget", t.Raw)
+		}
+	}
+	return nil
 }
 
 // TokenClassifier maps a token name to its TokenType.
 // Widgets provide their own classifier to categorize tokens.
 type TokenClassifier func(name string) TokenType
 
-// tokenPattern matches {token} or {token:param} in the format string.
+// tokenPattern matches {token}, {token:param} and either followed by |style in the format string.
 // Compiled once at package level for efficiency.
-var tokenPattern = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)(?::([^}]*))?\}`)
+var tokenPattern = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)(?::([^}|]*))?(?:\|([^}]*))?\}`)
 
 // ParseFormatTokens parses a format string into tokens using the provided classifier
 // to determine each token's type. The classifier is called for every {name} or {name:param}
@@ -51,11 +97,18 @@ func ParseFormatTokens(format string, classify TokenClassifier) []Token {
 			param = format[match[4]:match[5]]
 		}
 
+		style := TokenStyle{}
+		if match[6] >= 0 && match[7] >= 0 {
+			style = parseTokenStyle(format[match[6]:match[7]])
+		}
+
 		tokenType := classify(name)
 		tokens = append(tokens, Token{
 			Type:  tokenType,
 			Name:  name,
 			Param: param,
+			Style: style,
+			Raw:   format[match[0]:match[1]],
 		})
 
 		lastEnd = match[1]
@@ -71,3 +124,25 @@ func ParseFormatTokens(format string, classify TokenClassifier) []Token {
 
 	return tokens
 }
+
+// parseTokenStyle parses comma-separated style attributes: "bright", "dim", a gray
+// level 0-255 and font=NAME. Unknown attributes are ignored.
+func parseTokenStyle(spec string) TokenStyle {
+	var style TokenStyle
+	for _, attr := range strings.Split(spec, ",") {
+		attr = strings.TrimSpace(attr)
+		switch {
+		case strings.EqualFold(attr, "bright"):
+			style.Color, style.HasColor = StyleBright, true
+		case strings.EqualFold(attr, "dim"):
+			style.Color, style.HasColor = StyleDim, true
+		case strings.HasPrefix(attr, "font="):
+			style.Font = strings.TrimPrefix(attr, "font=")
+		default:
+			if level, err := strconv.Atoi(attr); err == nil && level >= 0 && level <= 255 {
+				style.Color, style.HasColor = uint8(level), true
+			}
+		}
+	}
+	return style
+}
@@ -111,6 +111,24 @@ func TestParseFormatTokens(t *testing.T) {
 				{Type: TokenText, Name: "temp", Param: "raw"},
 			},
 		},
+		{
+			name:      "styled tokens",
+			format:    "{temp|dim} {value:1|200,font=pixel5x7}",
+			wantCount: 3,
+			wantTokens: []Token{
+				{Type: TokenText, Name: "temp", Style: TokenStyle{Color: StyleDim, HasColor: true}},
+				{Type: TokenLiteral, Literal: " "},
+				{Type: TokenText, Name: "value", Param: "1", Style: TokenStyle{Color: 200, HasColor: true, Font: "pixel5x7"}},
+			},
+		},
+		{
+			name:      "unknown style attributes ignored",
+			format:    "{icon:24|sparkle,300}",
+			wantCount: 1,
+			wantTokens: []Token{
+				{Type: TokenIcon, Name: "icon", Param: "24"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -140,7 +158,22 @@ func TestParseFormatTokens(t *testing.T) {
 				if want.Literal != "" && got.Literal != want.Literal {
 					t.Errorf("token[%d].Literal = %q, want %q", i, got.Literal, want.Literal)
 				}
+				if got.Style != want.Style {
+					t.Errorf("token[%d].Style = %+v, want %+v", i, got.Style, want.Style)
+				}
 			}
 		})
 	}
 }
+
+func TestTokenStyle_TextColor(t *testing.T) {
+	if got := (TokenStyle{}).TextColor(255); got != 255 {
+		t.Errorf("TextColor() without a color = %d, want the default 255", got)
+	}
+	if got := (TokenStyle{Color: 0, HasColor: true}).TextColor(255); got != 0 {
+		t.Errorf("TextColor() with color 0 = %d, want 0", got)
+	}
+	if got := parseTokenStyle(" Bright ").TextColor(100); got != StyleBright {
+		t.Errorf("TextColor() for bright = %d, want %d", got, StyleBright)
+	}
+}
@@ -0,0 +1,83 @@
+package util
+
+// Aggregation modes for smoothing metric readouts over a sampling window
+const (
+	AggregateInstant = "instant"
+	AggregateAvg     = "avg"
+	AggregateMax     = "max"
+	AggregateMin     = "min"
+)
+
+// DefaultAggregateWindow is the number of samples aggregated when no window is configured
+const DefaultAggregateWindow = 10
+
+// IsValidAggregateMode checks if the aggregation mode is supported
+func IsValidAggregateMode(mode string) bool {
+	switch mode {
+	case AggregateInstant, AggregateAvg, AggregateMax, AggregateMin:
+		return true
+	}
+	return false
+}
+
+// Aggregator reduces the last N samples of a metric to a single value.
+// Samples are kept in a RingBuffer, the same structure used for graph history.
+// A nil Aggregator passes values through unchanged.
+type Aggregator struct {
+	mode    string
+	samples *RingBuffer[float64]
+}
+
+// NewAggregator creates an aggregator for the given mode and window size.
+// Returns nil for "instant" or unknown modes, so values pass through unchanged.
+func NewAggregator(mode string, window int) *Aggregator {
+	if mode == AggregateInstant || !IsValidAggregateMode(mode) {
+		return nil
+	}
+	if window <= 0 {
+		window = DefaultAggregateWindow
+	}
+	return &Aggregator{
+		mode:    mode,
+		samples: NewRingBuffer[float64](window),
+	}
+}
+
+// Add records a sample and returns the aggregated value over the window
+func (a *Aggregator) Add(value float64) float64 {
+	if a == nil {
+		return value
+	}
+	a.samples.Push(value)
+	return AggregateRing(a.samples, a.mode)
+}
+
+// AggregateRing computes the aggregate of all samples in a ring buffer.
+// Returns 0 for an empty buffer; "instant" returns the newest sample.
+func AggregateRing(r *RingBuffer[float64], mode string) float64 {
+	n := r.Len()
+	if n == 0 {
+		return 0
+	}
+
+	result := r.Get(0)
+	switch mode {
+	case AggregateAvg:
+		for i := 1; i < n; i++ {
+			result += r.Get(i)
+		}
+		return result / float64(n)
+	case AggregateMax:
+		for i := 1; i < n; i++ {
+			result = max(result, r.Get(i))
+		}
+		return result
+	case AggregateMin:
+		for i := 1; i < n; i++ {
+			result = min(result, r.Get(i))
+		}
+		return result
+	default:
+		return r.Get(n - 1)
+	}
+}
@@ -0,0 +1,77 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestNewAggregator_InstantIsNil(t *testing.T) {
+	if a := NewAggregator(AggregateInstant, 5); a != nil {
+		t.Error("NewAggregator(instant) should return nil")
+	}
+	if a := NewAggregator("median", 5); a != nil {
+		t.Error("NewAggregator(unknown) should return nil")
+	}
+	if a := NewAggregator("", 5); a != nil {
+		t.Error("NewAggregator(\"\") should return nil")
+	}
+}
+
+func TestAggregator_NilPassThrough(t *testing.T) {
+	var a *Aggregator
+	if got := a.Add(42); got != 42 {
+		t.Errorf("nil Add() = %v, want 42", got)
+	}
+}
+
+func TestAggregator_Modes(t *testing.T) {
+	samples := []float64{10, 50, 30, 20}
+
+	tests := []struct {
+		mode string
+		want float64
+	}{
+		{AggregateAvg, 100.0 / 3}, // window of 3: 50, 30, 20
+		{AggregateMax, 50},
+		{AggregateMin, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			a := NewAggregator(tt.mode, 3)
+			var got float64
+			for _, s := range samples {
+				got = a.Add(s)
+			}
+			if got != tt.want {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAggregator_DefaultWindow(t *testing.T) {
+	a := NewAggregator(AggregateMax, 0)
+	a.Add(100)
+	for i := 0; i < DefaultAggregateWindow-1; i++ {
+		a.Add(1)
+	}
+	if got := a.Add(1); got != 1 {
+		t.Errorf("Add() after window = %v, want 1 (old peak should drop out)", got)
+	}
+}
+
+func TestAggregateRing(t *testing.T) {
+	rb := NewRingBuffer[float64](4)
+	if got := AggregateRing(rb, AggregateAvg); got != 0 {
+		t.Errorf("AggregateRing(empty) = %v, want 0", got)
+	}
+
+	rb.Push(4)
+	rb.Push(8)
+	if got := AggregateRing(rb, AggregateInstant); got != 8 {
+		t.Errorf("AggregateRing(instant) = %v, want 8", got)
+	}
+	if got := AggregateRing(rb, AggregateAvg); got != 6 {
+		t.Errorf("AggregateRing(avg) = %v, want 6", got)
+	}
+}
@@ -0,0 +1,29 @@
+package util
+
+import (
+	"math"
+	"time"
+)
+
+// SynodicMonth is the mean length of a lunar phase cycle, in days
+const SynodicMonth = 29.530588853
+
+// referenceNewMoon is a known new moon (2000-01-06 18:14 UTC) the phase is counted from
+var referenceNewMoon = time.Date(2000, 1, 6, 18, 14, 0, 0, time.UTC)
+
+// MoonPhase returns the lunar phase at t as a fraction of the synodic month:
+// 0 is new moon, 0.25 first quarter, 0.5 full moon and 0.75 last quarter.
+// It uses the mean synodic month, accurate to within about a day.
+func MoonPhase(t time.Time) float64 {
+	days := t.Sub(referenceNewMoon).Hours() / 24
+	phase := math.Mod(days/SynodicMonth, 1)
+	if phase < 0 {
+		phase++
+	}
+	return phase
+}
+
+// MoonIllumination returns the illuminated fraction of the moon disc (0-1) at the given phase
+func MoonIllumination(phase float64) float64 {
+	return (1 - math.Cos(2*math.Pi*phase)) / 2
+}
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMoonPhase(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want float64
+	}{
+		{"reference new moon", time.Date(2000, 1, 6, 18, 14, 0, 0, time.UTC), 0},
+		{"full moon", time.Date(2024, 4, 23, 23, 49, 0, 0, time.UTC), 0.5},
+		{"first quarter", time.Date(2024, 6, 14, 5, 18, 0, 0, time.UTC), 0.25},
+		{"last quarter", time.Date(2024, 12, 22, 22, 18, 0, 0, time.UTC), 0.75},
+		{"before the reference", time.Date(1999, 12, 22, 17, 31, 0, 0, time.UTC), 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MoonPhase(tt.t)
+			// Within a day of the actual phase; wrap around new moon
+			diff := math.Abs(got - tt.want)
+			diff = math.Min(diff, 1-diff)
+			if diff > 1/SynodicMonth {
+				t.Errorf("MoonPhase() = %.3f, want %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoonIllumination(t *testing.T) {
+	for _, tt := range []struct{ phase, want float64 }{
+		{0, 0}, {0.25, 0.5}, {0.5, 1}, {0.75, 0.5}, {1, 0},
+	} {
+		if got := MoonIllumination(tt.phase); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("MoonIllumination(%g) = %g, want %g", tt.phase, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"math"
+	"time"
+)
+
+// PeakTracker follows the peak of a metric to use as a dynamic scale maximum.
+// A new high raises the peak immediately; once the peak is older than the window
+// it decays exponentially (time constant = window) toward the current value,
+// so a brief spike does not compress the scale for long.
+// A nil PeakTracker returns values unchanged.
+type PeakTracker struct {
+	window   time.Duration
+	peak     float64
+	peakTime time.Time
+	lastTime time.Time
+}
+
+// NewPeakTracker creates a peak tracker with the given hold/decay window
+func NewPeakTracker(window time.Duration) *PeakTracker {
+	return &PeakTracker{window: window}
+}
+
+// Add records a sample taken at now and returns the current peak
+func (p *PeakTracker) Add(value float64, now time.Time) float64 {
+	if p == nil {
+		return value
+	}
+
+	switch {
+	case p.lastTime.IsZero() || value >= p.peak:
+		p.peak = value
+		p.peakTime = now
+	case p.window > 0 && now.Sub(p.peakTime) > p.window:
+		elapsed := now.Sub(p.lastTime)
+		p.peak = max(value, p.peak*math.Exp(-elapsed.Seconds()/p.window.Seconds()))
+	}
+	p.lastTime = now
+
+	return p.peak
+}
+
+// Peak returns the current peak, zero before the first sample
+func (p *PeakTracker) Peak() float64 {
+	if p == nil {
+		return 0
+	}
+	return p.peak
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeakTracker(t *testing.T) {
+	start := time.Unix(1000, 0)
+	p := NewPeakTracker(30 * time.Second)
+
+	if got := p.Add(100, start); got != 100 {
+		t.Errorf("first sample peak = %v, want 100", got)
+	}
+	if got := p.Add(40, start.Add(10*time.Second)); got != 100 {
+		t.Errorf("peak within window = %v, want 100 (held)", got)
+	}
+	if got := p.Add(250, start.Add(20*time.Second)); got != 250 {
+		t.Errorf("new high = %v, want 250", got)
+	}
+
+	// Past the window the peak decays, but stays above the current value
+	got := p.Add(10, start.Add(51*time.Second))
+	if got >= 250 || got <= 10 {
+		t.Errorf("decayed peak = %v, want between 10 and 250", got)
+	}
+
+	// Decay keeps shrinking the peak toward the current value
+	for i := 0; i < 600; i++ {
+		got = p.Add(10, start.Add(time.Duration(52+i)*time.Second))
+	}
+	if got != 10 {
+		t.Errorf("fully decayed peak = %v, want 10", got)
+	}
+}
+
+func TestPeakTracker_Nil(t *testing.T) {
+	var p *PeakTracker
+	if got := p.Add(42, time.Now()); got != 42 {
+		t.Errorf("nil Add() = %v, want 42", got)
+	}
+	if got := p.Peak(); got != 0 {
+		t.Errorf("nil Peak() = %v, want 0", got)
+	}
+}
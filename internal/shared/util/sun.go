@@ -0,0 +1,49 @@
+package util
+
+import (
+	"math"
+	"time"
+)
+
+// julianUnixEpoch is the Julian date of the Unix epoch
+const julianUnixEpoch = 2440587.5
+
+// SunTimes returns sunrise and sunset on the calendar day of day at the given
+// coordinates (degrees, east and north positive), in day's location.
+// It uses the sunrise equation with atmospheric refraction, accurate to about a minute.
+// Returns false when the sun does not rise or set that day (polar day or night).
+func SunTimes(day time.Time, lat, lon float64) (sunrise, sunset time.Time, ok bool) {
+	y, m, d := day.Date()
+	noon := time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+
+	// Days since J2000.0, corrected to the mean solar noon at the longitude
+	n := math.Round(float64(noon.Unix())/86400 + julianUnixEpoch - 2451545.0)
+	jStar := n + 0.0009 - lon/360
+
+	meanAnomaly := math.Mod(357.5291+0.98560028*jStar, 360)
+	mRad := meanAnomaly * math.Pi / 180
+	center := 1.9148*math.Sin(mRad) + 0.0200*math.Sin(2*mRad) + 0.0003*math.Sin(3*mRad)
+	eclipticLon := math.Mod(meanAnomaly+center+180+102.9372, 360) * math.Pi / 180
+
+	transit := 2451545.0 + jStar + 0.0053*math.Sin(mRad) - 0.0069*math.Sin(2*eclipticLon)
+
+	sinDecl := math.Sin(eclipticLon) * math.Sin(23.4397*math.Pi/180)
+	cosDecl := math.Cos(math.Asin(sinDecl))
+	latRad := lat * math.Pi / 180
+
+	cosHourAngle := (math.Sin(-0.833*math.Pi/180) - math.Sin(latRad)*sinDecl) / (math.Cos(latRad) * cosDecl)
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return time.Time{}, time.Time{}, false
+	}
+	hourAngle := math.Acos(cosHourAngle) * 180 / math.Pi
+
+	sunrise = julianToTime(transit-hourAngle/360, day.Location())
+	sunset = julianToTime(transit+hourAngle/360, day.Location())
+	return sunrise, sunset, true
+}
+
+// julianToTime converts a Julian date to a time in loc
+func julianToTime(jd float64, loc *time.Location) time.Time {
+	secs := (jd - julianUnixEpoch) * 86400
+	return time.Unix(0, int64(secs*float64(time.Second))).In(loc)
+}
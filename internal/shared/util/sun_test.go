@@ -0,0 +1,47 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSunTimes(t *testing.T) {
+	tests := []struct {
+		name        string
+		day         time.Time
+		lat, lon    float64
+		rise, set   string // UTC, HH:MM
+		wantRiseSet bool
+	}{
+		{"London summer solstice", time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), 51.5074, -0.1278, "03:43", "20:21", true},
+		{"Moscow winter solstice", time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), 55.7558, 37.6173, "05:58", "12:57", true},
+		{"Tromso polar night", time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), 69.6492, 18.9553, "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rise, set, ok := SunTimes(tt.day, tt.lat, tt.lon)
+			if ok != tt.wantRiseSet {
+				t.Fatalf("SunTimes() ok = %v, want %v", ok, tt.wantRiseSet)
+			}
+			if !ok {
+				return
+			}
+			checkClose(t, "sunrise", rise, tt.day, tt.rise)
+			checkClose(t, "sunset", set, tt.day, tt.set)
+		})
+	}
+}
+
+// checkClose fails unless got is within 3 minutes of want (HH:MM UTC on day)
+func checkClose(t *testing.T, what string, got, day time.Time, want string) {
+	t.Helper()
+	hm, err := time.Parse("15:04", want)
+	if err != nil {
+		t.Fatalf("bad test time %q", want)
+	}
+	wantTime := day.Add(time.Duration(hm.Hour())*time.Hour + time.Duration(hm.Minute())*time.Minute)
+	if diff := got.Sub(wantTime); diff < -3*time.Minute || diff > 3*time.Minute {
+		t.Errorf("%s = %s, want about %s", what, got.UTC().Format("15:04"), want)
+	}
+}
@@ -4,6 +4,8 @@ package spotify
 import (
 	"context"
 	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 )
 
 // PlaybackState represents Spotify playback state.
@@ -30,6 +32,18 @@ func (s PlaybackState) String() string {
 	}
 }
 
+// Message returns the translatable message key for the state
+func (s PlaybackState) Message() i18n.Message {
+	switch s {
+	case StatePlaying:
+		return i18n.Playing
+	case StatePaused:
+		return i18n.Paused
+	default:
+		return i18n.Stopped
+	}
+}
+
 // TrackInfo contains metadata for the currently playing track.
 type TrackInfo struct {
 	// ID is the Spotify track ID.
@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 )
 
 func TestPlaybackState_String(t *testing.T) {
@@ -29,6 +31,24 @@ func TestPlaybackState_String(t *testing.T) {
 	}
 }
 
+func TestPlaybackState_Message(t *testing.T) {
+	tests := []struct {
+		state PlaybackState
+		want  i18n.Message
+	}{
+		{StateStopped, i18n.Stopped},
+		{StatePlaying, i18n.Playing},
+		{StatePaused, i18n.Paused},
+		{PlaybackState(99), i18n.Stopped},
+	}
+
+	for _, tt := range tests {
+		if got := tt.state.Message(); got != tt.want {
+			t.Errorf("PlaybackState(%d).Message() = %q, want %q", tt.state, got, tt.want)
+		}
+	}
+}
+
 func TestTokenInfo_IsExpired(t *testing.T) {
 	tests := []struct {
 		name      string
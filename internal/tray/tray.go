@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/getlantern/systray"
 	"github.com/pozitronik/steelclock-go/internal/autostart"
@@ -36,6 +37,7 @@ type Manager struct {
 	onReload        func() error
 	onExit          func()
 	onProfileSwitch func(path string) error
+	onAction        func(action config.ActionConfig)
 
 	// Menu items
 	profileMenuItems []*systray.MenuItem
@@ -44,6 +46,13 @@ type Manager struct {
 	menuAutostart    *systray.MenuItem
 	menuExit         *systray.MenuItem
 
+	// Custom actions submenu (tray_menu config)
+	customMu      sync.Mutex
+	menuActions   *systray.MenuItem
+	customItems   []*systray.MenuItem
+	customActions []config.ActionConfig
+	customPending []config.TrayMenuItemConfig
+
 	// State
 	readyChan       chan struct{}
 	onReadyCallback func()
@@ -75,6 +84,79 @@ func (m *Manager) SetWebEditor(editor *webeditor.Server) {
 	m.webEditor = editor
 }
 
+// SetActionHandler sets the callback invoked when a custom tray menu item is clicked
+func (m *Manager) SetActionHandler(handler func(action config.ActionConfig)) {
+	m.onAction = handler
+}
+
+// SetCustomMenu replaces the custom "Actions" submenu entries.
+// May be called before the tray is ready; items are applied once it is.
+func (m *Manager) SetCustomMenu(items []config.TrayMenuItemConfig) {
+	m.customMu.Lock()
+	defer m.customMu.Unlock()
+
+	if m.menuActions == nil {
+		m.customPending = items
+		return
+	}
+	m.applyCustomMenu(items)
+}
+
+// applyCustomMenu updates submenu items to match the given entries.
+// systray cannot remove menu items, so existing ones are reused and
+// surplus ones hidden. Caller must hold customMu.
+func (m *Manager) applyCustomMenu(items []config.TrayMenuItemConfig) {
+	m.customActions = m.customActions[:0]
+	for i, item := range items {
+		if i < len(m.customItems) {
+			m.customItems[i].SetTitle(item.Label)
+			m.customItems[i].Show()
+		} else {
+			menuItem := m.menuActions.AddSubMenuItem(item.Label, "")
+			m.customItems = append(m.customItems, menuItem)
+			go m.handleCustomClicks(menuItem, i)
+		}
+		m.customActions = append(m.customActions, item.ActionConfig)
+	}
+
+	for i := len(items); i < len(m.customItems); i++ {
+		m.customItems[i].Hide()
+	}
+
+	if len(items) > 0 {
+		m.menuActions.Show()
+	} else {
+		m.menuActions.Hide()
+	}
+}
+
+// handleCustomClicks dispatches clicks on a custom menu item slot
+func (m *Manager) handleCustomClicks(item *systray.MenuItem, index int) {
+	for range item.ClickedCh {
+		m.customMu.Lock()
+		var action config.ActionConfig
+		ok := index < len(m.customActions)
+		if ok {
+			action = m.customActions[index]
+		}
+		m.customMu.Unlock()
+
+		if ok && m.onAction != nil {
+			m.onAction(action)
+		}
+	}
+}
+
+// addActionsMenu adds the (initially hidden) custom actions submenu
+func (m *Manager) addActionsMenu() {
+	m.customMu.Lock()
+	defer m.customMu.Unlock()
+
+	m.menuActions = systray.AddMenuItem("Actions", "User-defined actions")
+	m.applyCustomMenu(m.customPending)
+	m.customPending = nil
+}
+
 // Run starts the system tray
 func (m *Manager) Run() {
 	systray.Run(m.onReady, m.onQuit)
@@ -105,6 +187,7 @@ func (m *Manager) onReady() {
 func (m *Manager) buildLegacyMenu() {
 	m.menuEdit = systray.AddMenuItem("Edit Config", "Open config file in default editor")
 	m.menuReload = systray.AddMenuItem("Reload Config", "Reload configuration")
+	m.addActionsMenu()
 	systray.AddSeparator()
 	m.addAutostartMenuItem()
 	systray.AddSeparator()
@@ -144,6 +227,7 @@ func (m *Manager) buildProfileMenu() {
 	// Edit and Reload items
 	m.menuEdit = systray.AddMenuItem("Edit Active Config", "Open active config file in default editor")
 	m.menuReload = systray.AddMenuItem("Reload Active Config", "Reload current configuration")
+	m.addActionsMenu()
 
 	systray.AddSeparator()
 	m.addAutostartMenuItem()
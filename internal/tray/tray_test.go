@@ -3,6 +3,8 @@ package tray
 import (
 	"testing"
 	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
 )
 
 // TestNewManager tests that NewManager creates a valid manager
@@ -197,3 +199,36 @@ func TestManagerCallbacks(t *testing.T) {
 		t.Error("exit callback was not called")
 	}
 }
+
+// TestSetCustomMenu_BeforeReady tests that custom items are deferred until the tray is ready
+func TestSetCustomMenu_BeforeReady(t *testing.T) {
+	mgr := NewManager("/test/config.json", func() error { return nil }, func() {})
+
+	items := []config.TrayMenuItemConfig{
+		{Label: "Reload", ActionConfig: config.ActionConfig{Action: config.ActionReloadConfig}},
+	}
+	mgr.SetCustomMenu(items)
+
+	if len(mgr.customPending) != 1 || mgr.customPending[0].Label != "Reload" {
+		t.Errorf("customPending = %+v, want 1 pending Reload item", mgr.customPending)
+	}
+	if len(mgr.customItems) != 0 {
+		t.Errorf("customItems = %d, want 0 before ready", len(mgr.customItems))
+	}
+}
+
+// TestSetActionHandler tests that the action callback is stored
+func TestSetActionHandler(t *testing.T) {
+	mgr := NewManager("/test/config.json", func() error { return nil }, func() {})
+
+	var got config.ActionConfig
+	mgr.SetActionHandler(func(a config.ActionConfig) { got = a })
+
+	if mgr.onAction == nil {
+		t.Fatal("onAction was not set")
+	}
+	mgr.onAction(config.ActionConfig{Action: config.ActionOpenEditor})
+	if got.Action != config.ActionOpenEditor {
+		t.Errorf("action = %q, want %q", got.Action, config.ActionOpenEditor)
+	}
+}
@@ -18,6 +18,11 @@ func (dn *DeviceNotifier) Subscribe() <-chan struct{} {
 	return nil
 }
 
+// SubscribeCapture is a no-op on non-Windows platforms
+func (dn *DeviceNotifier) SubscribeCapture() <-chan struct{} {
+	return nil
+}
+
 // Unsubscribe is a no-op on non-Windows platforms
 func (dn *DeviceNotifier) Unsubscribe(_ <-chan struct{}) {}
 
@@ -38,7 +38,8 @@ type DeviceNotifier struct {
 	mu          sync.RWMutex
 	mmde        *wca.IMMDeviceEnumerator
 	client      *notificationClient
-	subscribers []chan struct{}
+	subscribers []chan struct{} // Render (playback) device changes
+	captureSubs []chan struct{} // Capture (recording) device changes
 	started     bool
 }
 
@@ -142,7 +143,18 @@ func (dn *DeviceNotifier) Subscribe() <-chan struct{} {
 	return ch
 }
 
-// Unsubscribe removes a subscriber channel
+// SubscribeCapture returns a channel that will receive a signal when the capture (recording) device changes
+// The channel is buffered (capacity 1) to prevent blocking
+func (dn *DeviceNotifier) SubscribeCapture() <-chan struct{} {
+	dn.mu.Lock()
+	defer dn.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	dn.captureSubs = append(dn.captureSubs, ch)
+	return ch
+}
+
+// Unsubscribe removes a subscriber channel (render or capture)
 func (dn *DeviceNotifier) Unsubscribe(ch <-chan struct{}) {
 	dn.mu.Lock()
 	defer dn.mu.Unlock()
@@ -154,14 +166,36 @@ func (dn *DeviceNotifier) Unsubscribe(ch <-chan struct{}) {
 			return
 		}
 	}
+	for i, sub := range dn.captureSubs {
+		if sub == ch {
+			dn.captureSubs = append(dn.captureSubs[:i], dn.captureSubs[i+1:]...)
+			close(sub)
+			return
+		}
+	}
 }
 
 // notifySubscribers sends a signal to all subscribers (non-blocking)
 func (dn *DeviceNotifier) notifySubscribers() {
+	dn.notifyFlow(EAll)
+}
+
+// notifyFlow sends a signal to subscribers of the given data flow (non-blocking)
+func (dn *DeviceNotifier) notifyFlow(flow uint32) {
 	dn.mu.RLock()
 	defer dn.mu.RUnlock()
 
-	for _, ch := range dn.subscribers {
+	if flow == ERender || flow == EAll {
+		signalAll(dn.subscribers)
+	}
+	if flow == ECapture || flow == EAll {
+		signalAll(dn.captureSubs)
+	}
+}
+
+// signalAll sends a signal to each channel without blocking
+func signalAll(channels []chan struct{}) {
+	for _, ch := range channels {
 		select {
 		case ch <- struct{}{}:
 		default:
@@ -239,10 +273,9 @@ func onDeviceRemoved(this *notificationClient, _ *uint16) uintptr {
 func onDefaultDeviceChanged(this *notificationClient, flow uint32, role uint32, _ *uint16) uintptr {
 	// Default device changed - this is the main event we care about
 	if this.notifier != nil {
-		// Only care about render devices (playback)
-		if flow == ERender || flow == EAll {
+		if flow == ERender || flow == ECapture || flow == EAll {
 			log.Printf("[DEVICE-NOTIFIER] Default audio device changed (flow: %d, role: %d)", flow, role)
-			this.notifier.notifySubscribers()
+			this.notifier.notifyFlow(flow)
 		}
 	}
 	return 0 // S_OK
@@ -279,6 +312,10 @@ func (dn *DeviceNotifier) Stop() {
 		close(ch)
 	}
 	dn.subscribers = nil
+	for _, ch := range dn.captureSubs {
+		close(ch)
+	}
+	dn.captureSubs = nil
 
 	// Release device enumerator
 	if dn.mmde != nil {
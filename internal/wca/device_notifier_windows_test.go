@@ -382,7 +382,7 @@ func TestOnDefaultDeviceChanged(t *testing.T) {
 		{"ERender EMultimedia", ERender, EMultimedia, true},
 		{"ERender ECommunication", ERender, ECommunication, true},
 		{"EAll EConsole", EAll, EConsole, true},
-		{"ECapture EConsole", ECapture, EConsole, false}, // Capture devices are ignored
+		{"ECapture EConsole", ECapture, EConsole, false}, // Capture devices only notify capture subscribers
 	}
 
 	for _, tt := range tests {
@@ -409,6 +409,50 @@ func TestOnDefaultDeviceChanged(t *testing.T) {
 	}
 }
 
+// TestOnDefaultDeviceChanged_Capture tests that capture subscribers only follow capture device changes
+func TestOnDefaultDeviceChanged_Capture(t *testing.T) {
+	dn := &DeviceNotifier{
+		subscribers: make([]chan struct{}, 0),
+		started:     true,
+	}
+
+	client := newNotificationClient(dn)
+
+	tests := []struct {
+		name         string
+		flow         uint32
+		shouldNotify bool
+	}{
+		{"ECapture", ECapture, true},
+		{"EAll", EAll, true},
+		{"ERender", ERender, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := dn.SubscribeCapture()
+			defer dn.Unsubscribe(ch)
+
+			onDefaultDeviceChanged(client, tt.flow, EConsole, nil)
+
+			select {
+			case <-ch:
+				if !tt.shouldNotify {
+					t.Error("Should not have notified for this flow type")
+				}
+			case <-time.After(50 * time.Millisecond):
+				if tt.shouldNotify {
+					t.Error("Should have notified for this flow type")
+				}
+			}
+		})
+	}
+
+	if len(dn.captureSubs) != 0 {
+		t.Errorf("captureSubs = %d after unsubscribe, want 0", len(dn.captureSubs))
+	}
+}
+
 // TestOnDeviceAdded tests that device added callback returns S_OK but doesn't notify
 func TestOnDeviceAdded(t *testing.T) {
 	dn := &DeviceNotifier{
@@ -20,6 +20,11 @@ func GetDefaultRenderDevice(mmde interface{}) (interface{}, error) {
 	return nil, fmt.Errorf("audio devices are not supported on this platform")
 }
 
+// GetDefaultCaptureDevice is not available on non-Windows platforms.
+func GetDefaultCaptureDevice(mmde interface{}) (interface{}, error) {
+	return nil, fmt.Errorf("audio devices are not supported on this platform")
+}
+
 // SafeReleaseAudioEndpointVolume is a no-op on non-Windows platforms.
 func SafeReleaseAudioEndpointVolume(ptr interface{}) {}
 
@@ -90,6 +90,15 @@ func GetDefaultRenderDevice(mmde *wca.IMMDeviceEnumerator) (*wca.IMMDevice, erro
 	return mmd, nil
 }
 
+// GetDefaultCaptureDevice retrieves the default audio capture (input) endpoint, e.g. the microphone.
+func GetDefaultCaptureDevice(mmde *wca.IMMDeviceEnumerator) (*wca.IMMDevice, error) {
+	var mmd *wca.IMMDevice
+	if err := mmde.GetDefaultAudioEndpoint(wca.ECapture, wca.EConsole, &mmd); err != nil {
+		return nil, fmt.Errorf("failed to get default capture device: %w", err)
+	}
+	return mmd, nil
+}
+
 // SafeReleaseAudioEndpointVolume safely releases an IAudioEndpointVolume interface.
 func SafeReleaseAudioEndpointVolume(ptr **wca.IAudioEndpointVolume) {
 	if ptr != nil && *ptr != nil {
@@ -0,0 +1,111 @@
+package webeditor
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// ConfigChangedMessage is pushed to /api/config/changed subscribers after the
+// configuration file was changed outside the editor and reloaded
+type ConfigChangedMessage struct {
+	Type string `json:"type"` // Always "config_changed"
+	Path string `json:"path"`
+}
+
+// forwardConfigChanges broadcasts config paths received from changes until stop is closed
+func (s *Server) forwardConfigChanges(changes <-chan string, stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case path, ok := <-changes:
+			if !ok {
+				return
+			}
+			s.broadcastConfigChanged(path)
+		}
+	}
+}
+
+// broadcastConfigChanged queues a change notification for every subscriber.
+// Subscribers that are not keeping up miss the notification rather than block.
+func (s *Server) broadcastConfigChanged(path string) {
+	s.configSubsMu.Lock()
+	defer s.configSubsMu.Unlock()
+
+	for ch := range s.configSubs {
+		select {
+		case ch <- path:
+		default:
+		}
+	}
+}
+
+// subscribeConfigChanges registers a subscriber channel for change notifications
+func (s *Server) subscribeConfigChanges() chan string {
+	ch := make(chan string, 4)
+
+	s.configSubsMu.Lock()
+	defer s.configSubsMu.Unlock()
+
+	if s.configSubs == nil {
+		s.configSubs = make(map[chan string]struct{})
+	}
+	s.configSubs[ch] = struct{}{}
+	return ch
+}
+
+// unsubscribeConfigChanges removes a subscriber channel
+func (s *Server) unsubscribeConfigChanges(ch chan string) {
+	s.configSubsMu.Lock()
+	defer s.configSubsMu.Unlock()
+	delete(s.configSubs, ch)
+}
+
+// configSubscriberCount returns the number of connected change subscribers
+func (s *Server) configSubscriberCount() int {
+	s.configSubsMu.Lock()
+	defer s.configSubsMu.Unlock()
+	return len(s.configSubs)
+}
+
+// handleConfigChangedWebSocket upgrades to WebSocket and pushes a ConfigChangedMessage
+// each time the configuration file is changed outside the editor
+func (s *Server) handleConfigChangedWebSocket(w http.ResponseWriter, r *http.Request) {
+	// Default options accept only same-origin connections, i.e. the editor page itself
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		log.Printf("Web editor: failed to accept config WebSocket: %v", err)
+		return
+	}
+	defer func() { _ = conn.Close(websocket.StatusNormalClosure, "") }()
+
+	// The client sends nothing; CloseRead cancels ctx once it disconnects
+	ctx := conn.CloseRead(r.Context())
+
+	ch := s.subscribeConfigChanges()
+	defer s.unsubscribeConfigChanges(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case path := <-ch:
+			msg, err := json.Marshal(ConfigChangedMessage{Type: "config_changed", Path: path})
+			if err != nil {
+				continue
+			}
+			writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			err = conn.Write(writeCtx, websocket.MessageText, msg)
+			cancel()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
@@ -158,6 +158,64 @@ const API = {
         return result;
     },
 
+    /**
+     * Get the download URL of a profile bundle
+     * @param {string} path - The profile path
+     * @returns {string} URL of the zip bundle with the config, fonts and WADs
+     */
+    getProfileExportUrl(path) {
+        return '/api/profiles/export?path=' + encodeURIComponent(path);
+    },
+
+    /**
+     * Import a profile bundle
+     * @param {File} file - The zip bundle
+     * @returns {Promise<Object>} The import result with the new profile path
+     */
+    async importProfile(file) {
+        const response = await fetch('/api/profiles/import', {
+            method: 'POST',
+            headers: {
+                'Content-Type': 'application/zip',
+            },
+            body: file,
+        });
+
+        const result = await response.json();
+
+        if (result.error) {
+            throw new Error(result.error);
+        }
+
+        return result;
+    },
+
+    /**
+     * Apply a grayscale render mode (DOOM, capture) to a sample frame
+     * @param {Object} options - Render mode settings (render_mode, gamma, posterize_levels, ...)
+     * @param {string|null} frame - Base64 PNG/JPEG/GIF sample frame, or null for the built-in sample
+     * @param {number} width - Output width
+     * @param {number} height - Output height
+     * @returns {Promise<Object>} Processed frame (base64 grayscale, one byte per pixel) with width and height
+     */
+    async previewRenderMode(options, frame, width, height) {
+        const response = await fetch('/api/preview/render-mode', {
+            method: 'POST',
+            headers: {
+                'Content-Type': 'application/json',
+            },
+            body: JSON.stringify({ options, frame, width, height }),
+        });
+
+        const result = await response.json();
+
+        if (result.error) {
+            throw new Error(result.error);
+        }
+
+        return result;
+    },
+
     /**
      * Get preview availability and configuration
      * @param {string} [deviceId] - Optional device ID for multi-device preview
@@ -200,6 +258,15 @@ const API = {
         return new WebSocket(url);
     },
 
+    /**
+     * Create a WebSocket connection notifying about config file changes made outside the editor
+     * @returns {WebSocket} WebSocket connection receiving {type: 'config_changed', path} messages
+     */
+    createConfigChangedWebSocket() {
+        const protocol = window.location.protocol === 'https:' ? 'wss:' : 'ws:';
+        return new WebSocket(`${protocol}//${window.location.host}/api/config/changed`);
+    },
+
     /**
      * Enable or disable preview backend override.
      * When enabled, temporarily switches to preview backend regardless of config.
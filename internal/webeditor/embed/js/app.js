@@ -36,6 +36,9 @@ class ConfigEditor {
         this.profileSelect = document.getElementById('profile-select');
         this.renameProfileBtn = document.getElementById('btn-rename-profile');
         this.newProfileBtn = document.getElementById('btn-new-profile');
+        this.exportProfileBtn = document.getElementById('btn-export-profile');
+        this.importProfileBtn = document.getElementById('btn-import-profile');
+        this.importProfileFile = document.getElementById('import-profile-file');
         this.themeToggle = document.getElementById('toggle-theme');
         this.previewToggleCheckbox = document.getElementById('preview-toggle-checkbox');
     }
@@ -60,6 +63,9 @@ class ConfigEditor {
         this.profileSelect.addEventListener('change', () => this.switchProfile());
         this.renameProfileBtn.addEventListener('click', () => this.renameCurrentProfile());
         this.newProfileBtn.addEventListener('click', () => this.createNewProfile());
+        this.exportProfileBtn.addEventListener('click', () => this.exportCurrentProfile());
+        this.importProfileBtn.addEventListener('click', () => this.importProfileFile.click());
+        this.importProfileFile.addEventListener('change', () => this.importProfile());
 
         // View toggle (checkbox: unchecked = form, checked = json)
         this.viewToggleCheckbox.addEventListener('change', () => {
@@ -104,9 +110,45 @@ class ConfigEditor {
         // Load initial configuration
         await this.loadConfig();
 
+        // Refresh when the config file is edited outside the editor
+        this.watchConfigChanges();
+
         this.setStatus('Ready');
     }
 
+    /**
+     * Listen for config file changes made outside the editor.
+     * Reloads the edited profile unless it has unsaved changes; reconnects if the connection drops.
+     */
+    watchConfigChanges() {
+        const ws = API.createConfigChangedWebSocket();
+
+        ws.onmessage = (event) => {
+            let msg;
+            try {
+                msg = JSON.parse(event.data);
+            } catch (_err) {
+                return;
+            }
+            if (msg.type !== 'config_changed') {
+                return;
+            }
+            // Another profile is being edited
+            if (this.editingProfilePath && msg.path !== this.editingProfilePath) {
+                return;
+            }
+            if (this.isDirty) {
+                this.showNotification('Configuration changed on disk - reload to see the changes', 'warning');
+                return;
+            }
+            this.loadConfig();
+        };
+
+        ws.onclose = () => {
+            setTimeout(() => this.watchConfigChanges(), 5000);
+        };
+    }
+
     /**
      * Load JSON schema
      */
@@ -607,6 +649,50 @@ class ConfigEditor {
         }
     }
 
+    /**
+     * Download the edited profile as a zip bundle with its fonts and WADs
+     */
+    exportCurrentProfile() {
+        if (!this.editingProfilePath) {
+            this.showNotification('No profile to export', 'error');
+            return;
+        }
+        if (this.isDirty) {
+            this.showNotification('Exporting the saved version; unsaved changes are not included', 'warning');
+        }
+        window.location.href = API.getProfileExportUrl(this.editingProfilePath);
+    }
+
+    /**
+     * Import a profile bundle selected in the file input and switch to it
+     */
+    async importProfile() {
+        const file = this.importProfileFile.files[0];
+        this.importProfileFile.value = '';
+        if (!file) {
+            return;
+        }
+
+        try {
+            this.setStatus('Importing profile...');
+            const result = await API.importProfile(file);
+
+            this.showNotification('Profile imported from "' + file.name + '"', 'success');
+
+            await this.loadProfiles();
+
+            if (result.path) {
+                this.profileSelect.value = result.path;
+                await this.switchProfile();
+            }
+
+            this.setStatus('Ready');
+        } catch (err) {
+            this.showNotification('Failed to import profile: ' + err.message, 'error');
+            this.setStatus('Error');
+        }
+    }
+
     /**
      * Rename the current profile
      */
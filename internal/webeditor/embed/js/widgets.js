@@ -303,7 +303,22 @@ class WidgetEditor {
                 if (!propSchema.properties) continue; // Skip flat properties
 
                 widget[propName] = widget[propName] || {};
-                const subsection = this.renderPropertySubsection(propName, propSchema, widget[propName], onUpdate);
+
+                // Render mode settings get a live preview that follows every change
+                let preview = null;
+                let update = onUpdate;
+                if (propSchema.properties.render_mode) {
+                    preview = this.createRenderModePreview(widget, widget[propName]);
+                    update = () => {
+                        onUpdate();
+                        preview.refresh();
+                    };
+                }
+
+                const subsection = this.renderPropertySubsection(propName, propSchema, widget[propName], update);
+                if (preview) {
+                    subsection.querySelector('.subsection-content').appendChild(preview.element);
+                }
                 mainSection.appendChild(subsection);
             }
         }
@@ -311,6 +326,103 @@ class WidgetEditor {
         container.appendChild(mainSection);
     }
 
+    /**
+     * Create a live preview of a grayscale render mode on a sample frame.
+     * The built-in sample is used until an image (e.g. a DOOM screenshot) is chosen.
+     * @param {Object} widget - Widget config, for the preview size
+     * @param {Object} options - Render mode settings object
+     * @returns {{element: HTMLElement, refresh: Function}}
+     */
+    createRenderModePreview(widget, options) {
+        const row = document.createElement('div');
+        row.className = 'field-row';
+
+        const labelEl = document.createElement('span');
+        labelEl.className = 'field-label';
+        labelEl.textContent = 'preview:';
+
+        const body = document.createElement('div');
+        body.className = 'render-preview';
+
+        const canvas = document.createElement('canvas');
+
+        const fileInput = document.createElement('input');
+        fileInput.type = 'file';
+        fileInput.accept = 'image/png,image/jpeg,image/gif';
+        fileInput.title = 'Sample frame (default: built-in gradient)';
+
+        const hint = 'Render mode applied to a sample frame; choose an image to preview a screenshot';
+        const status = document.createElement('span');
+        status.className = 'field-desc';
+        status.textContent = hint;
+
+        body.appendChild(canvas);
+        body.appendChild(fileInput);
+        body.appendChild(status);
+        row.appendChild(labelEl);
+        row.appendChild(body);
+
+        let frame = null;
+        let timer = null;
+        let requestId = 0;
+
+        const draw = (result) => {
+            canvas.width = result.width;
+            canvas.height = result.height;
+            canvas.style.width = (result.width * 2) + 'px';
+
+            const ctx = canvas.getContext('2d');
+            const pixels = atob(result.frame);
+            const imageData = ctx.createImageData(result.width, result.height);
+            for (let i = 0; i < pixels.length; i++) {
+                const v = pixels.charCodeAt(i);
+                imageData.data[i * 4] = v;
+                imageData.data[i * 4 + 1] = v;
+                imageData.data[i * 4 + 2] = v;
+                imageData.data[i * 4 + 3] = 255;
+            }
+            ctx.putImageData(imageData, 0, 0);
+        };
+
+        // Debounced, so dragging a number input does not flood the server
+        const refresh = () => {
+            clearTimeout(timer);
+            timer = setTimeout(async () => {
+                const id = ++requestId;
+                try {
+                    const result = await API.previewRenderMode(options, frame,
+                        widget.position?.w || 128, widget.position?.h || 40);
+                    if (id === requestId) {
+                        draw(result);
+                        status.textContent = hint;
+                    }
+                } catch (err) {
+                    if (id === requestId) {
+                        status.textContent = 'Preview failed: ' + err.message;
+                    }
+                }
+            }, 150);
+        };
+
+        fileInput.addEventListener('change', () => {
+            const file = fileInput.files[0];
+            if (!file) {
+                frame = null;
+                refresh();
+                return;
+            }
+            const reader = new FileReader();
+            reader.onload = () => {
+                frame = reader.result.split(',')[1];
+                refresh();
+            };
+            reader.readAsDataURL(file);
+        });
+
+        refresh();
+        return { element: row, refresh };
+    }
+
     /**
      * Render a simple property as a field row
      */
@@ -1,10 +1,16 @@
 package webeditor
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"image/png"
 	"io"
+	"mime"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
@@ -36,17 +42,31 @@ func (s *Server) registerHandlers(mux *http.ServeMux) {
 	mux.HandleFunc("/api/schema", s.handleGetSchema)
 	mux.HandleFunc("/api/config", s.handleConfig)
 	mux.HandleFunc("/api/config/load", s.handleLoadConfigByPath)
+	mux.HandleFunc("/api/config/changed", s.handleConfigChangedWebSocket)
 	mux.HandleFunc("/api/validate", s.handleValidate)
 	mux.HandleFunc("/api/profiles", s.handleProfiles)
 	mux.HandleFunc("/api/profiles/active", s.handleActiveProfile)
 	mux.HandleFunc("/api/profiles/rename", s.handleRenameProfile)
+	mux.HandleFunc("/api/profiles/export", s.handleExportProfile)
+	mux.HandleFunc("/api/profiles/import", s.handleImportProfile)
 
 	// Preview endpoints
 	mux.HandleFunc("/api/preview", s.handlePreviewInfo)
 	mux.HandleFunc("/api/preview/devices", s.handlePreviewDevices)
 	mux.HandleFunc("/api/preview/frame", s.handlePreviewFrame)
+	mux.HandleFunc("/api/preview/frame.png", s.handlePreviewFramePNG)
 	mux.HandleFunc("/api/preview/ws", s.handlePreviewWebSocket)
 	mux.HandleFunc("/api/preview/override", s.handlePreviewOverride)
+	mux.HandleFunc("/api/preview/render-mode", s.handleRenderPreview)
+	mux.HandleFunc("/api/preview/record", s.handleRecord)
+	mux.HandleFunc("/api/preview/record/file", s.handleRecordFile)
+
+	// Frame pacing status
+	mux.HandleFunc("/api/pacing", s.handleFramePacing)
+	mux.HandleFunc("/api/nowplaying", s.handleNowPlaying)
+
+	// Connected HID devices (direct driver picker and diagnostics)
+	mux.HandleFunc("/api/hid/devices", s.handleHIDDevices)
 
 	// Claude Code status endpoint
 	mux.HandleFunc("/api/claude-status", s.handleClaudeStatus)
@@ -147,8 +167,8 @@ func (s *Server) saveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Save to file
-	if savePath != "" {
+	// Save to file; the active config goes through the provider even when named by path
+	if savePath != "" && savePath != s.configProvider.GetConfigPath() {
 		// Save to specific path
 		if err := os.WriteFile(savePath, configData, 0644); err != nil {
 			respondError(w, "Failed to save: "+err.Error(), http.StatusInternalServerError)
@@ -222,8 +242,9 @@ func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
 	var cfg config.Config
 	if err := json.Unmarshal(body, &cfg); err != nil {
 		respondJSON(w, map[string]interface{}{
-			"valid":  false,
-			"errors": []string{"Invalid JSON: " + err.Error()},
+			"valid":    false,
+			"errors":   []string{"Invalid JSON: " + err.Error()},
+			"warnings": []config.Warning{},
 		})
 		return
 	}
@@ -231,15 +252,23 @@ func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
 	// Validate (defaults are applied when actually loading the config)
 	if err := config.Validate(&cfg); err != nil {
 		respondJSON(w, map[string]interface{}{
-			"valid":  false,
-			"errors": []string{err.Error()},
+			"valid":    false,
+			"errors":   []string{err.Error()},
+			"warnings": []config.Warning{},
 		})
 		return
 	}
 
+	// Warnings do not affect validity
+	warnings := config.Warnings(&cfg)
+	if warnings == nil {
+		warnings = []config.Warning{}
+	}
+
 	respondJSON(w, map[string]interface{}{
-		"valid":  true,
-		"errors": []string{},
+		"valid":    true,
+		"errors":   []string{},
+		"warnings": warnings,
 	})
 }
 
@@ -407,6 +436,106 @@ func (s *Server) handleRenameProfile(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// maxImportSize limits the size of an uploaded profile bundle
+const maxImportSize = 128 << 20
+
+// profileBundler returns the profile provider as a ProfileBundler, or nil if unsupported
+func (s *Server) profileBundler() ProfileBundler {
+	if s.profileProvider == nil {
+		return nil
+	}
+	bundler, _ := s.profileProvider.(ProfileBundler)
+	return bundler
+}
+
+// handleExportProfile downloads a profile with its referenced fonts and WADs as a zip bundle.
+// The profile is selected by the "path" query parameter, defaulting to the active config.
+func (s *Server) handleExportProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	bundler := s.profileBundler()
+	if bundler == nil {
+		respondError(w, "Profile export not available", http.StatusNotImplemented)
+		return
+	}
+
+	path := r.URL.Query().Get("path")
+	if path == "" {
+		path = s.configProvider.GetConfigPath()
+	}
+
+	// Only known profiles can be exported
+	known := false
+	for _, p := range s.profileProvider.GetProfiles() {
+		if p.Path == path {
+			known = true
+			break
+		}
+	}
+	if !known {
+		respondError(w, "Profile not found", http.StatusNotFound)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := bundler.ExportBundle(path, &buf); err != nil {
+		respondError(w, "Failed to export profile: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)) + ".zip"
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	_, _ = w.Write(buf.Bytes())
+}
+
+// handleImportProfile adds a profile from an uploaded zip bundle
+func (s *Server) handleImportProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Origin check
+	origin := r.Header.Get("Origin")
+	if origin != "" && !strings.HasPrefix(origin, "http://127.0.0.1") &&
+		!strings.HasPrefix(origin, "http://localhost") {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	bundler := s.profileBundler()
+	if bundler == nil {
+		respondError(w, "Profile import not available", http.StatusNotImplemented)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxImportSize+1))
+	if err != nil {
+		respondError(w, "Failed to read request", http.StatusBadRequest)
+		return
+	}
+	if len(data) > maxImportSize {
+		respondError(w, "Bundle too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	path, err := bundler.ImportBundle(data)
+	if err != nil {
+		respondError(w, "Failed to import profile: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	respondJSON(w, map[string]interface{}{
+		"success": true,
+		"path":    path,
+		"message": "Profile imported",
+	})
+}
+
 // respondJSON sends a JSON response
 func respondJSON(w http.ResponseWriter, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -447,6 +576,9 @@ func (s *Server) handlePreviewInfo(w http.ResponseWriter, r *http.Request) {
 		"width":      cfg.Width,
 		"height":     cfg.Height,
 		"target_fps": cfg.TargetFPS,
+		"scale":      cfg.Scale,
+		"grid":       cfg.Grid,
+		"pixel_gap":  cfg.PixelGap,
 	})
 }
 
@@ -512,6 +644,66 @@ func (s *Server) handlePreviewFrame(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// handlePreviewFramePNG returns the current frame as a PNG image, scaled up
+// with nearest-neighbor for screenshots. The scale defaults to the preview
+// scale (or 4) and can be set with ?scale=1..16; the preview pixel gap and
+// grid are drawn as in the browser.
+// Supports ?device=<id> query parameter for multi-device.
+func (s *Server) handlePreviewFramePNG(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	scale := 0
+	if v := r.URL.Query().Get("scale"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPNGScale {
+			respondError(w, fmt.Sprintf("scale must be 1..%d", maxPNGScale), http.StatusBadRequest)
+			return
+		}
+		scale = n
+	}
+
+	deviceID := r.URL.Query().Get("device")
+	provider := s.getPreviewProvider(deviceID)
+
+	if provider == nil {
+		respondError(w, "Preview not available", http.StatusNotImplemented)
+		return
+	}
+
+	frame, _, _ := provider.GetCurrentFrame()
+	if frame == nil {
+		respondError(w, "No frame rendered yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	cfg := provider.GetPreviewConfig()
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		respondError(w, "Invalid display size", http.StatusInternalServerError)
+		return
+	}
+
+	if scale == 0 {
+		scale = cfg.Scale
+		if scale <= 0 {
+			scale = defaultPNGScale
+		}
+	}
+
+	img := upscaleFrame(unpackFrame(frame, cfg.Width, cfg.Height), scale, cfg.PixelGap, cfg.Grid)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		respondError(w, "Failed to encode PNG: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(buf.Bytes())
+}
+
 // handlePreviewWebSocket upgrades to WebSocket for live preview.
 // Supports ?device=<id> query parameter for multi-device.
 func (s *Server) handlePreviewWebSocket(w http.ResponseWriter, r *http.Request) {
@@ -641,3 +833,84 @@ const fallbackHTML = `<!DOCTYPE html>
     </script>
 </body>
 </html>`
+
+// handleFramePacing returns frame push pacing counters for all running devices
+func (s *Server) handleFramePacing(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mu.Lock()
+	provider := s.framePacing
+	s.mu.Unlock()
+
+	devices := []FramePacingInfo{}
+	if provider != nil {
+		devices = append(devices, provider()...)
+	}
+
+	respondJSON(w, map[string]interface{}{
+		"devices": devices,
+	})
+}
+
+// handleHIDDevices lists connected USB HID devices. Enumeration does not
+// depend on the active backend, so it also works when no device is in use.
+func (s *Server) handleHIDDevices(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mu.Lock()
+	provider := s.hidDevices
+	s.mu.Unlock()
+
+	devices := []HIDDeviceInfo{}
+	if provider != nil {
+		found, err := provider()
+		if err != nil {
+			respondError(w, "Failed to enumerate HID devices: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		devices = append(devices, found...)
+	}
+
+	respondJSON(w, map[string]interface{}{
+		"devices": devices,
+	})
+}
+
+// handleNowPlaying returns the track shown by the running media widget
+func (s *Server) handleNowPlaying(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mu.Lock()
+	provider := s.nowPlaying
+	s.mu.Unlock()
+
+	var info *NowPlayingInfo
+	if provider != nil {
+		info = provider()
+	}
+	if info == nil {
+		respondJSON(w, map[string]interface{}{
+			"available": false,
+		})
+		return
+	}
+
+	respondJSON(w, map[string]interface{}{
+		"available":   true,
+		"widget":      info.Widget,
+		"title":       info.Title,
+		"artist":      info.Artist,
+		"position_ms": info.PositionMs,
+		"duration_s":  info.DurationS,
+		"status":      info.Status,
+	})
+}
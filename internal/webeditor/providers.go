@@ -1,6 +1,7 @@
 package webeditor
 
 import (
+	"io"
 	"net/http"
 	"time"
 )
@@ -15,11 +16,19 @@ type ConfigProvider interface {
 	Save(data []byte) error
 }
 
+// ConfigChangeNotifier is optionally implemented by a ConfigProvider whose
+// configuration file can change outside the editor
+type ConfigChangeNotifier interface {
+	// ConfigChanged returns a channel receiving the config path after each external
+	// change, once the application has reloaded it
+	ConfigChanged() <-chan string
+}
+
 // PreviewProvider abstracts preview frame access
 type PreviewProvider interface {
 	// GetCurrentFrame returns the current frame data, frame number, and timestamp
 	GetCurrentFrame() (data []byte, frameNum uint64, timestamp time.Time)
-	// GetPreviewConfig returns the preview configuration (width, height, fps)
+	// GetPreviewConfig returns the preview configuration (size, fps, rendering options)
 	GetPreviewConfig() PreviewDisplayConfig
 	// HandleWebSocket handles a WebSocket connection for live preview
 	HandleWebSocket(w http.ResponseWriter, r *http.Request)
@@ -30,6 +39,42 @@ type PreviewDisplayConfig struct {
 	Width     int `json:"width"`
 	Height    int `json:"height"`
 	TargetFPS int `json:"target_fps"`
+
+	// Browser rendering options; they do not change the frames sent to the device
+	Scale    int  `json:"scale"`     // Upscaling factor (0 = default)
+	Grid     bool `json:"grid"`      // Pixel grid overlay
+	PixelGap int  `json:"pixel_gap"` // Dark spacing between pixels, in screen pixels
+}
+
+// FramePacingInfo reports frame push pacing counters for a running device
+type FramePacingInfo struct {
+	Device    string `json:"device"`
+	MaxFPS    int    `json:"max_fps"`   // 0 = unlimited
+	Pushed    uint64 `json:"pushed"`    // Frames pushed to the device
+	Coalesced uint64 `json:"coalesced"` // Render ticks skipped to honour max_fps
+}
+
+// NowPlayingInfo reports the track shown by a running media widget
+type NowPlayingInfo struct {
+	Widget     string `json:"widget"`
+	Title      string `json:"title"`
+	Artist     string `json:"artist"`
+	PositionMs int    `json:"position_ms"`
+	DurationS  int    `json:"duration_s"`
+	Status     string `json:"status"` // "playing", "paused" or "stopped"
+}
+
+// HIDDeviceInfo describes a connected USB HID device, for picking the
+// direct_driver settings. IDs use the same hex format as the config.
+type HIDDeviceInfo struct {
+	VID          string `json:"vid"`                  // e.g. "1038"
+	PID          string `json:"pid"`                  // e.g. "1612"
+	Interface    string `json:"interface,omitempty"`  // e.g. "mi_01"
+	UsagePage    string `json:"usage_page,omitempty"` // e.g. "FFC0"
+	Product      string `json:"product,omitempty"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Path         string `json:"path"`
+	Known        string `json:"known,omitempty"` // Name of a supported display device with these IDs
 }
 
 // DevicePreviewInfo describes a device available for preview
@@ -53,6 +98,15 @@ type ProfileProvider interface {
 	RenameProfile(oldPath, newName string) (string, error)
 }
 
+// ProfileBundler is optionally implemented by a ProfileProvider that can export a
+// profile together with its referenced files and import such bundles
+type ProfileBundler interface {
+	// ExportBundle writes the profile at path as a zip bundle to w
+	ExportBundle(path string, w io.Writer) error
+	// ImportBundle adds the profile from a zip bundle and returns its path
+	ImportBundle(data []byte) (string, error)
+}
+
 // ProfileInfo contains profile metadata for the API
 type ProfileInfo struct {
 	Path     string `json:"path"`
@@ -0,0 +1,346 @@
+package webeditor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultRecordSeconds is the recording length when the request does not set one
+	defaultRecordSeconds = 5
+	// maxRecordSeconds limits the recording length
+	maxRecordSeconds = 60
+	// defaultRecordFPS is the capture rate for previews that report no target FPS
+	defaultRecordFPS = 30
+	// minGIFDelay is the shortest frame delay in 1/100 s; browsers slow down shorter ones
+	minGIFDelay = 2
+	// defaultPNGScale is the upscaling factor of frame screenshots
+	defaultPNGScale = 4
+	// maxPNGScale limits the upscaling factor of frame screenshots
+	maxPNGScale = 16
+)
+
+// Errors returned by StartRecording
+var (
+	ErrRecordingActive    = errors.New("a recording is already in progress")
+	ErrPreviewUnavailable = errors.New("preview not available")
+)
+
+// recordingPalette holds the two levels of the packed 1-bit frames
+var recordingPalette = color.Palette{color.Gray{Y: 0}, color.Gray{Y: 255}}
+
+// previewGridColor is the pixel grid overlay color of upscaled frames
+var previewGridColor = color.Gray{Y: 64}
+
+// recordingState is the state of the current or last preview recording
+type recordingState struct {
+	active bool
+	path   string
+	frames int
+	err    error
+}
+
+// capturedFrame is a preview frame and the time it was rendered
+type capturedFrame struct {
+	data []byte
+	at   time.Time
+}
+
+// StartRecording captures the preview frames of a device for the given duration
+// and writes them to path as an animated GIF. Frames are polled at the preview's
+// target FPS and timed by their render timestamps. Recording runs in the
+// background; its progress is reported at /api/preview/record.
+func (s *Server) StartRecording(deviceID string, duration time.Duration, path string) error {
+	s.mu.Lock()
+	provider := s.getPreviewProvider(deviceID)
+	s.mu.Unlock()
+	if provider == nil {
+		return ErrPreviewUnavailable
+	}
+
+	s.recordingMu.Lock()
+	defer s.recordingMu.Unlock()
+	if s.recording.active {
+		return ErrRecordingActive
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create recording directory: %w", err)
+	}
+	s.recording = recordingState{active: true, path: path}
+
+	log.Printf("Web editor: recording %v of preview to %s", duration, path)
+	go s.record(provider, duration, path)
+	return nil
+}
+
+// record captures and encodes a recording, then stores its result
+func (s *Server) record(provider PreviewProvider, duration time.Duration, path string) {
+	frames, end := captureFrames(provider, duration)
+	cfg := provider.GetPreviewConfig()
+
+	var err error
+	if len(frames) == 0 {
+		err = errors.New("no frames captured")
+	} else {
+		var data []byte
+		if data, err = encodeGIF(frames, end, cfg.Width, cfg.Height); err == nil {
+			err = os.WriteFile(path, data, 0644)
+		}
+	}
+
+	if err != nil {
+		log.Printf("Web editor: recording failed: %v", err)
+	} else {
+		log.Printf("Web editor: recorded %d frames to %s", len(frames), path)
+	}
+
+	s.recordingMu.Lock()
+	s.recording = recordingState{path: path, frames: len(frames), err: err}
+	s.recordingMu.Unlock()
+}
+
+// captureFrames polls the provider at its target FPS for duration and returns
+// each distinct frame with its render time, clamped to the recording start
+func captureFrames(provider PreviewProvider, duration time.Duration) ([]capturedFrame, time.Time) {
+	fps := provider.GetPreviewConfig().TargetFPS
+	if fps <= 0 {
+		fps = defaultRecordFPS
+	}
+
+	start := time.Now()
+	end := start.Add(duration)
+	ticker := time.NewTicker(time.Second / time.Duration(fps))
+	defer ticker.Stop()
+
+	var frames []capturedFrame
+	var lastNum uint64
+	for now := start; ; now = <-ticker.C {
+		if data, num, at := provider.GetCurrentFrame(); data != nil && (len(frames) == 0 || num != lastNum) {
+			if at.Before(start) {
+				at = start // The frame on screen when recording began
+			}
+			frames = append(frames, capturedFrame{data: data, at: at})
+			lastNum = num
+		}
+		if !now.Before(end) {
+			return frames, now
+		}
+	}
+}
+
+// encodeGIF encodes captured packed-bit frames as a looping GIF. Each frame is
+// shown until the next one was rendered; frames shown for less than minGIFDelay
+// are dropped so the animation keeps real time.
+func encodeGIF(frames []capturedFrame, end time.Time, width, height int) ([]byte, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid frame size %dx%d", width, height)
+	}
+
+	// Frame start times in 1/100 s from the first frame; rounding cumulative
+	// times keeps the total length exact
+	origin := frames[0].at
+	centis := func(t time.Time) int {
+		return int((t.Sub(origin) + 5*time.Millisecond) / (10 * time.Millisecond))
+	}
+
+	anim := &gif.GIF{}
+	var starts []int
+	for i, f := range frames {
+		start := centis(f.at)
+		if i > 0 && i+1 < len(frames) && centis(frames[i+1].at)-start < minGIFDelay {
+			continue // The previous frame is extended over this one
+		}
+		anim.Image = append(anim.Image, unpackFrame(f.data, width, height))
+		starts = append(starts, start)
+	}
+	for i, start := range starts {
+		stop := centis(end)
+		if i+1 < len(starts) {
+			stop = starts[i+1]
+		}
+		anim.Delay = append(anim.Delay, max(stop-start, minGIFDelay))
+	}
+
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// unpackFrame converts a packed 1-bit frame (row by row, MSB first) to a paletted image
+func unpackFrame(data []byte, width, height int) *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, width, height), recordingPalette)
+	for i := range width * height {
+		if i/8 < len(data) && data[i/8]&(0x80>>(i%8)) != 0 {
+			img.Pix[i] = 1
+		}
+	}
+	return img
+}
+
+// upscaleFrame enlarges a frame by an integer factor with nearest-neighbor
+// sampling. The last gap rows and columns of each pixel are left dark to mimic
+// OLED dot spacing; with grid they are drawn in the grid color instead (one
+// line wide without a gap). Lines never cover a whole pixel.
+func upscaleFrame(img *image.Paletted, scale, gap int, grid bool) *image.Paletted {
+	if scale <= 1 {
+		return img
+	}
+
+	line := gap
+	if grid && line == 0 {
+		line = 1
+	}
+	line = max(0, min(line, scale-1))
+
+	palette := img.Palette
+	var lineIndex uint8 // Dark: the first palette entry
+	if grid {
+		palette = append(append(color.Palette{}, img.Palette...), previewGridColor)
+		lineIndex = uint8(len(palette) - 1)
+	}
+
+	b := img.Bounds()
+	out := image.NewPaletted(image.Rect(0, 0, b.Dx()*scale, b.Dy()*scale), palette)
+	for y := range out.Rect.Dy() {
+		src := img.Pix[(y/scale)*img.Stride:]
+		dst := out.Pix[y*out.Stride:]
+		rowLine := y%scale >= scale-line
+		for x := range out.Rect.Dx() {
+			if rowLine || x%scale >= scale-line {
+				dst[x] = lineIndex
+			} else {
+				dst[x] = src[x/scale]
+			}
+		}
+	}
+	return out
+}
+
+// recordRequest is the body of a recording request
+type recordRequest struct {
+	Device  string `json:"device"`  // Device ID (default: first device)
+	Seconds int    `json:"seconds"` // Recording length (default: 5, max: 60)
+	Path    string `json:"path"`    // Output .gif file within recordings/ next to the config (default: timestamped)
+}
+
+// handleRecord starts a preview recording (POST) or reports its state (GET)
+func (s *Server) handleRecord(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.recordingMu.Lock()
+		state := s.recording
+		s.recordingMu.Unlock()
+
+		result := map[string]interface{}{
+			"recording": state.active,
+			"path":      state.path,
+			"frames":    state.frames,
+		}
+		if state.err != nil {
+			result["error"] = state.err.Error()
+		}
+		respondJSON(w, result)
+	case http.MethodPost:
+		s.startRecording(w, r)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// startRecording handles a recording request
+func (s *Server) startRecording(w http.ResponseWriter, r *http.Request) {
+	// Origin check
+	origin := r.Header.Get("Origin")
+	if origin != "" && !strings.HasPrefix(origin, "http://127.0.0.1") &&
+		!strings.HasPrefix(origin, "http://localhost") {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req recordRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, "Invalid request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	seconds := req.Seconds
+	if seconds <= 0 {
+		seconds = defaultRecordSeconds
+	}
+	if seconds > maxRecordSeconds {
+		respondError(w, fmt.Sprintf("seconds must be at most %d", maxRecordSeconds), http.StatusBadRequest)
+		return
+	}
+
+	path, err := s.recordingPath(req.Path)
+	if err != nil {
+		respondError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch err := s.StartRecording(req.Device, time.Duration(seconds)*time.Second, path); {
+	case errors.Is(err, ErrPreviewUnavailable):
+		respondError(w, "Preview not available", http.StatusNotImplemented)
+	case errors.Is(err, ErrRecordingActive):
+		respondError(w, err.Error(), http.StatusConflict)
+	case err != nil:
+		respondError(w, err.Error(), http.StatusInternalServerError)
+	default:
+		respondJSON(w, map[string]interface{}{
+			"success": true,
+			"path":    path,
+			"seconds": seconds,
+		})
+	}
+}
+
+// recordingPath resolves a requested recording file within recordings/ next to the
+// config. The name may include subfolders but must stay inside that folder.
+func (s *Server) recordingPath(name string) (string, error) {
+	dir := filepath.Join(filepath.Dir(s.configProvider.GetConfigPath()), "recordings")
+	if name == "" {
+		return filepath.Join(dir, "steelclock-"+time.Now().Format("20060102-150405")+".gif"), nil
+	}
+	if !filepath.IsLocal(name) {
+		return "", fmt.Errorf("path must be relative to the recordings folder")
+	}
+	if !strings.EqualFold(filepath.Ext(name), ".gif") {
+		return "", fmt.Errorf("path must end with .gif")
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// handleRecordFile downloads the last finished recording
+func (s *Server) handleRecordFile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.recordingMu.Lock()
+	state := s.recording
+	s.recordingMu.Unlock()
+
+	if state.active || state.path == "" || state.err != nil {
+		respondError(w, "No finished recording", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(state.path)))
+	http.ServeFile(w, r, state.path)
+}
@@ -0,0 +1,351 @@
+package webeditor
+
+import (
+	"bytes"
+	"encoding/json"
+	"image/gif"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnpackFrame(t *testing.T) {
+	// 8x2 frame: first row alternating from the left, second row all on
+	img := unpackFrame([]byte{0xAA, 0xFF}, 8, 2)
+
+	want := []uint8{1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1}
+	if !bytes.Equal(img.Pix, want) {
+		t.Errorf("Pix = %v, want %v", img.Pix, want)
+	}
+
+	// Short data leaves the remaining pixels off
+	if img := unpackFrame([]byte{0xFF}, 8, 2); img.Pix[8] != 0 {
+		t.Error("pixels past the data should be off")
+	}
+}
+
+func TestEncodeGIF_Timing(t *testing.T) {
+	start := time.Now()
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+	frame := make([]byte, 640)
+
+	frames := []capturedFrame{
+		{frame, at(0)},
+		{frame, at(100)}, // Replaced after 5 ms: dropped, the previous frame covers it
+		{frame, at(105)},
+		{frame, at(300)},
+	}
+	data, err := encodeGIF(frames, at(1000), 128, 40)
+	if err != nil {
+		t.Fatalf("encodeGIF() error = %v", err)
+	}
+
+	anim, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gif.DecodeAll() error = %v", err)
+	}
+	want := []int{11, 19, 70}
+	if len(anim.Delay) != len(want) {
+		t.Fatalf("got %d frames (delays %v), want %d", len(anim.Delay), anim.Delay, len(want))
+	}
+	total := 0
+	for i, d := range anim.Delay {
+		total += d
+		if d != want[i] {
+			t.Errorf("delay[%d] = %d, want %d", i, d, want[i])
+		}
+	}
+	if total != 100 {
+		t.Errorf("total length = %d cs, want 100", total)
+	}
+	if b := anim.Image[0].Bounds(); b.Dx() != 128 || b.Dy() != 40 {
+		t.Errorf("frame size = %dx%d, want 128x40", b.Dx(), b.Dy())
+	}
+}
+
+func TestEncodeGIF_InvalidSize(t *testing.T) {
+	if _, err := encodeGIF([]capturedFrame{{make([]byte, 1), time.Now()}}, time.Now(), 0, 40); err == nil {
+		t.Error("encodeGIF() should fail for a zero width")
+	}
+}
+
+func TestHandleRecord_NoPreview(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/preview/record", strings.NewReader(`{"seconds": 1}`))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotImplemented {
+		t.Errorf("Expected status 501, got %d", w.Code)
+	}
+}
+
+func TestHandleRecord_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"too long", `{"seconds": 61}`},
+		{"not a gif", `{"path": "out.png"}`},
+		{"absolute path", `{"path": "/tmp/out.gif"}`},
+		{"parent folder", `{"path": "../out.gif"}`},
+		{"nested parent folder", `{"path": "clips/../../out.gif"}`},
+		{"invalid JSON", `{`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, _ := createTestServer(t)
+			server.SetPreviewProvider(&mockPreviewProvider{})
+			mux := createTestMux(server)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/preview/record", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("Expected status 400, got %d", w.Code)
+			}
+		})
+	}
+}
+
+func TestRecordingPath(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	dir := filepath.Join(filepath.Dir(server.configProvider.GetConfigPath()), "recordings")
+
+	if got, err := server.recordingPath("clips/demo.gif"); err != nil || got != filepath.Join(dir, "clips", "demo.gif") {
+		t.Errorf("recordingPath(clips/demo.gif) = %q, %v, want a file in %s", got, err, dir)
+	}
+	if got, err := server.recordingPath(""); err != nil || filepath.Dir(got) != dir {
+		t.Errorf("recordingPath(\"\") = %q, %v, want a file in %s", got, err, dir)
+	}
+}
+
+func TestHandleRecord_RecordsGIF(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	provider := &mockPreviewProvider{
+		frameData: bytes.Repeat([]byte{0xF0}, 640),
+		frameNum:  1,
+		timestamp: time.Now(),
+		config:    PreviewDisplayConfig{Width: 128, Height: 40, TargetFPS: 50},
+	}
+	server.SetPreviewProvider(provider)
+	mux := createTestMux(server)
+
+	// Record straight through the capability to keep the test short
+	path := filepath.Join(t.TempDir(), "clip.gif")
+	if err := server.StartRecording("", 200*time.Millisecond, path); err != nil {
+		t.Fatalf("StartRecording() error = %v", err)
+	}
+	if err := server.StartRecording("", time.Second, path); err != ErrRecordingActive {
+		t.Errorf("second StartRecording() error = %v, want ErrRecordingActive", err)
+	}
+
+	var status struct {
+		Recording bool   `json:"recording"`
+		Path      string `json:"path"`
+		Frames    int    `json:"frames"`
+		Error     string `json:"error"`
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/preview/record", nil))
+		if err := json.NewDecoder(w.Body).Decode(&status); err != nil {
+			t.Fatalf("Failed to decode status: %v", err)
+		}
+		if !status.Recording || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if status.Recording || status.Error != "" || status.Path != path || status.Frames != 1 {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Recording not written: %v", err)
+	}
+	if _, err := gif.DecodeAll(bytes.NewReader(data)); err != nil {
+		t.Errorf("Recording is not a valid GIF: %v", err)
+	}
+
+	// The finished recording is downloadable
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/preview/record/file", nil))
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), data) {
+		t.Errorf("download status %d with %d bytes, want the recorded file", w.Code, w.Body.Len())
+	}
+	if cd := w.Header().Get("Content-Disposition"); !strings.Contains(cd, "clip.gif") {
+		t.Errorf("Content-Disposition = %q, want the file name", cd)
+	}
+}
+
+func TestHandleRecordFile_NoRecording(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/preview/record/file", nil))
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
+
+func TestUpscaleFrame(t *testing.T) {
+	img := upscaleFrame(unpackFrame([]byte{0x80}, 2, 1), 3, 0, false)
+
+	if b := img.Bounds(); b.Dx() != 6 || b.Dy() != 3 {
+		t.Fatalf("size = %dx%d, want 6x3", b.Dx(), b.Dy())
+	}
+	want := []uint8{1, 1, 1, 0, 0, 0}
+	for y := range 3 {
+		if row := img.Pix[y*img.Stride : y*img.Stride+6]; !bytes.Equal(row, want) {
+			t.Errorf("row %d = %v, want %v", y, row, want)
+		}
+	}
+}
+
+func TestHandlePreviewFramePNG(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	server.SetPreviewProvider(&mockPreviewProvider{
+		frameData: bytes.Repeat([]byte{0xF0}, 640),
+		frameNum:  1,
+		timestamp: time.Now(),
+		config:    PreviewDisplayConfig{Width: 128, Height: 40},
+	})
+	mux := createTestMux(server)
+
+	tests := []struct {
+		query      string
+		wantWidth  int
+		wantHeight int
+	}{
+		{"", 512, 160},
+		{"?scale=1", 128, 40},
+		{"?scale=2", 256, 80},
+	}
+
+	for _, tt := range tests {
+		t.Run("scale"+tt.query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/preview/frame.png"+tt.query, nil))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", w.Code)
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "image/png" {
+				t.Errorf("Content-Type = %q, want image/png", ct)
+			}
+			img, err := png.Decode(w.Body)
+			if err != nil {
+				t.Fatalf("png.Decode() error = %v", err)
+			}
+			if b := img.Bounds(); b.Dx() != tt.wantWidth || b.Dy() != tt.wantHeight {
+				t.Errorf("size = %dx%d, want %dx%d", b.Dx(), b.Dy(), tt.wantWidth, tt.wantHeight)
+			}
+			// 0xF0: the first four pixels are on
+			if r, _, _, _ := img.At(0, 0).RGBA(); r == 0 {
+				t.Error("pixel (0,0) should be on")
+			}
+			if r, _, _, _ := img.At(img.Bounds().Dx()/128*4, 0).RGBA(); r != 0 {
+				t.Error("pixel 4 should be off")
+			}
+		})
+	}
+}
+
+func TestHandlePreviewFramePNG_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider PreviewProvider
+		query    string
+		wantCode int
+	}{
+		{"no preview", nil, "", http.StatusNotImplemented},
+		{"no frame", &mockPreviewProvider{config: PreviewDisplayConfig{Width: 128, Height: 40}}, "", http.StatusServiceUnavailable},
+		{"invalid scale", &mockPreviewProvider{}, "?scale=0", http.StatusBadRequest},
+		{"scale too large", &mockPreviewProvider{}, "?scale=17", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, _ := createTestServer(t)
+			if tt.provider != nil {
+				server.SetPreviewProvider(tt.provider)
+			}
+			mux := createTestMux(server)
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/preview/frame.png"+tt.query, nil))
+
+			if w.Code != tt.wantCode {
+				t.Errorf("Expected status %d, got %d", tt.wantCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestUpscaleFrame_GapAndGrid(t *testing.T) {
+	tests := []struct {
+		name string
+		gap  int
+		grid bool
+		want []uint8 // First row, then last row of the 2x1 frame scaled 3x
+	}{
+		{"gap", 1, false, []uint8{1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{"grid", 0, true, []uint8{1, 1, 2, 0, 0, 2, 2, 2, 2, 2, 2, 2}},
+		{"grid over gap", 1, true, []uint8{1, 1, 2, 0, 0, 2, 2, 2, 2, 2, 2, 2}},
+		{"gap clamped", 5, false, []uint8{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := upscaleFrame(unpackFrame([]byte{0x80}, 2, 1), 3, tt.gap, tt.grid)
+
+			got := append(append([]uint8{}, img.Pix[:6]...), img.Pix[2*img.Stride:2*img.Stride+6]...)
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("rows = %v, want %v", got, tt.want)
+			}
+			if tt.grid && img.Palette[2] != previewGridColor {
+				t.Errorf("palette = %v, want the grid color appended", img.Palette)
+			}
+		})
+	}
+}
+
+func TestHandlePreviewFramePNG_PreviewScale(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	server.SetPreviewProvider(&mockPreviewProvider{
+		frameData: make([]byte, 640),
+		frameNum:  1,
+		timestamp: time.Now(),
+		config:    PreviewDisplayConfig{Width: 128, Height: 40, Scale: 2, Grid: true},
+	})
+	mux := createTestMux(server)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/preview/frame.png", nil))
+
+	img, err := png.Decode(w.Body)
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 256 || b.Dy() != 80 {
+		t.Errorf("size = %dx%d, want 256x80 from the preview scale", b.Dx(), b.Dy())
+	}
+	if r, _, _, _ := img.At(1, 0).RGBA(); r>>8 != uint32(previewGridColor.Y) {
+		t.Errorf("pixel (1,0) = %d, want the grid color", r>>8)
+	}
+}
@@ -0,0 +1,108 @@
+package webeditor
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/color"
+	_ "image/gif"  // Register GIF decoder for sample frames
+	_ "image/jpeg" // Register JPEG decoder for sample frames
+	_ "image/png"  // Register PNG decoder for sample frames
+	"net/http"
+	"strings"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+const (
+	// maxRenderPreviewRequest limits the render preview request, including the encoded sample frame
+	maxRenderPreviewRequest = 8 << 20
+	// maxRenderPreviewSize limits the width and height of the processed frame
+	maxRenderPreviewSize = 512
+)
+
+// renderPreviewRequest is the body of a render mode preview request
+type renderPreviewRequest struct {
+	Options *config.DoomConfig `json:"options"` // Render mode settings, as in the doom/capture config
+	Frame   []byte             `json:"frame"`   // Sample frame (PNG, JPEG or GIF), base64; empty = built-in sample
+	Width   int                `json:"width"`   // Output width (default: 128)
+	Height  int                `json:"height"`  // Output height (default: 40)
+}
+
+// handleRenderPreview converts a sample frame with a grayscale render mode, the way the
+// DOOM and capture widgets process their frames, and returns the result as base64
+// grayscale pixels (one byte per pixel, row by row)
+func (s *Server) handleRenderPreview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Origin check
+	origin := r.Header.Get("Origin")
+	if origin != "" && !strings.HasPrefix(origin, "http://127.0.0.1") &&
+		!strings.HasPrefix(origin, "http://localhost") {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxRenderPreviewRequest)
+
+	var req renderPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, "Invalid request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	width, height := req.Width, req.Height
+	if width <= 0 {
+		width = 128
+	}
+	if height <= 0 {
+		height = 40
+	}
+	if width > maxRenderPreviewSize || height > maxRenderPreviewSize {
+		respondError(w, "Preview size too large", http.StatusBadRequest)
+		return
+	}
+
+	var frame image.Image = sampleFrame(width, height)
+	if len(req.Frame) > 0 {
+		decoded, _, err := image.Decode(bytes.NewReader(req.Frame))
+		if err != nil {
+			respondError(w, "Invalid sample frame: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		frame = decoded
+	}
+
+	gray := bitmap.DownsampleToGray(frame, width, height)
+	bitmap.ApplyRenderMode(gray, bitmap.NewRenderModeOptions(req.Options))
+
+	respondJSON(w, map[string]interface{}{
+		"frame":  gray.Pix,
+		"width":  width,
+		"height": height,
+	})
+}
+
+// sampleFrame returns a built-in test frame: a horizontal brightness ramp with
+// a darker band and a bright disc, covering the full gray range
+func sampleFrame(width, height int) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	cx, cy, radius := width*3/4, height/2, height/3
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := x * 255 / max(width-1, 1)
+			if y >= height/3 && y < height*2/3 {
+				v /= 2
+			}
+			if dx, dy := x-cx, y-cy; dx*dx+dy*dy <= radius*radius {
+				v = 255 - v/4
+			}
+			img.SetGray(x, y, color.Gray{Y: uint8(v)})
+		}
+	}
+	return img
+}
@@ -28,6 +28,16 @@ type Server struct {
 	onReload          func() error
 	onProfileSwitch   func(path string) error
 	onPreviewOverride func(enable bool) error
+	framePacing       func() []FramePacingInfo
+	nowPlaying        func() *NowPlayingInfo
+	hidDevices        func() ([]HIDDeviceInfo, error)
+
+	configSubs   map[chan string]struct{} // Subscribers of /api/config/changed
+	configSubsMu sync.Mutex
+	stopNotify   chan struct{} // Stops forwarding config change notifications
+
+	recording   recordingState // Current or last preview recording
+	recordingMu sync.Mutex
 
 	mu      sync.Mutex
 	running bool
@@ -89,6 +99,28 @@ func (s *Server) SetPreviewOverrideCallback(callback func(enable bool) error) {
 	s.onPreviewOverride = callback
 }
 
+// SetFramePacingProvider sets the source of per-device frame pacing counters
+func (s *Server) SetFramePacingProvider(provider func() []FramePacingInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.framePacing = provider
+}
+
+// SetNowPlayingProvider sets the source of the current media widget track.
+// The provider returns nil when no media widget is running.
+func (s *Server) SetNowPlayingProvider(provider func() *NowPlayingInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nowPlaying = provider
+}
+
+// SetHIDDevicesProvider sets the source of connected USB HID devices
+func (s *Server) SetHIDDevicesProvider(provider func() ([]HIDDeviceInfo, error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hidDevices = provider
+}
+
 // Start starts the HTTP server on the default port (localhost only)
 func (s *Server) Start() error {
 	s.mu.Lock()
@@ -121,6 +153,11 @@ func (s *Server) Start() error {
 
 	s.running = true
 
+	if notifier, ok := s.configProvider.(ConfigChangeNotifier); ok {
+		s.stopNotify = make(chan struct{})
+		go s.forwardConfigChanges(notifier.ConfigChanged(), s.stopNotify)
+	}
+
 	go func() {
 		if err := s.httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
 			log.Printf("Web editor server error: %v", err)
@@ -143,6 +180,11 @@ func (s *Server) Stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	if s.stopNotify != nil {
+		close(s.stopNotify)
+		s.stopNotify = nil
+	}
+
 	if err := s.httpServer.Shutdown(ctx); err != nil {
 		return fmt.Errorf("failed to shutdown server: %w", err)
 	}
@@ -1,8 +1,12 @@
 package webeditor
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"image"
+	"image/png"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coder/websocket"
 	"github.com/pozitronik/steelclock-go/internal/config"
 )
 
@@ -663,6 +668,47 @@ func TestHandleValidate_ValidConfig(t *testing.T) {
 	}
 }
 
+func TestHandleValidate_Warnings(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	oldChecker := config.WidgetTypeChecker
+	config.WidgetTypeChecker = func(typeName string) bool {
+		return typeName == "clock"
+	}
+	defer func() { config.WidgetTypeChecker = oldChecker }()
+
+	body := `{
+		"refresh_rate_ms": 100,
+		"display": {"width": 128, "height": 40},
+		"widgets": [
+			{"type": "clock", "position": {"w": 64, "h": 40}},
+			{"type": "clock", "position": {"x": 100, "w": 64, "h": 40}}
+		]
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/api/validate", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	var result struct {
+		Valid    bool             `json:"valid"`
+		Errors   []string         `json:"errors"`
+		Warnings []config.Warning `json:"warnings"`
+	}
+	if err := json.NewDecoder(w.Result().Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if !result.Valid {
+		t.Errorf("Expected valid=true with warnings, got errors %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Widget != 1 || result.Warnings[0].Type != "clock" {
+		t.Errorf("Unexpected warnings: %+v", result.Warnings)
+	}
+}
+
 func TestHandleValidate_InvalidJSON(t *testing.T) {
 	server, _, _ := createTestServer(t)
 	mux := createTestMux(server)
@@ -1026,12 +1072,245 @@ func TestHandleRenameProfile_MethodNotAllowed(t *testing.T) {
 	}
 }
 
+// mockBundlingProfileProvider adds bundle export and import to mockProfileProvider
+type mockBundlingProfileProvider struct {
+	*mockProfileProvider
+	exportedPath string
+	importedData []byte
+	importErr    error
+}
+
+func (m *mockBundlingProfileProvider) ExportBundle(path string, w io.Writer) error {
+	m.exportedPath = path
+	_, err := w.Write([]byte("PK bundle"))
+	return err
+}
+
+func (m *mockBundlingProfileProvider) ImportBundle(data []byte) (string, error) {
+	m.importedData = data
+	if m.importErr != nil {
+		return "", m.importErr
+	}
+	return "/test/imported.json", nil
+}
+
+func TestHandleExportProfile(t *testing.T) {
+	server, configProvider, profileProvider := createTestServer(t)
+	configProvider.configPath = "/test/profile1.json"
+	bundler := &mockBundlingProfileProvider{mockProfileProvider: profileProvider}
+	server.profileProvider = bundler
+	mux := createTestMux(server)
+
+	tests := []struct {
+		name     string
+		url      string
+		wantCode int
+		wantPath string
+	}{
+		{"active profile", "/api/profiles/export", http.StatusOK, "/test/profile1.json"},
+		{"by path", "/api/profiles/export?path=/test/profile2.json", http.StatusOK, "/test/profile2.json"},
+		{"unknown path", "/api/profiles/export?path=/etc/passwd", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundler.exportedPath = ""
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantCode {
+				t.Fatalf("Expected status %d, got %d", tt.wantCode, resp.StatusCode)
+			}
+			if bundler.exportedPath != tt.wantPath {
+				t.Errorf("Exported path = %q, want %q", bundler.exportedPath, tt.wantPath)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != "application/zip" {
+				t.Errorf("Content-Type = %q, want application/zip", ct)
+			}
+			if cd := resp.Header.Get("Content-Disposition"); !strings.Contains(cd, strings.TrimSuffix(filepath.Base(tt.wantPath), ".json")+".zip") {
+				t.Errorf("Content-Disposition = %q", cd)
+			}
+		})
+	}
+}
+
+func TestHandleImportProfile(t *testing.T) {
+	server, _, profileProvider := createTestServer(t)
+	bundler := &mockBundlingProfileProvider{mockProfileProvider: profileProvider}
+	server.profileProvider = bundler
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/profiles/import", strings.NewReader("zip data"))
+	req.Header.Set("Origin", "http://127.0.0.1:8384")
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if string(bundler.importedData) != "zip data" {
+		t.Errorf("Imported data = %q, want %q", bundler.importedData, "zip data")
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if result["path"] != "/test/imported.json" {
+		t.Errorf("Expected path /test/imported.json, got %v", result["path"])
+	}
+}
+
+func TestHandleImportProfile_Error(t *testing.T) {
+	server, _, profileProvider := createTestServer(t)
+	server.profileProvider = &mockBundlingProfileProvider{mockProfileProvider: profileProvider, importErr: errors.New("invalid bundle")}
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/profiles/import", strings.NewReader("junk"))
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	if resp := w.Result(); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", resp.StatusCode)
+	}
+}
+
+func TestHandleProfileBundle_NotSupported(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/api/profiles/export", nil),
+		httptest.NewRequest(http.MethodPost, "/api/profiles/import", strings.NewReader("zip")),
+	}
+	for _, req := range requests {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if resp := w.Result(); resp.StatusCode != http.StatusNotImplemented {
+			t.Errorf("%s %s: expected status 501, got %d", req.Method, req.URL.Path, resp.StatusCode)
+		}
+	}
+}
+
 // Handler tests - Preview
 
+// postRenderPreview sends a render mode preview request and decodes the response
+func postRenderPreview(t *testing.T, mux *http.ServeMux, body string) (int, struct {
+	Frame  []byte `json:"frame"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/preview/render-mode", strings.NewReader(body))
+	req.Header.Set("Origin", "http://127.0.0.1:8384")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	var result struct {
+		Frame  []byte `json:"frame"`
+		Width  int    `json:"width"`
+		Height int    `json:"height"`
+	}
+	if w.Code == http.StatusOK {
+		if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+	}
+	return w.Code, result
+}
+
+func TestHandleRenderPreview(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	// 4x1 PNG: black, dark gray, light gray, white
+	src := image.NewGray(image.Rect(0, 0, 4, 1))
+	copy(src.Pix, []byte{0, 90, 170, 255})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+	frame, _ := json.Marshal(buf.Bytes())
+
+	tests := []struct {
+		name    string
+		options string
+		want    []byte
+	}{
+		{"normal", `{}`, []byte{0, 90, 170, 255}},
+		{"threshold", `{"render_mode": "threshold", "threshold_value": 100}`, []byte{0, 0, 255, 255}},
+		{"posterize", `{"render_mode": "posterize", "posterize_levels": 2}`, []byte{0, 0, 255, 255}},
+		{"gamma", `{"render_mode": "gamma", "gamma": 3, "contrast_boost": 1}`, []byte{0, 180, 222, 255}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, result := postRenderPreview(t, mux,
+				`{"options": `+tt.options+`, "frame": `+string(frame)+`, "width": 4, "height": 1}`)
+			if code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", code)
+			}
+			if result.Width != 4 || result.Height != 1 || !bytes.Equal(result.Frame, tt.want) {
+				t.Errorf("frame = %v (%dx%d), want %v (4x1)", result.Frame, result.Width, result.Height, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleRenderPreview_SampleFrame(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	code, result := postRenderPreview(t, mux, `{"options": {"render_mode": "dither"}}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", code)
+	}
+	if result.Width != 128 || result.Height != 40 || len(result.Frame) != 128*40 {
+		t.Fatalf("frame %dx%d with %d bytes, want 128x40", result.Width, result.Height, len(result.Frame))
+	}
+	for _, v := range result.Frame {
+		if v != 0 && v != 255 {
+			t.Fatalf("dithered frame has gray value %d", v)
+		}
+	}
+}
+
+func TestHandleRenderPreview_Invalid(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	for name, body := range map[string]string{
+		"bad JSON":    `{"options":`,
+		"bad frame":   `{"frame": "bm90IGFuIGltYWdl"}`,
+		"too large":   `{"width": 4096, "height": 40}`,
+		"wrong types": `{"width": "wide"}`,
+	} {
+		if code, _ := postRenderPreview(t, mux, body); code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", name, code)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/preview/render-mode", strings.NewReader(`{}`))
+	req.Header.Set("Origin", "http://evil.com")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("foreign origin: expected status 403, got %d", w.Code)
+	}
+}
+
 func TestHandlePreviewInfo_Available(t *testing.T) {
 	server, _, _ := createTestServer(t)
 	server.SetPreviewProvider(&mockPreviewProvider{
-		config: PreviewDisplayConfig{Width: 128, Height: 40, TargetFPS: 30},
+		config: PreviewDisplayConfig{Width: 128, Height: 40, TargetFPS: 30, Scale: 6, Grid: true, PixelGap: 1},
 	})
 	mux := createTestMux(server)
 
@@ -1057,6 +1336,11 @@ func TestHandlePreviewInfo_Available(t *testing.T) {
 	if int(result["width"].(float64)) != 128 {
 		t.Errorf("Expected width=128, got %v", result["width"])
 	}
+
+	if result["scale"] != 6.0 || result["grid"] != true || result["pixel_gap"] != 1.0 {
+		t.Errorf("Expected preview options scale=6 grid=true pixel_gap=1, got %v %v %v",
+			result["scale"], result["grid"], result["pixel_gap"])
+	}
 }
 
 func TestHandlePreviewInfo_NotAvailable(t *testing.T) {
@@ -1650,3 +1934,243 @@ func TestHandlePreviewFrame_DeviceParam(t *testing.T) {
 		t.Errorf("frame_number = %v, want 2", result["frame_number"])
 	}
 }
+
+func TestHandleFramePacing(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	server.SetFramePacingProvider(func() []FramePacingInfo {
+		return []FramePacingInfo{{Device: "main", MaxFPS: 30, Pushed: 120, Coalesced: 40}}
+	})
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/pacing", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Devices []FramePacingInfo `json:"devices"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if len(result.Devices) != 1 {
+		t.Fatalf("Expected 1 device, got %d", len(result.Devices))
+	}
+	if got := result.Devices[0]; got.Device != "main" || got.MaxFPS != 30 || got.Pushed != 120 || got.Coalesced != 40 {
+		t.Errorf("Unexpected pacing info: %+v", got)
+	}
+}
+
+func TestHandleFramePacing_NoProvider(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/pacing", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(w.Result().Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	devices, ok := result["devices"].([]interface{})
+	if !ok || len(devices) != 0 {
+		t.Errorf("Expected an empty devices list, got %v", result["devices"])
+	}
+}
+
+func TestHandleHIDDevices(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	device := HIDDeviceInfo{VID: "1038", PID: "1612", Interface: "mi_01", UsagePage: "FFC0", Product: "Apex 7", Path: "/dev/hidraw3", Known: "Apex 7"}
+	server.SetHIDDevicesProvider(func() ([]HIDDeviceInfo, error) {
+		return []HIDDeviceInfo{device}, nil
+	})
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/hid/devices", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Devices []HIDDeviceInfo `json:"devices"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if len(result.Devices) != 1 || result.Devices[0] != device {
+		t.Errorf("Unexpected devices: %+v", result.Devices)
+	}
+}
+
+func TestHandleHIDDevices_Error(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	server.SetHIDDevicesProvider(func() ([]HIDDeviceInfo, error) {
+		return nil, errors.New("not supported")
+	})
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/hid/devices", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", w.Code)
+	}
+}
+
+func TestHandleHIDDevices_MethodNotAllowed(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/hid/devices", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", w.Code)
+	}
+}
+
+func TestHandleNowPlaying(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	server.SetNowPlayingProvider(func() *NowPlayingInfo {
+		return &NowPlayingInfo{Widget: "player", Title: "Song", Artist: "Band", PositionMs: 61000, DurationS: 180, Status: "playing"}
+	})
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/nowplaying", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Available bool `json:"available"`
+		NowPlayingInfo
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if !result.Available {
+		t.Error("Expected available to be true")
+	}
+	want := NowPlayingInfo{Widget: "player", Title: "Song", Artist: "Band", PositionMs: 61000, DurationS: 180, Status: "playing"}
+	if result.NowPlayingInfo != want {
+		t.Errorf("Unexpected now playing info: %+v", result.NowPlayingInfo)
+	}
+}
+
+func TestHandleNowPlaying_NotAvailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider func() *NowPlayingInfo
+	}{
+		{name: "no provider"},
+		{name: "no media widget", provider: func() *NowPlayingInfo { return nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, _ := createTestServer(t)
+			if tt.provider != nil {
+				server.SetNowPlayingProvider(tt.provider)
+			}
+			mux := createTestMux(server)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/nowplaying", nil)
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(w.Result().Body).Decode(&result); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			if result["available"] != false {
+				t.Errorf("Expected available to be false, got %v", result["available"])
+			}
+			if len(result) != 1 {
+				t.Errorf("Expected only the available field, got %v", result)
+			}
+		})
+	}
+}
+
+func TestHandleNowPlaying_MethodNotAllowed(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	mux := createTestMux(server)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/nowplaying", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", w.Code)
+	}
+}
+
+func TestConfigChangedWebSocket(t *testing.T) {
+	server, _, _ := createTestServer(t)
+	ts := httptest.NewServer(createTestMux(server))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(ts.URL, "http")+"/api/config/changed", nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer func() { _ = conn.Close(websocket.StatusNormalClosure, "") }()
+
+	// Forward notifications the way Start does for a notifying provider
+	changes := make(chan string, 1)
+	stop := make(chan struct{})
+	defer close(stop)
+	go server.forwardConfigChanges(changes, stop)
+
+	for server.configSubscriberCount() == 0 {
+		if ctx.Err() != nil {
+			t.Fatal("WebSocket client was not subscribed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	changes <- "/test/config.json"
+
+	_, data, err := conn.Read(ctx)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	var msg ConfigChangedMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("Failed to decode message: %v", err)
+	}
+	if msg.Type != "config_changed" || msg.Path != "/test/config.json" {
+		t.Errorf("Unexpected message: %+v", msg)
+	}
+}
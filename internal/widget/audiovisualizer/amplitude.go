@@ -0,0 +1,51 @@
+package audiovisualizer
+
+import (
+	"math"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// amplitudeSettings extracts the amplitude scale and dB floor from spectrum config
+func amplitudeSettings(cfg *config.SpectrumConfig) (scale string, floorDB float64) {
+	scale = AudioAmplitudeScaleLinear
+	floorDB = DefaultDBFloor
+
+	if cfg == nil {
+		return scale, floorDB
+	}
+	if cfg.AmplitudeScale == AudioAmplitudeScaleDB {
+		scale = AudioAmplitudeScaleDB
+	}
+	if cfg.DBFloor < 0 {
+		floorDB = cfg.DBFloor
+	}
+	return scale, floorDB
+}
+
+// magnitudeToDB maps a normalized magnitude (0.0-1.0) to a bar height on a
+// decibel scale: 0 dB maps to 1.0 and levels at or below floorDB map to 0.0.
+func magnitudeToDB(magnitude, floorDB float64) float64 {
+	if magnitude <= 0 || floorDB >= 0 {
+		return 0
+	}
+
+	db := 20 * math.Log10(magnitude)
+	if db <= floorDB {
+		return 0
+	}
+	if db >= 0 {
+		return 1
+	}
+	return 1 - db/floorDB
+}
+
+// applyAmplitudeScale converts bar values in place when the dB scale is selected
+func applyAmplitudeScale(values []float64, scale string, floorDB float64) {
+	if scale != AudioAmplitudeScaleDB {
+		return
+	}
+	for i, v := range values {
+		values[i] = magnitudeToDB(v, floorDB)
+	}
+}
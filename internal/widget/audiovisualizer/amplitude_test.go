@@ -0,0 +1,90 @@
+package audiovisualizer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestAmplitudeSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *config.SpectrumConfig
+		wantScale string
+		wantFloor float64
+	}{
+		{"nil config", nil, AudioAmplitudeScaleLinear, DefaultDBFloor},
+		{"empty config", &config.SpectrumConfig{}, AudioAmplitudeScaleLinear, DefaultDBFloor},
+		{"db default floor", &config.SpectrumConfig{AmplitudeScale: "db"}, AudioAmplitudeScaleDB, DefaultDBFloor},
+		{"db custom floor", &config.SpectrumConfig{AmplitudeScale: "db", DBFloor: -40}, AudioAmplitudeScaleDB, -40},
+		{"positive floor ignored", &config.SpectrumConfig{AmplitudeScale: "db", DBFloor: 10}, AudioAmplitudeScaleDB, DefaultDBFloor},
+		{"unknown scale", &config.SpectrumConfig{AmplitudeScale: "cubic"}, AudioAmplitudeScaleLinear, DefaultDBFloor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scale, floor := amplitudeSettings(tt.cfg)
+			if scale != tt.wantScale || floor != tt.wantFloor {
+				t.Errorf("amplitudeSettings() = (%q, %v), want (%q, %v)", scale, floor, tt.wantScale, tt.wantFloor)
+			}
+		})
+	}
+}
+
+func TestMagnitudeToDB(t *testing.T) {
+	tests := []struct {
+		name      string
+		magnitude float64
+		floor     float64
+		want      float64
+	}{
+		{"full scale", 1.0, -60, 1.0},
+		{"above full scale", 2.0, -60, 1.0},
+		{"zero", 0, -60, 0},
+		{"negative", -0.5, -60, 0},
+		{"at floor", 0.001, -60, 0},
+		{"below floor", 0.0001, -60, 0},
+		{"-20 dB of 60", 0.1, -60, 2.0 / 3.0},
+		{"-20 dB of 40", 0.1, -40, 0.5},
+		{"invalid floor", 0.5, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := magnitudeToDB(tt.magnitude, tt.floor)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("magnitudeToDB(%v, %v) = %v, want %v", tt.magnitude, tt.floor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMagnitudeToDB_LiftsQuietDetail(t *testing.T) {
+	// A component 40 dB below the strongest is nearly invisible on a linear scale
+	quiet := 0.01
+	if got := magnitudeToDB(quiet, DefaultDBFloor); got < 0.3 {
+		t.Errorf("magnitudeToDB(%v) = %v, want a visible bar (>= 0.3)", quiet, got)
+	}
+}
+
+func TestApplyAmplitudeScale(t *testing.T) {
+	values := []float64{1.0, 0.1, 0}
+
+	linear := append([]float64(nil), values...)
+	applyAmplitudeScale(linear, AudioAmplitudeScaleLinear, -60)
+	for i := range values {
+		if linear[i] != values[i] {
+			t.Errorf("linear scale changed value %d: %v -> %v", i, values[i], linear[i])
+		}
+	}
+
+	db := append([]float64(nil), values...)
+	applyAmplitudeScale(db, AudioAmplitudeScaleDB, -40)
+	want := []float64{1.0, 0.5, 0}
+	for i := range want {
+		if math.Abs(db[i]-want[i]) > 1e-9 {
+			t.Errorf("db[%d] = %v, want %v", i, db[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,339 @@
+//go:build darwin
+
+package audiovisualizer
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// AudioCaptureDarwin captures system audio through a CoreAudio process tap, or
+// microphone input from the default input device
+type AudioCaptureDarwin struct {
+	mu           sync.Mutex
+	mode         string
+	id           uintptr // Client data identifying the capture in the shared IOProc
+	device       uint32  // Device the IOProc runs on: the tap aggregate or the input device
+	procID       uintptr // IOProc registration on device
+	tap          *processTap
+	running      bool
+	sampleRate   int
+	samplesLeft  []float32
+	samplesRight []float32
+	maxSamples   int
+	lastError    error
+}
+
+// platformCapture is the capture type the shared Unix widget reads from
+type platformCapture = AudioCaptureDarwin
+
+// getSharedPlatformCapture returns the shared capture for a capture mode
+func getSharedPlatformCapture(mode string) (*platformCapture, error) {
+	return GetSharedAudioCaptureDarwinForMode(mode)
+}
+
+// reinitializeSharedPlatformCaptures restarts all shared captures
+func reinitializeSharedPlatformCaptures() error {
+	return ReinitializeSharedAudioCaptureDarwin()
+}
+
+// Shared audio capture instances, one per capture mode
+var (
+	sharedAudioCaptures  = make(map[string]*AudioCaptureDarwin)
+	sharedAudioCaptureMu sync.Mutex
+)
+
+// GetSharedAudioCaptureDarwinForMode returns the shared audio capture instance for a capture mode
+func GetSharedAudioCaptureDarwinForMode(mode string) (*AudioCaptureDarwin, error) {
+	sharedAudioCaptureMu.Lock()
+	defer sharedAudioCaptureMu.Unlock()
+
+	if capture := sharedAudioCaptures[mode]; capture != nil && capture.running {
+		return capture, nil
+	}
+
+	capture, err := NewAudioCaptureDarwinForMode(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedAudioCaptures[mode] = capture
+	return capture, nil
+}
+
+// ReinitializeSharedAudioCaptureDarwin reinitializes all shared audio captures
+func ReinitializeSharedAudioCaptureDarwin() error {
+	sharedAudioCaptureMu.Lock()
+	defer sharedAudioCaptureMu.Unlock()
+
+	modes := []string{config.AudioCaptureModeLoopback}
+	for mode, capture := range sharedAudioCaptures {
+		capture.Close()
+		if mode != config.AudioCaptureModeLoopback {
+			modes = append(modes, mode)
+		}
+	}
+	clear(sharedAudioCaptures)
+
+	for _, mode := range modes {
+		capture, err := NewAudioCaptureDarwinForMode(mode)
+		if err != nil {
+			return err
+		}
+		sharedAudioCaptures[mode] = capture
+	}
+	return nil
+}
+
+// NewAudioCaptureDarwinForMode creates a new audio capture instance for a capture mode
+func NewAudioCaptureDarwinForMode(mode string) (*AudioCaptureDarwin, error) {
+	ac := &AudioCaptureDarwin{
+		mode:       mode,
+		sampleRate: 48000,
+		maxSamples: 16384,
+	}
+
+	if err := loadCoreAudio(); err != nil {
+		log.Printf("[AUDIO-CAPTURE] CoreAudio unavailable: %v", err)
+		ac.lastError = err
+		return ac, nil // Return without error - the widget shows its error state
+	}
+
+	if err := ac.start(); err != nil {
+		log.Printf("[AUDIO-CAPTURE] Failed to start capture: %v", err)
+		ac.lastError = err
+		return ac, nil
+	}
+
+	return ac, nil
+}
+
+// Captures receiving audio from the shared IOProc, by client data
+var (
+	ioProcCaptures sync.Map
+	nextCaptureID  atomic.Uintptr
+)
+
+// audioIOProc is the AudioDeviceIOProc of all captures. CoreAudio calls it on its
+// realtime thread with each block of input.
+func audioIOProc(_ uint32, _, inputData, _, _, _ unsafe.Pointer, clientData uintptr) int32 {
+	if capture, ok := ioProcCaptures.Load(clientData); ok {
+		capture.(*AudioCaptureDarwin).appendBuffers(readBufferList(inputData))
+	}
+	return 0
+}
+
+// start opens the device for the capture mode and starts its IOProc
+func (ac *AudioCaptureDarwin) start() error {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if ac.running {
+		return nil
+	}
+
+	if ac.mode == config.AudioCaptureModeMicrophone {
+		if microphoneAccessDenied() {
+			log.Println("[AUDIO-CAPTURE] Microphone access denied; allow it in System Settings > Privacy & Security > Microphone")
+			return errAudioPermissionDenied
+		}
+		device, err := defaultDevice(kAudioHardwarePropertyDefaultInputDevice)
+		if err != nil {
+			return err
+		}
+		ac.device = device
+	} else {
+		if systemAudioAccessDenied() {
+			log.Println("[AUDIO-CAPTURE] System audio access denied; allow it in System Settings > Privacy & Security > Screen & System Audio Recording")
+			return errAudioPermissionDenied
+		}
+		tap, err := newProcessTap()
+		if err != nil {
+			return err
+		}
+		ac.tap = tap
+		ac.device = tap.aggregate
+	}
+
+	if rate, err := deviceSampleRate(ac.device); err == nil && rate > 0 {
+		ac.sampleRate = int(rate)
+	}
+
+	ac.id = nextCaptureID.Add(1)
+	ioProcCaptures.Store(ac.id, ac)
+	if status := coreAudio.createIOProcID(ac.device, coreAudio.ioProc, ac.id, &ac.procID); status != 0 {
+		ac.release()
+		return osStatusError("AudioDeviceCreateIOProcID", status)
+	}
+
+	ac.samplesLeft = make([]float32, 0, ac.maxSamples)
+	ac.samplesRight = make([]float32, 0, ac.maxSamples)
+	if status := coreAudio.deviceStart(ac.device, ac.procID); status != 0 {
+		ac.release()
+		return osStatusError("AudioDeviceStart", status)
+	}
+	ac.running = true
+
+	log.Printf("[AUDIO-CAPTURE] Started %s capture using CoreAudio at %d Hz", ac.mode, ac.sampleRate)
+	return nil
+}
+
+// release stops the IOProc and frees the device
+func (ac *AudioCaptureDarwin) release() {
+	if ac.procID != 0 {
+		coreAudio.deviceStop(ac.device, ac.procID)
+		coreAudio.destroyIOProcID(ac.device, ac.procID)
+		ac.procID = 0
+	}
+	ioProcCaptures.Delete(ac.id)
+	if ac.tap != nil {
+		ac.tap.destroy()
+		ac.tap = nil
+	}
+	ac.device = 0
+}
+
+// audioBufferData is one buffer of an AudioBufferList: float32 samples interleaved
+// over its channels
+type audioBufferData struct {
+	channels int
+	samples  []float32
+}
+
+// splitStereo returns the left and right channels of a block of input. Interleaved
+// input comes as one multichannel buffer, non-interleaved input as one buffer per
+// channel; mono input feeds both channels.
+func splitStereo(buffers []audioBufferData) (left, right []float32) {
+	switch {
+	case len(buffers) == 0:
+		return nil, nil
+	case len(buffers) == 1:
+		b := buffers[0]
+		frames := len(b.samples) / b.channels
+		left = make([]float32, frames)
+		right = make([]float32, frames)
+		for i := range frames {
+			left[i] = b.samples[i*b.channels]
+			right[i] = b.samples[i*b.channels+min(1, b.channels-1)]
+		}
+		return left, right
+	default:
+		frames := min(len(buffers[0].samples), len(buffers[1].samples))
+		return buffers[0].samples[:frames:frames], buffers[1].samples[:frames:frames]
+	}
+}
+
+// appendBuffers adds a block of input to the sample buffers
+func (ac *AudioCaptureDarwin) appendBuffers(buffers []audioBufferData) {
+	left, right := splitStereo(buffers)
+	if len(left) == 0 {
+		return
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	ac.samplesLeft = append(ac.samplesLeft, left...)
+	ac.samplesRight = append(ac.samplesRight, right...)
+
+	// Trim to max size
+	if len(ac.samplesLeft) > ac.maxSamples {
+		ac.samplesLeft = ac.samplesLeft[len(ac.samplesLeft)-ac.maxSamples:]
+		ac.samplesRight = ac.samplesRight[len(ac.samplesRight)-ac.maxSamples:]
+	}
+}
+
+// ReadSamples returns the current audio samples
+func (ac *AudioCaptureDarwin) ReadSamples() (left, right []float32, err error) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if !ac.running || len(ac.samplesLeft) == 0 {
+		return nil, nil, nil
+	}
+
+	left = make([]float32, len(ac.samplesLeft))
+	right = make([]float32, len(ac.samplesRight))
+	copy(left, ac.samplesLeft)
+	copy(right, ac.samplesRight)
+
+	return left, right, nil
+}
+
+// GetRecentSamples returns the most recent N samples
+func (ac *AudioCaptureDarwin) GetRecentSamples(count int) (left, right []float32) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if len(ac.samplesLeft) == 0 {
+		return nil, nil
+	}
+
+	if count > len(ac.samplesLeft) {
+		count = len(ac.samplesLeft)
+	}
+
+	start := len(ac.samplesLeft) - count
+	left = make([]float32, count)
+	right = make([]float32, count)
+	copy(left, ac.samplesLeft[start:])
+	copy(right, ac.samplesRight[start:])
+
+	return left, right
+}
+
+// IsRunning returns true if audio capture is active
+func (ac *AudioCaptureDarwin) IsRunning() bool {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	return ac.running
+}
+
+// LastError returns the error that stopped the capture, if any
+func (ac *AudioCaptureDarwin) LastError() error {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	return ac.lastError
+}
+
+// SampleRate returns the capture sample rate
+func (ac *AudioCaptureDarwin) SampleRate() int {
+	return ac.sampleRate
+}
+
+// Close stops the audio capture
+func (ac *AudioCaptureDarwin) Close() {
+	ac.mu.Lock()
+	if !ac.running {
+		ac.mu.Unlock()
+		return
+	}
+	ac.running = false
+	ac.mu.Unlock()
+
+	// Stopping waits for a running IOProc, which takes ac.mu to store its samples
+	ac.release()
+
+	log.Println("[AUDIO-CAPTURE] Stopped")
+}
+
+// WaitForSamples waits until at least minSamples are available or timeout
+func (ac *AudioCaptureDarwin) WaitForSamples(minSamples int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		ac.mu.Lock()
+		count := len(ac.samplesLeft)
+		ac.mu.Unlock()
+
+		if count >= minSamples {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+//go:build darwin
+
+package audiovisualizer
+
+import (
+	"slices"
+	"testing"
+	"unsafe"
+)
+
+func TestSplitStereo(t *testing.T) {
+	tests := []struct {
+		name      string
+		buffers   []audioBufferData
+		wantLeft  []float32
+		wantRight []float32
+	}{
+		{
+			name:      "interleaved stereo",
+			buffers:   []audioBufferData{{channels: 2, samples: []float32{1, -1, 2, -2, 3, -3}}},
+			wantLeft:  []float32{1, 2, 3},
+			wantRight: []float32{-1, -2, -3},
+		},
+		{
+			name:      "mono feeds both channels",
+			buffers:   []audioBufferData{{channels: 1, samples: []float32{1, 2}}},
+			wantLeft:  []float32{1, 2},
+			wantRight: []float32{1, 2},
+		},
+		{
+			name:      "extra channels are dropped",
+			buffers:   []audioBufferData{{channels: 3, samples: []float32{1, -1, 9, 2, -2, 9}}},
+			wantLeft:  []float32{1, 2},
+			wantRight: []float32{-1, -2},
+		},
+		{
+			name: "non-interleaved",
+			buffers: []audioBufferData{
+				{channels: 1, samples: []float32{1, 2, 3}},
+				{channels: 1, samples: []float32{-1, -2}},
+			},
+			wantLeft:  []float32{1, 2},
+			wantRight: []float32{-1, -2},
+		},
+		{
+			name: "no input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := splitStereo(tt.buffers)
+			if !slices.Equal(left, tt.wantLeft) || !slices.Equal(right, tt.wantRight) {
+				t.Errorf("splitStereo() = %v, %v, want %v, %v", left, right, tt.wantLeft, tt.wantRight)
+			}
+		})
+	}
+}
+
+func TestReadBufferList(t *testing.T) {
+	left := []float32{1, 2}
+	right := []float32{-1, -2}
+
+	// An AudioBufferList with two non-interleaved buffers
+	list := struct {
+		count   uint32
+		buffers [2]audioBuffer
+	}{
+		count: 2,
+		buffers: [2]audioBuffer{
+			{channels: 1, dataByteSize: 8, data: unsafe.Pointer(&left[0])},
+			{channels: 1, dataByteSize: 8, data: unsafe.Pointer(&right[0])},
+		},
+	}
+
+	buffers := readBufferList(unsafe.Pointer(&list))
+	if len(buffers) != 2 {
+		t.Fatalf("readBufferList() returned %d buffers, want 2", len(buffers))
+	}
+	if !slices.Equal(buffers[0].samples, left) || !slices.Equal(buffers[1].samples, right) {
+		t.Errorf("readBufferList() = %v, %v, want %v, %v", buffers[0].samples, buffers[1].samples, left, right)
+	}
+	if readBufferList(nil) != nil {
+		t.Error("readBufferList(nil) should return no buffers")
+	}
+}
+
+func TestAppendBuffers_TrimsToMax(t *testing.T) {
+	ac := &AudioCaptureDarwin{maxSamples: 3}
+	ac.appendBuffers([]audioBufferData{{channels: 2, samples: []float32{1, -1, 2, -2}}})
+	ac.appendBuffers([]audioBufferData{{channels: 2, samples: []float32{3, -3, 4, -4}}})
+
+	left, right := ac.GetRecentSamples(10)
+	if !slices.Equal(left, []float32{2, 3, 4}) || !slices.Equal(right, []float32{-2, -3, -4}) {
+		t.Errorf("samples = %v, %v, want the last 3 frames", left, right)
+	}
+}
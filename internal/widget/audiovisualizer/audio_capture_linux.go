@@ -12,11 +12,14 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
 )
 
-// AudioCaptureLinux captures system audio using PipeWire or PulseAudio
+// AudioCaptureLinux captures system audio (sink monitor) or microphone input using PipeWire or PulseAudio
 type AudioCaptureLinux struct {
 	mu           sync.Mutex
+	mode         string
 	cmd          *exec.Cmd
 	stdout       io.ReadCloser
 	running      bool
@@ -29,51 +32,81 @@ type AudioCaptureLinux struct {
 	audioTool    string
 }
 
+// platformCapture is the capture type the shared Unix widget reads from
+type platformCapture = AudioCaptureLinux
+
+// getSharedPlatformCapture returns the shared capture for a capture mode
+func getSharedPlatformCapture(mode string) (*platformCapture, error) {
+	return GetSharedAudioCaptureLinuxForMode(mode)
+}
+
+// reinitializeSharedPlatformCaptures restarts all shared captures
+func reinitializeSharedPlatformCaptures() error {
+	return ReinitializeSharedAudioCaptureLinux()
+}
+
+// Shared audio capture instances, one per capture mode
 var (
-	sharedAudioCapture   *AudioCaptureLinux
+	sharedAudioCaptures  = make(map[string]*AudioCaptureLinux)
 	sharedAudioCaptureMu sync.Mutex
 )
 
-// GetSharedAudioCaptureLinux returns the shared audio capture instance
+// GetSharedAudioCaptureLinux returns the shared loopback audio capture instance
 func GetSharedAudioCaptureLinux() (*AudioCaptureLinux, error) {
+	return GetSharedAudioCaptureLinuxForMode(config.AudioCaptureModeLoopback)
+}
+
+// GetSharedAudioCaptureLinuxForMode returns the shared audio capture instance for a capture mode
+func GetSharedAudioCaptureLinuxForMode(mode string) (*AudioCaptureLinux, error) {
 	sharedAudioCaptureMu.Lock()
 	defer sharedAudioCaptureMu.Unlock()
 
-	if sharedAudioCapture != nil && sharedAudioCapture.running {
-		return sharedAudioCapture, nil
+	if capture := sharedAudioCaptures[mode]; capture != nil && capture.running {
+		return capture, nil
 	}
 
-	capture, err := NewAudioCaptureLinux()
+	capture, err := NewAudioCaptureLinuxForMode(mode)
 	if err != nil {
 		return nil, err
 	}
 
-	sharedAudioCapture = capture
-	return sharedAudioCapture, nil
+	sharedAudioCaptures[mode] = capture
+	return capture, nil
 }
 
-// ReinitializeSharedAudioCaptureLinux reinitializes the shared audio capture
+// ReinitializeSharedAudioCaptureLinux reinitializes all shared audio captures
 func ReinitializeSharedAudioCaptureLinux() error {
 	sharedAudioCaptureMu.Lock()
 	defer sharedAudioCaptureMu.Unlock()
 
-	if sharedAudioCapture != nil {
-		sharedAudioCapture.Close()
-		sharedAudioCapture = nil
+	modes := []string{config.AudioCaptureModeLoopback}
+	for mode, capture := range sharedAudioCaptures {
+		capture.Close()
+		if mode != config.AudioCaptureModeLoopback {
+			modes = append(modes, mode)
+		}
 	}
+	clear(sharedAudioCaptures)
 
-	capture, err := NewAudioCaptureLinux()
-	if err != nil {
-		return err
+	for _, mode := range modes {
+		capture, err := NewAudioCaptureLinuxForMode(mode)
+		if err != nil {
+			return err
+		}
+		sharedAudioCaptures[mode] = capture
 	}
-
-	sharedAudioCapture = capture
 	return nil
 }
 
-// NewAudioCaptureLinux creates a new audio capture instance
+// NewAudioCaptureLinux creates a new loopback audio capture instance
 func NewAudioCaptureLinux() (*AudioCaptureLinux, error) {
+	return NewAudioCaptureLinuxForMode(config.AudioCaptureModeLoopback)
+}
+
+// NewAudioCaptureLinuxForMode creates a new audio capture instance for a capture mode
+func NewAudioCaptureLinuxForMode(mode string) (*AudioCaptureLinux, error) {
 	ac := &AudioCaptureLinux{
+		mode:       mode,
 		sampleRate: 48000,
 		channels:   2,
 		maxSamples: 16384,
@@ -135,12 +168,18 @@ func (ac *AudioCaptureLinux) start() error {
 
 	var cmd *exec.Cmd
 
+	microphone := ac.mode == config.AudioCaptureModeMicrophone
+
 	switch ac.audioTool {
 	case "pw-record":
 		// PipeWire: to capture system audio output (loopback), we need to:
 		// 1. Find the default sink's monitor
 		// 2. Record from it with raw output (no header)
-		sinkID := findDefaultSinkMonitor()
+		// In microphone mode no target is given, so pw-record uses the default source
+		var sinkID string
+		if !microphone {
+			sinkID = findDefaultSinkMonitor()
+		}
 		if sinkID != "" {
 			log.Printf("[AUDIO-CAPTURE] Found default sink ID: %s", sinkID)
 		}
@@ -160,12 +199,16 @@ func (ac *AudioCaptureLinux) start() error {
 
 		cmd = exec.Command("pw-record", args...)
 	case "parec":
-		// PulseAudio: capture from monitor
+		// PulseAudio: capture from monitor, or from the default source in microphone mode
+		device := "@DEFAULT_MONITOR@"
+		if microphone {
+			device = "@DEFAULT_SOURCE@"
+		}
 		cmd = exec.Command("parec",
 			"--rate=48000",
 			"--channels=2",
 			"--format=float32le",
-			"--device=@DEFAULT_MONITOR@")
+			"--device="+device)
 	default:
 		return nil
 	}
@@ -188,7 +231,7 @@ func (ac *AudioCaptureLinux) start() error {
 	// Start reading audio data in background
 	go ac.readLoop()
 
-	log.Printf("[AUDIO-CAPTURE] Started using %s", ac.audioTool)
+	log.Printf("[AUDIO-CAPTURE] Started %s capture using %s", ac.mode, ac.audioTool)
 	return nil
 }
 
@@ -289,6 +332,13 @@ func (ac *AudioCaptureLinux) IsRunning() bool {
 	return ac.running
 }
 
+// LastError returns the error that stopped the capture, if any
+func (ac *AudioCaptureLinux) LastError() error {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	return ac.lastError
+}
+
 // SampleRate returns the capture sample rate
 func (ac *AudioCaptureLinux) SampleRate() int {
 	return ac.sampleRate
@@ -1,4 +1,4 @@
-//go:build !windows && !linux
+//go:build !windows && !linux && !darwin
 
 package audiovisualizer
 
@@ -24,7 +24,7 @@ func GetSharedAudioCapture() (*AudioCaptureWCA, error) {
 	return nil, fmt.Errorf("audio capture is not supported on this platform")
 }
 
-// Widget stub for unsupported platforms (not Windows or Linux)
+// Widget stub for unsupported platforms (not Windows, Linux or macOS)
 type Widget struct {
 	*widget.BaseWidget
 	errorWidget *widget.ErrorWidget
@@ -1,8 +1,9 @@
-//go:build linux
+//go:build linux || darwin
 
 package audiovisualizer
 
 import (
+	"errors"
 	"image"
 	"image/color"
 	"log"
@@ -14,6 +15,8 @@ import (
 	"github.com/mjibson/go-dsp/fft"
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+	"github.com/pozitronik/steelclock-go/internal/shared"
 	"github.com/pozitronik/steelclock-go/internal/widget"
 )
 
@@ -38,14 +41,14 @@ var frequencyCompensationCurve = []struct {
 	{99999, 5.0},
 }
 
-// AudioCaptureWCA wraps AudioCaptureLinux for API compatibility
+// AudioCaptureWCA wraps the platform capture for API compatibility
 type AudioCaptureWCA struct {
-	capture *AudioCaptureLinux
+	capture *platformCapture
 }
 
 // GetSharedAudioCapture returns the shared audio capture instance
 func GetSharedAudioCapture() (*AudioCaptureWCA, error) {
-	capture, err := GetSharedAudioCaptureLinux()
+	capture, err := getSharedPlatformCapture(config.AudioCaptureModeLoopback)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +57,7 @@ func GetSharedAudioCapture() (*AudioCaptureWCA, error) {
 
 // ReinitializeSharedAudioCapture reinitializes the shared audio capture
 func ReinitializeSharedAudioCapture() error {
-	return ReinitializeSharedAudioCaptureLinux()
+	return reinitializeSharedPlatformCaptures()
 }
 
 // ReadSamples returns current audio samples
@@ -75,11 +78,12 @@ func (ac *AudioCaptureWCA) Close() {
 // Widget displays real-time spectrum analyzer or oscilloscope
 type Widget struct {
 	*widget.BaseWidget
-	audioCapture *AudioCaptureLinux
+	audioCapture *platformCapture
 	mu           sync.Mutex
 
 	// Display settings
 	displayMode string
+	captureMode string // "loopback" or "microphone"
 
 	// Error state management
 	errorWidget    *widget.ErrorWidget // Error widget proxy (nil = normal operation)
@@ -87,24 +91,45 @@ type Widget struct {
 	errorThreshold int                 // Errors before entering error state
 	startupTime    time.Time           // For startup grace period
 
+	// Silent placeholder while no device is available
+	silentOnNoDevice bool      // Show silence and keep retrying instead of the error state
+	lastDeviceRetry  time.Time // Last attempt to open a capture device
+
 	// Spectrum settings
 	frequencyScale         string
 	frequencyCompensation  bool
 	spectrumDynamicScaling float64
 	spectrumDynamicWindow  float64
-	smoothing              float64
+	attack                 float64
+	release                float64
 	peakHold               bool
 	peakHoldTime           float64
+	peakDecayStyle         string
+	peakGravity            float64
 	barStyle               string
 	fillColor              uint8
 	barCount               int
+	barBands               []int // Frequency band shown by each bar
+	segmentCount           int   // LED segments per bar (0 = solid bars)
+	segmentGap             int
+	amplitudeScale         string
+	dbFloor                float64
+	fftWindowName          string
+	fftWindow              []float64 // Window coefficients, built on the first FFT
 
 	// Oscilloscope settings
 	sampleCount       int
 	channelMode       string
 	waveformStyle     string
+	trigger           string // "none", "rising", "falling"
+	triggerLevel      float64
 	leftChannelColor  uint8
 	rightChannelColor uint8
+	stereoDivider     int              // Divider color between separated channels (-1=disabled)
+	correlation       correlationMeter // Stereo correlation mode state
+
+	// Input level
+	gain float64 // Manual multiplier applied to captured samples
 
 	// Audio data buffers
 	audioData      []float32
@@ -115,6 +140,7 @@ type Widget struct {
 	spectrumData        []float64
 	peakValues          []float64
 	peakTimestamps      []time.Time
+	peakVelocities      []float64 // Fall speed of each peak cap (gravity decay)
 	smoothedValues      []float64
 	barEnergyHistory    [][]float64
 	barEnergyIndex      int
@@ -125,14 +151,15 @@ type Widget struct {
 // New creates a new audio visualizer widget
 func New(cfg config.WidgetConfig) (widget.Widget, error) {
 	// Initialize audio capture
-	audioCapture, err := GetSharedAudioCaptureLinux()
+	captureMode := captureModeFromConfig(cfg)
+	audioCapture, err := getSharedPlatformCapture(captureMode)
 
 	if err != nil {
-		log.Printf("[AUDIO-VIS-LINUX] Audio capture error: %v", err)
+		log.Printf("[AUDIO-VIS] Audio capture error: %v", err)
 	} else if audioCapture != nil && audioCapture.IsRunning() {
-		log.Printf("[AUDIO-VIS-LINUX] Real audio capture initialized")
+		log.Printf("[AUDIO-VIS] Real audio capture initialized")
 	} else {
-		log.Printf("[AUDIO-VIS-LINUX] Audio capture not running")
+		log.Printf("[AUDIO-VIS] Audio capture not running")
 	}
 
 	// Set default update interval
@@ -156,6 +183,8 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 	spectrumDynamicWindow := 0.5
 	smoothing := 0.5
 	barStyle := AudioBarStyleBars
+	barLayout := AudioSpectrumLayoutNormal
+	fftWindowName := AudioFFTWindowHann
 
 	if cfg.Spectrum != nil {
 		if cfg.Spectrum.Bars > 0 {
@@ -171,6 +200,12 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		if cfg.Spectrum.Style != "" {
 			barStyle = cfg.Spectrum.Style
 		}
+		if cfg.Spectrum.Layout != "" {
+			barLayout = cfg.Spectrum.Layout
+		}
+		if cfg.Spectrum.Window != "" {
+			fftWindowName = cfg.Spectrum.Window
+		}
 		if cfg.Spectrum.DynamicScaling != nil {
 			if cfg.Spectrum.DynamicScaling.Strength > 0 {
 				spectrumDynamicScaling = cfg.Spectrum.DynamicScaling.Strength
@@ -185,6 +220,18 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		barCount = cfg.Position.W
 	}
 
+	// Mirrored layouts analyze half as many bands and show each one twice
+	barBands := spectrumBarOrder(barCount, barLayout)
+	bandCount := spectrumBandCount(barCount, barLayout)
+
+	segmentCount, segmentGap := 0, 0
+	if cfg.Spectrum != nil {
+		segmentCount, segmentGap = segmentSettings(cfg.Spectrum.SegmentCount, cfg.Spectrum.SegmentGap, cfg.Position.H)
+	}
+
+	amplitudeScale, dbFloor := amplitudeSettings(cfg.Spectrum)
+	attack, release := smoothingSettings(cfg.Spectrum, smoothing)
+
 	// Peak settings
 	peakHold := true
 	peakHoldTime := 1.0
@@ -195,11 +242,14 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 			peakHoldTime = cfg.Spectrum.Peak.HoldTime
 		}
 	}
+	peakDecayStyle, peakGravity := peakDecaySettings(cfg.Spectrum)
 
 	// Oscilloscope settings
 	sampleCount := 256
 	channelMode := AudioChannelModeMono
 	waveformStyle := AudioWaveformStyleLine
+	trigger := AudioTriggerNone
+	triggerLevel := 0.0
 
 	if cfg.Oscilloscope != nil {
 		if cfg.Oscilloscope.Samples > 0 {
@@ -208,11 +258,17 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		if cfg.Oscilloscope.Style != "" {
 			waveformStyle = cfg.Oscilloscope.Style
 		}
+		if cfg.Oscilloscope.Trigger != "" {
+			trigger = cfg.Oscilloscope.Trigger
+		}
+		triggerLevel = cfg.Oscilloscope.TriggerLevel
 	}
 
 	if cfg.Channel != "" {
 		channelMode = cfg.Channel
 	}
+	stereoDivider := shared.NewConfigHelper(cfg).GetStereoDivider()
+	gain, _ := gainSettings(cfg, displayMode) // No volume compensation on Linux and macOS
 
 	// Colors
 	fillColor := 255
@@ -232,7 +288,7 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 				rightChannelColor = *cfg.Spectrum.Colors.Right
 			}
 		}
-	case AudioDisplayModeOscilloscope:
+	case AudioDisplayModeOscilloscope, AudioDisplayModeLissajous:
 		if cfg.Oscilloscope != nil && cfg.Oscilloscope.Colors != nil {
 			if cfg.Oscilloscope.Colors.Fill != nil {
 				fillColor = *cfg.Oscilloscope.Colors.Fill
@@ -251,7 +307,7 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		windowSize = 2
 	}
 
-	energyHistory := make([][]float64, barCount)
+	energyHistory := make([][]float64, bandCount)
 	for i := range energyHistory {
 		energyHistory[i] = make([]float64, windowSize)
 	}
@@ -264,21 +320,36 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		frequencyCompensation:  frequencyCompensation,
 		spectrumDynamicScaling: spectrumDynamicScaling,
 		spectrumDynamicWindow:  spectrumDynamicWindow,
-		smoothing:              smoothing,
+		attack:                 attack,
+		release:                release,
 		peakHold:               peakHold,
 		peakHoldTime:           peakHoldTime,
+		peakDecayStyle:         peakDecayStyle,
+		peakGravity:            peakGravity,
 		barStyle:               barStyle,
 		fillColor:              uint8(fillColor),
 		barCount:               barCount,
+		barBands:               barBands,
+		correlation:            newCorrelationMeter(cfg.Correlation),
+		fftWindowName:          fftWindowName,
+		segmentCount:           segmentCount,
+		segmentGap:             segmentGap,
+		amplitudeScale:         amplitudeScale,
+		dbFloor:                dbFloor,
 		sampleCount:            sampleCount,
 		channelMode:            channelMode,
 		waveformStyle:          waveformStyle,
+		trigger:                trigger,
+		triggerLevel:           triggerLevel,
 		leftChannelColor:       uint8(leftChannelColor),
 		rightChannelColor:      uint8(rightChannelColor),
-		spectrumData:           make([]float64, barCount),
-		peakValues:             make([]float64, barCount),
-		peakTimestamps:         make([]time.Time, barCount),
-		smoothedValues:         make([]float64, barCount),
+		stereoDivider:          stereoDivider,
+		gain:                   gain,
+		spectrumData:           make([]float64, bandCount),
+		peakValues:             make([]float64, bandCount),
+		peakTimestamps:         make([]time.Time, bandCount),
+		peakVelocities:         make([]float64, bandCount),
+		smoothedValues:         make([]float64, bandCount),
 		barEnergyHistory:       energyHistory,
 		barEnergyIndex:         0,
 		barEnergyWindowSize:    windowSize,
@@ -287,22 +358,37 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		audioDataRight:         make([]float32, 0, 4096),
 		errorThreshold:         30, // ~1 second at 30fps
 		startupTime:            time.Now(),
+		captureMode:            captureMode,
+		silentOnNoDevice:       silentOnNoDevice(cfg, displayMode),
+		lastDeviceRetry:        time.Now(),
 	}
 
 	// Enter error state immediately if audio capture failed to initialize
 	if audioCapture == nil || !audioCapture.IsRunning() {
 		// Only show error after startup grace period (audio tools may take time to start)
 		// For immediate failures, we'll detect in Update()
-		if err != nil {
+		if err != nil && !w.silentOnNoDevice {
 			pos := w.GetPosition()
-			w.errorWidget = widget.NewErrorWidget(pos.W, pos.H, "NO AUDIO")
-			log.Printf("[AUDIO-VIS-LINUX] Entering error state: audio capture unavailable")
+			w.errorWidget = widget.NewErrorWidget(pos.W, pos.H, captureErrorMessage(audioCapture))
+			log.Printf("[AUDIO-VIS] Entering error state: audio capture unavailable")
 		}
 	}
 
 	return w, nil
 }
 
+// errAudioPermissionDenied is recorded by a capture the system refused access to audio
+var errAudioPermissionDenied = errors.New("audio capture permission denied")
+
+// captureErrorMessage returns the error widget text for a failed capture,
+// telling a missing permission apart from a missing audio source
+func captureErrorMessage(capture *platformCapture) string {
+	if capture != nil && errors.Is(capture.LastError(), errAudioPermissionDenied) {
+		return i18n.T(i18n.NoAccess)
+	}
+	return i18n.T(i18n.NoAudio)
+}
+
 // Update reads audio data and updates visualization
 func (w *Widget) Update() error {
 	w.mu.Lock()
@@ -316,27 +402,39 @@ func (w *Widget) Update() error {
 	w.lastUpdateTime = time.Now()
 
 	// Check audio capture health
+	var left, right []float32
 	if w.audioCapture == nil || !w.audioCapture.IsRunning() {
-		// Grace period: don't count errors during startup (audio tools may take time)
-		if time.Since(w.startupTime) > 3*time.Second {
-			w.errorCount++
-			if w.errorCount >= w.errorThreshold {
-				pos := w.GetPosition()
-				w.errorWidget = widget.NewErrorWidget(pos.W, pos.H, "NO AUDIO")
-				log.Printf("[AUDIO-VIS-LINUX] Entering error state after %d consecutive failures", w.errorCount)
+		if !w.silentOnNoDevice {
+			// Grace period: don't count errors during startup (audio tools may take time)
+			if time.Since(w.startupTime) > 3*time.Second {
+				w.errorCount++
+				if w.errorCount >= w.errorThreshold {
+					pos := w.GetPosition()
+					w.errorWidget = widget.NewErrorWidget(pos.W, pos.H, captureErrorMessage(w.audioCapture))
+					log.Printf("[AUDIO-VIS] Entering error state after %d consecutive failures", w.errorCount)
+				}
 			}
+			return nil
 		}
-		return nil
-	}
 
-	// Reset error count on successful capture access
-	w.errorCount = 0
+		// Keep looking for a device and show silence meanwhile
+		w.retryCapture()
+		left = make([]float32, silenceSamples)
+		right = make([]float32, silenceSamples)
+	} else {
+		// Reset error count on successful capture access
+		w.errorCount = 0
 
-	left, right := w.audioCapture.GetRecentSamples(4096)
-	if len(left) == 0 {
-		return nil
+		left, right = w.audioCapture.GetRecentSamples(4096)
+		if len(left) == 0 {
+			return nil
+		}
 	}
 
+	// Volume compensation is not available on Linux and macOS; only the manual gain applies
+	applyGain(left, w.gain)
+	applyGain(right, w.gain)
+
 	// Combine stereo to mono for spectrum analysis
 	samples := make([]float32, len(left))
 	for i := range left {
@@ -357,14 +455,33 @@ func (w *Widget) Update() error {
 		w.audioDataRight = w.audioDataRight[len(w.audioDataRight)-maxSamples:]
 	}
 
-	// Process for spectrum mode
+	// Process for spectrum and correlation modes
 	if w.displayMode == AudioDisplayModeSpectrum && len(w.audioData) >= 2048 {
 		w.updateSpectrum(w.audioData)
+	} else if w.displayMode == AudioDisplayModeCorrelation {
+		w.correlation.update(w.audioDataLeft, w.audioDataRight, time.Now())
 	}
 
 	return nil
 }
 
+// retryCapture tries to start a capture again, at most once per deviceRetryInterval
+func (w *Widget) retryCapture() {
+	if time.Since(w.lastDeviceRetry) < deviceRetryInterval {
+		return
+	}
+	w.lastDeviceRetry = time.Now()
+
+	capture, err := getSharedPlatformCapture(w.captureMode)
+	if err != nil {
+		return
+	}
+	w.audioCapture = capture
+	if capture.IsRunning() {
+		log.Printf("[AUDIO-VIS] Audio capture resumed")
+	}
+}
+
 // updateSpectrum performs FFT and updates spectrum data
 func (w *Widget) updateSpectrum(samples []float32) {
 	fftSize := 2048
@@ -382,11 +499,13 @@ func (w *Widget) updateSpectrum(samples []float32) {
 	mean /= float32(len(fftSamples))
 
 	// Convert to complex and apply window
+	if len(w.fftWindow) != fftSize {
+		w.fftWindow = fftWindow(w.fftWindowName, fftSize)
+	}
 	input := make([]complex128, fftSize)
 	for i := 0; i < fftSize; i++ {
 		sample := float64(fftSamples[i] - mean)
-		window := 0.5 * (1 - math.Cos(2*math.Pi*float64(i)/float64(fftSize-1)))
-		input[i] = complex(sample*window, 0)
+		input[i] = complex(sample*w.fftWindow[i], 0)
 	}
 
 	// Perform FFT
@@ -425,6 +544,9 @@ func (w *Widget) updateSpectrum(samples []float32) {
 		w.mapFrequenciesLinear(magnitudes, barCount)
 	}
 
+	// Convert bar heights to decibels if configured
+	applyAmplitudeScale(w.spectrumData, w.amplitudeScale, w.dbFloor)
+
 	// Store energy history
 	for i := 0; i < barCount; i++ {
 		w.barEnergyHistory[i][w.barEnergyIndex] = w.spectrumData[i]
@@ -433,10 +555,9 @@ func (w *Widget) updateSpectrum(samples []float32) {
 
 	// Apply smoothing
 	dt := time.Since(w.lastUpdateTime).Seconds()
-	if dt > 0 && w.smoothing > 0 {
+	if dt > 0 && (w.attack > 0 || w.release > 0) {
 		for i := range w.smoothedValues {
-			alpha := 1.0 - math.Pow(w.smoothing, dt*30)
-			w.smoothedValues[i] = alpha*w.spectrumData[i] + (1-alpha)*w.smoothedValues[i]
+			w.smoothedValues[i] = smoothValue(w.smoothedValues[i], w.spectrumData[i], w.attack, w.release, dt)
 		}
 	} else {
 		copy(w.smoothedValues, w.spectrumData)
@@ -449,18 +570,12 @@ func (w *Widget) updateSpectrum(samples []float32) {
 			if w.smoothedValues[i] > w.peakValues[i] {
 				w.peakValues[i] = w.smoothedValues[i]
 				w.peakTimestamps[i] = now
+				w.peakVelocities[i] = 0
 			} else {
 				elapsed := now.Sub(w.peakTimestamps[i]).Seconds()
 				if elapsed > w.peakHoldTime {
-					decayRate := 0.3
 					dt := time.Since(w.lastUpdateTime).Seconds()
-					w.peakValues[i] *= 1.0 - decayRate*dt
-					if w.peakValues[i] < w.smoothedValues[i] {
-						w.peakValues[i] = w.smoothedValues[i]
-					}
-					if w.peakValues[i] < 0.01 {
-						w.peakValues[i] = 0
-					}
+					w.peakValues[i], w.peakVelocities[i] = decayPeak(w.peakValues[i], w.smoothedValues[i], w.peakVelocities[i], dt, w.peakDecayStyle, w.peakGravity)
 				}
 			}
 		}
@@ -544,6 +659,11 @@ func (w *Widget) mapFrequenciesLinear(magnitudes []float64, barCount int) {
 	}
 }
 
+// NeedsRender always returns true: audio is captured continuously, so the bars move between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the visualization
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.Lock()
@@ -561,6 +681,10 @@ func (w *Widget) Render() (image.Image, error) {
 		w.renderSpectrum(img)
 	} else if w.displayMode == AudioDisplayModeOscilloscope {
 		w.renderOscilloscope(img)
+	} else if w.displayMode == AudioDisplayModeLissajous {
+		w.renderLissajous(img)
+	} else if w.displayMode == AudioDisplayModeCorrelation {
+		w.correlation.render(img, time.Now())
 	}
 
 	w.ApplyBorder(img)
@@ -570,7 +694,7 @@ func (w *Widget) Render() (image.Image, error) {
 
 // renderSpectrum draws spectrum analyzer bars
 func (w *Widget) renderSpectrum(img *image.Gray) {
-	barCount := len(w.spectrumData)
+	barCount := len(w.barBands)
 	if barCount == 0 {
 		return
 	}
@@ -585,7 +709,8 @@ func (w *Widget) renderSpectrum(img *image.Gray) {
 	}
 
 	for i := 0; i < barCount; i++ {
-		magnitude := w.smoothedValues[i]
+		band := w.barBands[i]
+		magnitude := w.smoothedValues[band]
 		if magnitude > 1.0 {
 			magnitude = 1.0
 		}
@@ -601,7 +726,13 @@ func (w *Widget) renderSpectrum(img *image.Gray) {
 		x := i * barWidth
 		y := height - barHeight
 
-		if w.barStyle == AudioBarStyleBars {
+		if w.barStyle == AudioBarStyleBars && w.segmentCount > 0 {
+			barW := barWidth - gap
+			if x+barW > width {
+				barW = width - x
+			}
+			drawSegmentedBar(img, x, barW, height, magnitude, w.segmentCount, w.segmentGap, w.fillColor)
+		} else if w.barStyle == AudioBarStyleBars {
 			for py := y; py < height; py++ {
 				for px := x; px < x+barWidth-gap && px < width; px++ {
 					img.SetGray(px, py, color.Gray{Y: w.fillColor})
@@ -616,8 +747,8 @@ func (w *Widget) renderSpectrum(img *image.Gray) {
 		}
 
 		// Peak hold
-		if w.peakHold && i < len(w.peakValues) {
-			peakMagnitude := w.peakValues[i]
+		if w.peakHold && band < len(w.peakValues) {
+			peakMagnitude := w.peakValues[band]
 			if peakMagnitude > 1.0 {
 				peakMagnitude = 1.0
 			}
@@ -638,20 +769,49 @@ func (w *Widget) renderSpectrum(img *image.Gray) {
 func (w *Widget) renderOscilloscope(img *image.Gray) {
 	pos := w.GetPosition()
 	height := pos.H
-	width := pos.W
 	sampleCount := w.sampleCount
 
-	if len(w.audioData) == 0 {
+	if w.channelMode == AudioChannelModeStereoSeparated {
+		// Use separate left and right channels for stereo_separated mode
+		if len(w.audioDataLeft) == 0 || len(w.audioDataRight) == 0 {
+			return
+		}
+
+		leftSamples := oscilloscopeWindow(w.audioDataLeft, sampleCount, w.trigger, w.triggerLevel)
+		rightSamples := oscilloscopeWindow(w.audioDataRight, sampleCount, w.trigger, w.triggerLevel)
+
+		// Top half - left channel, bottom half - right channel
+		w.drawWaveform(img, leftSamples, 0, height/2, height/4, w.leftChannelColor)
+		w.drawWaveform(img, rightSamples, height/2, height, height*3/4, w.rightChannelColor)
+
+		drawStereoDivider(img, pos.W, height, w.stereoDivider)
 		return
 	}
-	if sampleCount > len(w.audioData) {
-		sampleCount = len(w.audioData)
+
+	// Use mixed channels for mono/combined modes
+	if len(w.audioData) == 0 {
+		return
 	}
 
-	centerY := height / 2
-	samples := w.audioData[len(w.audioData)-sampleCount:]
+	samples := oscilloscopeWindow(w.audioData, sampleCount, w.trigger, w.triggerLevel)
+	w.drawWaveform(img, samples, 0, height, height/2, w.fillColor)
+}
+
+// renderLissajous plots the left channel against the right one (X/Y mode).
+// The capture always delivers two channels; mono sources are upmixed by the capture tool.
+func (w *Widget) renderLissajous(img *image.Gray) {
+	left := oscilloscopeWindow(w.audioDataLeft, w.sampleCount, AudioTriggerNone, 0)
+	right := oscilloscopeWindow(w.audioDataRight, w.sampleCount, AudioTriggerNone, 0)
+	drawLissajous(img, left, right, w.waveformStyle, w.fillColor)
+}
+
+// drawWaveform draws a single waveform in the specified region
+func (w *Widget) drawWaveform(img *image.Gray, samples []float32, yStart, yEnd, centerY int, fillColor uint8) {
+	pos := w.GetPosition()
+	width := pos.W
+	sampleCount := len(samples)
 
-	amplitude := float32(height) / 2.0
+	amplitude := float32(yEnd-yStart) / 2.0
 
 	for i := 0; i < sampleCount-1; i++ {
 		x1 := i * width / sampleCount
@@ -660,32 +820,32 @@ func (w *Widget) renderOscilloscope(img *image.Gray) {
 		y1 := centerY - int(samples[i]*amplitude)
 		y2 := centerY - int(samples[i+1]*amplitude)
 
-		if y1 < 0 {
-			y1 = 0
+		if y1 < yStart {
+			y1 = yStart
 		}
-		if y1 >= height {
-			y1 = height - 1
+		if y1 >= yEnd {
+			y1 = yEnd - 1
 		}
-		if y2 < 0 {
-			y2 = 0
+		if y2 < yStart {
+			y2 = yStart
 		}
-		if y2 >= height {
-			y2 = height - 1
+		if y2 >= yEnd {
+			y2 = yEnd - 1
 		}
 
 		if w.waveformStyle == AudioWaveformStyleLine {
-			bitmap.DrawLine(img, x1, y1, x2, y2, color.Gray{Y: w.fillColor})
+			bitmap.DrawLine(img, x1, y1, x2, y2, color.Gray{Y: fillColor})
 		} else if w.waveformStyle == AudioWaveformStyleFilled {
 			if y1 < centerY {
-				for y := y1; y <= centerY && y < height; y++ {
+				for y := y1; y <= centerY && y < yEnd; y++ {
 					if x1 >= 0 && x1 < width {
-						img.SetGray(x1, y, color.Gray{Y: w.fillColor})
+						img.SetGray(x1, y, color.Gray{Y: fillColor})
 					}
 				}
 			} else {
-				for y := centerY; y <= y1 && y < height; y++ {
+				for y := centerY; y <= y1 && y < yEnd; y++ {
 					if x1 >= 0 && x1 < width {
-						img.SetGray(x1, y, color.Gray{Y: w.fillColor})
+						img.SetGray(x1, y, color.Gray{Y: fillColor})
 					}
 				}
 			}
@@ -19,6 +19,8 @@ import (
 	"github.com/moutend/go-wca/pkg/wca"
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+	"github.com/pozitronik/steelclock-go/internal/shared"
 	wcautil "github.com/pozitronik/steelclock-go/internal/wca"
 	"github.com/pozitronik/steelclock-go/internal/widget"
 )
@@ -54,25 +56,45 @@ type Widget struct {
 
 	// Display settings
 	displayMode string
+	captureMode string // "loopback" or "microphone"
 
 	// Spectrum settings
 	frequencyScale         string
 	frequencyCompensation  bool
 	spectrumDynamicScaling float64
 	spectrumDynamicWindow  float64
-	smoothing              float64
+	attack                 float64
+	release                float64
 	peakHold               bool
 	peakHoldTime           float64
+	peakDecayStyle         string
+	peakGravity            float64
 	barStyle               string
 	fillColor              uint8
 	barCount               int
+	barBands               []int // Frequency band shown by each bar
+	segmentCount           int   // LED segments per bar (0 = solid bars)
+	segmentGap             int
+	amplitudeScale         string
+	dbFloor                float64
+	fftWindowName          string
+	fftWindow              []float64 // Window coefficients, built on the first FFT
 
 	// Oscilloscope settings
 	sampleCount       int
 	channelMode       string
 	waveformStyle     string
+	trigger           string // "none", "rising", "falling"
+	triggerLevel      float64
 	leftChannelColor  uint8
 	rightChannelColor uint8
+	stereoDivider     int              // Divider color between separated channels (-1=disabled)
+	monoWarned        bool             // Lissajous mode warned about a mono capture source
+	correlation       correlationMeter // Stereo correlation mode state
+
+	// Input level
+	gain               float64 // Manual multiplier applied to captured samples
+	volumeCompensation bool    // Undo system volume applied to loopback audio
 
 	// Audio data buffers
 	audioData      []float32 // Latest audio samples (mixed for spectrum)
@@ -83,6 +105,7 @@ type Widget struct {
 	spectrumData        []float64 // Spectrum magnitudes
 	peakValues          []float64 // Peak hold values
 	peakTimestamps      []time.Time
+	peakVelocities      []float64   // Fall speed of each peak cap (gravity decay)
 	smoothedValues      []float64   // Smoothed spectrum values
 	barEnergyHistory    [][]float64 // Rolling window of bar energies for dynamic scaling
 	barEnergyIndex      int         // Circular buffer index for energy history
@@ -97,6 +120,10 @@ type Widget struct {
 
 	// Device change notification
 	deviceNotifyChan <-chan struct{} // Receives signal on audio device change
+
+	// Silent placeholder while no device is available
+	silentOnNoDevice bool      // Show silence and keep retrying instead of the error state
+	lastDeviceRetry  time.Time // Last attempt to open a capture device
 }
 
 // New creates a new audio visualizer widget
@@ -111,7 +138,8 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 	pos := base.GetPosition()
 
 	// Try to get shared audio capture instance - don't fail if unavailable
-	capture, captureErr := GetSharedAudioCapture()
+	captureMode := captureModeFromConfig(cfg)
+	capture, captureErr := GetSharedAudioCaptureForMode(captureMode)
 	if captureErr != nil {
 		log.Printf("[AUDIO-VIS-WIN] Audio capture error: %v", captureErr)
 	}
@@ -124,17 +152,14 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		log.Printf("[AUDIO-VIS-WIN] Volume reader unavailable (optional): %v", err)
 	}
 
-	// Subscribe to device change notifications
+	// Subscribe to device change notifications for the endpoint direction being captured
 	var deviceNotifyChan <-chan struct{}
 	if notifier, err := wcautil.GetDeviceNotifier(); err == nil {
-		deviceNotifyChan = notifier.Subscribe()
-	}
-
-	// Check if we should enter error state immediately
-	var errorWidget *widget.ErrorWidget
-	if capture == nil || captureErr != nil {
-		errorWidget = widget.NewErrorWidget(pos.W, pos.H, "NO AUDIO")
-		log.Printf("[AUDIO-VIS-WIN] Entering error state: audio capture unavailable")
+		if captureMode == config.AudioCaptureModeMicrophone {
+			deviceNotifyChan = notifier.SubscribeCapture()
+		} else {
+			deviceNotifyChan = notifier.Subscribe()
+		}
 	}
 
 	// Display mode
@@ -143,6 +168,19 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		displayMode = AudioDisplayModeSpectrum
 	}
 
+	// Check if we should enter error state immediately
+	silent := silentOnNoDevice(cfg, displayMode)
+	var errorWidget *widget.ErrorWidget
+	if capture == nil || captureErr != nil {
+		if silent {
+			capture = nil
+			log.Printf("[AUDIO-VIS-WIN] Audio capture unavailable, showing silence until a device appears")
+		} else {
+			errorWidget = widget.NewErrorWidget(pos.W, pos.H, i18n.T(i18n.NoAudio))
+			log.Printf("[AUDIO-VIS-WIN] Entering error state: audio capture unavailable")
+		}
+	}
+
 	// Spectrum settings
 	barCount := 32
 	frequencyScale := AudioFrequencyScaleLogarithmic
@@ -151,6 +189,8 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 	spectrumDynamicWindow := 0.5
 	smoothing := 0.5
 	barStyle := AudioBarStyleBars
+	barLayout := AudioSpectrumLayoutNormal
+	fftWindowName := AudioFFTWindowHann
 
 	if cfg.Spectrum != nil {
 		if cfg.Spectrum.Bars > 0 {
@@ -166,6 +206,12 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		if cfg.Spectrum.Style != "" {
 			barStyle = cfg.Spectrum.Style
 		}
+		if cfg.Spectrum.Layout != "" {
+			barLayout = cfg.Spectrum.Layout
+		}
+		if cfg.Spectrum.Window != "" {
+			fftWindowName = cfg.Spectrum.Window
+		}
 		if cfg.Spectrum.DynamicScaling != nil {
 			if cfg.Spectrum.DynamicScaling.Strength > 0 {
 				spectrumDynamicScaling = cfg.Spectrum.DynamicScaling.Strength
@@ -182,6 +228,18 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		barCount = cfg.Position.W
 	}
 
+	// Mirrored layouts analyze half as many bands and show each one twice
+	barBands := spectrumBarOrder(barCount, barLayout)
+	bandCount := spectrumBandCount(barCount, barLayout)
+
+	segmentCount, segmentGap := 0, 0
+	if cfg.Spectrum != nil {
+		segmentCount, segmentGap = segmentSettings(cfg.Spectrum.SegmentCount, cfg.Spectrum.SegmentGap, cfg.Position.H)
+	}
+
+	amplitudeScale, dbFloor := amplitudeSettings(cfg.Spectrum)
+	attack, release := smoothingSettings(cfg.Spectrum, smoothing)
+
 	// Peak settings (spectrum mode only)
 	peakHold := true
 	peakHoldTime := 1.0
@@ -192,11 +250,14 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 			peakHoldTime = cfg.Spectrum.Peak.HoldTime
 		}
 	}
+	peakDecayStyle, peakGravity := peakDecaySettings(cfg.Spectrum)
 
 	// Oscilloscope settings
 	sampleCount := 256
 	channelMode := AudioChannelModeMono
 	waveformStyle := AudioWaveformStyleLine
+	trigger := AudioTriggerNone
+	triggerLevel := 0.0
 
 	if cfg.Oscilloscope != nil {
 		if cfg.Oscilloscope.Samples > 0 {
@@ -205,12 +266,22 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		if cfg.Oscilloscope.Style != "" {
 			waveformStyle = cfg.Oscilloscope.Style
 		}
+		if cfg.Oscilloscope.Trigger != "" {
+			trigger = cfg.Oscilloscope.Trigger
+		}
+		triggerLevel = cfg.Oscilloscope.TriggerLevel
 	}
 
 	// Channel mode from top-level config
 	if cfg.Channel != "" {
 		channelMode = cfg.Channel
 	}
+	stereoDivider := shared.NewConfigHelper(cfg).GetStereoDivider()
+	gain, volumeCompensation := gainSettings(cfg, displayMode)
+	if captureMode == config.AudioCaptureModeMicrophone {
+		// Output volume does not affect what the microphone records
+		volumeCompensation = false
+	}
 
 	// Error threshold from config (default: 30 = ~3 seconds at 33ms update interval)
 	errorThreshold := 30
@@ -236,7 +307,7 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 				rightChannelColor = *cfg.Spectrum.Colors.Right
 			}
 		}
-	case AudioDisplayModeOscilloscope:
+	case AudioDisplayModeOscilloscope, AudioDisplayModeLissajous:
 		if cfg.Oscilloscope != nil && cfg.Oscilloscope.Colors != nil {
 			if cfg.Oscilloscope.Colors.Fill != nil {
 				fillColor = *cfg.Oscilloscope.Colors.Fill
@@ -257,7 +328,7 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 	}
 
 	// Initialize energy history (2D array: [barCount][windowSize])
-	energyHistory := make([][]float64, barCount)
+	energyHistory := make([][]float64, bandCount)
 	for i := range energyHistory {
 		energyHistory[i] = make([]float64, windowSize)
 	}
@@ -267,25 +338,42 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		audioCapture:           capture,
 		volumeReader:           volumeReader,
 		displayMode:            displayMode,
+		captureMode:            captureMode,
 		frequencyScale:         frequencyScale,
 		frequencyCompensation:  frequencyCompensation,
 		spectrumDynamicScaling: spectrumDynamicScaling,
 		spectrumDynamicWindow:  spectrumDynamicWindow,
-		smoothing:              smoothing,
+		attack:                 attack,
+		release:                release,
 		peakHold:               peakHold,
 		peakHoldTime:           peakHoldTime,
+		peakDecayStyle:         peakDecayStyle,
+		peakGravity:            peakGravity,
 		barStyle:               barStyle,
 		fillColor:              uint8(fillColor),
 		barCount:               barCount,
+		barBands:               barBands,
+		correlation:            newCorrelationMeter(cfg.Correlation),
+		fftWindowName:          fftWindowName,
+		segmentCount:           segmentCount,
+		segmentGap:             segmentGap,
+		amplitudeScale:         amplitudeScale,
+		dbFloor:                dbFloor,
 		sampleCount:            sampleCount,
 		channelMode:            channelMode,
 		waveformStyle:          waveformStyle,
+		trigger:                trigger,
+		triggerLevel:           triggerLevel,
 		leftChannelColor:       uint8(leftChannelColor),
 		rightChannelColor:      uint8(rightChannelColor),
-		spectrumData:           make([]float64, barCount),
-		peakValues:             make([]float64, barCount),
-		peakTimestamps:         make([]time.Time, barCount),
-		smoothedValues:         make([]float64, barCount),
+		stereoDivider:          stereoDivider,
+		gain:                   gain,
+		volumeCompensation:     volumeCompensation,
+		spectrumData:           make([]float64, bandCount),
+		peakValues:             make([]float64, bandCount),
+		peakTimestamps:         make([]time.Time, bandCount),
+		peakVelocities:         make([]float64, bandCount),
+		smoothedValues:         make([]float64, bandCount),
 		barEnergyHistory:       energyHistory,
 		barEnergyIndex:         0,
 		barEnergyWindowSize:    windowSize,
@@ -294,6 +382,8 @@ func New(cfg config.WidgetConfig) (widget.Widget, error) {
 		errorWidget:            errorWidget,
 		startupTime:            time.Now(),
 		deviceNotifyChan:       deviceNotifyChan,
+		silentOnNoDevice:       silent,
+		lastDeviceRetry:        time.Now(),
 	}
 
 	return w, nil
@@ -310,9 +400,11 @@ func (w *Widget) Update() error {
 		case <-w.deviceNotifyChan:
 			// Device changed - reinitialize audio capture
 			log.Printf("[AUDIO-VIS] Device change detected, reinitializing...")
-			w.audioCapture.cleanup()
-			w.audioCapture.initialized = false
-			newCapture, err := GetSharedAudioCapture()
+			if w.audioCapture != nil {
+				w.audioCapture.cleanup()
+				w.audioCapture.initialized = false
+			}
+			newCapture, err := GetSharedAudioCaptureForMode(w.captureMode)
 			if err != nil {
 				log.Printf("[AUDIO-VIS] Failed to reinitialize after device change: %v", err)
 				// Don't enter error state immediately - will retry on next update
@@ -334,8 +426,17 @@ func (w *Widget) Update() error {
 		return w.errorWidget.Update()
 	}
 
+	// Without a device (silent mode only), keep looking for one and show silence meanwhile
+	if w.audioCapture == nil {
+		w.retryCapture()
+	}
+
 	// Capture audio samples (both channels)
-	leftSamples, rightSamples, err := w.audioCapture.ReadSamples()
+	var leftSamples, rightSamples []float32
+	var err error
+	if w.audioCapture != nil {
+		leftSamples, rightSamples, err = w.audioCapture.ReadSamples()
+	}
 	if err != nil {
 		// Grace period: ignore errors during first 3 seconds after startup
 		// Audio buffer needs time to warm up
@@ -344,7 +445,13 @@ func (w *Widget) Update() error {
 		}
 
 		w.errorCount++
-		if w.errorCount >= w.errorThreshold {
+		if w.errorCount >= w.errorThreshold && w.silentOnNoDevice {
+			// Drop the failed capture and look for a device again
+			w.audioCapture.initialized = false
+			w.audioCapture = nil
+			w.errorCount = 0
+			log.Printf("[AUDIO-VIS] Audio capture failed, showing silence until a device appears: %v", err)
+		} else if w.errorCount >= w.errorThreshold {
 			// Enter error state - create error widget proxy
 			pos := w.GetPosition()
 			w.errorWidget = widget.NewErrorWidget(pos.W, pos.H, "AUDIO ERROR")
@@ -360,43 +467,21 @@ func (w *Widget) Update() error {
 
 	// If no samples, create silent buffers to allow peaks to decay
 	if len(leftSamples) == 0 {
-		leftSamples = make([]float32, 1024)  // Silent buffer (all zeros)
-		rightSamples = make([]float32, 1024) // Silent buffer (all zeros)
+		leftSamples = make([]float32, silenceSamples)  // Silent buffer (all zeros)
+		rightSamples = make([]float32, silenceSamples) // Silent buffer (all zeros)
 	}
 
-	// Apply volume compensation to both channels
+	// Apply manual gain and volume compensation to both channels.
 	// WASAPI loopback captures audio AFTER system volume is applied,
-	// so we need to compensate to show visualization independent of volume level
-	volumePercent, _, err := w.volumeReader.GetVolume()
-	if err == nil && volumePercent > 1.0 { // Avoid division by very small numbers (1% minimum)
-		// Multiply by (100.0 / volumePercent) to restore original signal
-		// Example: at 30% volume, multiply by 100/30 = 3.33x
-		gainFactor := float32(100.0 / volumePercent)
-
-		// Apply gain to left channel
-		for i := range leftSamples {
-			leftSamples[i] *= gainFactor
-			// Clamp to -1.0 to +1.0 range
-			if leftSamples[i] > 1.0 {
-				leftSamples[i] = 1.0
-			}
-			if leftSamples[i] < -1.0 {
-				leftSamples[i] = -1.0
-			}
-		}
-
-		// Apply gain to right channel
-		for i := range rightSamples {
-			rightSamples[i] *= gainFactor
-			// Clamp to -1.0 to +1.0 range
-			if rightSamples[i] > 1.0 {
-				rightSamples[i] = 1.0
-			}
-			if rightSamples[i] < -1.0 {
-				rightSamples[i] = -1.0
-			}
+	// so compensation shows visualization independent of volume level
+	gain := w.gain
+	if w.volumeCompensation && w.volumeReader != nil {
+		if volumePercent, _, err := w.volumeReader.GetVolume(); err == nil {
+			gain *= volumeGain(volumePercent)
 		}
 	}
+	applyGain(leftSamples, gain)
+	applyGain(rightSamples, gain)
 
 	// Store left and right channels separately for oscilloscope mode
 	maxSamples := 8192
@@ -423,6 +508,8 @@ func (w *Widget) Update() error {
 	// Use accumulated audioData buffer, not just current samples
 	if w.displayMode == AudioDisplayModeSpectrum {
 		w.updateSpectrum(w.audioData)
+	} else if w.displayMode == AudioDisplayModeCorrelation {
+		w.correlation.update(w.audioDataLeft, w.audioDataRight, time.Now())
 	}
 
 	w.lastUpdateTime = time.Now()
@@ -449,12 +536,14 @@ func (w *Widget) updateSpectrum(samples []float32) {
 	mean /= float32(len(fftSamples))
 
 	// Convert to complex and apply window
+	if len(w.fftWindow) != fftSize {
+		w.fftWindow = fftWindow(w.fftWindowName, fftSize)
+	}
 	input := make([]complex128, fftSize)
 	for i := 0; i < fftSize; i++ {
-		// Remove DC offset and apply Hann window
+		// Remove DC offset and apply the configured window
 		sample := float64(fftSamples[i] - mean)
-		window := 0.5 * (1 - math.Cos(2*math.Pi*float64(i)/float64(fftSize-1)))
-		input[i] = complex(sample*window, 0)
+		input[i] = complex(sample*w.fftWindow[i], 0)
 	}
 
 	// Perform FFT
@@ -499,6 +588,9 @@ func (w *Widget) updateSpectrum(samples []float32) {
 		w.mapFrequenciesLinear(magnitudes, barCount)
 	}
 
+	// Convert bar heights to decibels if configured
+	applyAmplitudeScale(w.spectrumData, w.amplitudeScale, w.dbFloor)
+
 	// Store current spectrum data in energy history for dynamic scaling
 	for i := 0; i < barCount; i++ {
 		w.barEnergyHistory[i][w.barEnergyIndex] = w.spectrumData[i]
@@ -522,11 +614,9 @@ func (w *Widget) updateSpectrum(samples []float32) {
 
 	// Apply smoothing
 	dt := time.Since(w.lastUpdateTime).Seconds()
-	if dt > 0 && w.smoothing > 0 {
+	if dt > 0 && (w.attack > 0 || w.release > 0) {
 		for i := range w.smoothedValues {
-			// Exponential moving average with time-based decay
-			alpha := 1.0 - math.Pow(w.smoothing, dt*30) // Adjust for frame rate
-			w.smoothedValues[i] = alpha*w.spectrumData[i] + (1-alpha)*w.smoothedValues[i]
+			w.smoothedValues[i] = smoothValue(w.smoothedValues[i], w.spectrumData[i], w.attack, w.release, dt)
 		}
 	} else {
 		copy(w.smoothedValues, w.spectrumData)
@@ -539,24 +629,13 @@ func (w *Widget) updateSpectrum(samples []float32) {
 			if w.smoothedValues[i] > w.peakValues[i] {
 				w.peakValues[i] = w.smoothedValues[i]
 				w.peakTimestamps[i] = now
+				w.peakVelocities[i] = 0
 			} else {
 				// Decay peak if hold time expired
 				elapsed := now.Sub(w.peakTimestamps[i]).Seconds()
 				if elapsed > w.peakHoldTime {
-					// Faster decay - drop at ~30% per second
-					decayRate := 0.3 // 30% decay per second
 					dt := time.Since(w.lastUpdateTime).Seconds()
-					w.peakValues[i] *= 1.0 - decayRate*dt
-
-					// Clamp to smoothed value (peak should never go below current value)
-					if w.peakValues[i] < w.smoothedValues[i] {
-						w.peakValues[i] = w.smoothedValues[i]
-					}
-
-					// Clear if very small
-					if w.peakValues[i] < 0.01 {
-						w.peakValues[i] = 0
-					}
+					w.peakValues[i], w.peakVelocities[i] = decayPeak(w.peakValues[i], w.smoothedValues[i], w.peakVelocities[i], dt, w.peakDecayStyle, w.peakGravity)
 				}
 			}
 		}
@@ -751,6 +830,11 @@ func (w *Widget) calculateDynamicGain(barCount int) []float64 {
 	return gains
 }
 
+// NeedsRender always returns true: audio is captured continuously, so the bars move between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the visualization
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.Lock()
@@ -768,6 +852,10 @@ func (w *Widget) Render() (image.Image, error) {
 		w.renderSpectrum(img)
 	} else if w.displayMode == AudioDisplayModeOscilloscope {
 		w.renderOscilloscope(img)
+	} else if w.displayMode == AudioDisplayModeLissajous {
+		w.renderLissajous(img)
+	} else if w.displayMode == AudioDisplayModeCorrelation {
+		w.correlation.render(img, time.Now())
 	}
 
 	w.ApplyBorder(img)
@@ -777,7 +865,7 @@ func (w *Widget) Render() (image.Image, error) {
 
 // renderSpectrum draws spectrum analyzer bars
 func (w *Widget) renderSpectrum(img *image.Gray) {
-	barCount := len(w.spectrumData)
+	barCount := len(w.barBands)
 	if barCount == 0 {
 		return
 	}
@@ -793,7 +881,8 @@ func (w *Widget) renderSpectrum(img *image.Gray) {
 
 	for i := 0; i < barCount; i++ {
 		// Magnitude is already normalized to 0.0-1.0 range (dB scale)
-		magnitude := w.smoothedValues[i]
+		band := w.barBands[i]
+		magnitude := w.smoothedValues[band]
 		if magnitude > 1.0 {
 			magnitude = 1.0
 		}
@@ -815,7 +904,9 @@ func (w *Widget) renderSpectrum(img *image.Gray) {
 			if x+barW > width {
 				barW = width - x
 			}
-			if barW > 0 && barHeight > 0 {
+			if w.segmentCount > 0 {
+				drawSegmentedBar(img, x, barW, height, magnitude, w.segmentCount, w.segmentGap, w.fillColor)
+			} else if barW > 0 && barHeight > 0 {
 				bitmap.DrawFilledRectangle(img, x, y, barW, barHeight, w.fillColor)
 			}
 		} else {
@@ -830,8 +921,8 @@ func (w *Widget) renderSpectrum(img *image.Gray) {
 		}
 
 		// Draw peak hold
-		if w.peakHold && i < len(w.peakValues) {
-			peakMagnitude := w.peakValues[i]
+		if w.peakHold && band < len(w.peakValues) {
+			peakMagnitude := w.peakValues[band]
 			if peakMagnitude > 1.0 {
 				peakMagnitude = 1.0
 			}
@@ -861,11 +952,8 @@ func (w *Widget) renderOscilloscope(img *image.Gray) {
 		if len(w.audioData) == 0 {
 			return
 		}
-		if sampleCount > len(w.audioData) {
-			sampleCount = len(w.audioData)
-		}
 		centerY := height / 2
-		samples := w.audioData[len(w.audioData)-sampleCount:]
+		samples := oscilloscopeWindow(w.audioData, sampleCount, w.trigger, w.triggerLevel)
 		w.drawWaveform(img, samples, 0, height, centerY, w.fillColor)
 	} else if w.channelMode == AudioChannelModeStereoSeparated {
 		// Use separate left and right channels for stereo_separated mode
@@ -873,27 +961,59 @@ func (w *Widget) renderOscilloscope(img *image.Gray) {
 			return
 		}
 
-		// Get sample count based on available data
-		leftSampleCount := sampleCount
-		rightSampleCount := sampleCount
-		if leftSampleCount > len(w.audioDataLeft) {
-			leftSampleCount = len(w.audioDataLeft)
-		}
-		if rightSampleCount > len(w.audioDataRight) {
-			rightSampleCount = len(w.audioDataRight)
-		}
-
-		leftSamples := w.audioDataLeft[len(w.audioDataLeft)-leftSampleCount:]
-		rightSamples := w.audioDataRight[len(w.audioDataRight)-rightSampleCount:]
+		// Each channel is triggered on its own
+		leftSamples := oscilloscopeWindow(w.audioDataLeft, sampleCount, w.trigger, w.triggerLevel)
+		rightSamples := oscilloscopeWindow(w.audioDataRight, sampleCount, w.trigger, w.triggerLevel)
 
 		// Top half - actual left channel
 		w.drawWaveform(img, leftSamples, 0, height/2, height/4, w.leftChannelColor)
 
 		// Bottom half - actual right channel
 		w.drawWaveform(img, rightSamples, height/2, height, height*3/4, w.rightChannelColor)
+
+		drawStereoDivider(img, pos.W, height, w.stereoDivider)
 	}
 }
 
+// retryCapture tries to open a capture device, at most once per deviceRetryInterval
+func (w *Widget) retryCapture() {
+	if time.Since(w.lastDeviceRetry) < deviceRetryInterval {
+		return
+	}
+	w.lastDeviceRetry = time.Now()
+
+	capture, err := GetSharedAudioCaptureForMode(w.captureMode)
+	if err != nil {
+		return
+	}
+	w.audioCapture = capture
+	w.startupTime = time.Now() // Give the new device the startup grace period
+	log.Printf("[AUDIO-VIS] Audio device found, capture resumed")
+}
+
+// renderLissajous plots the left channel against the right one (X/Y mode)
+func (w *Widget) renderLissajous(img *image.Gray) {
+	left := oscilloscopeWindow(w.audioDataLeft, w.sampleCount, AudioTriggerNone, 0)
+	right := oscilloscopeWindow(w.audioDataRight, w.sampleCount, AudioTriggerNone, 0)
+
+	// A mono source has no X/Y information; show it as a diagonal line
+	channels := 2
+	if w.audioCapture != nil {
+		w.audioCapture.mu.Lock()
+		channels = w.audioCapture.channels
+		w.audioCapture.mu.Unlock()
+	}
+	if channels < 2 {
+		if !w.monoWarned {
+			log.Printf("[AUDIO-VIS] Lissajous mode needs a stereo source, capture has %d channel(s); drawing a diagonal line", channels)
+			w.monoWarned = true
+		}
+		right = nil
+	}
+
+	drawLissajous(img, left, right, w.waveformStyle, w.fillColor)
+}
+
 // drawWaveform draws a single waveform in the specified region
 func (w *Widget) drawWaveform(img *image.Gray, samples []float32, yStart, yEnd, centerY int, fillColor uint8) {
 	pos := w.GetPosition()
@@ -948,39 +1068,44 @@ func (w *Widget) drawWaveform(img *image.Gray, samples []float32, yStart, yEnd,
 	}
 }
 
-// Shared audio capture instance (recreatable singleton)
+// Shared audio capture instances, one per capture mode (recreatable singletons)
 var (
-	sharedAudioCapture    *AudioCaptureWCA
-	sharedAudioCaptureMu  sync.Mutex
-	sharedAudioCaptureErr error
+	sharedAudioCaptures  = make(map[string]*AudioCaptureWCA)
+	sharedAudioCaptureMu sync.Mutex
 )
 
-// GetSharedAudioCapture returns the shared AudioCaptureWCA instance
+// GetSharedAudioCapture returns the shared loopback AudioCaptureWCA instance
 // This can recreate the instance if it was previously invalidated
 func GetSharedAudioCapture() (*AudioCaptureWCA, error) {
+	return GetSharedAudioCaptureForMode(config.AudioCaptureModeLoopback)
+}
+
+// GetSharedAudioCaptureForMode returns the shared AudioCaptureWCA instance for a capture mode
+// ("loopback" or "microphone"), recreating it if it was previously invalidated
+func GetSharedAudioCaptureForMode(mode string) (*AudioCaptureWCA, error) {
 	sharedAudioCaptureMu.Lock()
 	defer sharedAudioCaptureMu.Unlock()
 
 	// Return existing instance if valid
-	if sharedAudioCapture != nil && sharedAudioCapture.initialized {
-		return sharedAudioCapture, nil
+	if ac := sharedAudioCaptures[mode]; ac != nil && ac.initialized {
+		return ac, nil
 	}
 
 	// Create new instance
-	ac := &AudioCaptureWCA{}
+	ac := &AudioCaptureWCA{mode: mode}
 	if err := ac.initialize(); err != nil {
-		sharedAudioCaptureErr = fmt.Errorf("failed to initialize: %w", err)
-		return nil, sharedAudioCaptureErr
+		return nil, fmt.Errorf("failed to initialize: %w", err)
 	}
 
-	sharedAudioCapture = ac
-	sharedAudioCaptureErr = nil
-	return sharedAudioCapture, nil
+	sharedAudioCaptures[mode] = ac
+	return ac, nil
 }
 
-// AudioCaptureWCA captures audio using Windows Core Audio API in loopback mode
+// AudioCaptureWCA captures audio using Windows Core Audio API,
+// either in loopback mode (default output device) or from the default input device
 type AudioCaptureWCA struct {
 	mu            sync.Mutex
+	mode          string
 	initialized   bool
 	audioClient   *wca.IAudioClient
 	captureClient *wca.IAudioCaptureClient
@@ -988,9 +1113,10 @@ type AudioCaptureWCA struct {
 	mmde          *wca.IMMDeviceEnumerator
 	bufferSize    uint32
 	sampleRate    uint32
+	channels      int
 }
 
-// initialize sets up WASAPI loopback capture
+// initialize sets up WASAPI capture for the configured mode
 func (ac *AudioCaptureWCA) initialize() error {
 	ac.mu.Lock()
 	defer ac.mu.Unlock()
@@ -1010,8 +1136,13 @@ func (ac *AudioCaptureWCA) initialize() error {
 	}
 	ac.mmde = mmde
 
-	// Get default audio endpoint (render for loopback)
-	mmd, err := wcautil.GetDefaultRenderDevice(mmde)
+	// Get default audio endpoint (render for loopback, capture for microphone)
+	var mmd *wca.IMMDevice
+	if ac.mode == config.AudioCaptureModeMicrophone {
+		mmd, err = wcautil.GetDefaultCaptureDevice(mmde)
+	} else {
+		mmd, err = wcautil.GetDefaultRenderDevice(mmde)
+	}
 	if err != nil {
 		ac.cleanup()
 		return err
@@ -1033,16 +1164,22 @@ func (ac *AudioCaptureWCA) initialize() error {
 		return fmt.Errorf("GetMixFormat failed: %w", err)
 	}
 	ac.sampleRate = wfx.NSamplesPerSec
+	ac.channels = int(wfx.NChannels)
 
-	// Initialize audio client in loopback mode
+	// Initialize audio client; loopback flag only applies to render endpoints
 	// AudclntStreamflagsLoopback = 0x00020000
 	const AudclntStreamflagsLoopback = 0x00020000
 	const refTimesPerSec = 10000000                           // 100ns units
 	bufferDuration := wca.REFERENCE_TIME(refTimesPerSec / 50) // 20ms buffer
 
+	var streamFlags uint32
+	if ac.mode != config.AudioCaptureModeMicrophone {
+		streamFlags = AudclntStreamflagsLoopback
+	}
+
 	if err := audioClientInterface.Initialize(
 		wca.AUDCLNT_SHAREMODE_SHARED,
-		AudclntStreamflagsLoopback,
+		streamFlags,
 		bufferDuration,
 		0,
 		wfx,
@@ -1126,13 +1263,10 @@ func (ac *AudioCaptureWCA) ReadSamples() ([]float32, []float32, error) {
 			break
 		}
 
-		// Convert samples to float32 (assuming format is float32 stereo interleaved: L-R-L-R)
-		// Extract both left and right channels
-		dataSlice := (*[1 << 30]float32)(unsafe.Pointer(pData))[:numFramesToRead*2]
-		for i := uint32(0); i < numFramesToRead; i++ {
-			leftSamples = append(leftSamples, dataSlice[i*2])     // Left channel
-			rightSamples = append(rightSamples, dataSlice[i*2+1]) // Right channel
-		}
+		// Convert samples to float32 (shared-mode mix format is float32 interleaved: L-R-L-R)
+		// Microphones are often mono; splitChannels feeds a single channel to both sides
+		dataSlice := (*[1 << 30]float32)(unsafe.Pointer(pData))[:numFramesToRead*uint32(ac.channels)]
+		leftSamples, rightSamples = splitChannels(dataSlice, ac.channels, leftSamples, rightSamples)
 
 		_ = ac.captureClient.ReleaseBuffer(numFramesToRead)
 	}
@@ -0,0 +1,42 @@
+package audiovisualizer
+
+import (
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// captureModeFromConfig returns the configured capture source, defaulting to loopback
+func captureModeFromConfig(cfg config.WidgetConfig) string {
+	if cfg.CaptureMode == config.AudioCaptureModeMicrophone {
+		return config.AudioCaptureModeMicrophone
+	}
+	return config.AudioCaptureModeLoopback
+}
+
+// silentOnNoDevice reports whether the active display mode should show silence
+// instead of the error state while no audio device is available
+func silentOnNoDevice(cfg config.WidgetConfig, displayMode string) bool {
+	switch {
+	case displayMode == AudioDisplayModeSpectrum && cfg.Spectrum != nil:
+		return cfg.Spectrum.SilentOnNoDevice
+	case (displayMode == AudioDisplayModeOscilloscope || displayMode == AudioDisplayModeLissajous) && cfg.Oscilloscope != nil:
+		return cfg.Oscilloscope.SilentOnNoDevice
+	}
+	return false
+}
+
+// splitChannels appends interleaved frames to the left and right sample slices.
+// Mono input (typical for microphones) feeds both channels; channels past the second are ignored.
+func splitChannels(data []float32, channels int, left, right []float32) ([]float32, []float32) {
+	if channels <= 0 {
+		return left, right
+	}
+	for i := 0; i+channels <= len(data); i += channels {
+		left = append(left, data[i])
+		if channels == 1 {
+			right = append(right, data[i])
+		} else {
+			right = append(right, data[i+1])
+		}
+	}
+	return left, right
+}
@@ -0,0 +1,83 @@
+package audiovisualizer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestCaptureModeFromConfig(t *testing.T) {
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{"", config.AudioCaptureModeLoopback},
+		{config.AudioCaptureModeLoopback, config.AudioCaptureModeLoopback},
+		{config.AudioCaptureModeMicrophone, config.AudioCaptureModeMicrophone},
+		{"unknown", config.AudioCaptureModeLoopback},
+	}
+
+	for _, tt := range tests {
+		if got := captureModeFromConfig(config.WidgetConfig{CaptureMode: tt.mode}); got != tt.want {
+			t.Errorf("captureModeFromConfig(%q) = %q, want %q", tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestSilentOnNoDevice(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Spectrum:     &config.SpectrumConfig{SilentOnNoDevice: true},
+		Oscilloscope: &config.OscilloscopeConfig{},
+	}
+
+	tests := []struct {
+		mode string
+		want bool
+	}{
+		{AudioDisplayModeSpectrum, true},
+		{AudioDisplayModeOscilloscope, false},
+		{AudioDisplayModeLissajous, false},
+	}
+
+	for _, tt := range tests {
+		if got := silentOnNoDevice(cfg, tt.mode); got != tt.want {
+			t.Errorf("silentOnNoDevice(%q) = %v, want %v", tt.mode, got, tt.want)
+		}
+	}
+
+	// Lissajous reads the oscilloscope block
+	cfg.Oscilloscope.SilentOnNoDevice = true
+	if !silentOnNoDevice(cfg, AudioDisplayModeLissajous) {
+		t.Error("silentOnNoDevice(lissajous) = false, want true")
+	}
+
+	if silentOnNoDevice(config.WidgetConfig{}, AudioDisplayModeSpectrum) {
+		t.Error("silentOnNoDevice() without config = true, want false")
+	}
+}
+
+func TestSplitChannels(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []float32
+		channels  int
+		wantLeft  []float32
+		wantRight []float32
+	}{
+		{"mono", []float32{0.1, 0.2, 0.3}, 1, []float32{0.1, 0.2, 0.3}, []float32{0.1, 0.2, 0.3}},
+		{"stereo", []float32{0.1, -0.1, 0.2, -0.2}, 2, []float32{0.1, 0.2}, []float32{-0.1, -0.2}},
+		{"quad uses first two", []float32{1, 2, 3, 4, 5, 6, 7, 8}, 4, []float32{1, 5}, []float32{2, 6}},
+		{"partial frame dropped", []float32{0.1, -0.1, 0.2}, 2, []float32{0.1}, []float32{-0.1}},
+		{"zero channels", []float32{0.1}, 0, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := splitChannels(tt.data, tt.channels, nil, nil)
+			if !slices.Equal(left, tt.wantLeft) || !slices.Equal(right, tt.wantRight) {
+				t.Errorf("splitChannels() = %v, %v, want %v, %v", left, right, tt.wantLeft, tt.wantRight)
+			}
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package audiovisualizer
 
+import "time"
+
 // Audio visualizer display mode constants
 const (
 	AudioDisplayModeSpectrum     = "spectrum"
 	AudioDisplayModeOscilloscope = "oscilloscope"
+	AudioDisplayModeLissajous    = "lissajous"
+	AudioDisplayModeCorrelation  = "correlation"
 )
 
 // Audio visualizer frequency scale constants
@@ -34,3 +38,51 @@ const (
 	AudioChannelModeStereoSeparated = "stereo_separated"
 	AudioChannelModeStereoCombined  = "stereo_combined"
 )
+
+// Audio visualizer amplitude scale constants (for spectrum mode)
+const (
+	AudioAmplitudeScaleLinear = "linear"
+	AudioAmplitudeScaleDB     = "db"
+)
+
+// DefaultDBFloor is the lowest level shown with the dB amplitude scale
+const DefaultDBFloor = -60.0
+
+// Audio visualizer bar layout constants (for spectrum mode)
+const (
+	AudioSpectrumLayoutNormal       = "normal"
+	AudioSpectrumLayoutMirrorCenter = "mirror_center"
+	AudioSpectrumLayoutMirrorEdges  = "mirror_edges"
+)
+
+// Audio visualizer FFT window function constants (for spectrum mode)
+const (
+	AudioFFTWindowNone     = "none"
+	AudioFFTWindowHann     = "hann"
+	AudioFFTWindowHamming  = "hamming"
+	AudioFFTWindowBlackman = "blackman"
+)
+
+// Audio visualizer peak decay style constants (for spectrum peak hold)
+const (
+	AudioPeakDecayExponential = "exponential"
+	AudioPeakDecayInstant     = "instant"
+	AudioPeakDecayLinear      = "linear"
+	AudioPeakDecayGravity     = "gravity"
+)
+
+// DefaultPeakGravity is the acceleration of falling peak caps, in bar heights per second squared
+const DefaultPeakGravity = 2.0
+
+// Audio visualizer trigger constants (for oscilloscope mode)
+const (
+	AudioTriggerNone    = "none"
+	AudioTriggerRising  = "rising"
+	AudioTriggerFalling = "falling"
+)
+
+// deviceRetryInterval is how often a widget without an audio device looks for one again
+const deviceRetryInterval = 5 * time.Second
+
+// silenceSamples is the length of the silent buffer shown while no device is available
+const silenceSamples = 1024
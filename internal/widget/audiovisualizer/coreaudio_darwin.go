@@ -0,0 +1,342 @@
+//go:build darwin
+
+package audiovisualizer
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+	"github.com/ebitengine/purego/objc"
+)
+
+// Framework paths, loaded at runtime so the build needs no cgo
+const (
+	coreAudioPath    = "/System/Library/Frameworks/CoreAudio.framework/CoreAudio"
+	avFoundationPath = "/System/Library/Frameworks/AVFoundation.framework/AVFoundation"
+	tccPath          = "/System/Library/PrivateFrameworks/TCC.framework/Versions/A/TCC"
+)
+
+// CoreAudio property selectors and scopes (four-char codes from AudioHardware.h)
+const (
+	kAudioObjectSystemObject                  = 1
+	kAudioObjectPropertyScopeGlobal           = 'g'<<24 | 'l'<<16 | 'o'<<8 | 'b'
+	kAudioObjectPropertyElementMain           = 0
+	kAudioHardwarePropertyDefaultInputDevice  = 'd'<<24 | 'I'<<16 | 'n'<<8 | ' '
+	kAudioHardwarePropertyDefaultOutputDevice = 'd'<<24 | 'O'<<16 | 'u'<<8 | 't'
+	kAudioDevicePropertyDeviceUID             = 'u'<<24 | 'i'<<16 | 'd'<<8 | ' '
+	kAudioDevicePropertyNominalSampleRate     = 'n'<<24 | 's'<<16 | 'r'<<8 | 't'
+)
+
+// AVAuthorizationStatus values of AVCaptureDevice
+const (
+	avAuthorizationStatusRestricted = 1
+	avAuthorizationStatusDenied     = 2
+)
+
+// tccPreflightDenied is the TCCAccessPreflight result for a service the user refused
+const tccPreflightDenied = 1
+
+// audioObjectPropertyAddress mirrors AudioObjectPropertyAddress
+type audioObjectPropertyAddress struct {
+	selector uint32
+	scope    uint32
+	element  uint32
+}
+
+// audioBuffer mirrors AudioBuffer; an AudioBufferList is a uint32 count followed,
+// at pointer alignment, by that many buffers
+type audioBuffer struct {
+	channels     uint32
+	dataByteSize uint32
+	data         unsafe.Pointer
+}
+
+// audioBufferListBuffers is the offset of the buffers in an AudioBufferList
+const audioBufferListBuffers = unsafe.Sizeof(uintptr(0))
+
+// coreAudio holds the CoreAudio functions, resolved once on first use
+var coreAudio struct {
+	once sync.Once
+	err  error
+
+	getPropertyData        func(object uint32, address *audioObjectPropertyAddress, qualifierSize uint32, qualifier unsafe.Pointer, dataSize *uint32, data unsafe.Pointer) int32
+	createIOProcID         func(device uint32, proc uintptr, clientData uintptr, procID *uintptr) int32
+	destroyIOProcID        func(device uint32, procID uintptr) int32
+	deviceStart            func(device uint32, procID uintptr) int32
+	deviceStop             func(device uint32, procID uintptr) int32
+	createAggregateDevice  func(description objc.ID, device *uint32) int32
+	destroyAggregateDevice func(device uint32) int32
+
+	// Process taps need macOS 14.2; both are nil on older systems
+	createProcessTap  func(description objc.ID, tap *uint32) int32
+	destroyProcessTap func(tap uint32) int32
+
+	// tccPreflight is the private TCC permission check, nil when unavailable
+	tccPreflight func(service objc.ID, options uintptr) int32
+
+	// ioProc is the C entry point shared by all captures; the client data picks the capture
+	ioProc uintptr
+}
+
+// loadCoreAudio resolves the CoreAudio functions
+func loadCoreAudio() error {
+	coreAudio.once.Do(func() {
+		lib, err := purego.Dlopen(coreAudioPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		if err != nil {
+			coreAudio.err = fmt.Errorf("load CoreAudio: %w", err)
+			return
+		}
+		purego.RegisterLibFunc(&coreAudio.getPropertyData, lib, "AudioObjectGetPropertyData")
+		purego.RegisterLibFunc(&coreAudio.createIOProcID, lib, "AudioDeviceCreateIOProcID")
+		purego.RegisterLibFunc(&coreAudio.destroyIOProcID, lib, "AudioDeviceDestroyIOProcID")
+		purego.RegisterLibFunc(&coreAudio.deviceStart, lib, "AudioDeviceStart")
+		purego.RegisterLibFunc(&coreAudio.deviceStop, lib, "AudioDeviceStop")
+		purego.RegisterLibFunc(&coreAudio.createAggregateDevice, lib, "AudioHardwareCreateAggregateDevice")
+		purego.RegisterLibFunc(&coreAudio.destroyAggregateDevice, lib, "AudioHardwareDestroyAggregateDevice")
+		if _, err := purego.Dlsym(lib, "AudioHardwareCreateProcessTap"); err == nil {
+			purego.RegisterLibFunc(&coreAudio.createProcessTap, lib, "AudioHardwareCreateProcessTap")
+			purego.RegisterLibFunc(&coreAudio.destroyProcessTap, lib, "AudioHardwareDestroyProcessTap")
+		}
+
+		// AVFoundation provides AVCaptureDevice for the microphone permission check
+		if _, err := purego.Dlopen(avFoundationPath, purego.RTLD_NOW|purego.RTLD_GLOBAL); err != nil {
+			coreAudio.err = fmt.Errorf("load AVFoundation: %w", err)
+			return
+		}
+
+		// macOS has no public check for the system audio recording permission;
+		// without the private one a refused tap just records silence
+		if tcc, err := purego.Dlopen(tccPath, purego.RTLD_NOW|purego.RTLD_LOCAL); err == nil {
+			if _, err := purego.Dlsym(tcc, "TCCAccessPreflight"); err == nil {
+				purego.RegisterLibFunc(&coreAudio.tccPreflight, tcc, "TCCAccessPreflight")
+			}
+		}
+
+		coreAudio.ioProc = purego.NewCallback(audioIOProc)
+	})
+	return coreAudio.err
+}
+
+// osStatusError formats a failed CoreAudio call
+func osStatusError(call string, status int32) error {
+	return fmt.Errorf("%s failed: OSStatus %d", call, status)
+}
+
+// defaultDevice returns the default input or output device
+func defaultDevice(selector uint32) (uint32, error) {
+	address := audioObjectPropertyAddress{selector, kAudioObjectPropertyScopeGlobal, kAudioObjectPropertyElementMain}
+	var device uint32
+	size := uint32(unsafe.Sizeof(device))
+	if status := coreAudio.getPropertyData(kAudioObjectSystemObject, &address, 0, nil, &size, unsafe.Pointer(&device)); status != 0 {
+		return 0, osStatusError("AudioObjectGetPropertyData", status)
+	}
+	if device == 0 {
+		return 0, fmt.Errorf("no default audio device")
+	}
+	return device, nil
+}
+
+// deviceUID returns the UID of a device as a retained NSString
+func deviceUID(device uint32) (objc.ID, error) {
+	address := audioObjectPropertyAddress{kAudioDevicePropertyDeviceUID, kAudioObjectPropertyScopeGlobal, kAudioObjectPropertyElementMain}
+	var uid objc.ID
+	size := uint32(unsafe.Sizeof(uid))
+	if status := coreAudio.getPropertyData(device, &address, 0, nil, &size, unsafe.Pointer(&uid)); status != 0 {
+		return 0, osStatusError("AudioObjectGetPropertyData", status)
+	}
+	return uid, nil
+}
+
+// deviceSampleRate returns the nominal sample rate of a device
+func deviceSampleRate(device uint32) (float64, error) {
+	address := audioObjectPropertyAddress{kAudioDevicePropertyNominalSampleRate, kAudioObjectPropertyScopeGlobal, kAudioObjectPropertyElementMain}
+	var rate float64
+	size := uint32(unsafe.Sizeof(rate))
+	if status := coreAudio.getPropertyData(device, &address, 0, nil, &size, unsafe.Pointer(&rate)); status != 0 {
+		return 0, osStatusError("AudioObjectGetPropertyData", status)
+	}
+	return rate, nil
+}
+
+// Objective-C selectors used to build tap and aggregate device descriptions
+var (
+	selAlloc                     = objc.RegisterName("alloc")
+	selNew                       = objc.RegisterName("new")
+	selRelease                   = objc.RegisterName("release")
+	selDrain                     = objc.RegisterName("drain")
+	selStringWithUTF8String      = objc.RegisterName("stringWithUTF8String:")
+	selNumberWithBool            = objc.RegisterName("numberWithBool:")
+	selArray                     = objc.RegisterName("array")
+	selArrayWithObject           = objc.RegisterName("arrayWithObject:")
+	selDictionary                = objc.RegisterName("dictionary")
+	selSetObjectForKey           = objc.RegisterName("setObject:forKey:")
+	selUUID                      = objc.RegisterName("UUID")
+	selUUIDString                = objc.RegisterName("UUIDString")
+	selInitStereoGlobalTapExcept = objc.RegisterName("initStereoGlobalTapButExcludeProcesses:")
+	selAuthorizationStatus       = objc.RegisterName("authorizationStatusForMediaType:")
+)
+
+// nsString returns an autoreleased NSString
+func nsString(s string) objc.ID {
+	return objc.ID(objc.GetClass("NSString")).Send(selStringWithUTF8String, s)
+}
+
+// nsBool returns an autoreleased NSNumber holding a boolean
+func nsBool(b bool) objc.ID {
+	return objc.ID(objc.GetClass("NSNumber")).Send(selNumberWithBool, b)
+}
+
+// nsDictionary returns an autoreleased NSDictionary of string keys
+func nsDictionary(entries map[string]objc.ID) objc.ID {
+	dict := objc.ID(objc.GetClass("NSMutableDictionary")).Send(selDictionary)
+	for key, value := range entries {
+		dict.Send(selSetObjectForKey, value, nsString(key))
+	}
+	return dict
+}
+
+// newUUIDString returns an autoreleased NSString holding a fresh UUID
+func newUUIDString() objc.ID {
+	return objc.ID(objc.GetClass("NSUUID")).Send(selUUID).Send(selUUIDString)
+}
+
+// withAutoreleasePool runs fn on a locked thread inside an autorelease pool,
+// which frees the temporary Objective-C objects fn creates
+func withAutoreleasePool(fn func() error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	pool := objc.ID(objc.GetClass("NSAutoreleasePool")).Send(selNew)
+	defer pool.Send(selDrain)
+	return fn()
+}
+
+// microphoneAccessDenied reports whether the user refused microphone access
+func microphoneAccessDenied() bool {
+	var status int
+	_ = withAutoreleasePool(func() error {
+		// AVMediaTypeAudio
+		status = objc.Send[int](objc.ID(objc.GetClass("AVCaptureDevice")), selAuthorizationStatus, nsString("soun"))
+		return nil
+	})
+	return status == avAuthorizationStatusDenied || status == avAuthorizationStatusRestricted
+}
+
+// systemAudioAccessDenied reports whether the user refused system audio recording.
+// It is false when macOS offers no way to tell.
+func systemAudioAccessDenied() bool {
+	if coreAudio.tccPreflight == nil {
+		return false
+	}
+	var result int32
+	_ = withAutoreleasePool(func() error {
+		result = coreAudio.tccPreflight(nsString("kTCCServiceAudioCapture"), 0)
+		return nil
+	})
+	return result == tccPreflightDenied
+}
+
+// processTap is a CoreAudio tap on the mixed system output, read through a private
+// aggregate device
+type processTap struct {
+	description objc.ID
+	tap         uint32
+	aggregate   uint32
+}
+
+// newProcessTap taps the stereo mix of all processes
+func newProcessTap() (*processTap, error) {
+	if coreAudio.createProcessTap == nil {
+		return nil, fmt.Errorf("system audio capture needs macOS 14.2 or later")
+	}
+	tapClass := objc.GetClass("CATapDescription")
+	if tapClass == 0 {
+		return nil, fmt.Errorf("system audio capture needs macOS 14.2 or later")
+	}
+
+	pt := &processTap{}
+	err := withAutoreleasePool(func() error {
+		pt.description = objc.ID(tapClass).Send(selAlloc).Send(selInitStereoGlobalTapExcept, objc.ID(objc.GetClass("NSArray")).Send(selArray))
+		if status := coreAudio.createProcessTap(pt.description, &pt.tap); status != 0 {
+			return osStatusError("AudioHardwareCreateProcessTap", status)
+		}
+
+		output, err := defaultDevice(kAudioHardwarePropertyDefaultOutputDevice)
+		if err != nil {
+			return err
+		}
+		outputUID, err := deviceUID(output)
+		if err != nil {
+			return err
+		}
+		defer outputUID.Send(selRelease)
+
+		// The output device clocks the aggregate; the tap starts with it
+		description := nsDictionary(map[string]objc.ID{
+			"name":         nsString("SteelClock Audio Tap"),
+			"uid":          newUUIDString(),
+			"master":       outputUID,
+			"private":      nsBool(true),
+			"stacked":      nsBool(false),
+			"tapautostart": nsBool(true),
+			"subdevices": objc.ID(objc.GetClass("NSArray")).Send(selArrayWithObject,
+				nsDictionary(map[string]objc.ID{"uid": outputUID})),
+			"taps": objc.ID(objc.GetClass("NSArray")).Send(selArrayWithObject,
+				nsDictionary(map[string]objc.ID{
+					"uid":   pt.description.Send(selUUID).Send(selUUIDString),
+					"drift": nsBool(true),
+				})),
+		})
+		if status := coreAudio.createAggregateDevice(description, &pt.aggregate); status != 0 {
+			return osStatusError("AudioHardwareCreateAggregateDevice", status)
+		}
+		return nil
+	})
+	if err != nil {
+		pt.destroy()
+		return nil, err
+	}
+	return pt, nil
+}
+
+// destroy removes the aggregate device and the tap
+func (pt *processTap) destroy() {
+	if pt.aggregate != 0 {
+		coreAudio.destroyAggregateDevice(pt.aggregate)
+		pt.aggregate = 0
+	}
+	if pt.tap != 0 {
+		coreAudio.destroyProcessTap(pt.tap)
+		pt.tap = 0
+	}
+	if pt.description != 0 {
+		pt.description.Send(selRelease)
+		pt.description = 0
+	}
+}
+
+// readBufferList returns the buffers of an AudioBufferList
+func readBufferList(list unsafe.Pointer) []audioBufferData {
+	if list == nil {
+		return nil
+	}
+	count := *(*uint32)(list)
+	if count == 0 {
+		return nil
+	}
+	raw := unsafe.Slice((*audioBuffer)(unsafe.Add(list, audioBufferListBuffers)), count)
+	buffers := make([]audioBufferData, 0, count)
+	for _, b := range raw {
+		if b.data == nil || b.channels == 0 {
+			continue
+		}
+		buffers = append(buffers, audioBufferData{
+			channels: int(b.channels),
+			samples:  unsafe.Slice((*float32)(b.data), b.dataByteSize/4),
+		})
+	}
+	return buffers
+}
@@ -0,0 +1,138 @@
+package audiovisualizer
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// correlationSamples is how many of the newest samples are correlated per reading (~85 ms at 48 kHz)
+const correlationSamples = 4096
+
+// correlationBlinkPeriod is how long the out-of-phase indicator stays on and off
+const correlationBlinkPeriod = 250 * time.Millisecond
+
+// correlationMeter tracks the smoothed stereo correlation shown by the correlation mode
+type correlationMeter struct {
+	value     float64 // Smoothed correlation, -1.0 to +1.0
+	smoothing float64
+	fill      uint8 // Indicator color while in phase
+	warning   uint8 // Indicator color while out of phase
+	ticks     uint8 // Scale marks color
+	updated   time.Time
+}
+
+// newCorrelationMeter resolves correlation settings and colors
+func newCorrelationMeter(cfg *config.CorrelationConfig) correlationMeter {
+	m := correlationMeter{smoothing: 0.5, fill: 180, warning: 255, ticks: 96}
+	if cfg == nil {
+		return m
+	}
+	if cfg.Smoothing > 0 {
+		m.smoothing = clampUnit(cfg.Smoothing)
+	}
+	if c := cfg.Colors; c != nil {
+		if c.Fill != nil {
+			m.fill = uint8(*c.Fill)
+		}
+		if c.Clipping != nil {
+			m.warning = uint8(*c.Clipping)
+		}
+		if c.Ticks != nil {
+			m.ticks = uint8(*c.Ticks)
+		}
+	}
+	return m
+}
+
+// update correlates the newest samples of both channels and smooths the reading
+func (m *correlationMeter) update(left, right []float32, now time.Time) {
+	n := min(len(left), len(right), correlationSamples)
+	target := stereoCorrelation(left[len(left)-n:], right[len(right)-n:])
+
+	dt := 0.0
+	if !m.updated.IsZero() {
+		dt = now.Sub(m.updated).Seconds()
+	}
+	m.updated = now
+	m.value = smoothValue(m.value, target, m.smoothing, m.smoothing, dt)
+}
+
+// render draws the meter; the out-of-phase indicator blinks
+func (m *correlationMeter) render(img *image.Gray, now time.Time) {
+	blinkOn := now.UnixMilli()/correlationBlinkPeriod.Milliseconds()%2 == 0
+	drawCorrelation(img, m.value, m.fill, m.warning, m.ticks, blinkOn)
+}
+
+// stereoCorrelation returns the Pearson correlation of the left and right
+// channels: +1 for mono, 0 for unrelated channels, -1 for a channel in
+// opposite phase. Silence in either channel has no defined phase and reads 0.
+func stereoCorrelation(left, right []float32) float64 {
+	n := min(len(left), len(right))
+	if n == 0 {
+		return 0
+	}
+
+	var meanL, meanR float64
+	for i := 0; i < n; i++ {
+		meanL += float64(left[i])
+		meanR += float64(right[i])
+	}
+	meanL /= float64(n)
+	meanR /= float64(n)
+
+	var cov, varL, varR float64
+	for i := 0; i < n; i++ {
+		l := float64(left[i]) - meanL
+		r := float64(right[i]) - meanR
+		cov += l * r
+		varL += l * l
+		varR += r * r
+	}
+
+	// Treat anything below the noise floor of 16-bit audio as silence
+	const minVariance = 1e-9
+	if varL < minVariance*float64(n) || varR < minVariance*float64(n) {
+		return 0
+	}
+	return math.Max(-1, math.Min(1, cov/math.Sqrt(varL*varR)))
+}
+
+// drawCorrelation draws a horizontal scale from -1 (left edge) to +1 (right
+// edge) with a bar growing from the center to value. A negative value is drawn
+// in the warning color and only while showNegative is set, so it can blink.
+func drawCorrelation(img *image.Gray, value float64, fill, warning, ticks uint8, showNegative bool) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 3 || height < 1 {
+		return
+	}
+
+	xAt := func(v float64) int {
+		return bounds.Min.X + int(math.Round((v+1)/2*float64(width-1)))
+	}
+	center := xAt(0)
+
+	// Scale: full-height center mark, short marks at the ends and halfway points
+	bitmap.DrawVerticalLine(img, center, bounds.Min.Y, bounds.Max.Y-1, ticks)
+	markHeight := max(1, height/4)
+	for _, v := range []float64{-1, -0.5, 0.5, 1} {
+		x := xAt(v)
+		bitmap.DrawVerticalLine(img, x, bounds.Min.Y, bounds.Min.Y+markHeight-1, ticks)
+		bitmap.DrawVerticalLine(img, x, bounds.Max.Y-markHeight, bounds.Max.Y-1, ticks)
+	}
+
+	// Indicator bar in the middle half of the height
+	barTop := bounds.Min.Y + height/4
+	barHeight := max(1, height/2)
+	x := xAt(value)
+	switch {
+	case x > center:
+		bitmap.DrawFilledRectangle(img, center+1, barTop, x-center, barHeight, fill)
+	case x < center && showNegative:
+		bitmap.DrawFilledRectangle(img, x, barTop, center-x, barHeight, warning)
+	}
+}
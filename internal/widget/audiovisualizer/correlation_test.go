@@ -0,0 +1,105 @@
+package audiovisualizer
+
+import (
+	"image"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// sine returns n samples of a sine wave with the given phase in radians
+func sine(n int, phase float64) []float32 {
+	s := make([]float32, n)
+	for i := range s {
+		s[i] = float32(0.5 * math.Sin(2*math.Pi*float64(i)/64+phase))
+	}
+	return s
+}
+
+func TestStereoCorrelation(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  []float32
+		right []float32
+		want  float64
+	}{
+		{"mono", sine(1024, 0), sine(1024, 0), 1},
+		{"opposite phase", sine(1024, 0), sine(1024, math.Pi), -1},
+		{"quadrature", sine(1024, 0), sine(1024, math.Pi/2), 0},
+		{"silent channel", sine(1024, 0), make([]float32, 1024), 0},
+		{"empty", nil, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stereoCorrelation(tt.left, tt.right); math.Abs(got-tt.want) > 1e-3 {
+				t.Errorf("stereoCorrelation() = %.4f, want %.4f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCorrelationMeter_Update(t *testing.T) {
+	m := newCorrelationMeter(nil)
+	now := time.Now()
+
+	// The first reading has no previous frame to smooth against
+	m.update(sine(8192, 0), sine(8192, math.Pi), now)
+	if math.Abs(m.value+1) > 1e-3 {
+		t.Fatalf("first update value = %.3f, want -1", m.value)
+	}
+
+	// Later readings move gradually toward the new correlation
+	m.update(sine(8192, 0), sine(8192, 0), now.Add(33*time.Millisecond))
+	if m.value <= -1 || m.value >= 1 {
+		t.Errorf("smoothed value = %.3f, want between -1 and 1", m.value)
+	}
+}
+
+func TestNewCorrelationMeter(t *testing.T) {
+	fill, warning, ticks := 100, 200, 50
+	m := newCorrelationMeter(&config.CorrelationConfig{
+		Smoothing: 2,
+		Colors:    &config.ModeColorsConfig{Fill: &fill, Clipping: &warning, Ticks: &ticks},
+	})
+	if m.smoothing != 1 || m.fill != 100 || m.warning != 200 || m.ticks != 50 {
+		t.Errorf("newCorrelationMeter() = %+v, want smoothing 1, colors 100/200/50", m)
+	}
+}
+
+func TestDrawCorrelation(t *testing.T) {
+	const w, h = 21, 8
+	center := 10
+
+	t.Run("in phase grows right", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, w, h))
+		drawCorrelation(img, 1, 180, 255, 96, true)
+		if got := img.GrayAt(w-2, h/2).Y; got != 180 {
+			t.Errorf("right side = %d, want fill 180", got)
+		}
+		if got := img.GrayAt(1, h/2).Y; got != 0 {
+			t.Errorf("left side = %d, want empty", got)
+		}
+		if got := img.GrayAt(center, 0).Y; got != 96 {
+			t.Errorf("center mark = %d, want ticks 96", got)
+		}
+	})
+
+	t.Run("out of phase grows left in warning color", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, w, h))
+		drawCorrelation(img, -1, 180, 255, 96, true)
+		if got := img.GrayAt(1, h/2).Y; got != 255 {
+			t.Errorf("left side = %d, want warning 255", got)
+		}
+	})
+
+	t.Run("out of phase blinks off", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, w, h))
+		drawCorrelation(img, -1, 180, 255, 96, false)
+		if got := img.GrayAt(1, h/2).Y; got != 0 {
+			t.Errorf("left side = %d, want empty while blinking off", got)
+		}
+	})
+}
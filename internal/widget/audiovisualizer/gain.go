@@ -0,0 +1,52 @@
+package audiovisualizer
+
+import (
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// maxGain caps the manual gain so a typo cannot turn every sample into a clipped square wave
+const maxGain = 100.0
+
+// gainSettings resolves the manual gain and volume compensation for the active display mode.
+// Each mode reads its own config block; unset values default to unity gain with compensation on.
+func gainSettings(cfg config.WidgetConfig, displayMode string) (gain float64, volumeCompensation bool) {
+	gain = 1.0
+	volumeCompensation = true
+
+	var cfgGain float64
+	var cfgCompensation *bool
+	switch {
+	case displayMode == AudioDisplayModeSpectrum && cfg.Spectrum != nil:
+		cfgGain, cfgCompensation = cfg.Spectrum.Gain, cfg.Spectrum.VolumeCompensation
+	case (displayMode == AudioDisplayModeOscilloscope || displayMode == AudioDisplayModeLissajous) && cfg.Oscilloscope != nil:
+		cfgGain, cfgCompensation = cfg.Oscilloscope.Gain, cfg.Oscilloscope.VolumeCompensation
+	}
+
+	if cfgGain > 0 {
+		gain = min(cfgGain, maxGain)
+	}
+	if cfgCompensation != nil {
+		volumeCompensation = *cfgCompensation
+	}
+	return gain, volumeCompensation
+}
+
+// volumeGain returns the multiplier that undoes the system volume applied to loopback audio.
+// Volumes at or below 1% are left alone to avoid amplifying noise without bound.
+func volumeGain(volumePercent float64) float64 {
+	if volumePercent <= 1.0 {
+		return 1.0
+	}
+	return 100.0 / volumePercent
+}
+
+// applyGain multiplies samples in place by gain, clamping to the -1.0 to +1.0 range
+func applyGain(samples []float32, gain float64) {
+	if gain == 1.0 {
+		return
+	}
+	g := float32(gain)
+	for i, s := range samples {
+		samples[i] = max(-1.0, min(1.0, s*g))
+	}
+}
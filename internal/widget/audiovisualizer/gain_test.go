@@ -0,0 +1,93 @@
+package audiovisualizer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestGainSettings(t *testing.T) {
+	tests := []struct {
+		name             string
+		cfg              config.WidgetConfig
+		mode             string
+		wantGain         float64
+		wantCompensation bool
+	}{
+		{"defaults", config.WidgetConfig{}, AudioDisplayModeSpectrum, 1.0, true},
+		{
+			"spectrum gain",
+			config.WidgetConfig{Spectrum: &config.SpectrumConfig{Gain: 2.5, VolumeCompensation: config.BoolPtr(false)}},
+			AudioDisplayModeSpectrum, 2.5, false,
+		},
+		{
+			"oscilloscope reads its own block",
+			config.WidgetConfig{
+				Spectrum:     &config.SpectrumConfig{Gain: 2.5},
+				Oscilloscope: &config.OscilloscopeConfig{Gain: 4, VolumeCompensation: config.BoolPtr(true)},
+			},
+			AudioDisplayModeOscilloscope, 4, true,
+		},
+		{
+			"spectrum settings ignored in oscilloscope mode",
+			config.WidgetConfig{Spectrum: &config.SpectrumConfig{Gain: 3, VolumeCompensation: config.BoolPtr(false)}},
+			AudioDisplayModeOscilloscope, 1.0, true,
+		},
+		{
+			"negative gain falls back to unity",
+			config.WidgetConfig{Spectrum: &config.SpectrumConfig{Gain: -2}},
+			AudioDisplayModeSpectrum, 1.0, true,
+		},
+		{
+			"gain capped",
+			config.WidgetConfig{Spectrum: &config.SpectrumConfig{Gain: 1000}},
+			AudioDisplayModeSpectrum, maxGain, true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gain, compensation := gainSettings(tt.cfg, tt.mode)
+			if gain != tt.wantGain || compensation != tt.wantCompensation {
+				t.Errorf("gainSettings() = %v, %v; want %v, %v", gain, compensation, tt.wantGain, tt.wantCompensation)
+			}
+		})
+	}
+}
+
+func TestVolumeGain(t *testing.T) {
+	tests := []struct {
+		volume float64
+		want   float64
+	}{
+		{100, 1},
+		{50, 2},
+		{25, 4},
+		{1, 1},
+		{0, 1},
+	}
+	for _, tt := range tests {
+		if got := volumeGain(tt.volume); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("volumeGain(%v) = %v, want %v", tt.volume, got, tt.want)
+		}
+	}
+}
+
+func TestApplyGain(t *testing.T) {
+	samples := []float32{0.1, -0.2, 0.6, -0.9}
+	applyGain(samples, 2)
+
+	want := []float32{0.2, -0.4, 1.0, -1.0}
+	for i := range want {
+		if math.Abs(float64(samples[i]-want[i])) > 1e-6 {
+			t.Errorf("sample %d = %v, want %v", i, samples[i], want[i])
+		}
+	}
+
+	unity := []float32{0.3, -0.7}
+	applyGain(unity, 1)
+	if unity[0] != 0.3 || unity[1] != -0.7 {
+		t.Errorf("unity gain changed samples: %v", unity)
+	}
+}
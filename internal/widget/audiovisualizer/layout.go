@@ -0,0 +1,42 @@
+package audiovisualizer
+
+// spectrumBarOrder returns the frequency band shown by each of barCount bars.
+// In "normal" layout band i is bar i, lowest frequencies on the left. Mirrored
+// layouts show every band twice, reflected around the center: "mirror_center"
+// puts the lowest band in the middle, "mirror_edges" at both edges. With an odd
+// bar count the middle bar is shared by both halves, with an even count the two
+// middle bars show the same band.
+func spectrumBarOrder(barCount int, layout string) []int {
+	order := make([]int, barCount)
+	bands := spectrumBandCount(barCount, layout)
+	for i := range order {
+		switch layout {
+		case AudioSpectrumLayoutMirrorCenter:
+			order[i] = mirrorDistance(i, barCount)
+		case AudioSpectrumLayoutMirrorEdges:
+			order[i] = bands - 1 - mirrorDistance(i, barCount)
+		default:
+			order[i] = i
+		}
+	}
+	return order
+}
+
+// spectrumBandCount returns how many frequency bands are analyzed for barCount bars
+func spectrumBandCount(barCount int, layout string) int {
+	switch layout {
+	case AudioSpectrumLayoutMirrorCenter, AudioSpectrumLayoutMirrorEdges:
+		return (barCount + 1) / 2
+	default:
+		return barCount
+	}
+}
+
+// mirrorDistance returns how many bars slot i is away from the center of barCount bars
+func mirrorDistance(i, barCount int) int {
+	d := 2*i - (barCount - 1)
+	if d < 0 {
+		d = -d
+	}
+	return d / 2
+}
@@ -0,0 +1,44 @@
+package audiovisualizer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSpectrumBarOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		barCount int
+		layout   string
+		want     []int
+	}{
+		{"normal", 4, AudioSpectrumLayoutNormal, []int{0, 1, 2, 3}},
+		{"unknown falls back to normal", 3, "sideways", []int{0, 1, 2}},
+		{"mirror center even", 6, AudioSpectrumLayoutMirrorCenter, []int{2, 1, 0, 0, 1, 2}},
+		{"mirror center odd", 5, AudioSpectrumLayoutMirrorCenter, []int{2, 1, 0, 1, 2}},
+		{"mirror edges even", 6, AudioSpectrumLayoutMirrorEdges, []int{0, 1, 2, 2, 1, 0}},
+		{"mirror edges odd", 5, AudioSpectrumLayoutMirrorEdges, []int{0, 1, 2, 1, 0}},
+		{"mirror single bar", 1, AudioSpectrumLayoutMirrorCenter, []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := spectrumBarOrder(tt.barCount, tt.layout)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("spectrumBarOrder(%d, %q) = %v, want %v", tt.barCount, tt.layout, got, tt.want)
+			}
+			// Every analyzed band is shown
+			bands := spectrumBandCount(tt.barCount, tt.layout)
+			seen := make(map[int]bool)
+			for _, b := range got {
+				if b < 0 || b >= bands {
+					t.Fatalf("band %d out of range [0, %d)", b, bands)
+				}
+				seen[b] = true
+			}
+			if len(seen) != bands {
+				t.Errorf("%d of %d bands shown", len(seen), bands)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package audiovisualizer
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+)
+
+// drawLissajous plots the left channel as X against the right channel as Y,
+// centered in the image with the same scale on both axes so a phase-shifted
+// sine still draws a circle. With "line" style consecutive points are joined,
+// otherwise each sample is a single dot. A nil right channel plots left
+// against itself, which is the diagonal line of a mono signal.
+func drawLissajous(img *image.Gray, left, right []float32, style string, fillColor uint8) {
+	if right == nil {
+		right = left
+	}
+	n := min(len(left), len(right))
+	if n == 0 {
+		return
+	}
+
+	bounds := img.Bounds()
+	cx := bounds.Min.X + bounds.Dx()/2
+	cy := bounds.Min.Y + bounds.Dy()/2
+	scale := float32(min(bounds.Dx(), bounds.Dy())-1) / 2
+	c := color.Gray{Y: fillColor}
+
+	point := func(i int) (int, int) {
+		return cx + int(left[i]*scale), cy - int(right[i]*scale)
+	}
+
+	px, py := point(0)
+	img.SetGray(px, py, c)
+	for i := 1; i < n; i++ {
+		x, y := point(i)
+		if style == AudioWaveformStyleLine {
+			bitmap.DrawLine(img, px, py, x, y, c)
+		} else {
+			img.SetGray(x, y, c)
+		}
+		px, py = x, y
+	}
+}
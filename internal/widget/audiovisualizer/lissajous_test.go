@@ -0,0 +1,51 @@
+package audiovisualizer
+
+import (
+	"image"
+	"testing"
+)
+
+func TestDrawLissajous(t *testing.T) {
+	lit := func(img *image.Gray, x, y int) bool { return img.GrayAt(x, y).Y != 0 }
+
+	t.Run("mono draws the diagonal", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, 41, 21))
+		drawLissajous(img, []float32{-1, 0, 1}, nil, AudioWaveformStyleLine, 255)
+		// Center at (20, 10), scale 10: (-1,-1) -> (10, 20), (1, 1) -> (30, 0)
+		for _, p := range [][2]int{{10, 20}, {20, 10}, {30, 0}, {25, 5}} {
+			if !lit(img, p[0], p[1]) {
+				t.Errorf("pixel %v not lit", p)
+			}
+		}
+		if lit(img, 10, 0) || lit(img, 30, 20) {
+			t.Error("anti-diagonal corner lit")
+		}
+	})
+
+	t.Run("dots are not joined", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, 41, 21))
+		drawLissajous(img, []float32{-1, 1}, []float32{0, 0}, AudioWaveformStyleFilled, 255)
+		if !lit(img, 10, 10) || !lit(img, 30, 10) {
+			t.Error("sample dots not lit")
+		}
+		if lit(img, 20, 10) {
+			t.Error("dots joined by a line")
+		}
+	})
+
+	t.Run("quadrature signal draws a circle", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, 41, 21))
+		// Samples at 0, 90, 180 and 270 degrees
+		drawLissajous(img, []float32{1, 0, -1, 0}, []float32{0, 1, 0, -1}, AudioWaveformStyleFilled, 255)
+		for _, p := range [][2]int{{30, 10}, {20, 0}, {10, 10}, {20, 20}} {
+			if !lit(img, p[0], p[1]) {
+				t.Errorf("pixel %v not lit", p)
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		img := image.NewGray(image.Rect(0, 0, 8, 8))
+		drawLissajous(img, nil, nil, AudioWaveformStyleLine, 255)
+	})
+}
@@ -0,0 +1,54 @@
+package audiovisualizer
+
+import "github.com/pozitronik/steelclock-go/internal/config"
+
+const (
+	peakExponentialRate = 0.3  // Share of the peak lost per second with "exponential" decay
+	peakLinearSpeed     = 0.5  // Bar heights per second with "linear" decay
+	peakFloor           = 0.01 // Peaks below this are cleared
+)
+
+// peakDecaySettings extracts the peak decay style and gravity from spectrum config
+func peakDecaySettings(cfg *config.SpectrumConfig) (style string, gravity float64) {
+	style = AudioPeakDecayExponential
+	gravity = DefaultPeakGravity
+
+	if cfg == nil || cfg.Peak == nil {
+		return style, gravity
+	}
+	switch cfg.Peak.PeakDecayStyle {
+	case AudioPeakDecayInstant, AudioPeakDecayLinear, AudioPeakDecayGravity:
+		style = cfg.Peak.PeakDecayStyle
+	}
+	if cfg.Peak.PeakGravity > 0 {
+		gravity = cfg.Peak.PeakGravity
+	}
+	return style, gravity
+}
+
+// decayPeak lets a peak cap whose hold time has expired fall toward the current
+// bar level over dt seconds. velocity is the fall speed carried between frames
+// by the "gravity" style; it is reset once the cap lands on the bar.
+func decayPeak(peak, level, velocity, dt float64, style string, gravity float64) (newPeak, newVelocity float64) {
+	switch style {
+	case AudioPeakDecayInstant:
+		peak = level
+	case AudioPeakDecayLinear:
+		peak -= peakLinearSpeed * dt
+	case AudioPeakDecayGravity:
+		velocity += gravity * dt
+		peak -= velocity * dt
+	default:
+		peak *= 1.0 - peakExponentialRate*dt
+	}
+
+	// Peak should never go below current value
+	if peak <= level {
+		peak = level
+		velocity = 0
+	}
+	if peak < peakFloor {
+		peak = 0
+	}
+	return peak, velocity
+}
@@ -0,0 +1,93 @@
+package audiovisualizer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestPeakDecaySettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *config.SpectrumConfig
+		wantStyle   string
+		wantGravity float64
+	}{
+		{"nil config", nil, AudioPeakDecayExponential, DefaultPeakGravity},
+		{"no peak config", &config.SpectrumConfig{}, AudioPeakDecayExponential, DefaultPeakGravity},
+		{"gravity", &config.SpectrumConfig{Peak: &config.PeakConfig{PeakDecayStyle: "gravity", PeakGravity: 5}}, AudioPeakDecayGravity, 5},
+		{"linear", &config.SpectrumConfig{Peak: &config.PeakConfig{PeakDecayStyle: "linear"}}, AudioPeakDecayLinear, DefaultPeakGravity},
+		{"unknown style", &config.SpectrumConfig{Peak: &config.PeakConfig{PeakDecayStyle: "bounce"}}, AudioPeakDecayExponential, DefaultPeakGravity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			style, gravity := peakDecaySettings(tt.cfg)
+			if style != tt.wantStyle || gravity != tt.wantGravity {
+				t.Errorf("peakDecaySettings() = %q, %v, want %q, %v", style, gravity, tt.wantStyle, tt.wantGravity)
+			}
+		})
+	}
+}
+
+func TestDecayPeak(t *testing.T) {
+	const dt = 0.1
+
+	t.Run("exponential", func(t *testing.T) {
+		peak, _ := decayPeak(0.8, 0, 0, dt, AudioPeakDecayExponential, DefaultPeakGravity)
+		if want := 0.8 * (1 - peakExponentialRate*dt); math.Abs(peak-want) > 1e-9 {
+			t.Errorf("peak = %v, want %v", peak, want)
+		}
+	})
+
+	t.Run("instant", func(t *testing.T) {
+		if peak, _ := decayPeak(0.8, 0.3, 0, dt, AudioPeakDecayInstant, DefaultPeakGravity); peak != 0.3 {
+			t.Errorf("peak = %v, want 0.3", peak)
+		}
+	})
+
+	t.Run("linear falls at constant speed", func(t *testing.T) {
+		peak := 0.8
+		var steps []float64
+		for i := 0; i < 3; i++ {
+			prev := peak
+			peak, _ = decayPeak(peak, 0, 0, dt, AudioPeakDecayLinear, DefaultPeakGravity)
+			steps = append(steps, prev-peak)
+		}
+		for _, s := range steps {
+			if math.Abs(s-peakLinearSpeed*dt) > 1e-9 {
+				t.Errorf("fall per step = %v, want %v", steps, peakLinearSpeed*dt)
+				break
+			}
+		}
+	})
+
+	t.Run("gravity accelerates", func(t *testing.T) {
+		peak, velocity := 0.9, 0.0
+		var steps []float64
+		for i := 0; i < 4; i++ {
+			prev := peak
+			peak, velocity = decayPeak(peak, 0, velocity, dt, AudioPeakDecayGravity, DefaultPeakGravity)
+			steps = append(steps, prev-peak)
+		}
+		for i := 1; i < len(steps); i++ {
+			if steps[i] <= steps[i-1] {
+				t.Fatalf("fall per step = %v, want increasing", steps)
+			}
+		}
+	})
+
+	t.Run("lands on bar", func(t *testing.T) {
+		peak, velocity := decayPeak(0.5, 0.45, 1.0, dt, AudioPeakDecayGravity, DefaultPeakGravity)
+		if peak != 0.45 || velocity != 0 {
+			t.Errorf("decayPeak() = %v, %v, want 0.45, 0", peak, velocity)
+		}
+	})
+
+	t.Run("cleared near zero", func(t *testing.T) {
+		if peak, _ := decayPeak(0.012, 0, 0, dt, AudioPeakDecayLinear, DefaultPeakGravity); peak != 0 {
+			t.Errorf("peak = %v, want 0", peak)
+		}
+	})
+}
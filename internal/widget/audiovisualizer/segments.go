@@ -0,0 +1,52 @@
+package audiovisualizer
+
+import (
+	"image"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+)
+
+// segmentSettings fits count segments separated by gap pixels (nil: 1) into
+// height, dropping the gap and then segments when they would be less than a pixel tall
+func segmentSettings(count int, gapPx *int, height int) (segments, segmentGap int) {
+	if count <= 0 || height <= 0 {
+		return 0, 0
+	}
+	gap := 1
+	if gapPx != nil {
+		gap = max(*gapPx, 0)
+	}
+	if count > height {
+		count = height
+	}
+	if count*(gap+1)-gap > height {
+		gap = 0
+	}
+	return count, gap
+}
+
+// drawSegmentedBar draws a bar filled to level (0.0-1.0) as a stack of LED
+// segments growing from the bottom of a height-pixel column. Fully covered
+// segments are lit with fillColor; the topmost, partially covered segment is
+// dimmed in proportion to the energy it represents.
+func drawSegmentedBar(img *image.Gray, x, barW, height int, level float64, segments, gap int, fillColor uint8) {
+	if segments <= 0 || barW <= 0 || level <= 0 {
+		return
+	}
+
+	lit := level * float64(segments)
+	pitch := float64(height+gap) / float64(segments)
+	for k := 0; k < segments && float64(k) < lit; k++ {
+		bottom := height - int(float64(k)*pitch)
+		top := height - int(float64(k+1)*pitch) + gap
+		if top < 0 {
+			top = 0
+		}
+
+		c := fillColor
+		if fraction := lit - float64(k); fraction < 1 {
+			c = uint8(float64(fillColor) * fraction)
+		}
+		bitmap.DrawFilledRectangle(img, x, top, barW, bottom-top, c)
+	}
+}
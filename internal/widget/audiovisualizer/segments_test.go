@@ -0,0 +1,84 @@
+package audiovisualizer
+
+import (
+	"image"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestSegmentSettings(t *testing.T) {
+	tests := []struct {
+		name             string
+		count            int
+		gap              *int
+		h                int
+		wantSeg, wantGap int
+	}{
+		{"disabled", 0, config.IntPtr(1), 40, 0, 0},
+		{"fits", 10, config.IntPtr(1), 40, 10, 1},
+		{"wide gap", 4, config.IntPtr(3), 40, 4, 3},
+		{"gap dropped", 20, config.IntPtr(1), 30, 20, 0},
+		{"segments capped to height", 50, config.IntPtr(1), 40, 40, 0},
+		{"default gap", 8, nil, 40, 8, 1},
+		{"explicit zero gap", 8, config.IntPtr(0), 40, 8, 0},
+		{"negative gap", 8, config.IntPtr(-2), 40, 8, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seg, gap := segmentSettings(tt.count, tt.gap, tt.h)
+			if seg != tt.wantSeg || gap != tt.wantGap {
+				t.Errorf("segmentSettings(%d, %v, %d) = %d, %d, want %d, %d", tt.count, tt.gap, tt.h, seg, gap, tt.wantSeg, tt.wantGap)
+			}
+		})
+	}
+}
+
+func TestDrawSegmentedBar(t *testing.T) {
+	// 4 segments of 4 pixels with 2-pixel gaps in a 22-pixel column:
+	// rows 18-21, 12-15, 6-9, 0-3 from the bottom up
+	column := func(level float64) []uint8 {
+		img := image.NewGray(image.Rect(0, 0, 1, 22))
+		drawSegmentedBar(img, 0, 1, 22, level, 4, 2, 200)
+		col := make([]uint8, 22)
+		for y := range col {
+			col[y] = img.GrayAt(0, y).Y
+		}
+		return col
+	}
+
+	t.Run("full segments with gaps", func(t *testing.T) {
+		col := column(0.5)
+		for y, v := range col {
+			want := uint8(0)
+			if (y >= 12 && y <= 15) || y >= 18 {
+				want = 200
+			}
+			if v != want {
+				t.Fatalf("row %d = %d, want %d (column %v)", y, v, want, col)
+			}
+		}
+	})
+
+	t.Run("partial top segment is dimmed", func(t *testing.T) {
+		col := column(0.625) // 2.5 segments
+		if col[21] != 200 || col[12] != 200 {
+			t.Errorf("full segments = %d, %d, want 200", col[21], col[12])
+		}
+		if col[6] != 100 || col[9] != 100 {
+			t.Errorf("partial segment = %d, %d, want 100", col[6], col[9])
+		}
+		if col[0] != 0 {
+			t.Errorf("unlit segment = %d, want 0", col[0])
+		}
+	})
+
+	t.Run("silent", func(t *testing.T) {
+		for y, v := range column(0) {
+			if v != 0 {
+				t.Fatalf("row %d = %d, want 0", y, v)
+			}
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package audiovisualizer
+
+import (
+	"math"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// smoothingSettings resolves attack and release smoothing factors.
+// Unset values fall back to the common smoothing factor.
+func smoothingSettings(cfg *config.SpectrumConfig, smoothing float64) (attack, release float64) {
+	attack = smoothing
+	release = smoothing
+
+	if cfg == nil {
+		return attack, release
+	}
+	if cfg.Attack != nil {
+		attack = clampUnit(*cfg.Attack)
+	}
+	if cfg.Release != nil {
+		release = clampUnit(*cfg.Release)
+	}
+	return attack, release
+}
+
+// smoothValue moves current toward target using an exponential moving average
+// with time-based decay. Rising values use the attack factor, falling values
+// use the release factor; a factor of 0 jumps to the target immediately.
+func smoothValue(current, target, attack, release, dt float64) float64 {
+	factor := release
+	if target > current {
+		factor = attack
+	}
+	if factor <= 0 || dt <= 0 {
+		return target
+	}
+
+	alpha := 1.0 - math.Pow(factor, dt*30) // Adjust for frame rate
+	return alpha*target + (1-alpha)*current
+}
+
+// clampUnit limits a value to the 0.0-1.0 range
+func clampUnit(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,60 @@
+package audiovisualizer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestSmoothingSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *config.SpectrumConfig
+		wantAttack  float64
+		wantRelease float64
+	}{
+		{"nil config", nil, 0.5, 0.5},
+		{"fallback to smoothing", &config.SpectrumConfig{}, 0.5, 0.5},
+		{"explicit attack and release", &config.SpectrumConfig{Attack: config.Float64Ptr(0), Release: config.Float64Ptr(0.9)}, 0, 0.9},
+		{"only release", &config.SpectrumConfig{Release: config.Float64Ptr(0.8)}, 0.5, 0.8},
+		{"out of range clamped", &config.SpectrumConfig{Attack: config.Float64Ptr(-1), Release: config.Float64Ptr(2)}, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attack, release := smoothingSettings(tt.cfg, 0.5)
+			if attack != tt.wantAttack || release != tt.wantRelease {
+				t.Errorf("smoothingSettings() = (%v, %v), want (%v, %v)", attack, release, tt.wantAttack, tt.wantRelease)
+			}
+		})
+	}
+}
+
+func TestSmoothValue(t *testing.T) {
+	const dt = 1.0 / 30
+
+	// Instant attack jumps straight to a higher target
+	if got := smoothValue(0.2, 0.9, 0, 0.9, dt); got != 0.9 {
+		t.Errorf("rising with attack 0 = %v, want 0.9", got)
+	}
+
+	// Slow release only moves part of the way down
+	got := smoothValue(0.9, 0.1, 0, 0.9, dt)
+	want := 0.1*0.1 + 0.9*0.9
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("falling with release 0.9 = %v, want %v", got, want)
+	}
+
+	// Symmetric factors match the plain exponential moving average
+	up := smoothValue(0.0, 1.0, 0.5, 0.5, dt)
+	down := smoothValue(1.0, 0.0, 0.5, 0.5, dt)
+	if math.Abs(up-(1-down)) > 1e-9 {
+		t.Errorf("symmetric smoothing mismatch: up=%v down=%v", up, down)
+	}
+
+	// Zero elapsed time returns the target
+	if got := smoothValue(0.3, 0.6, 0.5, 0.5, 0); got != 0.6 {
+		t.Errorf("dt=0 = %v, want 0.6", got)
+	}
+}
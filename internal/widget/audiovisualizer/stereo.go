@@ -0,0 +1,16 @@
+package audiovisualizer
+
+import (
+	"image"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+)
+
+// drawStereoDivider draws the line between the left (top) and right (bottom) halves
+// when stereo channels are rendered separately. A negative color disables it.
+func drawStereoDivider(img *image.Gray, width, height, dividerColor int) {
+	if dividerColor < 0 || width <= 0 || height < 2 {
+		return
+	}
+	bitmap.DrawHorizontalLine(img, 0, width-1, height/2, uint8(dividerColor))
+}
@@ -0,0 +1,36 @@
+package audiovisualizer
+
+import (
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+)
+
+func TestDrawStereoDivider(t *testing.T) {
+	t.Run("draws middle line", func(t *testing.T) {
+		img := bitmap.NewGrayscaleImage(16, 10, 0)
+		drawStereoDivider(img, 16, 10, 64)
+
+		for x := 0; x < 16; x++ {
+			if got := img.GrayAt(x, 5).Y; got != 64 {
+				t.Fatalf("pixel (%d,5) = %d, want 64", x, got)
+			}
+		}
+		for y := 0; y < 10; y++ {
+			if y != 5 && img.GrayAt(0, y).Y != 0 {
+				t.Errorf("pixel (0,%d) should stay empty", y)
+			}
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		img := bitmap.NewGrayscaleImage(16, 10, 0)
+		drawStereoDivider(img, 16, 10, -1)
+
+		for _, p := range img.Pix {
+			if p != 0 {
+				t.Fatal("disabled divider should draw nothing")
+			}
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package audiovisualizer
+
+// oscilloscopeWindow returns up to count samples of buf to draw. Without a
+// trigger these are the newest samples. With "rising" or "falling" the window
+// starts at the most recent crossing of level in that direction that still
+// leaves count samples to draw, so periodic signals stay still between frames.
+// When no crossing is found the newest samples are used.
+func oscilloscopeWindow(buf []float32, count int, trigger string, level float64) []float32 {
+	if count > len(buf) {
+		count = len(buf)
+	}
+	latest := len(buf) - count
+
+	if trigger == AudioTriggerRising || trigger == AudioTriggerFalling {
+		lvl := float32(level)
+		for i := latest; i > 0; i-- {
+			prev, cur := buf[i-1], buf[i]
+			if (trigger == AudioTriggerRising && prev < lvl && cur >= lvl) ||
+				(trigger == AudioTriggerFalling && prev > lvl && cur <= lvl) {
+				return buf[i : i+count]
+			}
+		}
+	}
+	return buf[latest:]
+}
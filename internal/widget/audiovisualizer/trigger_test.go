@@ -0,0 +1,51 @@
+package audiovisualizer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOscilloscopeWindow(t *testing.T) {
+	// Two periods of a triangle wave followed by some samples
+	buf := []float32{0, 0.5, 1, 0.5, 0, -0.5, -1, -0.5, 0, 0.5, 1, 0.5, 0, -0.5, -1, -0.5, 0}
+
+	tests := []struct {
+		name    string
+		count   int
+		trigger string
+		level   float64
+		want    []float32
+	}{
+		{"untriggered takes newest", 4, AudioTriggerNone, 0, []float32{-0.5, -1, -0.5, 0}},
+		{"rising", 4, AudioTriggerRising, 0.25, []float32{0.5, 1, 0.5, 0}},
+		{"falling", 4, AudioTriggerFalling, 0, []float32{0, -0.5, -1, -0.5}},
+		{"no crossing", 4, AudioTriggerRising, 2, []float32{-0.5, -1, -0.5, 0}},
+		{"count larger than buffer", 100, AudioTriggerRising, 0, buf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := oscilloscopeWindow(buf, tt.count, tt.trigger, tt.level)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("oscilloscopeWindow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOscilloscopeWindow_Stable(t *testing.T) {
+	// A periodic signal read at different phases lines up on the same trigger point
+	period := []float32{0, 0.5, 1, 0.5, 0, -0.5, -1, -0.5}
+	var signal []float32
+	for i := 0; i < 6; i++ {
+		signal = append(signal, period...)
+	}
+
+	first := oscilloscopeWindow(signal[:30], 8, AudioTriggerRising, 0.1)
+	for end := 31; end <= len(signal); end++ {
+		got := oscilloscopeWindow(signal[:end], 8, AudioTriggerRising, 0.1)
+		if !reflect.DeepEqual(got, first) {
+			t.Fatalf("window at %d = %v, want %v", end, got, first)
+		}
+	}
+}
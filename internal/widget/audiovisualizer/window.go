@@ -0,0 +1,30 @@
+package audiovisualizer
+
+import "math"
+
+// fftWindow returns the coefficients of the named window function for size
+// samples. Tapering the buffer ends before the FFT reduces spectral leakage,
+// which otherwise smears a single tone over neighbouring bars. "none" leaves
+// the samples as they are; unknown names fall back to "hann".
+func fftWindow(name string, size int) []float64 {
+	coeffs := make([]float64, size)
+	if size == 1 {
+		coeffs[0] = 1
+		return coeffs
+	}
+	n := float64(size - 1)
+	for i := range coeffs {
+		phase := 2 * math.Pi * float64(i) / n
+		switch name {
+		case AudioFFTWindowNone:
+			coeffs[i] = 1
+		case AudioFFTWindowHamming:
+			coeffs[i] = 0.54 - 0.46*math.Cos(phase)
+		case AudioFFTWindowBlackman:
+			coeffs[i] = 0.42 - 0.5*math.Cos(phase) + 0.08*math.Cos(2*phase)
+		default:
+			coeffs[i] = 0.5 * (1 - math.Cos(phase))
+		}
+	}
+	return coeffs
+}
@@ -0,0 +1,48 @@
+package audiovisualizer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFFTWindow(t *testing.T) {
+	tests := []struct {
+		name   string
+		window string
+		edge   float64 // First and last coefficient
+		middle float64 // Coefficient at the center of an odd-sized window
+	}{
+		{"none", AudioFFTWindowNone, 1, 1},
+		{"hann", AudioFFTWindowHann, 0, 1},
+		{"hamming", AudioFFTWindowHamming, 0.08, 1},
+		{"blackman", AudioFFTWindowBlackman, 0, 1},
+		{"unknown falls back to hann", "triangle", 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coeffs := fftWindow(tt.window, 9)
+			if len(coeffs) != 9 {
+				t.Fatalf("len = %d, want 9", len(coeffs))
+			}
+			if math.Abs(coeffs[0]-tt.edge) > 1e-9 || math.Abs(coeffs[8]-tt.edge) > 1e-9 {
+				t.Errorf("edges = %v, %v, want %v", coeffs[0], coeffs[8], tt.edge)
+			}
+			if math.Abs(coeffs[4]-tt.middle) > 1e-9 {
+				t.Errorf("middle = %v, want %v", coeffs[4], tt.middle)
+			}
+			// Windows are symmetric
+			for i := range coeffs {
+				if math.Abs(coeffs[i]-coeffs[8-i]) > 1e-9 {
+					t.Errorf("coeffs[%d] = %v, coeffs[%d] = %v, want equal", i, coeffs[i], 8-i, coeffs[8-i])
+				}
+			}
+		})
+	}
+}
+
+func TestFFTWindow_SingleSample(t *testing.T) {
+	if coeffs := fftWindow(AudioFFTWindowHann, 1); len(coeffs) != 1 || coeffs[0] != 1 {
+		t.Errorf("fftWindow(hann, 1) = %v, want [1]", coeffs)
+	}
+}
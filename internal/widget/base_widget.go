@@ -3,6 +3,7 @@ package widget
 import (
 	"image"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
@@ -24,7 +25,7 @@ type ContentArea struct {
 //   - Widget identification (ID/Name)
 //   - Position and size (GetPosition)
 //   - Style configuration (GetStyle)
-//   - Update interval timing
+//   - Update interval timing and optional redraw limiting
 //   - Auto-hide functionality for transient widgets
 //   - Padding and content area calculation
 //   - Canvas creation with background color
@@ -54,6 +55,9 @@ type BaseWidget struct {
 	position       config.PositionConfig
 	style          config.StyleConfig
 	updateInterval time.Duration
+	redrawOnUpdate bool
+	barPulse       bool        // Bar mode with a blink threshold animates between updates
+	updated        atomic.Bool // Set by MarkUpdated, cleared by NeedsRender
 	padding        int
 
 	// Auto-hide support
@@ -104,6 +108,8 @@ func NewBaseWidget(cfg config.WidgetConfig) *BaseWidget {
 		position:        cfg.Position,
 		style:           style,
 		updateInterval:  time.Duration(interval * float64(time.Second)),
+		redrawOnUpdate:  cfg.RedrawOnUpdate == nil || *cfg.RedrawOnUpdate,
+		barPulse:        cfg.Mode == config.ModeBar && cfg.Bar != nil && cfg.Bar.BlinkThreshold != nil && *cfg.Bar.BlinkThreshold >= 0,
 		padding:         padding,
 		autoHide:        autoHide,
 		autoHideTimeout: time.Duration(autoHideTimeout * float64(time.Second)),
@@ -123,6 +129,23 @@ func (b *BaseWidget) GetUpdateInterval() time.Duration {
 	return b.updateInterval
 }
 
+// MarkUpdated implements UpdateListener, flagging that the widget's data changed
+func (b *BaseWidget) MarkUpdated() {
+	b.updated.Store(true)
+}
+
+// NeedsRender implements ChangeReporter. By default a widget is only redrawn after a
+// successful update (clearing the flag), so it repaints once per update_interval.
+// Auto-hide widgets, pulsing bars and widgets with redraw_on_update set to false are
+// rendered on every frame. Widgets that animate between updates override this to always
+// return true.
+func (b *BaseWidget) NeedsRender() bool {
+	if !b.redrawOnUpdate || b.autoHide || b.barPulse {
+		return true
+	}
+	return b.updated.Swap(false)
+}
+
 // GetPosition returns the widget's position and dimensions.
 func (b *BaseWidget) GetPosition() config.PositionConfig {
 	return b.position
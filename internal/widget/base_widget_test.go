@@ -462,3 +462,55 @@ func TestBaseWidget_GetAutoHideTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseWidget_NeedsRender(t *testing.T) {
+	t.Run("redraws once per update by default", func(t *testing.T) {
+		base := NewBaseWidget(config.WidgetConfig{ID: "test"})
+
+		if base.NeedsRender() {
+			t.Error("NeedsRender() = true before any update")
+		}
+
+		base.MarkUpdated()
+		if !base.NeedsRender() {
+			t.Error("NeedsRender() = false after MarkUpdated")
+		}
+		if base.NeedsRender() {
+			t.Error("NeedsRender() should clear the update flag")
+		}
+	})
+
+	t.Run("redraw_on_update disabled renders every frame", func(t *testing.T) {
+		base := NewBaseWidget(config.WidgetConfig{ID: "test", RedrawOnUpdate: config.BoolPtr(false)})
+		for i := 0; i < 3; i++ {
+			if !base.NeedsRender() {
+				t.Fatal("NeedsRender() = false, want true with redraw_on_update false")
+			}
+		}
+	})
+
+	t.Run("auto-hide renders every frame", func(t *testing.T) {
+		base := NewBaseWidget(config.WidgetConfig{ID: "test", AutoHide: &config.AutoHideConfig{Enabled: true}})
+		for i := 0; i < 3; i++ {
+			if !base.NeedsRender() {
+				t.Fatal("NeedsRender() = false, want true with auto-hide")
+			}
+		}
+	})
+
+	t.Run("pulsing bar renders every frame", func(t *testing.T) {
+		base := NewBaseWidget(config.WidgetConfig{ID: "test", Mode: config.ModeBar, Bar: &config.BarConfig{BlinkThreshold: config.Float64Ptr(0)}})
+		for i := 0; i < 3; i++ {
+			if !base.NeedsRender() {
+				t.Fatal("NeedsRender() = false, want true with a bar blink threshold")
+			}
+		}
+	})
+
+	t.Run("negative blink threshold keeps update cadence", func(t *testing.T) {
+		base := NewBaseWidget(config.WidgetConfig{ID: "test", Mode: config.ModeBar, Bar: &config.BarConfig{BlinkThreshold: config.Float64Ptr(-1)}})
+		if base.NeedsRender() {
+			t.Error("NeedsRender() = true before any update with blinking disabled")
+		}
+	})
+}
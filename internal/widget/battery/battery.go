@@ -3,6 +3,8 @@ package battery
 import (
 	"fmt"
 	"image"
+	"image/color"
+	"image/draw"
 	"strings"
 	"sync"
 	"time"
@@ -10,7 +12,9 @@ import (
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
 	"github.com/pozitronik/steelclock-go/internal/shared/util"
 	"github.com/pozitronik/steelclock-go/internal/widget"
@@ -32,6 +36,13 @@ const (
 	indicatorModeNotifyBlink = "notify_blink"
 )
 
+// Critical battery alert modes
+const (
+	alertModeOff        = "off"
+	alertModeWidget     = "widget"
+	alertModeFullscreen = "fullscreen"
+)
+
 // Status represents the current battery state
 type Status struct {
 	Percentage    int  // 0-100
@@ -43,6 +54,15 @@ type Status struct {
 	TimeToFull    int  // Minutes to full charge (0 if unknown)
 }
 
+// graphSample is one point of the graph mode history
+type graphSample struct {
+	percentage int
+	charging   bool // A change between neighbouring samples is marked on the graph
+}
+
+// transitionMarkerColor is the dotted line marking a charge/discharge transition on the graph
+const transitionMarkerColor = 96
+
 // indicatorState tracks display settings and notify state for a power indicator
 type indicatorState struct {
 	mode           string        // "always", "never", "notify", "blink", "notify_blink"
@@ -63,12 +83,19 @@ type Widget struct {
 	chargingState indicatorState
 	pluggedState  indicatorState
 	economyState  indicatorState
+	blinkCfg      *config.BlinkConfig // Blink timing for "blink" and "notify_blink" modes
 
 	// Previous status for change detection (notify modes)
 	prevCharging bool
 	prevPlugged  bool
 	prevEconomy  bool
 
+	// Critical battery alert
+	alertMode     string        // "off", "widget", "fullscreen"
+	alertDuration time.Duration // how long the alert is shown after the level turns critical
+	alertUntil    time.Time     // when the current alert ends
+	prevCritical  bool
+
 	// Icon set for status indicators (selected based on widget size)
 	iconSet *glyphs.GlyphSet
 
@@ -86,7 +113,8 @@ type Widget struct {
 
 	// Graph mode
 	graphHistory int
-	history      *util.RingBuffer[int]
+	history      *util.RingBuffer[graphSample]
+	lastSample   time.Time // When the newest history sample was taken, to fill sampling gaps
 
 	// Font for text rendering
 	fontSize   int
@@ -102,6 +130,8 @@ type Widget struct {
 	gaugeNeedleColor uint8
 	gaugeShowTicks   bool
 	gaugeTicksColor  uint8
+	gaugeTickLabels  bool
+	gaugeLabelStep   int
 
 	// Bar settings
 	barDirection string
@@ -142,6 +172,8 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	chargingMode := indicatorModeAlways
 	pluggedMode := indicatorModeAlways
 	economyMode := indicatorModeBlink
+	alertMode := alertModeOff
+	alertDuration := 5 * time.Second
 
 	if cfg.PowerStatus != nil {
 		if cfg.PowerStatus.NotifyDuration > 0 {
@@ -156,6 +188,12 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		if cfg.PowerStatus.ShowEconomy != "" {
 			economyMode = cfg.PowerStatus.ShowEconomy
 		}
+		if cfg.PowerStatus.AlertMode != "" {
+			alertMode = cfg.PowerStatus.AlertMode
+		}
+		if cfg.PowerStatus.AlertDuration > 0 {
+			alertDuration = time.Duration(cfg.PowerStatus.AlertDuration) * time.Second
+		}
 	}
 
 	chargingState := indicatorState{mode: chargingMode, notifyDuration: notifyDuration}
@@ -250,6 +288,9 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		chargingState:     chargingState,
 		pluggedState:      pluggedState,
 		economyState:      economyState,
+		blinkCfg:          cfg.Blink,
+		alertMode:         alertMode,
+		alertDuration:     alertDuration,
 		iconSet:           iconSet,
 		lowThreshold:      lowThreshold,
 		criticalThreshold: criticalThreshold,
@@ -260,7 +301,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		colorBackground:   colorBackground,
 		colorBorder:       colorBorder,
 		graphHistory:      graphHistory,
-		history:           util.NewRingBuffer[int](graphHistory),
+		history:           util.NewRingBuffer[graphSample](graphHistory),
 		fontSize:          textSettings.FontSize,
 		fontName:          textSettings.FontName,
 		horizAlign:        textSettings.HorizAlign,
@@ -272,6 +313,8 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		gaugeNeedleColor:  uint8(gaugeSettings.NeedleColor),
 		gaugeShowTicks:    gaugeSettings.ShowTicks,
 		gaugeTicksColor:   uint8(gaugeSettings.TicksColor),
+		gaugeTickLabels:   gaugeSettings.TickLabels,
+		gaugeLabelStep:    gaugeSettings.LabelInterval,
 		barDirection:      barSettings.Direction,
 		barBorder:         barSettings.Border,
 		fillColor:         graphSettings.FillColor,
@@ -316,14 +359,63 @@ func (w *Widget) Update() error {
 	}
 	w.prevEconomy = status.IsEconomyMode
 
+	w.updateAlert(status, now)
+
 	w.currentStatus = status
 	w.hasData = true
-	// Add to history for graph mode
-	w.history.Push(status.Percentage)
+	w.recordSample(status, now)
 
 	return nil
 }
 
+// updateAlert starts the critical battery alert when the level drops to the critical
+// threshold on battery power, and dismisses it as soon as the charger is connected
+func (w *Widget) updateAlert(status Status, now time.Time) {
+	critical := status.HasBattery && !status.IsPluggedIn && !status.IsCharging && status.Percentage <= w.criticalThreshold
+	if critical && !w.prevCritical && w.alertMode != alertModeOff {
+		w.alertUntil = now.Add(w.alertDuration)
+	}
+	if status.IsPluggedIn || status.IsCharging {
+		w.alertUntil = time.Time{}
+	}
+	w.prevCritical = critical
+}
+
+// recordSample adds a reading to the graph history. Updates missed since the
+// previous sample (failed reads, system sleep) are filled by interpolating
+// between the two readings, so the graph keeps its time scale and a gap does
+// not show up as a vertical jump.
+func (w *Widget) recordSample(status Status, now time.Time) {
+	// Without a battery there is nothing to plot; the gap is bridged once it is back
+	if !status.HasBattery {
+		return
+	}
+
+	sample := graphSample{percentage: status.Percentage, charging: status.IsCharging}
+	interval := w.GetUpdateInterval()
+	if w.history.Len() > 0 && interval > 0 {
+		missed := int((now.Sub(w.lastSample)+interval/2)/interval) - 1
+		if missed > w.graphHistory {
+			missed = w.graphHistory
+		}
+		prev := w.history.Get(w.history.Len() - 1)
+		for i := 1; i <= missed; i++ {
+			w.history.Push(graphSample{
+				percentage: prev.percentage + (sample.percentage-prev.percentage)*i/(missed+1),
+				charging:   prev.charging,
+			})
+		}
+	}
+
+	w.history.Push(sample)
+	w.lastSample = now
+}
+
+// NeedsRender always returns true: status indicators blink and expire between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render renders the battery widget
 func (w *Widget) Render() (image.Image, error) {
 	// Create canvas with background and border
@@ -333,6 +425,7 @@ func (w *Widget) Render() (image.Image, error) {
 	w.mu.RLock()
 	status := w.currentStatus
 	hasData := w.hasData
+	alerting := w.alertMode == alertModeWidget && time.Now().Before(w.alertUntil)
 	w.mu.RUnlock()
 
 	if !hasData {
@@ -345,6 +438,11 @@ func (w *Widget) Render() (image.Image, error) {
 		return img, nil
 	}
 
+	if alerting {
+		w.drawAlert(img, status)
+		return img, nil
+	}
+
 	switch w.displayMode {
 	case config.ModeText:
 		w.renderText(img, status)
@@ -361,6 +459,46 @@ func (w *Widget) Render() (image.Image, error) {
 	return img, nil
 }
 
+// RenderOverlay implements widget.ExclusiveOverlay, taking over the display while
+// a fullscreen critical battery alert is active
+func (w *Widget) RenderOverlay(width, height int) (image.Image, error) {
+	if w.alertMode != alertModeFullscreen {
+		return nil, nil
+	}
+
+	w.mu.RLock()
+	status := w.currentStatus
+	alerting := time.Now().Before(w.alertUntil)
+	w.mu.RUnlock()
+
+	if !alerting {
+		return nil, nil
+	}
+
+	img := bitmap.NewGrayscaleImage(width, height, w.colorBackground)
+	w.drawAlert(img, status)
+	return img, nil
+}
+
+// drawAlert flashes a battery with the current percentage over the whole image
+func (w *Widget) drawAlert(img *image.Gray, status Status) {
+	bounds := img.Bounds()
+	draw.Draw(img, bounds, image.NewUniform(color.Gray{Y: w.colorBackground}), image.Point{}, draw.Src)
+	if !anim.BlinkVisible(time.Now(), w.blinkCfg) {
+		return
+	}
+
+	render.DrawBatteryShape(img, 0, 0, bounds.Dx(), bounds.Dy(), render.BatteryShapeConfig{
+		Orientation: config.DirectionHorizontal,
+		Percentage:  status.Percentage,
+		FillColor:   w.colorCritical,
+		BorderColor: w.colorBorder,
+		Padding:     w.padding,
+	})
+	text := fmt.Sprintf("%d%%", status.Percentage)
+	bitmap.SmartDrawAlignedText(img, text, w.fontFace, w.fontName, config.AlignCenter, config.AlignMiddle, w.padding)
+}
+
 // getColorForLevel returns the appropriate color based on battery level
 func (w *Widget) getColorForLevel(percentage int) uint8 {
 	if percentage <= w.criticalThreshold {
@@ -392,7 +530,7 @@ func (w *Widget) shouldShowIndicator(state *indicatorState, isActive bool) bool
 // shouldBlinkIndicator returns whether the indicator should blink (be hidden this frame)
 func (w *Widget) shouldBlinkIndicator(state *indicatorState) bool {
 	if state.mode == indicatorModeBlink || state.mode == indicatorModeNotifyBlink {
-		return time.Now().Second()%2 != 0
+		return !anim.BlinkVisible(time.Now(), w.blinkCfg)
 	}
 	return false
 }
@@ -444,6 +582,34 @@ func (w *Widget) drawStatusIcon(img *image.Gray, x, y int, status Status) {
 	bitmap.DrawGlyphWithBorder(img, icon, x, y, 0, 255)
 }
 
+// missingEstimate is shown by {time_remaining} and {time_to_full} when the OS has no estimate
+const missingEstimate = "--"
+
+// formatEstimate formats an OS runtime estimate like formatMinutes, or "--" when there is none
+func formatEstimate(minutes int) string {
+	if minutes <= 0 {
+		return missingEstimate
+	}
+	return formatMinutes(minutes)
+}
+
+// rateEstimate converts a capacity reading and a draw in matching units per hour to minutes
+func rateEstimate(now, full, rate int) (toEmpty, toFull int) {
+	// Some drivers report a negative current while discharging
+	if rate < 0 {
+		rate = -rate
+	}
+	if now <= 0 || rate <= 0 {
+		return 0, 0
+	}
+	// Readings are in micro units; widen before scaling so 32-bit builds don't overflow
+	toEmpty = int(int64(now) * 60 / int64(rate))
+	if full > now {
+		toFull = int(int64(full-now) * 60 / int64(rate))
+	}
+	return toEmpty, toFull
+}
+
 // formatMinutes formats a duration in minutes to a human-readable string
 func formatMinutes(minutes int) string {
 	if minutes <= 0 {
@@ -472,11 +638,11 @@ func (w *Widget) buildTokenFormatter(status Status) *render.TokenFormatter {
 	// Calculate full status text
 	statusFullText := ""
 	if w.shouldShowIndicator(&w.chargingState, status.IsCharging) && !w.shouldBlinkIndicator(&w.chargingState) {
-		statusFullText = "Charging"
+		statusFullText = i18n.T(i18n.Charging)
 	} else if w.shouldShowIndicator(&w.economyState, status.IsEconomyMode) && !w.shouldBlinkIndicator(&w.economyState) {
-		statusFullText = "Economy"
+		statusFullText = i18n.T(i18n.Economy)
 	} else if w.shouldShowIndicator(&w.pluggedState, status.IsPluggedIn) && !w.shouldBlinkIndicator(&w.pluggedState) {
-		statusFullText = "AC Power"
+		statusFullText = i18n.T(i18n.ACPower)
 	}
 
 	// Calculate smart time (to full if charging, to empty otherwise)
@@ -485,6 +651,17 @@ func (w *Widget) buildTokenFormatter(status Status) *render.TokenFormatter {
 		timeText = formatMinutes(status.TimeToFull)
 	}
 
+	// Estimates only apply in the current direction: no runtime while charging,
+	// no time to full once charged
+	timeRemaining := missingEstimate
+	if !status.IsCharging {
+		timeRemaining = formatEstimate(status.TimeToEmpty)
+	}
+	timeToFull := missingEstimate
+	if status.IsCharging && status.Percentage < 100 {
+		timeToFull = formatEstimate(status.TimeToFull)
+	}
+
 	// Calculate time_left_min
 	timeLeftMin := "-"
 	if status.TimeToEmpty > 0 {
@@ -522,7 +699,8 @@ func (w *Widget) buildTokenFormatter(status Status) *render.TokenFormatter {
 		Set("status_full", statusFullText).
 		Set("time", timeText).
 		Set("time_left", formatMinutes(status.TimeToEmpty)).
-		Set("time_to_full", formatMinutes(status.TimeToFull)).
+		Set("time_remaining", timeRemaining).
+		Set("time_to_full", timeToFull).
 		Set("time_left_min", timeLeftMin).
 		Set("level", level).
 		Set("charging", chargingStr).
@@ -602,6 +780,9 @@ func (w *Widget) renderGauge(img *image.Gray, status Status) {
 	// Use the existing DrawGauge function
 	bitmap.DrawGauge(img, 0, 0, pos.W, pos.H, float64(status.Percentage),
 		w.gaugeColor, w.gaugeNeedleColor, w.gaugeShowTicks, w.gaugeTicksColor)
+	if w.gaugeTickLabels {
+		bitmap.DrawGaugeTickLabels(img, 0, 0, pos.W, pos.H, w.gaugeLabelStep, w.gaugeTicksColor)
+	}
 
 	// Draw percentage text
 	if w.showPercentage {
@@ -622,10 +803,10 @@ func (w *Widget) renderGraph(img *image.Gray, status Status) {
 	historyData := w.history.ToSlice()
 	w.mu.RUnlock()
 
-	// Convert int slice to float64 slice for DrawGraph
+	// Convert samples to float64 slice for DrawGraph
 	floatHistory := make([]float64, len(historyData))
 	for i, v := range historyData {
-		floatHistory[i] = float64(v)
+		floatHistory[i] = float64(v.percentage)
 	}
 
 	graphX := w.padding
@@ -635,6 +816,7 @@ func (w *Widget) renderGraph(img *image.Gray, status Status) {
 
 	// Use existing DrawGraph function (values are 0-100 for percentage)
 	bitmap.DrawGraph(img, graphX, graphY, graphW, graphH, floatHistory, w.graphHistory, w.fillColor, w.lineColor)
+	w.drawTransitionMarkers(img, graphX, graphY, graphW, graphH, historyData)
 
 	// Draw current percentage
 	if w.showPercentage {
@@ -646,6 +828,28 @@ func (w *Widget) renderGraph(img *image.Gray, status Status) {
 	w.drawStatusIcon(img, w.padding+2, w.padding+2, status)
 }
 
+// drawTransitionMarkers draws a dotted vertical line wherever charging started or stopped.
+// Sample positions match the ones used by bitmap.DrawGraph.
+func (w *Widget) drawTransitionMarkers(img *image.Gray, x, y, width, height int, samples []graphSample) {
+	if len(samples) < 2 || w.graphHistory < 2 {
+		return
+	}
+	c := color.Gray{Y: transitionMarkerColor}
+	offset := w.graphHistory - len(samples)
+	for i := 1; i < len(samples); i++ {
+		if samples[i].charging == samples[i-1].charging {
+			continue
+		}
+		px := x + int(float64(offset+i)/float64(w.graphHistory-1)*float64(width))
+		if px >= x+width {
+			px = x + width - 1
+		}
+		for py := y; py < y+height; py += 2 {
+			img.SetGray(px, py, c)
+		}
+	}
+}
+
 // renderBattery renders battery as a large progressbar in battery shape
 func (w *Widget) renderBattery(img *image.Gray, status Status) {
 	pos := w.GetPosition()
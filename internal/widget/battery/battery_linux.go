@@ -112,12 +112,38 @@ func getBatteryStatus() (Status, error) {
 		result.TimeToFull = timeToFull / 60 // Convert seconds to minutes
 	}
 
+	// Most drivers don't report the times; derive them from the current rate
+	if result.TimeToEmpty == 0 || result.TimeToFull == 0 {
+		toEmpty, toFull := estimateFromRate(batteryPath)
+		if result.TimeToEmpty == 0 && !result.IsCharging {
+			result.TimeToEmpty = toEmpty
+		}
+		if result.TimeToFull == 0 && result.IsCharging {
+			result.TimeToFull = toFull
+		}
+	}
+
 	// Check for power saving / economy mode
 	result.IsEconomyMode = isPowerSavingMode()
 
 	return result, nil
 }
 
+// estimateFromRate returns minutes to empty and to full at the current power draw,
+// using energy (µWh, µW) or charge (µAh, µA) readings, whichever the driver exposes.
+// Zero means no estimate.
+func estimateFromRate(batteryPath string) (toEmpty, toFull int) {
+	now := readIntFile(filepath.Join(batteryPath, "energy_now"))
+	full := readIntFile(filepath.Join(batteryPath, "energy_full"))
+	rate := readIntFile(filepath.Join(batteryPath, "power_now"))
+	if now <= 0 || rate <= 0 {
+		now = readIntFile(filepath.Join(batteryPath, "charge_now"))
+		full = readIntFile(filepath.Join(batteryPath, "charge_full"))
+		rate = readIntFile(filepath.Join(batteryPath, "current_now"))
+	}
+	return rateEstimate(now, full, rate)
+}
+
 // isPowerSavingMode checks if power saving mode is active on Linux
 func isPowerSavingMode() bool {
 	// Try ACPI platform profile first (works on many modern laptops)
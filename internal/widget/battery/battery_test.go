@@ -1,10 +1,13 @@
 package battery
 
 import (
+	"image"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/widget"
 )
 
 func TestGetColorForLevel(t *testing.T) {
@@ -138,7 +141,7 @@ func TestShouldBlinkIndicator(t *testing.T) {
 		}
 	})
 
-	// Blink modes depend on time.Now().Second()%2, so we test behavior is deterministic
+	// Blink modes depend on the wall clock phase, so we only verify they run
 	// for both blink and notify_blink
 	t.Run("blink mode returns boolean", func(t *testing.T) {
 		state := &indicatorState{mode: indicatorModeBlink}
@@ -150,6 +153,25 @@ func TestShouldBlinkIndicator(t *testing.T) {
 		state := &indicatorState{mode: indicatorModeNotifyBlink}
 		_ = w.shouldBlinkIndicator(state)
 	})
+
+	t.Run("blink mode uses configured duty", func(t *testing.T) {
+		state := &indicatorState{mode: indicatorModeBlink}
+		// A short cycle must alternate between visible and hidden within the deadline
+		bw := &Widget{blinkCfg: &config.BlinkConfig{RateMs: 20, Duty: 0.5}}
+		sawHidden, sawVisible := false, false
+		deadline := time.Now().Add(time.Second)
+		for (!sawHidden || !sawVisible) && time.Now().Before(deadline) {
+			if bw.shouldBlinkIndicator(state) {
+				sawHidden = true
+			} else {
+				sawVisible = true
+			}
+			time.Sleep(time.Millisecond)
+		}
+		if !sawHidden || !sawVisible {
+			t.Errorf("20ms blink cycle should alternate within 1s (hidden=%v, visible=%v)", sawHidden, sawVisible)
+		}
+	})
 }
 
 func TestSelectBatteryIconSet(t *testing.T) {
@@ -200,6 +222,29 @@ func TestFormatMinutes(t *testing.T) {
 	}
 }
 
+func TestRateEstimate(t *testing.T) {
+	tests := []struct {
+		name                string
+		now, full, rate     int
+		wantEmpty, wantFull int
+	}{
+		{"half charged at 10 W", 25_000_000, 50_000_000, 10_000_000, 150, 150},
+		{"negative current", 2_000_000, 4_000_000, -1_000_000, 120, 120},
+		{"full", 50_000_000, 50_000_000, 10_000_000, 300, 0},
+		{"no draw", 25_000_000, 50_000_000, 0, 0, 0},
+		{"no reading", 0, 50_000_000, 10_000_000, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toEmpty, toFull := rateEstimate(tt.now, tt.full, tt.rate)
+			if toEmpty != tt.wantEmpty || toFull != tt.wantFull {
+				t.Errorf("rateEstimate() = %d, %d, want %d, %d", toEmpty, toFull, tt.wantEmpty, tt.wantFull)
+			}
+		})
+	}
+}
+
 func TestGetVisibleStatusIcon(t *testing.T) {
 	t.Run("charging has highest priority", func(t *testing.T) {
 		w := &Widget{
@@ -318,6 +363,36 @@ func TestExpandFormat(t *testing.T) {
 			Status{Percentage: 50, IsCharging: true, TimeToFull: 45, TimeToEmpty: 120},
 			"ETA: 45m",
 		},
+		{
+			"time remaining",
+			"{time_remaining}",
+			Status{Percentage: 50, TimeToEmpty: 135},
+			"2h 15m",
+		},
+		{
+			"time remaining unknown",
+			"{time_remaining}",
+			Status{Percentage: 50},
+			"--",
+		},
+		{
+			"time remaining while charging",
+			"{time_remaining}",
+			Status{Percentage: 50, IsCharging: true, TimeToEmpty: 135},
+			"--",
+		},
+		{
+			"time to full",
+			"{time_to_full}",
+			Status{Percentage: 50, IsCharging: true, TimeToFull: 45},
+			"45m",
+		},
+		{
+			"time to full when charged",
+			"{time_to_full}",
+			Status{Percentage: 100, IsPluggedIn: true, TimeToFull: 45},
+			"--",
+		},
 		{
 			"level normal",
 			"{level}",
@@ -550,3 +625,231 @@ func TestRender_AllModes(t *testing.T) {
 		})
 	}
 }
+
+func newGraphWidget(t *testing.T, history int) *Widget {
+	t.Helper()
+	w, err := New(config.WidgetConfig{
+		Type:     "battery",
+		Enabled:  config.BoolPtr(true),
+		Mode:     config.ModeGraph,
+		Position: config.PositionConfig{W: 64, H: 40},
+		Graph:    &config.GraphConfig{History: history},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return w
+}
+
+func historyPercentages(w *Widget) []int {
+	var got []int
+	for _, s := range w.history.ToSlice() {
+		got = append(got, s.percentage)
+	}
+	return got
+}
+
+func TestRecordSample(t *testing.T) {
+	start := time.Now()
+	interval := time.Second
+
+	t.Run("regular updates", func(t *testing.T) {
+		w := newGraphWidget(t, 10)
+		for i, pct := range []int{80, 79, 78} {
+			w.recordSample(Status{HasBattery: true, Percentage: pct}, start.Add(time.Duration(i)*interval))
+		}
+		if got := historyPercentages(w); !reflect.DeepEqual(got, []int{80, 79, 78}) {
+			t.Errorf("history = %v, want [80 79 78]", got)
+		}
+	})
+
+	t.Run("gap is interpolated", func(t *testing.T) {
+		w := newGraphWidget(t, 10)
+		w.recordSample(Status{HasBattery: true, Percentage: 80}, start)
+		w.recordSample(Status{HasBattery: true, Percentage: 76}, start.Add(4*interval))
+		if got := historyPercentages(w); !reflect.DeepEqual(got, []int{80, 79, 78, 77, 76}) {
+			t.Errorf("history = %v, want [80 79 78 77 76]", got)
+		}
+	})
+
+	t.Run("jitter is not a gap", func(t *testing.T) {
+		w := newGraphWidget(t, 10)
+		w.recordSample(Status{HasBattery: true, Percentage: 80}, start)
+		w.recordSample(Status{HasBattery: true, Percentage: 80}, start.Add(1400*time.Millisecond))
+		if got := w.history.Len(); got != 2 {
+			t.Errorf("history length = %d, want 2", got)
+		}
+	})
+
+	t.Run("long gap is capped", func(t *testing.T) {
+		w := newGraphWidget(t, 10)
+		w.recordSample(Status{HasBattery: true, Percentage: 80}, start)
+		w.recordSample(Status{HasBattery: true, Percentage: 20}, start.Add(time.Hour))
+		got := historyPercentages(w)
+		if len(got) != 10 || got[9] != 20 {
+			t.Errorf("history = %v, want 10 samples ending at 20", got)
+		}
+	})
+
+	t.Run("no battery is skipped", func(t *testing.T) {
+		w := newGraphWidget(t, 10)
+		w.recordSample(Status{HasBattery: true, Percentage: 80}, start)
+		w.recordSample(Status{HasBattery: false}, start.Add(interval))
+		w.recordSample(Status{HasBattery: true, Percentage: 78}, start.Add(2*interval))
+		if got := historyPercentages(w); !reflect.DeepEqual(got, []int{80, 79, 78}) {
+			t.Errorf("history = %v, want [80 79 78]", got)
+		}
+	})
+}
+
+func TestRenderGraph_TransitionMarker(t *testing.T) {
+	w := newGraphWidget(t, 5)
+	w.showPercentage = false
+	w.chargingState.mode = indicatorModeNever
+	w.pluggedState.mode = indicatorModeNever
+	w.economyState.mode = indicatorModeNever
+
+	start := time.Now()
+	for i, charging := range []bool{false, false, true, true, true} {
+		w.recordSample(Status{HasBattery: true, Percentage: 10, IsCharging: charging}, start.Add(time.Duration(i)*time.Second))
+	}
+	w.hasData = true
+	w.currentStatus = Status{HasBattery: true, Percentage: 10, IsCharging: true}
+
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	gray := img.(*image.Gray)
+
+	// The transition is at sample 2 of 5, i.e. halfway across; the top of the
+	// graph is empty because the line sits at 10%
+	markerX := 2 * 64 / 4
+	if got := gray.GrayAt(markerX, 0).Y; got != transitionMarkerColor {
+		t.Errorf("marker pixel = %d, want %d", got, transitionMarkerColor)
+	}
+	if got := gray.GrayAt(markerX-8, 0).Y; got != 0 {
+		t.Errorf("pixel away from marker = %d, want 0", got)
+	}
+}
+
+var _ widget.ExclusiveOverlay = (*Widget)(nil)
+
+func TestUpdateAlert(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		mode     string
+		statuses []Status
+		want     bool
+	}{
+		{
+			"drop to critical",
+			alertModeFullscreen,
+			[]Status{{HasBattery: true, Percentage: 11}, {HasBattery: true, Percentage: 10}},
+			true,
+		},
+		{
+			"first reading critical",
+			alertModeWidget,
+			[]Status{{HasBattery: true, Percentage: 5}},
+			true,
+		},
+		{
+			"off",
+			alertModeOff,
+			[]Status{{HasBattery: true, Percentage: 5}},
+			false,
+		},
+		{
+			"critical while plugged in",
+			alertModeFullscreen,
+			[]Status{{HasBattery: true, Percentage: 5, IsPluggedIn: true, IsCharging: true}},
+			false,
+		},
+		{
+			"dismissed when plugged in",
+			alertModeFullscreen,
+			[]Status{{HasBattery: true, Percentage: 5}, {HasBattery: true, Percentage: 5, IsPluggedIn: true}},
+			false,
+		},
+		{
+			"not repeated while critical",
+			alertModeFullscreen,
+			[]Status{{HasBattery: true, Percentage: 5}, {HasBattery: true, Percentage: 5, IsPluggedIn: true}, {HasBattery: true, Percentage: 5, IsPluggedIn: true}},
+			false,
+		},
+		{
+			"repeated after unplugging",
+			alertModeFullscreen,
+			[]Status{{HasBattery: true, Percentage: 5}, {HasBattery: true, Percentage: 5, IsPluggedIn: true}, {HasBattery: true, Percentage: 5}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Widget{alertMode: tt.mode, alertDuration: 5 * time.Second, criticalThreshold: 10}
+			for _, status := range tt.statuses {
+				w.updateAlert(status, now)
+			}
+			if got := now.Before(w.alertUntil); got != tt.want {
+				t.Errorf("alert active = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderOverlay(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "battery",
+		Enabled:  config.BoolPtr(true),
+		Position: config.PositionConfig{W: 32, H: 16},
+		PowerStatus: &config.PowerStatusConfig{
+			AlertMode:     "fullscreen",
+			AlertDuration: 3,
+		},
+	}
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if w.alertDuration != 3*time.Second {
+		t.Errorf("alertDuration = %v, want 3s", w.alertDuration)
+	}
+
+	img, err := w.RenderOverlay(128, 40)
+	if err != nil || img != nil {
+		t.Fatalf("RenderOverlay() without alert = %v, %v, want nil", img, err)
+	}
+
+	status := Status{HasBattery: true, Percentage: 5}
+	w.updateAlert(status, time.Now())
+	w.currentStatus = status
+	w.hasData = true
+
+	img, err = w.RenderOverlay(128, 40)
+	if err != nil {
+		t.Fatalf("RenderOverlay() error = %v", err)
+	}
+	if img == nil {
+		t.Fatal("RenderOverlay() = nil during alert")
+	}
+	if b := img.Bounds(); b.Dx() != 128 || b.Dy() != 40 {
+		t.Errorf("overlay size = %dx%d, want 128x40", b.Dx(), b.Dy())
+	}
+
+	// Fullscreen alerts leave the widget itself alone
+	widgetImg, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if b := widgetImg.Bounds(); b.Dx() != 32 {
+		t.Errorf("widget width = %d, want 32", b.Dx())
+	}
+
+	w.updateAlert(Status{HasBattery: true, Percentage: 5, IsPluggedIn: true}, time.Now())
+	if img, _ := w.RenderOverlay(128, 40); img != nil {
+		t.Error("RenderOverlay() still shown after plugging in")
+	}
+}
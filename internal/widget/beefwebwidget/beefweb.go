@@ -11,6 +11,7 @@ import (
 	"github.com/pozitronik/steelclock-go/internal/beefweb"
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/shared"
 	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
@@ -293,7 +294,7 @@ func (w *Widget) formatOutput(state *beefweb.PlayerState) string {
 		Set("album", track.Album).
 		Set("position", position).
 		Set("duration", duration).
-		Set("state", state.State.String())
+		Set("state", i18n.T(state.State.Message()))
 
 	return formatter.Format(w.format)
 }
@@ -309,6 +310,11 @@ func formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%02d:%02d", mins, secs)
 }
 
+// NeedsRender always returns true: track text scrolls between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render creates an image of the widget
 func (w *Widget) Render() (image.Image, error) {
 	// Check auto-hide
@@ -110,6 +110,9 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	// Parse format string
 	format := btCfg.Format
 	tokens := parseBluetoothFormat(format)
+	if err := render.RejectTokenStyles(tokens); err != nil {
+		return nil, fmt.Errorf("format: %w", err)
+	}
 
 	// Colors (on/off like keyboard widget)
 	colorOn := 255
@@ -141,8 +144,8 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		iconSet = glyphs.BluetoothIcons8x8
 	}
 
-	// Create blink animator for "not found" state (always blink, 500ms)
-	blinkAnim := anim.NewBlinkAnimator(anim.BlinkAlways, 500*time.Millisecond)
+	// Create blink animator for "not found" state (always blink)
+	blinkAnim := anim.NewBlinkAnimatorFromConfig(anim.BlinkAlways, cfg.Blink)
 
 	return &Widget{
 		BaseWidget:          base,
@@ -161,7 +164,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		fontFace:            fontFace,
 		iconSet:             iconSet,
 		blink:               blinkAnim,
-		batteryBlink:        anim.NewBlinkAnimator(anim.BlinkAlways, 500*time.Millisecond),
+		batteryBlink:        anim.NewBlinkAnimatorFromConfig(anim.BlinkAlways, cfg.Blink),
 		httpClient:          &http.Client{Timeout: 3 * time.Second},
 		apiReachable:        true, // optimistic start
 		deviceFound:         true, // optimistic start
@@ -242,6 +245,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: connection and low-battery indicators blink between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render creates an image of the Bluetooth device status
 func (w *Widget) Render() (image.Image, error) {
 	img := w.CreateCanvas()
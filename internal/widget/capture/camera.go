@@ -0,0 +1,138 @@
+package capture
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"os/exec"
+	"regexp"
+	"sync"
+)
+
+// Camera frames are requested from ffmpeg at this fixed size (letterboxed to
+// keep the aspect ratio) and then scaled to the widget like screen frames.
+const (
+	cameraFrameWidth  = 320
+	cameraFrameHeight = 240
+)
+
+// cameraSource streams frames from a camera through a long-running ffmpeg
+// process. Opening a camera is slow, so unlike screen capture the device is
+// kept open and the latest frame is returned on each Capture call.
+type cameraSource struct {
+	cmd *exec.Cmd
+
+	mu     sync.Mutex
+	latest *image.RGBA
+	err    error
+
+	done chan struct{}
+}
+
+// newCameraSource starts ffmpeg streaming raw RGB frames from the camera.
+func newCameraSource(device string, fps int) (frameSource, error) {
+	if _, err := exec.LookPath("ffmpeg"); err != nil {
+		return nil, fmt.Errorf("ffmpeg not found: camera capture requires ffmpeg")
+	}
+
+	inputArgs, err := cameraInputArgs(device)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(inputArgs,
+		"-vf", fmt.Sprintf("fps=%d,scale=%d:%d:force_original_aspect_ratio=decrease,pad=%d:%d:(ow-iw)/2:(oh-ih)/2",
+			fps, cameraFrameWidth, cameraFrameHeight, cameraFrameWidth, cameraFrameHeight),
+		"-f", "rawvideo",
+		"-pix_fmt", "rgb24",
+		"-loglevel", "error",
+		"-",
+	)
+
+	cmd := exec.Command("ffmpeg", args...)
+	hideWindow(cmd)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ffmpeg output: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start ffmpeg: %w", err)
+	}
+
+	c := &cameraSource{
+		cmd:  cmd,
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(c.done)
+		err := readFrames(stdout, cameraFrameWidth, cameraFrameHeight, c.setFrame)
+		c.mu.Lock()
+		c.err = fmt.Errorf("camera stream ended: %w", err)
+		c.mu.Unlock()
+	}()
+
+	return c, nil
+}
+
+// setFrame stores the most recent camera frame.
+func (c *cameraSource) setFrame(img *image.RGBA) {
+	c.mu.Lock()
+	c.latest = img
+	c.mu.Unlock()
+}
+
+// Capture returns the most recent camera frame.
+// Returns nil without error until the first frame arrives.
+func (c *cameraSource) Capture() (*image.RGBA, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.latest, nil
+}
+
+// Close stops the ffmpeg process.
+func (c *cameraSource) Close() {
+	if c.cmd.Process != nil {
+		_ = c.cmd.Process.Kill()
+	}
+	<-c.done
+	_ = c.cmd.Wait()
+}
+
+// readFrames reads consecutive rgb24 frames of the given size from r and
+// passes each one to store as an RGBA image. Returns when r fails or ends.
+func readFrames(r io.Reader, width, height int, store func(*image.RGBA)) error {
+	buf := make([]byte, width*height*3)
+	for {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return err
+		}
+
+		img := image.NewRGBA(image.Rect(0, 0, width, height))
+		for i, j := 0, 0; i < len(buf); i, j = i+3, j+4 {
+			img.Pix[j] = buf[i]
+			img.Pix[j+1] = buf[i+1]
+			img.Pix[j+2] = buf[i+2]
+			img.Pix[j+3] = 255
+		}
+		store(img)
+	}
+}
+
+// dshowVideoDeviceRe matches a video device line of `ffmpeg -list_devices true -f dshow` output
+var dshowVideoDeviceRe = regexp.MustCompile(`"([^"]+)"\s+\(video\)`)
+
+// parseDShowVideoDevice returns the first DirectShow video device name from
+// ffmpeg device listing output, or an empty string if none is found.
+func parseDShowVideoDevice(output string) string {
+	if m := dshowVideoDeviceRe.FindStringSubmatch(output); m != nil {
+		return m[1]
+	}
+	return ""
+}
@@ -0,0 +1,19 @@
+//go:build linux
+
+package capture
+
+import "os/exec"
+
+// defaultCameraDevice is the first Video4Linux device
+const defaultCameraDevice = "/dev/video0"
+
+// cameraInputArgs returns ffmpeg input arguments for a Video4Linux camera.
+func cameraInputArgs(device string) ([]string, error) {
+	if device == "" {
+		device = defaultCameraDevice
+	}
+	return []string{"-f", "v4l2", "-i", device}, nil
+}
+
+// hideWindow is a no-op on Linux.
+func hideWindow(_ *exec.Cmd) {}
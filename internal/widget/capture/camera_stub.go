@@ -0,0 +1,16 @@
+//go:build !windows && !linux
+
+package capture
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// cameraInputArgs returns an error on unsupported platforms.
+func cameraInputArgs(_ string) ([]string, error) {
+	return nil, fmt.Errorf("camera capture is not supported on this platform")
+}
+
+// hideWindow is a no-op on unsupported platforms.
+func hideWindow(_ *exec.Cmd) {}
@@ -0,0 +1,33 @@
+//go:build windows
+
+package capture
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+)
+
+// createNoWindow prevents ffmpeg from opening a console window
+const createNoWindow = 0x08000000
+
+// cameraInputArgs returns ffmpeg input arguments for a DirectShow camera.
+// An empty device selects the first video device reported by ffmpeg.
+func cameraInputArgs(device string) ([]string, error) {
+	if device == "" {
+		cmd := exec.Command("ffmpeg", "-hide_banner", "-list_devices", "true", "-f", "dshow", "-i", "dummy")
+		hideWindow(cmd)
+		// ffmpeg exits with an error for the dummy input, the listing is still printed
+		output, _ := cmd.CombinedOutput()
+		device = parseDShowVideoDevice(string(output))
+		if device == "" {
+			return nil, fmt.Errorf("no DirectShow camera found")
+		}
+	}
+	return []string{"-f", "dshow", "-i", "video=" + device}, nil
+}
+
+// hideWindow hides the console window of a child process.
+func hideWindow(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true, CreationFlags: createNoWindow}
+}
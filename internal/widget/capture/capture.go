@@ -0,0 +1,282 @@
+// Package capture provides a widget that mirrors a screen region or webcam
+// frame at a low rate, converted to grayscale using the DOOM render modes.
+package capture
+
+import (
+	"fmt"
+	"image"
+	"sync"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+	"github.com/pozitronik/steelclock-go/internal/widget/screenmirror"
+)
+
+func init() {
+	widget.Register("capture", func(cfg config.WidgetConfig) (widget.Widget, error) {
+		return New(cfg)
+	})
+}
+
+// Capture source constants
+const (
+	SourceScreen = "screen"
+	SourceRegion = "region"
+	SourceCamera = "camera"
+)
+
+// Default configuration values
+const (
+	defaultFPS       = 2
+	defaultScaleMode = screenmirror.ScaleModeFit
+	minFPS           = 1
+	maxFPS           = 10
+)
+
+// frameSource abstracts where frames come from (screen, region or camera).
+// screenmirror.ScreenCapture satisfies this interface.
+type frameSource interface {
+	// Capture grabs the most recent frame.
+	Capture() (*image.RGBA, error)
+	// Close releases any resources held by the source.
+	Close()
+}
+
+// Config holds capture widget configuration.
+type Config struct {
+	Source     string
+	Display    screenmirror.DisplaySelector
+	Region     *screenmirror.CaptureRegion
+	Camera     string
+	FPS        int
+	ScaleMode  screenmirror.ScaleMode
+	RenderOpts bitmap.RenderModeOptions
+}
+
+// Widget displays captured screen or camera content on the OLED display.
+type Widget struct {
+	*widget.BaseWidget
+
+	cfg    Config
+	source frameSource
+
+	// Current frame
+	currentImg *image.Gray
+	mu         sync.RWMutex
+
+	// Capture loop
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New creates a new capture widget.
+func New(cfg config.WidgetConfig) (*Widget, error) {
+	base := widget.NewBaseWidget(cfg)
+
+	captureCfg, err := parseConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := newSource(captureCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s capture: %w", captureCfg.Source, err)
+	}
+
+	w := &Widget{
+		BaseWidget: base,
+		cfg:        captureCfg,
+		source:     source,
+		stopCh:     make(chan struct{}),
+	}
+
+	// Start capture loop
+	w.wg.Add(1)
+	go w.captureLoop()
+
+	return w, nil
+}
+
+// parseConfig extracts configuration from WidgetConfig.
+func parseConfig(cfg config.WidgetConfig) (Config, error) {
+	captureCfg := Config{
+		Source:     SourceScreen,
+		FPS:        defaultFPS,
+		ScaleMode:  defaultScaleMode,
+		RenderOpts: bitmap.NewRenderModeOptions(nil),
+	}
+
+	c := cfg.Capture
+	if c == nil {
+		return captureCfg, nil
+	}
+
+	if c.Source != "" {
+		captureCfg.Source = c.Source
+	}
+
+	// Parse display selector (can be integer index or string name)
+	if c.Display != nil {
+		if c.Display.IsString {
+			captureCfg.Display.Name = c.Display.StringValue
+		} else {
+			index := c.Display.IntValue
+			captureCfg.Display.Index = &index
+		}
+	}
+
+	if c.Region != nil {
+		captureCfg.Region = &screenmirror.CaptureRegion{
+			X: c.Region.X,
+			Y: c.Region.Y,
+			W: c.Region.W,
+			H: c.Region.H,
+		}
+	}
+
+	captureCfg.Camera = c.Camera
+
+	if c.FPS > 0 {
+		captureCfg.FPS = c.FPS
+		if captureCfg.FPS < minFPS {
+			captureCfg.FPS = minFPS
+		} else if captureCfg.FPS > maxFPS {
+			captureCfg.FPS = maxFPS
+		}
+	}
+
+	if c.ScaleMode != "" {
+		captureCfg.ScaleMode = screenmirror.ScaleMode(c.ScaleMode)
+	}
+
+	// Reuse DOOM render mode parsing (defaults and clamping)
+	captureCfg.RenderOpts = bitmap.NewRenderModeOptions(&config.DoomConfig{
+		RenderMode:      c.RenderMode,
+		PosterizeLevels: c.PosterizeLevels,
+		ThresholdValue:  c.ThresholdValue,
+		Gamma:           c.Gamma,
+		ContrastBoost:   c.ContrastBoost,
+		DitherSize:      c.DitherSize,
+	})
+
+	switch captureCfg.Source {
+	case SourceScreen, SourceCamera:
+	case SourceRegion:
+		if captureCfg.Region == nil || captureCfg.Region.W <= 0 || captureCfg.Region.H <= 0 {
+			return captureCfg, fmt.Errorf("capture source %q requires region with positive w and h", SourceRegion)
+		}
+	default:
+		return captureCfg, fmt.Errorf("invalid capture source %q (valid: %s, %s, %s)", captureCfg.Source, SourceScreen, SourceRegion, SourceCamera)
+	}
+
+	return captureCfg, nil
+}
+
+// newSource creates the frame source for the configured capture source.
+func newSource(cfg Config) (frameSource, error) {
+	switch cfg.Source {
+	case SourceCamera:
+		return newCameraSource(cfg.Camera, cfg.FPS)
+	case SourceRegion:
+		return newScreenSource(screenmirror.CaptureConfig{Region: cfg.Region})
+	default:
+		return newScreenSource(screenmirror.CaptureConfig{Display: cfg.Display})
+	}
+}
+
+// captureLoop captures frames at the configured FPS.
+func (w *Widget) captureLoop() {
+	defer w.wg.Done()
+
+	interval := time.Second / time.Duration(w.cfg.FPS)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Capture immediately so the widget does not stay blank for a whole interval
+	w.captureFrame()
+
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+			w.captureFrame()
+		}
+	}
+}
+
+// captureFrame captures a single frame, scales it and applies the render mode.
+func (w *Widget) captureFrame() {
+	frame, err := w.source.Capture()
+	if err != nil || frame == nil {
+		return
+	}
+
+	contentArea := w.GetContentArea()
+	if contentArea.Width <= 0 || contentArea.Height <= 0 {
+		return
+	}
+
+	processed := processFrame(frame, contentArea.Width, contentArea.Height, w.cfg.ScaleMode, w.cfg.RenderOpts)
+
+	w.mu.Lock()
+	w.currentImg = processed
+	w.mu.Unlock()
+}
+
+// processFrame downscales a captured frame to the target size and converts it
+// to the display palette using the configured render mode.
+func processFrame(frame image.Image, width, height int, mode screenmirror.ScaleMode, opts bitmap.RenderModeOptions) *image.Gray {
+	scaled := screenmirror.ScaleImage(frame, width, height, mode)
+	bitmap.ApplyRenderMode(scaled, opts)
+	return scaled
+}
+
+// Update is called at the widget's update interval.
+func (w *Widget) Update() error {
+	// Frame capture is handled in the capture loop goroutine
+	return nil
+}
+
+// NeedsRender always returns true: frames are captured in the background.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
+// Render creates an image of the current captured content.
+func (w *Widget) Render() (image.Image, error) {
+	if w.ShouldHide() {
+		return nil, nil
+	}
+
+	img := w.CreateCanvas()
+	w.ApplyBorder(img)
+
+	contentArea := w.GetContentArea()
+
+	w.mu.RLock()
+	frame := w.currentImg
+	w.mu.RUnlock()
+
+	if frame != nil {
+		for y := 0; y < frame.Bounds().Dy() && y < contentArea.Height; y++ {
+			for x := 0; x < frame.Bounds().Dx() && x < contentArea.Width; x++ {
+				img.SetGray(contentArea.X+x, contentArea.Y+y, frame.GrayAt(x, y))
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// Stop stops capturing and releases the capture source.
+func (w *Widget) Stop() {
+	close(w.stopCh)
+	w.wg.Wait()
+
+	if w.source != nil {
+		w.source.Close()
+	}
+}
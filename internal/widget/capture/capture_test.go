@@ -0,0 +1,293 @@
+package capture
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+	"github.com/pozitronik/steelclock-go/internal/widget/screenmirror"
+)
+
+func TestParseConfig_Defaults(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "capture",
+		Position: config.PositionConfig{W: 128, H: 40},
+	}
+
+	result, err := parseConfig(cfg)
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+
+	if result.Source != SourceScreen {
+		t.Errorf("Source = %q, want %q", result.Source, SourceScreen)
+	}
+	if result.FPS != defaultFPS {
+		t.Errorf("FPS = %d, want %d", result.FPS, defaultFPS)
+	}
+	if result.ScaleMode != screenmirror.ScaleModeFit {
+		t.Errorf("ScaleMode = %q, want %q", result.ScaleMode, screenmirror.ScaleModeFit)
+	}
+	if result.RenderOpts.Mode != bitmap.RenderModeNormal {
+		t.Errorf("RenderOpts.Mode = %q, want %q", result.RenderOpts.Mode, bitmap.RenderModeNormal)
+	}
+}
+
+func TestParseConfig_CustomValues(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "capture",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Capture: &config.CaptureConfig{
+			Source:         SourceRegion,
+			Region:         &config.ScreenMirrorRegionConfig{X: 10, Y: 20, W: 300, H: 100},
+			FPS:            5,
+			ScaleMode:      "crop",
+			RenderMode:     "threshold",
+			ThresholdValue: 90,
+		},
+	}
+
+	result, err := parseConfig(cfg)
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+
+	if result.Region == nil || *result.Region != (screenmirror.CaptureRegion{X: 10, Y: 20, W: 300, H: 100}) {
+		t.Errorf("Region = %+v, want {10 20 300 100}", result.Region)
+	}
+	if result.FPS != 5 {
+		t.Errorf("FPS = %d, want 5", result.FPS)
+	}
+	if result.ScaleMode != screenmirror.ScaleModeCrop {
+		t.Errorf("ScaleMode = %q, want crop", result.ScaleMode)
+	}
+	if result.RenderOpts.Mode != bitmap.RenderModeThreshold || result.RenderOpts.ThresholdValue != 90 {
+		t.Errorf("RenderOpts = %+v, want threshold 90", result.RenderOpts)
+	}
+}
+
+func TestParseConfig_Display(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Capture: &config.CaptureConfig{
+			Display: &config.IntOrString{StringValue: "DISPLAY2", IsString: true},
+		},
+	}
+
+	result, err := parseConfig(cfg)
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if result.Display.Name != "DISPLAY2" || result.Display.Index != nil {
+		t.Errorf("Display = %+v, want name DISPLAY2", result.Display)
+	}
+
+	cfg.Capture.Display = &config.IntOrString{IntValue: 1}
+	result, err = parseConfig(cfg)
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if result.Display.Index == nil || *result.Display.Index != 1 {
+		t.Errorf("Display.Index = %v, want 1", result.Display.Index)
+	}
+}
+
+func TestParseConfig_FPSClamping(t *testing.T) {
+	tests := []struct {
+		name    string
+		fps     int
+		wantFPS int
+	}{
+		{"unset uses default", 0, defaultFPS},
+		{"in range", 4, 4},
+		{"too high", 60, maxFPS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.WidgetConfig{
+				Capture: &config.CaptureConfig{FPS: tt.fps},
+			}
+
+			result, err := parseConfig(cfg)
+			if err != nil {
+				t.Fatalf("parseConfig() error = %v", err)
+			}
+			if result.FPS != tt.wantFPS {
+				t.Errorf("FPS = %d, want %d", result.FPS, tt.wantFPS)
+			}
+		})
+	}
+}
+
+func TestParseConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		capture *config.CaptureConfig
+	}{
+		{"invalid source", &config.CaptureConfig{Source: "window"}},
+		{"region without bounds", &config.CaptureConfig{Source: SourceRegion}},
+		{"region with zero size", &config.CaptureConfig{
+			Source: SourceRegion,
+			Region: &config.ScreenMirrorRegionConfig{X: 0, Y: 0, W: 0, H: 10},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseConfig(config.WidgetConfig{Capture: tt.capture})
+			if err == nil {
+				t.Error("parseConfig() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestProcessFrame_AppliesRenderMode(t *testing.T) {
+	// Left half dark gray, right half light gray
+	src := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 40; x++ {
+			c := color.RGBA{R: 60, G: 60, B: 60, A: 255}
+			if x >= 20 {
+				c = color.RGBA{R: 200, G: 200, B: 200, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+
+	opts := bitmap.NewRenderModeOptions(&config.DoomConfig{RenderMode: "threshold"})
+	result := processFrame(src, 20, 10, screenmirror.ScaleModeStretch, opts)
+
+	if result.Bounds().Dx() != 20 || result.Bounds().Dy() != 10 {
+		t.Fatalf("result size = %v, want 20x10", result.Bounds())
+	}
+	if got := result.GrayAt(2, 5).Y; got != 0 {
+		t.Errorf("dark pixel = %d, want 0", got)
+	}
+	if got := result.GrayAt(17, 5).Y; got != 255 {
+		t.Errorf("light pixel = %d, want 255", got)
+	}
+}
+
+func TestReadFrames(t *testing.T) {
+	// Two 2x1 rgb24 frames followed by a truncated one
+	data := []byte{
+		255, 0, 0, 0, 255, 0,
+		0, 0, 255, 10, 20, 30,
+		1, 2,
+	}
+
+	var frames []*image.RGBA
+	err := readFrames(bytes.NewReader(data), 2, 1, func(img *image.RGBA) {
+		frames = append(frames, img)
+	})
+
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("readFrames() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if len(frames) != 2 {
+		t.Fatalf("got %d frames, want 2", len(frames))
+	}
+	if got := frames[0].RGBAAt(0, 0); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("frame 0 pixel 0 = %v, want red", got)
+	}
+	if got := frames[1].RGBAAt(1, 0); got != (color.RGBA{R: 10, G: 20, B: 30, A: 255}) {
+		t.Errorf("frame 1 pixel 1 = %v, want {10 20 30 255}", got)
+	}
+}
+
+func TestParseDShowVideoDevice(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{
+			name: "video and audio devices",
+			output: `[dshow @ 000001] "Microphone (Realtek Audio)" (audio)
+[dshow @ 000001] "Integrated Camera" (video)
+[dshow @ 000001]   Alternative name "@device_pnp_\\?\usb#vid"
+[dshow @ 000001] "OBS Virtual Camera" (video)`,
+			want: "Integrated Camera",
+		},
+		{
+			name:   "no video devices",
+			output: `[dshow @ 000001] "Microphone (Realtek Audio)" (audio)`,
+			want:   "",
+		},
+		{
+			name:   "empty output",
+			output: "",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseDShowVideoDevice(tt.output); got != tt.want {
+				t.Errorf("parseDShowVideoDevice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeSource is a frameSource returning a fixed frame
+type fakeSource struct {
+	frame  *image.RGBA
+	closed bool
+}
+
+func (f *fakeSource) Capture() (*image.RGBA, error) { return f.frame, nil }
+func (f *fakeSource) Close()                        { f.closed = true }
+
+func TestWidget_CaptureAndRender(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "capture",
+		ID:       "test_capture",
+		Position: config.PositionConfig{W: 16, H: 8},
+	}
+
+	frame := image.NewRGBA(image.Rect(0, 0, 32, 16))
+	for i := 0; i < len(frame.Pix); i++ {
+		frame.Pix[i] = 255
+	}
+	src := &fakeSource{frame: frame}
+
+	captureCfg, err := parseConfig(cfg)
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+
+	w := &Widget{
+		BaseWidget: widget.NewBaseWidget(cfg),
+		cfg:        captureCfg,
+		source:     src,
+		stopCh:     make(chan struct{}),
+	}
+	w.wg.Add(1)
+	go w.captureLoop()
+
+	// First frame is captured immediately when the loop starts
+	w.Stop()
+
+	if !src.closed {
+		t.Error("Stop() did not close the source")
+	}
+
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	gray, ok := img.(*image.Gray)
+	if !ok {
+		t.Fatalf("Render() returned %T, want *image.Gray", img)
+	}
+	if got := gray.GrayAt(8, 4).Y; got != 255 {
+		t.Errorf("center pixel = %d, want 255", got)
+	}
+}
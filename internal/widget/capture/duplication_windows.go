@@ -0,0 +1,373 @@
+//go:build windows
+
+package capture
+
+import (
+	"fmt"
+	"image"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// ---------------------------------------------------------------------------
+// Desktop Duplication API (DXGI 1.2) — screen capture
+// ---------------------------------------------------------------------------
+
+var (
+	d3d11DLL              = syscall.NewLazyDLL("d3d11.dll")
+	procD3D11CreateDevice = d3d11DLL.NewProc("D3D11CreateDevice")
+)
+
+// IID_IDXGIDevice = {54ec77fa-1377-44e6-8c32-88fd5f44c84c}
+// Stored as a GUID struct in little-endian byte order.
+var iidIDXGIDevice = [16]byte{
+	0xfa, 0x77, 0xec, 0x54, // Data1: 0x54ec77fa
+	0x77, 0x13, // Data2: 0x1377
+	0xe6, 0x44, // Data3: 0x44e6
+	0x8c, 0x32, 0x88, 0xfd, 0x5f, 0x44, 0xc8, 0x4c, // Data4
+}
+
+// IID_IDXGIOutput6 = {068346e8-aaec-4b84-add7-137f513f77a1}
+// IDXGIOutput6 (Windows 10 1703+) inherits DuplicateOutput from IDXGIOutput1.
+var iidIDXGIOutput6 = [16]byte{
+	0xe8, 0x46, 0x83, 0x06, // Data1: 0x068346e8
+	0xec, 0xaa, // Data2: 0xaaec
+	0x84, 0x4b, // Data3: 0x4b84
+	0xad, 0xd7, 0x13, 0x7f, 0x51, 0x3f, 0x77, 0xa1, // Data4
+}
+
+// IID_ID3D11Texture2D = {6f15aaf2-d208-4e89-9ab4-489535d34f9c}
+var iidID3D11Texture2D = [16]byte{
+	0xf2, 0xaa, 0x15, 0x6f, // Data1: 0x6f15aaf2
+	0x08, 0xd2, // Data2: 0xd208
+	0x89, 0x4e, // Data3: 0x4e89
+	0x9a, 0xb4, 0x48, 0x95, 0x35, 0xd3, 0x4f, 0x9c, // Data4
+}
+
+const (
+	d3dDriverTypeHardware = 1
+	d3d11SDKVersion       = 7
+	d3d11UsageStaging     = 3
+	d3d11CPUAccessRead    = 0x20000
+	d3d11MapRead          = 1
+	dxgiFormatB8G8R8A8    = 87
+
+	dxgiModeRotationUnspecified = 0
+	dxgiModeRotationIdentity    = 1
+
+	dxgiErrorAccessLost  = 0x887A0026
+	dxgiErrorWaitTimeout = 0x887A0027
+
+	// acquireTimeoutMs is how long AcquireNextFrame waits for a desktop update
+	acquireTimeoutMs = 50
+)
+
+// COM vtable indices used below
+const (
+	vtblQueryInterface = 0
+	vtblRelease        = 2
+
+	vtblDXGIDeviceGetAdapter   = 7  // IDXGIDevice::GetAdapter
+	vtblDXGIAdapterEnumOutputs = 7  // IDXGIAdapter::EnumOutputs
+	vtblDXGIOutputGetDesc      = 7  // IDXGIOutput::GetDesc
+	vtblDXGIOutputDuplicate    = 22 // IDXGIOutput1::DuplicateOutput
+
+	vtblDuplGetDesc          = 7  // IDXGIOutputDuplication::GetDesc
+	vtblDuplAcquireNextFrame = 8  // IDXGIOutputDuplication::AcquireNextFrame
+	vtblDuplReleaseFrame     = 14 // IDXGIOutputDuplication::ReleaseFrame
+
+	vtblDeviceCreateTexture2D = 5  // ID3D11Device::CreateTexture2D
+	vtblContextMap            = 14 // ID3D11DeviceContext::Map
+	vtblContextUnmap          = 15 // ID3D11DeviceContext::Unmap
+	vtblContextCopyResource   = 47 // ID3D11DeviceContext::CopyResource
+)
+
+// dxgiRect matches the Windows RECT struct layout.
+type dxgiRect struct {
+	Left, Top, Right, Bottom int32
+}
+
+// dxgiOutputDesc matches the DXGI_OUTPUT_DESC struct layout.
+type dxgiOutputDesc struct {
+	DeviceName         [32]uint16
+	DesktopCoordinates dxgiRect
+	AttachedToDesktop  int32
+	Rotation           uint32
+	Monitor            uintptr
+}
+
+// dxgiOutduplDesc matches the DXGI_OUTDUPL_DESC struct layout.
+type dxgiOutduplDesc struct {
+	Width                      uint32
+	Height                     uint32
+	RefreshRateNumerator       uint32
+	RefreshRateDenominator     uint32
+	Format                     uint32
+	ScanlineOrdering           uint32
+	Scaling                    uint32
+	Rotation                   uint32
+	DesktopImageInSystemMemory int32
+}
+
+// dxgiOutduplFrameInfo matches the DXGI_OUTDUPL_FRAME_INFO struct layout.
+type dxgiOutduplFrameInfo struct {
+	LastPresentTime           int64
+	LastMouseUpdateTime       int64
+	AccumulatedFrames         uint32
+	RectsCoalesced            int32
+	ProtectedContentMaskedOut int32
+	PointerX                  int32
+	PointerY                  int32
+	PointerVisible            int32
+	TotalMetadataBufferSize   uint32
+	PointerShapeBufferSize    uint32
+}
+
+// d3d11Texture2DDesc matches the D3D11_TEXTURE2D_DESC struct layout.
+type d3d11Texture2DDesc struct {
+	Width          uint32
+	Height         uint32
+	MipLevels      uint32
+	ArraySize      uint32
+	Format         uint32
+	SampleCount    uint32
+	SampleQuality  uint32
+	Usage          uint32
+	BindFlags      uint32
+	CPUAccessFlags uint32
+	MiscFlags      uint32
+}
+
+// d3d11MappedSubresource matches the D3D11_MAPPED_SUBRESOURCE struct layout.
+type d3d11MappedSubresource struct {
+	Data       unsafe.Pointer
+	RowPitch   uint32
+	DepthPitch uint32
+}
+
+// comCall invokes a COM method at the given vtable index on the object.
+// The object pointer is passed as the first argument (the implicit "this" in COM).
+func comCall(obj unsafe.Pointer, vtblIndex uintptr, args ...uintptr) uintptr {
+	vtbl := *(*unsafe.Pointer)(obj)
+	method := *(*uintptr)(unsafe.Add(vtbl, vtblIndex*unsafe.Sizeof(uintptr(0))))
+	allArgs := append([]uintptr{uintptr(obj)}, args...)
+	ret, _, _ := syscall.SyscallN(method, allArgs...)
+	return ret
+}
+
+// comRelease calls IUnknown::Release on a COM object if it is set.
+func comRelease(obj unsafe.Pointer) {
+	if obj != nil {
+		comCall(obj, vtblRelease)
+	}
+}
+
+// duplicationCapture captures a desktop area using the Desktop Duplication API.
+// Duplication is per output, so the area must lie within a single monitor
+// attached to the default adapter.
+type duplicationCapture struct {
+	mu sync.Mutex
+
+	device  unsafe.Pointer // ID3D11Device
+	context unsafe.Pointer // ID3D11DeviceContext
+	output  unsafe.Pointer // IDXGIOutput6
+	dupl    unsafe.Pointer // IDXGIOutputDuplication
+	staging unsafe.Pointer // ID3D11Texture2D (CPU readable copy of the desktop)
+
+	outputBounds image.Rectangle // Output area in virtual desktop coordinates
+	bounds       image.Rectangle // Captured area in virtual desktop coordinates
+	texWidth     int
+	texHeight    int
+
+	// Last captured frame, returned when the desktop has not changed
+	last *image.RGBA
+}
+
+// newDuplicationCapture creates a Desktop Duplication capture for the given
+// area in virtual desktop coordinates.
+func newDuplicationCapture(bounds image.Rectangle) (*duplicationCapture, error) {
+	if bounds.Empty() {
+		return nil, fmt.Errorf("empty capture area")
+	}
+
+	d := &duplicationCapture{bounds: bounds}
+	if err := d.init(); err != nil {
+		d.Close()
+		return nil, err
+	}
+	return d, nil
+}
+
+// init creates the D3D11 device and finds the output containing the capture area.
+func (d *duplicationCapture) init() error {
+	ret, _, _ := procD3D11CreateDevice.Call(
+		0, // default adapter
+		d3dDriverTypeHardware,
+		0, 0, // no software rasterizer, no flags
+		0, 0, // default feature levels
+		d3d11SDKVersion,
+		uintptr(unsafe.Pointer(&d.device)),
+		0,
+		uintptr(unsafe.Pointer(&d.context)),
+	)
+	if ret != 0 || d.device == nil {
+		return fmt.Errorf("D3D11CreateDevice failed: 0x%x", uint32(ret))
+	}
+
+	var dxgiDevice unsafe.Pointer
+	if hr := comCall(d.device, vtblQueryInterface, uintptr(unsafe.Pointer(&iidIDXGIDevice)), uintptr(unsafe.Pointer(&dxgiDevice))); hr != 0 {
+		return fmt.Errorf("IDXGIDevice not supported: 0x%x", uint32(hr))
+	}
+	defer comRelease(dxgiDevice)
+
+	var adapter unsafe.Pointer
+	if hr := comCall(dxgiDevice, vtblDXGIDeviceGetAdapter, uintptr(unsafe.Pointer(&adapter))); hr != 0 {
+		return fmt.Errorf("GetAdapter failed: 0x%x", uint32(hr))
+	}
+	defer comRelease(adapter)
+
+	for i := uintptr(0); ; i++ {
+		var output unsafe.Pointer
+		if comCall(adapter, vtblDXGIAdapterEnumOutputs, i, uintptr(unsafe.Pointer(&output))) != 0 {
+			break // DXGI_ERROR_NOT_FOUND or other error
+		}
+
+		var desc dxgiOutputDesc
+		comCall(output, vtblDXGIOutputGetDesc, uintptr(unsafe.Pointer(&desc)))
+		r := desc.DesktopCoordinates
+		outputBounds := image.Rect(int(r.Left), int(r.Top), int(r.Right), int(r.Bottom))
+
+		if d.bounds.In(outputBounds) {
+			hr := comCall(output, vtblQueryInterface, uintptr(unsafe.Pointer(&iidIDXGIOutput6)), uintptr(unsafe.Pointer(&d.output)))
+			comRelease(output)
+			if hr != 0 {
+				return fmt.Errorf("IDXGIOutput6 not supported: 0x%x", uint32(hr))
+			}
+			d.outputBounds = outputBounds
+			break
+		}
+		comRelease(output)
+	}
+
+	if d.output == nil {
+		return fmt.Errorf("capture area %v is not within a single output of the default adapter", d.bounds)
+	}
+
+	return d.duplicate()
+}
+
+// duplicate (re)creates the output duplication and the staging texture.
+// Called on init and after DXGI_ERROR_ACCESS_LOST (mode change, desktop switch).
+func (d *duplicationCapture) duplicate() error {
+	comRelease(d.dupl)
+	d.dupl = nil
+	comRelease(d.staging)
+	d.staging = nil
+
+	if hr := comCall(d.output, vtblDXGIOutputDuplicate, uintptr(d.device), uintptr(unsafe.Pointer(&d.dupl))); hr != 0 {
+		return fmt.Errorf("DuplicateOutput failed: 0x%x", uint32(hr))
+	}
+
+	var desc dxgiOutduplDesc
+	comCall(d.dupl, vtblDuplGetDesc, uintptr(unsafe.Pointer(&desc)))
+	if desc.Rotation != dxgiModeRotationUnspecified && desc.Rotation != dxgiModeRotationIdentity {
+		return fmt.Errorf("rotated outputs are not supported")
+	}
+
+	texDesc := d3d11Texture2DDesc{
+		Width:          desc.Width,
+		Height:         desc.Height,
+		MipLevels:      1,
+		ArraySize:      1,
+		Format:         dxgiFormatB8G8R8A8,
+		SampleCount:    1,
+		Usage:          d3d11UsageStaging,
+		CPUAccessFlags: d3d11CPUAccessRead,
+	}
+	if hr := comCall(d.device, vtblDeviceCreateTexture2D, uintptr(unsafe.Pointer(&texDesc)), 0, uintptr(unsafe.Pointer(&d.staging))); hr != 0 {
+		return fmt.Errorf("CreateTexture2D failed: 0x%x", uint32(hr))
+	}
+
+	d.texWidth = int(desc.Width)
+	d.texHeight = int(desc.Height)
+	return nil
+}
+
+// Capture acquires the next desktop frame and copies the capture area.
+// Returns the previous frame if the desktop has not changed.
+func (d *duplicationCapture) Capture() (*image.RGBA, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var info dxgiOutduplFrameInfo
+	var resource unsafe.Pointer
+	hr := comCall(d.dupl, vtblDuplAcquireNextFrame, acquireTimeoutMs, uintptr(unsafe.Pointer(&info)), uintptr(unsafe.Pointer(&resource)))
+	switch uint32(hr) {
+	case 0:
+	case dxgiErrorWaitTimeout:
+		return d.last, nil
+	case dxgiErrorAccessLost:
+		if err := d.duplicate(); err != nil {
+			return nil, err
+		}
+		return d.last, nil
+	default:
+		return nil, fmt.Errorf("AcquireNextFrame failed: 0x%x", uint32(hr))
+	}
+	defer comCall(d.dupl, vtblDuplReleaseFrame)
+
+	var texture unsafe.Pointer
+	hr = comCall(resource, vtblQueryInterface, uintptr(unsafe.Pointer(&iidID3D11Texture2D)), uintptr(unsafe.Pointer(&texture)))
+	comRelease(resource)
+	if hr != 0 {
+		return nil, fmt.Errorf("desktop resource is not a texture: 0x%x", uint32(hr))
+	}
+	defer comRelease(texture)
+
+	comCall(d.context, vtblContextCopyResource, uintptr(d.staging), uintptr(texture))
+
+	var mapped d3d11MappedSubresource
+	if hr := comCall(d.context, vtblContextMap, uintptr(d.staging), 0, d3d11MapRead, 0, uintptr(unsafe.Pointer(&mapped))); hr != 0 {
+		return nil, fmt.Errorf("map staging texture failed: 0x%x", uint32(hr))
+	}
+	defer comCall(d.context, vtblContextUnmap, uintptr(d.staging), 0)
+
+	// Capture area relative to the output, clipped to the texture
+	area := d.bounds.Sub(d.outputBounds.Min).Intersect(image.Rect(0, 0, d.texWidth, d.texHeight))
+	if area.Empty() {
+		return nil, fmt.Errorf("capture area outside of output")
+	}
+
+	pitch := int(mapped.RowPitch)
+	src := unsafe.Slice((*byte)(mapped.Data), pitch*d.texHeight)
+
+	img := image.NewRGBA(image.Rect(0, 0, area.Dx(), area.Dy()))
+	for y := 0; y < area.Dy(); y++ {
+		srcRow := src[(area.Min.Y+y)*pitch+area.Min.X*4:]
+		dstRow := img.Pix[y*img.Stride:]
+		for x := 0; x < area.Dx(); x++ {
+			// Desktop image is BGRA, Go uses RGBA - swap channels
+			dstRow[x*4] = srcRow[x*4+2]
+			dstRow[x*4+1] = srcRow[x*4+1]
+			dstRow[x*4+2] = srcRow[x*4]
+			dstRow[x*4+3] = 255
+		}
+	}
+
+	d.last = img
+	return img, nil
+}
+
+// Close releases all COM objects.
+func (d *duplicationCapture) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	comRelease(d.staging)
+	comRelease(d.dupl)
+	comRelease(d.output)
+	comRelease(d.context)
+	comRelease(d.device)
+	d.staging, d.dupl, d.output, d.context, d.device = nil, nil, nil, nil, nil
+}
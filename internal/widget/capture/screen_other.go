@@ -0,0 +1,10 @@
+//go:build !windows
+
+package capture
+
+import "github.com/pozitronik/steelclock-go/internal/widget/screenmirror"
+
+// newScreenSource creates a screen capture using the screen_mirror backend.
+func newScreenSource(cfg screenmirror.CaptureConfig) (frameSource, error) {
+	return screenmirror.NewScreenCapture(cfg)
+}
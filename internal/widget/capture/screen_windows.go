@@ -0,0 +1,67 @@
+//go:build windows
+
+package capture
+
+import (
+	"image"
+	"log"
+
+	"github.com/pozitronik/steelclock-go/internal/widget/screenmirror"
+)
+
+// screenSource captures the screen using the Desktop Duplication API,
+// falling back to GDI (screen_mirror backend) when duplication is unavailable.
+type screenSource struct {
+	gdi screenmirror.ScreenCapture
+	dup *duplicationCapture
+}
+
+// newScreenSource creates a Windows screen capture.
+// The GDI backend resolves the display/region bounds, which are then captured
+// through Desktop Duplication if the area lies within a single output.
+func newScreenSource(cfg screenmirror.CaptureConfig) (frameSource, error) {
+	gdi, err := screenmirror.NewScreenCapture(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &screenSource{gdi: gdi}
+
+	info := gdi.GetDisplayInfo()
+	if info.Index == screenmirror.DisplayIndexAll && cfg.Region == nil {
+		// Duplication works per output; spanning all monitors needs GDI
+		return s, nil
+	}
+
+	bounds := image.Rect(info.X, info.Y, info.X+info.Width, info.Y+info.Height)
+	dup, err := newDuplicationCapture(bounds)
+	if err != nil {
+		log.Printf("[CAPTURE] Desktop Duplication unavailable, using GDI: %v", err)
+		return s, nil
+	}
+	s.dup = dup
+
+	return s, nil
+}
+
+// Capture grabs a frame, preferring Desktop Duplication over GDI.
+func (s *screenSource) Capture() (*image.RGBA, error) {
+	if s.dup != nil {
+		img, err := s.dup.Capture()
+		if err == nil {
+			return img, nil
+		}
+		log.Printf("[CAPTURE] Desktop Duplication failed, switching to GDI: %v", err)
+		s.dup.Close()
+		s.dup = nil
+	}
+	return s.gdi.Capture()
+}
+
+// Close releases both capture backends.
+func (s *screenSource) Close() {
+	if s.dup != nil {
+		s.dup.Close()
+	}
+	s.gdi.Close()
+}
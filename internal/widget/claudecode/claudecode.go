@@ -503,6 +503,11 @@ func (w *Widget) onStateChange(newState State) {
 	}
 }
 
+// NeedsRender always returns true: the status animations play between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws Clawd and notification
 func (w *Widget) Render() (image.Image, error) {
 	if w.ShouldHide() {
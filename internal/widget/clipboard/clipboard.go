@@ -135,6 +135,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		FontName:      textSettings.FontName,
 		HorizAlign:    textSettings.HorizAlign,
 		VertAlign:     textSettings.VertAlign,
+		Orientation:   textSettings.Orientation,
 		ScrollEnabled: clipCfg.ScrollLongText,
 		ScrollMode:    anim.ScrollPauseEnds,
 		ScrollGap:     20,
@@ -334,6 +335,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: long clipboard text scrolls between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render creates an image of the clipboard content.
 func (w *Widget) Render() (image.Image, error) {
 	// Check auto-hide
@@ -359,11 +365,12 @@ func (w *Widget) Render() (image.Image, error) {
 		contentArea.Y+contentArea.Height,
 	)
 
-	// Apply scrolling if enabled and text is too wide
+	// Apply scrolling if enabled and text is too long for the reading axis
 	var scrollOffset float64
 	if w.scroller != nil {
-		textWidth := w.textRenderer.MeasureTextWidth(content)
-		scrollOffset = w.scroller.Update(textWidth, contentArea.Width)
+		textLen, viewLen := w.textRenderer.ScrollExtent(content, bounds)
+		scrollOffset = w.scroller.Update(textLen, viewLen)
+		content = w.scroller.VisibleText(content)
 	}
 
 	w.textRenderer.Render(img, content, scrollOffset, bounds)
@@ -65,26 +65,25 @@ func (r *AnalogRenderer) Render(img *image.Gray, t time.Time, x, y, w, h int) er
 		faceC := color.Gray{Y: uint8(r.config.FaceColor)}
 		bitmap.DrawCircle(img, centerX, centerY, radius, faceC)
 
-		// Draw hour markers if enabled
-		if r.config.ShowTicks {
-			for hour := 0; hour < 12; hour++ {
-				angle := float64(hour) * 30.0 // 30 degrees per hour
-				rad := (angle - 90.0) * math.Pi / 180.0
-
-				// Outer point on circle
-				x1 := centerX + int(float64(radius)*math.Cos(rad))
-				y1 := centerY + int(float64(radius)*math.Sin(rad))
-
-				// Inner point (tick mark length)
-				tickLen := 2
-				if hour%3 == 0 {
-					tickLen = 4 // Longer ticks at 12, 3, 6, 9
-				}
-				x2 := centerX + int(float64(radius-tickLen)*math.Cos(rad))
-				y2 := centerY + int(float64(radius-tickLen)*math.Sin(rad))
+		// Draw hour markers if enabled; numerals fall back to ticks on a face too small for them
+		showTicks := r.config.ShowTicks
+		if r.config.Numerals != "" {
+			roman := r.config.Numerals == config.HourMarkersRoman
+			if !bitmap.DrawClockHourNumerals(img, centerX, centerY, radius, 0, r.config.LabelInterval, roman, uint8(r.config.FaceColor)) {
+				showTicks = true
+			}
+		}
+		if showTicks {
+			drawHourTicks(img, centerX, centerY, radius, faceC)
+		}
 
-				bitmap.DrawLine(img, x1, y1, x2, y2, faceC)
+		// Draw hour numbers if enabled, inside the longest ticks
+		if r.config.TickLabels {
+			tickLen := 0
+			if r.config.ShowTicks {
+				tickLen = 4
 			}
+			bitmap.DrawClockTickLabels(img, centerX, centerY, radius, tickLen, r.config.LabelInterval, uint8(r.config.FaceColor))
 		}
 
 		// Draw center dot
@@ -106,7 +105,11 @@ func (r *AnalogRenderer) Render(img *image.Gray, t time.Time, x, y, w, h int) er
 	// Subtract 90 to convert from 0=3 o'clock to 0=12 o'clock
 	hourAngle := (float64(hour)*30.0 + float64(minute)*0.5 - 90.0) * math.Pi / 180.0
 	minuteAngle := (float64(minute)*6.0 + float64(second)*0.1 - 90.0) * math.Pi / 180.0
-	secondAngle := (float64(second)*6.0 - 90.0) * math.Pi / 180.0
+	seconds := float64(second)
+	if r.config.SmoothSeconds {
+		seconds += float64(t.Nanosecond()) / float64(time.Second)
+	}
+	secondAngle := (seconds*6.0 - 90.0) * math.Pi / 180.0
 
 	// Draw hour hand (short and thick) if not transparent
 	if r.config.HourColor >= 0 {
@@ -138,6 +141,43 @@ func (r *AnalogRenderer) Render(img *image.Gray, t time.Time, x, y, w, h int) er
 	return nil
 }
 
+// drawHourTicks draws the twelve hour tick marks on the rim of the clock face
+func drawHourTicks(img *image.Gray, centerX, centerY, radius int, c color.Gray) {
+	for hour := 0; hour < 12; hour++ {
+		angle := float64(hour) * 30.0 // 30 degrees per hour
+		rad := (angle - 90.0) * math.Pi / 180.0
+
+		// Outer point on circle
+		x1 := centerX + int(float64(radius)*math.Cos(rad))
+		y1 := centerY + int(float64(radius)*math.Sin(rad))
+
+		// Inner point (tick mark length)
+		tickLen := 2
+		if hour%3 == 0 {
+			tickLen = 4 // Longer ticks at 12, 3, 6, 9
+		}
+		x2 := centerX + int(float64(radius-tickLen)*math.Cos(rad))
+		y2 := centerY + int(float64(radius-tickLen)*math.Sin(rad))
+
+		bitmap.DrawLine(img, x1, y1, x2, y2, c)
+	}
+}
+
+// Granularity returns time.Second: the minute hand advances with every second
+// even when the second hand is hidden. A visible sweeping second hand moves on
+// every frame, so it returns zero then.
+func (r *AnalogRenderer) Granularity() time.Duration {
+	if r.sweeping() {
+		return 0
+	}
+	return time.Second
+}
+
+// sweeping reports whether a smoothly sweeping second hand is drawn
+func (r *AnalogRenderer) sweeping() bool {
+	return r.config.SmoothSeconds && r.config.ShowSeconds && r.config.SecondColor >= 0
+}
+
 // NeedsUpdate returns false as analog mode has no animations
 func (r *AnalogRenderer) NeedsUpdate() bool {
 	return false
@@ -33,6 +33,14 @@ func (r *BinaryRenderer) Render(img *image.Gray, t time.Time, x, y, w, h int) er
 	return nil
 }
 
+// Granularity returns time.Second if the format shows seconds and time.Minute otherwise
+func (r *BinaryRenderer) Granularity() time.Duration {
+	if parseBinaryFormat(r.config.Format).showSeconds {
+		return time.Second
+	}
+	return time.Minute
+}
+
 // NeedsUpdate returns false as binary mode has no animations
 func (r *BinaryRenderer) NeedsUpdate() bool {
 	return false
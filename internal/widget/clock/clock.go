@@ -18,13 +18,16 @@ func init() {
 	})
 }
 
-// Widget displays current time using various display modes
+// Widget displays current time, a countdown or elapsed time using various display modes
 type Widget struct {
 	*widget.BaseWidget
 	displayMode DisplayMode
 	renderer    Renderer
+	source      *timeSource
 	currentTime time.Time
-	mu          sync.RWMutex // Protects currentTime field
+	renderedAt  time.Time    // currentTime truncated to the renderer granularity at the last Render
+	rendered    bool         // Whether Render has produced an image yet
+	mu          sync.RWMutex // Protects currentTime and render state
 }
 
 // New creates a new clock widget
@@ -40,19 +43,59 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		displayMode = ModeAnalog
 	}
 
+	source, err := newTimeSource(cfg.Clock)
+	if err != nil {
+		return nil, err
+	}
+	// Durations are always shown in 24-hour form
+	if source.isTimer() {
+		cfg = withoutTwelveHour(cfg)
+	}
+
 	// Create the appropriate renderer based on display mode
 	renderer, err := createRenderer(cfg, displayMode, helper)
 	if err != nil {
 		return nil, err
 	}
+	if source.isTimer() {
+		switch r := renderer.(type) {
+		case *TextRenderer:
+			r.config.Timer = true
+		case *SegmentRenderer:
+			r.config.Timer = true
+		}
+	}
 
 	return &Widget{
 		BaseWidget:  base,
 		displayMode: displayMode,
 		renderer:    renderer,
+		source:      source,
 	}, nil
 }
 
+// withoutTwelveHour returns a copy of cfg with 12-hour and AM/PM options disabled for all modes
+func withoutTwelveHour(cfg config.WidgetConfig) config.WidgetConfig {
+	if cfg.Text != nil {
+		text := *cfg.Text
+		text.Use12h, text.ShowAmPm = false, false
+		cfg.Text = &text
+	}
+	if cfg.Binary != nil {
+		binary := *cfg.Binary
+		binary.Use12h, binary.ShowAmPm = false, false
+		binary.Format = twentyFourHourFormat(binary.Format)
+		cfg.Binary = &binary
+	}
+	if cfg.Segment != nil {
+		segment := *cfg.Segment
+		segment.Use12h, segment.ShowAmPm = false, false
+		segment.Format = twentyFourHourFormat(segment.Format)
+		cfg.Segment = &segment
+	}
+	return cfg
+}
+
 // createRenderer creates the appropriate renderer based on display mode
 func createRenderer(cfg config.WidgetConfig, mode DisplayMode, helper *shared.ConfigHelper) (Renderer, error) {
 	switch mode {
@@ -106,6 +149,8 @@ func createTextRenderer(cfg config.WidgetConfig, helper *shared.ConfigHelper) (*
 		Format:     format,
 		Use12h:     use12h,
 		ShowAmPm:   showAmPm,
+
+		Orientation: textSettings.Orientation,
 	}), nil
 }
 
@@ -117,9 +162,31 @@ func createAnalogRenderer(cfg config.WidgetConfig, helper *shared.ConfigHelper)
 	// Analog mode settings
 	showSeconds := true
 	showTicks := true
+	tickLabels := false
+	labelInterval := bitmap.DefaultAnalogLabelInterval
+	numerals := ""
+	smoothSeconds := false
 	if cfg.Analog != nil {
 		showSeconds = cfg.Analog.ShowSeconds
+		smoothSeconds = cfg.Analog.SmoothSeconds
 		showTicks = cfg.Analog.ShowTicks
+		tickLabels = cfg.Analog.TickLabels
+
+		// Hour markers take precedence over the separate tick and label switches
+		switch cfg.Analog.HourMarkers {
+		case config.HourMarkersNone:
+			showTicks, tickLabels = false, false
+		case config.HourMarkersTicks:
+			showTicks, tickLabels = true, false
+		case config.HourMarkersNumbers, config.HourMarkersRoman:
+			showTicks, tickLabels = false, false
+			numerals = cfg.Analog.HourMarkers
+			labelInterval = 1 // All twelve hours
+		}
+
+		if cfg.Analog.LabelInterval > 0 {
+			labelInterval = cfg.Analog.LabelInterval
+		}
 	}
 
 	// Colors for analog mode (defaults to white)
@@ -152,6 +219,11 @@ func createAnalogRenderer(cfg config.WidgetConfig, helper *shared.ConfigHelper)
 		HourColor:   hourColor,
 		MinuteColor: minuteColor,
 		SecondColor: secondColor,
+
+		TickLabels:    tickLabels,
+		LabelInterval: labelInterval,
+		Numerals:      numerals,
+		SmoothSeconds: smoothSeconds,
 	}), nil
 }
 
@@ -191,6 +263,11 @@ func createBinaryRenderer(cfg config.WidgetConfig) *BinaryRenderer {
 		binaryConfig.ShowAmPm = cfg.Binary.ShowAmPm
 	}
 
+	// %I and %p in the format work like use_12h and show_ampm
+	use12h, showAmPm := formatTwelveHour(binaryConfig.Format)
+	binaryConfig.Use12h = binaryConfig.Use12h || use12h
+	binaryConfig.ShowAmPm = binaryConfig.ShowAmPm || showAmPm
+
 	return NewBinaryRenderer(binaryConfig)
 }
 
@@ -218,9 +295,7 @@ func createSegmentRenderer(cfg config.WidgetConfig) *SegmentRenderer {
 		if cfg.Segment.ColonStyle != "" {
 			segmentConfig.ColonStyle = cfg.Segment.ColonStyle
 		}
-		if cfg.Segment.ColonBlink != nil {
-			segmentConfig.ColonBlink = *cfg.Segment.ColonBlink
-		}
+		segmentConfig.ColonBlinkMs = colonBlinkMs(cfg.Segment)
 		if cfg.Segment.OnColor != nil {
 			segmentConfig.OnColor = *cfg.Segment.OnColor
 		}
@@ -242,13 +317,43 @@ func createSegmentRenderer(cfg config.WidgetConfig) *SegmentRenderer {
 		}
 	}
 
+	// %I and %p in the format work like use_12h and show_ampm. %p draws a single
+	// "A"/"P" letter unless another ampm_style is set explicitly.
+	use12h, showAmPm := formatTwelveHour(segmentConfig.Format)
+	segmentConfig.Use12h = segmentConfig.Use12h || use12h
+	if showAmPm {
+		segmentConfig.ShowAmPm = true
+		if cfg.Segment == nil || cfg.Segment.AmPmStyle == "" {
+			segmentConfig.AmPmStyle = ampmStyleLetter
+		}
+	}
+
 	return NewSegmentRenderer(segmentConfig)
 }
 
-// Update updates the current time
+// colonBlinkMs resolves the colon toggle interval: colon_blink_ms when set,
+// otherwise the legacy colon_blink switch (true = 1000 ms, false = steady)
+func colonBlinkMs(cfg *config.SegmentClockConfig) int {
+	switch {
+	case cfg.ColonBlinkMs != nil:
+		return max(*cfg.ColonBlinkMs, 0)
+	case cfg.ColonBlink != nil && !*cfg.ColonBlink:
+		return 0
+	default:
+		return defaultColonBlinkMs
+	}
+}
+
+// Update updates the displayed time from the configured source
 func (w *Widget) Update() error {
+	t := w.source.At(time.Now())
+	// Analog and binary faces only show a time of day: durations stop at 23:59:59
+	if w.source.isTimer() && (w.displayMode == ModeAnalog || w.displayMode == ModeBinary) {
+		t = clampToDay(t)
+	}
+
 	w.mu.Lock()
-	w.currentTime = time.Now()
+	w.currentTime = t
 	w.mu.Unlock()
 	return nil
 }
@@ -281,9 +386,39 @@ func (w *Widget) Render() (image.Image, error) {
 		return nil, fmt.Errorf("failed to render clock: %w", err)
 	}
 
+	w.mu.Lock()
+	w.renderedAt = truncateTime(currentTime, w.renderer.Granularity())
+	w.rendered = true
+	w.mu.Unlock()
+
 	return img, nil
 }
 
+// NeedsRender reports whether the displayed time unit has changed since the last Render.
+// A clock showing HH:MM stays clean for the rest of the minute; animations and
+// sub-second formats keep it dirty on every frame.
+func (w *Widget) NeedsRender() bool {
+	granularity := w.renderer.Granularity()
+	if granularity <= 0 || w.renderer.NeedsUpdate() {
+		return true
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if !w.rendered || w.currentTime.IsZero() {
+		return true
+	}
+	return !truncateTime(w.currentTime, granularity).Equal(w.renderedAt)
+}
+
+// truncateTime rounds t down to a multiple of granularity; zero granularity returns t unchanged
+func truncateTime(t time.Time, granularity time.Duration) time.Time {
+	if granularity <= 0 {
+		return t
+	}
+	return t.Truncate(granularity)
+}
+
 // NeedsUpdate returns true if the renderer needs faster refresh (e.g., during animations)
 func (w *Widget) NeedsUpdate() bool {
 	return w.renderer.NeedsUpdate()
@@ -3,8 +3,10 @@ package clock
 import (
 	"image"
 	"testing"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared"
 )
 
 func TestNew(t *testing.T) {
@@ -209,6 +211,72 @@ func TestWidget_Render_ClockFace(t *testing.T) {
 	}
 }
 
+func TestCreateAnalogRenderer_HourMarkers(t *testing.T) {
+	tests := []struct {
+		markers      string
+		interval     int
+		wantTicks    bool
+		wantLabels   bool
+		wantNumerals string
+		wantInterval int
+	}{
+		{"", 0, true, true, "", 3},
+		{config.HourMarkersNone, 0, false, false, "", 3},
+		{config.HourMarkersTicks, 0, true, false, "", 3},
+		{config.HourMarkersNumbers, 0, false, false, config.HourMarkersNumbers, 1},
+		{config.HourMarkersRoman, 0, false, false, config.HourMarkersRoman, 1},
+		{config.HourMarkersRoman, 3, false, false, config.HourMarkersRoman, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.markers, func(t *testing.T) {
+			cfg := config.WidgetConfig{
+				Type: "clock",
+				Analog: &config.AnalogConfig{
+					ShowTicks:     true,
+					TickLabels:    true,
+					HourMarkers:   tt.markers,
+					LabelInterval: tt.interval,
+				},
+			}
+			r, err := createAnalogRenderer(cfg, shared.NewConfigHelper(cfg))
+			if err != nil {
+				t.Fatalf("createAnalogRenderer() error = %v", err)
+			}
+			c := r.config
+			if c.ShowTicks != tt.wantTicks || c.TickLabels != tt.wantLabels || c.Numerals != tt.wantNumerals || c.LabelInterval != tt.wantInterval {
+				t.Errorf("config = ticks %v, labels %v, numerals %q, interval %d; want %v, %v, %q, %d",
+					c.ShowTicks, c.TickLabels, c.Numerals, c.LabelInterval,
+					tt.wantTicks, tt.wantLabels, tt.wantNumerals, tt.wantInterval)
+			}
+		})
+	}
+}
+
+func TestAnalogRenderer_RomanNumerals(t *testing.T) {
+	render := func(cfg AnalogConfig, size int) *image.Gray {
+		cfg.FaceColor = 255
+		cfg.HourColor, cfg.MinuteColor, cfg.SecondColor = -1, -1, -1
+		img := image.NewGray(image.Rect(0, 0, size, size))
+		if err := NewAnalogRenderer(cfg).Render(img, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 0, 0, size, size); err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		return img
+	}
+	roman := AnalogConfig{Numerals: config.HourMarkersRoman, LabelInterval: 1}
+	ticks := AnalogConfig{ShowTicks: true}
+
+	// A large face shows numerals instead of ticks
+	if string(render(roman, 100).Pix) == string(render(ticks, 100).Pix) {
+		t.Error("roman numerals on a large face rendered as ticks")
+	}
+
+	// A small face silently falls back to ticks
+	if string(render(roman, 40).Pix) != string(render(ticks, 40).Pix) {
+		t.Error("roman numerals on a small face did not fall back to ticks")
+	}
+}
+
 func TestWidget_DefaultDisplayMode(t *testing.T) {
 	cfg := config.WidgetConfig{
 		Type:    "clock",
@@ -826,6 +894,254 @@ func TestWidget_SegmentAmPmStyles(t *testing.T) {
 	}
 }
 
+func TestFormatTwelveHour(t *testing.T) {
+	tests := []struct {
+		format       string
+		wantUse12h   bool
+		wantShowAmPm bool
+	}{
+		{"%H:%M:%S", false, false},
+		{"%I:%M", true, false},
+		{"%I:%M %p", true, true},
+		{"%H:%M %p", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			use12h, showAmPm := formatTwelveHour(tt.format)
+			if use12h != tt.wantUse12h || showAmPm != tt.wantShowAmPm {
+				t.Errorf("formatTwelveHour(%q) = (%v, %v), want (%v, %v)",
+					tt.format, use12h, showAmPm, tt.wantUse12h, tt.wantShowAmPm)
+			}
+		})
+	}
+}
+
+func TestTwentyFourHourFormat(t *testing.T) {
+	if got := twentyFourHourFormat("%I:%M %p"); got != "%H:%M" {
+		t.Errorf("twentyFourHourFormat() = %q, want %q", got, "%H:%M")
+	}
+}
+
+// newSegmentFormatRenderer creates the segment renderer of a 128x40 clock with the given format
+func newSegmentFormatRenderer(t *testing.T, format string) *SegmentRenderer {
+	t.Helper()
+	cfg := config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_segment_format",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "segment",
+		Segment:  &config.SegmentClockConfig{Format: format},
+	}
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	seg, ok := w.renderer.(*SegmentRenderer)
+	if !ok {
+		t.Fatalf("renderer = %T, want *SegmentRenderer", w.renderer)
+	}
+	return seg
+}
+
+func TestSegmentRenderer_TwelveHourFormat(t *testing.T) {
+	seg := newSegmentFormatRenderer(t, "%I:%M %p")
+
+	if !seg.config.Use12h || !seg.config.ShowAmPm {
+		t.Fatal("12-hour and AM/PM tokens should enable 12-hour display with AM/PM indicator")
+	}
+	if seg.config.AmPmStyle != ampmStyleLetter {
+		t.Errorf("AmPmStyle = %q, want %q", seg.config.AmPmStyle, ampmStyleLetter)
+	}
+
+	tests := []struct {
+		name       string
+		hour       int
+		wantDigits [2]int
+	}{
+		{"midnight", 0, [2]int{1, 2}},
+		{"morning", 3, [2]int{0, 3}},
+		{"noon", 12, [2]int{1, 2}},
+		{"afternoon", 15, [2]int{0, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := image.NewGray(image.Rect(0, 0, 128, 40))
+			at := time.Date(2025, 1, 1, tt.hour, 45, 0, 0, time.Local)
+			if err := seg.Render(img, at, 0, 0, 128, 40); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := [2]int{seg.lastDigits[0], seg.lastDigits[1]}; got != tt.wantDigits {
+				t.Errorf("hour digits = %v, want %v", got, tt.wantDigits)
+			}
+		})
+	}
+}
+
+func TestSegmentRenderer_AmPmLetter(t *testing.T) {
+	seg := newSegmentFormatRenderer(t, "%I:%M %p")
+
+	render := func(hour int) *image.Gray {
+		img := image.NewGray(image.Rect(0, 0, 128, 40))
+		at := time.Date(2025, 1, 1, hour, 45, 0, 0, time.Local)
+		if err := seg.Render(img, at, 0, 0, 128, 40); err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		return img
+	}
+	am := render(3)
+	pm := render(15)
+
+	// 03:45 and 15:45 show the same digits, so only the indicator differs
+	diff := false
+	for i := range am.Pix {
+		if am.Pix[i] != pm.Pix[i] {
+			diff = true
+			break
+		}
+	}
+	if !diff {
+		t.Error("AM and PM renders should differ by the indicator letter")
+	}
+
+	// Without the AM/PM token no indicator is drawn
+	plain := newSegmentFormatRenderer(t, "%I:%M")
+	if plain.config.ShowAmPm {
+		t.Error("format without AM/PM token should not show the indicator")
+	}
+}
+
+func TestSegmentRenderer_ColonBlinkWithAmPmSuffix(t *testing.T) {
+	seg := newSegmentFormatRenderer(t, "%I:%M %p")
+
+	_, colons := parseSegmentFormatAdvanced(seg.config.Format)
+	if len(colons) != 1 || colons[0] != 1 {
+		t.Errorf("colon positions = %v, want [1]", colons)
+	}
+	if seg.Granularity() != time.Second {
+		t.Errorf("Granularity() = %v, want 1s for blinking colon", seg.Granularity())
+	}
+}
+
+func TestSegmentRenderer_ColonBlinkTiming(t *testing.T) {
+	tests := []struct {
+		name    string
+		segment config.SegmentClockConfig
+		ms      int64
+		want    bool
+	}{
+		{"default visible on even second", config.SegmentClockConfig{}, 2000, true},
+		{"default hidden on odd second", config.SegmentClockConfig{}, 3500, false},
+		{"half-second blink hidden", config.SegmentClockConfig{ColonBlinkMs: intPtr(500)}, 700, false},
+		{"half-second blink visible next cycle", config.SegmentClockConfig{ColonBlinkMs: intPtr(500)}, 1100, true},
+		{"slow blink still visible", config.SegmentClockConfig{ColonBlinkMs: intPtr(2000)}, 1500, true},
+		{"slow blink hidden", config.SegmentClockConfig{ColonBlinkMs: intPtr(2000)}, 2500, false},
+		{"zero is steady", config.SegmentClockConfig{ColonBlinkMs: intPtr(0)}, 3500, true},
+		{"legacy true blinks each second", config.SegmentClockConfig{ColonBlink: config.BoolPtr(true)}, 3500, false},
+		{"legacy false is steady", config.SegmentClockConfig{ColonBlink: config.BoolPtr(false)}, 3500, true},
+		{"ms wins over legacy switch", config.SegmentClockConfig{ColonBlink: config.BoolPtr(false), ColonBlinkMs: intPtr(500)}, 700, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segment := tt.segment
+			segment.Format = "%H:%M"
+			segment.OffColor = intPtr(0)
+			cfg := config.WidgetConfig{
+				Type:     "clock",
+				ID:       "test_colon_blink",
+				Position: config.PositionConfig{W: 128, H: 40},
+				Mode:     "segment",
+				Segment:  &segment,
+			}
+			w, err := New(cfg)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			seg := w.renderer.(*SegmentRenderer)
+
+			img := image.NewGray(image.Rect(0, 0, 128, 40))
+			seg.drawColon(img, 0, 0, 8, 40, time.UnixMilli(tt.ms))
+			visible := false
+			for _, p := range img.Pix {
+				if p > 0 {
+					visible = true
+					break
+				}
+			}
+			if visible != tt.want {
+				t.Errorf("colon visible = %v, want %v", visible, tt.want)
+			}
+		})
+	}
+}
+
+func TestColonBlinkMs(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.SegmentClockConfig
+		want int
+	}{
+		{"default", config.SegmentClockConfig{}, 1000},
+		{"legacy true", config.SegmentClockConfig{ColonBlink: config.BoolPtr(true)}, 1000},
+		{"legacy false", config.SegmentClockConfig{ColonBlink: config.BoolPtr(false)}, 0},
+		{"explicit", config.SegmentClockConfig{ColonBlinkMs: intPtr(250)}, 250},
+		{"explicit zero over legacy true", config.SegmentClockConfig{ColonBlink: config.BoolPtr(true), ColonBlinkMs: intPtr(0)}, 0},
+		{"negative is steady", config.SegmentClockConfig{ColonBlinkMs: intPtr(-5)}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := colonBlinkMs(&tt.cfg); got != tt.want {
+				t.Errorf("colonBlinkMs() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew_TimerSourceStripsTwelveHourTokens(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_countdown_format",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "segment",
+		Clock:    &config.ClockConfig{Source: "countdown", Target: "2030-01-01"},
+		Segment:  &config.SegmentClockConfig{Format: "%I:%M %p"},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	seg := w.renderer.(*SegmentRenderer)
+	if seg.config.Use12h || seg.config.ShowAmPm {
+		t.Error("countdown source should ignore 12-hour and AM/PM tokens")
+	}
+}
+
+func TestBinaryRenderer_TwelveHourFormat(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_binary_format",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "binary",
+		Binary:   &config.BinaryClockConfig{Format: "%I:%M %p"},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	bin, ok := w.renderer.(*BinaryRenderer)
+	if !ok {
+		t.Fatalf("renderer = %T, want *BinaryRenderer", w.renderer)
+	}
+	if !bin.config.Use12h || !bin.config.ShowAmPm {
+		t.Error("12-hour and AM/PM tokens should enable 12-hour display with AM/PM bit")
+	}
+}
+
 func TestConvertStrftimeToGo(t *testing.T) {
 	tests := []struct {
 		strftime string
@@ -844,9 +1160,14 @@ func TestConvertStrftimeToGo(t *testing.T) {
 		{"%M", "04"},
 		{"%S", "05"},
 		{"%p", "PM"},
+		{"%A", "Monday"},
+		{"%a", "Mon"},
+		{"%B", "January"},
+		{"%b", "Jan"},
 		// Mixed formats
 		{"%I:%M %p", "3:04 PM"},
 		{"%H:%M:%S on %Y-%m-%d", "15:04:05 on 2006-01-02"},
+		{"%a %d %b", "Mon 02 Jan"},
 		// Go format passthrough
 		{"15:04:05", "15:04:05"},
 		{"3:04 PM", "3:04 PM"},
@@ -861,3 +1182,191 @@ func TestConvertStrftimeToGo(t *testing.T) {
 		})
 	}
 }
+
+func TestTextGranularity(t *testing.T) {
+	tests := []struct {
+		format string
+		want   time.Duration
+	}{
+		{"15:04", time.Minute},
+		{"3:04 PM", time.Minute},
+		{"15:04:05", time.Second},
+		{"3:4:5", time.Second},
+		{"02.01.2006", time.Minute},
+		{"15:04:05.000", 0},
+		{"15:04:05,999", 0},
+	}
+	for _, tt := range tests {
+		if got := textGranularity(tt.format); got != tt.want {
+			t.Errorf("textGranularity(%q) = %v, want %v", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestRendererGranularity(t *testing.T) {
+	segment := func(format string, blink bool) Renderer {
+		cfg := NewSegmentConfig()
+		cfg.Format = format
+		if !blink {
+			cfg.ColonBlinkMs = 0
+		}
+		return NewSegmentRenderer(cfg)
+	}
+	segmentBlink := func(format string, blinkMs int) Renderer {
+		cfg := NewSegmentConfig()
+		cfg.Format = format
+		cfg.ColonBlinkMs = blinkMs
+		return NewSegmentRenderer(cfg)
+	}
+	binary := func(format string) Renderer {
+		cfg := NewBinaryConfig()
+		cfg.Format = format
+		return NewBinaryRenderer(cfg)
+	}
+
+	tests := []struct {
+		name     string
+		renderer Renderer
+		want     time.Duration
+	}{
+		{"segment with seconds", segment("%H:%M:%S", false), time.Second},
+		{"segment minutes, blinking colon", segment("%H:%M", true), time.Second},
+		{"segment minutes, steady colon", segment("%H:%M", false), time.Minute},
+		{"segment minutes, fast colon", segmentBlink("%H:%M", 500), 500 * time.Millisecond},
+		{"segment seconds, slow colon", segmentBlink("%H:%M:%S", 2000), time.Second},
+		{"segment minutes, uneven interval", segmentBlink("%H:%M", 300), 100 * time.Millisecond},
+		{"binary with seconds", binary("%H:%M:%S"), time.Second},
+		{"binary minutes", binary("%H:%M"), time.Minute},
+		{"analog", NewAnalogRenderer(AnalogConfig{}), time.Second},
+		{"analog sweeping seconds", NewAnalogRenderer(AnalogConfig{ShowSeconds: true, SmoothSeconds: true}), 0},
+		{"analog sweep, seconds hidden", NewAnalogRenderer(AnalogConfig{SmoothSeconds: true}), time.Second},
+		{"analog sweep, transparent hand", NewAnalogRenderer(AnalogConfig{ShowSeconds: true, SmoothSeconds: true, SecondColor: -1}), time.Second},
+	}
+	for _, tt := range tests {
+		if got := tt.renderer.Granularity(); got != tt.want {
+			t.Errorf("%s: Granularity() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestWidget_NeedsRender_SameMinute verifies an HH:MM clock skips rendering
+// until the minute changes
+func TestWidget_NeedsRender_SameMinute(t *testing.T) {
+	widget, err := New(config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_clock_minute",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "text",
+		Text:     &config.TextConfig{Format: "%H:%M", Size: 12},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	setTime := func(tm time.Time) {
+		widget.mu.Lock()
+		widget.currentTime = tm
+		widget.mu.Unlock()
+	}
+
+	base := time.Date(2025, 6, 1, 12, 30, 10, 0, time.UTC)
+	setTime(base)
+	if !widget.NeedsRender() {
+		t.Error("NeedsRender() = false before the first render")
+	}
+	if _, err := widget.Render(); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	for _, offset := range []time.Duration{0, 20 * time.Second, 49 * time.Second} {
+		setTime(base.Add(offset))
+		if widget.NeedsRender() {
+			t.Errorf("NeedsRender() = true at +%v, want false within the same minute", offset)
+		}
+	}
+
+	setTime(base.Add(50 * time.Second))
+	if !widget.NeedsRender() {
+		t.Error("NeedsRender() = false after the minute changed")
+	}
+}
+
+// TestWidget_NeedsRender_Seconds verifies an HH:MM:SS clock renders every second
+func TestWidget_NeedsRender_Seconds(t *testing.T) {
+	widget, err := New(config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_clock_seconds",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "text",
+		Text:     &config.TextConfig{Format: "%H:%M:%S", Size: 12},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	base := time.Date(2025, 6, 1, 12, 30, 10, 0, time.UTC)
+	widget.currentTime = base
+	if _, err := widget.Render(); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	widget.currentTime = base.Add(500 * time.Millisecond)
+	if widget.NeedsRender() {
+		t.Error("NeedsRender() = true within the same second")
+	}
+	widget.currentTime = base.Add(time.Second)
+	if !widget.NeedsRender() {
+		t.Error("NeedsRender() = false after the second changed")
+	}
+}
+
+// TestAnalogRenderer_SmoothSeconds verifies the sweeping second hand moves between
+// two renders within the same second, while the ticking hand does not
+func TestAnalogRenderer_SmoothSeconds(t *testing.T) {
+	render := func(smooth bool, tm time.Time) string {
+		r := NewAnalogRenderer(AnalogConfig{
+			ShowSeconds:   true,
+			SmoothSeconds: smooth,
+			FaceColor:     -1,
+			HourColor:     -1,
+			MinuteColor:   -1,
+			SecondColor:   255,
+		})
+		img := image.NewGray(image.Rect(0, 0, 80, 80))
+		if err := r.Render(img, tm, 0, 0, 80, 80); err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		return string(img.Pix)
+	}
+
+	base := time.Date(2025, 6, 1, 12, 30, 7, 100*int(time.Millisecond), time.UTC)
+	later := base.Add(600 * time.Millisecond)
+
+	if render(true, base) == render(true, later) {
+		t.Error("sweeping second hand did not move within the second")
+	}
+	if render(false, base) != render(false, later) {
+		t.Error("ticking second hand moved within the second")
+	}
+}
+
+// TestWidget_NeedsRender_SmoothSeconds verifies a sweeping second hand redraws on every frame
+func TestWidget_NeedsRender_SmoothSeconds(t *testing.T) {
+	widget, err := New(config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_clock_sweep",
+		Position: config.PositionConfig{W: 40, H: 40},
+		Mode:     "analog",
+		Analog:   &config.AnalogConfig{ShowSeconds: true, SmoothSeconds: true},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := widget.Render(); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !widget.NeedsRender() {
+		t.Error("NeedsRender() = false for a sweeping second hand")
+	}
+}
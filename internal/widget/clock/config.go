@@ -41,10 +41,14 @@ const (
 	colonStyleNone = "none"
 )
 
+// defaultColonBlinkMs is the time between colon toggles: on for one second, off for the next
+const defaultColonBlinkMs = 1000
+
 // AM/PM indicator styles for segment clock
 const (
-	ampmStyleDot  = "dot"
-	ampmStyleText = "text"
+	ampmStyleDot    = "dot"
+	ampmStyleText   = "text"
+	ampmStyleLetter = "letter" // Single "A"/"P" glyph, default when the format contains %p
 )
 
 // Flip animation styles
@@ -62,6 +66,9 @@ type TextConfig struct {
 	Format     string // Go time format string (e.g., "15:04:05")
 	Use12h     bool   // Use 12-hour format
 	ShowAmPm   bool   // Show AM/PM text when Use12h is true
+	Timer      bool   // Time is a duration: the hour token shows total hours
+
+	Orientation config.TextOrientation
 }
 
 // AnalogConfig holds configuration for analog clock rendering
@@ -75,6 +82,15 @@ type AnalogConfig struct {
 	HourColor   int // -1 = transparent, 0-255 = color
 	MinuteColor int // -1 = transparent, 0-255 = color
 	SecondColor int // -1 = transparent, 0-255 = color
+
+	TickLabels    bool // Draw hour numbers inside the face
+	LabelInterval int  // Hours between labels
+
+	// Numerals replaces the hour ticks with "numbers" or "roman" hour numerals ("" = none).
+	// Ticks are drawn instead when the face is too small for the numerals.
+	Numerals string
+
+	SmoothSeconds bool // Sweep the second hand using fractional seconds
 }
 
 // BinaryConfig holds configuration for binary clock rendering
@@ -101,14 +117,15 @@ type SegmentConfig struct {
 	SegmentStyle     string // "rectangle", "hexagon", "rounded"
 	DigitSpacing     int
 	ColonStyle       string // "dots", "bar", "none"
-	ColonBlink       bool
-	OnColor          int // 0-255
-	OffColor         int // 0-255
+	ColonBlinkMs     int    // Time between colon toggles in ms (0 = steady colon)
+	OnColor          int    // 0-255
+	OffColor         int    // 0-255
 	FlipStyle        string
 	FlipSpeed        float64
 	Use12h           bool   // Use 12-hour format
 	ShowAmPm         bool   // Show AM/PM indicator
-	AmPmStyle        string // "dot" or "text"
+	AmPmStyle        string // "dot", "text" or "letter"
+	Timer            bool   // Time is a duration: hour digits show total hours (up to 99)
 }
 
 // NewBinaryConfig creates a BinaryConfig with default values
@@ -138,7 +155,7 @@ func NewSegmentConfig() SegmentConfig {
 		SegmentStyle:     segmentStyleRectangle,
 		DigitSpacing:     2,
 		ColonStyle:       colonStyleDots,
-		ColonBlink:       true,
+		ColonBlinkMs:     defaultColonBlinkMs,
 		OnColor:          255,
 		OffColor:         30,
 		FlipStyle:        flipStyleNone,
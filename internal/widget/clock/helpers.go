@@ -3,7 +3,9 @@ package clock
 import (
 	"image"
 	"image/color"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 )
@@ -31,14 +33,18 @@ func convertStrftimeToGo(strftime string) string {
 		token string
 		goFmt string
 	}{
-		{"%Y", "2006"}, // 4-digit year
-		{"%m", "01"},   // 2-digit month
-		{"%d", "02"},   // 2-digit day
-		{"%H", "15"},   // 24-hour hour
-		{"%I", "3"},    // 12-hour hour (no leading zero)
-		{"%M", "04"},   // minute
-		{"%S", "05"},   // second
-		{"%p", "PM"},   // AM/PM indicator
+		{"%Y", "2006"},    // 4-digit year
+		{"%m", "01"},      // 2-digit month
+		{"%d", "02"},      // 2-digit day
+		{"%H", "15"},      // 24-hour hour
+		{"%I", "3"},       // 12-hour hour (no leading zero)
+		{"%M", "04"},      // minute
+		{"%S", "05"},      // second
+		{"%p", "PM"},      // AM/PM indicator
+		{"%A", "Monday"},  // full weekday name
+		{"%a", "Mon"},     // abbreviated weekday name
+		{"%B", "January"}, // full month name
+		{"%b", "Jan"},     // abbreviated month name
 	}
 
 	result := strftime
@@ -49,6 +55,22 @@ func convertStrftimeToGo(strftime string) string {
 	return result
 }
 
+// fractionalSecondsPattern matches Go fractional second tokens such as ".000" or ",999"
+var fractionalSecondsPattern = regexp.MustCompile(`[.,](0+|9+)($|[^0-9])`)
+
+// textGranularity returns the smallest time unit shown by a Go time format:
+// zero for fractional seconds, time.Second for seconds and time.Minute otherwise
+func textGranularity(format string) time.Duration {
+	if fractionalSecondsPattern.MatchString(format) {
+		return 0
+	}
+	// "5" and "05" are the Go seconds tokens; "15" is the 24-hour token
+	if strings.Contains(strings.ReplaceAll(format, "15", ""), "5") {
+		return time.Second
+	}
+	return time.Minute
+}
+
 // convert24to12 converts 24-hour time to 12-hour format
 // Returns 12-hour value (1-12) and isPM boolean
 func convert24to12(hour int) (int, bool) {
@@ -60,6 +82,19 @@ func convert24to12(hour int) (int, bool) {
 	return hour12, isPM
 }
 
+// formatTwelveHour reports whether a segment or binary format asks for 12-hour
+// hours (%I) and the AM/PM indicator (%p)
+func formatTwelveHour(format string) (use12h, showAmPm bool) {
+	return strings.Contains(format, "%I"), strings.Contains(format, "%p")
+}
+
+// twentyFourHourFormat rewrites a segment or binary format to show 24-hour hours
+// without the AM/PM indicator
+func twentyFourHourFormat(format string) string {
+	format = strings.ReplaceAll(format, "%I", "%H")
+	return strings.TrimSpace(strings.ReplaceAll(format, "%p", ""))
+}
+
 // binaryTimeComponents holds parsed time components for binary display
 type binaryTimeComponents struct {
 	showHours   bool
@@ -116,7 +151,8 @@ type segmentDigitSource struct {
 }
 
 // parseSegmentFormatAdvanced parses format string to build a list of digit sources
-// Supports: %H (hours), %M (minutes), %S (seconds), and literal digits 0-9
+// Supports: %H/%I (hours), %M (minutes), %S (seconds), and literal digits 0-9.
+// %p is skipped here; the AM/PM indicator is drawn after the last digit.
 // Colons and other separators are tracked for colon placement
 func parseSegmentFormatAdvanced(format string) ([]segmentDigitSource, []int) {
 	var digits []segmentDigitSource
@@ -173,6 +209,12 @@ func parseSegmentFormatAdvanced(format string) ([]segmentDigitSource, []int) {
 	return digits, colonPositions
 }
 
+// Small character glyph size in pixels
+const (
+	smallCharWidth  = 3
+	smallCharHeight = 5
+)
+
 // Small character patterns for binary clock labels (3x5 font)
 var smallCharPatterns = map[string][]uint8{
 	"H": {0b101, 0b101, 0b111, 0b101, 0b101},
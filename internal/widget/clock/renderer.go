@@ -17,4 +17,8 @@ type Renderer interface {
 	// (e.g., during animations like segment flip effects)
 	// This allows the compositor to know when to increase update frequency
 	NeedsUpdate() bool
+
+	// Granularity returns the smallest change of time that can alter the rendered image,
+	// e.g. time.Minute for an HH:MM display. Zero means every frame may differ.
+	Granularity() time.Duration
 }
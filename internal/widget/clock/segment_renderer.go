@@ -32,6 +32,9 @@ func NewSegmentRenderer(cfg SegmentConfig) *SegmentRenderer {
 // Render draws the clock as a 7-segment display
 func (r *SegmentRenderer) Render(img *image.Gray, t time.Time, x, y, w, h int) error {
 	hour := t.Hour()
+	if r.config.Timer {
+		hour = min(durationHours(t), 99) // Two hour digits
+	}
 	minute := t.Minute()
 	second := t.Second()
 
@@ -105,6 +108,10 @@ func (r *SegmentRenderer) Render(img *image.Gray, t time.Time, x, y, w, h int) e
 
 	// Each colon has digitSpacing on both sides
 	totalWidth := len(digitInfos)*digitW + (len(digitInfos)-1)*r.config.DigitSpacing + numColons*(colonW+r.config.DigitSpacing)
+	// The letter indicator is centered together with the digits
+	if r.config.Use12h && r.config.ShowAmPm && r.config.AmPmStyle == ampmStyleLetter {
+		totalWidth += r.config.DigitSpacing + smallCharWidth
+	}
 	startX := x + (w-totalWidth)/2
 	startY := y + (h-digitH)/2
 
@@ -164,6 +171,37 @@ func (r *SegmentRenderer) Render(img *image.Gray, t time.Time, x, y, w, h int) e
 	return nil
 }
 
+// Granularity returns time.Minute for an HH:MM display with a steady colon, time.Second
+// if the format shows seconds, and the colon blink step when a blinking colon is drawn.
+// Flip animations are reported by NeedsUpdate.
+func (r *SegmentRenderer) Granularity() time.Duration {
+	granularity := time.Minute
+	sources, colonPositions := parseSegmentFormatAdvanced(r.config.Format)
+	for _, src := range sources {
+		if !src.isLiteral && src.timeType == 'S' {
+			granularity = time.Second
+		}
+	}
+	if r.config.ColonBlinkMs > 0 && r.config.ColonStyle != colonStyleNone && len(colonPositions) > 0 {
+		granularity = min(granularity, colonBlinkStep(r.config.ColonBlinkMs))
+	}
+	return granularity
+}
+
+// colonBlinkStep returns the longest time step that lands on every colon toggle
+// and on whole seconds
+func colonBlinkStep(blinkMs int) time.Duration {
+	return time.Duration(gcd(int64(blinkMs), 1000)) * time.Millisecond
+}
+
+// gcd returns the greatest common divisor of two positive integers
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
 // NeedsUpdate returns true if any digit is currently animating
 func (r *SegmentRenderer) NeedsUpdate() bool {
 	r.mu.RLock()
@@ -195,8 +233,8 @@ func (r *SegmentRenderer) drawSegmentDigit(img *image.Gray, x, y, width, height
 func (r *SegmentRenderer) drawColon(img *image.Gray, x, y, width, height int, t time.Time) {
 	// Determine if colon should be visible (blinking)
 	visible := true
-	if r.config.ColonBlink {
-		visible = t.Second()%2 == 0
+	if r.config.ColonBlinkMs > 0 {
+		visible = (t.UnixMilli()/int64(r.config.ColonBlinkMs))%2 == 0
 	}
 
 	// Convert style string to bitmap.ColonStyle
@@ -216,6 +254,13 @@ func (r *SegmentRenderer) drawAmPmIndicator(img *image.Gray, x, y, height int, i
 	c := color.Gray{Y: uint8(r.config.OnColor)}
 
 	switch r.config.AmPmStyle {
+	case ampmStyleLetter:
+		// Single "A" or "P" aligned to the bottom of the digits
+		letter := "A"
+		if isPM {
+			letter = "P"
+		}
+		drawSmallChar(img, letter, x, y+height-smallCharHeight, c)
 	case ampmStyleText:
 		// Draw small "AM" or "PM" text using the small character patterns
 		text := "AM"
@@ -0,0 +1,176 @@
+package clock
+
+import (
+	"fmt"
+	"log"
+	"time"
+	_ "time/tzdata" // Zone database for timezone overrides on systems without one (Windows)
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// Time sources for the clock widget
+const (
+	SourceClock     = "clock"     // Current time (local or the configured timezone)
+	SourceCountdown = "countdown" // Time remaining until the target
+	SourceElapsed   = "elapsed"   // Time passed since the target
+)
+
+// Accepted target layouts, tried in order
+var (
+	targetDateLayouts = []string{
+		time.RFC3339,
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02",
+	}
+	targetDailyLayouts = []string{
+		"15:04:05",
+		"15:04",
+	}
+)
+
+// durationEpoch is the origin durations are expressed from: 1h02m03s becomes
+// 01:02:03 on this date, and every whole day moves the date forward
+var durationEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// timeSource produces the time value handed to renderers.
+// For countdown and elapsed sources the duration is expressed as a time after
+// durationEpoch, so every renderer can display it; renderers showing hours take
+// the total from durationHours instead of the hour of day.
+type timeSource struct {
+	kind   string
+	target time.Time // Absolute target, or time of day when daily is set
+	daily  bool
+	loc    *time.Location // Zone the time of day and targets are expressed in
+}
+
+// newTimeSource parses the clock source settings
+func newTimeSource(cfg *config.ClockConfig) (*timeSource, error) {
+	loc := time.Local
+	if cfg != nil {
+		loc = loadTimezone(cfg.Timezone)
+	}
+
+	if cfg == nil || cfg.Source == "" || cfg.Source == SourceClock {
+		return &timeSource{kind: SourceClock, loc: loc}, nil
+	}
+
+	if cfg.Source != SourceCountdown && cfg.Source != SourceElapsed {
+		return nil, fmt.Errorf("invalid clock source %q (valid: %s, %s, %s)", cfg.Source, SourceClock, SourceCountdown, SourceElapsed)
+	}
+	if cfg.Target == "" {
+		return nil, fmt.Errorf("clock source %q requires a target", cfg.Source)
+	}
+
+	target, daily, err := parseTarget(cfg.Target, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &timeSource{kind: cfg.Source, target: target, daily: daily, loc: loc}, nil
+}
+
+// loadTimezone resolves an IANA zone name such as "America/New_York".
+// An empty name means local time; a zone that fails to load falls back to local time.
+func loadTimezone(name string) *time.Location {
+	if name == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		log.Printf("Clock: unknown timezone %q, using local time: %v", name, err)
+		return time.Local
+	}
+	return loc
+}
+
+// parseTarget parses an absolute date/time or a daily time of day in the given zone
+func parseTarget(s string, loc *time.Location) (target time.Time, daily bool, err error) {
+	for _, layout := range targetDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, false, nil
+		}
+	}
+	for _, layout := range targetDailyLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("invalid clock target %q (expected \"2006-01-02 15:04:05\", \"2006-01-02\" or \"15:04\")", s)
+}
+
+// isTimer reports whether the source shows a duration rather than the time of day
+func (s *timeSource) isTimer() bool {
+	return s.kind != SourceClock
+}
+
+// At returns the value to render at the given moment
+func (s *timeSource) At(now time.Time) time.Time {
+	switch s.kind {
+	case SourceCountdown:
+		// Round up so the display reaches 00:00:00 exactly at the target
+		remaining := s.targetAfter(now).Sub(now)
+		return durationAsTime((remaining + time.Second - 1).Truncate(time.Second))
+	case SourceElapsed:
+		return durationAsTime(now.Sub(s.targetBefore(now)))
+	default:
+		return now.In(s.loc)
+	}
+}
+
+// targetAfter returns the target a countdown runs toward: the next daily occurrence,
+// or the absolute target
+func (s *timeSource) targetAfter(now time.Time) time.Time {
+	if !s.daily {
+		return s.target
+	}
+	t := s.occurrenceOn(now)
+	if !t.After(now) {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// targetBefore returns the target elapsed time is counted from: the latest daily
+// occurrence, or the absolute target
+func (s *timeSource) targetBefore(now time.Time) time.Time {
+	if !s.daily {
+		return s.target
+	}
+	t := s.occurrenceOn(now)
+	if t.After(now) {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t
+}
+
+// occurrenceOn returns the daily target time on the same date as now in the source zone
+func (s *timeSource) occurrenceOn(now time.Time) time.Time {
+	now = now.In(s.loc)
+	return time.Date(now.Year(), now.Month(), now.Day(),
+		s.target.Hour(), s.target.Minute(), s.target.Second(), 0, s.loc)
+}
+
+// durationAsTime maps a duration to a time after durationEpoch; negative durations
+// clamp to 00:00:00. The time of day wraps every 24 hours, the days are kept in the date.
+func durationAsTime(d time.Duration) time.Time {
+	if d < 0 {
+		d = 0
+	}
+	return durationEpoch.Add(d.Truncate(time.Second))
+}
+
+// durationHours returns the total hours of a duration mapped by durationAsTime, days included
+func durationHours(t time.Time) int {
+	return int(t.Sub(durationEpoch) / time.Hour)
+}
+
+// clampToDay limits a duration mapped by durationAsTime to 23:59:59, for
+// renderers that can only show a time of day
+func clampToDay(t time.Time) time.Time {
+	if last := durationEpoch.Add(24*time.Hour - time.Second); t.After(last) {
+		return last
+	}
+	return t
+}
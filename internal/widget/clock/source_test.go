@@ -0,0 +1,254 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestNewTimeSource(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *config.ClockConfig
+		wantKind  string
+		wantDaily bool
+		wantErr   bool
+	}{
+		{"nil config", nil, SourceClock, false, false},
+		{"explicit clock", &config.ClockConfig{Source: "clock"}, SourceClock, false, false},
+		{"countdown to date", &config.ClockConfig{Source: "countdown", Target: "2030-01-01"}, SourceCountdown, false, false},
+		{"countdown to date and time", &config.ClockConfig{Source: "countdown", Target: "2030-01-01 12:30"}, SourceCountdown, false, false},
+		{"elapsed since RFC 3339", &config.ClockConfig{Source: "elapsed", Target: "2024-05-01T08:00:00Z"}, SourceElapsed, false, false},
+		{"daily countdown", &config.ClockConfig{Source: "countdown", Target: "17:30"}, SourceCountdown, true, false},
+		{"daily elapsed with seconds", &config.ClockConfig{Source: "elapsed", Target: "09:00:15"}, SourceElapsed, true, false},
+		{"unknown source", &config.ClockConfig{Source: "stopwatch"}, "", false, true},
+		{"missing target", &config.ClockConfig{Source: "countdown"}, "", false, true},
+		{"invalid target", &config.ClockConfig{Source: "elapsed", Target: "tomorrow"}, "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := newTimeSource(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newTimeSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if src.kind != tt.wantKind || src.daily != tt.wantDaily {
+				t.Errorf("newTimeSource() = {%s, daily=%v}, want {%s, daily=%v}", src.kind, src.daily, tt.wantKind, tt.wantDaily)
+			}
+		})
+	}
+}
+
+func TestTimeSource_At(t *testing.T) {
+	now := time.Date(2025, 6, 10, 14, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name   string
+		cfg    *config.ClockConfig
+		now    time.Time
+		wantHH int
+		wantMM int
+		wantSS int
+	}{
+		{"countdown to absolute target", &config.ClockConfig{Source: "countdown", Target: "2025-06-10 15:02:03"}, now, 1, 2, 3},
+		{"countdown rounds partial seconds up", &config.ClockConfig{Source: "countdown", Target: "2025-06-10 14:00:10"}, now.Add(500 * time.Millisecond), 0, 0, 10},
+		{"countdown past target clamps", &config.ClockConfig{Source: "countdown", Target: "2025-06-10 13:00"}, now, 0, 0, 0},
+		{"elapsed since absolute target", &config.ClockConfig{Source: "elapsed", Target: "2025-06-10 13:15:30"}, now, 0, 44, 30},
+		{"elapsed before target clamps", &config.ClockConfig{Source: "elapsed", Target: "2025-06-10 15:00"}, now, 0, 0, 0},
+		{"daily countdown later today", &config.ClockConfig{Source: "countdown", Target: "17:30"}, now, 3, 30, 0},
+		{"daily countdown rolls to tomorrow", &config.ClockConfig{Source: "countdown", Target: "09:00"}, now, 19, 0, 0},
+		{"daily elapsed since this morning", &config.ClockConfig{Source: "elapsed", Target: "09:00"}, now, 5, 0, 0},
+		{"daily elapsed since yesterday", &config.ClockConfig{Source: "elapsed", Target: "18:00"}, now, 20, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := newTimeSource(tt.cfg)
+			if err != nil {
+				t.Fatalf("newTimeSource() error = %v", err)
+			}
+			got := src.At(tt.now)
+			if got.Hour() != tt.wantHH || got.Minute() != tt.wantMM || got.Second() != tt.wantSS {
+				t.Errorf("At() = %02d:%02d:%02d, want %02d:%02d:%02d",
+					got.Hour(), got.Minute(), got.Second(), tt.wantHH, tt.wantMM, tt.wantSS)
+			}
+		})
+	}
+}
+
+func TestTimeSource_ClockPassesThrough(t *testing.T) {
+	src, err := newTimeSource(nil)
+	if err != nil {
+		t.Fatalf("newTimeSource() error = %v", err)
+	}
+	now := time.Now()
+	if got := src.At(now); !got.Equal(now) {
+		t.Errorf("At() = %v, want %v", got, now)
+	}
+}
+
+func TestTimeSource_Timezone(t *testing.T) {
+	src, err := newTimeSource(&config.ClockConfig{Timezone: "America/New_York"})
+	if err != nil {
+		t.Fatalf("newTimeSource() error = %v", err)
+	}
+
+	// 15:30 UTC in January is 10:30 in New York (EST, UTC-5)
+	now := time.Date(2025, 1, 15, 15, 30, 0, 0, time.UTC)
+	got := src.At(now)
+	if got.Hour() != 10 || got.Minute() != 30 {
+		t.Errorf("At() = %02d:%02d, want 10:30", got.Hour(), got.Minute())
+	}
+	if !got.Equal(now) {
+		t.Errorf("At() = %v, want the same instant as %v", got, now)
+	}
+}
+
+func TestTimeSource_InvalidTimezoneFallsBackToLocal(t *testing.T) {
+	src, err := newTimeSource(&config.ClockConfig{Timezone: "Mars/Olympus_Mons"})
+	if err != nil {
+		t.Fatalf("newTimeSource() error = %v, want fallback to local time", err)
+	}
+	if src.loc != time.Local {
+		t.Errorf("loc = %v, want Local", src.loc)
+	}
+}
+
+func TestTimeSource_DailyTargetInTimezone(t *testing.T) {
+	// Daily countdown to 18:00 Tokyo time (UTC+9, no DST)
+	src, err := newTimeSource(&config.ClockConfig{Source: "countdown", Target: "18:00", Timezone: "Asia/Tokyo"})
+	if err != nil {
+		t.Fatalf("newTimeSource() error = %v", err)
+	}
+
+	// 07:00 UTC is 16:00 in Tokyo: two hours to go
+	now := time.Date(2025, 6, 10, 7, 0, 0, 0, time.UTC)
+	got := src.At(now)
+	if got.Hour() != 2 || got.Minute() != 0 || got.Second() != 0 {
+		t.Errorf("At() = %02d:%02d:%02d, want 02:00:00", got.Hour(), got.Minute(), got.Second())
+	}
+}
+
+func TestNew_TimezoneAllModes(t *testing.T) {
+	for _, mode := range []string{"text", "analog", "binary", "segment"} {
+		t.Run(mode, func(t *testing.T) {
+			cfg := config.WidgetConfig{
+				Type:     "clock",
+				ID:       "test_timezone_" + mode,
+				Position: config.PositionConfig{W: 128, H: 40},
+				Mode:     mode,
+				Clock:    &config.ClockConfig{Timezone: "Asia/Tokyo"},
+			}
+
+			w, err := New(cfg)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if err := w.Update(); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if loc := w.currentTime.Location().String(); loc != "Asia/Tokyo" {
+				t.Errorf("current time zone = %s, want Asia/Tokyo", loc)
+			}
+			if _, err := w.Render(); err != nil {
+				t.Errorf("Render() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestNew_TimerSourceDisables12Hour(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_countdown",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "segment",
+		Clock:    &config.ClockConfig{Source: "countdown", Target: "2030-01-01"},
+		Segment:  &config.SegmentClockConfig{Use12h: true, ShowAmPm: true},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	seg, ok := w.renderer.(*SegmentRenderer)
+	if !ok {
+		t.Fatalf("renderer = %T, want *SegmentRenderer", w.renderer)
+	}
+	if seg.config.Use12h || seg.config.ShowAmPm {
+		t.Error("countdown source should disable 12-hour display")
+	}
+	if !cfg.Segment.Use12h {
+		t.Error("New() must not modify the caller's config")
+	}
+
+	if _, err := w.Render(); err != nil {
+		t.Errorf("Render() error = %v", err)
+	}
+}
+
+func TestNew_InvalidSource(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "clock",
+		ID:       "test_invalid",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Clock:    &config.ClockConfig{Source: "countdown", Target: "soon"},
+	}
+
+	if _, err := New(cfg); err == nil {
+		t.Error("New() expected error for invalid target")
+	}
+}
+
+func TestDurationAsTime_PastOneDay(t *testing.T) {
+	d := 25*time.Hour + 2*time.Minute + 3*time.Second
+	got := durationAsTime(d)
+
+	if h := durationHours(got); h != 25 {
+		t.Errorf("durationHours() = %d, want 25", h)
+	}
+	if s := formatDuration(got, "15:04:05"); s != "25:02:03" {
+		t.Errorf("formatDuration() = %q, want 25:02:03", s)
+	}
+	if s := formatDuration(durationAsTime(100*time.Hour), "15h 04m"); s != "100h 00m" {
+		t.Errorf("formatDuration() = %q, want 100h 00m", s)
+	}
+	if c := clampToDay(got); c.Hour() != 23 || c.Minute() != 59 || c.Second() != 59 {
+		t.Errorf("clampToDay() = %s, want 23:59:59", c.Format("15:04:05"))
+	}
+	if c := clampToDay(durationAsTime(time.Hour)); durationHours(c) != 1 {
+		t.Errorf("clampToDay() changed a duration under a day: %s", c.Format("15:04:05"))
+	}
+}
+
+func TestNew_TimerPastOneDay(t *testing.T) {
+	target := time.Now().Add(-(49*time.Hour + 30*time.Minute)).Format("2006-01-02 15:04:05")
+
+	for _, mode := range []string{"text", "segment"} {
+		t.Run(mode, func(t *testing.T) {
+			w, err := New(config.WidgetConfig{
+				Type:     "clock",
+				ID:       "timer",
+				Mode:     mode,
+				Position: config.PositionConfig{W: 128, H: 40},
+				Clock:    &config.ClockConfig{Source: "elapsed", Target: target},
+			})
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if err := w.Update(); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if h := durationHours(w.currentTime); h != 49 {
+				t.Errorf("elapsed hours = %d, want 49", h)
+			}
+			if _, err := w.Render(); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+		})
+	}
+}
@@ -1,11 +1,13 @@
 package clock
 
 import (
+	"fmt"
 	"image"
 	"strings"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 )
 
 // TextRenderer renders clock in text mode
@@ -30,7 +32,12 @@ func (r *TextRenderer) Render(img *image.Gray, t time.Time, _, _, _, _ int) erro
 		format = strings.ReplaceAll(format, "15", "3")
 	}
 
-	timeStr := t.Format(format)
+	var timeStr string
+	if r.config.Timer {
+		timeStr = formatDuration(t, format)
+	} else {
+		timeStr = i18n.FormatTime(t, format)
+	}
 
 	// Append AM/PM indicator if enabled
 	if r.config.Use12h && r.config.ShowAmPm {
@@ -41,11 +48,28 @@ func (r *TextRenderer) Render(img *image.Gray, t time.Time, _, _, _, _ int) erro
 		}
 	}
 
-	bitmap.SmartDrawAlignedText(img, timeStr, r.config.FontFace, r.config.FontName,
-		r.config.HorizAlign, r.config.VertAlign, r.config.Padding)
+	bitmap.SmartDrawAlignedTextOriented(img, timeStr, r.config.FontFace, r.config.FontName,
+		r.config.HorizAlign, r.config.VertAlign, r.config.Padding, r.config.Orientation)
 	return nil
 }
 
+// formatDuration formats a duration mapped by durationAsTime, replacing the
+// 24-hour token with the total hours so durations past a day keep counting
+func formatDuration(t time.Time, format string) string {
+	parts := strings.Split(format, "15")
+	hours := fmt.Sprintf("%02d", durationHours(t))
+	for i, part := range parts {
+		parts[i] = i18n.FormatTime(t, part)
+	}
+	return strings.Join(parts, hours)
+}
+
+// Granularity returns time.Second if the format shows seconds, zero for fractional
+// seconds and time.Minute otherwise
+func (r *TextRenderer) Granularity() time.Duration {
+	return textGranularity(r.config.Format)
+}
+
 // NeedsUpdate returns false as text mode has no animations
 func (r *TextRenderer) NeedsUpdate() bool {
 	return false
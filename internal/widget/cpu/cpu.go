@@ -1,6 +1,7 @@
 package cpu
 
 import (
+	"fmt"
 	"image"
 	"sync"
 	"time"
@@ -38,8 +39,15 @@ type Widget struct {
 	// MetricRenderer for rendering
 	Renderer *render.MetricRenderer
 
-	// Metrics provider (abstraction over gopsutil)
-	cpuProvider metrics.CPUProvider
+	// Metrics providers (abstraction over gopsutil and platform APIs)
+	cpuProvider     metrics.CPUProvider
+	freqProvider    metrics.CPUFrequencyProvider
+	loadAvgProvider metrics.LoadAvgProvider
+
+	// Text mode format tokens (nil = plain usage percentage)
+	textTokens []render.Token
+	needFreq   bool // {freq} is used, query freqProvider on update
+	needLoad   bool // {load_avg} is used, query loadAvgProvider on update
 
 	// Separate typed fields instead of interface{} to avoid runtime type assertions
 	currentUsageSingle  float64   // Aggregate CPU usage (when perCore=false)
@@ -48,10 +56,20 @@ type Widget struct {
 	historySingle  *util.RingBuffer[float64]   // Aggregate history (when perCore=false)
 	historyPerCore *util.RingBuffer[[]float64] // Per-core history (when perCore=true)
 	hasData        bool                        // Indicates if currentUsage has been set
+	currentMHz     float64                     // Current clock speed (when needFreq)
+	hasFreq        bool                        // Clock speed was readable on the last update
+	currentLoad    metrics.LoadAvgStat         // Load averages (when needLoad)
+	hasLoad        bool                        // Load averages were readable on the last update
 	coreCount      int
 	fontFace       font.Face    // Kept for per-core text rendering
 	fontName       string       // Kept for per-core text rendering
 	mu             sync.RWMutex // Protects currentUsage and history
+
+	// Aggregation of displayed values over a sample window (nil = instantaneous)
+	aggregateMode   string
+	aggregateWindow int
+	aggregator      *util.Aggregator   // Aggregate usage (when perCore=false)
+	coreAggregators []*util.Aggregator // Per-core usage (when perCore=true), sized on first sample
 }
 
 // New creates a new CPU widget
@@ -67,31 +85,56 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 
 	// CPU-specific settings
 	perCore, coreBorder, coreMargin := helper.GetPerCoreSettings()
+	aggregateMode, aggregateWindow := helper.GetAggregateSettings()
 
 	cpuProvider := metrics.DefaultCPU
+	freqProvider := metrics.DefaultCPUFrequency
+	loadAvgProvider := metrics.DefaultLoadAvg
+	if cfg.Demo {
+		demo := metrics.NewDemoCPU()
+		cpuProvider, freqProvider, loadAvgProvider = demo, demo, demo
+	}
 	cores, err := cpuProvider.Counts(true)
 	if err != nil || cores == 0 {
 		cores = 1
 	}
 
+	// Tokens apply to the single-value text mode only
+	var textTokens []render.Token
+	if mr.DisplayMode == render.DisplayModeText && !perCore && cfg.Text != nil {
+		var err error
+		if textTokens, err = render.ParseTextFormat(cfg.Text.Format); err != nil {
+			return nil, fmt.Errorf("text.format: %w", err)
+		}
+	}
+
 	return &Widget{
-		BaseWidget:     base,
-		displayMode:    mr.DisplayMode,
-		perCore:        perCore,
-		padding:        mr.Padding,
-		coreBorder:     coreBorder,
-		coreMargin:     coreMargin,
-		fillColor:      mr.FillColor,
-		historyLen:     mr.HistoryLen,
-		strategy:       mr.Strategy,
-		gridStrategy:   render.GetGridMetricStrategy(mr.DisplayMode),
-		Renderer:       mr.Renderer,
-		cpuProvider:    cpuProvider,
-		historySingle:  util.NewRingBuffer[float64](mr.HistoryLen),
-		historyPerCore: util.NewRingBuffer[[]float64](mr.HistoryLen),
-		coreCount:      cores,
-		fontFace:       mr.FontFace,
-		fontName:       mr.FontName,
+		BaseWidget:      base,
+		displayMode:     mr.DisplayMode,
+		perCore:         perCore,
+		padding:         mr.Padding,
+		coreBorder:      coreBorder,
+		coreMargin:      coreMargin,
+		fillColor:       mr.FillColor,
+		historyLen:      mr.HistoryLen,
+		strategy:        mr.Strategy,
+		gridStrategy:    render.GetGridMetricStrategy(mr.DisplayMode),
+		Renderer:        mr.Renderer,
+		cpuProvider:     cpuProvider,
+		freqProvider:    freqProvider,
+		loadAvgProvider: loadAvgProvider,
+		textTokens:      textTokens,
+		needFreq:        render.UsesToken(textTokens, tokenFreq),
+		needLoad:        render.UsesToken(textTokens, tokenLoadAvg),
+		historySingle:   util.NewRingBuffer[float64](mr.HistoryLen),
+		historyPerCore:  util.NewRingBuffer[[]float64](mr.HistoryLen),
+		coreCount:       cores,
+		fontFace:        mr.FontFace,
+		fontName:        mr.FontName,
+
+		aggregateMode:   aggregateMode,
+		aggregateWindow: aggregateWindow,
+		aggregator:      util.NewAggregator(aggregateMode, aggregateWindow),
 	}, nil
 }
 
@@ -115,7 +158,7 @@ func (w *Widget) Update() error {
 		}
 
 		w.mu.Lock()
-		w.currentUsagePerCore = percentages
+		w.currentUsagePerCore = w.aggregatePerCore(percentages)
 		w.hasData = true
 
 		// Add to history (ring buffer handles capacity automatically)
@@ -143,9 +186,22 @@ func (w *Widget) Update() error {
 			usage = 100
 		}
 
+		// Optional token readings; unavailable values render as empty tokens
+		var mhz float64
+		var load metrics.LoadAvgStat
+		var freqErr, loadErr error
+		if w.needFreq {
+			mhz, freqErr = w.freqProvider.CurrentMHz()
+		}
+		if w.needLoad {
+			load, loadErr = w.loadAvgProvider.LoadAvg()
+		}
+
 		w.mu.Lock()
-		w.currentUsageSingle = usage
+		w.currentUsageSingle = w.aggregator.Add(usage)
 		w.hasData = true
+		w.currentMHz, w.hasFreq = mhz, w.needFreq && freqErr == nil
+		w.currentLoad, w.hasLoad = load, w.needLoad && loadErr == nil
 
 		// Add to history (ring buffer handles capacity automatically)
 		if w.displayMode == render.DisplayModeGraph {
@@ -157,6 +213,28 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// aggregatePerCore applies per-core aggregation, returning raw values when aggregation is off
+// (w.aggregator is nil exactly when the mode is "instant").
+// History keeps the raw samples. Must be called with mu held.
+func (w *Widget) aggregatePerCore(percentages []float64) []float64 {
+	if w.aggregator == nil {
+		return percentages
+	}
+
+	if len(w.coreAggregators) != len(percentages) {
+		w.coreAggregators = make([]*util.Aggregator, len(percentages))
+		for i := range w.coreAggregators {
+			w.coreAggregators[i] = util.NewAggregator(w.aggregateMode, w.aggregateWindow)
+		}
+	}
+
+	result := make([]float64, len(percentages))
+	for i, v := range percentages {
+		result[i] = w.coreAggregators[i].Add(v)
+	}
+	return result
+}
+
 // Render creates an image of the CPU widget
 func (w *Widget) Render() (image.Image, error) {
 	// Create canvas with background and border
@@ -192,6 +270,7 @@ func (w *Widget) Render() (image.Image, error) {
 			BorderColor: borderColor,
 			FontFace:    w.fontFace,
 			FontName:    w.fontName,
+			Orientation: w.Renderer.Text.Orientation,
 		}
 
 		// For graph mode, transpose history from [time][core] to [core][time]
@@ -205,6 +284,17 @@ func (w *Widget) Render() (image.Image, error) {
 		return img, nil
 	}
 
+	if w.textTokens != nil {
+		w.Renderer.RenderText(img, render.FormatText(w.textTokens, tokenValues{
+			usage:   w.currentUsageSingle,
+			mhz:     w.currentMHz,
+			hasFreq: w.hasFreq,
+			load:    w.currentLoad,
+			hasLoad: w.hasLoad,
+		}.text))
+		return img, nil
+	}
+
 	// For single-value mode, use strategy pattern
 	w.strategy.Render(img, render.MetricData{
 		Value:       w.currentUsageSingle,
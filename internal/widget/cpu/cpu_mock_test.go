@@ -1,11 +1,13 @@
 package cpu
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
 	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
 )
 
 // TestWidget_WithMockProvider demonstrates how to use mock providers for testing.
@@ -116,6 +118,78 @@ func TestWidget_MockProvider_PerCore(t *testing.T) {
 	}
 }
 
+// TestWidget_MockProvider_Aggregate tests windowed aggregation of displayed usage
+func TestWidget_MockProvider_Aggregate(t *testing.T) {
+	tests := []struct {
+		name    string
+		perCore bool
+	}{
+		{"single", false},
+		{"per-core", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.WidgetConfig{
+				Type:    "cpu",
+				ID:      "test_cpu_aggregate",
+				Enabled: config.BoolPtr(true),
+				Position: config.PositionConfig{
+					X: 0, Y: 0, W: 128, H: 40,
+				},
+				Mode:            "graph",
+				PerCore:         &config.PerCoreConfig{Enabled: tt.perCore},
+				Aggregate:       "max",
+				AggregateWindow: 3,
+			}
+
+			widget, err := New(cfg)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			samples := []float64{20.0, 90.0, 30.0}
+			call := 0
+			widget.cpuProvider = &metrics.MockCPU{
+				PercentFunc: func(interval time.Duration, perCore bool) ([]float64, error) {
+					v := samples[call]
+					call++
+					return []float64{v, v}, nil
+				},
+			}
+
+			for range samples {
+				if err := widget.Update(); err != nil {
+					t.Fatalf("Update() error = %v", err)
+				}
+			}
+
+			widget.mu.RLock()
+			defer widget.mu.RUnlock()
+
+			if tt.perCore {
+				for i, v := range widget.currentUsagePerCore {
+					if v != 90.0 {
+						t.Errorf("core %d usage = %f, want 90.0 (max)", i, v)
+					}
+				}
+				// History keeps raw samples
+				if last := widget.historyPerCore.Get(widget.historyPerCore.Len() - 1); last[0] != 30.0 {
+					t.Errorf("newest history = %f, want raw 30.0", last[0])
+				}
+				return
+			}
+
+			if widget.currentUsageSingle != 90.0 {
+				t.Errorf("currentUsageSingle = %f, want 90.0 (max)", widget.currentUsageSingle)
+			}
+			if last := widget.historySingle.Get(widget.historySingle.Len() - 1); last != 30.0 {
+				t.Errorf("newest history = %f, want raw 30.0", last)
+			}
+		})
+	}
+}
+
 // TestWidget_MockProvider_EdgeCases tests edge cases using mock
 func TestWidget_MockProvider_EdgeCases(t *testing.T) {
 	tests := []struct {
@@ -208,3 +282,93 @@ type testError struct {
 func (e *testError) Error() string {
 	return e.msg
 }
+
+// TestWidget_Demo verifies demo mode feeds synthetic per-core load
+func TestWidget_Demo(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "cpu",
+		ID:       "test_cpu_demo",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "text",
+		Demo:     true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, ok := widget.cpuProvider.(*metrics.DemoCPU); !ok {
+		t.Fatalf("cpuProvider = %T, want *metrics.DemoCPU", widget.cpuProvider)
+	}
+	if widget.coreCount != metrics.DemoCPUCores {
+		t.Errorf("coreCount = %d, want %d", widget.coreCount, metrics.DemoCPUCores)
+	}
+	if err := widget.Update(); err != nil {
+		t.Errorf("Update() error = %v", err)
+	}
+}
+
+// TestNew_RejectsTokenStyles tests that a styled token such as {usage|dim} is an error
+func TestNew_RejectsTokenStyles(t *testing.T) {
+	_, err := New(config.WidgetConfig{
+		Type:     "cpu",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Mode:     "text",
+		Text:     &config.TextConfig{Format: "{usage|dim}"},
+	})
+	if err == nil {
+		t.Error("New() should reject token styles")
+	}
+}
+
+// TestWidget_MockProvider_TextTokens tests frequency and load average tokens in text mode
+func TestWidget_MockProvider_TextTokens(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:    "cpu",
+		ID:      "test_cpu_mock_tokens",
+		Enabled: config.BoolPtr(true),
+		Position: config.PositionConfig{
+			X: 0, Y: 0, W: 128, H: 40,
+		},
+		Mode: "text",
+		Text: &config.TextConfig{Format: "{usage} {freq} {load_avg}"},
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !widget.needFreq || !widget.needLoad {
+		t.Fatalf("needFreq = %v, needLoad = %v, want both true", widget.needFreq, widget.needLoad)
+	}
+
+	widget.cpuProvider = &metrics.MockCPU{}
+	widget.freqProvider = &metrics.MockCPUFrequency{}
+	widget.loadAvgProvider = &metrics.MockLoadAvg{
+		LoadAvgFunc: func() (metrics.LoadAvgStat, error) {
+			return metrics.LoadAvgStat{}, errors.New("not supported")
+		},
+	}
+
+	if err := widget.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	widget.mu.RLock()
+	text := render.FormatText(widget.textTokens, tokenValues{
+		usage:   widget.currentUsageSingle,
+		mhz:     widget.currentMHz,
+		hasFreq: widget.hasFreq,
+		load:    widget.currentLoad,
+		hasLoad: widget.hasLoad,
+	}.text)
+	widget.mu.RUnlock()
+
+	if text != "50% 3.8GHz" {
+		t.Errorf("text = %q, want %q", text, "50% 3.8GHz")
+	}
+
+	if _, err := widget.Render(); err != nil {
+		t.Errorf("Render() error = %v", err)
+	}
+}
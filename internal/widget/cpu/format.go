@@ -0,0 +1,68 @@
+package cpu
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+)
+
+// Text format tokens, e.g. "{usage} {freq}" renders "45% 3.8GHz"
+const (
+	tokenUsage   = "usage"    // {usage} or {usage:N} for N decimals
+	tokenFreq    = "freq"     // {freq}, {freq:mhz} or {freq:ghz}
+	tokenLoadAvg = "load_avg" // {load_avg} (1 minute), {load_avg:5} or {load_avg:15}
+)
+
+// Frequency unit modifiers for {freq}
+const (
+	freqUnitMHz = "mhz"
+	freqUnitGHz = "ghz"
+)
+
+// tokenValues holds the readings substituted into text format tokens
+type tokenValues struct {
+	usage   float64
+	mhz     float64
+	hasFreq bool
+	load    metrics.LoadAvgStat
+	hasLoad bool
+}
+
+// text returns the text of a format token. Unknown tokens and values that
+// aren't available render as empty strings.
+func (v tokenValues) text(t render.Token) string {
+	switch t.Name {
+	case tokenUsage:
+		return render.FormatPercent(v.usage, t.Param)
+	case tokenFreq:
+		if !v.hasFreq {
+			return ""
+		}
+		return formatFrequency(v.mhz, t.Param)
+	case tokenLoadAvg:
+		if !v.hasLoad {
+			return ""
+		}
+		value := v.load.Load1
+		switch t.Param {
+		case "5":
+			value = v.load.Load5
+		case "15":
+			value = v.load.Load15
+		}
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	}
+	return ""
+}
+
+// formatFrequency formats a clock speed in MHz. Without a unit modifier,
+// speeds of 1000 MHz and above are shown in GHz.
+func formatFrequency(mhz float64, unit string) string {
+	unit = strings.ToLower(strings.TrimSpace(unit))
+	if unit == freqUnitGHz || (unit != freqUnitMHz && mhz >= 1000) {
+		return strconv.FormatFloat(mhz/1000, 'f', 1, 64) + "GHz"
+	}
+	return strconv.FormatFloat(mhz, 'f', 0, 64) + "MHz"
+}
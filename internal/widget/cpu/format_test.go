@@ -0,0 +1,48 @@
+package cpu
+
+import (
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+)
+
+func TestFormatText(t *testing.T) {
+	values := tokenValues{
+		usage:   45.26,
+		mhz:     3812,
+		hasFreq: true,
+		load:    metrics.LoadAvgStat{Load1: 1.5, Load5: 0.75, Load15: 0.25},
+		hasLoad: true,
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		values tokenValues
+		want   string
+	}{
+		{"usage and freq", "{usage} {freq}", values, "45% 3.8GHz"},
+		{"usage decimals", "{usage:1}", values, "45.3%"},
+		{"freq mhz", "{freq:mhz}", values, "3812MHz"},
+		{"freq ghz below 1000", "{freq:ghz}", tokenValues{mhz: 800, hasFreq: true}, "0.8GHz"},
+		{"freq auto below 1000", "{freq}", tokenValues{mhz: 800, hasFreq: true}, "800MHz"},
+		{"load avg windows", "{load_avg} {load_avg:5} {load_avg:15}", values, "1.50 0.75 0.25"},
+		{"freq unavailable", "{usage} {freq}", tokenValues{usage: 45}, "45%"},
+		{"load unavailable", "L:{load_avg}", tokenValues{}, "L:"},
+		{"unknown token", "{usage}{bogus}", values, "45%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := render.ParseTextFormat(tt.format)
+			if err != nil {
+				t.Fatalf("ParseTextFormat(%q) error = %v", tt.format, err)
+			}
+			got := render.FormatText(tokens, tt.values.text)
+			if got != tt.want {
+				t.Errorf("formatText(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
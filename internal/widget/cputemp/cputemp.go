@@ -0,0 +1,177 @@
+package cputemp
+
+import (
+	"errors"
+	"image"
+	"sync"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	"github.com/pozitronik/steelclock-go/internal/shared/util"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+)
+
+func init() {
+	widget.Register("cpu_temp", func(cfg config.WidgetConfig) (widget.Widget, error) {
+		return New(cfg)
+	})
+}
+
+// defaultTextFormat is the text mode format when text.format is not set
+const defaultTextFormat = "%.0f°C"
+
+// sensorRetryInterval is how often a widget without a temperature sensor looks
+// for one again; drivers and sensors can appear after startup
+const sensorRetryInterval = time.Minute
+
+// Widget displays the CPU package temperature
+type Widget struct {
+	*widget.BaseWidget
+	mu             sync.RWMutex
+	strategy       render.MetricDisplayStrategy
+	Renderer       *render.MetricRenderer
+	displayMode    render.DisplayMode
+	statusRenderer *render.StatusRenderer
+	maxTempC       float64
+	currentTemp    float64                   // Last reading in °C
+	history        *util.RingBuffer[float64] // Readings scaled to 0-100 of maxTempC
+	hasData        bool
+	unavailable    bool      // The platform exposes no CPU temperature sensor
+	lastProbe      time.Time // Last sensor read while unavailable
+	textFormat     string
+	tempProvider   metrics.CPUTemperatureProvider
+}
+
+// New creates a new CPU temperature widget
+func New(cfg config.WidgetConfig) (*Widget, error) {
+	base := widget.NewBaseWidget(cfg)
+	helper := shared.NewConfigHelper(cfg)
+
+	mr, err := helper.BuildMetricRenderer()
+	if err != nil {
+		return nil, err
+	}
+
+	maxTempC := cfg.MaxTempC
+	if maxTempC <= 0 {
+		maxTempC = config.DefaultMaxTempC
+	}
+
+	textFormat := defaultTextFormat
+	if cfg.Text != nil && cfg.Text.Format != "" {
+		textFormat = cfg.Text.Format
+	}
+
+	var tempProvider metrics.CPUTemperatureProvider = metrics.DefaultCPUTemperature
+	if cfg.Demo {
+		tempProvider = metrics.NewDemoCPU()
+	}
+
+	return &Widget{
+		BaseWidget:     base,
+		strategy:       mr.Strategy,
+		Renderer:       mr.Renderer,
+		displayMode:    mr.DisplayMode,
+		statusRenderer: render.NewStatusRenderer("5x7"),
+		maxTempC:       maxTempC,
+		history:        util.NewRingBuffer[float64](mr.HistoryLen),
+		textFormat:     textFormat,
+		tempProvider:   tempProvider,
+	}, nil
+}
+
+// Update reads the current CPU temperature.
+// While the platform reports no temperature sensor, Render shows "N/A" and the
+// sensor is only probed again every sensorRetryInterval.
+func (w *Widget) Update() error {
+	now := time.Now()
+	w.mu.RLock()
+	waiting := w.unavailable && now.Sub(w.lastProbe) < sensorRetryInterval
+	w.mu.RUnlock()
+	if waiting {
+		return nil
+	}
+
+	celsius, err := w.tempProvider.CurrentCelsius()
+	if errors.Is(err, metrics.ErrCPUTemperatureUnavailable) {
+		w.mu.Lock()
+		w.unavailable = true
+		w.lastProbe = now
+		w.mu.Unlock()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.unavailable = false
+	w.currentTemp = celsius
+	w.hasData = true
+	if w.displayMode == render.DisplayModeGraph {
+		w.history.Push(w.scale(celsius))
+	}
+
+	return nil
+}
+
+// scale converts a temperature to the 0-100 range used by bar, graph and gauge
+func (w *Widget) scale(celsius float64) float64 {
+	percent := celsius / w.maxTempC * 100
+	if percent < 0 {
+		return 0
+	}
+	if percent > 100 {
+		return 100
+	}
+	return percent
+}
+
+// GetValue returns the current temperature in °C (thread-safe)
+func (w *Widget) GetValue() float64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.currentTemp
+}
+
+// Render creates an image of the CPU temperature widget
+func (w *Widget) Render() (image.Image, error) {
+	img := w.CreateCanvas()
+	w.ApplyBorder(img)
+
+	content := w.GetContentArea()
+	pos := w.GetPosition()
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.unavailable {
+		w.statusRenderer.DrawCentered(img, "N/A", content.X, content.Y, content.Width, content.Height)
+		return img, nil
+	}
+
+	if !w.hasData {
+		return img, nil
+	}
+
+	// Text shows degrees; the other modes show the temperature relative to max_temp_c
+	value := w.scale(w.currentTemp)
+	if w.displayMode == render.DisplayModeText {
+		value = w.currentTemp
+	}
+
+	w.strategy.Render(img, render.MetricData{
+		Value:       value,
+		History:     w.history.ToSlice(),
+		TextFormat:  w.textFormat,
+		ContentArea: image.Rect(content.X, content.Y, content.X+content.Width, content.Y+content.Height),
+		GaugeArea:   image.Rect(0, 0, pos.W, pos.H),
+	}, w.Renderer)
+
+	return img, nil
+}
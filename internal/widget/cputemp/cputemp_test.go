@@ -0,0 +1,199 @@
+package cputemp
+
+import (
+	"errors"
+	"image"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+)
+
+func newTestWidget(t *testing.T, mode string, maxTempC float64) *Widget {
+	t.Helper()
+	cfg := config.WidgetConfig{
+		Type:    "cpu_temp",
+		ID:      "test_cpu_temp",
+		Enabled: config.BoolPtr(true),
+		Position: config.PositionConfig{
+			X: 0, Y: 0, W: 64, H: 20,
+		},
+		Mode:     mode,
+		MaxTempC: maxTempC,
+		Colors: &config.ColorsConfig{
+			Fill: config.IntPtr(255),
+		},
+		Graph: &config.GraphConfig{
+			History: 30,
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return w
+}
+
+func countLit(img image.Image) int {
+	gray := img.(*image.Gray)
+	lit := 0
+	for _, p := range gray.Pix {
+		if p > 0 {
+			lit++
+		}
+	}
+	return lit
+}
+
+func TestWidget_Scale(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxTempC float64
+		celsius  float64
+		want     float64
+	}{
+		{"default scale", 0, 65, 65},
+		{"custom scale", 80, 60, 75},
+		{"above max clamped", 80, 95, 100},
+		{"below zero clamped", 100, -5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWidget(t, "bar", tt.maxTempC)
+			if got := w.scale(tt.celsius); got != tt.want {
+				t.Errorf("scale(%v) = %v, want %v", tt.celsius, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWidget_UpdateWithMockProvider(t *testing.T) {
+	w := newTestWidget(t, "graph", 0)
+	w.tempProvider = &metrics.MockCPUTemperature{
+		CurrentCelsiusFunc: func() (float64, error) { return 72.5, nil },
+	}
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got := w.GetValue(); got != 72.5 {
+		t.Errorf("GetValue() = %v, want 72.5", got)
+	}
+	if h := w.history.ToSlice(); len(h) != 1 || h[0] != 72.5 {
+		t.Errorf("history = %v, want [72.5]", h)
+	}
+}
+
+func TestWidget_UpdateError(t *testing.T) {
+	w := newTestWidget(t, "text", 0)
+	readErr := errors.New("sensor read failed")
+	w.tempProvider = &metrics.MockCPUTemperature{
+		CurrentCelsiusFunc: func() (float64, error) { return 0, readErr },
+	}
+
+	if err := w.Update(); !errors.Is(err, readErr) {
+		t.Errorf("Update() error = %v, want %v", err, readErr)
+	}
+}
+
+func TestWidget_Unavailable(t *testing.T) {
+	w := newTestWidget(t, "gauge", 0)
+	calls := 0
+	w.tempProvider = &metrics.MockCPUTemperature{
+		CurrentCelsiusFunc: func() (float64, error) {
+			calls++
+			return 0, metrics.ErrCPUTemperatureUnavailable
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := w.Update(); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("provider called %d times, want one probe per retry interval", calls)
+	}
+
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if countLit(img) == 0 {
+		t.Error("Render() should draw N/A when the temperature is unavailable")
+	}
+}
+
+func TestWidget_SensorAppearsLater(t *testing.T) {
+	w := newTestWidget(t, "text", 0)
+	available := false
+	w.tempProvider = &metrics.MockCPUTemperature{
+		CurrentCelsiusFunc: func() (float64, error) {
+			if !available {
+				return 0, metrics.ErrCPUTemperatureUnavailable
+			}
+			return 61, nil
+		},
+	}
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	// The driver loads; the next probe after the retry interval picks it up
+	available = true
+	w.mu.Lock()
+	w.lastProbe = w.lastProbe.Add(-sensorRetryInterval)
+	w.mu.Unlock()
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if w.unavailable || w.GetValue() != 61 {
+		t.Errorf("unavailable = %v, value = %v; want the new reading", w.unavailable, w.GetValue())
+	}
+}
+
+func TestWidget_RenderAllModes(t *testing.T) {
+	for _, mode := range []string{"text", "bar", "graph", "gauge"} {
+		t.Run(mode, func(t *testing.T) {
+			w := newTestWidget(t, mode, 0)
+			w.tempProvider = &metrics.MockCPUTemperature{}
+
+			for i := 0; i < 3; i++ {
+				if err := w.Update(); err != nil {
+					t.Fatalf("Update() error = %v", err)
+				}
+			}
+
+			img, err := w.Render()
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if countLit(img) == 0 {
+				t.Errorf("Render() in %s mode drew nothing", mode)
+			}
+		})
+	}
+}
+
+func TestWidget_Demo(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "cpu_temp",
+		ID:       "test_cpu_temp_demo",
+		Position: config.PositionConfig{W: 64, H: 20},
+		Demo:     true,
+	}
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got := w.GetValue(); got < 40 || got > 85 {
+		t.Errorf("demo temperature = %v, want 40-85", got)
+	}
+}
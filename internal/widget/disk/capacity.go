@@ -0,0 +1,161 @@
+package disk
+
+import (
+	"fmt"
+	"image"
+	"log"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	"github.com/pozitronik/steelclock-go/internal/shared/util"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+)
+
+// Text format tokens in capacity metric, e.g. "{free_gb} GB free" renders "120.4 GB free"
+const (
+	tokenPercent = "percent"  // {percent} or {percent:N} for N decimals
+	tokenUsedGB  = "used_gb"  // {used_gb} or {used_gb:N}, one decimal by default
+	tokenFreeGB  = "free_gb"  // {free_gb} or {free_gb:N}, one decimal by default
+	tokenTotalGB = "total_gb" // {total_gb} or {total_gb:N}, one decimal by default
+)
+
+// capacityMeter shows how full a file system is, using the single-value renderers
+// shared with the CPU and memory widgets
+type capacityMeter struct {
+	base        *widget.BaseWidget
+	path        string
+	provider    metrics.DiskUsageProvider
+	strategy    render.MetricDisplayStrategy
+	renderer    *render.MetricRenderer
+	displayMode render.DisplayMode
+
+	// Text mode format tokens (nil = plain usage percentage)
+	textTokens []render.Token
+
+	mu      sync.RWMutex
+	stat    metrics.DiskUsageStat
+	history *util.RingBuffer[float64]
+}
+
+// newCapacityMeter creates the capacity display for the mount point in cfg.Disk,
+// or for the system drive when none is configured
+func newCapacityMeter(base *widget.BaseWidget, cfg config.WidgetConfig) (*capacityMeter, error) {
+	mr, err := shared.NewConfigHelper(cfg).BuildMetricRenderer()
+	if err != nil {
+		return nil, err
+	}
+
+	path := systemDrive()
+	if cfg.Disk != nil && *cfg.Disk != "" {
+		if metrics.IsMountPath(*cfg.Disk) {
+			path = *cfg.Disk
+		} else {
+			log.Printf("disk widget %s: capacity needs a mount point, not device %s, falling back to %s",
+				cfg.ID, *cfg.Disk, path)
+		}
+	}
+
+	var provider metrics.DiskUsageProvider = metrics.DefaultDiskUsage
+	if cfg.Demo {
+		provider = metrics.NewDemoDiskUsage()
+	}
+
+	// Tokens apply to text mode only
+	var textTokens []render.Token
+	if mr.DisplayMode == render.DisplayModeText && cfg.Text != nil {
+		var err error
+		if textTokens, err = render.ParseTextFormat(cfg.Text.Format); err != nil {
+			return nil, fmt.Errorf("text.format: %w", err)
+		}
+	}
+
+	return &capacityMeter{
+		base:        base,
+		path:        path,
+		provider:    provider,
+		strategy:    mr.Strategy,
+		renderer:    mr.Renderer,
+		displayMode: mr.DisplayMode,
+		textTokens:  textTokens,
+		history:     util.NewRingBuffer[float64](mr.HistoryLen),
+	}, nil
+}
+
+// systemDrive returns the mount point measured when no disk is configured
+func systemDrive() string {
+	if runtime.GOOS != "windows" {
+		return "/"
+	}
+	if drive := os.Getenv("SystemDrive"); drive != "" {
+		return drive + `\`
+	}
+	return `C:\`
+}
+
+// update reads the file system capacity
+func (c *capacityMeter) update() error {
+	stat, err := c.provider.Usage(c.path)
+	if err != nil {
+		return err
+	}
+	stat.UsedPercent = min(max(stat.UsedPercent, 0), 100)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stat = stat
+	if c.displayMode == render.DisplayModeGraph {
+		c.history.Push(stat.UsedPercent)
+	}
+	return nil
+}
+
+// render draws the used percentage, or the formatted text when tokens are configured
+func (c *capacityMeter) render() (image.Image, error) {
+	img := c.base.CreateCanvas()
+	c.base.ApplyBorder(img)
+
+	content := c.base.GetContentArea()
+	pos := c.base.GetPosition()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.textTokens != nil {
+		c.renderer.RenderText(img, render.FormatText(c.textTokens, textValue(c.stat)))
+		return img, nil
+	}
+
+	c.strategy.Render(img, render.MetricData{
+		Value:       c.stat.UsedPercent,
+		History:     c.history.ToSlice(),
+		TextFormat:  "%.0f",
+		ContentArea: image.Rect(content.X, content.Y, content.X+content.Width, content.Y+content.Height),
+		GaugeArea:   image.Rect(0, 0, pos.W, pos.H),
+	}, c.renderer)
+
+	return img, nil
+}
+
+// textValue returns the text of format tokens for the given reading.
+// Unknown tokens render as empty strings.
+func textValue(stat metrics.DiskUsageStat) render.TokenValue {
+	return func(t render.Token) string {
+		switch t.Name {
+		case tokenPercent:
+			return render.FormatPercent(stat.UsedPercent, t.Param)
+		case tokenUsedGB:
+			return render.FormatGB(stat.Used, t.Param)
+		case tokenFreeGB:
+			return render.FormatGB(stat.Free, t.Param)
+		case tokenTotalGB:
+			return render.FormatGB(stat.Total, t.Param)
+		}
+		return ""
+	}
+}
@@ -1,7 +1,11 @@
 package disk
 
 import (
+	"errors"
 	"fmt"
+	"image"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
@@ -26,6 +30,14 @@ type Widget struct {
 	diskName     *string
 	diskProvider metrics.DiskProvider
 
+	// Capacity display, replacing throughput when metric is "capacity"
+	capacity *capacityMeter
+
+	// I/O scope: volume, system or process
+	scope           string
+	processMatch    string
+	processProvider metrics.ProcessIOProvider
+
 	// State for delta calculation
 	lastRead  uint64
 	lastWrite uint64
@@ -43,6 +55,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	padding := helper.GetPadding()
 	barSettings := helper.GetBarSettings()
 	graphSettings := helper.GetGraphSettings()
+	aggregateMode, aggregateWindow := helper.GetAggregateSettings()
 
 	// Extract disk-specific colors (read/write)
 	readColor := 255
@@ -117,11 +130,12 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		},
 		render.DualGaugeConfig{}, // Not used for disk
 		render.TextConfig{
-			FontFace:   fontFace,
-			FontName:   textSettings.FontName,
-			HorizAlign: textSettings.HorizAlign,
-			VertAlign:  textSettings.VertAlign,
-			Padding:    padding,
+			FontFace:    fontFace,
+			FontName:    textSettings.FontName,
+			HorizAlign:  textSettings.HorizAlign,
+			VertAlign:   textSettings.VertAlign,
+			Padding:     padding,
+			Orientation: textSettings.Orientation,
 		},
 	)
 
@@ -133,6 +147,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		MaxSpeedBps:   maxSpeedBps,
 		Unit:          unit,
 		ShowUnit:      showUnit,
+		TextMode:      cfg.TextMode,
 		SupportsGauge: false, // Disk doesn't support gauge mode
 		TextConfig: widgetbase.DualIOTextConfig{
 			PrimaryPrefix:   "R",
@@ -141,36 +156,87 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		Converter:  converter,
 		Renderer:   renderer,
 		HistoryLen: graphSettings.HistoryLen,
+
+		AggregateMode:   aggregateMode,
+		AggregateWindow: aggregateWindow,
 	})
 
-	return &Widget{
-		DualIOWidget: baseDualIO,
-		diskName:     cfg.Disk,
-		diskProvider: metrics.DefaultDisk,
-	}, nil
+	scope := cfg.Scope
+	if scope == "" {
+		scope = config.DiskScopeVolume
+	}
+
+	// A mount point ("C:", "/home") is resolved to its device once; demo data has no mounts
+	capacityMetric := cfg.Metric == config.DiskMetricCapacity
+	diskName := cfg.Disk
+	if !cfg.Demo && !capacityMetric && diskName != nil && metrics.IsMountPath(*diskName) {
+		device, err := metrics.DiskDeviceForMount(*diskName)
+		if err != nil {
+			log.Printf("disk widget %s: %v, falling back to all disks", cfg.ID, err)
+			diskName = nil
+		} else {
+			diskName = &device
+		}
+	}
+
+	diskProvider := metrics.DefaultDisk
+	var processProvider metrics.ProcessIOProvider
+	if cfg.Demo {
+		name := ""
+		if cfg.Disk != nil {
+			name = *cfg.Disk
+		}
+		diskProvider = metrics.NewDemoDisk(name)
+		// Demo data has no processes; the demo device stands in for every scope
+		if scope == config.DiskScopeProcess {
+			scope = config.DiskScopeVolume
+		}
+	} else if scope == config.DiskScopeProcess {
+		processProvider = metrics.NewGopsutilProcessIO()
+	}
+
+	// Without a matcher there is nothing to filter by
+	if scope == config.DiskScopeProcess && strings.TrimSpace(cfg.Process) == "" {
+		log.Printf("disk widget %s: no process matcher configured, using volume scope", cfg.ID)
+		scope = config.DiskScopeVolume
+	}
+
+	w := &Widget{
+		DualIOWidget:    baseDualIO,
+		diskName:        diskName,
+		diskProvider:    diskProvider,
+		scope:           scope,
+		processMatch:    cfg.Process,
+		processProvider: processProvider,
+	}
+
+	if capacityMetric {
+		w.capacity, err = newCapacityMeter(base, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// Render draws the capacity or the throughput, depending on the metric
+func (w *Widget) Render() (image.Image, error) {
+	if w.capacity != nil {
+		return w.capacity.render()
+	}
+	return w.DualIOWidget.Render()
 }
 
 // Update updates the disk stats
 func (w *Widget) Update() error {
-	stats, err := w.diskProvider.IOCounters()
-	if err != nil {
-		return err
+	if w.capacity != nil {
+		return w.capacity.update()
 	}
 
-	// Find the disk
-	var readBytes, writeBytes uint64
-	if w.diskName != nil && *w.diskName != "" {
-		// Use specified disk
-		if stat, ok := stats[*w.diskName]; ok {
-			readBytes = stat.ReadBytes
-			writeBytes = stat.WriteBytes
-		}
-	} else {
-		// Sum all disks
-		for _, stat := range stats {
-			readBytes += stat.ReadBytes
-			writeBytes += stat.WriteBytes
-		}
+	readBytes, writeBytes, err := w.readCounters()
+	if err != nil {
+		return err
 	}
 
 	now := time.Now()
@@ -193,3 +259,40 @@ func (w *Widget) Update() error {
 
 	return nil
 }
+
+// readCounters returns cumulative read/write bytes for the configured scope
+func (w *Widget) readCounters() (readBytes, writeBytes uint64, err error) {
+	if w.scope == config.DiskScopeProcess {
+		stat, err := w.processProvider.ProcessIO(w.processMatch)
+		if err == nil {
+			return stat.ReadBytes, stat.WriteBytes, nil
+		}
+		if !errors.Is(err, metrics.ErrProcessIOUnavailable) {
+			return 0, 0, err
+		}
+		log.Printf("disk widget %s: %v, falling back to volume scope", w.Name(), err)
+		w.scope = config.DiskScopeVolume
+		// Counters from a different source must not be used for the next delta
+		w.lastTime = time.Time{}
+	}
+
+	stats, err := w.diskProvider.IOCounters()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if w.scope == config.DiskScopeVolume && w.diskName != nil && *w.diskName != "" {
+		// Use specified disk
+		if stat, ok := stats[*w.diskName]; ok {
+			return stat.ReadBytes, stat.WriteBytes, nil
+		}
+		return 0, 0, nil
+	}
+
+	// Sum all disks
+	for _, stat := range stats {
+		readBytes += stat.ReadBytes
+		writeBytes += stat.WriteBytes
+	}
+	return readBytes, writeBytes, nil
+}
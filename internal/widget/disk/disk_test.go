@@ -1,9 +1,13 @@
 package disk
 
 import (
+	"errors"
+	"image"
+	"runtime"
 	"testing"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/metrics"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
 )
 
@@ -376,6 +380,52 @@ func TestWidget_SpecificDisk(t *testing.T) {
 	}
 }
 
+// TestNew_MountPoint tests that a mount point is resolved to a device name,
+// or falls back to all disks when it cannot be resolved
+func TestNew_MountPoint(t *testing.T) {
+	mount := "/"
+	if runtime.GOOS == "windows" {
+		mount = `c:\`
+	}
+	cfg := config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_mount",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Disk:     &mount,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if widget.diskName != nil && *widget.diskName == mount {
+		t.Errorf("diskName = %q, want a resolved device or all disks", *widget.diskName)
+	}
+	if err := widget.Update(); err != nil {
+		t.Errorf("Update() error = %v", err)
+	}
+}
+
+// TestNew_DemoMountPoint tests that demo mode keeps the configured name
+func TestNew_DemoMountPoint(t *testing.T) {
+	mount := "/home"
+	cfg := config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_demo_mount",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Disk:     &mount,
+		Demo:     true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if widget.diskName == nil || *widget.diskName != mount {
+		t.Errorf("diskName = %v, want %q", widget.diskName, mount)
+	}
+}
+
 // TestWidget_ConcurrentAccess tests thread safety
 func TestWidget_ConcurrentAccess(t *testing.T) {
 	cfg := config.WidgetConfig{
@@ -415,3 +465,264 @@ func TestWidget_ConcurrentAccess(t *testing.T) {
 	<-done
 	// Should not panic or race
 }
+
+// TestNew_Demo verifies demo mode reports I/O on the configured disk
+func TestNew_Demo(t *testing.T) {
+	name := "sda"
+	cfg := config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_demo",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Disk:     &name,
+		Demo:     true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, ok := widget.diskProvider.(*metrics.DemoDisk); !ok {
+		t.Fatalf("diskProvider = %T, want *metrics.DemoDisk", widget.diskProvider)
+	}
+
+	stats, err := widget.diskProvider.IOCounters()
+	if err != nil {
+		t.Fatalf("IOCounters() error = %v", err)
+	}
+	if _, ok := stats[name]; !ok {
+		t.Errorf("IOCounters() = %v, want device %s", stats, name)
+	}
+}
+
+// TestWidget_SystemScope verifies system scope sums all disks even when a disk is named
+func TestWidget_SystemScope(t *testing.T) {
+	name := "sda"
+	widget, err := New(config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_system",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Disk:     &name,
+		Scope:    config.DiskScopeSystem,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	widget.diskProvider = &metrics.MockDisk{}
+
+	if err := widget.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if widget.lastRead != 2500000 || widget.lastWrite != 1250000 {
+		t.Errorf("counters = %d/%d, want 2500000/1250000", widget.lastRead, widget.lastWrite)
+	}
+}
+
+// TestWidget_ProcessScope verifies process scope reads the process provider
+func TestWidget_ProcessScope(t *testing.T) {
+	widget, err := New(config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_process",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Scope:    config.DiskScopeProcess,
+		Process:  "chrome",
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var matched string
+	widget.processProvider = &metrics.MockProcessIO{
+		ProcessIOFunc: func(match string) (metrics.DiskStat, error) {
+			matched = match
+			return metrics.DiskStat{ReadBytes: 4000, WriteBytes: 1000}, nil
+		},
+	}
+
+	if err := widget.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if matched != "chrome" {
+		t.Errorf("matcher = %q, want chrome", matched)
+	}
+	if widget.lastRead != 4000 || widget.lastWrite != 1000 {
+		t.Errorf("counters = %d/%d, want 4000/1000", widget.lastRead, widget.lastWrite)
+	}
+}
+
+// TestWidget_ProcessScopeFallback verifies the widget switches to volume scope
+// when process I/O is not available
+func TestWidget_ProcessScopeFallback(t *testing.T) {
+	name := "sdb"
+	widget, err := New(config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_fallback",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Disk:     &name,
+		Scope:    config.DiskScopeProcess,
+		Process:  "chrome",
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	widget.diskProvider = &metrics.MockDisk{}
+	widget.processProvider = &metrics.MockProcessIO{
+		ProcessIOFunc: func(string) (metrics.DiskStat, error) {
+			return metrics.DiskStat{}, metrics.ErrProcessIOUnavailable
+		},
+	}
+
+	if err := widget.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if widget.scope != config.DiskScopeVolume {
+		t.Errorf("scope = %q, want %q", widget.scope, config.DiskScopeVolume)
+	}
+	if widget.lastRead != 500000 || widget.lastWrite != 250000 {
+		t.Errorf("counters = %d/%d, want sdb 500000/250000", widget.lastRead, widget.lastWrite)
+	}
+
+	// Other provider errors are reported without changing scope
+	widget.scope = config.DiskScopeProcess
+	widget.processProvider = &metrics.MockProcessIO{
+		ProcessIOFunc: func(string) (metrics.DiskStat, error) {
+			return metrics.DiskStat{}, errors.New("transient")
+		},
+	}
+	if err := widget.Update(); err == nil {
+		t.Error("expected error from process provider")
+	}
+	if widget.scope != config.DiskScopeProcess {
+		t.Errorf("scope = %q, want %q", widget.scope, config.DiskScopeProcess)
+	}
+}
+
+// TestNew_ProcessScopeWithoutMatcher verifies a missing matcher falls back to volume scope
+func TestNew_ProcessScopeWithoutMatcher(t *testing.T) {
+	widget, err := New(config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_no_matcher",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Scope:    config.DiskScopeProcess,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if widget.scope != config.DiskScopeVolume {
+		t.Errorf("scope = %q, want %q", widget.scope, config.DiskScopeVolume)
+	}
+}
+
+// TestWidget_CapacityMetric tests the used percentage and text tokens of the capacity metric
+func TestWidget_CapacityMetric(t *testing.T) {
+	mount := "/data"
+	if runtime.GOOS == "windows" {
+		mount = "D:"
+	}
+	cfg := config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_capacity",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Mode:     "text",
+		Disk:     &mount,
+		Metric:   config.DiskMetricCapacity,
+		Text:     &config.TextConfig{Format: "{free_gb} of {total_gb:0} GB free, {percent}"},
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if widget.capacity == nil {
+		t.Fatal("capacity metric should create a capacity meter")
+	}
+
+	var gotPath string
+	widget.capacity.provider = &metrics.MockDiskUsage{
+		UsageFunc: func(path string) (metrics.DiskUsageStat, error) {
+			gotPath = path
+			return metrics.DiskUsageStat{Total: 500 << 30, Free: 150 << 29, Used: 425 << 29, UsedPercent: 85}, nil
+		},
+	}
+	if err := widget.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if gotPath != mount {
+		t.Errorf("usage path = %q, want %q", gotPath, mount)
+	}
+
+	want := "75.0 of 500 GB free, 85%"
+	if got := render.FormatText(widget.capacity.textTokens, textValue(widget.capacity.stat)); got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+	if img, err := widget.Render(); err != nil || img == nil {
+		t.Errorf("Render() = %v, %v", img, err)
+	}
+}
+
+// TestWidget_CapacityModes tests capacity rendering in every display mode, including gauge
+func TestWidget_CapacityModes(t *testing.T) {
+	for _, mode := range []string{"text", "bar", "graph", "gauge"} {
+		t.Run(mode, func(t *testing.T) {
+			cfg := config.WidgetConfig{
+				Type:     "disk",
+				ID:       "test_disk_capacity_" + mode,
+				Position: config.PositionConfig{W: 128, H: 40},
+				Mode:     mode,
+				Metric:   config.DiskMetricCapacity,
+			}
+			widget, err := New(cfg)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			widget.capacity.provider = &metrics.MockDiskUsage{}
+
+			for i := 0; i < 3; i++ {
+				if err := widget.Update(); err != nil {
+					t.Fatalf("Update() error = %v", err)
+				}
+			}
+			if widget.capacity.stat.UsedPercent != 60 {
+				t.Errorf("used percent = %v, want 60", widget.capacity.stat.UsedPercent)
+			}
+
+			img, err := widget.Render()
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			lit := false
+			for _, p := range img.(*image.Gray).Pix {
+				if p > 0 {
+					lit = true
+					break
+				}
+			}
+			if !lit {
+				t.Error("Render() drew nothing")
+			}
+		})
+	}
+}
+
+// TestNew_CapacityDeviceName tests that a device name falls back to the system drive
+func TestNew_CapacityDeviceName(t *testing.T) {
+	device := "sda"
+	cfg := config.WidgetConfig{
+		Type:     "disk",
+		ID:       "test_disk_capacity_device",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Disk:     &device,
+		Metric:   config.DiskMetricCapacity,
+		Demo:     true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if widget.capacity.path != systemDrive() {
+		t.Errorf("path = %q, want system drive %q", widget.capacity.path, systemDrive())
+	}
+	if _, ok := widget.capacity.provider.(*metrics.DemoDiskUsage); !ok {
+		t.Errorf("provider = %T, want *metrics.DemoDiskUsage", widget.capacity.provider)
+	}
+}
@@ -0,0 +1,127 @@
+package doom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// demoLumps are the attract loop demos replaced by a configured demo
+var demoLumps = []string{"DEMO1", "DEMO2", "DEMO3", "DEMO4"}
+
+const (
+	demoVersion19  = 109  // Doom v1.9 demo format
+	demoVersion191 = 111  // Doom v1.91 demo format (long tics)
+	demoHeaderSize = 13   // Version, skill, episode, map, flags and players
+	demoEndMarker  = 0x80 // Last byte of a demo
+)
+
+// validateDemo checks that data is a demo the engine can play back
+func validateDemo(data []byte) error {
+	if len(data) < demoHeaderSize+1 {
+		return fmt.Errorf("demo is too short")
+	}
+	if v := data[0]; v != demoVersion19 && v != demoVersion191 {
+		return fmt.Errorf("unsupported demo version %d (only v1.9 demos are supported)", v)
+	}
+	if data[len(data)-1] != demoEndMarker {
+		return fmt.Errorf("demo end marker not found")
+	}
+	return nil
+}
+
+// replaceDemoLumps returns a copy of the WAD where all attract loop demo lumps
+// refer to demo, which is appended to the end of the file
+func replaceDemoLumps(wad, demo []byte) ([]byte, error) {
+	if len(wad) < 12 || (string(wad[:4]) != "IWAD" && string(wad[:4]) != "PWAD") {
+		return nil, fmt.Errorf("not a WAD file")
+	}
+	numLumps := int(binary.LittleEndian.Uint32(wad[4:8]))
+	dirOffset := int(binary.LittleEndian.Uint32(wad[8:12]))
+	if dirOffset < 12 || dirOffset+numLumps*16 > len(wad) {
+		return nil, fmt.Errorf("invalid WAD directory")
+	}
+
+	patched := make([]byte, len(wad), len(wad)+len(demo))
+	copy(patched, wad)
+	demoPos := len(wad)
+	patched = append(patched, demo...)
+
+	replaced := 0
+	for i := 0; i < numLumps; i++ {
+		entry := patched[dirOffset+i*16 : dirOffset+(i+1)*16]
+		name := strings.ToUpper(strings.TrimRight(string(entry[8:16]), "\x00"))
+		if !slices.Contains(demoLumps, name) {
+			continue
+		}
+		binary.LittleEndian.PutUint32(entry[0:4], uint32(demoPos))
+		binary.LittleEndian.PutUint32(entry[4:8], uint32(len(demo)))
+		replaced++
+	}
+	if replaced == 0 {
+		return nil, fmt.Errorf("WAD has no demo lumps")
+	}
+	return patched, nil
+}
+
+// loadDemoFS reads the WAD and the demo file and returns a file system for the engine
+// that serves the WAD with its attract loop demos replaced by the demo
+func loadDemoFS(wadFile, demoFile string) (fs.FS, error) {
+	demo, err := os.ReadFile(demoFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDemo(demo); err != nil {
+		return nil, err
+	}
+	wad, err := os.ReadFile(wadFile)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := replaceDemoLumps(wad, demo)
+	if err != nil {
+		return nil, err
+	}
+	return &demoFS{base: os.DirFS("."), name: wadFile, wad: patched}, nil
+}
+
+// demoFS serves the patched WAD under its original name, so the engine identifies
+// the game from the file name as usual. Other files come from the working directory.
+type demoFS struct {
+	base fs.FS
+	name string
+	wad  []byte
+}
+
+// Open implements fs.FS
+func (f *demoFS) Open(name string) (fs.File, error) {
+	if name != f.name {
+		return f.base.Open(name)
+	}
+	return &memFile{Reader: bytes.NewReader(f.wad), name: name, size: int64(len(f.wad))}, nil
+}
+
+// memFile is an in-memory file; the engine reads WADs with ReadAt
+type memFile struct {
+	*bytes.Reader
+	name string
+	size int64
+}
+
+func (m *memFile) Stat() (fs.FileInfo, error) { return memFileInfo{m}, nil }
+func (m *memFile) Close() error               { return nil }
+
+// memFileInfo describes a memFile
+type memFileInfo struct{ f *memFile }
+
+func (i memFileInfo) Name() string       { return i.f.name }
+func (i memFileInfo) Size() int64        { return i.f.size }
+func (i memFileInfo) Mode() fs.FileMode  { return 0444 }
+func (i memFileInfo) ModTime() time.Time { return time.Time{} }
+func (i memFileInfo) IsDir() bool        { return false }
+func (i memFileInfo) Sys() any           { return nil }
@@ -0,0 +1,200 @@
+package doom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// buildWAD creates a WAD with the given lumps, data first and the directory at the end
+func buildWAD(lumps map[string][]byte, order []string) []byte {
+	var data bytes.Buffer
+	data.Write(make([]byte, 12))
+	var dir bytes.Buffer
+	for _, name := range order {
+		entry := make([]byte, 16)
+		binary.LittleEndian.PutUint32(entry[0:4], uint32(data.Len()))
+		binary.LittleEndian.PutUint32(entry[4:8], uint32(len(lumps[name])))
+		copy(entry[8:], name)
+		dir.Write(entry)
+		data.Write(lumps[name])
+	}
+	wad := data.Bytes()
+	copy(wad[0:4], "IWAD")
+	binary.LittleEndian.PutUint32(wad[4:8], uint32(len(order)))
+	binary.LittleEndian.PutUint32(wad[8:12], uint32(len(wad)))
+	return append(wad, dir.Bytes()...)
+}
+
+// readLump returns the data of a named lump from a WAD
+func readLump(t *testing.T, wad []byte, name string) []byte {
+	t.Helper()
+	numLumps := int(binary.LittleEndian.Uint32(wad[4:8]))
+	dirOffset := int(binary.LittleEndian.Uint32(wad[8:12]))
+	for i := 0; i < numLumps; i++ {
+		entry := wad[dirOffset+i*16:]
+		if string(bytes.TrimRight(entry[8:16], "\x00")) == name {
+			pos := binary.LittleEndian.Uint32(entry[0:4])
+			size := binary.LittleEndian.Uint32(entry[4:8])
+			return wad[pos : pos+size]
+		}
+	}
+	t.Fatalf("lump %s not found", name)
+	return nil
+}
+
+// testDemo returns a minimal v1.9 demo with a single tic
+func testDemo() []byte {
+	demo := []byte{demoVersion19, 2, 1, 1, 0, 0, 0, 0, 0, 1, 0, 0, 0}
+	return append(demo, 25, 0, 0, 0, demoEndMarker)
+}
+
+func TestValidateDemo(t *testing.T) {
+	if err := validateDemo(testDemo()); err != nil {
+		t.Errorf("validateDemo() error = %v", err)
+	}
+
+	longTics := testDemo()
+	longTics[0] = demoVersion191
+	if err := validateDemo(longTics); err != nil {
+		t.Errorf("validateDemo(v1.91) error = %v", err)
+	}
+
+	oldVersion := testDemo()
+	oldVersion[0] = 106
+	noMarker := testDemo()
+	noMarker = noMarker[:len(noMarker)-1]
+	for name, data := range map[string][]byte{
+		"too short":   {demoVersion19, demoEndMarker},
+		"old version": oldVersion,
+		"no marker":   noMarker,
+	} {
+		if err := validateDemo(data); err == nil {
+			t.Errorf("validateDemo(%s) error = nil, want error", name)
+		}
+	}
+}
+
+func TestReplaceDemoLumps(t *testing.T) {
+	lumps := map[string][]byte{
+		"PLAYPAL": {1, 2, 3},
+		"DEMO1":   {4, 5},
+		"DEMO2":   {6},
+		"E1M1":    {7, 8, 9, 10},
+		"DEMO3":   {11, 12, 13},
+	}
+	wad := buildWAD(lumps, []string{"PLAYPAL", "DEMO1", "DEMO2", "E1M1", "DEMO3"})
+	original := append([]byte(nil), wad...)
+	demo := testDemo()
+
+	patched, err := replaceDemoLumps(wad, demo)
+	if err != nil {
+		t.Fatalf("replaceDemoLumps() error = %v", err)
+	}
+
+	for _, name := range []string{"DEMO1", "DEMO2", "DEMO3"} {
+		if got := readLump(t, patched, name); !bytes.Equal(got, demo) {
+			t.Errorf("%s = %v, want the demo", name, got)
+		}
+	}
+	for _, name := range []string{"PLAYPAL", "E1M1"} {
+		if got := readLump(t, patched, name); !bytes.Equal(got, lumps[name]) {
+			t.Errorf("%s = %v, want %v", name, got, lumps[name])
+		}
+	}
+	if !bytes.Equal(wad, original) {
+		t.Error("replaceDemoLumps() modified the original WAD")
+	}
+}
+
+func TestReplaceDemoLumps_Invalid(t *testing.T) {
+	noDemos := buildWAD(map[string][]byte{"E1M1": {1}}, []string{"E1M1"})
+	badDir := buildWAD(map[string][]byte{"DEMO1": {1}}, []string{"DEMO1"})
+	binary.LittleEndian.PutUint32(badDir[4:8], 100)
+
+	for name, wad := range map[string][]byte{
+		"not a WAD":   []byte("PK\x03\x04 not a wad file"),
+		"too short":   []byte("IWAD"),
+		"no demos":    noDemos,
+		"bad dir":     badDir,
+		"empty input": nil,
+	} {
+		if _, err := replaceDemoLumps(wad, testDemo()); err == nil {
+			t.Errorf("replaceDemoLumps(%s) error = nil, want error", name)
+		}
+	}
+}
+
+func TestLoadDemoFS(t *testing.T) {
+	dir := t.TempDir()
+	wadFile := filepath.Join(dir, "doom1.wad")
+	demoFile := filepath.Join(dir, "run.lmp")
+	wad := buildWAD(map[string][]byte{"DEMO1": {1, 2}}, []string{"DEMO1"})
+	if err := os.WriteFile(wadFile, wad, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(demoFile, testDemo(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fsys, err := loadDemoFS(wadFile, demoFile)
+	if err != nil {
+		t.Fatalf("loadDemoFS() error = %v", err)
+	}
+
+	f, err := fsys.Open(wadFile)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Size() != int64(len(wad)+len(testDemo())) {
+		t.Errorf("Size() = %d, want %d", info.Size(), len(wad)+len(testDemo()))
+	}
+
+	// The engine reads lumps with ReadAt
+	readerAt, ok := f.(io.ReaderAt)
+	if !ok {
+		t.Fatal("WAD file does not implement io.ReaderAt")
+	}
+	data := make([]byte, info.Size())
+	if _, err := readerAt.ReadAt(data, 0); err != nil {
+		t.Fatalf("ReadAt() error = %v", err)
+	}
+	if got := readLump(t, data, "DEMO1"); !bytes.Equal(got, testDemo()) {
+		t.Errorf("DEMO1 = %v, want the demo", got)
+	}
+
+	// Other files are not served from memory
+	if _, err := fsys.Open("missing.cfg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Open(missing.cfg) error = %v, want not exist", err)
+	}
+}
+
+func TestLoadDemoFS_InvalidDemo(t *testing.T) {
+	dir := t.TempDir()
+	wadFile := filepath.Join(dir, "doom1.wad")
+	demoFile := filepath.Join(dir, "run.lmp")
+	if err := os.WriteFile(wadFile, buildWAD(map[string][]byte{"DEMO1": {1}}, []string{"DEMO1"}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(demoFile, []byte("not a demo"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := loadDemoFS(wadFile, demoFile); err == nil {
+		t.Error("loadDemoFS() with an invalid demo error = nil, want error")
+	}
+	if _, err := loadDemoFS(wadFile, filepath.Join(dir, "missing.lmp")); err == nil {
+		t.Error("loadDemoFS() with a missing demo error = nil, want error")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/pozitronik/steelclock-go/internal/widget/battery"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/clock"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/cpu"
+	_ "github.com/pozitronik/steelclock-go/internal/widget/cputemp"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/disk"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/doom"
 	_ "github.com/pozitronik/steelclock-go/internal/widget/gameoflife"
@@ -63,6 +64,11 @@ func TestCreateWidget_AllTypes(t *testing.T) {
 			widgetType: "cpu",
 			wantErr:    false,
 		},
+		{
+			name:       "create cpu_temp widget",
+			widgetType: "cpu_temp",
+			wantErr:    false,
+		},
 		{
 			name:       "create memory widget",
 			widgetType: "memory",
@@ -425,6 +431,7 @@ func TestRegisteredTypes(t *testing.T) {
 		"battery",
 		"clock",
 		"cpu",
+		"cpu_temp",
 		"disk",
 		"doom",
 		"game_of_life",
@@ -2,6 +2,7 @@ package gameoflife
 
 import (
 	"image"
+	"log"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -32,23 +33,25 @@ type Widget struct {
 	mu sync.Mutex
 
 	// Configuration
-	birthRules     []int   // Neighbor counts that cause birth
-	survivalRules  []int   // Neighbor counts that cause survival
-	wrapEdges      bool    // Toroidal topology
-	cellSize       int     // Pixels per cell
-	trailEffect    bool    // Enable fading trail
-	trailDecay     int     // Decay amount per frame
-	cellColor      uint8   // Alive cell color
-	randomDensity  float64 // Initial density for random pattern
-	initialPattern string  // Pattern to use on restart
-	restartTimeout float64 // Seconds to wait before restart (-1 = never, 0 = immediate)
-	restartMode    string  // "reset", "inject", or "random"
+	birthRules     []int    // Neighbor counts that cause birth
+	survivalRules  []int    // Neighbor counts that cause survival
+	wrapEdges      bool     // Toroidal topology
+	cellSize       int      // Pixels per cell
+	trailEffect    bool     // Enable fading trail
+	trailDecay     int      // Decay amount per frame
+	cellColor      uint8    // Alive cell color
+	birthColor     uint8    // Color of cells born in the last generation (0 = cellColor)
+	randomDensity  float64  // Initial density for random pattern
+	initialPattern string   // Pattern to use on restart
+	filePattern    [][2]int // Cells of an initial pattern loaded from an RLE file
+	restartTimeout float64  // Seconds to wait before restart (-1 = never, 0 = immediate)
+	restartMode    string   // "reset", "inject", or "random"
 
 	// Grid state
 	gridWidth  int
 	gridHeight int
 	current    [][]uint8 // Current cell states (0=dead, 255=alive, 1-254=fading)
-	next       [][]uint8 // Next generation buffer
+	next       [][]uint8 // Next generation buffer; holds the previous generation between updates
 
 	// Restart tracking
 	stableFrames int       // Count of frames with no change
@@ -115,6 +118,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	trailEffect := true
 	trailDecay := 30
 	cellColor := uint8(255)
+	birthColor := uint8(0)
 	randomDensity := 0.3
 	initialPattern := restartModeRandom
 	restartTimeout := 3.0           // Default: restart after 3 seconds
@@ -142,6 +146,9 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		if cfg.GameOfLife.CellColor > 0 {
 			cellColor = uint8(cfg.GameOfLife.CellColor)
 		}
+		if cfg.GameOfLife.BirthColor > 0 && cfg.GameOfLife.BirthColor <= 255 {
+			birthColor = uint8(cfg.GameOfLife.BirthColor)
+		}
 		if cfg.GameOfLife.RandomDensity > 0 {
 			randomDensity = cfg.GameOfLife.RandomDensity
 		}
@@ -160,6 +167,18 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	gridWidth := pos.W / cellSize
 	gridHeight := pos.H / cellSize
 
+	// Load a pattern file, falling back to a random pattern if it cannot be used
+	var filePattern [][2]int
+	if isRLEFile(initialPattern) {
+		cells, err := loadRLE(initialPattern, gridWidth, gridHeight)
+		if err != nil {
+			log.Printf("game_of_life widget %s: invalid pattern file %s: %v, falling back to random", base.Name(), initialPattern, err)
+			initialPattern = restartModeRandom
+		} else {
+			filePattern = cells
+		}
+	}
+
 	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	w := &Widget{
@@ -171,8 +190,10 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		trailEffect:    trailEffect,
 		trailDecay:     trailDecay,
 		cellColor:      cellColor,
+		birthColor:     birthColor,
 		randomDensity:  randomDensity,
 		initialPattern: initialPattern,
+		filePattern:    filePattern,
 		restartTimeout: restartTimeout,
 		restartMode:    restartMode,
 		gridWidth:      gridWidth,
@@ -266,10 +287,11 @@ func (w *Widget) performRestart() {
 
 // setPattern initializes the grid with a pattern
 func (w *Widget) setPattern(pattern string) {
-	// Clear grid
+	// Clear grid and the previous generation, so the whole pattern shows as newly born
 	for y := 0; y < w.gridHeight; y++ {
 		for x := 0; x < w.gridWidth; x++ {
 			w.current[y][x] = 0
+			w.next[y][x] = 0
 		}
 	}
 
@@ -289,8 +311,11 @@ func (w *Widget) setPattern(pattern string) {
 		return
 	}
 
-	// Try predefined patterns
+	// Try predefined patterns, then the pattern loaded from a file
 	coords, ok := patterns[pattern]
+	if !ok && w.filePattern != nil && pattern == w.initialPattern {
+		coords, ok = w.filePattern, true
+	}
 	if !ok {
 		// Default to random if pattern not found
 		w.setPattern(restartModeRandom)
@@ -393,6 +418,11 @@ func (w *Widget) countAliveCells() int {
 	return count
 }
 
+// isBorn reports whether a cell came alive in the last generation and is drawn with birthColor
+func (w *Widget) isBorn(x, y int) bool {
+	return w.birthColor > 0 && w.current[y][x] == w.cellColor && w.next[y][x] != w.cellColor
+}
+
 // isGridEmpty returns true if all cells are dead (including fading)
 func (w *Widget) isGridEmpty() bool {
 	for y := 0; y < w.gridHeight; y++ {
@@ -460,7 +490,7 @@ func (w *Widget) Update() error {
 		}
 	}
 
-	// Swap buffers
+	// Swap buffers; next keeps the previous generation for telling births from survivors
 	w.current, w.next = w.next, w.current
 
 	// Check for restart conditions (only if restart is enabled)
@@ -505,6 +535,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: the simulation is drawn on every frame.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the current state
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.Lock()
@@ -520,6 +555,9 @@ func (w *Widget) Render() (image.Image, error) {
 			if brightness == 0 {
 				continue
 			}
+			if w.isBorn(x, y) {
+				brightness = w.birthColor
+			}
 
 			// Draw cell (potentially multiple pixels if cellSize > 1)
 			px := x * w.cellSize
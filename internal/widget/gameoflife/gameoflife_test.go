@@ -1,6 +1,7 @@
 package gameoflife
 
 import (
+	"image"
 	"testing"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
@@ -180,6 +181,50 @@ func TestWidget_Render(t *testing.T) {
 	}
 }
 
+func TestWidget_BirthColor(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:    "game_of_life",
+		ID:      "test-gol",
+		Enabled: config.BoolPtr(true),
+		Position: config.PositionConfig{
+			X: 0, Y: 0, W: 10, H: 10,
+		},
+		GameOfLife: &config.GameOfLifeConfig{
+			InitialPattern: "clear",
+			CellColor:      200,
+			BirthColor:     255,
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// L-tromino: the missing corner is born and the block is stable from then on
+	w.current[1][1] = 200
+	w.current[1][2] = 200
+	w.current[2][1] = 200
+
+	for gen, wantCorner := range []uint8{255, 200} {
+		if err := w.Update(); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		img, err := w.Render()
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		gray := img.(*image.Gray)
+
+		if got := gray.GrayAt(2, 2).Y; got != wantCorner {
+			t.Errorf("generation %d: born cell color = %d, want %d", gen+1, got, wantCorner)
+		}
+		if got := gray.GrayAt(1, 1).Y; got != 200 {
+			t.Errorf("generation %d: surviving cell color = %d, want 200", gen+1, got)
+		}
+	}
+}
+
 func TestParseRules(t *testing.T) {
 	tests := []struct {
 		rules        string
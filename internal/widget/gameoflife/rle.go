@@ -0,0 +1,96 @@
+package gameoflife
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// isRLEFile reports whether an initial pattern refers to an RLE pattern file
+func isRLEFile(pattern string) bool {
+	return strings.EqualFold(filepath.Ext(pattern), ".rle")
+}
+
+// loadRLE reads an RLE pattern file that fits a width x height grid and returns its alive cells
+func loadRLE(path string, width, height int) ([][2]int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseRLE(string(data), width, height)
+}
+
+// parseRLE parses a pattern in the Life RLE format and returns the alive cells as
+// relative coordinates. Lines starting with '#' are comments, and the optional
+// "x = ..., y = ..." header line is skipped: the size is taken from the cells.
+// In the body, "b" is a dead cell, "o" (or any other letter) an alive cell,
+// "$" ends a row and "!" ends the pattern; each may be preceded by a run count.
+// A pattern that does not fit a width x height grid is an error, so a huge run
+// count cannot make the parser allocate without bound.
+func parseRLE(data string, width, height int) ([][2]int, error) {
+	var cells [][2]int
+	x, y, run := 0, 0, 0
+	hasRun := false
+
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "x") && strings.Contains(line, "=") {
+			continue
+		}
+
+		for _, c := range line {
+			switch {
+			case c >= '0' && c <= '9':
+				run = run*10 + int(c-'0')
+				hasRun = true
+				if run > max(width, height) {
+					return nil, fmt.Errorf("run count at row %d exceeds the %dx%d grid", y, width, height)
+				}
+				continue
+			case c == ' ' || c == '\t' || c == '\r':
+				continue
+			}
+
+			count := 1
+			if hasRun {
+				count = run
+			}
+			run, hasRun = 0, false
+
+			switch {
+			case c == '!':
+				if len(cells) == 0 {
+					return nil, fmt.Errorf("pattern has no alive cells")
+				}
+				return cells, nil
+			case c == '$':
+				if y+count > height {
+					return nil, fmt.Errorf("pattern is taller than the %dx%d grid", width, height)
+				}
+				y += count
+				x = 0
+			case c == 'b' || c == '.':
+				if x+count > width {
+					return nil, fmt.Errorf("row %d is wider than the %dx%d grid", y, width, height)
+				}
+				x += count
+			case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+				if x+count > width || y >= height {
+					return nil, fmt.Errorf("row %d does not fit the %dx%d grid", y, width, height)
+				}
+				for i := 0; i < count; i++ {
+					cells = append(cells, [2]int{x + i, y})
+				}
+				x += count
+			default:
+				return nil, fmt.Errorf("unexpected character %q at row %d", c, y)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("pattern is missing the terminating '!'")
+}
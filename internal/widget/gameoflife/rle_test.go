@@ -0,0 +1,149 @@
+package gameoflife
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// sortCells orders cells by row, then column
+func sortCells(cells [][2]int) [][2]int {
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i][1] != cells[j][1] {
+			return cells[i][1] < cells[j][1]
+		}
+		return cells[i][0] < cells[j][0]
+	})
+	return cells
+}
+
+func TestParseRLE(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want [][2]int
+	}{
+		{
+			name: "glider",
+			data: "#N Glider\n#C The smallest spaceship\nx = 3, y = 3, rule = B3/S23\nbob$2bo$3o!\n",
+			want: patterns["glider"],
+		},
+		{
+			name: "lwss without header",
+			data: "bo2bo$o4b$o3bo$4o!",
+			want: patterns["lwss"],
+		},
+		{
+			name: "gosper glider gun",
+			data: `#N Gosper glider gun
+x = 36, y = 9, rule = B3/S23
+24bo$22bobo$12b2o6b2o12b2o$11bo3bo4b2o12b2o$2o8bo5bo3b2o$2o8bo3bob2o4b
+obo$10bo5bo7bo$11bo3bo$12b2o!`,
+			want: patterns["glider_gun"],
+		},
+		{
+			name: "empty rows and CRLF",
+			data: "x = 2, y = 4\r\no$$2$bo!\r\n",
+			want: [][2]int{{0, 0}, {1, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRLE(tt.data, 64, 32)
+			if err != nil {
+				t.Fatalf("parseRLE() error = %v", err)
+			}
+			want := sortCells(append([][2]int(nil), tt.want...))
+			if got = sortCells(got); !reflect.DeepEqual(got, want) {
+				t.Errorf("parseRLE() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseRLE_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"no terminator":    "x = 3, y = 1\n3o",
+		"no alive cells":   "x = 3, y = 1\n3b!",
+		"bad character":    "x = 3, y = 1\nb*o!",
+		"only a comment":   "#N Nothing",
+		"empty":            "",
+		"header and stop":  "x = 0, y = 0\n!",
+		"huge run":         "99999999999999999999o!",
+		"huge dead run":    "9999999999b$o!",
+		"huge row skip":    "o9999999999$o!",
+		"wider than grid":  "5o!",
+		"taller than grid": "o$o$o$o$o!",
+		"run past row end": "3b2o!",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if cells, err := parseRLE(data, 4, 4); err == nil {
+				t.Errorf("parseRLE() = %v, want error", cells)
+			}
+		})
+	}
+}
+
+func TestNew_RLEPattern(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Blinker.RLE")
+	if err := os.WriteFile(path, []byte("x = 3, y = 1\n3o!\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.WidgetConfig{
+		Type:     "game_of_life",
+		ID:       "test-gol",
+		Position: config.PositionConfig{W: 10, H: 10},
+		GameOfLife: &config.GameOfLifeConfig{
+			InitialPattern: path,
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if w.countAliveCells() != 3 {
+		t.Fatalf("alive cells = %d, want 3", w.countAliveCells())
+	}
+	// Centered on the grid
+	for _, x := range []int{4, 5, 6} {
+		if w.current[5][x] != w.cellColor {
+			t.Errorf("current[5][%d] = %d, want alive", x, w.current[5][x])
+		}
+	}
+
+	// The file pattern is used again on reset
+	w.setPattern("clear")
+	w.performRestart()
+	if w.countAliveCells() != 3 || w.current[5][5] != w.cellColor {
+		t.Errorf("reset did not restore the file pattern")
+	}
+}
+
+func TestNew_InvalidRLEFallsBackToRandom(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "game_of_life",
+		ID:       "test-gol",
+		Position: config.PositionConfig{W: 20, H: 20},
+		GameOfLife: &config.GameOfLifeConfig{
+			InitialPattern: filepath.Join(t.TempDir(), "missing.rle"),
+			RandomDensity:  0.5,
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if w.initialPattern != restartModeRandom {
+		t.Errorf("initialPattern = %q, want %q", w.initialPattern, restartModeRandom)
+	}
+	if w.countAliveCells() == 0 {
+		t.Error("fallback random pattern has no alive cells")
+	}
+}
@@ -361,6 +361,11 @@ func (w *Widget) startNextLine() {
 	w.currentSpeed = w.pickTypingSpeed()
 }
 
+// NeedsRender always returns true: code is typed out and the cursor blinks on every frame.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the code display.
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.Lock()
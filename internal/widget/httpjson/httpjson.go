@@ -0,0 +1,263 @@
+package httpjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+	"golang.org/x/image/font"
+)
+
+func init() {
+	widget.Register("http_json", func(cfg config.WidgetConfig) (widget.Widget, error) {
+		return New(cfg)
+	})
+}
+
+// missingValue is shown for tokens whose path is not present in the response
+const missingValue = "-"
+
+// defaultToken is the token used when no tokens are configured; it shows the whole response
+const defaultToken = "value"
+
+// maxResponseSize caps how much of a response body is read
+const maxResponseSize = 1 << 20
+
+// Widget polls a URL returning JSON and displays values picked with JSONPath expressions
+type Widget struct {
+	*widget.BaseWidget
+	url     string
+	headers map[string]string
+	paths   map[string]jsonPath
+	tokens  []render.Token
+	client  *http.Client
+
+	// Display settings
+	fontName    string
+	horizAlign  config.HAlign
+	vertAlign   config.VAlign
+	orientation config.TextOrientation
+	padding     int
+	fontFace    font.Face
+
+	// State (mutex-protected)
+	mu        sync.RWMutex
+	values    map[string]any // Token name -> extracted JSON value
+	hasData   bool
+	lastError string // Short error label shown instead of the values
+}
+
+// New creates a new HTTP JSON widget
+func New(cfg config.WidgetConfig) (*Widget, error) {
+	if cfg.HTTPJSON == nil || strings.TrimSpace(cfg.HTTPJSON.URL) == "" {
+		return nil, fmt.Errorf("http_json widget requires 'url' in http_json config")
+	}
+	httpCfg := cfg.HTTPJSON
+
+	// The request interval drives the widget update loop; update_interval is accepted
+	// as the same knob (the config loader keeps both in sync)
+	interval := httpCfg.Interval
+	if interval <= 0 {
+		interval = cfg.UpdateInterval
+	}
+	if interval <= 0 {
+		interval = config.DefaultHTTPJSONInterval
+	}
+	cfg.UpdateInterval = interval
+
+	timeout := httpCfg.Timeout
+	if timeout <= 0 {
+		timeout = config.DefaultHTTPJSONTimeout
+	}
+
+	format := httpCfg.Format
+	if format == "" {
+		format = config.DefaultHTTPJSONFormat
+	}
+
+	tokenPaths := httpCfg.Tokens
+	if len(tokenPaths) == 0 {
+		tokenPaths = map[string]string{defaultToken: "$"}
+	}
+	paths := make(map[string]jsonPath, len(tokenPaths))
+	for name, expr := range tokenPaths {
+		path, err := parseJSONPath(expr)
+		if err != nil {
+			return nil, fmt.Errorf("token %q: %w", name, err)
+		}
+		paths[name] = path
+	}
+
+	// Secrets such as API tokens are usually kept in environment variables
+	headers := make(map[string]string, len(httpCfg.Headers))
+	for name, value := range httpCfg.Headers {
+		headers[name] = os.ExpandEnv(value)
+	}
+
+	tokens := render.ParseFormatTokens(format, func(string) render.TokenType {
+		return render.TokenText
+	})
+	if err := render.RejectTokenStyles(tokens); err != nil {
+		return nil, fmt.Errorf("format: %w", err)
+	}
+
+	base := widget.NewBaseWidget(cfg)
+	helper := shared.NewConfigHelper(cfg)
+	textSettings := helper.GetTextSettings()
+
+	fontFace, err := bitmap.LoadFont(textSettings.FontName, textSettings.FontSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load font: %w", err)
+	}
+
+	return &Widget{
+		BaseWidget:  base,
+		url:         os.ExpandEnv(httpCfg.URL),
+		headers:     headers,
+		paths:       paths,
+		tokens:      tokens,
+		client:      &http.Client{Timeout: time.Duration(timeout * float64(time.Second))},
+		fontName:    textSettings.FontName,
+		horizAlign:  textSettings.HorizAlign,
+		vertAlign:   textSettings.VertAlign,
+		orientation: textSettings.Orientation,
+		padding:     helper.GetPadding(),
+		fontFace:    fontFace,
+	}, nil
+}
+
+// Update fetches the endpoint and extracts token values
+func (w *Widget) Update() error {
+	doc, err := w.fetch()
+	if err != nil {
+		log.Printf("http_json %s: %v", w.Name(), err)
+		w.mu.Lock()
+		w.lastError = errorLabel(err)
+		w.mu.Unlock()
+		return nil // Not fatal - widget shows the error state until the next successful fetch
+	}
+
+	values := make(map[string]any, len(w.paths))
+	for name, path := range w.paths {
+		if value, ok := path.lookup(doc); ok {
+			values[name] = value
+		}
+	}
+
+	w.mu.Lock()
+	w.values = values
+	w.hasData = true
+	w.lastError = ""
+	w.mu.Unlock()
+
+	return nil
+}
+
+// fetchError classifies request failures for the on-screen error label
+type fetchError struct {
+	label string
+	err   error
+}
+
+func (e *fetchError) Error() string { return e.err.Error() }
+func (e *fetchError) Unwrap() error { return e.err }
+
+// fetch requests the URL and decodes the JSON body
+func (w *Widget) fetch() (any, error) {
+	req, err := http.NewRequest(http.MethodGet, w.url, nil)
+	if err != nil {
+		return nil, &fetchError{label: i18n.T(i18n.BadURL), err: err}
+	}
+	req.Header.Set("Accept", "application/json")
+	for name, value := range w.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, &fetchError{label: i18n.T(i18n.NoConnection), err: err}
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &fetchError{
+			label: fmt.Sprintf("HTTP %d", resp.StatusCode),
+			err:   fmt.Errorf("unexpected status %d from %s", resp.StatusCode, w.url),
+		}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, &fetchError{label: i18n.T(i18n.NoConnection), err: err}
+	}
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, &fetchError{label: i18n.T(i18n.BadJSON), err: fmt.Errorf("failed to parse response: %w", err)}
+	}
+	return doc, nil
+}
+
+// errorLabel returns a short description of err that fits on the display
+func errorLabel(err error) string {
+	var fe *fetchError
+	if errors.As(err, &fe) {
+		return fe.label
+	}
+	return i18n.T(i18n.Error)
+}
+
+// Render draws the formatted values, the error state or a loading indicator
+func (w *Widget) Render() (image.Image, error) {
+	img := w.CreateCanvas()
+	w.ApplyBorder(img)
+
+	w.mu.RLock()
+	text := w.textLocked()
+	w.mu.RUnlock()
+
+	bitmap.SmartDrawAlignedTextOriented(img, text, w.fontFace, w.fontName, w.horizAlign, w.vertAlign, w.padding, w.orientation)
+	return img, nil
+}
+
+// textLocked returns the text to display; the caller must hold w.mu
+func (w *Widget) textLocked() string {
+	if w.lastError != "" {
+		return w.lastError
+	}
+	if !w.hasData {
+		return i18n.T(i18n.Loading)
+	}
+
+	var sb strings.Builder
+	for i := range w.tokens {
+		t := &w.tokens[i]
+		if t.Type == render.TokenLiteral {
+			sb.WriteString(t.Literal)
+			continue
+		}
+		value, ok := w.values[t.Name]
+		if !ok {
+			sb.WriteString(missingValue)
+			continue
+		}
+		sb.WriteString(formatValue(value, t.Param))
+	}
+	return sb.String()
+}
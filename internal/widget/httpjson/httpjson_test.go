@@ -0,0 +1,248 @@
+package httpjson
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestParseJSONPath_Lookup(t *testing.T) {
+	var doc any
+	if err := json.Unmarshal([]byte(`{
+		"state": "on",
+		"attributes": {"temperature": 21.5, "friendly name": "Living room"},
+		"sensors": [{"value": 1}, {"value": 2}, {"value": 3}],
+		"nothing": null
+	}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expr string
+		want any
+		ok   bool
+	}{
+		{"$.state", "on", true},
+		{"state", "on", true},
+		{"$.attributes.temperature", 21.5, true},
+		{"$['attributes']['friendly name']", "Living room", true},
+		{`$.attributes["friendly name"]`, "Living room", true},
+		{"$.sensors[1].value", 2.0, true},
+		{"sensors[0].value", 1.0, true},
+		{"$.sensors[-1].value", 3.0, true},
+		{"$.nothing", nil, true},
+		{"$.sensors[5].value", nil, false},
+		{"$.missing", nil, false},
+		{"$.state.inner", nil, false},
+		{"$.attributes[0]", nil, false},
+	}
+
+	for _, tt := range tests {
+		path, err := parseJSONPath(tt.expr)
+		if err != nil {
+			t.Errorf("parseJSONPath(%q) error = %v", tt.expr, err)
+			continue
+		}
+		got, ok := path.lookup(doc)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("lookup(%q) = %v, %v; want %v, %v", tt.expr, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	// "$" alone selects the whole document
+	path, err := parseJSONPath("$")
+	if err != nil {
+		t.Fatalf("parseJSONPath($) error = %v", err)
+	}
+	if got, ok := path.lookup(doc); !ok || got == nil {
+		t.Error("lookup($) should return the whole document")
+	}
+}
+
+func TestParseJSONPath_Invalid(t *testing.T) {
+	for _, expr := range []string{"$.", "$..a", "$.list[", "$.list[x]", "$.a[0]]"} {
+		if _, err := parseJSONPath(expr); err == nil {
+			t.Errorf("parseJSONPath(%q) expected error", expr)
+		}
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		value any
+		param string
+		want  string
+	}{
+		{"text", "", "text"},
+		{"text", "1", "text"},
+		{"21.53", "1", "21.5"},
+		{"21.53", "", "21.53"},
+		{21.5, "", "21.5"},
+		{21.56, "1", "21.6"},
+		{1000000.0, "", "1000000"},
+		{3.0, "2", "3.00"},
+		{true, "", "true"},
+		{nil, "", "-"},
+		{[]any{1.0, 2.0}, "", "[1,2]"},
+		{map[string]any{"a": "b"}, "", `{"a":"b"}`},
+	}
+	for _, tt := range tests {
+		if got := formatValue(tt.value, tt.param); got != tt.want {
+			t.Errorf("formatValue(%v, %q) = %q, want %q", tt.value, tt.param, got, tt.want)
+		}
+	}
+}
+
+func newTestWidget(t *testing.T, httpCfg *config.HTTPJSONConfig) *Widget {
+	t.Helper()
+	w, err := New(config.WidgetConfig{
+		Type:     "http_json",
+		ID:       "test_http_json",
+		Position: config.PositionConfig{W: 128, H: 40},
+		HTTPJSON: httpCfg,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return w
+}
+
+func TestNew_Validation(t *testing.T) {
+	if _, err := New(config.WidgetConfig{Type: "http_json"}); err == nil {
+		t.Error("expected error without http_json config")
+	}
+
+	_, err := New(config.WidgetConfig{
+		Type:     "http_json",
+		HTTPJSON: &config.HTTPJSONConfig{URL: "http://localhost", Tokens: map[string]string{"x": "$.a["}},
+	})
+	if err == nil {
+		t.Error("expected error for invalid JSONPath")
+	}
+
+	_, err = New(config.WidgetConfig{
+		Type:     "http_json",
+		HTTPJSON: &config.HTTPJSONConfig{URL: "http://localhost", Format: "{value|dim}"},
+	})
+	if err == nil {
+		t.Error("expected error for a token style, which http_json does not support")
+	}
+}
+
+func TestNew_Defaults(t *testing.T) {
+	w := newTestWidget(t, &config.HTTPJSONConfig{URL: "http://localhost"})
+
+	if got := w.GetUpdateInterval().Seconds(); got != config.DefaultHTTPJSONInterval {
+		t.Errorf("update interval = %vs, want %v", got, config.DefaultHTTPJSONInterval)
+	}
+	if _, ok := w.paths[defaultToken]; !ok {
+		t.Errorf("default token %q not configured", defaultToken)
+	}
+	if w.textLocked() != "..." {
+		t.Errorf("text before first fetch = %q, want ...", w.textLocked())
+	}
+}
+
+func TestNew_UpdateIntervalFallback(t *testing.T) {
+	w, err := New(config.WidgetConfig{
+		Type:           "http_json",
+		ID:             "test_http_json",
+		Position:       config.PositionConfig{W: 128, H: 40},
+		UpdateInterval: 15,
+		HTTPJSON:       &config.HTTPJSONConfig{URL: "http://localhost"},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if got := w.GetUpdateInterval().Seconds(); got != 15 {
+		t.Errorf("update interval = %vs, want 15", got)
+	}
+}
+
+func TestWidget_Update(t *testing.T) {
+	t.Setenv("HTTPJSON_TEST_TOKEN", "secret")
+
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = rw.Write([]byte(`{"state": "21.456", "attributes": {"unit": "C", "humidity": 40.25}}`))
+	}))
+	defer server.Close()
+
+	w := newTestWidget(t, &config.HTTPJSONConfig{
+		URL:      server.URL,
+		Headers:  map[string]string{"Authorization": "Bearer ${HTTPJSON_TEST_TOKEN}"},
+		Interval: 30,
+		Tokens: map[string]string{
+			"temp":     "$.state",
+			"unit":     "$.attributes.unit",
+			"humidity": "$.attributes.humidity",
+			"missing":  "$.attributes.pressure",
+		},
+		Format: "{temp}{unit} {humidity:0}% {missing} {unknown}",
+	})
+
+	if got := w.GetUpdateInterval().Seconds(); got != 30 {
+		t.Errorf("update interval = %vs, want 30", got)
+	}
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if gotAuth != "Bearer secret" {
+		t.Errorf("Authorization header = %q, want env-expanded value", gotAuth)
+	}
+
+	want := "21.456C 40% - -"
+	if got := w.textLocked(); got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+
+	img, err := w.Render()
+	if err != nil || img == nil {
+		t.Fatalf("Render() = %v, %v", img, err)
+	}
+}
+
+func TestWidget_ErrorStates(t *testing.T) {
+	status := http.StatusOK
+	body := `{"value": 1}`
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(status)
+		_, _ = rw.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	w := newTestWidget(t, &config.HTTPJSONConfig{
+		URL:    server.URL,
+		Tokens: map[string]string{"value": "$.value"},
+	})
+
+	status, body = http.StatusUnauthorized, `{}`
+	_ = w.Update()
+	if got := w.textLocked(); got != "HTTP 401" {
+		t.Errorf("text after 401 = %q, want HTTP 401", got)
+	}
+
+	status, body = http.StatusOK, `not json`
+	_ = w.Update()
+	if got := w.textLocked(); got != "Bad JSON" {
+		t.Errorf("text after bad body = %q, want Bad JSON", got)
+	}
+
+	// A successful fetch clears the error
+	body = `{"value": 7}`
+	_ = w.Update()
+	if got := w.textLocked(); got != "7" {
+		t.Errorf("text after recovery = %q, want 7", got)
+	}
+
+	server.Close()
+	_ = w.Update()
+	if got := w.textLocked(); got != "No connection" {
+		t.Errorf("text with server down = %q, want No connection", got)
+	}
+}
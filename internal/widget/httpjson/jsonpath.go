@@ -0,0 +1,137 @@
+package httpjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a compiled JSONPath expression: a chain of object keys and array indexes.
+// Supported syntax is the subset needed to pick single values:
+// $.key, $.key.nested, $.list[0], $.list[-1] (from the end) and $['key with spaces'].
+// The leading "$" is optional.
+type jsonPath []pathStep
+
+// pathStep is a single object key or array index lookup
+type pathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseJSONPath compiles a JSONPath expression
+func parseJSONPath(expr string) (jsonPath, error) {
+	s := strings.TrimSpace(expr)
+	s = strings.TrimPrefix(s, "$")
+
+	var path jsonPath
+	for len(s) > 0 {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: empty key", expr)
+			}
+			path = append(path, pathStep{key: s[:end]})
+			s = s[end:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: missing ']'", expr)
+			}
+			step, err := parseBracket(s[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSONPath %q: %w", expr, err)
+			}
+			path = append(path, step)
+			s = s[end+1:]
+		default:
+			// Bare leading key without "$." (e.g. "sensors[0].value")
+			if len(path) > 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: unexpected %q", expr, s[0])
+			}
+			s = "." + s
+		}
+	}
+	return path, nil
+}
+
+// parseBracket parses the contents of [...]: a quoted key or an integer index
+func parseBracket(content string) (pathStep, error) {
+	content = strings.TrimSpace(content)
+	if len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0] {
+		return pathStep{key: content[1 : len(content)-1]}, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return pathStep{}, fmt.Errorf("bad index %q", content)
+	}
+	return pathStep{index: index, isIndex: true}, nil
+}
+
+// lookup walks the decoded JSON document and returns the value the path points to
+func (p jsonPath) lookup(doc any) (any, bool) {
+	current := doc
+	for _, step := range p {
+		if step.isIndex {
+			list, ok := current.([]any)
+			if !ok {
+				return nil, false
+			}
+			index := step.index
+			if index < 0 {
+				index += len(list)
+			}
+			if index < 0 || index >= len(list) {
+				return nil, false
+			}
+			current = list[index]
+			continue
+		}
+
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[step.key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// formatValue converts a JSON value to display text. For numbers, param sets the
+// number of decimal places; objects and arrays are shown as compact JSON.
+func formatValue(value any, param string) string {
+	switch v := value.(type) {
+	case nil:
+		return missingValue
+	case string:
+		// Numeric strings (e.g. Home Assistant sensor states) can be rounded too
+		if param != "" {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				return formatValue(f, param)
+			}
+		}
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		if decimals, err := strconv.Atoi(param); err == nil && decimals >= 0 {
+			return strconv.FormatFloat(v, 'f', decimals, 64)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return missingValue
+		}
+		return string(data)
+	}
+}
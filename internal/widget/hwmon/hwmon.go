@@ -9,6 +9,7 @@ import (
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/metrics"
 	"github.com/pozitronik/steelclock-go/internal/shared"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
@@ -112,6 +113,11 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		}
 	}
 
+	var hwmonProvider metrics.HWMonProvider = metrics.NewLHMHTTPProvider(url)
+	if cfg.Demo {
+		hwmonProvider = metrics.NewDemoHWMon()
+	}
+
 	return &Widget{
 		BaseWidget:     base,
 		displayMode:    mr.DisplayMode,
@@ -129,7 +135,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		strategy:       mr.Strategy,
 		gridStrategy:   render.GetGridMetricStrategy(mr.DisplayMode),
 		Renderer:       mr.Renderer,
-		hwmonProvider:  metrics.NewLHMHTTPProvider(url),
+		hwmonProvider:  hwmonProvider,
 		historySingle:  util.NewRingBuffer[float64](mr.HistoryLen),
 		historyPerCore: util.NewRingBuffer[[]float64](mr.HistoryLen),
 		userTextFormat: userTextFormat,
@@ -232,7 +238,7 @@ func (w *Widget) Update() error {
 		log.Printf("hwmon: sensors unavailable: %v", err)
 		w.mu.Lock()
 		w.unavailable = true
-		w.unavailableMsg = "No sensors"
+		w.unavailableMsg = i18n.T(i18n.NoSensors)
 		w.mu.Unlock()
 		return nil
 	}
@@ -514,3 +514,27 @@ func TestWidget_NoMatchingSensors(t *testing.T) {
 		t.Error("hasData should be false when no sensors match")
 	}
 }
+
+func TestWidget_Demo(t *testing.T) {
+	cfg := baseCfg()
+	cfg.Demo = true
+	cfg.HWMon = &config.HWMonConfig{SensorType: "Temperature", SensorFilter: "CPU Package"}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, ok := w.hwmonProvider.(*metrics.DemoHWMon); !ok {
+		t.Fatalf("hwmonProvider = %T, want *metrics.DemoHWMon", w.hwmonProvider)
+	}
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !w.hasData {
+		t.Fatal("expected demo sensors to produce data")
+	}
+	if w.rawValue < 30 || w.rawValue > 90 || w.rawUnit != "°C" {
+		t.Errorf("rawValue = %f %s, want a plausible CPU temperature", w.rawValue, w.rawUnit)
+	}
+}
@@ -385,6 +385,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: the star field moves on every frame.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the hyperspace effect
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.Lock()
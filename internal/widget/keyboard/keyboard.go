@@ -7,11 +7,13 @@ import (
 	"image"
 	"image/color"
 	"sync"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
 	"github.com/pozitronik/steelclock-go/internal/config"
 	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"github.com/pozitronik/steelclock-go/internal/widget"
 	"golang.org/x/image/font"
 	"golang.org/x/image/math/fixed"
@@ -49,6 +51,14 @@ type Widget struct {
 	numState      bool
 	scrollState   bool
 	fontFace      font.Face
+
+	// Flash on state change: indicator alternates between old and new state, then settles
+	flashDuration time.Duration
+	blinkCfg      *config.BlinkConfig
+	hasState      bool      // false until the first Update, so the initial state does not flash
+	capsChanged   time.Time // when caps lock state last changed
+	numChanged    time.Time
+	scrollChanged time.Time
 }
 
 // New creates a new keyboard widget
@@ -132,6 +142,11 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		}
 	}
 
+	var flashDuration time.Duration
+	if cfg.Indicators != nil && cfg.Indicators.FlashOnChange > 0 {
+		flashDuration = time.Duration(cfg.Indicators.FlashOnChange * float64(time.Second))
+	}
+
 	// Load font (needed when any indicator uses text mode)
 	fontFace, err := bitmap.LoadFont(textSettings.FontName, textSettings.FontSize)
 	if err != nil {
@@ -159,9 +174,16 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		colorOn:       colorOn,
 		colorOff:      colorOff,
 		fontFace:      fontFace,
+		flashDuration: flashDuration,
+		blinkCfg:      cfg.Blink,
 	}, nil
 }
 
+// NeedsRender always returns true: indicators blink after a lock key changes.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render creates an image of the keyboard widget
 func (w *Widget) Render() (image.Image, error) {
 	// Create canvas with background and border
@@ -170,9 +192,10 @@ func (w *Widget) Render() (image.Image, error) {
 
 	// Copy state under lock to avoid race conditions
 	w.mu.RLock()
-	capsState := w.capsState
-	numState := w.numState
-	scrollState := w.scrollState
+	now := time.Now()
+	capsState := w.flashState(w.capsState, w.capsChanged, now)
+	numState := w.flashState(w.numState, w.numChanged, now)
+	scrollState := w.flashState(w.scrollState, w.scrollChanged, now)
 	w.mu.RUnlock()
 
 	// Determine rendering mode based on indicator configurations
@@ -190,6 +213,15 @@ func (w *Widget) Render() (image.Image, error) {
 	return img, nil
 }
 
+// flashState returns the state to display, showing the previous state during
+// the hidden phases of a flash after a recent change
+func (w *Widget) flashState(state bool, changedAt, now time.Time) bool {
+	if anim.FlashVisible(changedAt, now, w.flashDuration, w.blinkCfg) {
+		return state
+	}
+	return !state
+}
+
 // renderText renders keyboard indicators as text
 func (w *Widget) renderText(img *image.Gray, capsState, numState, scrollState bool) {
 	// Build indicator text
@@ -4,6 +4,7 @@ package keyboard
 
 import (
 	"syscall"
+	"time"
 )
 
 var (
@@ -23,7 +24,22 @@ func (w *Widget) Update() error {
 	num := isKeyToggled(VkNumlock)
 	scroll := isKeyToggled(VkScroll)
 
+	now := time.Now()
+
 	w.mu.Lock()
+	// Record state changes for flash_on_change (skip the initial read)
+	if w.hasState {
+		if caps != w.capsState {
+			w.capsChanged = now
+		}
+		if num != w.numState {
+			w.numChanged = now
+		}
+		if scroll != w.scrollState {
+			w.scrollChanged = now
+		}
+	}
+	w.hasState = true
 	w.capsState = caps
 	w.numState = num
 	w.scrollState = scroll
@@ -303,6 +303,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: the rain animation advances on every frame.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the matrix effect
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.Lock()
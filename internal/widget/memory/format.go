@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+)
+
+// Text format tokens, e.g. "{used_gb}/{total_gb} GB" renders "7.8/15.9 GB"
+const (
+	tokenPercent = "percent"  // {percent} or {percent:N} for N decimals
+	tokenUsedGB  = "used_gb"  // {used_gb} or {used_gb:N}, one decimal by default
+	tokenTotalGB = "total_gb" // {total_gb} or {total_gb:N}, one decimal by default
+)
+
+// textValue returns the text of format tokens for the given reading.
+// Unknown tokens render as empty strings.
+func textValue(stat metrics.MemoryStat) render.TokenValue {
+	return func(t render.Token) string {
+		switch t.Name {
+		case tokenPercent:
+			return render.FormatPercent(stat.UsedPercent, t.Param)
+		case tokenUsedGB:
+			return render.FormatGB(stat.Used, t.Param)
+		case tokenTotalGB:
+			return render.FormatGB(stat.Total, t.Param)
+		}
+		return ""
+	}
+}
@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+)
+
+func TestFormatText(t *testing.T) {
+	stat := metrics.MemoryStat{Total: 16 << 30, Used: 10<<30 + 400<<20, UsedPercent: 65.04}
+
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{"percent", "{percent}", "65%"},
+		{"percent decimals", "{percent:1}", "65.0%"},
+		{"used and total", "{used_gb}/{total_gb} GB", "10.4/16.0 GB"},
+		{"gb decimals", "{used_gb:0}G {total_gb:2}G", "10G 16.00G"},
+		{"unknown token", "{percent}{bogus}", "65%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := render.ParseTextFormat(tt.format)
+			if err != nil {
+				t.Fatalf("ParseTextFormat(%q) error = %v", tt.format, err)
+			}
+			got := render.FormatText(tokens, textValue(stat))
+			if got != tt.want {
+				t.Errorf("formatText(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
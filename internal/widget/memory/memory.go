@@ -1,6 +1,7 @@
 package memory
 
 import (
+	"fmt"
 	"image"
 	"sync"
 
@@ -18,16 +19,22 @@ func init() {
 	})
 }
 
-// Widget displays RAM usage
+// Widget displays RAM or swap usage
 type Widget struct {
 	*widget.BaseWidget
-	mu             sync.RWMutex
-	strategy       render.MetricDisplayStrategy
-	Renderer       *render.MetricRenderer
-	displayMode    render.DisplayMode
-	currentValue   float64
-	history        *util.RingBuffer[float64]
-	textFormat     string
+	mu           sync.RWMutex
+	strategy     render.MetricDisplayStrategy
+	Renderer     *render.MetricRenderer
+	displayMode  render.DisplayMode
+	currentValue float64
+	currentStat  metrics.MemoryStat
+	history      *util.RingBuffer[float64]
+	textFormat   string
+	showSwap     bool // Report swap (pagefile) instead of physical memory
+
+	// Text mode format tokens (nil = plain usage percentage)
+	textTokens []render.Token
+
 	memoryProvider metrics.MemoryProvider
 }
 
@@ -42,6 +49,20 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		return nil, err
 	}
 
+	memoryProvider := metrics.DefaultMemory
+	if cfg.Demo {
+		memoryProvider = metrics.NewDemoMemory()
+	}
+
+	// Tokens apply to text mode only
+	var textTokens []render.Token
+	if mr.DisplayMode == render.DisplayModeText && cfg.Text != nil {
+		var err error
+		if textTokens, err = render.ParseTextFormat(cfg.Text.Format); err != nil {
+			return nil, fmt.Errorf("text.format: %w", err)
+		}
+	}
+
 	return &Widget{
 		BaseWidget:     base,
 		strategy:       mr.Strategy,
@@ -49,16 +70,23 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		displayMode:    mr.DisplayMode,
 		history:        util.NewRingBuffer[float64](mr.HistoryLen),
 		textFormat:     "%.0f",
-		memoryProvider: metrics.DefaultMemory,
+		showSwap:       cfg.ShowSwap,
+		textTokens:     textTokens,
+		memoryProvider: memoryProvider,
 	}, nil
 }
 
 // Update updates the memory usage
 func (w *Widget) Update() error {
-	percent, err := w.memoryProvider.UsedPercent()
+	read := w.memoryProvider.VirtualMemory
+	if w.showSwap {
+		read = w.memoryProvider.SwapMemory
+	}
+	stat, err := read()
 	if err != nil {
 		return err
 	}
+	percent := stat.UsedPercent
 
 	// Clamp to 0-100
 	if percent < 0 {
@@ -72,6 +100,7 @@ func (w *Widget) Update() error {
 	defer w.mu.Unlock()
 
 	w.currentValue = percent
+	w.currentStat = stat
 	if w.displayMode == render.DisplayModeGraph {
 		w.history.Push(percent)
 	}
@@ -79,7 +108,7 @@ func (w *Widget) Update() error {
 	return nil
 }
 
-// GetValue returns the current memory usage percentage (thread-safe)
+// GetValue returns the current memory or swap usage percentage (thread-safe)
 func (w *Widget) GetValue() float64 {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
@@ -99,6 +128,13 @@ func (w *Widget) Render() (image.Image, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
+	if w.textTokens != nil {
+		stat := w.currentStat
+		stat.UsedPercent = w.currentValue
+		w.Renderer.RenderText(img, render.FormatText(w.textTokens, textValue(stat)))
+		return img, nil
+	}
+
 	// Delegate rendering to strategy
 	w.strategy.Render(img, render.MetricData{
 		Value:       w.currentValue,
@@ -27,8 +27,8 @@ func TestWidget_WithMockProvider(t *testing.T) {
 
 	// Inject mock provider
 	mockProvider := &metrics.MockMemory{
-		UsedPercentFunc: func() (float64, error) {
-			return 42.5, nil // Controlled value
+		VirtualMemoryFunc: func() (metrics.MemoryStat, error) {
+			return metrics.MemoryStat{UsedPercent: 42.5}, nil // Controlled value
 		},
 	}
 	widget.memoryProvider = mockProvider
@@ -77,8 +77,8 @@ func TestWidget_MockProvider_EdgeCases(t *testing.T) {
 			}
 
 			widget.memoryProvider = &metrics.MockMemory{
-				UsedPercentFunc: func() (float64, error) {
-					return tt.mockValue, nil
+				VirtualMemoryFunc: func() (metrics.MemoryStat, error) {
+					return metrics.MemoryStat{UsedPercent: tt.mockValue}, nil
 				},
 			}
 
@@ -94,3 +94,92 @@ func TestWidget_MockProvider_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+// TestWidget_Demo verifies demo mode feeds synthetic values instead of system memory
+func TestWidget_Demo(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "memory",
+		ID:       "test_memory_demo",
+		Position: config.PositionConfig{W: 64, H: 20},
+		Mode:     "text",
+		Demo:     true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, ok := widget.memoryProvider.(*metrics.DemoMemory); !ok {
+		t.Fatalf("memoryProvider = %T, want *metrics.DemoMemory", widget.memoryProvider)
+	}
+
+	if err := widget.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if v := widget.GetValue(); v <= 0 || v > 100 {
+		t.Errorf("GetValue() = %f, want a plausible percentage", v)
+	}
+}
+
+// TestWidget_ShowSwap verifies show_swap reads swap instead of physical memory
+func TestWidget_ShowSwap(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "memory",
+		ID:       "test_memory_swap",
+		Position: config.PositionConfig{W: 64, H: 20},
+		Mode:     "text",
+		ShowSwap: true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	widget.memoryProvider = &metrics.MockMemory{
+		VirtualMemoryFunc: func() (metrics.MemoryStat, error) {
+			t.Error("VirtualMemory() called with show_swap enabled")
+			return metrics.MemoryStat{}, nil
+		},
+		SwapMemoryFunc: func() (metrics.MemoryStat, error) {
+			return metrics.MemoryStat{Total: 4 << 30, Used: 1 << 30, UsedPercent: 25}, nil
+		},
+	}
+
+	if err := widget.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if v := widget.GetValue(); v != 25 {
+		t.Errorf("GetValue() = %f, want 25", v)
+	}
+}
+
+// TestWidget_TextTokens verifies text mode renders format tokens and plain formats stay untokenized
+func TestWidget_TextTokens(t *testing.T) {
+	for _, format := range []string{"{used_gb}/{total_gb}", "RAM"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := config.WidgetConfig{
+				Type:     "memory",
+				ID:       "test_memory_tokens",
+				Position: config.PositionConfig{W: 64, H: 20},
+				Mode:     "text",
+				Text:     &config.TextConfig{Format: format},
+			}
+
+			widget, err := New(cfg)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if hasTokens := widget.textTokens != nil; hasTokens != (format != "RAM") {
+				t.Errorf("textTokens = %v for format %q", widget.textTokens, format)
+			}
+			widget.memoryProvider = &metrics.MockMemory{}
+
+			if err := widget.Update(); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if _, err := widget.Render(); err != nil {
+				t.Errorf("Render() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package moonphase
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/shared/util"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+	"golang.org/x/image/font"
+)
+
+func init() {
+	widget.Register("moon_phase", func(cfg config.WidgetConfig) (widget.Widget, error) {
+		return New(cfg)
+	})
+}
+
+const (
+	litColor     = 255 // Illuminated part of the moon
+	outlineColor = 96  // Moon outline, keeps the dark part visible
+	labelGap     = 3   // Pixels between the moon and the label
+)
+
+// phaseNames are the eight principal phases, starting from new moon
+var phaseNames = [8]string{
+	"New Moon", "Waxing Crescent", "First Quarter", "Waxing Gibbous",
+	"Full Moon", "Waning Gibbous", "Last Quarter", "Waning Crescent",
+}
+
+// Widget displays the current lunar phase
+type Widget struct {
+	*widget.BaseWidget
+	showLabel  bool
+	radius     int // Configured radius, 0 = fit the widget
+	southern   bool
+	padding    int
+	fontName   string
+	fontFace   font.Face
+	horizAlign config.HAlign
+	vertAlign  config.VAlign
+
+	now func() time.Time
+
+	mu    sync.RWMutex
+	phase float64 // Fraction of the synodic month, 0 = new moon
+}
+
+// New creates a new moon phase widget
+func New(cfg config.WidgetConfig) (*Widget, error) {
+	base := widget.NewBaseWidget(cfg)
+	helper := shared.NewConfigHelper(cfg)
+
+	w := &Widget{
+		BaseWidget: base,
+		padding:    helper.GetPadding(),
+		now:        time.Now,
+	}
+
+	if cfg.MoonPhase != nil {
+		w.showLabel = cfg.MoonPhase.ShowLabel
+		w.radius = cfg.MoonPhase.Radius
+		w.southern = cfg.MoonPhase.Hemisphere == config.HemisphereSouthern
+	}
+
+	if w.showLabel {
+		textSettings := helper.GetTextSettings()
+		fontFace, err := bitmap.LoadFont(textSettings.FontName, textSettings.FontSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load font: %w", err)
+		}
+		w.fontName = textSettings.FontName
+		w.fontFace = fontFace
+		w.horizAlign = textSettings.HorizAlign
+		w.vertAlign = textSettings.VertAlign
+	}
+
+	w.phase = util.MoonPhase(w.now())
+
+	return w, nil
+}
+
+// Update recalculates the lunar phase
+func (w *Widget) Update() error {
+	phase := util.MoonPhase(w.now())
+
+	w.mu.Lock()
+	w.phase = phase
+	w.mu.Unlock()
+
+	return nil
+}
+
+// Render draws the moon and the optional phase name
+func (w *Widget) Render() (image.Image, error) {
+	img := w.CreateCanvas()
+	w.ApplyBorder(img)
+
+	w.mu.RLock()
+	phase := w.phase
+	w.mu.RUnlock()
+
+	pos := w.GetPosition()
+	contentW := pos.W - 2*w.padding
+	contentH := pos.H - 2*w.padding
+	if contentW <= 0 || contentH <= 0 {
+		return img, nil
+	}
+
+	// Without a label the moon is centered; with a label it sits on the left
+	radius := w.radius
+	if radius == 0 {
+		size := contentH
+		if !w.showLabel {
+			size = min(contentW, contentH)
+		}
+		radius = (size - 1) / 2
+	}
+	cx := w.padding + contentW/2
+	if w.showLabel {
+		cx = w.padding + radius
+	}
+	cy := w.padding + contentH/2
+
+	drawMoon(img, cx, cy, radius, phase, w.southern)
+
+	if w.showLabel {
+		labelX := cx + radius + 1 + labelGap
+		bitmap.SmartDrawTextInRect(img, PhaseName(phase), w.fontFace, w.fontName,
+			labelX, w.padding, pos.W-w.padding-labelX, contentH, w.horizAlign, w.vertAlign, 0)
+	}
+
+	return img, nil
+}
+
+// PhaseName returns the name of the principal phase nearest to phase
+func PhaseName(phase float64) string {
+	return phaseNames[int(math.Floor(phase*8+0.5))%8]
+}
+
+// drawMoon draws the moon outline and fills its illuminated part. The terminator is
+// an ellipse whose horizontal semi-axis follows the cosine of the phase angle. Seen
+// from the northern hemisphere the waxing moon is lit on the right; southern mirrors it.
+func drawMoon(img *image.Gray, cx, cy, radius int, phase float64, southern bool) {
+	if radius <= 0 {
+		return
+	}
+	bitmap.DrawCircle(img, cx, cy, radius, color.Gray{Y: outlineColor})
+
+	waxing := phase < 0.5
+	cosPhase := math.Cos(2 * math.Pi * phase)
+	r2 := float64(radius * radius)
+	for dy := -radius; dy <= radius; dy++ {
+		halfWidth := math.Sqrt(r2 - float64(dy*dy))
+		terminator := halfWidth * cosPhase
+		for dx := -radius; dx <= radius; dx++ {
+			x := float64(dx)
+			if math.Abs(x) > halfWidth {
+				continue
+			}
+			if southern {
+				x = -x
+			}
+			if (waxing && x > terminator) || (!waxing && x < -terminator) {
+				img.SetGray(cx+dx, cy+dy, color.Gray{Y: litColor})
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package moonphase
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func newTestConfig(moon *config.MoonPhaseConfig) config.WidgetConfig {
+	return config.WidgetConfig{
+		Type:      "moon_phase",
+		ID:        "moon_phase_0",
+		Position:  config.PositionConfig{W: 40, H: 40},
+		MoonPhase: moon,
+	}
+}
+
+// renderPhase renders the widget at a fixed phase
+func renderPhase(t *testing.T, w *Widget, phase float64) *image.Gray {
+	t.Helper()
+	w.phase = phase
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	return img.(*image.Gray)
+}
+
+// litPixels counts illuminated pixels left and right of the center column
+func litPixels(img *image.Gray, cx int) (left, right int) {
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if img.GrayAt(x, y).Y != litColor {
+				continue
+			}
+			if x < cx {
+				left++
+			} else if x > cx {
+				right++
+			}
+		}
+	}
+	return left, right
+}
+
+func TestPhaseName(t *testing.T) {
+	tests := []struct {
+		phase float64
+		want  string
+	}{
+		{0, "New Moon"},
+		{0.98, "New Moon"},
+		{0.1, "Waxing Crescent"},
+		{0.25, "First Quarter"},
+		{0.4, "Waxing Gibbous"},
+		{0.5, "Full Moon"},
+		{0.6, "Waning Gibbous"},
+		{0.75, "Last Quarter"},
+		{0.85, "Waning Crescent"},
+	}
+	for _, tt := range tests {
+		if got := PhaseName(tt.phase); got != tt.want {
+			t.Errorf("PhaseName(%g) = %q, want %q", tt.phase, got, tt.want)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	w, err := New(newTestConfig(nil))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	w.now = func() time.Time { return time.Date(2024, 4, 23, 23, 49, 0, 0, time.UTC) }
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got := PhaseName(w.phase); got != "Full Moon" {
+		t.Errorf("phase after Update() = %g (%s), want full moon", w.phase, got)
+	}
+}
+
+func TestRender_Phases(t *testing.T) {
+	w, err := New(newTestConfig(nil))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if left, right := litPixels(renderPhase(t, w, 0), 20); left+right != 0 {
+		t.Errorf("new moon lit %d pixels, want 0", left+right)
+	}
+
+	left, right := litPixels(renderPhase(t, w, 0.5), 20)
+	if left == 0 || right == 0 || abs(left-right) > left/20 {
+		t.Errorf("full moon lit left=%d right=%d, want a symmetric disc", left, right)
+	}
+	full := left + right
+
+	left, right = litPixels(renderPhase(t, w, 0.25), 20)
+	if left != 0 || right == 0 {
+		t.Errorf("first quarter lit left=%d right=%d, want the right half", left, right)
+	}
+
+	left, right = litPixels(renderPhase(t, w, 0.1), 20)
+	crescent := left + right
+	if left != 0 || crescent == 0 || crescent >= full/4 {
+		t.Errorf("waxing crescent lit left=%d right=%d, want a thin right crescent", left, right)
+	}
+
+	left, right = litPixels(renderPhase(t, w, 0.6), 20)
+	if left <= right || left+right <= full/2 {
+		t.Errorf("waning gibbous lit left=%d right=%d, want mostly the left side", left, right)
+	}
+}
+
+func TestRender_SouthernHemisphere(t *testing.T) {
+	w, err := New(newTestConfig(&config.MoonPhaseConfig{Hemisphere: config.HemisphereSouthern}))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	left, right := litPixels(renderPhase(t, w, 0.1), 20)
+	if right != 0 || left == 0 {
+		t.Errorf("southern waxing crescent lit left=%d right=%d, want the left side", left, right)
+	}
+}
+
+func TestRender_LabelAndRadius(t *testing.T) {
+	cfg := newTestConfig(&config.MoonPhaseConfig{ShowLabel: true, Radius: 8})
+	cfg.Position = config.PositionConfig{W: 128, H: 40}
+	cfg.Text = &config.TextConfig{Font: "5x7"}
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	img := renderPhase(t, w, 0.5)
+
+	// The moon sits on the left with the configured radius
+	if img.GrayAt(8, 20).Y != litColor || img.GrayAt(17, 20).Y == litColor {
+		t.Error("full moon with radius 8 not drawn at the left edge")
+	}
+
+	labelPixels := 0
+	for y := 0; y < 40; y++ {
+		for x := 20; x < 128; x++ {
+			if img.GrayAt(x, y).Y > 0 {
+				labelPixels++
+			}
+		}
+	}
+	if labelPixels == 0 {
+		t.Error("phase label not drawn")
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -2,6 +2,7 @@ package network
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
@@ -24,12 +25,17 @@ func init() {
 type Widget struct {
 	*widgetbase.DualIOWidget
 	interfaceName   *string
+	autoInterface   bool // Follow the interface carrying the default route
+	allInterfaces   bool // Sum all non-loopback interfaces
 	networkProvider metrics.NetworkProvider
+	routeProvider   metrics.DefaultRouteProvider
 
 	// State for delta calculation
-	lastRx   uint64
-	lastTx   uint64
-	lastTime time.Time
+	lastRx        uint64
+	lastTx        uint64
+	lastTime      time.Time
+	lastInterface string                         // Interface the counters above were read from
+	lastCounters  map[string]metrics.NetworkStat // Per-interface counters in "all" mode
 }
 
 // New creates a new network widget
@@ -43,6 +49,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	padding := helper.GetPadding()
 	barSettings := helper.GetBarSettings()
 	graphSettings := helper.GetGraphSettings()
+	aggregateMode, aggregateWindow := helper.GetAggregateSettings()
 
 	// Extract network-specific colors (rx/tx)
 	rxColor := 255
@@ -94,6 +101,16 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		maxSpeedBps = cfg.MaxSpeedMbps * 1000000 / 8
 	}
 
+	// Auto-scale to the observed peak, replacing max_speed_mbps
+	var autoScaleWindow time.Duration
+	if cfg.AutoScale {
+		window := cfg.AutoScaleWindow
+		if window <= 0 {
+			window = config.DefaultAutoScaleWindow
+		}
+		autoScaleWindow = time.Duration(window * float64(time.Second))
+	}
+
 	// Unit selection - default to "Mbps" for backward compatibility
 	unit := cfg.Unit
 	if unit == "" {
@@ -141,11 +158,12 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 			SecondaryNeedleColor: uint8(max(0, txNeedleColor)),
 		},
 		render.TextConfig{
-			FontFace:   fontFace,
-			FontName:   textSettings.FontName,
-			HorizAlign: textSettings.HorizAlign,
-			VertAlign:  textSettings.VertAlign,
-			Padding:    padding,
+			FontFace:    fontFace,
+			FontName:    textSettings.FontName,
+			HorizAlign:  textSettings.HorizAlign,
+			VertAlign:   textSettings.VertAlign,
+			Padding:     padding,
+			Orientation: textSettings.Orientation,
 		},
 	)
 
@@ -165,12 +183,35 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		Converter:  converter,
 		Renderer:   renderer,
 		HistoryLen: graphSettings.HistoryLen,
+
+		AggregateMode:   aggregateMode,
+		AggregateWindow: aggregateWindow,
+		AutoScaleWindow: autoScaleWindow,
 	})
 
+	autoInterface := cfg.Interface != nil &&
+		(*cfg.Interface == config.NetworkInterfaceAuto || *cfg.Interface == config.NetworkInterfaceDefault)
+
+	allInterfaces := cfg.Interface != nil && *cfg.Interface == config.NetworkInterfaceAll
+
+	networkProvider := metrics.DefaultNetwork
+	var routeProvider metrics.DefaultRouteProvider = metrics.DefaultRoute
+	if cfg.Demo {
+		name := ""
+		if cfg.Interface != nil && !autoInterface && !allInterfaces {
+			name = *cfg.Interface
+		}
+		networkProvider = metrics.NewDemoNetwork(name)
+		routeProvider = metrics.DemoDefaultRoute{}
+	}
+
 	return &Widget{
 		DualIOWidget:    baseDualIO,
 		interfaceName:   cfg.Interface,
-		networkProvider: metrics.DefaultNetwork,
+		autoInterface:   autoInterface,
+		allInterfaces:   allInterfaces,
+		networkProvider: networkProvider,
+		routeProvider:   routeProvider,
 	}, nil
 }
 
@@ -181,23 +222,36 @@ func (w *Widget) Update() error {
 		return err
 	}
 
-	// Find the interface
-	var rx, tx uint64
-	if w.interfaceName != nil && *w.interfaceName != "" {
-		// Use specified interface
-		for _, stat := range stats {
-			if stat.Name == *w.interfaceName {
-				rx = stat.BytesRecv
-				tx = stat.BytesSent
-				break
-			}
+	interfaceName := ""
+	if w.interfaceName != nil {
+		interfaceName = *w.interfaceName
+	}
+	if w.autoInterface {
+		// Resolve the active connection on every update, so the widget follows
+		// switches between adapters. Without a connection there is no throughput.
+		interfaceName, err = w.routeProvider.DefaultRouteInterface()
+		if err != nil {
+			w.lastTime = time.Time{}
+			w.lastInterface = ""
+			w.SetValuesAndHistory(0, 0, w.IsGraphMode())
+			return nil
 		}
+	}
+
+	// Counters of different interfaces are unrelated: start over after a switch
+	if interfaceName != w.lastInterface {
+		w.lastTime = time.Time{}
+		w.lastInterface = interfaceName
+	}
+
+	var rxDelta, txDelta uint64
+	if w.allInterfaces {
+		rxDelta, txDelta = w.sumInterfaceDeltas(stats)
 	} else {
-		// Sum all interfaces
-		for _, stat := range stats {
-			rx += stat.BytesRecv
-			tx += stat.BytesSent
-		}
+		rx, tx := interfaceCounters(stats, interfaceName)
+		rxDelta, txDelta = rx-w.lastRx, tx-w.lastTx
+		w.lastRx = rx
+		w.lastTx = tx
 	}
 
 	now := time.Now()
@@ -206,17 +260,66 @@ func (w *Widget) Update() error {
 		elapsed := now.Sub(w.lastTime).Seconds()
 		if elapsed > 0 {
 			// Calculate bytes per second
-			rxBps := float64(rx-w.lastRx) / elapsed
-			txBps := float64(tx-w.lastTx) / elapsed
+			rxBps := float64(rxDelta) / elapsed
+			txBps := float64(txDelta) / elapsed
 
 			// Update base widget values
 			w.SetValuesAndHistory(rxBps, txBps, w.IsGraphMode())
 		}
 	}
 
-	w.lastRx = rx
-	w.lastTx = tx
 	w.lastTime = now
 
 	return nil
 }
+
+// interfaceCounters returns the counters of the named interface, or the sum of
+// all interfaces when name is empty
+func interfaceCounters(stats []metrics.NetworkStat, name string) (rx, tx uint64) {
+	if name != "" {
+		// Use specified interface
+		for _, stat := range stats {
+			if stat.Name == name {
+				return stat.BytesRecv, stat.BytesSent
+			}
+		}
+		return 0, 0
+	}
+	// Sum all interfaces
+	for _, stat := range stats {
+		rx += stat.BytesRecv
+		tx += stat.BytesSent
+	}
+	return rx, tx
+}
+
+// sumInterfaceDeltas sums the traffic of every non-loopback interface since the
+// previous update. Each interface is compared with its own previous counters, so an
+// interface that appears, disappears or resets its counters causes no spike.
+func (w *Widget) sumInterfaceDeltas(stats []metrics.NetworkStat) (rxDelta, txDelta uint64) {
+	counters := make(map[string]metrics.NetworkStat, len(stats))
+	for _, stat := range stats {
+		if isLoopback(stat.Name) {
+			continue
+		}
+		counters[stat.Name] = stat
+		last, ok := w.lastCounters[stat.Name]
+		if !ok {
+			continue
+		}
+		if stat.BytesRecv >= last.BytesRecv {
+			rxDelta += stat.BytesRecv - last.BytesRecv
+		}
+		if stat.BytesSent >= last.BytesSent {
+			txDelta += stat.BytesSent - last.BytesSent
+		}
+	}
+	w.lastCounters = counters
+	return rxDelta, txDelta
+}
+
+// isLoopback reports whether an interface name denotes a loopback interface
+// ("lo" on Linux, "lo0" on macOS, "Loopback Pseudo-Interface 1" on Windows)
+func isLoopback(name string) bool {
+	return name == "lo" || name == "lo0" || strings.Contains(strings.ToLower(name), "loopback")
+}
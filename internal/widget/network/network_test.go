@@ -2,8 +2,10 @@ package network
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/metrics"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
 )
 
@@ -290,6 +292,35 @@ func TestWidget_AutoScale(t *testing.T) {
 	}
 }
 
+// TestNew_AutoScalePeak tests that auto_scale tracks the observed peak
+func TestNew_AutoScalePeak(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:         "network",
+		ID:           "test_network_peak",
+		Position:     config.PositionConfig{W: 128, H: 40},
+		Mode:         "bar",
+		MaxSpeedMbps: 1000,
+		AutoScale:    true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if widget.ScaleTracker == nil {
+		t.Fatal("auto_scale should create a peak tracker")
+	}
+
+	cfg.AutoScale = false
+	widget, err = New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if widget.ScaleTracker != nil {
+		t.Error("fixed max_speed_mbps should not track peaks")
+	}
+}
+
 // TestWidget_SpecificInterface tests monitoring specific interface
 func TestWidget_SpecificInterface(t *testing.T) {
 	// Use a likely non-existent interface to test error handling
@@ -317,6 +348,176 @@ func TestWidget_SpecificInterface(t *testing.T) {
 	}
 }
 
+// TestWidget_AutoInterface tests following the interface carrying the default route
+func TestWidget_AutoInterface(t *testing.T) {
+	iface := "auto"
+	cfg := config.WidgetConfig{
+		Type:      "network",
+		ID:        "test_network_auto",
+		Position:  config.PositionConfig{W: 128, H: 40},
+		Interface: &iface,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !widget.autoInterface {
+		t.Fatal("interface \"auto\" should follow the default route")
+	}
+
+	// eth0 transfers 1000 bytes per update, wlan0 a million
+	var calls uint64
+	widget.networkProvider = &metrics.MockNetwork{
+		IOCountersFunc: func() ([]metrics.NetworkStat, error) {
+			calls++
+			return []metrics.NetworkStat{
+				{Name: "eth0", BytesRecv: calls * 1000, BytesSent: calls * 1000},
+				{Name: "wlan0", BytesRecv: calls * 1000000, BytesSent: calls * 1000000},
+			}, nil
+		},
+	}
+	route := "eth0"
+	var routeErr error
+	widget.routeProvider = &metrics.MockDefaultRoute{
+		DefaultRouteInterfaceFunc: func() (string, error) {
+			return route, routeErr
+		},
+	}
+
+	update := func() float64 {
+		t.Helper()
+		time.Sleep(10 * time.Millisecond)
+		if err := widget.Update(); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		return widget.PrimaryValue
+	}
+
+	update()
+	ethRate := update()
+	if ethRate <= 0 {
+		t.Fatalf("eth0 rate = %f, want positive", ethRate)
+	}
+
+	// Switching adapters starts over instead of mixing counters of both
+	route = "wlan0"
+	if got := update(); got != ethRate {
+		t.Errorf("rate right after switching = %f, want unchanged %f", got, ethRate)
+	}
+	if got := update(); got <= ethRate*10 {
+		t.Errorf("wlan0 rate = %f, want well above eth0 rate %f", got, ethRate)
+	}
+
+	// No default route: zero throughput, not an error
+	routeErr = metrics.ErrDefaultRouteUnavailable
+	if got := update(); got != 0 {
+		t.Errorf("rate without default route = %f, want 0", got)
+	}
+}
+
+// TestWidget_AllInterfaces tests summing non-loopback interfaces without spikes
+// when interfaces appear or disappear
+func TestWidget_AllInterfaces(t *testing.T) {
+	iface := "all"
+	cfg := config.WidgetConfig{
+		Type:         "network",
+		ID:           "test_network_all",
+		Position:     config.PositionConfig{W: 128, H: 40},
+		Mode:         "bar",
+		Interface:    &iface,
+		MaxSpeedMbps: 100,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !widget.allInterfaces {
+		t.Fatal("interface \"all\" should sum all interfaces")
+	}
+
+	var stats []metrics.NetworkStat
+	widget.networkProvider = &metrics.MockNetwork{
+		IOCountersFunc: func() ([]metrics.NetworkStat, error) {
+			return stats, nil
+		},
+	}
+
+	// Returns the bytes received since the previous update, assuming a fixed interval
+	var last time.Time
+	update := func(s ...metrics.NetworkStat) float64 {
+		t.Helper()
+		stats = s
+		if err := widget.Update(); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		elapsed := widget.lastTime.Sub(last).Seconds()
+		last = widget.lastTime
+		return widget.PrimaryValue * elapsed
+	}
+
+	update(
+		metrics.NetworkStat{Name: "eth0", BytesRecv: 1000},
+		metrics.NetworkStat{Name: "eth1", BytesRecv: 5000},
+		metrics.NetworkStat{Name: "lo", BytesRecv: 100},
+	)
+	time.Sleep(10 * time.Millisecond)
+	got := update(
+		metrics.NetworkStat{Name: "eth0", BytesRecv: 3000},
+		metrics.NetworkStat{Name: "eth1", BytesRecv: 6000},
+		metrics.NetworkStat{Name: "lo", BytesRecv: 900000},
+	)
+	if got < 2999 || got > 3001 {
+		t.Errorf("bytes received = %f, want 3000 (loopback excluded)", got)
+	}
+
+	// A new interface with large counters and a vanished one do not count
+	time.Sleep(10 * time.Millisecond)
+	got = update(
+		metrics.NetworkStat{Name: "eth1", BytesRecv: 6500},
+		metrics.NetworkStat{Name: "bond0", BytesRecv: 50000000},
+	)
+	if got < 499 || got > 501 {
+		t.Errorf("bytes received after interface change = %f, want 500", got)
+	}
+
+	// The new interface counts from the next update on
+	time.Sleep(10 * time.Millisecond)
+	got = update(
+		metrics.NetworkStat{Name: "eth1", BytesRecv: 6500},
+		metrics.NetworkStat{Name: "bond0", BytesRecv: 50001000},
+	)
+	if got < 999 || got > 1001 {
+		t.Errorf("bytes received = %f, want 1000", got)
+	}
+}
+
+// TestNew_DemoAutoInterface tests that demo mode resolves "auto" to the demo interface
+func TestNew_DemoAutoInterface(t *testing.T) {
+	iface := "default"
+	cfg := config.WidgetConfig{
+		Type:      "network",
+		ID:        "test_network_demo_auto",
+		Position:  config.PositionConfig{W: 128, H: 40},
+		Interface: &iface,
+		Demo:      true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	name, err := widget.routeProvider.DefaultRouteInterface()
+	if err != nil {
+		t.Fatalf("DefaultRouteInterface() error = %v", err)
+	}
+	stats, _ := widget.networkProvider.IOCounters()
+	if len(stats) != 1 || stats[0].Name != name {
+		t.Errorf("demo interface = %v, default route = %q; want them to match", stats, name)
+	}
+}
+
 // TestWidget_RenderGauge tests dual gauge mode rendering
 func TestWidget_RenderGauge(t *testing.T) {
 	cfg := config.WidgetConfig{
@@ -467,3 +668,28 @@ func TestWidget_ConcurrentAccess(t *testing.T) {
 	<-done
 	// Should not panic or race
 }
+
+// TestNew_Demo verifies demo mode reports traffic on the configured interface
+func TestNew_Demo(t *testing.T) {
+	iface := "eth0"
+	cfg := config.WidgetConfig{
+		Type:      "network",
+		ID:        "test_network_demo",
+		Position:  config.PositionConfig{W: 128, H: 40},
+		Interface: &iface,
+		Demo:      true,
+	}
+
+	widget, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, ok := widget.networkProvider.(*metrics.DemoNetwork); !ok {
+		t.Fatalf("networkProvider = %T, want *metrics.DemoNetwork", widget.networkProvider)
+	}
+
+	stats, err := widget.networkProvider.IOCounters()
+	if err != nil || len(stats) != 1 || stats[0].Name != iface {
+		t.Errorf("IOCounters() = %v, %v; want a single %s interface", stats, err, iface)
+	}
+}
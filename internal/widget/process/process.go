@@ -0,0 +1,166 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"strings"
+	"sync"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	"github.com/pozitronik/steelclock-go/internal/shared/util"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+)
+
+func init() {
+	widget.Register("process", func(cfg config.WidgetConfig) (widget.Widget, error) {
+		return New(cfg)
+	})
+}
+
+// Widget displays CPU or memory usage of a single named process (or a PID)
+type Widget struct {
+	*widget.BaseWidget
+	displayMode render.DisplayMode
+
+	// Strategy pattern for rendering
+	strategy render.MetricDisplayStrategy
+	// MetricRenderer for rendering
+	Renderer *render.MetricRenderer
+
+	// Process selection
+	name       string // Process name matcher (case-insensitive substring)
+	pid        int32  // Exact process ID (0 = match by name)
+	metric     string // config.ProcessMetricCPU or config.ProcessMetricMemory
+	textFormat string // Format string for text mode (from text.format)
+
+	// Metrics provider (PDH on Windows, gopsutil elsewhere)
+	usageProvider metrics.ProcessUsageProvider
+
+	// Current value and history
+	currentValue float64
+	history      *util.RingBuffer[float64]
+	running      bool // A matching process was found on the last update
+	mu           sync.RWMutex
+}
+
+// New creates a new process widget
+func New(cfg config.WidgetConfig) (*Widget, error) {
+	base := widget.NewBaseWidget(cfg)
+	helper := shared.NewConfigHelper(cfg)
+
+	// Build common metric renderer
+	mr, err := helper.BuildMetricRenderer()
+	if err != nil {
+		return nil, err
+	}
+
+	name := ""
+	pid := int32(0)
+	metric := config.ProcessMetricCPU
+	if cfg.ProcessMonitor != nil {
+		name = strings.TrimSpace(cfg.ProcessMonitor.Name)
+		pid = cfg.ProcessMonitor.PID
+		if cfg.ProcessMonitor.Metric != "" {
+			metric = cfg.ProcessMonitor.Metric
+		}
+	}
+	if name == "" && pid <= 0 {
+		return nil, fmt.Errorf("process widget requires 'name' or 'pid' in process_monitor config")
+	}
+
+	textFormat := "%.0f"
+	if cfg.Text != nil && cfg.Text.Format != "" {
+		textFormat = cfg.Text.Format
+	}
+
+	var usageProvider metrics.ProcessUsageProvider = metrics.NewProcessUsage()
+	if cfg.Demo {
+		usageProvider = metrics.NewDemoProcessUsage()
+	}
+
+	return &Widget{
+		BaseWidget:    base,
+		displayMode:   mr.DisplayMode,
+		strategy:      mr.Strategy,
+		Renderer:      mr.Renderer,
+		name:          name,
+		pid:           pid,
+		metric:        metric,
+		textFormat:    textFormat,
+		usageProvider: usageProvider,
+		history:       util.NewRingBuffer[float64](mr.HistoryLen),
+	}, nil
+}
+
+// Update samples the watched process
+func (w *Widget) Update() error {
+	stat, err := w.usageProvider.ProcessUsage(w.name, w.pid)
+	if errors.Is(err, metrics.ErrProcessNotFound) {
+		w.mu.Lock()
+		w.running = false
+		w.mu.Unlock()
+		return nil // Render shows the "not running" state
+	}
+	if err != nil {
+		return err
+	}
+
+	value := stat.CPUPercent
+	if w.metric == config.ProcessMetricMemory {
+		value = stat.MemoryPercent
+	}
+	value = max(0, min(value, 100))
+
+	// Keeps the widget visible while the process runs when auto-hide is enabled
+	w.TriggerAutoHide()
+
+	w.mu.Lock()
+	w.currentValue = value
+	w.running = true
+	if w.displayMode == render.DisplayModeGraph {
+		w.history.Push(value)
+	}
+	w.mu.Unlock()
+
+	return nil
+}
+
+// Render creates an image of the process widget.
+// Returns nil when auto-hide is enabled and the process hasn't been seen within the timeout.
+func (w *Widget) Render() (image.Image, error) {
+	if w.ShouldHide() {
+		return nil, nil
+	}
+
+	// Create canvas with background and border
+	img := w.CreateCanvas()
+	w.ApplyBorder(img)
+
+	// Get content area and position
+	content := w.GetContentArea()
+	pos := w.GetPosition()
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if !w.running {
+		bitmap.SmartDrawAlignedText(img, i18n.T(i18n.NotRunning), nil, bitmap.FontNamePixel5x7, config.AlignCenter, config.AlignMiddle, 0)
+		return img, nil
+	}
+
+	w.strategy.Render(img, render.MetricData{
+		Value:       w.currentValue,
+		History:     w.history.ToSlice(),
+		TextFormat:  w.textFormat,
+		ContentArea: image.Rect(content.X, content.Y, content.X+content.Width, content.Y+content.Height),
+		GaugeArea:   image.Rect(0, 0, pos.W, pos.H),
+	}, w.Renderer)
+
+	return img, nil
+}
@@ -0,0 +1,178 @@
+package process
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/metrics"
+)
+
+func newTestWidget(t *testing.T, mode string, pc *config.ProcessConfig, provider metrics.ProcessUsageProvider) *Widget {
+	t.Helper()
+	cfg := config.WidgetConfig{
+		Type:    "process",
+		ID:      "test_process",
+		Enabled: config.BoolPtr(true),
+		Position: config.PositionConfig{
+			X: 0, Y: 0, W: 128, H: 40,
+		},
+		Mode:           mode,
+		Graph:          &config.GraphConfig{History: 30},
+		ProcessMonitor: pc,
+	}
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	w.usageProvider = provider
+	return w
+}
+
+func TestNew_Defaults(t *testing.T) {
+	w := newTestWidget(t, "text", &config.ProcessConfig{Name: " game.exe "}, &metrics.MockProcessUsage{})
+	if w.name != "game.exe" {
+		t.Errorf("name = %q, want %q", w.name, "game.exe")
+	}
+	if w.metric != config.ProcessMetricCPU {
+		t.Errorf("metric = %q, want %q", w.metric, config.ProcessMetricCPU)
+	}
+	if w.textFormat != "%.0f" {
+		t.Errorf("textFormat = %q, want %q", w.textFormat, "%.0f")
+	}
+}
+
+func TestNew_RequiresMatcher(t *testing.T) {
+	for _, pc := range []*config.ProcessConfig{nil, {Name: "  "}, {Metric: config.ProcessMetricMemory}} {
+		_, err := New(config.WidgetConfig{
+			Type:           "process",
+			ID:             "test_process",
+			Position:       config.PositionConfig{W: 128, H: 40},
+			ProcessMonitor: pc,
+		})
+		if err == nil {
+			t.Errorf("New(%+v) expected error for missing name and pid", pc)
+		}
+	}
+}
+
+func TestUpdate_Metrics(t *testing.T) {
+	stat := metrics.ProcessUsageStat{Count: 3, CPUPercent: 40, MemoryPercent: 150}
+	var gotName string
+	var gotPID int32
+	provider := &metrics.MockProcessUsage{
+		ProcessUsageFunc: func(name string, pid int32) (metrics.ProcessUsageStat, error) {
+			gotName, gotPID = name, pid
+			return stat, nil
+		},
+	}
+
+	tests := []struct {
+		metric string
+		want   float64
+	}{
+		{config.ProcessMetricCPU, 40},
+		{config.ProcessMetricMemory, 100}, // Clamped
+	}
+	for _, tt := range tests {
+		t.Run(tt.metric, func(t *testing.T) {
+			w := newTestWidget(t, "graph", &config.ProcessConfig{Name: "game", PID: 42, Metric: tt.metric}, provider)
+			if err := w.Update(); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if gotName != "game" || gotPID != 42 {
+				t.Errorf("provider called with (%q, %d), want (\"game\", 42)", gotName, gotPID)
+			}
+			if !w.running || w.currentValue != tt.want {
+				t.Errorf("running = %v, currentValue = %v, want true, %v", w.running, w.currentValue, tt.want)
+			}
+			if w.history.Len() != 1 {
+				t.Errorf("history length = %d, want 1", w.history.Len())
+			}
+		})
+	}
+}
+
+func TestUpdate_NotRunning(t *testing.T) {
+	w := newTestWidget(t, "bar", &config.ProcessConfig{Name: "game"}, &metrics.MockProcessUsage{
+		ProcessUsageFunc: func(string, int32) (metrics.ProcessUsageStat, error) {
+			return metrics.ProcessUsageStat{}, metrics.ErrProcessNotFound
+		},
+	})
+	w.running = true
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v, want nil for a missing process", err)
+	}
+	if w.running {
+		t.Error("running = true, want false")
+	}
+
+	img, err := w.Render()
+	if err != nil || img == nil {
+		t.Fatalf("Render() = %v, %v; want the not running state", img, err)
+	}
+}
+
+func TestUpdate_ProviderError(t *testing.T) {
+	providerErr := errors.New("counters unavailable")
+	w := newTestWidget(t, "text", &config.ProcessConfig{Name: "game"}, &metrics.MockProcessUsage{
+		ProcessUsageFunc: func(string, int32) (metrics.ProcessUsageStat, error) {
+			return metrics.ProcessUsageStat{}, providerErr
+		},
+	})
+	if err := w.Update(); !errors.Is(err, providerErr) {
+		t.Errorf("Update() error = %v, want %v", err, providerErr)
+	}
+}
+
+func TestRender_AutoHide(t *testing.T) {
+	found := false
+	cfg := config.WidgetConfig{
+		Type:           "process",
+		ID:             "test_process_autohide",
+		Enabled:        config.BoolPtr(true),
+		Position:       config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Mode:           "text",
+		AutoHide:       &config.AutoHideConfig{Enabled: true, Timeout: 60},
+		ProcessMonitor: &config.ProcessConfig{Name: "game"},
+	}
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	w.usageProvider = &metrics.MockProcessUsage{
+		ProcessUsageFunc: func(string, int32) (metrics.ProcessUsageStat, error) {
+			if !found {
+				return metrics.ProcessUsageStat{}, metrics.ErrProcessNotFound
+			}
+			return metrics.ProcessUsageStat{Count: 1, CPUPercent: 10}, nil
+		},
+	}
+
+	_ = w.Update()
+	if img, _ := w.Render(); img != nil {
+		t.Error("Render() should hide the widget before the process is seen")
+	}
+
+	found = true
+	_ = w.Update()
+	if img, _ := w.Render(); img == nil {
+		t.Error("Render() should show the widget while the process runs")
+	}
+}
+
+func TestRender_AllModes(t *testing.T) {
+	for _, mode := range []string{"text", "bar", "graph", "gauge"} {
+		t.Run(mode, func(t *testing.T) {
+			w := newTestWidget(t, mode, &config.ProcessConfig{Name: "game"}, &metrics.MockProcessUsage{})
+			if err := w.Update(); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			img, err := w.Render()
+			if err != nil || img == nil {
+				t.Errorf("Render() = %v, %v", img, err)
+			}
+		})
+	}
+}
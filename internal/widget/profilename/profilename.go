@@ -0,0 +1,118 @@
+package profilename
+
+import (
+	"fmt"
+	"image"
+	"strings"
+	"sync"
+
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+	"golang.org/x/image/font"
+)
+
+func init() {
+	widget.Register("profile_name", func(cfg config.WidgetConfig) (widget.Widget, error) {
+		return New(cfg)
+	})
+}
+
+// ActiveProfileName is a callback that returns the name of the active profile.
+// It is set by the application layer to avoid importing the profile manager here.
+var ActiveProfileName func() string
+
+// nameToken is the placeholder replaced with the profile name in format strings
+const nameToken = "{name}"
+
+// Widget displays the name of the currently active profile
+type Widget struct {
+	*widget.BaseWidget
+	format      string
+	fontName    string
+	horizAlign  config.HAlign
+	vertAlign   config.VAlign
+	orientation config.TextOrientation
+	padding     int
+	fontFace    font.Face
+
+	mu   sync.RWMutex
+	text string
+}
+
+// New creates a new profile name widget
+func New(cfg config.WidgetConfig) (*Widget, error) {
+	base := widget.NewBaseWidget(cfg)
+	helper := shared.NewConfigHelper(cfg)
+
+	textSettings := helper.GetTextSettings()
+
+	format := cfg.Format
+	if format == "" {
+		format = nameToken
+	}
+
+	fontFace, err := bitmap.LoadFont(textSettings.FontName, textSettings.FontSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load font: %w", err)
+	}
+
+	w := &Widget{
+		BaseWidget:  base,
+		format:      format,
+		fontName:    textSettings.FontName,
+		horizAlign:  textSettings.HorizAlign,
+		vertAlign:   textSettings.VertAlign,
+		orientation: textSettings.Orientation,
+		padding:     helper.GetPadding(),
+		fontFace:    fontFace,
+	}
+	w.text = w.formatName(currentName())
+
+	return w, nil
+}
+
+// Update refreshes the active profile name
+func (w *Widget) Update() error {
+	text := w.formatName(currentName())
+
+	w.mu.Lock()
+	w.text = text
+	w.mu.Unlock()
+
+	return nil
+}
+
+// Render draws the profile name
+func (w *Widget) Render() (image.Image, error) {
+	img := w.CreateCanvas()
+	w.ApplyBorder(img)
+
+	w.mu.RLock()
+	text := w.text
+	w.mu.RUnlock()
+
+	if text != "" {
+		bitmap.SmartDrawAlignedTextOriented(img, text, w.fontFace, w.fontName, w.horizAlign, w.vertAlign, w.padding, w.orientation)
+	}
+
+	return img, nil
+}
+
+// formatName substitutes the profile name into the format string.
+// Returns an empty string when no profile name is available.
+func (w *Widget) formatName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return strings.ReplaceAll(w.format, nameToken, name)
+}
+
+// currentName returns the active profile name from the callback, if set
+func currentName() string {
+	if ActiveProfileName == nil {
+		return ""
+	}
+	return ActiveProfileName()
+}
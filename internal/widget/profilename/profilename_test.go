@@ -0,0 +1,123 @@
+package profilename
+
+import (
+	"image"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// withProvider installs a profile name callback for the duration of a test
+func withProvider(t *testing.T, provider func() string) {
+	t.Helper()
+	original := ActiveProfileName
+	ActiveProfileName = provider
+	t.Cleanup(func() { ActiveProfileName = original })
+}
+
+func newTestConfig() config.WidgetConfig {
+	return config.WidgetConfig{
+		Type:     "profile_name",
+		ID:       "profile_name_0",
+		Position: config.PositionConfig{W: 128, H: 20},
+		Text:     &config.TextConfig{Size: 10},
+	}
+}
+
+func TestNew(t *testing.T) {
+	withProvider(t, func() string { return "Gaming" })
+
+	w, err := New(newTestConfig())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if w.format != "{name}" {
+		t.Errorf("format = %q, want {name}", w.format)
+	}
+	if w.text != "Gaming" {
+		t.Errorf("text = %q, want Gaming", w.text)
+	}
+}
+
+func TestUpdate_FollowsProfileSwitch(t *testing.T) {
+	name := "Work"
+	withProvider(t, func() string { return name })
+
+	w, err := New(newTestConfig())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	name = "Gaming"
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if w.text != "Gaming" {
+		t.Errorf("text = %q, want Gaming", w.text)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	withProvider(t, func() string { return "Work" })
+
+	cfg := newTestConfig()
+	cfg.Format = "[{name}]"
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if w.text != "[Work]" {
+		t.Errorf("text = %q, want [Work]", w.text)
+	}
+}
+
+func TestNoProvider(t *testing.T) {
+	withProvider(t, nil)
+
+	w, err := New(newTestConfig())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if w.text != "" {
+		t.Errorf("text = %q, want empty without provider", w.text)
+	}
+
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	gray := img.(*image.Gray)
+	for _, p := range gray.Pix {
+		if p != 0 {
+			t.Fatal("Render() without profile name should produce an empty canvas")
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	withProvider(t, func() string { return "Gaming" })
+
+	w, err := New(newTestConfig())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if img.Bounds().Dx() != 128 || img.Bounds().Dy() != 20 {
+		t.Errorf("Render() size = %v, want 128x20", img.Bounds())
+	}
+
+	lit := false
+	for _, p := range img.(*image.Gray).Pix {
+		if p > 0 {
+			lit = true
+			break
+		}
+	}
+	if !lit {
+		t.Error("Render() should draw the profile name")
+	}
+}
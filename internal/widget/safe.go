@@ -0,0 +1,56 @@
+package widget
+
+import (
+	"fmt"
+	"image"
+	"runtime/debug"
+)
+
+// PanicError is returned by SafeUpdate and SafeRender when the widget panicked
+type PanicError struct {
+	Widget string // Widget name
+	Op     string // "update" or "render"
+	Value  any    // Value passed to panic
+	Stack  []byte // Stack trace captured at recovery
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("widget %s panicked during %s: %v", e.Widget, e.Op, e.Value)
+}
+
+// SafeUpdate calls w.Update, converting a panic into a *PanicError so a single
+// misbehaving widget can't take down its update loop
+func SafeUpdate(w Widget) (err error) {
+	defer recoverPanic(w, "update", &err)
+	return w.Update()
+}
+
+// SafeRender calls w.Render, converting a panic into a *PanicError so a single
+// misbehaving widget can't take down the render loop
+func SafeRender(w Widget) (img image.Image, err error) {
+	defer recoverPanic(w, "render", &err)
+	return w.Render()
+}
+
+// SafeRenderOverlay calls RenderOverlay if w implements ExclusiveOverlay, converting a
+// panic into a *PanicError. Returns nil for widgets without overlay support.
+func SafeRenderOverlay(w Widget, width, height int) (img image.Image, err error) {
+	overlay, ok := w.(ExclusiveOverlay)
+	if !ok {
+		return nil, nil
+	}
+	defer recoverPanic(w, "overlay", &err)
+	return overlay.RenderOverlay(width, height)
+}
+
+// recoverPanic stores a recovered panic in err. Must be called directly via defer.
+func recoverPanic(w Widget, op string, err *error) {
+	if r := recover(); r != nil {
+		*err = &PanicError{
+			Widget: w.Name(),
+			Op:     op,
+			Value:  r,
+			Stack:  debug.Stack(),
+		}
+	}
+}
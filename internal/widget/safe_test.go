@@ -0,0 +1,64 @@
+package widget
+
+import (
+	"errors"
+	"image"
+	"strings"
+	"testing"
+)
+
+// mockPanickingWidget panics in Update and Render
+type mockPanickingWidget struct {
+	mockWidget
+}
+
+func (m *mockPanickingWidget) Update() error                { panic("update boom") }
+func (m *mockPanickingWidget) Render() (image.Image, error) { panic("render boom") }
+
+func TestSafeUpdate_Panic(t *testing.T) {
+	w := &mockPanickingWidget{mockWidget: mockWidget{name: "bad"}}
+
+	err := SafeUpdate(w)
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("SafeUpdate() error = %v, want *PanicError", err)
+	}
+	if panicErr.Widget != "bad" || panicErr.Op != "update" || panicErr.Value != "update boom" {
+		t.Errorf("PanicError = %+v", panicErr)
+	}
+	if len(panicErr.Stack) == 0 {
+		t.Error("PanicError should capture the stack trace")
+	}
+	if !strings.Contains(err.Error(), "update boom") {
+		t.Errorf("Error() = %q, want panic value included", err.Error())
+	}
+}
+
+func TestSafeRender_Panic(t *testing.T) {
+	w := &mockPanickingWidget{mockWidget: mockWidget{name: "bad"}}
+
+	img, err := SafeRender(w)
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("SafeRender() error = %v, want *PanicError", err)
+	}
+	if panicErr.Op != "render" {
+		t.Errorf("Op = %q, want render", panicErr.Op)
+	}
+	if img != nil {
+		t.Error("SafeRender() should return nil image on panic")
+	}
+}
+
+func TestSafeRender_NoPanic(t *testing.T) {
+	w := &mockWidget{name: "good"}
+
+	if _, err := SafeRender(w); err != nil {
+		t.Errorf("SafeRender() error = %v, want nil", err)
+	}
+	if err := SafeUpdate(w); err != nil {
+		t.Errorf("SafeUpdate() error = %v, want nil", err)
+	}
+}
@@ -77,3 +77,9 @@ type ScreenCapture interface {
 	// GetDisplayInfo returns information about the captured display.
 	GetDisplayInfo() DisplayInfo
 }
+
+// NewScreenCapture creates the platform-specific screen capture.
+// Used by other widgets that reuse screen_mirror capture backends.
+func NewScreenCapture(cfg CaptureConfig) (ScreenCapture, error) {
+	return newScreenCapture(cfg)
+}
@@ -257,6 +257,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: the screen is captured in the background.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render creates an image of the current captured content.
 func (w *Widget) Render() (image.Image, error) {
 	// Check auto-hide
@@ -15,6 +15,7 @@ import (
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/shared"
 	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
@@ -389,7 +390,7 @@ func (w *Widget) formatOutput(state *spotify.PlayerState) string {
 		Set("album", track.Album).
 		Set("position", position).
 		Set("duration", duration).
-		Set("state", state.State.String()).
+		Set("state", i18n.T(state.State.Message())).
 		Set("device", state.DeviceName).
 		Set("volume", fmt.Sprintf("%d", state.Volume))
 
@@ -407,6 +408,11 @@ func formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%02d:%02d", mins, secs)
 }
 
+// NeedsRender always returns true: track text scrolls between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render creates an image of the widget
 func (w *Widget) Render() (image.Image, error) {
 	// Check auto-hide
@@ -486,15 +492,15 @@ func (w *Widget) renderAuthRequired(img *image.Gray) {
 		textW := pos.W - textX - w.padding
 
 		if textW > 20 { // Only show text if there's reasonable space
-			_, fontHeight := bitmap.SmartMeasureText("Not connected", w.fontFace, w.fontName)
+			_, fontHeight := bitmap.SmartMeasureText(i18n.T(i18n.NotConnected), w.fontFace, w.fontName)
 			textY := (pos.H - fontHeight) / 2
 
-			bitmap.SmartDrawTextAtPosition(img, "Not connected", w.fontFace, w.fontName,
+			bitmap.SmartDrawTextAtPosition(img, i18n.T(i18n.NotConnected), w.fontFace, w.fontName,
 				textX, textY, textX, 0, textW, pos.H)
 		}
 	} else {
 		// Fallback to text only
-		bitmap.SmartDrawAlignedText(img, "Not connected", w.fontFace, w.fontName, w.horizAlign, w.vertAlign, w.padding)
+		bitmap.SmartDrawAlignedText(img, i18n.T(i18n.NotConnected), w.fontFace, w.fontName, w.horizAlign, w.vertAlign, w.padding)
 	}
 }
 
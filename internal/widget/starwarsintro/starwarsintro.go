@@ -411,6 +411,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: the crawl scrolls on every frame.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the current animation frame
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.Lock()
@@ -3,6 +3,8 @@ package telegramcounter
 import (
 	"fmt"
 	"image"
+	"image/color"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -45,6 +47,8 @@ type Widget struct {
 	fontSize   int
 	mode       string // "badge" or "text"
 	textFormat string // format string with tokens like {unread}, {mentions}, etc.
+	maxCount   int    // counts above this render as "{max}+" (0 = no limit)
+	dot        bool   // show a filled dot instead of the badge or text
 
 	// Badge colors (for badge mode)
 	badgeForeground int // 0-255 grayscale, -1 for transparent
@@ -75,6 +79,19 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		return nil, fmt.Errorf("telegram auth configuration is required")
 	}
 
+	// Parse count display settings
+	maxCount := 0
+	dot := false
+	if cfg.Counter != nil {
+		switch cfg.Counter.Format {
+		case "", config.CounterFormatDot:
+		default:
+			return nil, fmt.Errorf("invalid counter.format %q", cfg.Counter.Format)
+		}
+		maxCount = max(cfg.Counter.Max, 0)
+		dot = cfg.Counter.Format == config.CounterFormatDot
+	}
+
 	// Create client config for registry
 	clientCfg := &tgclient.ClientConfig{
 		Auth:    cfg.Auth,
@@ -138,6 +155,8 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		authCfg:         cfg.Auth,
 		mode:            mode,
 		textFormat:      textFormat,
+		maxCount:        maxCount,
+		dot:             dot,
 		badgeForeground: badgeForeground,
 		badgeBackground: badgeBackground,
 		fontFace:        fontFace,
@@ -147,7 +166,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		width:           pos.W,
 		height:          pos.H,
 		connection:      connManager,
-		blink:           anim.NewBlinkAnimator(blinkMode, 500*time.Millisecond),
+		blink:           anim.NewBlinkAnimatorFromConfig(blinkMode, cfg.Blink),
 		statusRenderer:  render.NewStatusRenderer("5x7"),
 	}
 
@@ -189,6 +208,11 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// NeedsRender always returns true: the counter blinks and receives messages between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the widget
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.RLock()
@@ -228,6 +252,11 @@ func (w *Widget) Render() (image.Image, error) {
 
 // renderUnreadCount renders the unread count in the configured format
 func (w *Widget) renderUnreadCount(img *image.Gray) {
+	if w.dot {
+		w.drawDot(img)
+		return
+	}
+
 	switch w.mode {
 	case modeBadge:
 		// Draw Telegram icon (paper airplane) centered
@@ -243,20 +272,28 @@ func (w *Widget) renderUnreadCount(img *image.Gray) {
 // formatText replaces tokens in the text format with actual values (except {icon})
 func (w *Widget) formatText() string {
 	f := render.NewTokenFormatter().
-		Set("unread", fmt.Sprintf("%d", w.unreadStats.Total)).
-		Set("total", fmt.Sprintf("%d", w.unreadStats.Total)).
-		Set("mentions", fmt.Sprintf("%d", w.unreadStats.Mentions)).
-		Set("reactions", fmt.Sprintf("%d", w.unreadStats.Reactions)).
-		Set("private", fmt.Sprintf("%d", w.unreadStats.Private)).
-		Set("groups", fmt.Sprintf("%d", w.unreadStats.Groups)).
-		Set("channels", fmt.Sprintf("%d", w.unreadStats.Channels)).
-		Set("muted", fmt.Sprintf("%d", w.unreadStats.Muted)).
-		Set("private_muted", fmt.Sprintf("%d", w.unreadStats.PrivateMuted)).
-		Set("groups_muted", fmt.Sprintf("%d", w.unreadStats.GroupsMuted)).
-		Set("channels_muted", fmt.Sprintf("%d", w.unreadStats.ChannelsMuted))
+		Set("unread", w.formatCount(w.unreadStats.Total)).
+		Set("total", w.formatCount(w.unreadStats.Total)).
+		Set("mentions", w.formatCount(w.unreadStats.Mentions)).
+		Set("reactions", w.formatCount(w.unreadStats.Reactions)).
+		Set("private", w.formatCount(w.unreadStats.Private)).
+		Set("groups", w.formatCount(w.unreadStats.Groups)).
+		Set("channels", w.formatCount(w.unreadStats.Channels)).
+		Set("muted", w.formatCount(w.unreadStats.Muted)).
+		Set("private_muted", w.formatCount(w.unreadStats.PrivateMuted)).
+		Set("groups_muted", w.formatCount(w.unreadStats.GroupsMuted)).
+		Set("channels_muted", w.formatCount(w.unreadStats.ChannelsMuted))
 	return f.Format(w.textFormat)
 }
 
+// formatCount formats a count, capping it at the configured maximum, e.g. "99+"
+func (w *Widget) formatCount(n int) string {
+	if w.maxCount > 0 && n > w.maxCount {
+		return strconv.Itoa(w.maxCount) + "+"
+	}
+	return strconv.Itoa(n)
+}
+
 // getIconSize returns the icon size based on actual text height
 func (w *Widget) getIconSize() int {
 	// For text mode, measure actual text height
@@ -347,6 +384,16 @@ func (w *Widget) drawTelegramIcon(img *image.Gray, x, y int) {
 	bitmap.DrawGlyphWithBackground(img, icon, x, y, w.badgeForeground, w.badgeBackground)
 }
 
+// drawDot draws a filled dot in the badge foreground color, centered in the widget
+func (w *Widget) drawDot(img *image.Gray) {
+	c := uint8(255)
+	if w.badgeForeground >= 0 {
+		c = uint8(w.badgeForeground)
+	}
+	radius := max(min(w.width, w.height)/4, 1)
+	bitmap.DrawFilledCircle(img, w.width/2, w.height/2, radius, color.Gray{Y: c})
+}
+
 // Stop cleans up resources
 func (w *Widget) Stop() {
 	w.mu.Lock()
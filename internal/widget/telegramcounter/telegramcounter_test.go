@@ -0,0 +1,82 @@
+package telegramcounter
+
+import (
+	"image"
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+func TestFormatCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxCount int
+		n        int
+		want     string
+	}{
+		{"no limit", 0, 1234, "1234"},
+		{"zero", 99, 0, "0"},
+		{"below max", 99, 98, "98"},
+		{"at max", 99, 99, "99"},
+		{"above max", 99, 100, "99+"},
+		{"far above max", 99, 5000, "99+"},
+		{"max of one", 1, 2, "1+"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Widget{maxCount: tt.maxCount}
+			if got := w.formatCount(tt.n); got != tt.want {
+				t.Errorf("formatCount(%d) with max %d = %q, want %q", tt.n, tt.maxCount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDrawDot(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		foreground    int
+		wantColor     uint8
+	}{
+		{"foreground color", 20, 20, 200, 200},
+		{"transparent foreground draws white", 20, 20, -1, 255},
+		{"wide widget uses the height", 40, 12, 255, 255},
+		{"tiny widget still draws", 2, 2, 255, 255},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Widget{width: tt.width, height: tt.height, badgeForeground: tt.foreground}
+			img := image.NewGray(image.Rect(0, 0, tt.width, tt.height))
+			w.drawDot(img)
+
+			if got := img.GrayAt(tt.width/2, tt.height/2).Y; got != tt.wantColor {
+				t.Errorf("dot center = %d, want %d", got, tt.wantColor)
+			}
+			// The dot stays clear of the widget corners
+			for _, p := range []image.Point{{0, 0}, {tt.width - 1, 0}, {0, tt.height - 1}, {tt.width - 1, tt.height - 1}} {
+				if tt.width > 4 && img.GrayAt(p.X, p.Y).Y != 0 {
+					t.Errorf("corner %v is lit, want the dot centered", p)
+				}
+			}
+		})
+	}
+}
+
+func TestNew_InvalidCounterFormat(t *testing.T) {
+	_, err := New(config.WidgetConfig{
+		Type:     "telegram_counter",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 20, H: 20},
+		Auth: &config.TelegramAuthConfig{
+			APIID:       12345,
+			APIHash:     "testhash",
+			PhoneNumber: "+1234567890",
+		},
+		Counter: &config.TelegramCounterConfig{Format: "number"},
+	})
+	if err == nil {
+		t.Error("New() should reject an unknown counter.format")
+	}
+}
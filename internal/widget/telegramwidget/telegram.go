@@ -3,11 +3,15 @@ package telegramwidget
 import (
 	"fmt"
 	"image"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
 	"github.com/pozitronik/steelclock-go/internal/shared/util"
@@ -28,6 +32,10 @@ const (
 	maxErrorLineLength = 22
 )
 
+// maxPendingMessages caps the messages waiting for their minimum display time;
+// when full, the oldest waiting message is dropped so the newest ones win
+const maxPendingMessages = 3
+
 // ElementAppearance holds processed appearance settings for header or message
 type ElementAppearance struct {
 	Enabled    bool
@@ -39,14 +47,20 @@ type ElementAppearance struct {
 	VertAlign  config.VAlign
 	// Format string with tokens: {sender}, {chat}, {type}, {time}, {date}, {forwarded}
 	Format string
+	// Parsed Format, and the fonts named by its token styles, e.g. {sender|font=pixel5x7}
+	Tokens     []render.Token
+	StyleFonts map[string]font.Face
 	// Scroll settings
 	ScrollEnabled   bool
 	ScrollDirection anim.ScrollDirection
 	ScrollSpeed     float64
 	ScrollMode      anim.ScrollMode
 	ScrollGap       int
+	ScrollPauseMs   int
 	// Word break mode: "normal" or "break-all"
 	WordBreak string
+	// Wrap onto several lines with vertical scrolling instead of a single scrolling line
+	MultiLine bool
 }
 
 // ChatAppearance holds full appearance settings for a chat type
@@ -56,6 +70,11 @@ type ChatAppearance struct {
 	Separator   config.SeparatorConfig
 	Timeout     int
 	Transitions config.TransitionConfig
+	// Lowercase keywords that make the message blink, and whether to also invert it
+	Highlight       []string
+	HighlightInvert bool
+	// Minimum time a message stays before the next one replaces it (0 = no minimum)
+	MinDisplay time.Duration
 }
 
 // Widget displays Telegram notifications
@@ -75,6 +94,8 @@ type Widget struct {
 	currentMessage     *tgclient.MessageInfo
 	messageStartTime   time.Time
 	dismissedMessageID int // Track dismissed message to prevent re-showing after timeout
+	// Messages waiting for the current one's minimum display time, oldest first
+	pending []tgclient.MessageInfo
 
 	// Connection manager (shared module)
 	connection *util.ConnectionManager
@@ -133,12 +154,14 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		Mode:      appearance.Header.ScrollMode,
 		Direction: appearance.Header.ScrollDirection,
 		Gap:       appearance.Header.ScrollGap,
+		PauseMs:   appearance.Header.ScrollPauseMs,
 	}
 	messageScrollerCfg := anim.ScrollerConfig{
 		Speed:     appearance.Message.ScrollSpeed,
 		Mode:      appearance.Message.ScrollMode,
 		Direction: appearance.Message.ScrollDirection,
 		Gap:       appearance.Message.ScrollGap,
+		PauseMs:   appearance.Message.ScrollPauseMs,
 	}
 
 	w := &Widget{
@@ -153,37 +176,12 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		statusRenderer:  statusRenderer,
 		headerScroller:  anim.NewTextScroller(headerScrollerCfg),
 		messageScroller: anim.NewTextScroller(messageScrollerCfg),
-		blink:           anim.NewBlinkAnimator(anim.BlinkAlways, 500*time.Millisecond),
+		blink:           anim.NewBlinkAnimatorFromConfig(anim.BlinkAlways, cfg.Blink),
 		transition:      anim.NewTransitionManager(pos.W, pos.H),
 	}
 
 	// Add message callback (using Add instead of Set for proper multi-widget support)
-	client.AddMessageCallback(func(msg tgclient.MessageInfo) {
-		w.mu.Lock()
-		defer w.mu.Unlock()
-
-		// Update messages list
-		w.messages = w.client.GetMessages()
-
-		// Start transition for new message
-		// This works for both:
-		// - Transitioning between messages (currentMessage != nil)
-		// - First message appearance (currentMessage == nil, transitions from empty)
-		w.startTransition(msg.ChatType)
-
-		// Set new current message
-		msgCopy := msg
-		w.currentMessage = &msgCopy
-		w.messageStartTime = time.Now()
-		w.dismissedMessageID = 0 // Reset dismissed ID when new message arrives
-
-		// Reset scroll offsets for new message
-		w.headerScroller.Reset()
-		w.messageScroller.Reset()
-
-		// Trigger auto-hide timer (widget becomes visible when message arrives)
-		w.TriggerAutoHide()
-	})
+	client.AddMessageCallback(w.onMessage)
 
 	// Add error callback (using Add instead of Set for proper multi-widget support)
 	// Note: ConnectionManager handles connection errors internally,
@@ -211,6 +209,7 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 			ScrollSpeed:     30,
 			ScrollMode:      anim.ScrollContinuous,
 			ScrollGap:       20,
+			ScrollPauseMs:   1000,
 			WordBreak:       "normal",
 		},
 		Message: ElementAppearance{
@@ -226,7 +225,9 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 			ScrollSpeed:     30,
 			ScrollMode:      anim.ScrollContinuous,
 			ScrollGap:       20,
+			ScrollPauseMs:   1000,
 			WordBreak:       "normal",
+			MultiLine:       true,
 		},
 		Separator: config.SeparatorConfig{
 			Color:     128,
@@ -244,11 +245,11 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 	if appCfg == nil {
 		// Load default fonts
 		var err error
-		appearance.Header.FontFace, err = bitmap.LoadFont("", appearance.Header.FontSize)
+		appearance.Header.FontFace, err = loadElementFont("", appearance.Header.FontSize)
 		if err != nil {
 			return appearance, err
 		}
-		appearance.Message.FontFace, err = bitmap.LoadFont("", appearance.Message.FontSize)
+		appearance.Message.FontFace, err = loadElementFont("", appearance.Message.FontSize)
 		if err != nil {
 			return appearance, err
 		}
@@ -263,6 +264,7 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 			appearance.Header.Enabled = *app.Header.Enabled
 		}
 		appearance.Header.Blink = app.Header.Blink
+		appearance.Header.MultiLine = app.Header.MultiLine
 
 		if app.Header.Text != nil {
 			if app.Header.Text.Font != "" {
@@ -298,6 +300,9 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 			if app.Header.Scroll.Gap > 0 {
 				appearance.Header.ScrollGap = app.Header.Scroll.Gap
 			}
+			if app.Header.Scroll.PauseMs > 0 {
+				appearance.Header.ScrollPauseMs = app.Header.Scroll.PauseMs
+			}
 		}
 
 		if app.Header.WordBreak != "" {
@@ -343,6 +348,9 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 			if app.Message.Scroll.Gap > 0 {
 				appearance.Message.ScrollGap = app.Message.Scroll.Gap
 			}
+			if app.Message.Scroll.PauseMs > 0 {
+				appearance.Message.ScrollPauseMs = app.Message.Scroll.PauseMs
+			}
 		}
 
 		if app.Message.WordBreak != "" {
@@ -359,6 +367,19 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 	// Parse timeout
 	appearance.Timeout = app.Timeout
 
+	// Parse highlight keywords
+	for _, keyword := range app.Highlight {
+		if keyword = strings.ToLower(strings.TrimSpace(keyword)); keyword != "" {
+			appearance.Highlight = append(appearance.Highlight, keyword)
+		}
+	}
+	appearance.HighlightInvert = app.HighlightInvert
+
+	// Parse minimum display time
+	if app.MinDisplayMs > 0 {
+		appearance.MinDisplay = time.Duration(app.MinDisplayMs) * time.Millisecond
+	}
+
 	// Parse transitions
 	if app.Transitions != nil {
 		if app.Transitions.In != "" {
@@ -373,23 +394,53 @@ func parseAppearance(appCfg *config.TelegramAppearanceConfig) (ChatAppearance, e
 		if app.Transitions.OutSpeed > 0 {
 			appearance.Transitions.OutSpeed = app.Transitions.OutSpeed
 		}
+		if app.Transitions.Easing != "" {
+			appearance.Transitions.Easing = app.Transitions.Easing
+		}
 	}
 
 	// Load fonts
 	var err error
-	appearance.Header.FontFace, err = bitmap.LoadFont(appearance.Header.FontName, appearance.Header.FontSize)
+	appearance.Header.FontFace, err = loadElementFont(appearance.Header.FontName, appearance.Header.FontSize)
 	if err != nil {
 		return appearance, fmt.Errorf("failed to load header font: %w", err)
 	}
 
-	appearance.Message.FontFace, err = bitmap.LoadFont(appearance.Message.FontName, appearance.Message.FontSize)
+	appearance.Message.FontFace, err = loadElementFont(appearance.Message.FontName, appearance.Message.FontSize)
 	if err != nil {
 		return appearance, fmt.Errorf("failed to load message font: %w", err)
 	}
 
+	if appearance.Header.Format != "" {
+		appearance.Header.Tokens = render.ParseFormatTokens(appearance.Header.Format, func(string) render.TokenType { return render.TokenText })
+		if appearance.Header.MultiLine {
+			// Wrapped headers are laid out as plain text
+			if err := render.RejectTokenStyles(appearance.Header.Tokens); err != nil {
+				return appearance, fmt.Errorf("multi-line header: %w", err)
+			}
+		}
+		appearance.Header.StyleFonts, err = render.LoadStyleFonts(appearance.Header.Tokens, appearance.Header.FontSize)
+		if err != nil {
+			return appearance, fmt.Errorf("failed to load header font: %w", err)
+		}
+		for name, face := range appearance.Header.StyleFonts {
+			appearance.Header.StyleFonts[name] = bitmap.WithEmojiGlyphs(face)
+		}
+	}
+
 	return appearance, nil
 }
 
+// loadElementFont loads a header or message font. Emoji in messages are drawn with
+// monochrome stand-in glyphs, since display fonts have none.
+func loadElementFont(name string, size int) (font.Face, error) {
+	face, err := bitmap.LoadFont(name, size)
+	if err != nil {
+		return nil, err
+	}
+	return bitmap.WithEmojiGlyphs(face), nil
+}
+
 // getAppearance returns the appearance settings (now single appearance for all chat types)
 func (w *Widget) getAppearance(_ tgclient.ChatType) *ChatAppearance {
 	return &w.appearance
@@ -437,6 +488,13 @@ func (w *Widget) Update() error {
 		}
 	}
 
+	// Show the next waiting message once the current one has had its minimum display time
+	if len(w.pending) > 0 && (w.currentMessage == nil || time.Since(w.messageStartTime) >= w.appearance.MinDisplay) {
+		next := w.pending[0]
+		w.pending = w.pending[1:]
+		w.showMessage(next)
+	}
+
 	// Check message timeout
 	if w.currentMessage != nil {
 		appearance := w.getAppearance(w.currentMessage.ChatType)
@@ -454,8 +512,8 @@ func (w *Widget) Update() error {
 	// Refresh messages from client
 	if w.connection.IsConnected() {
 		w.messages = w.client.GetMessages()
-		// If no current message, show latest (unless it was dismissed)
-		if w.currentMessage == nil && len(w.messages) > 0 {
+		// If no current message, show latest (unless it was dismissed or others are waiting)
+		if w.currentMessage == nil && len(w.pending) == 0 && len(w.messages) > 0 {
 			// Skip dismissed message
 			if w.messages[0].ID != w.dismissedMessageID {
 				w.currentMessage = &w.messages[0]
@@ -468,6 +526,50 @@ func (w *Widget) Update() error {
 	return nil
 }
 
+// onMessage handles a new incoming message. While the current message is within its
+// minimum display time, the new one waits in the pending queue instead.
+func (w *Widget) onMessage(msg tgclient.MessageInfo) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Update messages list
+	w.messages = w.client.GetMessages()
+
+	if w.currentMessage != nil && time.Since(w.messageStartTime) < w.appearance.MinDisplay {
+		// Drop the oldest waiting message rather than letting the queue grow
+		if len(w.pending) >= maxPendingMessages {
+			w.pending = w.pending[1:]
+		}
+		w.pending = append(w.pending, msg)
+		w.TriggerAutoHide()
+		return
+	}
+
+	w.showMessage(msg)
+}
+
+// showMessage makes msg the current message
+func (w *Widget) showMessage(msg tgclient.MessageInfo) {
+	// Start transition for new message
+	// This works for both:
+	// - Transitioning between messages (currentMessage != nil)
+	// - First message appearance (currentMessage == nil, transitions from empty)
+	w.startTransition(msg.ChatType)
+
+	// Set new current message
+	msgCopy := msg
+	w.currentMessage = &msgCopy
+	w.messageStartTime = time.Now()
+	w.dismissedMessageID = 0 // Reset dismissed ID when new message arrives
+
+	// Reset scroll offsets for new message
+	w.headerScroller.Reset()
+	w.messageScroller.Reset()
+
+	// Trigger auto-hide timer (widget becomes visible when message arrives)
+	w.TriggerAutoHide()
+}
+
 // startTransition initiates a transition to a new message
 func (w *Widget) startTransition(chatType tgclient.ChatType) {
 	appearance := w.getAppearance(chatType)
@@ -488,9 +590,15 @@ func (w *Widget) startTransition(chatType tgclient.ChatType) {
 	}
 
 	// Start transition via manager
+	w.transition.SetEasing(anim.Easing(appearance.Transitions.Easing))
 	w.transition.Start(anim.TransitionType(appearance.Transitions.In), transitionSpeed, oldFrame)
 }
 
+// NeedsRender always returns true: messages scroll, blink and arrive between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render draws the widget
 func (w *Widget) Render() (image.Image, error) {
 	// Check if widget should be hidden (auto-hide mode)
@@ -506,16 +614,16 @@ func (w *Widget) Render() (image.Image, error) {
 
 	// Draw based on state
 	if w.connection.IsConnecting() {
-		w.drawStatusText(img, "Connecting...")
+		w.drawStatusText(img, i18n.T(i18n.Connecting))
 	} else if w.connection.GetError() != nil {
 		w.renderError(img)
 	} else if !w.connection.IsConnected() {
 		// Show "Connecting..." on initial state (before first connection attempt)
 		// to avoid brief "Disconnected" flash
 		if w.connection.IsInitialState() {
-			w.drawStatusText(img, "Connecting...")
+			w.drawStatusText(img, i18n.T(i18n.Connecting))
 		} else {
-			w.drawStatusText(img, "Disconnected")
+			w.drawStatusText(img, i18n.T(i18n.Disconnected))
 		}
 	} else if w.currentMessage == nil {
 		// No message to display - return empty/transparent widget
@@ -577,11 +685,18 @@ func (w *Widget) renderMessage(img *image.Gray, msg tgclient.MessageInfo) {
 	messageY := 0
 
 	// Calculate header height if enabled
+	var headerText string
+	var headerStyled render.StyledText
 	if appearance.Header.Enabled {
+		headerStyled = w.formatHeader(msg)
+		headerText = headerStyled.String()
 		_, textHeight := bitmap.SmartMeasureText("Ag", appearance.Header.FontFace, appearance.Header.FontName)
 		if textHeight == 0 {
 			textHeight = 16 // fallback if font measurement fails
 		}
+		if appearance.Header.MultiLine {
+			textHeight = w.multiLineHeaderHeight(headerText, appearance.Header, textHeight)
+		}
 		headerHeight = textHeight + 2
 	}
 
@@ -598,7 +713,6 @@ func (w *Widget) renderMessage(img *image.Gray, msg tgclient.MessageInfo) {
 
 	// Render header to sub-image (provides natural clipping)
 	if appearance.Header.Enabled && headerHeight > 0 {
-		headerText := w.formatHeader(msg)
 		if headerText != "" {
 			// Apply blink effect
 			if appearance.Header.Blink && !w.blink.ShouldRender() {
@@ -611,8 +725,16 @@ func (w *Widget) renderMessage(img *image.Gray, msg tgclient.MessageInfo) {
 				for i := range headerImg.Pix {
 					headerImg.Pix[i] = bgColor
 				}
+				if appearance.Header.ScrollEnabled {
+					headerText = w.headerScroller.VisibleText(headerText)
+					headerStyled = headerStyled.Prefix(utf8.RuneCountInString(headerText))
+				}
 				// Render header (coordinates relative to sub-image: 0,0)
-				w.renderScrollingText(headerImg, headerText, appearance.Header, w.headerScroller.GetOffset(), 0, 0, w.width, headerHeight)
+				if appearance.Header.MultiLine {
+					w.renderMultiLineText(headerImg, headerText, appearance.Header, w.headerScroller.GetOffset(), 0, 0, w.width, headerHeight)
+				} else {
+					w.renderScrollingText(headerImg, headerStyled, appearance.Header, w.headerScroller.GetOffset(), 0, 0, w.width, headerHeight)
+				}
 				// Copy to main image at (0, 0)
 				bitmap.CopyGrayRegion(img, headerImg, 0, 0)
 			}
@@ -644,8 +766,9 @@ func (w *Widget) renderMessage(img *image.Gray, msg tgclient.MessageInfo) {
 			messageText = "You have a new message"
 		}
 
-		// Apply blink effect
-		if appearance.Message.Blink && !w.blink.ShouldRender() {
+		// Apply blink effect, forced on for messages mentioning a highlight keyword
+		highlighted := containsKeyword(msg.Text, appearance.Highlight)
+		if (appearance.Message.Blink || highlighted) && !w.blink.ShouldRender() {
 			// Skip rendering when blinking off
 		} else {
 			// Create sub-image for message region
@@ -655,14 +778,67 @@ func (w *Widget) renderMessage(img *image.Gray, msg tgclient.MessageInfo) {
 			for i := range msgImg.Pix {
 				msgImg.Pix[i] = bgColor
 			}
+			if appearance.Message.ScrollEnabled {
+				messageText = w.messageScroller.VisibleText(messageText)
+			}
 			// Render message (coordinates relative to sub-image: 0,0)
 			w.renderMultiLineText(msgImg, messageText, appearance.Message, w.messageScroller.GetOffset(), 0, 0, w.width, msgHeight)
+			if highlighted && appearance.HighlightInvert {
+				for i, p := range msgImg.Pix {
+					msgImg.Pix[i] = 255 - p
+				}
+			}
 			// Copy to main image at (0, messageY)
 			bitmap.CopyGrayRegion(img, msgImg, 0, messageY)
 		}
 	}
 }
 
+// containsKeyword reports whether text contains one of the lowercase keywords as a whole word,
+// so "ann" matches "Hi Ann!" but not "Annual report"
+func containsKeyword(text string, keywords []string) bool {
+	if len(keywords) == 0 || text == "" {
+		return false
+	}
+	text = strings.ToLower(text)
+	for _, keyword := range keywords {
+		for start := 0; start < len(text); {
+			i := strings.Index(text[start:], keyword)
+			if i < 0 {
+				break
+			}
+			i += start
+			end := i + len(keyword)
+			before, _ := utf8.DecodeLastRuneInString(text[:i])
+			after, _ := utf8.DecodeRuneInString(text[end:])
+			if (i == 0 || !isWordRune(before)) && (end == len(text) || !isWordRune(after)) {
+				return true
+			}
+			_, size := utf8.DecodeRuneInString(text[i:])
+			start = i + size
+		}
+	}
+	return false
+}
+
+// isWordRune reports whether r continues a word
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// multiLineHeaderHeight returns the height of a wrapped header: all of its lines, but no more
+// than half the widget so the message stays visible. Taller headers scroll vertically.
+func (w *Widget) multiLineHeaderHeight(text string, elem ElementAppearance, lineHeight int) int {
+	renderer := render.NewMultiLineRenderer(render.MultiLineRendererConfig{
+		FontFace:  elem.FontFace,
+		FontName:  elem.FontName,
+		WordBreak: elem.WordBreak,
+	}, w.width)
+
+	height := renderer.MeasureTotalHeight(text, w.width)
+	return max(min(height, w.height/2-2), lineHeight)
+}
+
 // renderMultiLineText renders wrapped text with optional vertical scrolling
 func (w *Widget) renderMultiLineText(img *image.Gray, text string, elem ElementAppearance, scrollOffset float64, x, y, width, height int) {
 	renderer := render.NewMultiLineRenderer(render.MultiLineRendererConfig{
@@ -681,7 +857,7 @@ func (w *Widget) renderMultiLineText(img *image.Gray, text string, elem ElementA
 }
 
 // renderScrollingText renders text with scrolling support (single line, horizontal scroll)
-func (w *Widget) renderScrollingText(img *image.Gray, text string, elem ElementAppearance, scrollOffset float64, x, y, width, height int) {
+func (w *Widget) renderScrollingText(img *image.Gray, text render.StyledText, elem ElementAppearance, scrollOffset float64, x, y, width, height int) {
 	renderer := render.NewHorizontalTextRenderer(render.HorizontalTextRendererConfig{
 		FontFace:      elem.FontFace,
 		FontName:      elem.FontName,
@@ -693,13 +869,13 @@ func (w *Widget) renderScrollingText(img *image.Gray, text string, elem ElementA
 	})
 
 	bounds := image.Rect(x, y, x+width, y+height)
-	renderer.Render(img, text, scrollOffset, bounds)
+	renderer.RenderStyled(img, text, scrollOffset, bounds)
 }
 
-// formatHeader creates the header string for a message
-// Supports format tokens: {sender}, {chat}, {type}, {time}, {date}, {forwarded}
+// formatHeader creates the header text for a message
+// Supports format tokens: {sender}, {chat}, {type}, {time}, {date}, {forwarded}, with token styles
 // If format is empty, uses auto format based on chat type
-func (w *Widget) formatHeader(msg tgclient.MessageInfo) string {
+func (w *Widget) formatHeader(msg tgclient.MessageInfo) render.StyledText {
 	appearance := w.getAppearance(msg.ChatType)
 
 	// Get sender name with fallback for private chats
@@ -742,9 +918,10 @@ func (w *Widget) formatHeader(msg tgclient.MessageInfo) string {
 		}
 	}
 
+	base := render.StyledRun{Color: 255, FontFace: appearance.Header.FontFace, FontName: appearance.Header.FontName}
+
 	// If no custom format, use auto format based on chat type
-	format := appearance.Header.Format
-	if format == "" {
+	if appearance.Header.Format == "" {
 		header := ""
 		switch msg.ChatType {
 		case tgclient.ChatTypePrivate:
@@ -760,19 +937,29 @@ func (w *Widget) formatHeader(msg tgclient.MessageInfo) string {
 				header = forwardedStr
 			}
 		}
-		return header
+		if header == "" {
+			return nil
+		}
+		base.Text = header
+		return render.StyledText{base}
 	}
 
-	// Apply format tokens using TokenFormatter
-	formatter := render.NewTokenFormatter().
-		Set("sender", senderName).
-		Set("chat", chatTitle).
-		Set("type", chatTypeStr).
-		Set("time", msg.Time.Format("15:04")).
-		Set("date", msg.Time.Format("Jan 2")).
-		Set("forwarded", forwardedStr)
-
-	return formatter.Format(format)
+	// Unknown tokens and tokens with a parameter are kept as written
+	values := map[string]string{
+		"sender":    senderName,
+		"chat":      chatTitle,
+		"type":      chatTypeStr,
+		"time":      msg.Time.Format("15:04"),
+		"date":      i18n.FormatTime(msg.Time, "Jan 2"),
+		"forwarded": forwardedStr,
+	}
+	return render.StyleTokens(appearance.Header.Tokens, func(t render.Token) (string, bool) {
+		if t.Param != "" {
+			return "", false
+		}
+		v, ok := values[t.Name]
+		return v, ok
+	}, base, appearance.Header.StyleFonts)
 }
 
 // Stop cleans up resources
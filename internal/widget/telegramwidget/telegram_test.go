@@ -1,9 +1,15 @@
 package telegramwidget
 
 import (
+	"image"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	tgclient "github.com/pozitronik/steelclock-go/internal/telegram"
 )
 
 func TestNew(t *testing.T) {
@@ -254,6 +260,226 @@ func TestWidget_AppearanceSettings(t *testing.T) {
 	}
 }
 
+func TestWidget_MultiLineHeader(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "telegram",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 80},
+		Auth: &config.TelegramAuthConfig{
+			APIID:       12345,
+			APIHash:     "testhash",
+			PhoneNumber: "+1234567890",
+		},
+		Appearance: &config.TelegramAppearanceConfig{
+			Header: &config.TelegramElementConfig{MultiLine: true, WordBreak: "break-all"},
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !w.appearance.Header.MultiLine {
+		t.Fatal("Header.MultiLine = false, want true")
+	}
+
+	elem := w.appearance.Header
+	_, lineHeight := bitmap.SmartMeasureText("Ag", elem.FontFace, elem.FontName)
+
+	// Short headers keep a single line
+	if got := w.multiLineHeaderHeight("Chat", elem, lineHeight); got != lineHeight {
+		t.Errorf("single line header height = %d, want %d", got, lineHeight)
+	}
+
+	// Long CJK headers wrap onto more lines, up to half the widget
+	long := strings.Repeat("你好世界", 10)
+	got := w.multiLineHeaderHeight(long, elem, lineHeight)
+	if got <= lineHeight || got > 80/2-2 {
+		t.Errorf("wrapped header height = %d, want more than %d and at most %d", got, lineHeight, 80/2-2)
+	}
+
+	// Rendering a message with a wrapped header works
+	w.renderMessage(image.NewGray(image.Rect(0, 0, 128, 80)), tgclient.MessageInfo{ChatType: tgclient.ChatTypeGroup, ChatTitle: long, SenderName: "Ann", Text: "Hi"})
+}
+
+func TestWidget_EmojiGlyphs(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "telegram",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Auth: &config.TelegramAuthConfig{
+			APIID:       12345,
+			APIHash:     "testhash",
+			PhoneNumber: "+1234567890",
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	elem := w.appearance.Message
+	known, _ := bitmap.SmartMeasureText("👍", elem.FontFace, elem.FontName)
+	unknown, _ := bitmap.SmartMeasureText("🦩", elem.FontFace, elem.FontName)
+	selector, _ := bitmap.SmartMeasureText("👍\uFE0F", elem.FontFace, elem.FontName)
+	if known == 0 || unknown != known || selector != known {
+		t.Errorf("emoji widths = %d (known), %d (unknown), %d (with selector), want equal and non-zero", known, unknown, selector)
+	}
+}
+
+func TestWidget_HeaderTokenStyles(t *testing.T) {
+	newWidget := func(header *config.TelegramElementConfig) (*Widget, error) {
+		return New(config.WidgetConfig{
+			Type:     "telegram",
+			Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+			Auth: &config.TelegramAuthConfig{
+				APIID:       12345,
+				APIHash:     "testhash",
+				PhoneNumber: "+1234567890",
+			},
+			Appearance: &config.TelegramAppearanceConfig{Header: header},
+		})
+	}
+
+	w, err := newWidget(&config.TelegramElementConfig{Text: &config.TextConfig{Format: "{sender|dim}: {chat} {unknown}"}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	header := w.formatHeader(tgclient.MessageInfo{ChatType: tgclient.ChatTypeGroup, ChatTitle: "Team", SenderName: "Ann"})
+	if len(header) != 2 || header[0].Text != "Ann" || header[0].Color != render.StyleDim {
+		t.Fatalf("formatHeader() = %+v, want a dim sender run", header)
+	}
+	if header[1].Text != ": Team {unknown}" || header[1].Color != 255 {
+		t.Errorf("formatHeader() rest = %+v, want the plain rest with the unknown token kept", header[1])
+	}
+
+	// The styled header is drawn in its own gray levels
+	img := image.NewGray(image.Rect(0, 0, 128, 40))
+	w.renderMessage(img, tgclient.MessageInfo{ChatType: tgclient.ChatTypeGroup, ChatTitle: "Team", SenderName: "Ann"})
+	levels := map[uint8]bool{}
+	for _, p := range img.Pix {
+		levels[p] = true
+	}
+	if !levels[render.StyleDim] || !levels[255] {
+		t.Error("rendered header should hold both dim and bright pixels")
+	}
+
+	if _, err := newWidget(&config.TelegramElementConfig{Text: &config.TextConfig{Format: "{sender|font=no-such-font}"}}); err == nil {
+		t.Error("New() should fail for a token style naming an unknown font")
+	}
+	if _, err := newWidget(&config.TelegramElementConfig{MultiLine: true, Text: &config.TextConfig{Format: "{sender|dim}"}}); err == nil {
+		t.Error("New() should reject token styles in a multi-line header")
+	}
+}
+
+func TestContainsKeyword(t *testing.T) {
+	keywords := []string{"ann", "@ann_dev", "release date"}
+
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"Hi Ann!", true},
+		{"ANN, are you there?", true},
+		{"ping @ann_dev please", true},
+		{"The release date moved", true},
+		{"Annual report", false},
+		{"Joann said hi", false},
+		{"ann_x is here", false},
+		{"@ann_developer", false},
+		{"Anna and ann", true},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := containsKeyword(tt.text, keywords); got != tt.want {
+			t.Errorf("containsKeyword(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+
+	if containsKeyword("Hi Ann", nil) {
+		t.Error("containsKeyword() with no keywords = true, want false")
+	}
+}
+
+func TestWidget_Highlight(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "telegram",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Auth: &config.TelegramAuthConfig{
+			APIID:       12345,
+			APIHash:     "testhash",
+			PhoneNumber: "+1234567890",
+		},
+		Appearance: &config.TelegramAppearanceConfig{
+			Header:          &config.TelegramElementConfig{Enabled: config.BoolPtr(false)},
+			Highlight:       []string{" Ann ", ""},
+			HighlightInvert: true,
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if len(w.appearance.Highlight) != 1 || w.appearance.Highlight[0] != "ann" {
+		t.Fatalf("Highlight = %q, want [ann]", w.appearance.Highlight)
+	}
+
+	// Highlighted messages are drawn inverted: mostly lit
+	img := image.NewGray(image.Rect(0, 0, 128, 40))
+	w.renderMessage(img, tgclient.MessageInfo{ChatType: tgclient.ChatTypePrivate, SenderName: "Bob", Text: "Hi Ann"})
+	if lit := countLitPixels(img); lit < len(img.Pix)/2 {
+		t.Errorf("highlighted message lit %d of %d pixels, want an inverted area", lit, len(img.Pix))
+	}
+
+	img = image.NewGray(image.Rect(0, 0, 128, 40))
+	w.renderMessage(img, tgclient.MessageInfo{ChatType: tgclient.ChatTypePrivate, SenderName: "Bob", Text: "Annual report"})
+	if lit := countLitPixels(img); lit == 0 || lit >= len(img.Pix)/2 {
+		t.Errorf("plain message lit %d of %d pixels, want normal text", lit, len(img.Pix))
+	}
+}
+
+func TestWidget_MinDisplay(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "telegram",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Auth: &config.TelegramAuthConfig{
+			APIID:       12345,
+			APIHash:     "testhash",
+			PhoneNumber: "+1234567890",
+		},
+		Appearance: &config.TelegramAppearanceConfig{MinDisplayMs: 1000},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// The first message shows at once, later ones wait; the queue keeps the newest
+	for id := 1; id <= 2+maxPendingMessages; id++ {
+		w.onMessage(tgclient.MessageInfo{ID: id, Text: "msg"})
+	}
+	if w.currentMessage == nil || w.currentMessage.ID != 1 {
+		t.Fatalf("current message = %v, want 1", w.currentMessage)
+	}
+	if len(w.pending) != maxPendingMessages || w.pending[0].ID != 3 {
+		t.Fatalf("pending = %d messages starting at %d, want %d starting at 3", len(w.pending), w.pending[0].ID, maxPendingMessages)
+	}
+
+	// Nothing changes before the minimum display time
+	_ = w.Update()
+	if w.currentMessage.ID != 1 {
+		t.Errorf("current message = %d before the minimum display time, want 1", w.currentMessage.ID)
+	}
+
+	// Afterwards the next waiting message replaces it
+	w.messageStartTime = time.Now().Add(-2 * time.Second)
+	_ = w.Update()
+	if w.currentMessage.ID != 3 || len(w.pending) != maxPendingMessages-1 {
+		t.Errorf("current message = %d with %d pending, want 3 with %d", w.currentMessage.ID, len(w.pending), maxPendingMessages-1)
+	}
+}
+
 func TestWidget_Stop(t *testing.T) {
 	cfg := config.WidgetConfig{
 		Type:     "telegram",
@@ -390,3 +616,14 @@ func TestWidget_AutoHide(t *testing.T) {
 		}
 	})
 }
+
+// countLitPixels counts the non-black pixels of img
+func countLitPixels(img *image.Gray) int {
+	count := 0
+	for _, p := range img.Pix {
+		if p > 0 {
+			count++
+		}
+	}
+	return count
+}
@@ -46,9 +46,12 @@ type Widget struct {
 	gaugeNeedleColor uint8
 	gaugeShowTicks   bool
 	gaugeTicksColor  uint8
+	gaugeTickLabels  bool
+	gaugeLabelStep   int
 	fontName         string
 	horizAlign       config.HAlign
 	vertAlign        config.VAlign
+	orientation      config.TextOrientation
 	padding          int
 	pollInterval     time.Duration // Configurable internal polling rate
 
@@ -109,9 +112,12 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		gaugeNeedleColor: uint8(gaugeSettings.NeedleColor),
 		gaugeShowTicks:   gaugeSettings.ShowTicks,
 		gaugeTicksColor:  uint8(gaugeSettings.TicksColor),
+		gaugeTickLabels:  gaugeSettings.TickLabels,
+		gaugeLabelStep:   gaugeSettings.LabelInterval,
 		fontName:         textSettings.FontName,
 		horizAlign:       textSettings.HorizAlign,
 		vertAlign:        textSettings.VertAlign,
+		orientation:      textSettings.Orientation,
 		padding:          padding,
 		pollInterval:     pollInterval,
 		lastSuccessTime:  time.Now(), // Initialize to prevent false "stuck" detection
@@ -276,6 +282,11 @@ func (w *Widget) Stop() {
 	w.wg.Wait()
 }
 
+// NeedsRender always returns true: volume is polled in the background.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render renders the volume widget
 func (w *Widget) Render() (image.Image, error) {
 	w.mu.RLock()
@@ -331,7 +342,7 @@ func (w *Widget) renderText(img *image.Gray) {
 	}
 
 	// Draw text with configured alignment
-	bitmap.SmartDrawAlignedText(img, text, w.face, w.fontName, w.horizAlign, w.vertAlign, w.padding)
+	bitmap.SmartDrawAlignedTextOriented(img, text, w.face, w.fontName, w.horizAlign, w.vertAlign, w.padding, w.orientation)
 }
 
 // renderBarHorizontal renders volume as horizontal bar
@@ -418,6 +429,9 @@ func (w *Widget) renderBarVertical(img *image.Gray, pos config.PositionConfig, s
 func (w *Widget) renderGauge(img *image.Gray, pos config.PositionConfig) {
 	// Use shared gauge drawing function
 	bitmap.DrawGauge(img, 0, 0, pos.W, pos.H, w.volume, w.gaugeColor, w.gaugeNeedleColor, w.gaugeShowTicks, w.gaugeTicksColor)
+	if w.gaugeTickLabels {
+		bitmap.DrawGaugeTickLabels(img, 0, 0, pos.W, pos.H, w.gaugeLabelStep, w.gaugeTicksColor)
+	}
 
 	// Draw mute indicator
 	if w.isMuted {
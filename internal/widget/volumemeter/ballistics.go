@@ -0,0 +1,52 @@
+package volumemeter
+
+import "math"
+
+// Metering ballistics presets
+const (
+	BallisticsVU          = "vu"
+	BallisticsPPM         = "ppm"
+	BallisticsDigitalPeak = "digital_peak"
+)
+
+// meterBallistics describes how the displayed level follows the measured peak
+type meterBallistics struct {
+	attack    float64 // Rise time constant in seconds (0 = instant)
+	release   float64 // Fall time constant in seconds, for integrating meters
+	releaseDB float64 // Fall rate in dB per second, for peak meters (takes precedence over release)
+	decayRate float64 // Linear fall in normalized units per second (decay_rate override, takes precedence over both)
+}
+
+// ballisticsPresets approximate the standardized meter types. A VU meter
+// integrates over 300 ms in both directions (99% of the reading, so a time
+// constant of 0.3/ln(100) s). An IEC Type II PPM reaches a peak within about
+// 10 ms and falls 24 dB in 2.8 s. A digital peak meter shows peaks instantly
+// and falls 20 dB in 1.7 s (IEC 60268-18).
+var ballisticsPresets = map[string]meterBallistics{
+	BallisticsVU:          {attack: 0.065, release: 0.065},
+	BallisticsPPM:         {attack: 0.01, releaseDB: 24 / 2.8},
+	BallisticsDigitalPeak: {attack: 0, releaseDB: 20 / 1.7},
+}
+
+// follow moves the displayed level toward the measured level over dt seconds.
+// A falling display never drops below the measured level.
+func (b meterBallistics) follow(display, level, dt float64) float64 {
+	if level >= display {
+		if b.attack <= 0 {
+			return level
+		}
+		return display + (level-display)*(1-math.Exp(-dt/b.attack))
+	}
+
+	switch {
+	case b.decayRate > 0:
+		display -= b.decayRate * dt
+	case b.releaseDB > 0:
+		display *= math.Pow(10, -b.releaseDB*dt/20)
+	case b.release > 0:
+		display += (level - display) * (1 - math.Exp(-dt/b.release))
+	default:
+		display = level
+	}
+	return math.Max(display, level)
+}
@@ -0,0 +1,124 @@
+package volumemeter
+
+import (
+	"image"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/widget"
+)
+
+func TestMeterBallistics_Attack(t *testing.T) {
+	vu := ballisticsPresets[BallisticsVU]
+	ppm := ballisticsPresets[BallisticsPPM]
+	digital := ballisticsPresets[BallisticsDigitalPeak]
+
+	// A digital peak meter jumps to the level at once
+	if got := digital.follow(0, 0.8, 0.01); got != 0.8 {
+		t.Errorf("digital_peak attack = %.3f, want 0.8", got)
+	}
+
+	// A VU meter reaches 99% of a step after 300 ms
+	if got := vu.follow(0, 1, 0.3); math.Abs(got-0.99) > 0.005 {
+		t.Errorf("vu after 300ms = %.3f, want ~0.99", got)
+	}
+
+	// After 10 ms a PPM is much closer to the peak than a VU meter
+	if p, v := ppm.follow(0, 1, 0.01), vu.follow(0, 1, 0.01); p <= v {
+		t.Errorf("ppm after 10ms = %.3f, vu = %.3f, want ppm ahead", p, v)
+	}
+}
+
+func TestMeterBallistics_Release(t *testing.T) {
+	tests := []struct {
+		name   string
+		preset string
+		dt     float64
+		want   float64
+	}{
+		{"ppm falls 24 dB in 2.8 s", BallisticsPPM, 2.8, math.Pow(10, -24.0/20)},
+		{"digital peak falls 20 dB in 1.7 s", BallisticsDigitalPeak, 1.7, 0.1},
+		{"vu falls to 1% in 300 ms", BallisticsVU, 0.3, 0.01},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ballisticsPresets[tt.preset].follow(1, 0, tt.dt)
+			if math.Abs(got-tt.want) > 0.002 {
+				t.Errorf("follow() = %.4f, want %.4f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeterBallistics_DecayRateOverride(t *testing.T) {
+	b := ballisticsPresets[BallisticsPPM]
+	b.decayRate = 2.0
+
+	// The override replaces the release with a linear fall but keeps the attack
+	if got := b.follow(1, 0, 0.25); math.Abs(got-0.5) > 1e-9 {
+		t.Errorf("release with decay_rate = %.3f, want 0.5", got)
+	}
+	if got, want := b.follow(0, 1, 0.01), ballisticsPresets[BallisticsPPM].follow(0, 1, 0.01); got != want {
+		t.Errorf("attack with decay_rate = %.3f, want %.3f", got, want)
+	}
+}
+
+func TestMeterBallistics_NeverBelowLevel(t *testing.T) {
+	for name, b := range ballisticsPresets {
+		if got := b.follow(0.9, 0.5, 10); got != 0.5 {
+			t.Errorf("%s: follow() after a long fall = %.3f, want 0.5", name, got)
+		}
+	}
+}
+
+func TestWidget_StereoBallistics(t *testing.T) {
+	vu := ballisticsPresets[BallisticsVU]
+	start := time.Now()
+	w := &Widget{
+		BaseWidget: widget.NewBaseWidget(config.WidgetConfig{
+			Type:     "volume_meter",
+			Position: config.PositionConfig{X: 0, Y: 0, W: 100, H: 20},
+		}),
+		displayMode:    "bar_horizontal",
+		fillColor:      255,
+		stereoMode:     true,
+		stereoDivider:  -1,
+		ballistics:     &vu,
+		lastUpdateTime: start,
+	}
+
+	// A loud left channel rises gradually, the silent right one stays down
+	w.applyMeterData(&MeterData{Peak: 1, ChannelPeaks: []float64{1, 0}, ChannelCount: 2}, start.Add(50*time.Millisecond))
+	if left := w.channelDisplay[0]; left <= 0 || left >= 1 {
+		t.Errorf("left display after 50 ms = %.3f, want a partial rise", left)
+	}
+	if right := w.channelDisplay[1]; right != 0 {
+		t.Errorf("right display = %.3f, want 0", right)
+	}
+	if w.channelDisplay[0] != w.displayPeak {
+		t.Errorf("left display = %.3f, want the mono display %.3f for the same input", w.channelDisplay[0], w.displayPeak)
+	}
+
+	// Once the input stops, the left bar falls gradually instead of vanishing
+	w.applyMeterData(&MeterData{ChannelPeaks: []float64{0, 0}, ChannelCount: 2}, start.Add(100*time.Millisecond))
+	left := w.channelDisplay[0]
+	if left <= 0 {
+		t.Fatalf("left display after release = %.3f, want above 0", left)
+	}
+
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	gray := img.(*image.Gray)
+	width := 0
+	for x := 0; x < 100 && gray.GrayAt(x, 2).Y == 255; x++ {
+		width++
+	}
+	if want := int(100 * left); width != want {
+		t.Errorf("left bar width = %d, want %d from the displayed level", width, want)
+	}
+}
@@ -56,6 +56,8 @@ type Widget struct {
 	gaugeNeedleColor uint8
 	gaugeShowTicks   bool
 	gaugeTicksColor  uint8
+	gaugeTickLabels  bool
+	gaugeLabelStep   int
 	fontName         string
 	horizontalAlign  config.HAlign
 	verticalAlign    config.VAlign
@@ -69,7 +71,8 @@ type Widget struct {
 	showClipping        bool
 	clippingThreshold   float64
 	silenceThreshold    float64
-	decayRate           float64 // normalized units per second (0.0-1.0/s)
+	decayRate           float64          // normalized units per second (0.0-1.0/s)
+	ballistics          *meterBallistics // Standard meter preset (nil = rise and fall driven by decayRate)
 	showPeakHold        bool
 	peakHoldTime        time.Duration
 	autoHideOnSilence   bool
@@ -79,6 +82,7 @@ type Widget struct {
 	peak           float64   // Current overall peak (0.0-1.0)
 	displayPeak    float64   // Peak with decay applied for display
 	channelPeaks   []float64 // Per-channel peaks
+	channelDisplay []float64 // Per-channel peaks with decay applied for display
 	channelCount   int
 	isClipping     bool
 	hasAudio       bool
@@ -191,13 +195,10 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 
 	// Stereo settings (includes divider color between channels)
 	stereoMode := false
-	stereoDivider := 64 // Default: mid-gray divider
+	stereoDivider := helper.GetStereoDivider()
 
 	if cfg.Stereo != nil {
 		stereoMode = cfg.Stereo.Enabled
-		if cfg.Stereo.Divider != nil {
-			stereoDivider = *cfg.Stereo.Divider
-		}
 	}
 
 	// Metering settings
@@ -205,6 +206,8 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	decayRate := 2.0
 	useDBScale := false
 
+	var ballistics *meterBallistics
+
 	if cfg.Metering != nil {
 		useDBScale = cfg.Metering.DBScale
 		if cfg.Metering.DecayRate > 0 {
@@ -213,6 +216,13 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		if cfg.Metering.SilenceThreshold > 0 {
 			silenceThreshold = cfg.Metering.SilenceThreshold
 		}
+		if preset, ok := ballisticsPresets[cfg.Metering.Ballistics]; ok {
+			// An explicit decay_rate still overrides the preset release
+			if cfg.Metering.DecayRate > 0 {
+				preset.decayRate = cfg.Metering.DecayRate
+			}
+			ballistics = &preset
+		}
 	}
 
 	// Peak hold settings
@@ -256,6 +266,8 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		gaugeNeedleColor:    uint8(gaugeSettings.NeedleColor),
 		gaugeShowTicks:      gaugeSettings.ShowTicks,
 		gaugeTicksColor:     uint8(gaugeSettings.TicksColor),
+		gaugeTickLabels:     gaugeSettings.TickLabels,
+		gaugeLabelStep:      gaugeSettings.LabelInterval,
 		fontName:            textSettings.FontName,
 		horizontalAlign:     textSettings.HorizAlign,
 		verticalAlign:       textSettings.VertAlign,
@@ -268,6 +280,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		clippingThreshold:   clippingThreshold,
 		silenceThreshold:    silenceThreshold,
 		decayRate:           decayRate,
+		ballistics:          ballistics,
 		showPeakHold:        showPeakHold,
 		peakHoldTime:        peakHoldTime,
 		autoHideOnSilence:   autoHideOnSilence,
@@ -412,10 +425,20 @@ func (w *Widget) updateMeter() {
 	// Successful read
 	w.successfulCalls++
 	w.consecutiveErrors = 0
-	w.lastSuccessTime = time.Now()
-
-	// Update state
 	now := time.Now()
+	w.lastSuccessTime = now
+
+	w.applyMeterData(data, now)
+
+	// Auto-hide on silence
+	if w.autoHideOnSilence && w.hasAudio {
+		w.TriggerAutoHide()
+	}
+}
+
+// applyMeterData stores a meter reading taken at now and moves the displayed levels
+// toward it. Callers must hold w.mu.
+func (w *Widget) applyMeterData(data *MeterData, now time.Time) {
 	timeDelta := now.Sub(w.lastUpdateTime).Seconds()
 	w.lastUpdateTime = now
 
@@ -425,30 +448,14 @@ func (w *Widget) updateMeter() {
 	w.isClipping = data.IsClipping
 	w.hasAudio = data.HasAudio
 
-	// Apply ballistics to display peak (smooth rise and fall like real VU meter)
-	if w.peak > w.displayPeak {
-		// Rising: apply rise ballistics (faster than decay, but not instant)
-		rise := w.decayRate * 3.0 * timeDelta // Rise 3x faster than fall
-		w.displayPeak += rise
-		if w.displayPeak > w.peak {
-			w.displayPeak = w.peak
-		}
-	} else if w.displayPeak > w.peak {
-		// Falling: apply fall ballistics (decay) - only when displayPeak is above current peak
-		decay := w.decayRate * timeDelta
-		w.displayPeak -= decay
-		if w.displayPeak < w.peak {
-			w.displayPeak = w.peak // Don't fall below current peak
-		}
+	// Apply ballistics to display peaks (smooth rise and fall like real VU meter).
+	// Stereo bars follow their channels with the same ballistics as the mono bar.
+	w.displayPeak = w.followLevel(w.displayPeak, w.peak, timeDelta)
+	if len(w.channelDisplay) != len(w.channelPeaks) {
+		w.channelDisplay = make([]float64, len(w.channelPeaks))
 	}
-	// If w.peak == w.displayPeak, do nothing (already at current level)
-
-	// Clamp to valid range
-	if w.displayPeak < 0 {
-		w.displayPeak = 0
-	}
-	if w.displayPeak > 1.0 {
-		w.displayPeak = 1.0
+	for i, chPeak := range w.channelPeaks {
+		w.channelDisplay[i] = w.followLevel(w.channelDisplay[i], chPeak, timeDelta)
 	}
 
 	// Peak hold (per-channel)
@@ -469,11 +476,30 @@ func (w *Widget) updateMeter() {
 			}
 		}
 	}
+}
 
-	// Auto-hide on silence
-	if w.autoHideOnSilence && w.hasAudio {
-		w.TriggerAutoHide()
+// followLevel moves a displayed level toward the measured level over dt seconds,
+// using the ballistics preset or, without one, decayRate
+func (w *Widget) followLevel(display, level, dt float64) float64 {
+	if w.ballistics != nil {
+		display = w.ballistics.follow(display, level, dt)
+	} else if level > display {
+		// Rising: apply rise ballistics (faster than decay, but not instant)
+		rise := w.decayRate * 3.0 * dt // Rise 3x faster than fall
+		display = math.Min(display+rise, level)
+	} else if display > level {
+		// Falling: apply fall ballistics (decay), never below the current level
+		decay := w.decayRate * dt
+		display = math.Max(display-decay, level)
 	}
+
+	// Clamp to valid range
+	return math.Max(0, math.Min(display, 1.0))
+}
+
+// NeedsRender always returns true: the meter is fed by background polling.
+func (w *Widget) NeedsRender() bool {
+	return true
 }
 
 // Render renders the volume meter widget
@@ -495,8 +521,8 @@ func (w *Widget) Render() (image.Image, error) {
 
 	w.mu.RLock()
 	displayPeak := w.displayPeak // Decayed peak (for main display)
-	channelPeaks := make([]float64, len(w.channelPeaks))
-	copy(channelPeaks, w.channelPeaks)
+	channelPeaks := make([]float64, len(w.channelDisplay))
+	copy(channelPeaks, w.channelDisplay) // Decayed per-channel peaks (for stereo display)
 	peakHoldValues := make([]float64, len(w.peakHoldValues))
 	copy(peakHoldValues, w.peakHoldValues)
 	isClipping := w.isClipping
@@ -659,6 +685,9 @@ func (w *Widget) renderGauge(img *image.Gray, peak, peakHold float64, isClipping
 	// DrawGauge expects percentage as 0-100, not 0.0-1.0
 	percentage := peak * 100.0
 	bitmap.DrawGauge(img, 0, 0, pos.W, pos.H, percentage, w.gaugeColor, needleColor, w.gaugeShowTicks, w.gaugeTicksColor)
+	if w.gaugeTickLabels {
+		bitmap.DrawGaugeTickLabels(img, 0, 0, pos.W, pos.H, w.gaugeLabelStep, w.gaugeTicksColor)
+	}
 
 	// Draw peak hold mark if enabled
 	if w.showPeakHold && peakHold > 0 {
@@ -873,6 +902,9 @@ func (w *Widget) renderGaugeStereo(img *image.Gray, channelPeaks []float64, peak
 		leftNeedleColor = w.clippingColor
 	}
 	bitmap.DrawGauge(leftImg, 0, 0, leftGaugePos.W, leftGaugePos.H, leftPercentage, w.gaugeColor, leftNeedleColor, w.gaugeShowTicks, w.gaugeTicksColor)
+	if w.gaugeTickLabels {
+		bitmap.DrawGaugeTickLabels(leftImg, 0, 0, leftGaugePos.W, leftGaugePos.H, w.gaugeLabelStep, w.gaugeTicksColor)
+	}
 
 	// Draw left channel peak hold mark
 	if w.showPeakHold && len(peakHoldValues) >= 1 && peakHoldValues[0] > 0 {
@@ -900,6 +932,9 @@ func (w *Widget) renderGaugeStereo(img *image.Gray, channelPeaks []float64, peak
 		rightNeedleColor = w.clippingColor
 	}
 	bitmap.DrawGauge(rightImg, 0, 0, rightGaugePos.W, rightGaugePos.H, rightPercentage, w.gaugeColor, rightNeedleColor, w.gaugeShowTicks, w.gaugeTicksColor)
+	if w.gaugeTickLabels {
+		bitmap.DrawGaugeTickLabels(rightImg, 0, 0, rightGaugePos.W, rightGaugePos.H, w.gaugeLabelStep, w.gaugeTicksColor)
+	}
 
 	// Draw right channel peak hold mark
 	if w.showPeakHold && len(peakHoldValues) >= 2 && peakHoldValues[1] > 0 {
@@ -783,3 +783,48 @@ func TestWidget_Ballistics(t *testing.T) {
 	_ = peak1
 	_ = peak2
 }
+
+// TestWidget_BallisticsPreset tests that metering presets are resolved and decay_rate overrides their release
+func TestWidget_BallisticsPreset(t *testing.T) {
+	skipIfNoAudioDeviceMeter(t)
+
+	tests := []struct {
+		name          string
+		metering      *config.MeteringConfig
+		wantPreset    bool
+		wantDecayRate float64
+	}{
+		{"no preset", &config.MeteringConfig{DecayRate: 3.0}, false, 0},
+		{"unknown preset", &config.MeteringConfig{Ballistics: "analog"}, false, 0},
+		{"ppm", &config.MeteringConfig{Ballistics: BallisticsPPM}, true, 0},
+		{"vu with decay_rate", &config.MeteringConfig{Ballistics: BallisticsVU, DecayRate: 1.5}, true, 1.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.WidgetConfig{
+				Type:    "volume_meter",
+				ID:      "test_meter_preset",
+				Enabled: config.BoolPtr(true),
+				Position: config.PositionConfig{
+					X: 0, Y: 0, W: 128, H: 40,
+				},
+				Mode:     "bar_horizontal",
+				Metering: tt.metering,
+			}
+
+			widget, err := New(cfg)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			defer widget.Stop()
+
+			if (widget.ballistics != nil) != tt.wantPreset {
+				t.Fatalf("ballistics set = %v, want %v", widget.ballistics != nil, tt.wantPreset)
+			}
+			if widget.ballistics != nil && widget.ballistics.decayRate != tt.wantDecayRate {
+				t.Errorf("ballistics.decayRate = %.1f, want %.1f", widget.ballistics.decayRate, tt.wantDecayRate)
+			}
+		})
+	}
+}
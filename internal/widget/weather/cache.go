@@ -0,0 +1,136 @@
+package weather
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cacheVersion is bumped whenever the cache file layout changes; older files are ignored
+const cacheVersion = 1
+
+// weatherCache is the on-disk copy of the last successful fetch.
+// NaN values cannot be encoded as JSON, so unreported readings are stored as null.
+type weatherCache struct {
+	Version    int             `json:"version"`
+	Saved      time.Time       `json:"saved"`
+	Weather    cachedWeather   `json:"weather"`
+	Forecast   *ForecastData   `json:"forecast,omitempty"`
+	AirQuality *AirQualityData `json:"air_quality,omitempty"`
+	UVIndex    *UVIndexData    `json:"uv_index,omitempty"`
+}
+
+// cachedWeather mirrors WData with nullable readings
+type cachedWeather struct {
+	Temperature   float64   `json:"temperature"`
+	FeelsLike     *float64  `json:"feels_like"`
+	Condition     string    `json:"condition"`
+	Description   string    `json:"description"`
+	Humidity      int       `json:"humidity"`
+	WindSpeed     *float64  `json:"wind_speed"`
+	WindDirection string    `json:"wind_direction"`
+	Pressure      float64   `json:"pressure"`
+	Visibility    float64   `json:"visibility"`
+	Sunrise       time.Time `json:"sunrise"`
+	Sunset        time.Time `json:"sunset"`
+}
+
+// weatherCachePath returns the cache file for a provider and location, or ""
+// when there is no user cache directory. Units are part of the key because
+// providers return converted values.
+func weatherCachePath(provider string, cfg ProviderConfig) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%s|%s|%.4f|%.4f|%s", provider, cfg.City, cfg.Lat, cfg.Lon, cfg.Units)
+	return filepath.Join(dir, "steelclock", "weather", fmt.Sprintf("%016x.json", h.Sum64()))
+}
+
+// saveWeatherCache writes the fetched data to path, creating its directory
+func saveWeatherCache(path string, saved time.Time, weather *WData, forecast *ForecastData, aqi *AirQualityData, uv *UVIndexData) error {
+	c := weatherCache{
+		Version: cacheVersion,
+		Saved:   saved,
+		Weather: cachedWeather{
+			Temperature:   weather.Temperature,
+			FeelsLike:     nullableFloat(weather.FeelsLike),
+			Condition:     weather.Condition,
+			Description:   weather.Description,
+			Humidity:      weather.Humidity,
+			WindSpeed:     nullableFloat(weather.WindSpeed),
+			WindDirection: weather.WindDirection,
+			Pressure:      weather.Pressure,
+			Visibility:    weather.Visibility,
+			Sunrise:       weather.Sunrise,
+			Sunset:        weather.Sunset,
+		},
+		Forecast:   forecast,
+		AirQuality: aqi,
+		UVIndex:    uv,
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// Write to a temporary file first so a crash never leaves a truncated cache
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// loadWeatherCache reads a cache file written by saveWeatherCache
+func loadWeatherCache(path string) (*weatherCache, *WData, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var c weatherCache
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, nil, err
+	}
+	if c.Version != cacheVersion {
+		return nil, nil, fmt.Errorf("unsupported cache version %d", c.Version)
+	}
+
+	cw := c.Weather
+	weather := &WData{
+		Temperature:   cw.Temperature,
+		FeelsLike:     math.NaN(),
+		Condition:     cw.Condition,
+		Description:   cw.Description,
+		Humidity:      cw.Humidity,
+		WindSpeed:     math.NaN(),
+		WindDirection: cw.WindDirection,
+		Pressure:      cw.Pressure,
+		Visibility:    cw.Visibility,
+		Sunrise:       cw.Sunrise,
+		Sunset:        cw.Sunset,
+	}
+	if cw.FeelsLike != nil {
+		weather.FeelsLike = *cw.FeelsLike
+	}
+	if cw.WindSpeed != nil {
+		weather.WindSpeed = *cw.WindSpeed
+	}
+	return &c, weather, nil
+}
+
+// nullableFloat maps NaN to nil for JSON encoding
+func nullableFloat(v float64) *float64 {
+	if math.IsNaN(v) {
+		return nil
+	}
+	return &v
+}
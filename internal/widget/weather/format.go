@@ -2,9 +2,11 @@ package weather
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
 )
 
@@ -39,7 +41,8 @@ func getWeatherTokenType(name string) render.TokenType {
 }
 
 // getWeatherTokenText returns the text value for a text token
-// units should be "metric" or "imperial"
+// units should be "metric" or "imperial"; temperature, wind and visibility tokens
+// accept a unit modifier parameter (e.g. {temp:f}) that overrides them
 func getWeatherTokenText(t *render.Token, weather *WData, forecast *ForecastData, aqi *AirQualityData, uv *UVIndexData, units string) string {
 	// Guard against nil weather data
 	if weather == nil {
@@ -47,24 +50,38 @@ func getWeatherTokenText(t *render.Token, weather *WData, forecast *ForecastData
 	}
 
 	unit := "C"
-	speedUnit := "m/s"
 	if units == unitsImperial {
 		unit = "F"
-		speedUnit = "mph"
 	}
 
 	switch t.Name {
 	case "temp":
-		return fmt.Sprintf("%.0f%s", weather.Temperature, unit)
+		value, suffix := convertTemperature(weather.Temperature, units, t.Param)
+		return fmt.Sprintf("%.0f%s", value, suffix)
 	case "temp_raw":
-		return fmt.Sprintf("%.0f", weather.Temperature)
+		value, _ := convertTemperature(weather.Temperature, units, t.Param)
+		return fmt.Sprintf("%.0f", value)
 	case "feels_like", "feels":
-		return fmt.Sprintf("%.0f%s", weather.FeelsLike, unit)
+		if math.IsNaN(weather.FeelsLike) {
+			return missingValue
+		}
+		value, suffix := convertTemperature(weather.FeelsLike, units, t.Param)
+		return fmt.Sprintf("%.0f%s", value, suffix)
 	case "humidity":
+		if weather.Humidity < 0 {
+			return missingValue
+		}
 		return fmt.Sprintf("%d%%", weather.Humidity)
-	case "wind":
-		return fmt.Sprintf("%.1f%s", weather.WindSpeed, speedUnit)
+	case "wind", "wind_speed":
+		if math.IsNaN(weather.WindSpeed) {
+			return missingValue
+		}
+		value, suffix := convertSpeed(weather.WindSpeed, units, t.Param)
+		return fmt.Sprintf("%.1f%s", value, suffix)
 	case "wind_dir":
+		if weather.WindDirection == "" {
+			return missingValue
+		}
 		return weather.WindDirection
 	case "pressure":
 		return fmt.Sprintf("%.0fhPa", weather.Pressure)
@@ -72,14 +89,25 @@ func getWeatherTokenText(t *render.Token, weather *WData, forecast *ForecastData
 		return weather.Description
 	case "condition":
 		return getWeatherDescription(weather.Condition)
+	case "city":
+		if weather.City == "" {
+			return missingValue
+		}
+		return weather.City
 	case "visibility":
-		if units == unitsImperial {
-			return fmt.Sprintf("%.1fmi", weather.Visibility/1609.34)
+		if visibilityImperial(units, t.Param) {
+			return fmt.Sprintf("%.1fmi", weather.Visibility/metersPerMile)
 		}
 		return fmt.Sprintf("%.0fkm", weather.Visibility/1000)
 	case "sunrise":
+		if weather.Sunrise.IsZero() {
+			return missingValue
+		}
 		return weather.Sunrise.Format("15:04")
 	case "sunset":
+		if weather.Sunset.IsZero() {
+			return missingValue
+		}
 		return weather.Sunset.Format("15:04")
 	case "daylight":
 		remaining := time.Until(weather.Sunset)
@@ -164,7 +192,7 @@ func getForecastTokenText(t *render.Token, forecast *ForecastData, unit string)
 	case "temp":
 		return fmt.Sprintf("%.0f%s", point.Temperature, unit)
 	case "name":
-		return point.Time.Format("Mon")
+		return i18n.WeekdayShort(point.Time.Weekday())
 	case "time":
 		return point.Time.Format("15:04")
 	case "condition":
@@ -185,3 +213,13 @@ func needsWeatherForecast(formatCycle []string) bool {
 	}
 	return false
 }
+
+// hasForecastScroll checks if any format uses the scrolling forecast token
+func hasForecastScroll(formatCycle []string) bool {
+	for _, f := range formatCycle {
+		if strings.Contains(f, "{forecast:scroll}") {
+			return true
+		}
+	}
+	return false
+}
@@ -30,13 +30,43 @@ func getWeatherDescription(condition string) string {
 	}
 }
 
+// missingValue is shown for data the provider did not report
+const missingValue = "--"
+
 // degreesToDirection converts wind degrees to cardinal direction
 func degreesToDirection(deg float64) string {
 	directions := []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
 	index := int(math.Round(deg/45.0)) % 8
+	if index < 0 {
+		index += 8
+	}
 	return directions[index]
 }
 
+// reportedFloat returns the value of an optional API field, or NaN when it was absent
+func reportedFloat(v *float64) float64 {
+	if v == nil {
+		return math.NaN()
+	}
+	return *v
+}
+
+// reportedHumidity returns the value of an optional humidity field, or -1 when it was absent
+func reportedHumidity(v *int) int {
+	if v == nil {
+		return -1
+	}
+	return *v
+}
+
+// reportedDirection converts optional wind degrees to a compass direction, or "" when absent
+func reportedDirection(deg *float64) string {
+	if deg == nil {
+		return ""
+	}
+	return degreesToDirection(*deg)
+}
+
 // getAQILevel returns AQI level description
 func getAQILevel(aqi int) string {
 	switch {
@@ -55,6 +85,34 @@ func getAQILevel(aqi int) string {
 	}
 }
 
+// AQI brightness per band, from dim for good air to full white for hazardous
+const (
+	aqiBrightnessGood          = 80
+	aqiBrightnessModerate      = 120
+	aqiBrightnessSensitive     = 160
+	aqiBrightnessUnhealthy     = 200
+	aqiBrightnessVeryUnhealthy = 230
+	aqiBrightnessHazardous     = 255
+)
+
+// getAQIBrightness maps an AQI value to a gray level using the same bands as getAQILevel
+func getAQIBrightness(aqi int) uint8 {
+	switch getAQILevel(aqi) {
+	case AQIGood:
+		return aqiBrightnessGood
+	case AQIModerate:
+		return aqiBrightnessModerate
+	case AQIUnhealthySensitive:
+		return aqiBrightnessSensitive
+	case AQIUnhealthy:
+		return aqiBrightnessUnhealthy
+	case AQIVeryUnhealthy:
+		return aqiBrightnessVeryUnhealthy
+	default:
+		return aqiBrightnessHazardous
+	}
+}
+
 // getUVLevel returns UV index level description
 func getUVLevel(index float64) string {
 	switch {
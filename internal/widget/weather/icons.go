@@ -1,6 +1,10 @@
 package weather
 
-import "strings"
+import (
+	"math"
+	"strings"
+	"time"
+)
 
 // getWeatherIconName maps weather condition to icon name
 func getWeatherIconName(condition string) string {
@@ -56,9 +60,44 @@ func getUVIcon(uv *UVIndexData) string {
 	}
 }
 
-// getHumidityIcon returns icon name for humidity level
+// getNightIconName returns the night variant of a condition icon, or "" when it has none
+func getNightIconName(condition string) string {
+	switch condition {
+	case Clear:
+		return "moon"
+	case PartlyCloudy:
+		return "partly_cloudy_night"
+	default:
+		return ""
+	}
+}
+
+// isNight reports whether now falls outside the daylight between sunrise and sunset.
+// Instants are compared, not clock times, so the result does not depend on the time
+// zone, even when the sunset falls after local midnight. Sunrise is moved by whole days
+// to the last one before now, so data fetched on an earlier day still applies.
+// Without sunrise and sunset it is always day.
+func isNight(weather *WData, now time.Time) bool {
+	if weather.Sunrise.IsZero() || weather.Sunset.IsZero() {
+		return false
+	}
+	const day = 24 * time.Hour
+	daylight := weather.Sunset.Sub(weather.Sunrise) % day
+	if daylight < 0 {
+		daylight += day // Sunset of the day before the sunrise
+	}
+	sinceSunrise := now.Sub(weather.Sunrise) % day
+	if sinceSunrise < 0 {
+		sinceSunrise += day
+	}
+	return sinceSunrise >= daylight
+}
+
+// getHumidityIcon returns icon name for humidity level, or "" when it is unknown
 func getHumidityIcon(humidity int) string {
 	switch {
+	case humidity < 0:
+		return ""
 	case humidity < 30:
 		return "humidity_low"
 	case humidity < 60:
@@ -70,8 +109,12 @@ func getHumidityIcon(humidity int) string {
 
 // getWindIcon returns icon name for wind speed level
 // windSpeed is expected in m/s for metric or mph for imperial
-// units should be unitsMetric or unitsImperial
+// units should be unitsMetric or unitsImperial. Unknown speed returns "".
 func getWindIcon(windSpeed float64, units string) string {
+	if math.IsNaN(windSpeed) {
+		return ""
+	}
+
 	// Wind speed thresholds in m/s (convert if imperial)
 	speed := windSpeed
 	if units == unitsImperial {
@@ -90,9 +133,11 @@ func getWindIcon(windSpeed float64, units string) string {
 	}
 }
 
-// getWindDirIcon returns arrow icon name for wind direction
+// getWindDirIcon returns arrow icon name for wind direction, or "" when it is unknown
 func getWindDirIcon(direction string) string {
 	switch strings.ToUpper(direction) {
+	case "":
+		return ""
 	case "N":
 		return "arrow_n"
 	case "NE":
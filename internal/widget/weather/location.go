@@ -0,0 +1,106 @@
+package weather
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// location is one place the widget shows, with its own provider, data and cache
+type location struct {
+	name       string // Shown by the {city} token
+	provider   Provider
+	cachePath  string // Last successful fetch on disk, empty to disable
+	weather    *WData
+	forecast   *ForecastData
+	airQuality *AirQualityData
+	uvIndex    *UVIndexData
+	lastError  string
+	fetchedAt  time.Time // When the shown data was fetched; older than the update interval means stale
+}
+
+// newLocation creates a location with its own provider and cache file
+func newLocation(place config.WeatherLocationConfig, providerName string, cfg ProviderConfig, opts ProviderOptions) (*location, error) {
+	// Only demo data can do without a place
+	if place.City == "" && place.Lat == 0 && place.Lon == 0 && providerName != providerDemo {
+		return nil, fmt.Errorf("location is required: specify either city or lat/lon coordinates")
+	}
+
+	provider, err := newProvider(providerName, cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &location{name: place.Name, provider: provider}
+	if l.name == "" {
+		l.name = place.City
+	}
+	// Demo data is never worth keeping
+	if providerName != providerDemo {
+		l.cachePath = weatherCachePath(providerName, cfg)
+	}
+	return l, nil
+}
+
+// setWeather stores current weather, naming it after the location and showing
+// sunrise and sunset in zone tz
+func (l *location) setWeather(weather *WData, tz *time.Location) {
+	weather.City = l.name
+	if !weather.Sunrise.IsZero() {
+		weather.Sunrise = weather.Sunrise.In(tz)
+	}
+	if !weather.Sunset.IsZero() {
+		weather.Sunset = weather.Sunset.In(tz)
+	}
+	l.weather = weather
+}
+
+// clear drops all data, so the last error is shown instead
+func (l *location) clear() {
+	l.weather, l.forecast, l.airQuality, l.uvIndex = nil, nil, nil, nil
+}
+
+// loadCache restores the last successful fetch from disk unless it is older than maxAge
+func (l *location) loadCache(tz *time.Location, maxAge time.Duration) {
+	if l.cachePath == "" {
+		return
+	}
+	cache, weather, err := loadWeatherCache(l.cachePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Weather: ignoring cache %s: %v", l.cachePath, err)
+		}
+		return
+	}
+	l.fetchedAt = cache.Saved
+	if l.isExpired(time.Now(), maxAge) {
+		return
+	}
+	l.setWeather(weather, tz)
+	l.forecast = cache.Forecast
+	l.airQuality = cache.AirQuality
+	l.uvIndex = cache.UVIndex
+}
+
+// saveCache writes the current data to disk
+func (l *location) saveCache() {
+	if l.cachePath == "" {
+		return
+	}
+	if err := saveWeatherCache(l.cachePath, l.fetchedAt, l.weather, l.forecast, l.airQuality, l.uvIndex); err != nil {
+		log.Printf("Weather: failed to save cache: %v", err)
+	}
+}
+
+// isExpired reports whether the shown data is older than maxAge; 0 never expires
+func (l *location) isExpired(now time.Time, maxAge time.Duration) bool {
+	return maxAge > 0 && now.Sub(l.fetchedAt) > maxAge
+}
+
+// isStale reports whether the shown data missed its refresh because the last fetch failed
+func (l *location) isStale(now time.Time, interval time.Duration) bool {
+	return l.lastError != "" && l.weather != nil && now.Sub(l.fetchedAt) > interval
+}
@@ -1,5 +1,13 @@
 package weather
 
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+)
+
 // Provider WeatherProvider defines the interface for weather data providers
 type Provider interface {
 	// FetchWeather fetches current weather and optionally forecast
@@ -24,3 +32,44 @@ type ProviderConfig struct {
 	ForecastHours int
 	ForecastDays  int
 }
+
+// ProviderOptions holds widget settings a provider may need besides its location
+type ProviderOptions struct {
+	APIKey     string       // Value of the api_key setting
+	HTTPClient *http.Client // Shared client with the widget's request timeout
+}
+
+// ProviderFactory creates a provider for one location. It returns an error
+// when the settings don't suit the provider, e.g. a missing API key.
+type ProviderFactory func(cfg ProviderConfig, opts ProviderOptions) (Provider, error)
+
+// providers holds all registered provider factories, keyed by the provider setting
+var providers = make(map[string]ProviderFactory)
+
+// RegisterProvider registers a provider factory for the given provider name.
+// This should be called from init() functions in provider implementation files.
+func RegisterProvider(name string, factory ProviderFactory) {
+	if _, exists := providers[name]; exists {
+		log.Printf("WARNING: Weather provider '%s' is being re-registered", name)
+	}
+	providers[name] = factory
+}
+
+// RegisteredProviders returns a sorted list of all registered provider names
+func RegisteredProviders() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newProvider creates the named provider for one location
+func newProvider(name string, cfg ProviderConfig, opts ProviderOptions) (Provider, error) {
+	factory, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown weather provider: %s (available: %s)", name, strings.Join(RegisteredProviders(), ", "))
+	}
+	return factory(cfg, opts)
+}
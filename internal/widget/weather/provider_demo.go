@@ -0,0 +1,116 @@
+package weather
+
+import (
+	"math"
+	"time"
+)
+
+// demoConditions is the sequence the demo provider steps through, one per fetch
+var demoConditions = []string{Clear, PartlyCloudy, Cloudy, Rain, Storm, Drizzle, Snow, Fog}
+
+func init() {
+	RegisterProvider(providerDemo, func(cfg ProviderConfig, _ ProviderOptions) (Provider, error) {
+		return NewDemoProvider(cfg), nil
+	})
+}
+
+// DemoProvider implements Provider with synthetic weather for designing layouts offline.
+// Each fetch advances to the next condition so every icon can be previewed.
+type DemoProvider struct {
+	config ProviderConfig
+	step   int
+}
+
+// NewDemoProvider creates a new demo weather provider
+func NewDemoProvider(cfg ProviderConfig) *DemoProvider {
+	return &DemoProvider{config: cfg}
+}
+
+// Name returns the provider name
+func (p *DemoProvider) Name() string {
+	return providerDemo
+}
+
+// temperature converts a Celsius value to the configured units
+func (p *DemoProvider) temperature(celsius float64) float64 {
+	if p.config.Units == unitsImperial {
+		return math.Round(celsius*9/5 + 32)
+	}
+	return celsius
+}
+
+// FetchWeather returns synthetic current weather and forecast
+func (p *DemoProvider) FetchWeather(needForecast bool) (*WData, *ForecastData, error) {
+	step := p.step
+	p.step++
+
+	now := time.Now()
+	condition := demoConditions[step%len(demoConditions)]
+	temp := 12 + 8*math.Sin(float64(step)*0.7)
+	wind := 3.5 + float64(step%5)
+	if p.config.Units == unitsImperial {
+		wind = math.Round(wind * 2.237)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	weatherData := &WData{
+		Temperature:   p.temperature(math.Round(temp)),
+		FeelsLike:     p.temperature(math.Round(temp - 2)),
+		Condition:     condition,
+		Description:   getWeatherDescription(condition),
+		Humidity:      55 + (step*7)%40,
+		WindSpeed:     wind,
+		WindDirection: degreesToDirection(float64(step*45) + 10),
+		Pressure:      1013 + float64(step%9) - 4,
+		Visibility:    10000,
+		Sunrise:       today.Add(6*time.Hour + 42*time.Minute),
+		Sunset:        today.Add(19*time.Hour + 18*time.Minute),
+	}
+
+	if !needForecast {
+		return weatherData, nil, nil
+	}
+
+	forecastData := &ForecastData{}
+	nextHour := now.Truncate(time.Hour).Add(time.Hour)
+	for i := 0; i < p.config.ForecastHours; i++ {
+		cond := demoConditions[(step+i/3)%len(demoConditions)]
+		forecastData.Hourly = append(forecastData.Hourly, ForecastPoint{
+			Time:        nextHour.Add(time.Duration(i) * time.Hour),
+			Temperature: p.temperature(math.Round(temp + 5*math.Sin(float64(i)*math.Pi/12))),
+			Condition:   cond,
+			Description: getWeatherDescription(cond),
+		})
+	}
+	for i := 0; i < p.config.ForecastDays; i++ {
+		cond := demoConditions[(step+i+1)%len(demoConditions)]
+		forecastData.Daily = append(forecastData.Daily, ForecastPoint{
+			Time:        today.AddDate(0, 0, i),
+			Temperature: p.temperature(math.Round(temp + float64(i%3) - 1)),
+			Condition:   cond,
+			Description: getWeatherDescription(cond),
+		})
+	}
+
+	return weatherData, forecastData, nil
+}
+
+// FetchAirQuality returns synthetic air quality data
+func (p *DemoProvider) FetchAirQuality() (*AirQualityData, error) {
+	aqi := 20 + (p.step*23)%160
+	return &AirQualityData{
+		AQI:   aqi,
+		Level: getAQILevel(aqi),
+		PM25:  float64(aqi) / 4,
+		PM10:  float64(aqi) / 2,
+	}, nil
+}
+
+// FetchUVIndex returns synthetic UV index data
+func (p *DemoProvider) FetchUVIndex() (*UVIndexData, error) {
+	index := float64(1 + p.step%11)
+	return &UVIndexData{
+		Index: index,
+		Level: getUVLevel(index),
+	}, nil
+}
@@ -9,6 +9,15 @@ import (
 	"time"
 )
 
+func init() {
+	RegisterProvider(providerOpenMeteo, func(cfg ProviderConfig, opts ProviderOptions) (Provider, error) {
+		if cfg.City != "" && cfg.Lat == 0 && cfg.Lon == 0 {
+			return nil, fmt.Errorf("open-meteo provider requires lat/lon coordinates; city name is only supported with openweathermap")
+		}
+		return NewOpenMeteoProvider(cfg, opts.HTTPClient), nil
+	})
+}
+
 // OpenMeteoProvider implements WeatherProvider for Open-Meteo API
 type OpenMeteoProvider struct {
 	config     ProviderConfig
@@ -34,7 +43,7 @@ func (p *OpenMeteoProvider) FetchWeather(needForecast bool) (*WData, *ForecastDa
 	params := url.Values{}
 	params.Set("latitude", fmt.Sprintf("%f", p.config.Lat))
 	params.Set("longitude", fmt.Sprintf("%f", p.config.Lon))
-	params.Set("current", "temperature_2m,relative_humidity_2m,weather_code,wind_speed_10m,wind_direction_10m,surface_pressure,visibility")
+	params.Set("current", "temperature_2m,apparent_temperature,relative_humidity_2m,weather_code,wind_speed_10m,wind_direction_10m,surface_pressure,visibility")
 	params.Set("daily", "sunrise,sunset")
 	params.Set("timezone", "auto")
 
@@ -47,6 +56,9 @@ func (p *OpenMeteoProvider) FetchWeather(needForecast bool) (*WData, *ForecastDa
 	if p.config.Units == unitsImperial {
 		params.Set("temperature_unit", "fahrenheit")
 		params.Set("wind_speed_unit", "mph")
+	} else {
+		// Open-Meteo defaults to km/h; token unit conversion expects m/s for metric
+		params.Set("wind_speed_unit", "ms")
 	}
 
 	resp, err := p.httpClient.Get(baseURL + "?" + params.Encode())
@@ -61,14 +73,16 @@ func (p *OpenMeteoProvider) FetchWeather(needForecast bool) (*WData, *ForecastDa
 	}
 
 	var result struct {
-		Current struct {
-			Temperature      float64 `json:"temperature_2m"`
-			RelativeHumidity int     `json:"relative_humidity_2m"`
-			WeatherCode      int     `json:"weather_code"`
-			WindSpeed        float64 `json:"wind_speed_10m"`
-			WindDirection    float64 `json:"wind_direction_10m"`
-			Pressure         float64 `json:"surface_pressure"`
-			Visibility       float64 `json:"visibility"`
+		UTCOffset int `json:"utc_offset_seconds"`
+		Current   struct {
+			Temperature         float64  `json:"temperature_2m"`
+			ApparentTemperature *float64 `json:"apparent_temperature"`
+			RelativeHumidity    *int     `json:"relative_humidity_2m"`
+			WeatherCode         int      `json:"weather_code"`
+			WindSpeed           *float64 `json:"wind_speed_10m"`
+			WindDirection       *float64 `json:"wind_direction_10m"`
+			Pressure            float64  `json:"surface_pressure"`
+			Visibility          float64  `json:"visibility"`
 		} `json:"current"`
 		Hourly struct {
 			Time        []string  `json:"time"`
@@ -90,23 +104,24 @@ func (p *OpenMeteoProvider) FetchWeather(needForecast bool) (*WData, *ForecastDa
 
 	condition := mapOpenMeteoWeatherCode(result.Current.WeatherCode)
 
-	// Parse sunrise/sunset
+	// Parse sunrise/sunset, given in the location's local time
+	zone := time.FixedZone("", result.UTCOffset)
 	var sunrise, sunset time.Time
 	if len(result.Daily.Sunrise) > 0 {
-		sunrise, _ = time.Parse("2006-01-02T15:04", result.Daily.Sunrise[0])
+		sunrise, _ = time.ParseInLocation("2006-01-02T15:04", result.Daily.Sunrise[0], zone)
 	}
 	if len(result.Daily.Sunset) > 0 {
-		sunset, _ = time.Parse("2006-01-02T15:04", result.Daily.Sunset[0])
+		sunset, _ = time.ParseInLocation("2006-01-02T15:04", result.Daily.Sunset[0], zone)
 	}
 
 	weatherData := &WData{
 		Temperature:   result.Current.Temperature,
-		FeelsLike:     result.Current.Temperature, // Open-Meteo doesn't provide feels_like in free tier
+		FeelsLike:     reportedFloat(result.Current.ApparentTemperature),
 		Condition:     condition,
 		Description:   getWeatherDescription(condition),
-		Humidity:      result.Current.RelativeHumidity,
-		WindSpeed:     result.Current.WindSpeed,
-		WindDirection: degreesToDirection(result.Current.WindDirection),
+		Humidity:      reportedHumidity(result.Current.RelativeHumidity),
+		WindSpeed:     reportedFloat(result.Current.WindSpeed),
+		WindDirection: reportedDirection(result.Current.WindDirection),
 		Pressure:      result.Current.Pressure,
 		Visibility:    result.Current.Visibility,
 		Sunrise:       sunrise,
@@ -9,6 +9,15 @@ import (
 	"time"
 )
 
+func init() {
+	RegisterProvider(providerOpenWeatherMap, func(cfg ProviderConfig, opts ProviderOptions) (Provider, error) {
+		if opts.APIKey == "" {
+			return nil, fmt.Errorf("api_key is required for OpenWeatherMap provider")
+		}
+		return NewOpenWeatherMapProvider(cfg, opts.APIKey, opts.HTTPClient), nil
+	})
+}
+
 // OpenWeatherMapProvider implements WeatherProvider for OpenWeatherMap API
 type OpenWeatherMapProvider struct {
 	config     ProviderConfig
@@ -57,18 +66,18 @@ func (p *OpenWeatherMapProvider) FetchWeather(needForecast bool) (*WData, *Forec
 
 	var result struct {
 		Main struct {
-			Temp      float64 `json:"temp"`
-			FeelsLike float64 `json:"feels_like"`
-			Humidity  int     `json:"humidity"`
-			Pressure  float64 `json:"pressure"`
+			Temp      float64  `json:"temp"`
+			FeelsLike *float64 `json:"feels_like"`
+			Humidity  *int     `json:"humidity"`
+			Pressure  float64  `json:"pressure"`
 		} `json:"main"`
 		Weather []struct {
 			ID          int    `json:"id"`
 			Description string `json:"description"`
 		} `json:"weather"`
 		Wind struct {
-			Speed float64 `json:"speed"`
-			Deg   float64 `json:"deg"`
+			Speed *float64 `json:"speed"`
+			Deg   *float64 `json:"deg"`
 		} `json:"wind"`
 		Visibility int `json:"visibility"`
 		Sys        struct {
@@ -90,12 +99,12 @@ func (p *OpenWeatherMapProvider) FetchWeather(needForecast bool) (*WData, *Forec
 
 	weatherData := &WData{
 		Temperature:   result.Main.Temp,
-		FeelsLike:     result.Main.FeelsLike,
+		FeelsLike:     reportedFloat(result.Main.FeelsLike),
 		Condition:     condition,
 		Description:   description,
-		Humidity:      result.Main.Humidity,
-		WindSpeed:     result.Wind.Speed,
-		WindDirection: degreesToDirection(result.Wind.Deg),
+		Humidity:      reportedHumidity(result.Main.Humidity),
+		WindSpeed:     reportedFloat(result.Wind.Speed),
+		WindDirection: reportedDirection(result.Wind.Deg),
 		Pressure:      result.Main.Pressure,
 		Visibility:    float64(result.Visibility),
 		Sunrise:       time.Unix(result.Sys.Sunrise, 0),
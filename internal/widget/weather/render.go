@@ -10,7 +10,9 @@ import (
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	"golang.org/x/image/font"
 )
 
 // renderTokens renders all tokens to the image
@@ -215,7 +217,8 @@ func (w *Widget) measureToken(t *render.Token, weather *WData, forecast *Forecas
 		return w.getIconSize(t)
 	case render.TokenText:
 		text := getWeatherTokenText(t, weather, forecast, aqi, uv, w.units)
-		width, _ := bitmap.SmartMeasureText(text, w.fontFace, w.fontName)
+		face, name := w.tokenFont(t)
+		width, _ := bitmap.SmartMeasureText(text, face, name)
 		return width
 	case TokenLarge:
 		return 0 // Large tokens are measured separately
@@ -254,8 +257,9 @@ func (w *Widget) renderTokenInRectWithAlign(img *image.Gray, t *render.Token, x,
 
 	case render.TokenText:
 		text := getWeatherTokenText(t, weather, forecast, aqi, uv, w.units)
-		width, _ := bitmap.SmartMeasureText(text, w.fontFace, w.fontName)
-		bitmap.SmartDrawTextInRect(img, text, w.fontFace, w.fontName, x, y, width+10, height, config.AlignLeft, vAlign, 0)
+		face, name := w.tokenFont(t)
+		width, _ := bitmap.SmartMeasureText(text, face, name)
+		bitmap.SmartDrawTextInRectWithColor(img, text, face, name, x, y, width+10, height, config.AlignLeft, vAlign, 0, w.tokenColor(t, aqi))
 		return width
 
 	case TokenLarge:
@@ -265,6 +269,25 @@ func (w *Widget) renderTokenInRectWithAlign(img *image.Gray, t *render.Token, x,
 	return 0
 }
 
+// tokenColor returns the gray level of a text token. With aqi_color the {aqi} value
+// is drawn in the brightness of its band, so poor air stands out; a token style
+// such as {aqi|bright} overrides both.
+func (w *Widget) tokenColor(t *render.Token, aqi *AirQualityData) uint8 {
+	if w.aqiColor && t.Name == "aqi" && aqi != nil {
+		return t.Style.TextColor(getAQIBrightness(aqi.AQI))
+	}
+	return t.Style.TextColor(255)
+}
+
+// tokenFont returns the face and font name for a text token: the font from its
+// style, e.g. {temp|font=pixel5x7}, or the widget font
+func (w *Widget) tokenFont(t *render.Token) (font.Face, string) {
+	if face, ok := w.styleFonts[t.Style.Font]; ok {
+		return face, t.Style.Font
+	}
+	return w.fontFace, w.fontName
+}
+
 // renderIconTokenWithAlign renders an icon token with explicit vertical alignment
 func (w *Widget) renderIconTokenWithAlign(img *image.Gray, t *render.Token, x, y, height int, vAlign config.VAlign, weather *WData, forecast *ForecastData, aqi *AirQualityData, uv *UVIndexData) int {
 	iconSize := w.getIconSize(t)
@@ -281,6 +304,12 @@ func (w *Widget) renderIconTokenWithAlign(img *image.Gray, t *render.Token, x, y
 	case "icon":
 		if weather != nil {
 			iconName = getWeatherIconName(weather.Condition)
+			// Use the night variant when the icon set has one
+			if isNight(weather, time.Now()) {
+				if night := getNightIconName(weather.Condition); glyphs.GetIcon(iconSet, night) != nil {
+					iconName = night
+				}
+			}
 		} else {
 			iconName = "sun" // default fallback
 		}
@@ -322,7 +351,7 @@ func (w *Widget) renderIconTokenWithAlign(img *image.Gray, t *render.Token, x, y
 		default: // center
 			iconY = y + (height-icon.Height)/2
 		}
-		glyphs.DrawGlyph(img, icon, x, iconY, color.Gray{Y: 255})
+		glyphs.DrawGlyph(img, icon, x, iconY, color.Gray{Y: t.Style.TextColor(255)})
 		return icon.Width
 	}
 
@@ -522,9 +551,9 @@ func (w *Widget) renderForecastScroll(img *image.Gray, x, y, width, height int,
 	// Build scrolling text
 	var text string
 	if weather != nil {
-		text = fmt.Sprintf("Now: %.0f%s %s", weather.Temperature, unit, weather.Description)
+		text = fmt.Sprintf("%s: %.0f%s %s", i18n.T(i18n.Now), weather.Temperature, unit, weather.Description)
 	} else {
-		text = "No data"
+		text = i18n.T(i18n.NoData)
 	}
 
 	if forecast != nil {
@@ -536,7 +565,7 @@ func (w *Widget) renderForecastScroll(img *image.Gray, x, y, width, height int,
 
 		// Add daily forecast
 		for _, day := range forecast.Daily {
-			text += fmt.Sprintf(" | %s: %.0f%s %s", day.Time.Format("Mon"), day.Temperature, unit, getWeatherDescription(day.Condition))
+			text += fmt.Sprintf(" | %s: %.0f%s %s", i18n.WeekdayShort(day.Time.Weekday()), day.Temperature, unit, getWeatherDescription(day.Condition))
 		}
 	}
 
@@ -552,3 +581,27 @@ func (w *Widget) renderForecastScroll(img *image.Gray, x, y, width, height int,
 		bitmap.SmartDrawTextInRect(img, text, w.fontFace, w.fontName, drawX+textWidth, y, textWidth, height, config.AlignLeft, config.AlignMiddle, 0)
 	}
 }
+
+// staleMarker is a small asterisk drawn in the top-right corner over cached data,
+// one pixel in from the edges to stay clear of the border
+var staleMarker = [3]string{
+	"x.x",
+	".x.",
+	"x.x",
+}
+
+// staleMarkerColor keeps the marker dim so it doesn't compete with the readings
+const staleMarkerColor = 128
+
+// drawStaleMarker marks the image as showing data from a failed refresh
+func drawStaleMarker(img *image.Gray) {
+	b := img.Bounds()
+	left := b.Max.X - len(staleMarker[0]) - 1
+	for y, row := range staleMarker {
+		for x, c := range row {
+			if c == 'x' {
+				img.SetGray(left+x, b.Min.Y+1+y, color.Gray{Y: staleMarkerColor})
+			}
+		}
+	}
+}
@@ -43,16 +43,17 @@ const TokenLarge = render.TokenCustomBase
 // WData holds the current weather information
 type WData struct {
 	Temperature   float64
-	FeelsLike     float64
-	Condition     string // One of the Weather* constants
-	Description   string // Human-readable description
-	Humidity      int
-	WindSpeed     float64
-	WindDirection string
+	FeelsLike     float64 // NaN when the provider did not report it
+	Condition     string  // One of the Weather* constants
+	Description   string  // Human-readable description
+	Humidity      int     // Percent, -1 when not reported
+	WindSpeed     float64 // NaN when not reported
+	WindDirection string  // 8-point compass direction, empty when not reported
 	Pressure      float64
 	Visibility    float64
 	Sunrise       time.Time
 	Sunset        time.Time
+	City          string // Location name for the {city} token, set by the widget
 }
 
 // AirQualityData holds air quality information
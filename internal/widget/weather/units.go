@@ -0,0 +1,95 @@
+package weather
+
+import "strings"
+
+// Unit modifiers accepted as token parameters, e.g. {temp:c} or {wind:mph}.
+// A token without a modifier uses the widget's units setting.
+const (
+	unitCelsius         = "c"
+	unitFahrenheit      = "f"
+	unitMetersPerSecond = "ms"
+	unitKmPerHour       = "kmh"
+	unitMilesPerHour    = "mph"
+	unitKnots           = "kn"
+	unitKilometers      = "km"
+	unitMiles           = "mi"
+)
+
+// Conversion factors
+const (
+	mphPerMeterPerSecond   = 2.23694
+	kmhPerMeterPerSecond   = 3.6
+	knotsPerMeterPerSecond = 1.94384
+	metersPerMile          = 1609.34
+)
+
+// normalizeUnitModifier lowercases a token unit modifier and maps common spellings
+// ("m/s", "km/h", "kt") to their canonical form
+func normalizeUnitModifier(modifier string) string {
+	modifier = strings.ToLower(strings.TrimSpace(modifier))
+	switch modifier {
+	case "m/s":
+		return unitMetersPerSecond
+	case "km/h", "kph":
+		return unitKmPerHour
+	case "kt", "kts", "knots":
+		return unitKnots
+	}
+	return modifier
+}
+
+// convertTemperature converts a temperature reported in the widget units to the unit
+// requested by the modifier. Returns the value and its display suffix.
+func convertTemperature(value float64, units, modifier string) (float64, string) {
+	celsius := value
+	if units == unitsImperial {
+		celsius = (value - 32) * 5 / 9
+	}
+
+	switch normalizeUnitModifier(modifier) {
+	case unitCelsius:
+		return celsius, "C"
+	case unitFahrenheit:
+		return celsius*9/5 + 32, "F"
+	}
+	if units == unitsImperial {
+		return value, "F"
+	}
+	return value, "C"
+}
+
+// convertSpeed converts a wind speed reported in the widget units (m/s for metric,
+// mph for imperial) to the unit requested by the modifier. Returns the value and its suffix.
+func convertSpeed(value float64, units, modifier string) (float64, string) {
+	mps := value
+	if units == unitsImperial {
+		mps = value / mphPerMeterPerSecond
+	}
+
+	switch normalizeUnitModifier(modifier) {
+	case unitMetersPerSecond:
+		return mps, "m/s"
+	case unitKmPerHour:
+		return mps * kmhPerMeterPerSecond, "km/h"
+	case unitMilesPerHour:
+		return mps * mphPerMeterPerSecond, "mph"
+	case unitKnots:
+		return mps * knotsPerMeterPerSecond, "kn"
+	}
+	if units == unitsImperial {
+		return value, "mph"
+	}
+	return value, "m/s"
+}
+
+// visibilityImperial reports whether visibility should be shown in miles
+// for the given widget units and modifier
+func visibilityImperial(units, modifier string) bool {
+	switch normalizeUnitModifier(modifier) {
+	case unitKilometers:
+		return false
+	case unitMiles:
+		return true
+	}
+	return units == unitsImperial
+}
@@ -28,6 +28,7 @@ func init() {
 const (
 	providerOpenWeatherMap = "openweathermap"
 	providerOpenMeteo      = "open-meteo"
+	providerDemo           = "demo" // Selected by the widget-level demo flag
 )
 
 // Weather unit constants
@@ -40,16 +41,19 @@ const (
 type Widget struct {
 	*widget.BaseWidget
 	// Configuration
-	weatherProvider Provider
-	units           string
-	iconSize        int
-	formatCycle     []string // Format strings (single or multiple for cycling)
-	cycleInterval   int
-	forecastHours   int // Used for rendering forecasts
-	forecastDays    int // Used for rendering forecasts
-	scrollSpeed     float64
-	aqiEnabled      bool
-	uvEnabled       bool
+	locations     []*location // Places to cycle through, each with its own provider and data
+	units         string
+	iconSize      int
+	loc           *time.Location // Zone for sunrise and sunset times
+	formatCycle   []string       // Format strings (single or multiple for cycling)
+	cycleInterval int
+	forecastHours int // Used for rendering forecasts
+	forecastDays  int // Used for rendering forecasts
+	scrollSpeed   float64
+	animated      bool // Cycles formats or scrolls the forecast between updates
+	aqiEnabled    bool
+	aqiColor      bool // Draw {aqi} in the brightness of its band
+	uvEnabled     bool
 	// Transition configuration
 	transitionType  string
 	transitionSpeed float64
@@ -60,23 +64,20 @@ type Widget struct {
 	vertAlign  config.VAlign
 	padding    int
 	fontFace   font.Face
+	styleFonts map[string]font.Face // Fonts named by token styles, at the widget font size
 	// Parsed tokens (cached)
 	tokens        []render.Token
-	currentFormat int // Index into formatCycle
+	currentView   int // Index into the views, see viewFormat and viewLocation
 	lastCycleTime time.Time
 	// Transition state
-	transition    *anim.TransitionManager
-	pendingFormat int // Format index to transition to
+	transition  *anim.TransitionManager
+	pendingView int // View index to transition to
 	// Scroll state
 	scrollOffset float64
 	lastUpdate   time.Time
-	// Weather data
-	weather    *WData
-	forecast   *ForecastData
-	airQuality *AirQualityData
-	uvIndex    *UVIndexData
-	lastError  string
-	mu         sync.RWMutex
+	// Offline cache
+	cacheMaxAge time.Duration // Stale data older than this is dropped, 0 keeps it indefinitely
+	mu          sync.RWMutex
 }
 
 // New creates a new weather widget
@@ -91,30 +92,32 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	// Weather-specific settings with defaults
 	providerName := providerOpenMeteo
 	apiKey := ""
-	city := ""
-	lat := 0.0
-	lon := 0.0
+	var places []config.WeatherLocationConfig
 	units := unitsMetric
 	iconSize := 16
+	timezone := ""
 	formatCycle := []string{"{icon} {temp}"}
 	cycleInterval := 10
 	transitionType := "none"
 	transitionSpeed := 0.5
+	var transitionEasing anim.Easing
 	forecastHours := 24
 	forecastDays := 3
 	scrollSpeed := 30.0
 	aqiEnabled := false
+	aqiColor := false
 	uvEnabled := false
+	cacheMaxAge := 0
 
 	if cfg.Weather != nil {
 		if cfg.Weather.Provider != "" {
 			providerName = cfg.Weather.Provider
 		}
 		apiKey = cfg.Weather.ApiKey
-		if cfg.Weather.Location != nil {
-			city = cfg.Weather.Location.City
-			lat = cfg.Weather.Location.Lat
-			lon = cfg.Weather.Location.Lon
+		if len(cfg.Weather.Locations) > 0 {
+			places = cfg.Weather.Locations
+		} else if cfg.Weather.Location != nil {
+			places = []config.WeatherLocationConfig{*cfg.Weather.Location}
 		}
 		if cfg.Weather.Units != "" {
 			units = cfg.Weather.Units
@@ -122,6 +125,11 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		if cfg.Weather.IconSize > 0 {
 			iconSize = cfg.Weather.IconSize
 		}
+		timezone = cfg.Weather.Timezone
+		aqiColor = cfg.Weather.AqiColor
+		if cfg.Weather.CacheMaxAgeMin > 0 {
+			cacheMaxAge = cfg.Weather.CacheMaxAgeMin
+		}
 		if len(cfg.Weather.Format) > 0 {
 			formatCycle = cfg.Weather.Format
 		}
@@ -136,6 +144,7 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 			if cfg.Weather.Cycle.Speed > 0 {
 				transitionSpeed = cfg.Weather.Cycle.Speed
 			}
+			transitionEasing = anim.Easing(cfg.Weather.Cycle.Easing)
 		}
 
 		// New forecast config
@@ -163,21 +172,37 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		}
 	}
 
-	// Validate configuration
-	if providerName == providerOpenWeatherMap && apiKey == "" {
-		return nil, fmt.Errorf("api_key is required for OpenWeatherMap provider")
+	// Demo mode needs neither network access nor a location
+	if cfg.Demo {
+		providerName = providerDemo
 	}
 
-	// Location validation
-	hasCity := city != ""
-	hasCoords := lat != 0 || lon != 0
-	if !hasCity && !hasCoords {
-		return nil, fmt.Errorf("location is required: specify either city or lat/lon coordinates")
+	// Create HTTP client
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
 	}
 
-	// Open-Meteo requires coordinates
-	if providerName == providerOpenMeteo && hasCity && !hasCoords {
-		return nil, fmt.Errorf("open-meteo provider requires lat/lon coordinates; city name is only supported with openweathermap")
+	// Create a provider for each location, so every place is fetched and cached on its own
+	if len(places) == 0 {
+		places = []config.WeatherLocationConfig{{}}
+	}
+	locations := make([]*location, 0, len(places))
+	for i, place := range places {
+		l, err := newLocation(place, providerName, ProviderConfig{
+			City:          place.City,
+			Lat:           place.Lat,
+			Lon:           place.Lon,
+			Units:         units,
+			ForecastHours: forecastHours,
+			ForecastDays:  forecastDays,
+		}, ProviderOptions{APIKey: apiKey, HTTPClient: httpClient})
+		if err != nil {
+			if len(places) > 1 {
+				return nil, fmt.Errorf("locations[%d]: %w", i, err)
+			}
+			return nil, err
+		}
+		locations = append(locations, l)
 	}
 
 	// Font settings
@@ -193,6 +218,16 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		return nil, fmt.Errorf("failed to load font: %w", err)
 	}
 
+	// Load fonts named by token styles, e.g. {temp|font=pixel5x7}
+	var styleTokens []render.Token
+	for _, f := range formatCycle {
+		styleTokens = append(styleTokens, parseWeatherFormat(f)...)
+	}
+	styleFonts, err := render.LoadStyleFonts(styleTokens, fontSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load font: %w", err)
+	}
+
 	// Auto-detect if AQI/UV tokens are used in any format
 	for _, f := range formatCycle {
 		if strings.Contains(f, "{aqi") {
@@ -203,39 +238,13 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		}
 	}
 
-	// Create HTTP client
-	httpClient := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	// Create provider config
-	providerCfg := ProviderConfig{
-		City:          city,
-		Lat:           lat,
-		Lon:           lon,
-		Units:         units,
-		ForecastHours: forecastHours,
-		ForecastDays:  forecastDays,
-	}
-
-	// Create the weather provider
-	var weatherProvider Provider
-	switch providerName {
-	case providerOpenWeatherMap:
-		weatherProvider = NewOpenWeatherMapProvider(providerCfg, apiKey, httpClient)
-	case providerOpenMeteo:
-		weatherProvider = NewOpenMeteoProvider(providerCfg, httpClient)
-	default:
-		_ = fontFace.Close() // Clean up loaded font
-		return nil, fmt.Errorf("unknown weather provider: %s", providerName)
-	}
-
 	pos := base.GetPosition()
 	w := &Widget{
 		BaseWidget:      base,
-		weatherProvider: weatherProvider,
+		locations:       locations,
 		units:           units,
 		iconSize:        iconSize,
+		loc:             loadTimezone(timezone),
 		formatCycle:     formatCycle,
 		cycleInterval:   cycleInterval,
 		forecastHours:   forecastHours,
@@ -243,61 +252,127 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		transitionType:  transitionType,
 		transitionSpeed: transitionSpeed,
 		scrollSpeed:     scrollSpeed,
+		animated:        (len(formatCycle)*len(locations) > 1 && cycleInterval > 0) || hasForecastScroll(formatCycle),
 		aqiEnabled:      aqiEnabled,
+		aqiColor:        aqiColor,
 		uvEnabled:       uvEnabled,
+		cacheMaxAge:     time.Duration(cacheMaxAge) * time.Minute,
 		fontSize:        fontSize,
 		fontName:        fontName,
 		horizAlign:      textSettings.HorizAlign,
 		vertAlign:       textSettings.VertAlign,
 		padding:         padding,
 		fontFace:        fontFace,
+		styleFonts:      styleFonts,
 		lastCycleTime:   time.Now(),
 		lastUpdate:      time.Now(),
 		transition:      anim.NewTransitionManager(pos.W, pos.H),
 	}
 
+	w.transition.SetEasing(transitionEasing)
+
 	// Parse initial format (first format in cycle)
 	w.tokens = parseWeatherFormat(formatCycle[0])
 
 	return w, nil
 }
 
-// Update fetches fresh weather data from the API
+// loadTimezone resolves an IANA zone name such as "Europe/Berlin".
+// An empty name means local time; a zone that fails to load falls back to local time.
+func loadTimezone(name string) *time.Location {
+	if name == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		log.Printf("Weather: unknown timezone %q, using local time: %v", name, err)
+		return time.Local
+	}
+	return loc
+}
+
+// Update fetches fresh weather data for every location
 func (w *Widget) Update() error {
 	// Check if we need forecast data
 	needForecast := needsWeatherForecast(w.formatCycle)
 
+	for _, l := range w.locations {
+		w.updateLocation(l, needForecast)
+	}
+
+	return nil // Errors are shown per location; don't return them to keep the widget running
+}
+
+// updateLocation fetches fresh data from the location's provider
+func (w *Widget) updateLocation(l *location, needForecast bool) {
 	// Fetch weather and forecast from provider
-	weather, forecast, err := w.weatherProvider.FetchWeather(needForecast)
+	weather, forecast, err := l.provider.FetchWeather(needForecast)
 
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
 	if err != nil {
-		w.lastError = err.Error()
-		log.Printf("Weather update error: %v", err)
-		return nil // Don't return error to keep widget running
+		l.lastError = err.Error()
+		if l.name != "" {
+			log.Printf("Weather update error for %s: %v", l.name, err)
+		} else {
+			log.Printf("Weather update error: %v", err)
+		}
+		// Fall back to the last successful fetch, from disk after a restart
+		if l.weather == nil {
+			l.loadCache(w.loc, w.cacheMaxAge)
+		}
+		if l.weather != nil && l.isExpired(time.Now(), w.cacheMaxAge) {
+			l.clear()
+		}
+		return
 	}
 
-	w.weather = weather
-	w.forecast = forecast
-	w.lastError = ""
+	l.setWeather(weather, w.loc)
+	l.forecast = forecast
+	l.lastError = ""
+	l.fetchedAt = time.Now()
 
 	// Fetch AQI if enabled
 	if w.aqiEnabled {
-		if aqi, err := w.weatherProvider.FetchAirQuality(); err == nil && aqi != nil {
-			w.airQuality = aqi
+		if aqi, err := l.provider.FetchAirQuality(); err == nil && aqi != nil {
+			l.airQuality = aqi
 		}
 	}
 
 	// Fetch UV if enabled
 	if w.uvEnabled {
-		if uv, err := w.weatherProvider.FetchUVIndex(); err == nil && uv != nil {
-			w.uvIndex = uv
+		if uv, err := l.provider.FetchUVIndex(); err == nil && uv != nil {
+			l.uvIndex = uv
 		}
 	}
 
-	return nil
+	l.saveCache()
+}
+
+// viewCount is the number of views the widget cycles through: every format for every location
+func (w *Widget) viewCount() int {
+	return len(w.formatCycle) * len(w.locations)
+}
+
+// viewFormat returns the format string shown by a view
+func (w *Widget) viewFormat(view int) string {
+	return w.formatCycle[view%len(w.formatCycle)]
+}
+
+// viewLocation returns the location shown by a view. All formats of a location
+// are shown before moving on to the next one.
+func (w *Widget) viewLocation(view int) *location {
+	return w.locations[view/len(w.formatCycle)]
+}
+
+// NeedsRender renders every frame while formats cycle or the forecast scrolls;
+// static layouts are redrawn once per update.
+func (w *Widget) NeedsRender() bool {
+	if w.animated {
+		return true
+	}
+	return w.BaseWidget.NeedsRender()
 }
 
 // Render creates the weather widget image
@@ -316,69 +391,78 @@ func (w *Widget) Render() (image.Image, error) {
 	if w.transition.IsActive() {
 		if !w.transition.Update() {
 			// Transition complete
-			w.currentFormat = w.pendingFormat
-			w.tokens = parseWeatherFormat(w.formatCycle[w.currentFormat])
+			w.currentView = w.pendingView
+			w.tokens = parseWeatherFormat(w.viewFormat(w.currentView))
 			w.scrollOffset = 0
 		}
 	}
 
-	// Handle format cycling (start new transition when it's time)
-	if len(w.formatCycle) > 1 && w.cycleInterval > 0 && !w.transition.IsActive() {
+	// Handle format and location cycling (start new transition when it's time)
+	if w.viewCount() > 1 && w.cycleInterval > 0 && !w.transition.IsActive() {
 		cycleElapsed := now.Sub(w.lastCycleTime).Seconds()
 		if cycleElapsed >= float64(w.cycleInterval) {
 			// Capture current frame
 			oldFrame := bitmap.NewGrayscaleImage(pos.W, pos.H, w.GetRenderBackgroundColor())
-			w.renderTokens(oldFrame, w.tokens, w.weather, w.forecast, w.airQuality, w.uvIndex, w.scrollOffset)
+			w.renderView(oldFrame, *w.viewLocation(w.currentView), w.tokens, w.scrollOffset)
 
 			// Set up transition
-			w.pendingFormat = (w.currentFormat + 1) % len(w.formatCycle)
+			w.pendingView = (w.currentView + 1) % w.viewCount()
 			w.transition.Start(anim.TransitionType(w.transitionType), w.transitionSpeed, oldFrame)
 			w.lastCycleTime = now
 		}
 	}
 	w.mu.Unlock()
 
+	// Copy the shown locations, so rendering doesn't race with updates
 	w.mu.RLock()
-	weather := w.weather
-	forecast := w.forecast
-	aqi := w.airQuality
-	uv := w.uvIndex
-	lastError := w.lastError
+	current := *w.viewLocation(w.currentView)
+	pending := *w.viewLocation(w.pendingView)
+	stale := current.isStale(now, w.GetUpdateInterval())
 	tokens := w.tokens
 	scrollOffset := w.scrollOffset
-	pendingFormat := w.pendingFormat
+	pendingView := w.pendingView
 	w.mu.RUnlock()
 
-	// Handle error state
-	if lastError != "" && weather == nil {
-		errMsg := abbreviateWeatherError(lastError)
-		bitmap.SmartDrawAlignedText(img, errMsg, w.fontFace, w.fontName, "center", "center", w.padding)
-		return img, nil
-	}
-
-	// Handle no data yet
-	if weather == nil {
-		bitmap.SmartDrawAlignedText(img, "...", w.fontFace, w.fontName, "center", "center", w.padding)
-		return img, nil
-	}
-
 	// If transition is active, render both frames and composite
 	// Use IsActiveLive for accurate timing regardless of Update() frequency
 	if w.transition.IsActiveLive() && w.transition.OldFrame() != nil {
 		// Render new frame
 		newFrame := bitmap.NewGrayscaleImage(pos.W, pos.H, w.GetRenderBackgroundColor())
-		newTokens := parseWeatherFormat(w.formatCycle[pendingFormat])
-		w.renderTokens(newFrame, newTokens, weather, forecast, aqi, uv, 0) // Reset scroll for new format
+		newTokens := parseWeatherFormat(w.viewFormat(pendingView))
+		w.renderView(newFrame, pending, newTokens, 0) // Reset scroll for new format
 
 		// Apply transition with live progress for smooth animation
 		w.transition.ApplyLive(img, newFrame)
 	} else {
 		// Normal rendering
-		w.renderTokens(img, tokens, weather, forecast, aqi, uv, scrollOffset)
+		w.renderView(img, current, tokens, scrollOffset)
+
+		// Error and loading states are shown without a border
+		if current.weather == nil {
+			return img, nil
+		}
 	}
 
 	// Draw border if enabled (always on top)
 	w.ApplyBorder(img)
 
+	if stale {
+		drawStaleMarker(img)
+	}
+
 	return img, nil
 }
+
+// renderView draws a location's data with the given tokens, or its error or
+// loading state while it has no data
+func (w *Widget) renderView(img *image.Gray, l location, tokens []render.Token, scrollOffset float64) {
+	switch {
+	case l.weather != nil:
+		w.renderTokens(img, tokens, l.weather, l.forecast, l.airQuality, l.uvIndex, scrollOffset)
+	case l.lastError != "":
+		errMsg := abbreviateWeatherError(l.lastError)
+		bitmap.SmartDrawAlignedText(img, errMsg, w.fontFace, w.fontName, "center", "center", w.padding)
+	default:
+		bitmap.SmartDrawAlignedText(img, "...", w.fontFace, w.fontName, "center", "center", w.padding)
+	}
+}
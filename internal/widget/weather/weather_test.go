@@ -1,11 +1,17 @@
 package weather
 
 import (
+	"errors"
 	"image"
+	"math"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
 )
 
 func TestNew(t *testing.T) {
@@ -198,7 +204,7 @@ func TestWidget_Render_WithData(t *testing.T) {
 
 	// Manually set weather data to avoid network call
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature: 15.5,
 		FeelsLike:   14.0,
 		Condition:   Clear,
@@ -258,7 +264,7 @@ func TestWidget_TextFormat(t *testing.T) {
 
 	// Set weather data
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature: 20.0,
 		Condition:   Rain,
 		Description: "Light rain",
@@ -440,12 +446,12 @@ func TestWidget_ForecastGraphFormat(t *testing.T) {
 
 	// Set weather and forecast data
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature: 15.0,
 		Condition:   Clear,
 		Description: "Clear",
 	}
-	widget.forecast = &ForecastData{
+	widget.locations[0].forecast = &ForecastData{
 		Hourly: []ForecastPoint{
 			{Temperature: 15.0, Condition: Clear},
 			{Temperature: 16.0, Condition: Clear},
@@ -502,12 +508,12 @@ func TestWidget_ForecastIconsFormat(t *testing.T) {
 
 	// Set weather and daily forecast data
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature: 15.0,
 		Condition:   Clear,
 		Description: "Clear",
 	}
-	widget.forecast = &ForecastData{
+	widget.locations[0].forecast = &ForecastData{
 		Daily: []ForecastPoint{
 			{Time: time.Now(), Temperature: 15.0, Condition: Clear},
 			{Time: time.Now().Add(24 * time.Hour), Temperature: 18.0, Condition: PartlyCloudy},
@@ -562,12 +568,12 @@ func TestWidget_ScrollFormat(t *testing.T) {
 
 	// Set weather and forecast data
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature: 15.0,
 		Condition:   Clear,
 		Description: "Clear sky",
 	}
-	widget.forecast = &ForecastData{
+	widget.locations[0].forecast = &ForecastData{
 		Hourly: []ForecastPoint{
 			{Time: time.Now().Add(time.Hour), Temperature: 16.0, Condition: Clear},
 			{Time: time.Now().Add(2 * time.Hour), Temperature: 18.0, Condition: PartlyCloudy},
@@ -630,7 +636,7 @@ func TestWidget_FormatCycle(t *testing.T) {
 
 	// Set weather data
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature:   15.0,
 		Condition:     Clear,
 		Description:   "Clear",
@@ -649,6 +655,44 @@ func TestWidget_FormatCycle(t *testing.T) {
 	}
 }
 
+func TestWidget_NeedsRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  config.StringOrSlice
+		cycle   *config.WeatherCycleConfig
+		animate bool
+	}{
+		{"static format", config.StringOrSlice{"{icon} {temp}"}, nil, false},
+		{"forecast scroll", config.StringOrSlice{"{forecast:scroll}"}, nil, true},
+		{"format cycle", config.StringOrSlice{"{temp}", "{wind}"}, &config.WeatherCycleConfig{Interval: 5}, true},
+		{"cycle disabled", config.StringOrSlice{"{temp}", "{wind}"}, &config.WeatherCycleConfig{Interval: 0}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := New(config.WidgetConfig{
+				Type:     "weather",
+				ID:       "test_weather",
+				Position: config.PositionConfig{W: 128, H: 40},
+				Weather: &config.WeatherConfig{
+					Provider: "open-meteo",
+					Location: &config.WeatherLocationConfig{Lat: 51.5074, Lon: -0.1278},
+					Format:   tt.format,
+					Cycle:    tt.cycle,
+				},
+			})
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			// Without a pending update only animated layouts are redrawn
+			if got := w.NeedsRender(); got != tt.animate {
+				t.Errorf("NeedsRender() = %v, want %v", got, tt.animate)
+			}
+		})
+	}
+}
+
 func TestWidget_ConfigDefaults(t *testing.T) {
 	cfg := config.WidgetConfig{
 		Type:    "weather",
@@ -723,12 +767,12 @@ func TestWidget_AQIFormat(t *testing.T) {
 
 	// Set weather and AQI data
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature: 15.0,
 		Condition:   Clear,
 		Description: "Clear",
 	}
-	widget.airQuality = &AirQualityData{
+	widget.locations[0].airQuality = &AirQualityData{
 		AQI:   42,
 		Level: AQIGood,
 		PM25:  10.5,
@@ -770,7 +814,7 @@ func TestWidget_MultilineFormat(t *testing.T) {
 
 	// Set weather data
 	widget.mu.Lock()
-	widget.weather = &WData{
+	widget.locations[0].weather = &WData{
 		Temperature:   15.0,
 		Condition:     Clear,
 		Description:   "Clear",
@@ -838,6 +882,148 @@ func TestGetAQILevel(t *testing.T) {
 	}
 }
 
+func TestGetAQIBrightness(t *testing.T) {
+	tests := []struct {
+		aqi  int
+		want uint8
+	}{
+		{0, aqiBrightnessGood},
+		{50, aqiBrightnessGood},
+		{51, aqiBrightnessModerate},
+		{150, aqiBrightnessSensitive},
+		{200, aqiBrightnessUnhealthy},
+		{300, aqiBrightnessVeryUnhealthy},
+		{301, aqiBrightnessHazardous},
+	}
+
+	prev := uint8(0)
+	for _, tt := range tests {
+		got := getAQIBrightness(tt.aqi)
+		if got != tt.want {
+			t.Errorf("getAQIBrightness(%d) = %d, want %d", tt.aqi, got, tt.want)
+		}
+		if got < prev {
+			t.Errorf("getAQIBrightness(%d) = %d is dimmer than a better band (%d)", tt.aqi, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestWidget_AQIColor(t *testing.T) {
+	brightest := func(aqiColor bool, aqi int) uint8 {
+		t.Helper()
+		w, err := New(config.WidgetConfig{
+			Type:     "weather",
+			ID:       "test_weather",
+			Position: config.PositionConfig{X: 0, Y: 0, W: 64, H: 20},
+			Style:    &config.StyleConfig{Border: -1},
+			Weather: &config.WeatherConfig{
+				Provider: "open-meteo",
+				Location: &config.WeatherLocationConfig{Lat: 51.5074, Lon: -0.1278},
+				Format:   config.StringOrSlice{"{aqi}"},
+				AqiColor: aqiColor,
+			},
+		})
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		w.locations[0].weather = &WData{Temperature: 20}
+		w.locations[0].airQuality = &AirQualityData{AQI: aqi}
+
+		img, err := w.Render()
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		var peak uint8
+		for _, v := range img.(*image.Gray).Pix {
+			peak = max(peak, v)
+		}
+		return peak
+	}
+
+	if got := brightest(true, 20); got != aqiBrightnessGood {
+		t.Errorf("good air drawn at %d, want %d", got, aqiBrightnessGood)
+	}
+	if got := brightest(true, 350); got != aqiBrightnessHazardous {
+		t.Errorf("hazardous air drawn at %d, want %d", got, aqiBrightnessHazardous)
+	}
+	if got := brightest(false, 20); got != 255 {
+		t.Errorf("without aqi_color drawn at %d, want 255", got)
+	}
+}
+
+func TestWidget_TokenStyles(t *testing.T) {
+	newWidget := func(format string) *Widget {
+		t.Helper()
+		w, err := New(config.WidgetConfig{
+			Type:     "weather",
+			ID:       "test_weather",
+			Position: config.PositionConfig{X: 0, Y: 0, W: 64, H: 20},
+			Style:    &config.StyleConfig{Border: -1},
+			Weather: &config.WeatherConfig{
+				Provider: "open-meteo",
+				Location: &config.WeatherLocationConfig{Lat: 51.5074, Lon: -0.1278},
+				Format:   config.StringOrSlice{format},
+				AqiColor: true,
+			},
+		})
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		w.locations[0].weather = &WData{Temperature: 20, Condition: Clear}
+		w.locations[0].airQuality = &AirQualityData{AQI: 20}
+		return w
+	}
+	peak := func(w *Widget) uint8 {
+		t.Helper()
+		img, err := w.Render()
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		var p uint8
+		for _, v := range img.(*image.Gray).Pix {
+			p = max(p, v)
+		}
+		return p
+	}
+
+	for _, tt := range []struct {
+		format string
+		want   uint8
+	}{
+		{"{temp}", 255},
+		{"{temp|dim}", render.StyleDim},
+		{"{temp:f|90}", 90},
+		{"{icon|dim}", render.StyleDim},
+		{"{aqi|bright}", render.StyleBright}, // Overrides aqi_color
+	} {
+		if got := peak(newWidget(tt.format)); got != tt.want {
+			t.Errorf("%s drawn at %d, want %d", tt.format, got, tt.want)
+		}
+	}
+
+	w := newWidget("{temp} {humidity|font=pixel5x7}")
+	if face, name := w.tokenFont(&w.tokens[2]); face != nil || name != "pixel5x7" {
+		t.Errorf("tokenFont() = %v, %q, want the internal pixel5x7 font", face, name)
+	}
+	if face, name := w.tokenFont(&w.tokens[0]); face != w.fontFace || name != w.fontName {
+		t.Errorf("tokenFont() = %v, %q, want the widget font", face, name)
+	}
+
+	_, err := New(config.WidgetConfig{
+		Type:     "weather",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 64, H: 20},
+		Weather: &config.WeatherConfig{
+			Provider: "open-meteo",
+			Location: &config.WeatherLocationConfig{Lat: 51.5074, Lon: -0.1278},
+			Format:   config.StringOrSlice{"{temp|font=no-such-font}"},
+		},
+	})
+	if err == nil {
+		t.Error("New() should fail for a token style naming an unknown font")
+	}
+}
+
 func TestGetUVLevel(t *testing.T) {
 	tests := []struct {
 		uv       float64
@@ -862,6 +1048,150 @@ func TestGetUVLevel(t *testing.T) {
 	}
 }
 
+func TestGetWeatherTokenText_MissingData(t *testing.T) {
+	reported := &WData{FeelsLike: 18, Humidity: 40, WindSpeed: 3, WindDirection: "SW"}
+	missing := &WData{
+		FeelsLike:     reportedFloat(nil),
+		Humidity:      reportedHumidity(nil),
+		WindSpeed:     reportedFloat(nil),
+		WindDirection: reportedDirection(nil),
+	}
+
+	tests := []struct {
+		format       string
+		wantReported string
+	}{
+		{"{feels_like}", "18C"},
+		{"{humidity}", "40%"},
+		{"{wind_speed}", "3.0m/s"},
+		{"{wind_dir}", "SW"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			tokens := parseWeatherFormat(tt.format)
+			if got := getWeatherTokenText(&tokens[0], reported, nil, nil, nil, unitsMetric); got != tt.wantReported {
+				t.Errorf("reported %s = %q, want %q", tt.format, got, tt.wantReported)
+			}
+			if got := getWeatherTokenText(&tokens[0], missing, nil, nil, nil, unitsMetric); got != missingValue {
+				t.Errorf("missing %s = %q, want %q", tt.format, got, missingValue)
+			}
+		})
+	}
+
+	// Icons for missing data are left blank
+	if getHumidityIcon(missing.Humidity) != "" || getWindIcon(missing.WindSpeed, unitsMetric) != "" || getWindDirIcon(missing.WindDirection) != "" {
+		t.Error("icons for missing data should be empty")
+	}
+}
+
+func TestIsNight(t *testing.T) {
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	weather := &WData{Sunrise: day.Add(5 * time.Hour), Sunset: day.Add(21 * time.Hour)}
+
+	tests := []struct {
+		now  time.Time
+		want bool
+	}{
+		{day.Add(3 * time.Hour), true},
+		{day.Add(5 * time.Hour), false},
+		{day.Add(12 * time.Hour), false},
+		{day.Add(21 * time.Hour), true},
+		// A later or earlier day still applies
+		{day.Add(36 * time.Hour), false},
+		{day.Add(47 * time.Hour), true},
+		{day.Add(-12 * time.Hour), false},
+		// Another zone makes no difference: 12:00 in UTC+5 is 07:00 UTC
+		{time.Date(2024, 6, 1, 12, 0, 0, 0, time.FixedZone("", 5*3600)), false},
+		{time.Date(2024, 6, 1, 4, 0, 0, 0, time.FixedZone("", 5*3600)), true},
+	}
+	for _, tt := range tests {
+		if got := isNight(weather, tt.now); got != tt.want {
+			t.Errorf("isNight(%v) = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+
+	// Sunset after local midnight: in UTC+3, sunrise is 05:00 and sunset 00:30 the next day
+	zone := time.FixedZone("", 3*3600)
+	lateSunset := &WData{
+		Sunrise: time.Date(2024, 6, 1, 5, 0, 0, 0, zone),
+		Sunset:  time.Date(2024, 6, 2, 0, 30, 0, 0, zone),
+	}
+	for _, tt := range []struct {
+		now  time.Time
+		want bool
+	}{
+		{time.Date(2024, 6, 1, 23, 0, 0, 0, zone), false},
+		{time.Date(2024, 6, 2, 0, 15, 0, 0, zone), false},
+		{time.Date(2024, 6, 2, 1, 0, 0, 0, zone), true},
+		{time.Date(2024, 6, 2, 12, 0, 0, 0, zone), false},
+		// The same instants seen from UTC
+		{time.Date(2024, 6, 1, 21, 15, 0, 0, time.UTC), false},
+		{time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC), true},
+	} {
+		if got := isNight(lateSunset, tt.now); got != tt.want {
+			t.Errorf("isNight(%v) with a sunset after midnight = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+
+	// A sunset reported for the day before the sunrise still spans the same daylight
+	earlySunset := &WData{Sunrise: day.Add(29 * time.Hour), Sunset: day.Add(21 * time.Hour)}
+	if isNight(earlySunset, day.Add(12*time.Hour)) || !isNight(earlySunset, day.Add(22*time.Hour)) {
+		t.Error("isNight() with the sunset before the sunrise mismatches the daylight")
+	}
+
+	if isNight(&WData{}, day) {
+		t.Error("isNight() without sunrise and sunset = true, want false")
+	}
+}
+
+func TestGetNightIconName(t *testing.T) {
+	if got := getNightIconName(Clear); got != "moon" {
+		t.Errorf("getNightIconName(Clear) = %q, want moon", got)
+	}
+	// The 16px set has both night icons; the 24px set falls back to the day cloud
+	for _, condition := range []string{Clear, PartlyCloudy} {
+		if glyphs.GetIcon(glyphs.WeatherIcons16x16, getNightIconName(condition)) == nil {
+			t.Errorf("16px set has no night icon for %s", condition)
+		}
+	}
+	if glyphs.GetIcon(glyphs.WeatherIcons24x24, getNightIconName(PartlyCloudy)) != nil {
+		t.Error("24px set unexpectedly has a partly cloudy night icon")
+	}
+	if getNightIconName(Rain) != "" {
+		t.Error("rain should have no night variant")
+	}
+}
+
+func TestGetWeatherTokenText_SunTimes(t *testing.T) {
+	zone := time.FixedZone("", 2*3600)
+	weather := &WData{
+		Sunrise: time.Date(2024, 6, 1, 4, 12, 0, 0, time.UTC).In(zone),
+		Sunset:  time.Date(2024, 6, 1, 19, 45, 0, 0, time.UTC).In(zone),
+	}
+	for format, want := range map[string]string{"{sunrise}": "06:12", "{sunset}": "21:45"} {
+		tokens := parseWeatherFormat(format)
+		if got := getWeatherTokenText(&tokens[0], weather, nil, nil, nil, unitsMetric); got != want {
+			t.Errorf("%s = %q, want %q", format, got, want)
+		}
+		if got := getWeatherTokenText(&tokens[0], &WData{}, nil, nil, nil, unitsMetric); got != missingValue {
+			t.Errorf("%s without data = %q, want %q", format, got, missingValue)
+		}
+	}
+}
+
+func TestLoadTimezone(t *testing.T) {
+	if loadTimezone("") != time.Local {
+		t.Error("empty timezone should be local time")
+	}
+	if loadTimezone("Not/AZone") != time.Local {
+		t.Error("unknown timezone should fall back to local time")
+	}
+	if loc := loadTimezone("UTC"); loc.String() != "UTC" {
+		t.Errorf("loadTimezone(UTC) = %v", loc)
+	}
+}
+
 func TestDegreesToDirection(t *testing.T) {
 	tests := []struct {
 		deg      float64
@@ -876,6 +1206,7 @@ func TestDegreesToDirection(t *testing.T) {
 		{270, "W"},
 		{315, "NW"},
 		{360, "N"},
+		{-90, "W"},
 	}
 
 	for _, tt := range tests {
@@ -951,3 +1282,403 @@ func TestAbbreviateWeatherError(t *testing.T) {
 		})
 	}
 }
+
+func TestNew_Demo(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "weather",
+		ID:       "test_weather_demo",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Demo:     true,
+		Weather: &config.WeatherConfig{
+			// No location or API key: demo mode needs neither
+			Provider: "openweathermap",
+			Format:   []string{"{icon} {temp} {aqi} {uv}", "{forecast:days}"},
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer func() { _ = w.fontFace.Close() }()
+
+	if w.locations[0].provider.Name() != providerDemo {
+		t.Fatalf("provider = %s, want %s", w.locations[0].provider.Name(), providerDemo)
+	}
+
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if w.locations[0].weather == nil {
+		t.Fatal("expected demo weather data")
+	}
+	if w.locations[0].airQuality == nil || w.locations[0].uvIndex == nil {
+		t.Error("expected demo AQI and UV data")
+	}
+	if _, err := w.Render(); err != nil {
+		t.Errorf("Render() error = %v", err)
+	}
+}
+
+func TestDemoProvider(t *testing.T) {
+	p := NewDemoProvider(ProviderConfig{Units: unitsMetric, ForecastHours: 6, ForecastDays: 3})
+
+	seen := make(map[string]bool)
+	for i := 0; i < len(demoConditions); i++ {
+		data, forecast, err := p.FetchWeather(true)
+		if err != nil {
+			t.Fatalf("FetchWeather() error = %v", err)
+		}
+		seen[data.Condition] = true
+		if data.Temperature < -10 || data.Temperature > 40 {
+			t.Errorf("temperature %f out of plausible range", data.Temperature)
+		}
+		if len(forecast.Hourly) != 6 || len(forecast.Daily) != 3 {
+			t.Errorf("forecast = %d hourly, %d daily; want 6, 3", len(forecast.Hourly), len(forecast.Daily))
+		}
+	}
+	if len(seen) != len(demoConditions) {
+		t.Errorf("saw %d conditions, want all %d", len(seen), len(demoConditions))
+	}
+
+	if _, forecast, _ := p.FetchWeather(false); forecast != nil {
+		t.Error("expected no forecast when not requested")
+	}
+
+	imperial := NewDemoProvider(ProviderConfig{Units: unitsImperial})
+	data, _, _ := imperial.FetchWeather(false)
+	if data.Temperature < 32 {
+		t.Errorf("imperial temperature = %f, want Fahrenheit", data.Temperature)
+	}
+}
+
+func TestGetWeatherTokenText_UnitModifiers(t *testing.T) {
+	metric := &WData{Temperature: 20, FeelsLike: 10, WindSpeed: 10, Visibility: 16093.4}
+	imperial := &WData{Temperature: 68, FeelsLike: 50, WindSpeed: 10, Visibility: 16093.4}
+
+	tests := []struct {
+		format string
+		data   *WData
+		units  string
+		want   string
+	}{
+		{"{temp}", metric, unitsMetric, "20C"},
+		{"{temp:f}", metric, unitsMetric, "68F"},
+		{"{temp:F}", metric, unitsMetric, "68F"},
+		{"{temp:c}", imperial, unitsImperial, "20C"},
+		{"{temp:unknown}", imperial, unitsImperial, "68F"},
+		{"{temp_raw:f}", metric, unitsMetric, "68"},
+		{"{feels:f}", metric, unitsMetric, "50F"},
+		{"{wind}", metric, unitsMetric, "10.0m/s"},
+		{"{wind:mph}", metric, unitsMetric, "22.4mph"},
+		{"{wind:kmh}", metric, unitsMetric, "36.0km/h"},
+		{"{wind:km/h}", metric, unitsMetric, "36.0km/h"},
+		{"{wind:kn}", metric, unitsMetric, "19.4kn"},
+		{"{wind:ms}", imperial, unitsImperial, "4.5m/s"},
+		{"{wind}", imperial, unitsImperial, "10.0mph"},
+		{"{visibility}", metric, unitsMetric, "16km"},
+		{"{visibility:mi}", metric, unitsMetric, "10.0mi"},
+		{"{visibility:km}", imperial, unitsImperial, "16km"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+"/"+tt.units, func(t *testing.T) {
+			tokens := parseWeatherFormat(tt.format)
+			if len(tokens) != 1 {
+				t.Fatalf("parseWeatherFormat(%q) returned %d tokens, want 1", tt.format, len(tokens))
+			}
+			got := getWeatherTokenText(&tokens[0], tt.data, nil, nil, nil, tt.units)
+			if got != tt.want {
+				t.Errorf("getWeatherTokenText(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+// failingProvider simulates a network outage
+type failingProvider struct{}
+
+func (failingProvider) FetchWeather(bool) (*WData, *ForecastData, error) {
+	return nil, nil, errors.New("dial tcp: no such host")
+}
+func (failingProvider) FetchAirQuality() (*AirQualityData, error) { return nil, nil }
+func (failingProvider) FetchUVIndex() (*UVIndexData, error)       { return nil, nil }
+func (failingProvider) Name() string                              { return "failing" }
+
+func TestWeatherCache_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "weather", "cache.json")
+	saved := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	weather := &WData{Temperature: 12, FeelsLike: math.NaN(), Condition: Rain, Humidity: 80, WindSpeed: 3.5, WindDirection: "NE"}
+	forecast := &ForecastData{Daily: []ForecastPoint{{Time: saved, Temperature: 14, Condition: Cloudy}}}
+
+	if err := saveWeatherCache(path, saved, weather, forecast, &AirQualityData{AQI: 42}, nil); err != nil {
+		t.Fatalf("saveWeatherCache() error = %v", err)
+	}
+	cache, got, err := loadWeatherCache(path)
+	if err != nil {
+		t.Fatalf("loadWeatherCache() error = %v", err)
+	}
+	if !cache.Saved.Equal(saved) {
+		t.Errorf("Saved = %v, want %v", cache.Saved, saved)
+	}
+	if got.Temperature != 12 || got.Condition != Rain || got.Humidity != 80 || got.WindSpeed != 3.5 || got.WindDirection != "NE" {
+		t.Errorf("weather = %+v, want the saved values", got)
+	}
+	if !math.IsNaN(got.FeelsLike) {
+		t.Errorf("FeelsLike = %v, want NaN", got.FeelsLike)
+	}
+	if cache.Forecast == nil || len(cache.Forecast.Daily) != 1 || cache.Forecast.Daily[0].Temperature != 14 {
+		t.Errorf("Forecast = %+v, want one daily point", cache.Forecast)
+	}
+	if cache.AirQuality == nil || cache.AirQuality.AQI != 42 || cache.UVIndex != nil {
+		t.Errorf("AirQuality = %+v, UVIndex = %+v", cache.AirQuality, cache.UVIndex)
+	}
+}
+
+func TestWidget_OfflineCache(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:           "weather",
+		ID:             "test_weather",
+		Enabled:        config.BoolPtr(true),
+		UpdateInterval: 60,
+		Position:       config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Weather: &config.WeatherConfig{
+			Provider:       "open-meteo",
+			Location:       &config.WeatherLocationConfig{Lat: 51.5074, Lon: -0.1278},
+			CacheMaxAgeMin: 30,
+		},
+	}
+
+	newOffline := func(t *testing.T, path string) *Widget {
+		w, err := New(cfg)
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		w.locations[0].provider = failingProvider{}
+		w.locations[0].cachePath = path
+		return w
+	}
+	staleMarkerLit := func(img image.Image) bool {
+		gray := img.(*image.Gray)
+		b := gray.Bounds()
+		return gray.GrayAt(b.Max.X-2, 1).Y == staleMarkerColor && gray.GrayAt(b.Max.X-3, 2).Y == staleMarkerColor
+	}
+
+	t.Run("recent cache is shown as stale", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cache.json")
+		weather := &WData{Temperature: 21, FeelsLike: math.NaN(), WindSpeed: math.NaN(), Humidity: -1}
+		if err := saveWeatherCache(path, time.Now().Add(-10*time.Minute), weather, nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		w := newOffline(t, path)
+		_ = w.Update()
+		if w.locations[0].weather == nil || w.locations[0].weather.Temperature != 21 {
+			t.Fatalf("weather = %+v, want the cached reading", w.locations[0].weather)
+		}
+		img, err := w.Render()
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		if !staleMarkerLit(img) {
+			t.Error("stale marker not drawn over cached data")
+		}
+	})
+
+	t.Run("expired cache shows the error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cache.json")
+		if err := saveWeatherCache(path, time.Now().Add(-time.Hour), &WData{Temperature: 21}, nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		w := newOffline(t, path)
+		_ = w.Update()
+		if w.locations[0].weather != nil {
+			t.Errorf("weather = %+v, want nil after cache_max_age_min", w.locations[0].weather)
+		}
+	})
+
+	t.Run("in-memory data expires", func(t *testing.T) {
+		w := newOffline(t, "")
+		w.locations[0].weather = &WData{Temperature: 21}
+		w.locations[0].fetchedAt = time.Now().Add(-time.Hour)
+		_ = w.Update()
+		if w.locations[0].weather != nil {
+			t.Errorf("weather = %+v, want nil after cache_max_age_min", w.locations[0].weather)
+		}
+	})
+
+	t.Run("fresh data has no marker", func(t *testing.T) {
+		w := newOffline(t, "")
+		w.locations[0].weather = &WData{Temperature: 21}
+		w.locations[0].fetchedAt = time.Now()
+		img, err := w.Render()
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		if staleMarkerLit(img) {
+			t.Error("stale marker drawn over fresh data")
+		}
+	})
+}
+
+func TestWidget_Locations(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "weather",
+		ID:       "test_weather",
+		Enabled:  config.BoolPtr(true),
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Weather: &config.WeatherConfig{
+			Provider: "open-meteo",
+			Locations: []config.WeatherLocationConfig{
+				{Name: "London", Lat: 51.5074, Lon: -0.1278},
+				{Name: "Tokyo", Lat: 35.6762, Lon: 139.6503},
+				{Name: "New York", Lat: 40.7128, Lon: -74.0060},
+			},
+			Format: config.StringOrSlice{"{city} {temp}", "{humidity}"},
+			Cycle:  &config.WeatherCycleConfig{Interval: 5, Transition: "slide_left"},
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if len(w.locations) != 3 {
+		t.Fatalf("locations = %d, want 3", len(w.locations))
+	}
+	if w.viewCount() != 6 {
+		t.Errorf("viewCount() = %d, want 6", w.viewCount())
+	}
+	if !w.animated {
+		t.Error("animated = false, want true for cycling locations")
+	}
+
+	// Every format of a location is shown before moving on to the next one
+	views := []struct {
+		city   string
+		format string
+	}{
+		{"London", "{city} {temp}"},
+		{"London", "{humidity}"},
+		{"Tokyo", "{city} {temp}"},
+		{"Tokyo", "{humidity}"},
+		{"New York", "{city} {temp}"},
+		{"New York", "{humidity}"},
+	}
+	for view, want := range views {
+		if got := w.viewLocation(view).name; got != want.city {
+			t.Errorf("viewLocation(%d) = %s, want %s", view, got, want.city)
+		}
+		if got := w.viewFormat(view); got != want.format {
+			t.Errorf("viewFormat(%d) = %q, want %q", view, got, want.format)
+		}
+	}
+
+	// Each location is cached separately
+	paths := make(map[string]bool)
+	for _, l := range w.locations {
+		paths[l.cachePath] = true
+	}
+	if len(paths) != 3 {
+		t.Errorf("cache paths = %v, want 3 distinct paths", paths)
+	}
+
+	// Locations are fetched on their own: one without data doesn't hide the others
+	w.locations[1].setWeather(&WData{Temperature: 25}, time.UTC)
+	if w.locations[1].weather.City != "Tokyo" {
+		t.Errorf("City = %q, want Tokyo", w.locations[1].weather.City)
+	}
+	w.currentView = 2
+	if _, err := w.Render(); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+}
+
+func TestNew_LocationValidation(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type: "weather",
+		Weather: &config.WeatherConfig{
+			Provider: "open-meteo",
+			Locations: []config.WeatherLocationConfig{
+				{Lat: 51.5074, Lon: -0.1278},
+				{City: "Tokyo"},
+			},
+		},
+	}
+
+	_, err := New(cfg)
+	if err == nil {
+		t.Fatal("New() error = nil, want error for a city without coordinates")
+	}
+	if !strings.HasPrefix(err.Error(), "locations[1]: ") {
+		t.Errorf("New() error = %q, want it to name locations[1]", err)
+	}
+}
+
+func TestGetWeatherTokenText_City(t *testing.T) {
+	tokens := parseWeatherFormat("{city}")
+	if got := getWeatherTokenText(&tokens[0], &WData{City: "London"}, nil, nil, nil, unitsMetric); got != "London" {
+		t.Errorf("{city} = %q, want London", got)
+	}
+	if got := getWeatherTokenText(&tokens[0], &WData{}, nil, nil, nil, unitsMetric); got != missingValue {
+		t.Errorf("{city} without a name = %q, want %q", got, missingValue)
+	}
+}
+
+// staticProvider is a minimal backend registered by TestRegisterProvider
+type staticProvider struct {
+	config ProviderConfig
+}
+
+func (p *staticProvider) FetchWeather(bool) (*WData, *ForecastData, error) {
+	return &WData{Temperature: p.config.Lat, Condition: Clear, Humidity: 50}, nil, nil
+}
+func (p *staticProvider) FetchAirQuality() (*AirQualityData, error) { return nil, nil }
+func (p *staticProvider) FetchUVIndex() (*UVIndexData, error)       { return nil, nil }
+func (p *staticProvider) Name() string                              { return "static" }
+
+func TestRegisterProvider(t *testing.T) {
+	var gotOpts ProviderOptions
+	RegisterProvider("static", func(cfg ProviderConfig, opts ProviderOptions) (Provider, error) {
+		gotOpts = opts
+		return &staticProvider{config: cfg}, nil
+	})
+	t.Cleanup(func() { delete(providers, "static") })
+
+	for _, name := range []string{providerOpenMeteo, providerOpenWeatherMap, providerDemo, "static"} {
+		if _, ok := providers[name]; !ok {
+			t.Errorf("provider %q is not registered", name)
+		}
+	}
+
+	cfg := config.WidgetConfig{
+		Type:     "weather",
+		ID:       "test_weather",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Weather: &config.WeatherConfig{
+			Provider: "static",
+			ApiKey:   "secret",
+			Location: &config.WeatherLocationConfig{Lat: 21, Lon: 1},
+		},
+	}
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if gotOpts.APIKey != "secret" || gotOpts.HTTPClient == nil {
+		t.Errorf("factory options = %+v, want the api key and a client", gotOpts)
+	}
+	w.locations[0].cachePath = "" // Keep the test off the user cache directory
+	if err := w.Update(); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if w.locations[0].weather == nil || w.locations[0].weather.Temperature != 21 {
+		t.Errorf("weather = %+v, want data from the registered provider", w.locations[0].weather)
+	}
+
+	cfg.Weather.Provider = "unknown"
+	if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), "unknown weather provider") {
+		t.Errorf("New() error = %v, want unknown weather provider", err)
+	}
+}
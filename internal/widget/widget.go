@@ -35,6 +35,28 @@ type Stoppable interface {
 	Stop()
 }
 
+// ChangeReporter is an optional interface for widgets that know when their output changes.
+// When NeedsRender returns false, the layout manager reuses the widget's previous image
+// instead of calling Render, so unchanged widgets cost almost nothing per frame.
+type ChangeReporter interface {
+	// NeedsRender reports whether Render may produce a different image than last time
+	NeedsRender() bool
+}
+
+// UpdateListener is an optional interface for widgets that track when their update loop runs.
+// The scheduler calls MarkUpdated after every successful Update.
+type UpdateListener interface {
+	MarkUpdated()
+}
+
+// ExclusiveOverlay is an optional interface for widgets that can temporarily take over
+// the whole display, e.g. to show an alert. While a widget returns an overlay image, the
+// compositor sends it instead of the composited layout.
+type ExclusiveOverlay interface {
+	// RenderOverlay returns a full-display image of the given size, or nil when no overlay is requested
+	RenderOverlay(width, height int) (image.Image, error)
+}
+
 // StopWidget calls Stop() on the widget if it implements Stoppable.
 // Safe to call on any widget - does nothing if widget doesn't implement Stoppable.
 func StopWidget(w Widget) {
@@ -5,10 +5,12 @@ import (
 	"image"
 	"image/color"
 	"sync"
+	"unicode/utf8"
 
 	"github.com/pozitronik/steelclock-go/internal/bitmap"
 	"github.com/pozitronik/steelclock-go/internal/bitmap/glyphs"
 	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
 	"github.com/pozitronik/steelclock-go/internal/shared"
 	"github.com/pozitronik/steelclock-go/internal/shared/anim"
 	"github.com/pozitronik/steelclock-go/internal/shared/render"
@@ -29,12 +31,22 @@ const (
 	placeholderModeText = "text"
 )
 
+// textLine is one formatted line of track information with its own scroller
+type textLine struct {
+	format        string
+	tokens        []render.Token // Parsed format
+	scrollEnabled bool
+	scrollGap     int // gap between text repetitions (kept for rendering)
+	scroller      *anim.TextScroller
+	text          string            // Plain formatted text
+	styled        render.StyledText // Formatted text in its token styles
+}
+
 // Widget displays information from Winamp media player
 type Widget struct {
 	*widget.BaseWidget
 
 	// Configuration
-	format          string
 	fontSize        int
 	fontName        string
 	horizAlign      config.HAlign
@@ -43,9 +55,8 @@ type Widget struct {
 	placeholderMode string // "text" or "icon"
 	placeholderText string
 
-	// Scroll settings
-	scrollEnabled bool
-	scrollGap     int // gap between text repetitions (kept for rendering)
+	// Lines stacked top to bottom; a single line fills the content area
+	lines []*textLine
 
 	// Auto-show event flags
 	autoShowOnTrackChange bool
@@ -57,14 +68,12 @@ type Widget struct {
 	// Runtime state
 	client         winamp.Client
 	fontFace       font.Face
-	currentText    string
+	styleFonts     map[string]font.Face // Fonts named by token styles, at the widget font size
 	previousTitle  string
 	previousStatus winamp.PlaybackStatus
-	previousPosMs  int // for seek detection
+	previousPosMs  int               // for seek detection
+	lastInfo       *winamp.TrackInfo // Last track info fetched, nil when nothing is loaded
 	mu             sync.RWMutex
-
-	// Shared scroller
-	scroller *anim.TextScroller
 }
 
 // New creates a new Winamp widget
@@ -91,8 +100,12 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 	// Extract Winamp-specific settings (placeholder)
 	placeholderMode := placeholderModeIcon
 	placeholderText := "No Winamp"
+	source := config.WinampSourceAuto
 
 	if cfg.Winamp != nil {
+		if cfg.Winamp.Source != "" {
+			source = cfg.Winamp.Source
+		}
 		if cfg.Winamp.Placeholder != nil {
 			if cfg.Winamp.Placeholder.Mode != "" {
 				placeholderMode = cfg.Winamp.Placeholder.Mode
@@ -121,30 +134,17 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		autoShowOnSeek = cfg.AutoShow.OnSeek
 	}
 
-	// Extract scroll settings
-	scrollEnabled := false
-	scrollDirection := anim.ScrollLeft
-	scrollSpeed := 30.0 // pixels per second
-	scrollMode := anim.ScrollContinuous
-	scrollPauseMs := 1000
-	scrollGap := 20
-
-	if cfg.Scroll != nil {
-		scrollEnabled = cfg.Scroll.Enabled
-		if cfg.Scroll.Direction != "" {
-			scrollDirection = cfg.Scroll.Direction
-		}
-		if cfg.Scroll.Speed > 0 {
-			scrollSpeed = cfg.Scroll.Speed
-		}
-		if cfg.Scroll.Mode != "" {
-			scrollMode = cfg.Scroll.Mode
-		}
-		if cfg.Scroll.PauseMs > 0 {
-			scrollPauseMs = cfg.Scroll.PauseMs
-		}
-		if cfg.Scroll.Gap > 0 {
-			scrollGap = cfg.Scroll.Gap
+	// Separate lines replace text.format; each scrolls on its own,
+	// using the widget scroll settings unless it defines its own
+	lines := []*textLine{newTextLine(format, cfg.Scroll)}
+	if cfg.Winamp != nil && len(cfg.Winamp.Lines) > 0 {
+		lines = make([]*textLine, len(cfg.Winamp.Lines))
+		for i, lineCfg := range cfg.Winamp.Lines {
+			scroll := cfg.Scroll
+			if lineCfg.Scroll != nil {
+				scroll = lineCfg.Scroll
+			}
+			lines[i] = newTextLine(lineCfg.Format, scroll)
 		}
 	}
 
@@ -154,18 +154,18 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		return nil, fmt.Errorf("failed to load font: %w", err)
 	}
 
-	// Create scroller with configuration
-	scroller := anim.NewTextScroller(anim.ScrollerConfig{
-		Speed:     scrollSpeed,
-		Mode:      scrollMode,
-		Direction: scrollDirection,
-		Gap:       scrollGap,
-		PauseMs:   scrollPauseMs,
-	})
+	// Load fonts named by token styles, e.g. {title|font=pixel5x7}
+	var tokens []render.Token
+	for _, line := range lines {
+		tokens = append(tokens, line.tokens...)
+	}
+	styleFonts, err := render.LoadStyleFonts(tokens, fontSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load font: %w", err)
+	}
 
 	return &Widget{
 		BaseWidget:            base,
-		format:                format,
 		fontSize:              fontSize,
 		fontName:              textSettings.FontName,
 		horizAlign:            textSettings.HorizAlign,
@@ -173,36 +173,81 @@ func New(cfg config.WidgetConfig) (*Widget, error) {
 		padding:               padding,
 		placeholderMode:       placeholderMode,
 		placeholderText:       placeholderText,
-		scrollEnabled:         scrollEnabled,
-		scrollGap:             scrollGap,
+		lines:                 lines,
 		autoShowOnTrackChange: autoShowOnTrackChange,
 		autoShowOnPlay:        autoShowOnPlay,
 		autoShowOnPause:       autoShowOnPause,
 		autoShowOnStop:        autoShowOnStop,
 		autoShowOnSeek:        autoShowOnSeek,
-		client:                winamp.NewClient(),
+		client:                winamp.NewClientForSource(source),
 		fontFace:              fontFace,
-		scroller:              scroller,
+		styleFonts:            styleFonts,
 		previousStatus:        winamp.StatusStopped,
 		previousPosMs:         -1,
 	}, nil
 }
 
+// newTextLine creates a line with the given format and scroll settings
+func newTextLine(format string, scroll *config.ScrollConfig) *textLine {
+	scrollEnabled := false
+	scrollDirection := anim.ScrollLeft
+	scrollSpeed := 30.0 // pixels per second
+	scrollMode := anim.ScrollContinuous
+	scrollPauseMs := 1000
+	scrollGap := 20
+
+	if scroll != nil {
+		scrollEnabled = scroll.Enabled
+		if scroll.Direction != "" {
+			scrollDirection = scroll.Direction
+		}
+		if scroll.Speed > 0 {
+			scrollSpeed = scroll.Speed
+		}
+		if scroll.Mode != "" {
+			scrollMode = scroll.Mode
+		}
+		if scroll.PauseMs > 0 {
+			scrollPauseMs = scroll.PauseMs
+		}
+		if scroll.Gap > 0 {
+			scrollGap = scroll.Gap
+		}
+	}
+
+	return &textLine{
+		format:        format,
+		tokens:        render.ParseFormatTokens(format, func(string) render.TokenType { return render.TokenText }),
+		scrollEnabled: scrollEnabled,
+		scrollGap:     scrollGap,
+		scroller: anim.NewTextScroller(anim.ScrollerConfig{
+			Speed:     scrollSpeed,
+			Mode:      scrollMode,
+			Direction: scrollDirection,
+			Gap:       scrollGap,
+			PauseMs:   scrollPauseMs,
+		}),
+	}
+}
+
 // Update fetches current track information from Winamp
 func (w *Widget) Update() error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	// Update scroll position if scrolling is enabled and we have text
-	if w.scrollEnabled && w.currentText != "" {
-		pos := w.GetPosition()
-		contentWidth := pos.W - w.padding*2
-		textWidth, _ := bitmap.SmartMeasureText(w.currentText, w.fontFace, w.fontName)
-		w.scroller.Update(textWidth, contentWidth)
+	// Update scroll positions of lines that scroll and have text
+	pos := w.GetPosition()
+	contentWidth := pos.W - w.padding*2
+	for _, line := range w.lines {
+		if line.scrollEnabled && line.text != "" {
+			textWidth, _ := line.styled.Measure()
+			line.scroller.Update(textWidth, contentWidth)
+		}
 	}
 
 	// Get track info from Winamp
 	info := w.client.GetTrackInfo()
+	w.lastInfo = info
 
 	// Handle stopped/not running state
 	if info == nil {
@@ -210,7 +255,7 @@ func (w *Widget) Update() error {
 		if w.autoShowOnStop && w.previousStatus != winamp.StatusStopped {
 			w.TriggerAutoHide()
 		}
-		w.currentText = ""
+		w.clearText()
 		w.previousStatus = winamp.StatusStopped
 		w.previousPosMs = -1
 		return nil
@@ -250,12 +295,16 @@ func (w *Widget) Update() error {
 
 	// Handle stopped state for display
 	if info.Status == winamp.StatusStopped {
-		w.currentText = ""
+		w.clearText()
 		return nil
 	}
 
-	// Format the output string
-	w.currentText = w.formatOutput(info)
+	// Format the output strings
+	base := render.StyledRun{Color: 255, FontFace: w.fontFace, FontName: w.fontName}
+	for _, line := range w.lines {
+		line.styled = formatOutput(line.tokens, info, base, w.styleFonts)
+		line.text = line.styled.String()
+	}
 
 	// Check for track change and trigger auto-show
 	// Only consider it a track change if:
@@ -267,8 +316,10 @@ func (w *Widget) Update() error {
 	//    timing where title updates before status during stop transition)
 	if info.Title != w.previousTitle {
 		if info.Title != "" && !statusChanged && info.Status != winamp.StatusStopped {
-			// Reset scroll position on track change
-			w.scroller.Reset()
+			// Reset scroll positions on track change
+			for _, line := range w.lines {
+				line.scroller.Reset()
+			}
 			// Trigger auto-show if enabled
 			if w.autoShowOnTrackChange {
 				w.TriggerAutoHide()
@@ -281,10 +332,32 @@ func (w *Widget) Update() error {
 	return nil
 }
 
-// formatOutput replaces placeholders with actual values
-func (w *Widget) formatOutput(info *winamp.TrackInfo) string {
+// NowPlaying returns a copy of the last track info fetched from the player,
+// or nil if the player is not running or has nothing loaded
+func (w *Widget) NowPlaying() *winamp.TrackInfo {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.lastInfo == nil {
+		return nil
+	}
+	info := *w.lastInfo
+	return &info
+}
+
+// clearText empties all lines, so the placeholder is shown
+func (w *Widget) clearText() {
+	for _, line := range w.lines {
+		line.text = ""
+		line.styled = nil
+	}
+}
+
+// formatOutput replaces the tokens of a line format with track values, drawn in base or
+// in their token styles. Unknown tokens and tokens with a parameter are kept as written.
+func formatOutput(tokens []render.Token, info *winamp.TrackInfo, base render.StyledRun, fonts map[string]font.Face) render.StyledText {
 	if info == nil {
-		return ""
+		return nil
 	}
 
 	// Format shuffle/repeat as symbols or text
@@ -297,26 +370,33 @@ func (w *Widget) formatOutput(info *winamp.TrackInfo) string {
 		repeatStr = "R"
 	}
 
-	// Use TokenFormatter for placeholder replacement
-	formatter := render.NewTokenFormatter().
-		Set("title", info.Title).
-		Set("filename", info.FileName).
-		Set("filepath", info.FilePath).
-		Set("position", formatTime(info.PositionMs/1000)).
-		Set("duration", formatTime(info.DurationS)).
-		Set("position_ms", fmt.Sprintf("%d", info.PositionMs)).
-		Set("duration_s", fmt.Sprintf("%d", info.DurationS)).
-		Set("bitrate", fmt.Sprintf("%d", info.Bitrate)).
-		Set("samplerate", fmt.Sprintf("%d", info.SampleRate)).
-		Set("channels", fmt.Sprintf("%d", info.Channels)).
-		Set("status", info.Status.String()).
-		Set("track_num", fmt.Sprintf("%d", info.TrackNumber)).
-		Set("playlist_length", fmt.Sprintf("%d", info.PlaylistLength)).
-		Set("shuffle", shuffleStr).
-		Set("repeat", repeatStr).
-		Set("version", info.Version)
-
-	return formatter.Format(w.format)
+	values := map[string]string{
+		"title":           info.Title,
+		"artist":          info.Artist,
+		"filename":        info.FileName,
+		"filepath":        info.FilePath,
+		"position":        formatTime(info.PositionMs / 1000),
+		"duration":        formatTime(info.DurationS),
+		"position_ms":     fmt.Sprintf("%d", info.PositionMs),
+		"duration_s":      fmt.Sprintf("%d", info.DurationS),
+		"bitrate":         fmt.Sprintf("%d", info.Bitrate),
+		"samplerate":      fmt.Sprintf("%d", info.SampleRate),
+		"channels":        fmt.Sprintf("%d", info.Channels),
+		"status":          i18n.T(info.Status.Message()),
+		"track_num":       fmt.Sprintf("%d", info.TrackNumber),
+		"playlist_length": fmt.Sprintf("%d", info.PlaylistLength),
+		"shuffle":         shuffleStr,
+		"repeat":          repeatStr,
+		"version":         info.Version,
+	}
+
+	return render.StyleTokens(tokens, func(t render.Token) (string, bool) {
+		if t.Param != "" {
+			return "", false
+		}
+		v, ok := values[t.Name]
+		return v, ok
+	}, base, fonts)
 }
 
 // formatTime converts seconds to MM:SS format
@@ -329,6 +409,11 @@ func formatTime(seconds int) string {
 	return fmt.Sprintf("%02d:%02d", mins, secs)
 }
 
+// NeedsRender always returns true: track text scrolls between updates.
+func (w *Widget) NeedsRender() bool {
+	return true
+}
+
 // Render creates an image of the widget
 func (w *Widget) Render() (image.Image, error) {
 	// Check auto-hide
@@ -341,21 +426,38 @@ func (w *Widget) Render() (image.Image, error) {
 	w.ApplyBorder(img)
 
 	w.mu.RLock()
-	currentText := w.currentText
-	scrollOffset := w.scroller.GetOffset()
-	w.mu.RUnlock()
+	defer w.mu.RUnlock()
 
 	// Check if Winamp is running and playing
-	if currentText == "" {
+	empty := true
+	for _, line := range w.lines {
+		if line.text != "" {
+			empty = false
+			break
+		}
+	}
+	if empty {
 		w.renderPlaceholder(img)
 		return img, nil
 	}
 
-	// Render scrolling or static text
-	if w.scrollEnabled {
-		w.renderScrollingText(img, currentText, scrollOffset)
-	} else {
-		bitmap.SmartDrawAlignedText(img, currentText, w.fontFace, w.fontName, w.horizAlign, w.vertAlign, w.padding)
+	// Split the content area into equal rows, one per line
+	pos := w.GetPosition()
+	contentX := w.padding
+	contentY := w.padding
+	contentW := pos.W - w.padding*2
+	contentH := pos.H - w.padding*2
+	for i, line := range w.lines {
+		rowY := contentY + i*contentH/len(w.lines)
+		rowH := contentY + (i+1)*contentH/len(w.lines) - rowY
+		area := image.Rect(contentX, rowY, contentX+contentW, rowY+rowH)
+
+		// Render scrolling or static text
+		if line.scrollEnabled {
+			w.renderScrollingText(img, line, area)
+		} else {
+			render.DrawStyledTextInRect(img, line.styled, area, w.horizAlign, w.vertAlign)
+		}
 	}
 
 	return img, nil
@@ -381,56 +483,62 @@ func (w *Widget) renderPlaceholder(img *image.Gray) {
 	}
 }
 
-// renderScrollingText renders text with scroll offset
-func (w *Widget) renderScrollingText(img *image.Gray, text string, offset float64) {
-	pos := w.GetPosition()
-	contentX := w.padding
-	contentY := w.padding
-	contentW := pos.W - w.padding*2
-	contentH := pos.H - w.padding*2
+// renderScrollingText renders a line within its area with the line's scroll offset
+func (w *Widget) renderScrollingText(img *image.Gray, line *textLine, area image.Rectangle) {
+	// Typewriter reveals the text in place instead of moving it
+	if line.scroller.GetConfig().Mode == anim.ScrollTypewriter {
+		visible := line.scroller.VisibleText(line.text)
+		text := line.styled.Prefix(utf8.RuneCountInString(visible))
+		render.DrawStyledTextInRect(img, text, area, w.horizAlign, w.vertAlign)
+		return
+	}
 
-	textWidth, _ := bitmap.SmartMeasureText(text, w.fontFace, w.fontName)
+	text := line.styled
+	offset := line.scroller.GetOffset()
+	textWidth, _ := text.Measure()
 
 	// If text fits, just draw it normally
-	if textWidth <= contentW {
-		bitmap.SmartDrawAlignedText(img, text, w.fontFace, w.fontName, w.horizAlign, w.vertAlign, w.padding)
+	if textWidth <= area.Dx() {
+		render.DrawStyledTextInRect(img, text, area, w.horizAlign, w.vertAlign)
 		return
 	}
 
-	// Calculate aligned position using bitmap helper
-	textX, textY := bitmap.SmartCalculateTextPosition(text, w.fontFace, w.fontName, contentX, contentY, contentW, contentH, w.horizAlign, w.vertAlign)
+	// Aligned left edge of the text
+	textX := render.AlignedX(textWidth, area.Min.X, area.Dx(), w.horizAlign)
+	draw := func(x, y int) {
+		render.DrawStyledTextAt(img, text, x, y, area.Dy(), w.vertAlign, area)
+	}
 
 	// Get scroller configuration
-	scrollCfg := w.scroller.GetConfig()
+	scrollCfg := line.scroller.GetConfig()
 
 	// Handle horizontal scrolling
-	if w.scroller.IsHorizontal() {
+	if line.scroller.IsHorizontal() {
 		// Apply horizontal scroll offset
 		scrollX := textX - int(offset)
 
 		// For continuous mode, draw text twice for seamless loop
 		if scrollCfg.Mode == anim.ScrollContinuous {
-			bitmap.SmartDrawTextAtPosition(img, text, w.fontFace, w.fontName, scrollX, textY, contentX, contentY, contentW, contentH)
+			draw(scrollX, area.Min.Y)
 
 			// Draw second instance for seamless loop
 			if scrollCfg.Direction == anim.ScrollLeft {
-				textX2 := scrollX + textWidth + w.scrollGap
-				if textX2 < contentX+contentW {
-					bitmap.SmartDrawTextAtPosition(img, text, w.fontFace, w.fontName, textX2, textY, contentX, contentY, contentW, contentH)
+				textX2 := scrollX + textWidth + line.scrollGap
+				if textX2 < area.Max.X {
+					draw(textX2, area.Min.Y)
 				}
 			} else {
-				textX2 := scrollX - textWidth - w.scrollGap
-				if textX2+textWidth > contentX {
-					bitmap.SmartDrawTextAtPosition(img, text, w.fontFace, w.fontName, textX2, textY, contentX, contentY, contentW, contentH)
+				textX2 := scrollX - textWidth - line.scrollGap
+				if textX2+textWidth > area.Min.X {
+					draw(textX2, area.Min.Y)
 				}
 			}
 		} else {
 			// For bounce and pause_ends modes, just draw once
-			bitmap.SmartDrawTextAtPosition(img, text, w.fontFace, w.fontName, scrollX, textY, contentX, contentY, contentW, contentH)
+			draw(scrollX, area.Min.Y)
 		}
 	} else {
 		// Vertical scrolling - apply offset to Y
-		scrollY := textY - int(offset)
-		bitmap.SmartDrawTextAtPosition(img, text, w.fontFace, w.fontName, textX, scrollY, contentX, contentY, contentW, contentH)
+		draw(textX, area.Min.Y-int(offset))
 	}
 }
@@ -1,10 +1,14 @@
 package winampwidget
 
 import (
+	"image"
+	"slices"
 	"testing"
 
 	"github.com/pozitronik/steelclock-go/internal/config"
 	"github.com/pozitronik/steelclock-go/internal/shared/anim"
+	"github.com/pozitronik/steelclock-go/internal/shared/render"
+	"github.com/pozitronik/steelclock-go/internal/winamp"
 )
 
 // TestNew tests basic widget creation
@@ -53,8 +57,8 @@ func TestNew_WithTextConfig(t *testing.T) {
 	if w.fontSize != 14 {
 		t.Errorf("widget.fontSize = %d, want 14", w.fontSize)
 	}
-	if w.format != "{title} - {status}" {
-		t.Errorf("widget.format = %q, want {title} - {status}", w.format)
+	if w.lines[0].format != "{title} - {status}" {
+		t.Errorf("widget.format = %q, want {title} - {status}", w.lines[0].format)
 	}
 	if w.horizAlign != config.AlignLeft {
 		t.Errorf("widget.horizAlign = %q, want left", w.horizAlign)
@@ -86,10 +90,10 @@ func TestNew_WithScrollConfig(t *testing.T) {
 		t.Fatalf("New() error = %v", err)
 	}
 
-	if !w.scrollEnabled {
+	if !w.lines[0].scrollEnabled {
 		t.Error("widget.scrollEnabled = false, want true")
 	}
-	scrollCfg := w.scroller.GetConfig()
+	scrollCfg := w.lines[0].scroller.GetConfig()
 	if scrollCfg.Direction != anim.ScrollRight {
 		t.Errorf("scrollCfg.Direction = %q, want right", scrollCfg.Direction)
 	}
@@ -102,8 +106,8 @@ func TestNew_WithScrollConfig(t *testing.T) {
 	if scrollCfg.PauseMs != 2000 {
 		t.Errorf("scrollCfg.PauseMs = %d, want 2000", scrollCfg.PauseMs)
 	}
-	if w.scrollGap != 30 {
-		t.Errorf("widget.scrollGap = %d, want 30", w.scrollGap)
+	if w.lines[0].scrollGap != 30 {
+		t.Errorf("widget.scrollGap = %d, want 30", w.lines[0].scrollGap)
 	}
 }
 
@@ -189,8 +193,8 @@ func TestWidget_Defaults(t *testing.T) {
 	}
 
 	// Check default values
-	if w.format != "{title}" {
-		t.Errorf("default format = %q, want {title}", w.format)
+	if w.lines[0].format != "{title}" {
+		t.Errorf("default format = %q, want {title}", w.lines[0].format)
 	}
 	if w.fontSize != 12 {
 		t.Errorf("default fontSize = %d, want 12", w.fontSize)
@@ -207,10 +211,10 @@ func TestWidget_Defaults(t *testing.T) {
 	if w.placeholderText != "No Winamp" {
 		t.Errorf("default placeholderText = %q, want No Winamp", w.placeholderText)
 	}
-	if w.scrollEnabled {
+	if w.lines[0].scrollEnabled {
 		t.Error("default scrollEnabled = true, want false")
 	}
-	defaultScrollCfg := w.scroller.GetConfig()
+	defaultScrollCfg := w.lines[0].scroller.GetConfig()
 	if defaultScrollCfg.Direction != anim.ScrollLeft {
 		t.Errorf("default scrollDirection = %q, want left", defaultScrollCfg.Direction)
 	}
@@ -306,6 +310,147 @@ func TestWidget_Render_TextPlaceholder(t *testing.T) {
 	}
 }
 
+// TestNew_WithLines tests separate lines with per-line scroll settings
+func TestNew_WithLines(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type: "winamp",
+		Position: config.PositionConfig{
+			X: 0, Y: 0, W: 128, H: 40,
+		},
+		Text:   &config.TextConfig{Format: "ignored"},
+		Scroll: &config.ScrollConfig{Enabled: true, Speed: 20},
+		Winamp: &config.WinampConfig{
+			Lines: []config.WinampLineConfig{
+				{Format: "{title}"},
+				{Format: "{position}/{duration}", Scroll: &config.ScrollConfig{Enabled: false}},
+			},
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if len(w.lines) != 2 {
+		t.Fatalf("len(lines) = %d, want 2", len(w.lines))
+	}
+	if w.lines[0].format != "{title}" || !w.lines[0].scrollEnabled || w.lines[0].scroller.GetConfig().Speed != 20 {
+		t.Errorf("line 0 = %q scroll %v, want {title} with widget scroll", w.lines[0].format, w.lines[0].scrollEnabled)
+	}
+	if w.lines[1].scrollEnabled {
+		t.Error("line 1 should use its own scroll settings")
+	}
+}
+
+// TestWidget_Render_Lines tests that each line is drawn in its own row
+func TestWidget_Render_Lines(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type: "winamp",
+		Position: config.PositionConfig{
+			X: 0, Y: 0, W: 128, H: 40,
+		},
+		Winamp: &config.WinampConfig{
+			Lines: []config.WinampLineConfig{{Format: "{title}"}, {Format: "{status}"}},
+		},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	setLineText(w, w.lines[0], "Top")
+
+	img, err := w.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	gray := img.(*image.Gray)
+	top, bottom := 0, 0
+	for y := 0; y < 40; y++ {
+		for x := 0; x < 128; x++ {
+			if gray.GrayAt(x, y).Y > 0 {
+				if y < 20 {
+					top++
+				} else {
+					bottom++
+				}
+			}
+		}
+	}
+	if top == 0 || bottom != 0 {
+		t.Errorf("lit pixels top/bottom = %d/%d, want text only in the top row", top, bottom)
+	}
+}
+
+// TestWidget_Render_Typewriter tests that typewriter mode reveals the text as it is typed
+func TestWidget_Render_Typewriter(t *testing.T) {
+	cfg := config.WidgetConfig{
+		Type:     "winamp",
+		Position: config.PositionConfig{X: 0, Y: 0, W: 128, H: 40},
+		Scroll:   &config.ScrollConfig{Enabled: true, Mode: config.ScrollTypewriter, Speed: 10},
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	setLineText(w, w.lines[0], "Song")
+
+	litPixels := func() int {
+		img, err := w.Render()
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		lit := 0
+		for _, p := range img.(*image.Gray).Pix {
+			if p > 0 {
+				lit++
+			}
+		}
+		return lit
+	}
+
+	if lit := litPixels(); lit != 0 {
+		t.Errorf("lit pixels before typing = %d, want 0", lit)
+	}
+
+	w.lines[0].scroller.SetOffset(2)
+	partial := litPixels()
+	w.lines[0].scroller.SetOffset(4)
+	full := litPixels()
+	if partial == 0 || partial >= full {
+		t.Errorf("lit pixels for 2 of 4 characters = %d, full text = %d; want fewer but some", partial, full)
+	}
+}
+
+// TestWidget_NowPlaying tests that the last fetched track is exposed as a copy
+func TestWidget_NowPlaying(t *testing.T) {
+	w, err := New(config.WidgetConfig{
+		Type:     "winamp",
+		Position: config.PositionConfig{W: 128, H: 40},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if info := w.NowPlaying(); info != nil {
+		t.Errorf("NowPlaying() before update = %+v, want nil", info)
+	}
+
+	w.lastInfo = &winamp.TrackInfo{Title: "Song", PositionMs: 1500, Status: winamp.StatusPlaying}
+	info := w.NowPlaying()
+	if info == nil || info.Title != "Song" || info.PositionMs != 1500 || info.Status != winamp.StatusPlaying {
+		t.Fatalf("NowPlaying() = %+v, want the last track info", info)
+	}
+
+	info.Title = "Changed"
+	if w.lastInfo.Title != "Song" {
+		t.Error("NowPlaying() returned the widget's own track info, want a copy")
+	}
+}
+
 // TestFormatTime tests the formatTime helper function
 func TestFormatTime(t *testing.T) {
 	tests := []struct {
@@ -329,3 +474,57 @@ func TestFormatTime(t *testing.T) {
 		}
 	}
 }
+
+// setLineText sets the formatted text of a line in the widget font
+func setLineText(w *Widget, line *textLine, text string) {
+	line.text = text
+	line.styled = render.StyledText{{Text: text, Color: 255, FontFace: w.fontFace, FontName: w.fontName}}
+}
+
+func TestFormatOutput(t *testing.T) {
+	info := &winamp.TrackInfo{Title: "Song", Artist: "Band", Status: winamp.StatusPlaying}
+	base := render.StyledRun{Color: 255}
+
+	tests := []struct {
+		name   string
+		format string
+		want   render.StyledText
+	}{
+		{
+			name:   "plain format is one run",
+			format: "{artist} - {title}",
+			want:   render.StyledText{{Text: "Band - Song", Color: 255}},
+		},
+		{
+			name:   "styled token",
+			format: "{artist|dim} - {title}",
+			want:   render.StyledText{{Text: "Band", Color: render.StyleDim}, {Text: " - Song", Color: 255}},
+		},
+		{
+			name:   "unknown and parameterized tokens kept as written",
+			format: "{album} {title:20}",
+			want:   render.StyledText{{Text: "{album} {title:20}", Color: 255}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := render.ParseFormatTokens(tt.format, func(string) render.TokenType { return render.TokenText })
+			got := formatOutput(tokens, info, base, nil)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("formatOutput(%q) = %+v, want %+v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew_UnknownStyleFont(t *testing.T) {
+	_, err := New(config.WidgetConfig{
+		Type:     "winamp",
+		Position: config.PositionConfig{W: 128, H: 40},
+		Text:     &config.TextConfig{Format: "{title|font=no-such-font}"},
+	})
+	if err == nil {
+		t.Error("New() should fail for a token style naming an unknown font")
+	}
+}
@@ -0,0 +1,151 @@
+package winamp
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// errMPRISUnavailable is returned by the MPRIS query on platforms without D-Bus
+var errMPRISUnavailable = errors.New("MPRIS is only available on Linux")
+
+// playerctlFormat requests the MPRIS properties used by TrackInfo, tab-separated.
+// Position and length are reported in microseconds.
+const playerctlFormat = "{{status}}\t{{xesam:title}}\t{{xesam:artist}}\t{{position}}\t{{mpris:length}}\t{{xesam:url}}\t{{xesam:trackNumber}}\t{{shuffle}}\t{{loop}}\t{{playerName}}"
+
+// mprisPollInterval is the minimum time between playerctl runs. Each query starts a
+// process, so faster widget updates reuse the last result.
+const mprisPollInterval = time.Second
+
+// mprisClient reads track information from MPRIS media players (Spotify, VLC, ...)
+// through the playerctl command-line tool
+type mprisClient struct {
+	// query returns playerctl metadata in playerctlFormat
+	query func() (string, error)
+	now   func() time.Time
+
+	mu      sync.Mutex
+	info    *TrackInfo // Last query result (nil = no player)
+	queried time.Time  // When the last query ran
+}
+
+// NewMPRISClient creates a client for MPRIS media players.
+// Requires playerctl on Linux; on other platforms no player is ever found.
+func NewMPRISClient() Client {
+	return &mprisClient{query: queryPlayerctl, now: time.Now}
+}
+
+// IsRunning returns true if an MPRIS player is available
+func (c *mprisClient) IsRunning() bool {
+	return c.GetTrackInfo() != nil
+}
+
+// GetStatus returns the current playback status
+func (c *mprisClient) GetStatus() PlaybackStatus {
+	if info := c.GetTrackInfo(); info != nil {
+		return info.Status
+	}
+	return StatusStopped
+}
+
+// GetTrackInfo returns information about the current track of the active player.
+// playerctl runs at most once per mprisPollInterval; in between, the position of
+// a playing track advances with the clock.
+func (c *mprisClient) GetTrackInfo() *TrackInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if c.queried.IsZero() || now.Sub(c.queried) >= mprisPollInterval {
+		c.info = nil
+		if out, err := c.query(); err == nil {
+			c.info = parsePlayerctlOutput(out)
+		}
+		c.queried = now
+	}
+	if c.info == nil {
+		return nil
+	}
+
+	info := *c.info
+	if info.Status == StatusPlaying && info.PositionMs >= 0 {
+		info.PositionMs += int(now.Sub(c.queried).Milliseconds())
+		if info.DurationS > 0 {
+			info.PositionMs = min(info.PositionMs, info.DurationS*1000)
+		}
+	}
+	return &info
+}
+
+// GetCurrentTitle returns the title of the current track
+func (c *mprisClient) GetCurrentTitle() string {
+	if info := c.GetTrackInfo(); info != nil {
+		return info.Title
+	}
+	return ""
+}
+
+// GetCurrentPosition returns the current playback position in milliseconds
+func (c *mprisClient) GetCurrentPosition() int {
+	if info := c.GetTrackInfo(); info != nil {
+		return info.PositionMs
+	}
+	return -1
+}
+
+// GetTrackDuration returns the track duration in seconds
+func (c *mprisClient) GetTrackDuration() int {
+	if info := c.GetTrackInfo(); info != nil {
+		return info.DurationS
+	}
+	return -1
+}
+
+// parsePlayerctlOutput converts a playerctlFormat line to TrackInfo.
+// Returns nil for empty or malformed output.
+func parsePlayerctlOutput(out string) *TrackInfo {
+	fields := strings.Split(strings.TrimRight(out, "\r\n"), "\t")
+	if len(fields) < 10 {
+		return nil
+	}
+
+	info := &TrackInfo{
+		Title:      fields[1],
+		Artist:     fields[2],
+		PositionMs: -1,
+		DurationS:  -1,
+		Shuffle:    fields[7] == "true",
+		Repeat:     fields[8] == "Track" || fields[8] == "Playlist",
+		Version:    fields[9],
+	}
+
+	switch fields[0] {
+	case "Playing":
+		info.Status = StatusPlaying
+	case "Paused":
+		info.Status = StatusPaused
+	default:
+		info.Status = StatusStopped
+	}
+
+	if us, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+		info.PositionMs = int(us / 1000)
+	}
+	if us, err := strconv.ParseInt(fields[4], 10, 64); err == nil {
+		info.DurationS = int(us / 1000000)
+	}
+	if n, err := strconv.Atoi(fields[6]); err == nil {
+		info.TrackNumber = n
+	}
+
+	// Local files expose a file:// URL; streams keep only the title
+	if filePath, ok := strings.CutPrefix(fields[5], "file://"); ok {
+		info.FilePath = filePath
+		info.FileName = path.Base(filePath)
+	}
+
+	return info
+}
@@ -0,0 +1,25 @@
+//go:build linux
+
+package winamp
+
+import (
+	"context"
+	"os/exec"
+	"time"
+)
+
+// playerctlTimeout bounds a metadata query so a hung player cannot stall updates
+const playerctlTimeout = 500 * time.Millisecond
+
+// queryPlayerctl asks playerctl for the metadata of the active MPRIS player.
+// Fails when playerctl is not installed or no player is running.
+func queryPlayerctl() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), playerctlTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, "playerctl", "metadata", "--format", playerctlFormat).Output()
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+
+package winamp
+
+// queryPlayerctl always fails: MPRIS is a Linux D-Bus interface
+func queryPlayerctl() (string, error) {
+	return "", errMPRISUnavailable
+}
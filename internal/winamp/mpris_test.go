@@ -0,0 +1,128 @@
+package winamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+)
+
+// TestParsePlayerctlOutput tests conversion of playerctl metadata to TrackInfo
+func TestParsePlayerctlOutput(t *testing.T) {
+	out := "Playing\tSong\tBand\t61500000\t215000000\tfile:///music/band/song.flac\t3\ttrue\tPlaylist\tvlc\n"
+
+	info := parsePlayerctlOutput(out)
+	if info == nil {
+		t.Fatal("parsePlayerctlOutput() returned nil")
+	}
+	if info.Status != StatusPlaying {
+		t.Errorf("Status = %v, want Playing", info.Status)
+	}
+	if info.Title != "Song" || info.Artist != "Band" {
+		t.Errorf("Title/Artist = %q/%q, want Song/Band", info.Title, info.Artist)
+	}
+	if info.PositionMs != 61500 || info.DurationS != 215 {
+		t.Errorf("PositionMs/DurationS = %d/%d, want 61500/215", info.PositionMs, info.DurationS)
+	}
+	if info.FilePath != "/music/band/song.flac" || info.FileName != "song.flac" {
+		t.Errorf("FilePath/FileName = %q/%q", info.FilePath, info.FileName)
+	}
+	if info.TrackNumber != 3 || !info.Shuffle || !info.Repeat || info.Version != "vlc" {
+		t.Errorf("TrackNumber/Shuffle/Repeat/Version = %d/%v/%v/%q", info.TrackNumber, info.Shuffle, info.Repeat, info.Version)
+	}
+}
+
+// TestParsePlayerctlOutput_Stream tests a stream without file URL or length
+func TestParsePlayerctlOutput_Stream(t *testing.T) {
+	info := parsePlayerctlOutput("Paused\tRadio Show\t\t\t\thttps://stream.example/live\t\tfalse\tNone\tspotify")
+	if info == nil {
+		t.Fatal("parsePlayerctlOutput() returned nil")
+	}
+	if info.Status != StatusPaused {
+		t.Errorf("Status = %v, want Paused", info.Status)
+	}
+	if info.PositionMs != -1 || info.DurationS != -1 {
+		t.Errorf("PositionMs/DurationS = %d/%d, want -1/-1", info.PositionMs, info.DurationS)
+	}
+	if info.FilePath != "" || info.Repeat {
+		t.Errorf("FilePath = %q, Repeat = %v, want empty and false", info.FilePath, info.Repeat)
+	}
+}
+
+// TestParsePlayerctlOutput_Malformed tests that incomplete output is rejected
+func TestParsePlayerctlOutput_Malformed(t *testing.T) {
+	for _, out := range []string{"", "No players found", "Playing\tSong"} {
+		if info := parsePlayerctlOutput(out); info != nil {
+			t.Errorf("parsePlayerctlOutput(%q) = %+v, want nil", out, info)
+		}
+	}
+}
+
+// TestMPRISClient tests the client against a fake playerctl query
+func TestMPRISClient(t *testing.T) {
+	now := time.Now()
+	c := &mprisClient{now: func() time.Time { return now }, query: func() (string, error) {
+		return "Playing\tSong\tBand\t1000000\t60000000\t\t1\tfalse\tNone\tspotify", nil
+	}}
+	if !c.IsRunning() || c.GetStatus() != StatusPlaying {
+		t.Error("client with a playing player should report Playing")
+	}
+	if c.GetCurrentTitle() != "Song" || c.GetCurrentPosition() != 1000 || c.GetTrackDuration() != 60 {
+		t.Errorf("title/position/duration = %q/%d/%d", c.GetCurrentTitle(), c.GetCurrentPosition(), c.GetTrackDuration())
+	}
+
+	none := &mprisClient{now: time.Now, query: func() (string, error) { return "", errors.New("no players") }}
+	if none.IsRunning() || none.GetTrackInfo() != nil || none.GetCurrentPosition() != -1 {
+		t.Error("client without players should report nothing")
+	}
+}
+
+// TestMPRISClient_PollInterval tests that playerctl runs at most once per poll interval
+func TestMPRISClient_PollInterval(t *testing.T) {
+	now := time.Now()
+	queries := 0
+	status := "Playing"
+	c := &mprisClient{now: func() time.Time { return now }, query: func() (string, error) {
+		queries++
+		return status + "\tSong\tBand\t1000000\t3000000\t\t1\tfalse\tNone\tspotify", nil
+	}}
+
+	c.GetTrackInfo()
+	c.GetStatus()
+	now = now.Add(mprisPollInterval / 2)
+	if got := c.GetCurrentPosition(); got != 1500 {
+		t.Errorf("position between queries = %d, want 1500", got)
+	}
+	if queries != 1 {
+		t.Errorf("playerctl ran %d times within the poll interval, want 1", queries)
+	}
+
+	// The position stops at the end of the track
+	now = now.Add(mprisPollInterval/2 - time.Millisecond)
+	status = "Paused"
+	if got := c.GetCurrentPosition(); got != 1999 {
+		t.Errorf("position before the next query = %d, want 1999", got)
+	}
+
+	now = now.Add(time.Millisecond)
+	if c.GetStatus() != StatusPaused || queries != 2 {
+		t.Errorf("status = %v after %d queries, want a fresh Paused reading", c.GetStatus(), queries)
+	}
+	if got := c.GetCurrentPosition(); got != 1000 {
+		t.Errorf("paused position = %d, want 1000", got)
+	}
+}
+
+// TestNewClientForSource tests player source selection
+func TestNewClientForSource(t *testing.T) {
+	if _, ok := NewClientForSource(config.WinampSourceMPRIS).(*mprisClient); !ok {
+		t.Error("mpris source should create an MPRIS client")
+	}
+	if _, ok := NewClientForSource(config.WinampSourceWinamp).(*mprisClient); ok {
+		t.Error("winamp source should not create an MPRIS client")
+	}
+	if NewClientForSource(config.WinampSourceAuto) == nil {
+		t.Error("auto source returned nil")
+	}
+}
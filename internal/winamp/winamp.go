@@ -1,6 +1,14 @@
-// Package winamp provides communication with Winamp media player via Windows IPC messages.
+// Package winamp provides communication with Winamp media player via Windows IPC messages,
+// and with MPRIS media players (Spotify, VLC, ...) on Linux.
 package winamp
 
+import (
+	"runtime"
+
+	"github.com/pozitronik/steelclock-go/internal/config"
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+)
+
 // PlaybackStatus represents Winamp playback state
 type PlaybackStatus int
 
@@ -22,9 +30,22 @@ func (s PlaybackStatus) String() string {
 	}
 }
 
+// Message returns the translatable message key for the status
+func (s PlaybackStatus) Message() i18n.Message {
+	switch s {
+	case StatusPlaying:
+		return i18n.Playing
+	case StatusPaused:
+		return i18n.Paused
+	default:
+		return i18n.Stopped
+	}
+}
+
 // TrackInfo contains information about the currently playing track
 type TrackInfo struct {
 	Title          string         // Track title from playlist
+	Artist         string         // Track artist (MPRIS only; Winamp titles usually include it)
 	FilePath       string         // Full file path
 	FileName       string         // Filename without path
 	PositionMs     int            // Current position in milliseconds
@@ -70,3 +91,18 @@ type Client interface {
 func NewClient() Client {
 	return newPlatformClient()
 }
+
+// NewClientForSource creates a client for the configured player source:
+// "winamp", "mpris", or "auto" (MPRIS on Linux, Winamp elsewhere)
+func NewClientForSource(source string) Client {
+	switch source {
+	case config.WinampSourceMPRIS:
+		return NewMPRISClient()
+	case config.WinampSourceWinamp:
+		return NewClient()
+	}
+	if runtime.GOOS == "linux" {
+		return NewMPRISClient()
+	}
+	return NewClient()
+}
@@ -1,6 +1,10 @@
 package winamp
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/pozitronik/steelclock-go/internal/i18n"
+)
 
 // TestPlaybackStatus_String tests the String method of PlaybackStatus
 func TestPlaybackStatus_String(t *testing.T) {
@@ -22,6 +26,25 @@ func TestPlaybackStatus_String(t *testing.T) {
 	}
 }
 
+// TestPlaybackStatus_Message tests the translation key of PlaybackStatus
+func TestPlaybackStatus_Message(t *testing.T) {
+	tests := []struct {
+		status   PlaybackStatus
+		expected i18n.Message
+	}{
+		{StatusPlaying, i18n.Playing},
+		{StatusPaused, i18n.Paused},
+		{StatusStopped, i18n.Stopped},
+		{PlaybackStatus(99), i18n.Stopped},
+	}
+
+	for _, tt := range tests {
+		if got := tt.status.Message(); got != tt.expected {
+			t.Errorf("PlaybackStatus(%d).Message() = %q, want %q", tt.status, got, tt.expected)
+		}
+	}
+}
+
 // TestNewClient verifies that NewClient returns a non-nil Client
 func TestNewClient(t *testing.T) {
 	client := NewClient()